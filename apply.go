@@ -1,6 +1,83 @@
 package scalr
 
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"time"
+)
+
+// Compile-time proof of interface implementation.
+var _ Applies = (*applies)(nil)
+
+// Applies describes all the apply related methods that the Scalr API supports.
+type Applies interface {
+	// Read an apply by its ID.
+	Read(ctx context.Context, applyID string) (*Apply, error)
+	// Logs retrieves the logs of an apply. The caller must close the returned reader.
+	Logs(ctx context.Context, applyID string, options LogReadOptions) (io.ReadCloser, error)
+}
+
+// applies implements Applies.
+type applies struct {
+	client *Client
+}
+
+// ApplyStatus represents an apply state.
+type ApplyStatus string
+
+// List all available apply statuses.
+const (
+	ApplyCanceled    ApplyStatus = "canceled"
+	ApplyErrored     ApplyStatus = "errored"
+	ApplyFinished    ApplyStatus = "finished"
+	ApplyPending     ApplyStatus = "pending"
+	ApplyQueued      ApplyStatus = "queued"
+	ApplyRunning     ApplyStatus = "running"
+	ApplyUnreachable ApplyStatus = "unreachable"
+)
+
 // Apply represents a Scalr apply.
 type Apply struct {
-	ID string `jsonapi:"primary,applies"`
+	ID                   string                `jsonapi:"primary,applies"`
+	Status               ApplyStatus           `jsonapi:"attr,status"`
+	ResourceAdditions    int                   `jsonapi:"attr,resource-additions"`
+	ResourceChanges      int                   `jsonapi:"attr,resource-changes"`
+	ResourceDestructions int                   `jsonapi:"attr,resource-destructions"`
+	ResourceImports      int                   `jsonapi:"attr,resource-imports"`
+	ResourceForgets      int                   `jsonapi:"attr,resource-forgets"`
+	StatusTimestamps     *map[string]time.Time `jsonapi:"attr,status-timestamps"`
+}
+
+// Read an apply by its ID.
+func (s *applies) Read(ctx context.Context, applyID string) (*Apply, error) {
+	if !validStringID(&applyID) {
+		return nil, errors.New("invalid value for apply ID")
+	}
+
+	u := fmt.Sprintf("applies/%s", url.QueryEscape(applyID))
+	req, err := s.client.newRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	a := &Apply{}
+	err = s.client.do(ctx, req, a)
+	if err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+// Logs retrieves the logs of an apply.
+func (s *applies) Logs(ctx context.Context, applyID string, options LogReadOptions) (io.ReadCloser, error) {
+	if !validStringID(&applyID) {
+		return nil, errors.New("invalid value for apply ID")
+	}
+
+	u := fmt.Sprintf("applies/%s/output", url.QueryEscape(applyID))
+	return s.client.readLogs(ctx, u, &options)
 }
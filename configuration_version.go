@@ -4,7 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"net/url"
+	"os"
+	"time"
 )
 
 // Compile-time proof of interface implementation.
@@ -19,6 +22,10 @@ type ConfigurationVersions interface {
 
 	// Read a configuration version by its ID.
 	Read(ctx context.Context, cvID string) (*ConfigurationVersion, error)
+
+	// Upload packs the Terraform configuration in the given directory and
+	// uploads it to the configuration version, then waits until it is processed.
+	Upload(ctx context.Context, cvID string, path string) (*ConfigurationVersion, error)
 }
 
 // configurationVersions implements ConfigurationVersions.
@@ -40,8 +47,10 @@ const (
 // Terraform configuration in Scalr. A workspace must have at least one
 // configuration version before any runs may be queued on it.
 type ConfigurationVersion struct {
-	ID     string              `jsonapi:"primary,configuration-versions"`
-	Status ConfigurationStatus `jsonapi:"attr,status"`
+	ID           string              `jsonapi:"primary,configuration-versions"`
+	Status       ConfigurationStatus `jsonapi:"attr,status"`
+	ErrorMessage string              `jsonapi:"attr,error-message"`
+	UploadURL    string              `jsonapi:"attr,upload-url"`
 	// Relations
 	Workspace *Workspace `jsonapi:"relation,workspace"`
 }
@@ -108,3 +117,97 @@ func (s *configurationVersions) Read(ctx context.Context, cvID string) (*Configu
 
 	return cv, nil
 }
+
+// Upload packs the Terraform configuration in the given directory as a gzipped
+// tarball and uploads it to the configuration version. The .git/ and .terraform/
+// directories are always skipped, as well as files matched by the .terraformignore
+// file of the directory.
+//
+// Once uploaded, the configuration version is polled until it leaves the pending
+// status. If processing the configuration failed, the errored configuration version
+// is returned along with an error.
+func (s *configurationVersions) Upload(ctx context.Context, cvID string, path string) (*ConfigurationVersion, error) {
+	cv, err := s.Read(ctx, cvID)
+	if err != nil {
+		return nil, err
+	}
+	if cv.UploadURL == "" {
+		return nil, fmt.Errorf("configuration version %s has no upload URL", cvID)
+	}
+
+	// Fail early, packing errors would otherwise surface as upload errors.
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("%s is not a directory", path)
+	}
+
+	req, err := s.client.newUploadRequest(cv.UploadURL)
+	if err != nil {
+		return nil, err
+	}
+
+	// Read-only and dry-run clients never send the upload, so there is nothing to pack.
+	if !s.client.readOnly && s.client.dryRun == nil {
+		// Presigned upload URLs reject chunked bodies, so the tarball is packed
+		// to a temporary file to be sent with its length.
+		f, err := os.CreateTemp("", "scalr-upload-*.tar.gz")
+		if err != nil {
+			return nil, err
+		}
+		defer os.Remove(f.Name())
+		defer f.Close()
+
+		if err := packContents(path, f); err != nil {
+			return nil, err
+		}
+		size, err := f.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return nil, err
+		}
+		if err := req.SetBody(f); err != nil {
+			return nil, err
+		}
+		req.ContentLength = size
+	}
+
+	err = s.client.do(ctx, req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.waitForUpload(ctx, cvID)
+}
+
+// waitForUpload polls the configuration version until its status is not pending anymore.
+func (s *configurationVersions) waitForUpload(ctx context.Context, cvID string) (*ConfigurationVersion, error) {
+	interval := 500 * time.Millisecond
+
+	for {
+		cv, err := s.Read(ctx, cvID)
+		if err != nil {
+			return nil, err
+		}
+
+		switch cv.Status {
+		case ConfigurationUploaded:
+			return cv, nil
+		case ConfigurationErrored:
+			return cv, fmt.Errorf("configuration version %s errored: %s", cvID, cv.ErrorMessage)
+		}
+
+		timer := time.NewTimer(jitter(interval))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+
+		if interval < 5*time.Second {
+			interval *= 2
+		}
+	}
+}
@@ -1,9 +1,15 @@
 package scalr
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -74,3 +80,156 @@ func TestConfigurationVersionsRead(t *testing.T) {
 		assert.EqualError(t, err, "invalid value for configuration version ID")
 	})
 }
+
+func TestConfigurationVersionsUpload(t *testing.T) {
+	ctx := context.Background()
+
+	// uploadServer serves cv-123, which becomes the given status once uploaded.
+	// The first throttled uploads are rate limited.
+	uploadServer := func(t *testing.T, final ConfigurationStatus, throttled int) (*Client, *[]byte) {
+		var uploaded []byte
+		status := ConfigurationPending
+		polls := 0
+
+		var ts *httptest.Server
+		ts = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == "PUT" {
+				assert.Equal(t, "application/octet-stream", r.Header.Get("Content-Type"))
+				assert.Empty(t, r.Header.Get("Authorization"))
+				assert.Empty(t, r.TransferEncoding)
+				if throttled > 0 {
+					throttled--
+					w.Header().Set("Retry-After", "0")
+					w.WriteHeader(http.StatusTooManyRequests)
+					return
+				}
+				uploaded, _ = io.ReadAll(r.Body)
+				assert.Equal(t, int64(len(uploaded)), r.ContentLength)
+				return
+			}
+
+			// Stay pending for one more poll after the upload.
+			if uploaded != nil {
+				if polls++; polls > 1 {
+					status = final
+				}
+			}
+
+			w.Header().Set("Content-Type", "application/vnd.api+json")
+			fmt.Fprintf(w, `{"data":{"id":"cv-123","type":"configuration-versions","attributes":{"status":%q,"error-message":"boom","upload-url":%q}}}`, status, ts.URL+"/upload/cv-123")
+		}))
+		t.Cleanup(ts.Close)
+
+		client, err := NewClient(&Config{
+			Address:    ts.URL,
+			Token:      "dummy-token",
+			HTTPClient: ts.Client(),
+		})
+		require.NoError(t, err)
+
+		return client, &uploaded
+	}
+
+	t.Run("when the configuration is uploaded", func(t *testing.T) {
+		client, uploaded := uploadServer(t, ConfigurationUploaded, 0)
+
+		cv, err := client.ConfigurationVersions.Upload(ctx, "cv-123", "test-fixtures/config-version")
+		require.NoError(t, err)
+		assert.Equal(t, ConfigurationUploaded, cv.Status)
+		assert.Equal(t, []string{"main.tf"}, keys(unpackNames(t, bytes.NewReader(*uploaded))))
+	})
+
+	t.Run("when the upload is retried", func(t *testing.T) {
+		client, uploaded := uploadServer(t, ConfigurationUploaded, 2)
+
+		cv, err := client.ConfigurationVersions.Upload(ctx, "cv-123", "test-fixtures/config-version")
+		require.NoError(t, err)
+		assert.Equal(t, ConfigurationUploaded, cv.Status)
+		assert.Equal(t, []string{"main.tf"}, keys(unpackNames(t, bytes.NewReader(*uploaded))))
+	})
+
+	t.Run("when the upload URL is on another host", func(t *testing.T) {
+		var uploaded []byte
+		storage := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Empty(t, r.Header.Get("Authorization"))
+			uploaded, _ = io.ReadAll(r.Body)
+		}))
+		t.Cleanup(storage.Close)
+
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			status := ConfigurationPending
+			if uploaded != nil {
+				status = ConfigurationUploaded
+			}
+			w.Header().Set("Content-Type", "application/vnd.api+json")
+			fmt.Fprintf(w, `{"data":{"id":"cv-123","type":"configuration-versions","attributes":{"status":%q,"upload-url":%q}}}`, status, storage.URL+"/cv-123")
+		}))
+		t.Cleanup(ts.Close)
+
+		client, err := NewClient(&Config{
+			Address:    ts.URL,
+			Token:      "dummy-token",
+			Headers:    http.Header{"Authorization": []string{"Basic c2NhbHI="}},
+			HTTPClient: ts.Client(),
+		})
+		require.NoError(t, err)
+
+		cv, err := client.ConfigurationVersions.Upload(ctx, "cv-123", "test-fixtures/config-version")
+		require.NoError(t, err)
+		assert.Equal(t, ConfigurationUploaded, cv.Status)
+		assert.Equal(t, []string{"main.tf"}, keys(unpackNames(t, bytes.NewReader(uploaded))))
+	})
+
+	t.Run("in dry-run mode", func(t *testing.T) {
+		client, uploaded := uploadServer(t, ConfigurationUploaded, 0)
+		plan := &MutationPlan{}
+		client.dryRun = plan
+
+		cv, err := client.ConfigurationVersions.Upload(ctx, "cv-123", "test-fixtures/config-version")
+		assert.Nil(t, cv)
+		assert.ErrorIs(t, err, ErrMutationBlocked)
+		assert.Nil(t, *uploaded)
+
+		// The tarball is not packed for the plan.
+		require.Len(t, plan.Mutations(), 1)
+		assert.Equal(t, http.MethodPut, plan.Mutations()[0].Method)
+		assert.Empty(t, plan.Mutations()[0].Body)
+	})
+
+	t.Run("when the directory does not exist", func(t *testing.T) {
+		client, uploaded := uploadServer(t, ConfigurationUploaded, 0)
+
+		cv, err := client.ConfigurationVersions.Upload(ctx, "cv-123", "test-fixtures/nonexisting")
+		assert.Nil(t, cv)
+		assert.ErrorIs(t, err, os.ErrNotExist)
+		assert.Nil(t, *uploaded)
+	})
+
+	t.Run("when processing the configuration failed", func(t *testing.T) {
+		client, _ := uploadServer(t, ConfigurationErrored, 0)
+
+		cv, err := client.ConfigurationVersions.Upload(ctx, "cv-123", "test-fixtures/config-version")
+		require.NotNil(t, cv)
+		assert.Equal(t, ConfigurationErrored, cv.Status)
+		assert.EqualError(t, err, "configuration version cv-123 errored: boom")
+	})
+
+	t.Run("when the context is canceled", func(t *testing.T) {
+		client, _ := uploadServer(t, ConfigurationPending, 0)
+
+		ctx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+		defer cancel()
+
+		cv, err := client.ConfigurationVersions.Upload(ctx, "cv-123", "test-fixtures/config-version")
+		assert.Nil(t, cv)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("with invalid configuration version ID", func(t *testing.T) {
+		client, _ := uploadServer(t, ConfigurationUploaded, 0)
+
+		cv, err := client.ConfigurationVersions.Upload(ctx, badIdentifier, "test-fixtures/config-version")
+		assert.Nil(t, cv)
+		assert.EqualError(t, err, "invalid value for configuration version ID")
+	})
+}
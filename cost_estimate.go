@@ -1,6 +1,96 @@
 package scalr
 
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"time"
+)
+
+// Compile-time proof of interface implementation.
+var _ CostEstimates = (*costEstimates)(nil)
+
+// CostEstimates describes all the cost estimate related methods that the
+// Scalr API supports.
+type CostEstimates interface {
+	// Read a cost estimate by its ID.
+	Read(ctx context.Context, costEstimateID string) (*CostEstimate, error)
+	// Logs retrieves the logs of a cost estimate. The caller must close the returned reader.
+	Logs(ctx context.Context, costEstimateID string, options LogReadOptions) (io.ReadCloser, error)
+}
+
+// costEstimates implements CostEstimates.
+type costEstimates struct {
+	client *Client
+}
+
+// CostEstimateStatus represents a cost estimate state.
+type CostEstimateStatus string
+
+// List all available cost estimate statuses.
+const (
+	CostEstimateCanceled    CostEstimateStatus = "canceled"
+	CostEstimateErrored     CostEstimateStatus = "errored"
+	CostEstimateFinished    CostEstimateStatus = "finished"
+	CostEstimatePending     CostEstimateStatus = "pending"
+	CostEstimateQueued      CostEstimateStatus = "queued"
+	CostEstimateRunning     CostEstimateStatus = "running"
+	CostEstimateUnreachable CostEstimateStatus = "unreachable"
+)
+
 // CostEstimate represents a Scalr costEstimate.
 type CostEstimate struct {
-	ID string `jsonapi:"primary,cost-estimates"`
+	ID                      string                `jsonapi:"primary,cost-estimates"`
+	Status                  CostEstimateStatus    `jsonapi:"attr,status"`
+	ErrorMessage            string                `jsonapi:"attr,error-message"`
+	ProposedMonthlyCost     string                `jsonapi:"attr,proposed-monthly-cost"`
+	PriorMonthlyCost        string                `jsonapi:"attr,prior-monthly-cost"`
+	DeltaMonthlyCost        string                `jsonapi:"attr,delta-monthly-cost"`
+	ResourcesCount          int                   `jsonapi:"attr,resources-count"`
+	MatchedResourcesCount   int                   `jsonapi:"attr,matched-resources-count"`
+	UnmatchedResourcesCount int                   `jsonapi:"attr,unmatched-resources-count"`
+	StatusTimestamps        *map[string]time.Time `jsonapi:"attr,status-timestamps"`
+}
+
+// Read a cost estimate by its ID.
+func (s *costEstimates) Read(ctx context.Context, costEstimateID string) (*CostEstimate, error) {
+	if !validStringID(&costEstimateID) {
+		return nil, errors.New("invalid value for cost estimate ID")
+	}
+
+	u := fmt.Sprintf("cost-estimates/%s", url.QueryEscape(costEstimateID))
+	req, err := s.client.newRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	ce := &CostEstimate{}
+	err = s.client.do(ctx, req, ce)
+	if err != nil {
+		return nil, err
+	}
+
+	return ce, nil
+}
+
+// Logs retrieves the logs of a cost estimate.
+func (s *costEstimates) Logs(ctx context.Context, costEstimateID string, options LogReadOptions) (io.ReadCloser, error) {
+	if !validStringID(&costEstimateID) {
+		return nil, errors.New("invalid value for cost estimate ID")
+	}
+
+	// The cost estimate output endpoint has no server side cleaning,
+	// so escape sequences are stripped while reading.
+	u := fmt.Sprintf("cost-estimates/%s/output", url.QueryEscape(costEstimateID))
+	logs, err := s.client.readLogs(ctx, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	if options.Clean {
+		return newANSIStripper(logs), nil
+	}
+
+	return logs, nil
 }
@@ -0,0 +1,130 @@
+package scalr
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// APIError is returned when the API responds with a non-2xx status code.
+// It wraps ErrUnauthorized, ErrResourceNotFound, ErrWorkspaceLocked and
+// ErrWorkspaceNotLocked where applicable, so these can be checked with errors.Is,
+// and converts to a ResourceNotFoundError for 404s with errors.As. These errors
+// are never returned bare, so comparing them with == or a type assertion fails.
+type APIError struct {
+	// HTTP status code of the response.
+	StatusCode int
+	// Method and path of the failed request.
+	Method string
+	Path   string
+	// Value of the X-Request-Id response header, if any.
+	RequestID string
+	// Errors holds every error object of the JSON:API error document.
+	Errors []*APIErrorObject
+
+	// sentinel is the well-known error matching the response, if any.
+	sentinel error
+}
+
+// APIErrorObject represents a single JSON:API error object.
+type APIErrorObject struct {
+	Status string         `json:"status"`
+	Code   string         `json:"code"`
+	Title  string         `json:"title"`
+	Detail string         `json:"detail"`
+	Source APIErrorSource `json:"source"`
+}
+
+// APIErrorSource points to the part of the request document causing the error.
+type APIErrorSource struct {
+	Pointer   string `json:"pointer"`
+	Parameter string `json:"parameter"`
+}
+
+// Error implements the error interface.
+func (e *APIError) Error() string {
+	// The messages are those of the errors returned before APIError existed:
+	// the sentinels other than ErrResourceNotFound ignore the error document.
+	if e.sentinel != nil && (len(e.Errors) == 0 || e.sentinel != ErrResourceNotFound) {
+		return e.sentinel.Error()
+	}
+	if len(e.Errors) == 0 {
+		return fmt.Sprintf("%d %s", e.StatusCode, http.StatusText(e.StatusCode))
+	}
+
+	var errs []string
+	for _, o := range e.Errors {
+		msg := o.Title
+		if o.Source.Pointer != "" {
+			msg += fmt.Sprintf(" (source: %s)", o.Source.Pointer)
+		}
+		if o.Detail != "" {
+			msg += fmt.Sprintf("\n\n%s", o.Detail)
+		}
+		errs = append(errs, msg)
+	}
+
+	if e.StatusCode == http.StatusForbidden {
+		return "The Scalr Terraform provider has been configured with an access token that lacks sufficient permissions." +
+			" If you are running remotely, follow the documentation (https://docs.scalr.io/docs/scalr) on how to " +
+			"enable the Scalr provider configuration in the remote workspace. " +
+			"If running locally, ensure you have enough permissions to perform actions." +
+			"\n Errors: " + strings.Join(errs, "\n")
+	}
+
+	return strings.Join(errs, "\n")
+}
+
+// Unwrap returns the sentinel error matching the response, if any.
+func (e *APIError) Unwrap() error {
+	return e.sentinel
+}
+
+// As allows a 404 APIError to be used as a ResourceNotFoundError.
+func (e *APIError) As(target interface{}) bool {
+	if t, ok := target.(*ResourceNotFoundError); ok && e.StatusCode == http.StatusNotFound {
+		*t = ResourceNotFoundError{Message: e.Error()}
+		return true
+	}
+	return false
+}
+
+// newAPIError decodes the error document of the response.
+func newAPIError(r *http.Response) *APIError {
+	e := &APIError{
+		StatusCode: r.StatusCode,
+		RequestID:  r.Header.Get("X-Request-Id"),
+	}
+	if r.Request != nil {
+		e.Method = r.Request.Method
+		e.Path = r.Request.URL.Path
+	}
+
+	switch r.StatusCode {
+	case http.StatusUnauthorized:
+		e.sentinel = ErrUnauthorized
+	case http.StatusNotFound:
+		e.sentinel = ErrResourceNotFound
+	case http.StatusConflict:
+		switch {
+		case strings.HasSuffix(e.Path, "actions/lock"):
+			e.sentinel = ErrWorkspaceLocked
+		case strings.HasSuffix(e.Path, "actions/unlock"):
+			e.sentinel = ErrWorkspaceNotLocked
+		case strings.HasSuffix(e.Path, "actions/force-unlock"):
+			e.sentinel = ErrWorkspaceNotLocked
+		}
+	}
+
+	var payload struct {
+		Errors []*APIErrorObject `json:"errors"`
+	}
+	if r.Body != nil {
+		// A body which is not a JSON:API error document leaves Errors empty.
+		_ = json.NewDecoder(r.Body).Decode(&payload)
+	}
+	e.Errors = payload.Errors
+
+	return e
+}
@@ -0,0 +1,111 @@
+package scalr
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"reflect"
+)
+
+// pageOptions is implemented by every list options struct embedding ListOptions.
+type pageOptions interface {
+	setPageNumber(n int)
+}
+
+// setPageNumber implements pageOptions.
+func (o *ListOptions) setPageNumber(n int) {
+	o.PageNumber = n
+}
+
+// Iterate returns an iterator over all the items returned by a List method,
+// requesting the following pages as the iteration goes. The options must embed
+// ListOptions, the iteration starts at options.PageNumber if it is set.
+// Breaking out of the loop stops the pagination, no further page is requested.
+//
+// List methods taking additional arguments can be wrapped in a closure:
+//
+//	runs := scalr.Iterate[*scalr.Run](ctx, client.Runs.List, scalr.RunListOptions{
+//		Workspace: scalr.String("ws-123"),
+//	})
+//	for run, err := range runs {
+//		if err != nil {
+//			return err
+//		}
+//		fmt.Println(run.ID)
+//	}
+func Iterate[T any, O any, L any, PO interface {
+	*O
+	pageOptions
+}](ctx context.Context, list func(context.Context, O) (*L, error), options O) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		for {
+			page, err := list(ctx, options)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+
+			items, pagination, err := pageItems[T](page)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			if pagination == nil || pagination.NextPage == 0 || pagination.NextPage <= pagination.CurrentPage {
+				return
+			}
+			PO(&options).setPageNumber(pagination.NextPage)
+		}
+	}
+}
+
+// Collect gathers all the items of an iterator into a slice.
+// It stops at the first error and returns it along with the items collected so far.
+func Collect[T any](seq iter.Seq2[T, error]) ([]T, error) {
+	var result []T
+	for item, err := range seq {
+		if err != nil {
+			return result, err
+		}
+		result = append(result, item)
+	}
+	return result, nil
+}
+
+// pageItems extracts the Items and Pagination fields of a list.
+func pageItems[T any, L any](page *L) ([]T, *Pagination, error) {
+	if page == nil {
+		return nil, nil, nil
+	}
+
+	dst := reflect.ValueOf(page).Elem()
+	if dst.Kind() != reflect.Struct {
+		return nil, nil, fmt.Errorf("%T is not a list", page)
+	}
+
+	items := dst.FieldByName("Items")
+	pagination := dst.FieldByName("Pagination")
+	if !items.IsValid() || items.Kind() != reflect.Slice || !pagination.IsValid() {
+		return nil, nil, fmt.Errorf("%T must have Items and Pagination fields", page)
+	}
+
+	result := make([]T, 0, items.Len())
+	for i := 0; i < items.Len(); i++ {
+		item, ok := items.Index(i).Interface().(T)
+		if !ok {
+			var zero T
+			return nil, nil, fmt.Errorf("list item %s is not of type %T", items.Index(i).Type(), zero)
+		}
+		result = append(result, item)
+	}
+
+	p, _ := pagination.Interface().(*Pagination)
+	return result, p, nil
+}
@@ -0,0 +1,113 @@
+package scalr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// listPages returns a list function serving total tags split in pages of size.
+func listPages(total, size int) (func(context.Context, TagListOptions) (*TagList, error), *[]int) {
+	var requested []int
+	return func(ctx context.Context, options TagListOptions) (*TagList, error) {
+		page := options.PageNumber
+		if page == 0 {
+			page = 1
+		}
+		requested = append(requested, page)
+
+		pages := (total + size - 1) / size
+		tl := &TagList{Pagination: &Pagination{CurrentPage: page, TotalPages: pages, TotalCount: total}}
+		if page < pages {
+			tl.NextPage = page + 1
+		}
+		for i := (page - 1) * size; i < page*size && i < total; i++ {
+			tl.Items = append(tl.Items, &Tag{ID: fmt.Sprintf("tag-%d", i)})
+		}
+		return tl, nil
+	}, &requested
+}
+
+func TestIterate(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("over all pages", func(t *testing.T) {
+		list, requested := listPages(5, 2)
+
+		tags, err := Collect(Iterate[*Tag](ctx, list, TagListOptions{}))
+		require.NoError(t, err)
+		require.Len(t, tags, 5)
+		assert.Equal(t, "tag-0", tags[0].ID)
+		assert.Equal(t, "tag-4", tags[4].ID)
+		assert.Equal(t, []int{1, 2, 3}, *requested)
+	})
+
+	t.Run("from a given page", func(t *testing.T) {
+		list, requested := listPages(5, 2)
+
+		tags, err := Collect(Iterate[*Tag](ctx, list, TagListOptions{ListOptions: ListOptions{PageNumber: 2}}))
+		require.NoError(t, err)
+		assert.Len(t, tags, 3)
+		assert.Equal(t, []int{2, 3}, *requested)
+	})
+
+	t.Run("with early exit", func(t *testing.T) {
+		list, requested := listPages(10, 2)
+
+		var ids []string
+		for tag, err := range Iterate[*Tag](ctx, list, TagListOptions{}) {
+			require.NoError(t, err)
+			ids = append(ids, tag.ID)
+			if len(ids) == 3 {
+				break
+			}
+		}
+		assert.Equal(t, []string{"tag-0", "tag-1", "tag-2"}, ids)
+		assert.Equal(t, []int{1, 2}, *requested)
+	})
+
+	t.Run("when a page fails", func(t *testing.T) {
+		list, _ := listPages(5, 2)
+		failing := func(ctx context.Context, options TagListOptions) (*TagList, error) {
+			if options.PageNumber == 2 {
+				return nil, errors.New("boom")
+			}
+			return list(ctx, options)
+		}
+
+		tags, err := Collect(Iterate[*Tag](ctx, failing, TagListOptions{}))
+		assert.EqualError(t, err, "boom")
+		assert.Len(t, tags, 2)
+	})
+
+	t.Run("with a client List method", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			page := r.URL.Query().Get("page[number]")
+			if page == "" {
+				page = "1"
+			}
+			next := "2"
+			if page == "2" {
+				next = "null"
+			}
+			w.Header().Set("Content-Type", "application/vnd.api+json")
+			fmt.Fprintf(w, `{"data":[{"id":"run-%s","type":"runs"}],"meta":{"pagination":{"current-page":%s,"next-page":%s}}}`, page, page, next)
+		}))
+		defer ts.Close()
+
+		client, err := NewClient(&Config{Address: ts.URL, Token: "dummy-token", HTTPClient: ts.Client()})
+		require.NoError(t, err)
+
+		runs, err := Collect(Iterate[*Run](ctx, client.Runs.List, RunListOptions{}))
+		require.NoError(t, err)
+		require.Len(t, runs, 2)
+		assert.Equal(t, "run-1", runs[0].ID)
+		assert.Equal(t, "run-2", runs[1].ID)
+	})
+}
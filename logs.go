@@ -0,0 +1,100 @@
+package scalr
+
+import (
+	"bufio"
+	"context"
+	"io"
+)
+
+// LogReadOptions represents the options for reading the log of a run stage.
+type LogReadOptions struct {
+	// Strip ANSI escape sequences (colors, cursor movements) from the log.
+	Clean bool `url:"clean,omitempty"`
+}
+
+// readLogs requests the log at the given path and returns its body as a stream.
+func (c *Client) readLogs(ctx context.Context, path string, options interface{}) (io.ReadCloser, error) {
+	req, err := c.newRequest("GET", path, options)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "text/plain")
+
+	return c.doStream(ctx, req)
+}
+
+// ansiStripper removes ANSI escape sequences from the wrapped reader.
+// It keeps track of partially read sequences, so sequences split between
+// two reads are removed as well.
+type ansiStripper struct {
+	r     *bufio.Reader
+	c     io.Closer
+	state int
+}
+
+// States of the ansiStripper escape sequence parser.
+const (
+	ansiText = iota
+	ansiEscape
+	ansiCSI
+	ansiOSC
+)
+
+// newANSIStripper returns an io.ReadCloser stripping ANSI escape sequences from rc.
+func newANSIStripper(rc io.ReadCloser) io.ReadCloser {
+	return &ansiStripper{r: bufio.NewReader(rc), c: rc}
+}
+
+// Read implements io.Reader.
+func (s *ansiStripper) Read(p []byte) (int, error) {
+	n := 0
+	for n < len(p) {
+		// Only block for more input if nothing has been read yet.
+		if n > 0 && s.r.Buffered() == 0 {
+			break
+		}
+
+		b, err := s.r.ReadByte()
+		if err != nil {
+			return n, err
+		}
+
+		switch s.state {
+		case ansiText:
+			if b == 0x1b {
+				s.state = ansiEscape
+				continue
+			}
+			p[n] = b
+			n++
+		case ansiEscape:
+			switch b {
+			case '[':
+				s.state = ansiCSI
+			case ']':
+				s.state = ansiOSC
+			default:
+				// Two byte sequence, e.g. ESC c.
+				s.state = ansiText
+			}
+		case ansiCSI:
+			// Parameter and intermediate bytes are followed by a final byte in the 0x40-0x7e range.
+			if b >= 0x40 && b <= 0x7e {
+				s.state = ansiText
+			}
+		case ansiOSC:
+			// Operating system commands are terminated by BEL or ESC \.
+			if b == 0x07 {
+				s.state = ansiText
+			} else if b == 0x1b {
+				s.state = ansiEscape
+			}
+		}
+	}
+	return n, nil
+}
+
+// Close implements io.Closer.
+func (s *ansiStripper) Close() error {
+	return s.c.Close()
+}
@@ -0,0 +1,101 @@
+package scalr
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const coloredLog = "\x1b[0m\x1b[1mTerraform will perform the following actions:\x1b[0m\n" +
+	"  \x1b[32m+\x1b[0m resource \"null_resource\" \"test\"\n" +
+	"\x1b]0;title\x07Plan: \x1b[1m1\x1b[0m to add\x1bc\n"
+
+const cleanLog = "Terraform will perform the following actions:\n" +
+	"  + resource \"null_resource\" \"test\"\n" +
+	"Plan: 1 to add\n"
+
+func TestANSIStripper(t *testing.T) {
+	t.Run("strips escape sequences", func(t *testing.T) {
+		r := newANSIStripper(io.NopCloser(strings.NewReader(coloredLog)))
+		out, err := io.ReadAll(r)
+		require.NoError(t, err)
+		assert.Equal(t, cleanLog, string(out))
+	})
+
+	t.Run("strips sequences split between reads", func(t *testing.T) {
+		r := newANSIStripper(io.NopCloser(iotest.OneByteReader(strings.NewReader(coloredLog))))
+		out, err := io.ReadAll(r)
+		require.NoError(t, err)
+		assert.Equal(t, cleanLog, string(out))
+	})
+}
+
+func TestLogs(t *testing.T) {
+	ctx := context.Background()
+
+	var query, accept string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.RawQuery
+		accept = r.Header.Get("Accept")
+		if strings.Contains(r.URL.Path, "missing") {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "text/plain")
+		_, _ = io.WriteString(w, coloredLog)
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "dummy-token",
+		HTTPClient: ts.Client(),
+	})
+	require.NoError(t, err)
+
+	t.Run("plan logs with server side cleaning", func(t *testing.T) {
+		logs, err := client.Plans.Logs(ctx, "plan-123", LogReadOptions{Clean: true})
+		require.NoError(t, err)
+		defer logs.Close()
+
+		_, err = io.ReadAll(logs)
+		require.NoError(t, err)
+		assert.Equal(t, "clean=true", query)
+		assert.Equal(t, "text/plain", accept)
+	})
+
+	t.Run("cost estimate logs with client side cleaning", func(t *testing.T) {
+		logs, err := client.CostEstimates.Logs(ctx, "ce-123", LogReadOptions{Clean: true})
+		require.NoError(t, err)
+		defer logs.Close()
+
+		out, err := io.ReadAll(logs)
+		require.NoError(t, err)
+		assert.Empty(t, query)
+		assert.Equal(t, cleanLog, string(out))
+	})
+
+	t.Run("raw logs", func(t *testing.T) {
+		logs, err := client.Applies.Logs(ctx, "apply-123", LogReadOptions{})
+		require.NoError(t, err)
+		defer logs.Close()
+
+		out, err := io.ReadAll(logs)
+		require.NoError(t, err)
+		assert.Empty(t, query)
+		assert.Equal(t, coloredLog, string(out))
+	})
+
+	t.Run("when the logs do not exist", func(t *testing.T) {
+		logs, err := client.PolicyChecks.Logs(ctx, "missing", LogReadOptions{})
+		assert.Nil(t, logs)
+		assert.ErrorIs(t, err, ErrResourceNotFound)
+	})
+}
@@ -0,0 +1,160 @@
+package scalr
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+// APIRequest describes an API call passing through the middleware chain.
+type APIRequest struct {
+	// HTTP method of the call.
+	Method string
+	// Path of the called resource, relative to the base path of the API.
+	Path string
+	// HTTP is the request about to be sent. Middlewares may alter its
+	// headers, e.g. to sign it or to propagate a request ID.
+	HTTP *http.Request
+}
+
+// Handler sends an API request. The response is returned along with the decoded
+// API error, if any, in which case the response body has already been consumed.
+type Handler func(ctx context.Context, req *APIRequest) (*http.Response, error)
+
+// Middleware wraps a Handler to run code around every API call.
+type Middleware func(next Handler) Handler
+
+// send runs the request through the middleware chain and returns the response
+// if the call succeeded.
+func (c *Client) send(ctx context.Context, req *retryablehttp.Request) (*http.Response, error) {
+	// Record the start of the call, so retries can be capped.
+	ctx = withRetryStart(ctx)
+
+	handler := func(ctx context.Context, r *APIRequest) (*http.Response, error) {
+		// Use the request and context as left by the middlewares.
+		req.Request = r.HTTP.WithContext(ctx)
+
+		// Never send mutations in read-only and dry-run modes.
+		if err := c.guardMutation(r, req); err != nil {
+			return nil, err
+		}
+
+		// Execute the request and check the response.
+		resp, err := c.retryClient(ctx).Do(req)
+		if err != nil {
+			// If we got an error, and the context has been canceled,
+			// the context's error is probably more useful.
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			default:
+				return nil, err
+			}
+		}
+
+		// Basic response checking.
+		if err := checkResponseCode(resp); err != nil {
+			resp.Body.Close()
+			return resp, err
+		}
+
+		return resp, nil
+	}
+
+	// The first middleware is the outermost one.
+	for i := len(c.middlewares) - 1; i >= 0; i-- {
+		handler = c.middlewares[i](handler)
+	}
+
+	resp, err := handler(ctx, &APIRequest{
+		Method: req.Method,
+		Path:   strings.TrimPrefix(req.URL.Path, c.baseURL.Path),
+		HTTP:   req.Request,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// LoggingMiddleware logs every API call to the logger. Successful calls are
+// logged at the debug level and failed ones at the warn level.
+func LoggingMiddleware(logger *slog.Logger) Middleware {
+	return func(next Handler) Handler {
+		return func(ctx context.Context, req *APIRequest) (*http.Response, error) {
+			start := time.Now()
+			resp, err := next(ctx, req)
+
+			attrs := []slog.Attr{
+				slog.String("method", req.Method),
+				slog.String("path", req.Path),
+				slog.Duration("duration", time.Since(start)),
+			}
+			if resp != nil {
+				attrs = append(attrs, slog.Int("status", resp.StatusCode))
+				if id := resp.Header.Get("X-Request-Id"); id != "" {
+					attrs = append(attrs, slog.String("request_id", id))
+				}
+			}
+
+			if err != nil {
+				attrs = append(attrs, slog.String("error", err.Error()))
+				logger.LogAttrs(ctx, slog.LevelWarn, "Scalr API request failed", attrs...)
+			} else {
+				logger.LogAttrs(ctx, slog.LevelDebug, "Scalr API request", attrs...)
+			}
+
+			return resp, err
+		}
+	}
+}
+
+// Tracer starts spans for API calls. It can be implemented on top of any
+// tracing library, e.g. OpenTelemetry.
+type Tracer interface {
+	// Start starts a span with the given name. The returned context is used
+	// for the rest of the call, which lets the tracer propagate the span.
+	Start(ctx context.Context, name string) (context.Context, Span)
+}
+
+// Span is a single traced API call.
+type Span interface {
+	// SetAttribute annotates the span.
+	SetAttribute(key string, value interface{})
+	// End ends the span, err is the error the call failed with, if any.
+	End(err error)
+}
+
+// TracingMiddleware wraps every API call into a span of the tracer.
+// Spans are annotated with the method, path, status code and request ID.
+func TracingMiddleware(tracer Tracer) Middleware {
+	return func(next Handler) Handler {
+		return func(ctx context.Context, req *APIRequest) (*http.Response, error) {
+			ctx, span := tracer.Start(ctx, "scalr "+req.Method)
+			span.SetAttribute("http.request.method", req.Method)
+			span.SetAttribute("scalr.path", req.Path)
+
+			resp, err := next(ctx, req)
+			if resp != nil {
+				span.SetAttribute("http.response.status_code", resp.StatusCode)
+				if id := resp.Header.Get("X-Request-Id"); id != "" {
+					span.SetAttribute("scalr.request_id", id)
+				}
+			}
+
+			var apiErr *APIError
+			if errors.As(err, &apiErr) && len(apiErr.Errors) > 0 {
+				span.SetAttribute("scalr.error.title", apiErr.Errors[0].Title)
+			}
+			span.End(err)
+
+			return resp, err
+		}
+	}
+}
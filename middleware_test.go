@@ -0,0 +1,149 @@
+package scalr
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testSpan struct {
+	name  string
+	attrs map[string]interface{}
+	err   error
+	ended bool
+}
+
+func (s *testSpan) SetAttribute(key string, value interface{}) { s.attrs[key] = value }
+func (s *testSpan) End(err error)                              { s.err, s.ended = err, true }
+
+type testTracer struct {
+	spans []*testSpan
+}
+
+func (t *testTracer) Start(ctx context.Context, name string) (context.Context, Span) {
+	span := &testSpan{name: name, attrs: make(map[string]interface{})}
+	t.spans = append(t.spans, span)
+	return ctx, span
+}
+
+func middlewareServer(t *testing.T, middlewares ...Middleware) *Client {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "req-123")
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		if r.Header.Get("X-Signature") != "signed" {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"errors":[{"title":"Forbidden","detail":"unsigned request"}]}`))
+			return
+		}
+		if strings.HasSuffix(r.URL.Path, "missing") {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errors":[{"title":"Not found"}]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":{"id":"tag-123","type":"tags"}}`))
+	}))
+	t.Cleanup(ts.Close)
+
+	client, err := NewClient(&Config{
+		Address:     ts.URL,
+		Token:       "dummy-token",
+		HTTPClient:  ts.Client(),
+		Middlewares: middlewares,
+	})
+	require.NoError(t, err)
+	return client
+}
+
+// signing adds the signature header expected by middlewareServer.
+func signing(next Handler) Handler {
+	return func(ctx context.Context, req *APIRequest) (*http.Response, error) {
+		req.HTTP.Header.Set("X-Signature", "signed")
+		return next(ctx, req)
+	}
+}
+
+func TestMiddlewares(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("run in order around every call", func(t *testing.T) {
+		var calls []string
+		record := func(name string) Middleware {
+			return func(next Handler) Handler {
+				return func(ctx context.Context, req *APIRequest) (*http.Response, error) {
+					calls = append(calls, name+" before "+req.Method+" "+req.Path)
+					resp, err := next(ctx, req)
+					var apiErr *APIError
+					if errors.As(err, &apiErr) {
+						calls = append(calls, name+" after "+apiErr.Errors[0].Title)
+					} else {
+						calls = append(calls, name+" after")
+					}
+					return resp, err
+				}
+			}
+		}
+
+		client := middlewareServer(t, record("first"), signing, record("second"))
+
+		_, err := client.Tags.Read(ctx, "tag-123")
+		require.NoError(t, err)
+		_, err = client.Tags.Read(ctx, "missing")
+		require.Error(t, err)
+
+		assert.Equal(t, []string{
+			"first before GET tags/tag-123",
+			"second before GET tags/tag-123",
+			"second after",
+			"first after",
+			"first before GET tags/missing",
+			"second before GET tags/missing",
+			"second after Not found",
+			"first after Not found",
+		}, calls)
+	})
+
+	t.Run("with the logging middleware", func(t *testing.T) {
+		buf := bytes.NewBuffer(nil)
+		logger := slog.New(slog.NewTextHandler(buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+		client := middlewareServer(t, LoggingMiddleware(logger))
+
+		_, err := client.Tags.Read(ctx, "tag-123")
+		require.Error(t, err)
+
+		out := buf.String()
+		assert.Contains(t, out, "level=WARN")
+		assert.Contains(t, out, "method=GET path=tags/tag-123")
+		assert.Contains(t, out, "status=403 request_id=req-123")
+		assert.Contains(t, out, `\n Errors: Forbidden\n\nunsigned request"`)
+	})
+
+	t.Run("with the tracing middleware", func(t *testing.T) {
+		tracer := &testTracer{}
+		client := middlewareServer(t, TracingMiddleware(tracer), signing)
+
+		_, err := client.Tags.Read(ctx, "tag-123")
+		require.NoError(t, err)
+		_, err = client.Tags.Read(ctx, "missing")
+		require.Error(t, err)
+
+		require.Len(t, tracer.spans, 2)
+		assert.True(t, tracer.spans[0].ended)
+		assert.Equal(t, "scalr GET", tracer.spans[0].name)
+		assert.Equal(t, "tags/tag-123", tracer.spans[0].attrs["scalr.path"])
+		assert.Equal(t, 200, tracer.spans[0].attrs["http.response.status_code"])
+		assert.Equal(t, "req-123", tracer.spans[0].attrs["scalr.request_id"])
+		assert.NoError(t, tracer.spans[0].err)
+
+		assert.Equal(t, 404, tracer.spans[1].attrs["http.response.status_code"])
+		assert.Equal(t, "Not found", tracer.spans[1].attrs["scalr.error.title"])
+		assert.ErrorIs(t, tracer.spans[1].err, ErrResourceNotFound)
+	})
+}
@@ -0,0 +1,151 @@
+package scalr
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+// ErrMutationBlocked is returned for calls which would mutate Scalr when the
+// client is read-only or in dry-run mode.
+var ErrMutationBlocked = errors.New("mutation blocked")
+
+// Mutation is a call which would have mutated Scalr.
+type Mutation struct {
+	// HTTP method of the call: POST, PATCH, PUT or DELETE.
+	Method string
+	// Path of the called resource, relative to the base path of the API.
+	Path string
+	// Body that would have been sent, if any.
+	Body []byte
+}
+
+// MutationBlockedError is returned instead of sending a call which would have
+// mutated Scalr. It matches ErrMutationBlocked with errors.Is.
+type MutationBlockedError struct {
+	// Mutation is what would have been sent.
+	Mutation Mutation
+}
+
+func (e *MutationBlockedError) Error() string {
+	return fmt.Sprintf("%s: %s %s", ErrMutationBlocked, e.Mutation.Method, e.Mutation.Path)
+}
+
+func (e *MutationBlockedError) Is(target error) bool {
+	return target == ErrMutationBlocked
+}
+
+// MutationPlan records the calls simulated by a client in dry-run mode.
+// The zero value is an empty plan ready to use.
+type MutationPlan struct {
+	mu        sync.Mutex
+	mutations []Mutation
+}
+
+// Mutations returns the recorded mutations, in the order they were attempted.
+func (p *MutationPlan) Mutations() []Mutation {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return append([]Mutation(nil), p.mutations...)
+}
+
+// Reset empties the plan.
+func (p *MutationPlan) Reset() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.mutations = nil
+}
+
+func (p *MutationPlan) add(m Mutation) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.mutations = append(p.mutations, m)
+}
+
+// String renders the plan in a Terraform plan fashion: one line per mutation,
+// prefixed with "+" for creations, "~" for changes and "-" for deletions and
+// followed by its body, then a summary of the number of changes.
+func (p *MutationPlan) String() string {
+	mutations := p.Mutations()
+	if len(mutations) == 0 {
+		return "No changes."
+	}
+
+	var b strings.Builder
+	var create, change, destroy int
+	for _, m := range mutations {
+		symbol := "~"
+		switch m.Method {
+		case http.MethodPost:
+			symbol = "+"
+			create++
+		case http.MethodDelete:
+			symbol = "-"
+			destroy++
+		default:
+			change++
+		}
+
+		fmt.Fprintf(&b, "%s %s %s\n", symbol, m.Method, m.Path)
+		if body := planBody(m.Body); body != "" {
+			for _, line := range strings.Split(body, "\n") {
+				fmt.Fprintf(&b, "    %s\n", line)
+			}
+		}
+	}
+	fmt.Fprintf(&b, "\nPlan: %d to create, %d to change, %d to destroy.", create, change, destroy)
+
+	return b.String()
+}
+
+// planBody indents JSON bodies for display. Binary bodies, like uploaded
+// configuration versions, are summarized.
+func planBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, body, "", "  "); err != nil {
+		return fmt.Sprintf("(%d bytes)", len(body))
+	}
+	return buf.String()
+}
+
+// guardMutation returns a MutationBlockedError for calls mutating Scalr when
+// the client is read-only or in dry-run mode, recording them into the plan of
+// the latter.
+func (c *Client) guardMutation(r *APIRequest, req *retryablehttp.Request) error {
+	if !isMutation(r.Method) || (!c.readOnly && c.dryRun == nil) {
+		return nil
+	}
+
+	body, err := req.BodyBytes()
+	if err != nil {
+		return err
+	}
+	m := Mutation{Method: r.Method, Path: r.Path, Body: body}
+
+	if c.dryRun != nil {
+		c.dryRun.add(m)
+	}
+
+	return &MutationBlockedError{Mutation: m}
+}
+
+// isMutation reports whether a request with this method changes Scalr.
+func isMutation(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPatch, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
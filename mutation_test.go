@@ -0,0 +1,96 @@
+package scalr
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mutationServer(t *testing.T, cfg *Config) (*Client, *int32) {
+	var mutations int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			atomic.AddInt32(&mutations, 1)
+		}
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		_, _ = w.Write([]byte(`{"data":{"id":"tag-123","type":"tags","attributes":{"name":"prod"}}}`))
+	}))
+	t.Cleanup(ts.Close)
+
+	cfg.Address = ts.URL
+	cfg.Token = "dummy-token"
+	cfg.HTTPClient = ts.Client()
+	client, err := NewClient(cfg)
+	require.NoError(t, err)
+
+	return client, &mutations
+}
+
+func TestClient_readOnly(t *testing.T) {
+	ctx := context.Background()
+	client, mutations := mutationServer(t, &Config{ReadOnly: true})
+
+	tag, err := client.Tags.Read(ctx, "tag-123")
+	require.NoError(t, err)
+	assert.Equal(t, "prod", tag.Name)
+
+	_, err = client.Tags.Update(ctx, "tag-123", TagUpdateOptions{Name: String("dev")})
+	assert.ErrorIs(t, err, ErrMutationBlocked)
+
+	var blocked *MutationBlockedError
+	require.True(t, errors.As(err, &blocked))
+	assert.Equal(t, http.MethodPatch, blocked.Mutation.Method)
+	assert.Equal(t, "tags/tag-123", blocked.Mutation.Path)
+	assert.Contains(t, string(blocked.Mutation.Body), `"dev"`)
+
+	err = client.Tags.Delete(ctx, "tag-123")
+	assert.ErrorIs(t, err, ErrMutationBlocked)
+
+	assert.Zero(t, atomic.LoadInt32(mutations))
+}
+
+func TestClient_dryRun(t *testing.T) {
+	ctx := context.Background()
+	plan := &MutationPlan{}
+	client, mutations := mutationServer(t, &Config{DryRun: plan})
+
+	assert.Equal(t, "No changes.", plan.String())
+
+	_, err := client.Tags.Read(ctx, "tag-123")
+	require.NoError(t, err)
+
+	_, err = client.Tags.Create(ctx, TagCreateOptions{
+		Name:    String("prod"),
+		Account: &Account{ID: "acc-123"},
+	})
+	assert.ErrorIs(t, err, ErrMutationBlocked)
+	_, err = client.Tags.Update(ctx, "tag-123", TagUpdateOptions{Name: String("dev")})
+	assert.ErrorIs(t, err, ErrMutationBlocked)
+	err = client.Tags.Delete(ctx, "tag-123")
+	assert.ErrorIs(t, err, ErrMutationBlocked)
+
+	assert.Zero(t, atomic.LoadInt32(mutations))
+
+	recorded := plan.Mutations()
+	require.Len(t, recorded, 3)
+	assert.Equal(t, http.MethodPost, recorded[0].Method)
+	assert.Equal(t, "tags", recorded[0].Path)
+	assert.Equal(t, http.MethodDelete, recorded[2].Method)
+	assert.Empty(t, recorded[2].Body)
+
+	out := plan.String()
+	assert.Contains(t, out, "+ POST tags\n")
+	assert.Contains(t, out, "~ PATCH tags/tag-123\n")
+	assert.Contains(t, out, "- DELETE tags/tag-123\n")
+	assert.Contains(t, out, `        "name": "dev"`)
+	assert.Contains(t, out, "Plan: 1 to create, 1 to change, 1 to destroy.")
+
+	plan.Reset()
+	assert.Empty(t, plan.Mutations())
+}
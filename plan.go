@@ -1,6 +1,84 @@
 package scalr
 
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"time"
+)
+
+// Compile-time proof of interface implementation.
+var _ Plans = (*plans)(nil)
+
+// Plans describes all the plan related methods that the Scalr API supports.
+type Plans interface {
+	// Read a plan by its ID.
+	Read(ctx context.Context, planID string) (*Plan, error)
+	// Logs retrieves the logs of a plan. The caller must close the returned reader.
+	Logs(ctx context.Context, planID string, options LogReadOptions) (io.ReadCloser, error)
+}
+
+// plans implements Plans.
+type plans struct {
+	client *Client
+}
+
+// PlanStatus represents a plan state.
+type PlanStatus string
+
+// List all available plan statuses.
+const (
+	PlanCanceled    PlanStatus = "canceled"
+	PlanErrored     PlanStatus = "errored"
+	PlanFinished    PlanStatus = "finished"
+	PlanPending     PlanStatus = "pending"
+	PlanQueued      PlanStatus = "queued"
+	PlanRunning     PlanStatus = "running"
+	PlanUnreachable PlanStatus = "unreachable"
+)
+
 // Plan represents a Scalr plan.
 type Plan struct {
-	ID string `jsonapi:"primary,plans"`
+	ID                   string                `jsonapi:"primary,plans"`
+	Status               PlanStatus            `jsonapi:"attr,status"`
+	HasChanges           bool                  `jsonapi:"attr,has-changes"`
+	ResourceAdditions    int                   `jsonapi:"attr,resource-additions"`
+	ResourceChanges      int                   `jsonapi:"attr,resource-changes"`
+	ResourceDestructions int                   `jsonapi:"attr,resource-destructions"`
+	ResourceImports      int                   `jsonapi:"attr,resource-imports"`
+	ResourceForgets      int                   `jsonapi:"attr,resource-forgets"`
+	StatusTimestamps     *map[string]time.Time `jsonapi:"attr,status-timestamps"`
+}
+
+// Read a plan by its ID.
+func (s *plans) Read(ctx context.Context, planID string) (*Plan, error) {
+	if !validStringID(&planID) {
+		return nil, errors.New("invalid value for plan ID")
+	}
+
+	u := fmt.Sprintf("plans/%s", url.QueryEscape(planID))
+	req, err := s.client.newRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Plan{}
+	err = s.client.do(ctx, req, p)
+	if err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// Logs retrieves the logs of a plan.
+func (s *plans) Logs(ctx context.Context, planID string, options LogReadOptions) (io.ReadCloser, error) {
+	if !validStringID(&planID) {
+		return nil, errors.New("invalid value for plan ID")
+	}
+
+	u := fmt.Sprintf("plans/%s/output", url.QueryEscape(planID))
+	return s.client.readLogs(ctx, u, &options)
 }
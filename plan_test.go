@@ -0,0 +1,64 @@
+package scalr
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlansRead(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	rTest, rTestCleanup := createRun(t, client, nil, nil)
+	defer rTestCleanup()
+
+	t.Run("when the plan exists", func(t *testing.T) {
+		require.NotNil(t, rTest.Plan)
+
+		p, err := client.Plans.Read(ctx, rTest.Plan.ID)
+		require.NoError(t, err)
+		assert.Equal(t, rTest.Plan.ID, p.ID)
+		assert.NotEmpty(t, p.Status)
+	})
+
+	t.Run("when the plan does not exist", func(t *testing.T) {
+		p, err := client.Plans.Read(ctx, "plan-nonexisting")
+		assert.Nil(t, p)
+		assert.Error(t, err)
+	})
+
+	t.Run("with invalid plan ID", func(t *testing.T) {
+		p, err := client.Plans.Read(ctx, badIdentifier)
+		assert.Nil(t, p)
+		assert.EqualError(t, err, "invalid value for plan ID")
+	})
+}
+
+func TestPlansLogs(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	rTest, rTestCleanup := createRun(t, client, nil, nil)
+	defer rTestCleanup()
+
+	t.Run("when the plan exists", func(t *testing.T) {
+		require.NotNil(t, rTest.Plan)
+
+		logs, err := client.Plans.Logs(ctx, rTest.Plan.ID, LogReadOptions{Clean: true})
+		require.NoError(t, err)
+		defer logs.Close()
+
+		_, err = io.ReadAll(logs)
+		assert.NoError(t, err)
+	})
+
+	t.Run("with invalid plan ID", func(t *testing.T) {
+		logs, err := client.Plans.Logs(ctx, badIdentifier, LogReadOptions{})
+		assert.Nil(t, logs)
+		assert.EqualError(t, err, "invalid value for plan ID")
+	})
+}
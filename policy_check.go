@@ -1,6 +1,163 @@
 package scalr
 
-// PolicyCheck represents a Scalr policy check..
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"time"
+)
+
+// Compile-time proof of interface implementation.
+var _ PolicyChecks = (*policyChecks)(nil)
+
+// PolicyChecks describes all the policy check related methods that the
+// Scalr API supports.
+type PolicyChecks interface {
+	// List all policy checks of the given run.
+	List(ctx context.Context, runID string, options PolicyCheckListOptions) (*PolicyCheckList, error)
+	// Read a policy check by its ID.
+	Read(ctx context.Context, policyCheckID string) (*PolicyCheck, error)
+	// Override a soft-mandatory or warning policy.
+	Override(ctx context.Context, policyCheckID string) (*PolicyCheck, error)
+	// Logs retrieves the logs of a policy check. The caller must close the returned reader.
+	Logs(ctx context.Context, policyCheckID string, options LogReadOptions) (io.ReadCloser, error)
+}
+
+// policyChecks implements PolicyChecks.
+type policyChecks struct {
+	client *Client
+}
+
+// PolicyCheckStatus represents a policy check state.
+type PolicyCheckStatus string
+
+// List all available policy check statuses.
+const (
+	PolicyCheckCanceled    PolicyCheckStatus = "canceled"
+	PolicyCheckErrored     PolicyCheckStatus = "errored"
+	PolicyCheckHardFailed  PolicyCheckStatus = "hard_failed"
+	PolicyCheckOverridden  PolicyCheckStatus = "overridden"
+	PolicyCheckPassed      PolicyCheckStatus = "passed"
+	PolicyCheckPending     PolicyCheckStatus = "pending"
+	PolicyCheckQueued      PolicyCheckStatus = "queued"
+	PolicyCheckSoftFailed  PolicyCheckStatus = "soft_failed"
+	PolicyCheckUnreachable PolicyCheckStatus = "unreachable"
+)
+
+// PolicyCheckList represents a list of policy checks.
+type PolicyCheckList struct {
+	*Pagination
+	Items []*PolicyCheck
+}
+
+// PolicyCheck represents a Scalr policy check.
 type PolicyCheck struct {
-	ID string `jsonapi:"primary,policy-checks"`
+	ID               string                `jsonapi:"primary,policy-checks"`
+	Status           PolicyCheckStatus     `jsonapi:"attr,status"`
+	Result           *PolicyCheckResult    `jsonapi:"attr,result"`
+	StatusTimestamps *map[string]time.Time `jsonapi:"attr,status-timestamps"`
+}
+
+// PolicyCheckResult represents the outcome of a policy check.
+type PolicyCheckResult struct {
+	// Whether all policies have passed without failures.
+	Result         bool                       `json:"result"`
+	Passed         int                        `json:"passed"`
+	TotalFailed    int                        `json:"total-failed"`
+	HardFailed     int                        `json:"hard-failed"`
+	SoftFailed     int                        `json:"soft-failed"`
+	AdvisoryFailed int                        `json:"advisory-failed"`
+	DurationMs     int                        `json:"duration-ms"`
+	Policies       []*PolicyCheckPolicyResult `json:"policies"`
+}
+
+// PolicyCheckPolicyResult represents the outcome of a single policy within a
+// policy check.
+type PolicyCheckPolicyResult struct {
+	Name             string                 `json:"name"`
+	EnforcementLevel PolicyEnforcementLevel `json:"enforced-level"`
+	// Whether the policy has passed.
+	Result bool `json:"result"`
+	// Messages reported by the policy, e.g. the reasons it has failed.
+	Messages []string `json:"messages"`
+}
+
+// PolicyCheckListOptions represents the options for listing policy checks.
+type PolicyCheckListOptions struct {
+	ListOptions
+}
+
+// List all policy checks of the given run.
+func (s *policyChecks) List(ctx context.Context, runID string, options PolicyCheckListOptions) (*PolicyCheckList, error) {
+	if !validStringID(&runID) {
+		return nil, errors.New("invalid value for run ID")
+	}
+
+	u := fmt.Sprintf("runs/%s/policy-checks", url.QueryEscape(runID))
+	req, err := s.client.newRequest("GET", u, &options)
+	if err != nil {
+		return nil, err
+	}
+
+	pcl := &PolicyCheckList{}
+	err = s.client.do(ctx, req, pcl)
+	if err != nil {
+		return nil, err
+	}
+
+	return pcl, nil
+}
+
+// Read a policy check by its ID.
+func (s *policyChecks) Read(ctx context.Context, policyCheckID string) (*PolicyCheck, error) {
+	if !validStringID(&policyCheckID) {
+		return nil, errors.New("invalid value for policy check ID")
+	}
+
+	u := fmt.Sprintf("policy-checks/%s", url.QueryEscape(policyCheckID))
+	req, err := s.client.newRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	pc := &PolicyCheck{}
+	err = s.client.do(ctx, req, pc)
+	if err != nil {
+		return nil, err
+	}
+
+	return pc, nil
+}
+
+// Override a soft-mandatory or warning policy.
+func (s *policyChecks) Override(ctx context.Context, policyCheckID string) (*PolicyCheck, error) {
+	if !validStringID(&policyCheckID) {
+		return nil, errors.New("invalid value for policy check ID")
+	}
+
+	u := fmt.Sprintf("policy-checks/%s/actions/override", url.QueryEscape(policyCheckID))
+	req, err := s.client.newRequest("POST", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	pc := &PolicyCheck{}
+	err = s.client.do(ctx, req, pc)
+	if err != nil {
+		return nil, err
+	}
+
+	return pc, nil
+}
+
+// Logs retrieves the logs of a policy check.
+func (s *policyChecks) Logs(ctx context.Context, policyCheckID string, options LogReadOptions) (io.ReadCloser, error) {
+	if !validStringID(&policyCheckID) {
+		return nil, errors.New("invalid value for policy check ID")
+	}
+
+	u := fmt.Sprintf("policy-checks/%s/output", url.QueryEscape(policyCheckID))
+	return s.client.readLogs(ctx, u, &options)
 }
@@ -0,0 +1,44 @@
+package scalr
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolicyChecksReadResult(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		_, _ = w.Write([]byte(`{"data":{"id":"polchk-123","type":"policy-checks","attributes":{
+			"status":"soft_failed",
+			"result":{"result":false,"passed":1,"total-failed":1,"soft-failed":1,"policies":[
+				{"name":"cost","enforced-level":"soft-mandatory","result":false,"messages":["too expensive"]},
+				{"name":"tags","enforced-level":"advisory","result":true}
+			]}}}}`))
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "dummy-token",
+		HTTPClient: ts.Client(),
+	})
+	require.NoError(t, err)
+
+	pc, err := client.PolicyChecks.Read(context.Background(), "polchk-123")
+	require.NoError(t, err)
+	assert.Equal(t, PolicyCheckSoftFailed, pc.Status)
+	require.NotNil(t, pc.Result)
+	assert.Equal(t, 1, pc.Result.SoftFailed)
+	require.Len(t, pc.Result.Policies, 2)
+	assert.Equal(t, &PolicyCheckPolicyResult{
+		Name:             "cost",
+		EnforcementLevel: PolicyEnforcementLevelSoft,
+		Messages:         []string{"too expensive"},
+	}, pc.Result.Policies[0])
+	assert.True(t, pc.Result.Policies[1].Result)
+}
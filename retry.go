@@ -0,0 +1,143 @@
+package scalr
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+const (
+	defaultRetryWaitMin     = 100 * time.Millisecond
+	defaultRetryWaitMax     = 400 * time.Millisecond
+	defaultRetryMaxDuration = 2 * time.Minute
+)
+
+// Backoff returns how long to wait before retrying a request. The attemptNum
+// starts at zero for the first retry. The resp is nil when the request failed
+// without a response.
+type Backoff func(attemptNum int, resp *http.Response) time.Duration
+
+// DefaultBackoff waits for as long as the API asks to through the Retry-After
+// or rate limit reset headers. Otherwise it backs off exponentially, from 100ms
+// up to 400ms.
+func DefaultBackoff(attemptNum int, resp *http.Response) time.Duration {
+	if wait, ok := RetryAfter(resp); ok {
+		return wait
+	}
+	return retryablehttp.DefaultBackoff(defaultRetryWaitMin, defaultRetryWaitMax, attemptNum, nil)
+}
+
+// RetryAfter returns the delay requested by the API before retrying the request.
+// It honours the Retry-After header, in seconds or as an HTTP date, and for rate
+// limited requests the X-RateLimit-Reset and RateLimit-Reset headers, either as
+// a number of seconds or as a Unix timestamp.
+func RetryAfter(resp *http.Response) (time.Duration, bool) {
+	if resp == nil {
+		return 0, false
+	}
+
+	if v := resp.Header.Get("Retry-After"); v != "" {
+		if seconds, err := strconv.ParseInt(strings.TrimSpace(v), 10, 64); err == nil && seconds >= 0 {
+			return time.Duration(seconds) * time.Second, true
+		}
+		if at, err := http.ParseTime(v); err == nil {
+			return nonNegative(time.Until(at)), true
+		}
+	}
+
+	if resp.StatusCode != http.StatusTooManyRequests {
+		return 0, false
+	}
+
+	for _, h := range []string{"X-RateLimit-Reset", "RateLimit-Reset"} {
+		v := strings.TrimSpace(resp.Header.Get(h))
+		if v == "" {
+			continue
+		}
+		reset, err := strconv.ParseFloat(v, 64)
+		if err != nil || reset < 0 {
+			continue
+		}
+		// Values this large can only be timestamps, not a number of seconds.
+		if reset > 1e9 {
+			return nonNegative(time.Until(time.Unix(0, int64(reset*float64(time.Second))))), true
+		}
+		return time.Duration(reset * float64(time.Second)), true
+	}
+
+	return 0, false
+}
+
+func nonNegative(d time.Duration) time.Duration {
+	if d < 0 {
+		return 0
+	}
+	return d
+}
+
+// retryStartKey is the context key holding the time a request was first sent.
+type retryStartKey struct{}
+
+// withRetryStart records the start of a request, so the time spent retrying it can be capped.
+func withRetryStart(ctx context.Context) context.Context {
+	return context.WithValue(ctx, retryStartKey{}, time.Now())
+}
+
+// retryBudget returns how long a request can still be retried for, and false if it is not capped.
+func (c *Client) retryBudget(ctx context.Context) (time.Duration, bool) {
+	start, ok := ctx.Value(retryStartKey{}).(time.Time)
+	if !ok || c.retryMaxDuration <= 0 {
+		return 0, false
+	}
+	return c.retryMaxDuration - time.Since(start), true
+}
+
+// retryClient returns the client sending a call, which waits between retries
+// within the retry budget of ctx, even when a request failed without a response.
+// Retries which would have to wait past the budget are not made, and the last
+// response or error is returned right away.
+func (c *Client) retryClient(ctx context.Context) *retryablehttp.Client {
+	// The wait before the next retry, computed once it is known to fit the budget.
+	var wait time.Duration
+	attemptNum := 0
+
+	return &retryablehttp.Client{
+		CheckRetry: func(ctx context.Context, resp *http.Response, err error) (bool, error) {
+			retry, checkErr := c.http.CheckRetry(ctx, resp, err)
+			if !retry {
+				return false, checkErr
+			}
+			var ok bool
+			if wait, ok = c.retryWait(ctx, attemptNum, resp); !ok {
+				return false, checkErr
+			}
+			return true, checkErr
+		},
+		Backoff: func(_, _ time.Duration, _ int, resp *http.Response) time.Duration {
+			attemptNum++
+			if c.retryLogHook != nil {
+				c.retryLogHook(attemptNum, resp)
+			}
+			return wait
+		},
+		ErrorHandler: c.http.ErrorHandler,
+		HTTPClient:   c.http.HTTPClient,
+		RetryWaitMin: c.http.RetryWaitMin,
+		RetryWaitMax: c.http.RetryWaitMax,
+		RetryMax:     c.http.RetryMax,
+	}
+}
+
+// retryWait applies the configured Backoff and reports false when the wait
+// does not fit in the retry budget of ctx.
+func (c *Client) retryWait(ctx context.Context, attemptNum int, resp *http.Response) (time.Duration, bool) {
+	wait := c.backoff(attemptNum, resp)
+	if budget, ok := c.retryBudget(ctx); ok && wait > budget {
+		return 0, false
+	}
+	return wait, true
+}
@@ -0,0 +1,230 @@
+package scalr
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryAfter(t *testing.T) {
+	cases := map[string]struct {
+		status  int
+		headers map[string]string
+		wait    time.Duration
+		ok      bool
+	}{
+		"retry-after-seconds": {
+			status:  429,
+			headers: map[string]string{"Retry-After": "3"},
+			wait:    3 * time.Second,
+			ok:      true,
+		},
+		"retry-after-date-in-the-past": {
+			status:  503,
+			headers: map[string]string{"Retry-After": "Wed, 21 Oct 2015 07:28:00 GMT"},
+			wait:    0,
+			ok:      true,
+		},
+		"rate-limit-reset-seconds": {
+			status:  429,
+			headers: map[string]string{"X-RateLimit-Reset": "1.5"},
+			wait:    1500 * time.Millisecond,
+			ok:      true,
+		},
+		"ietf-rate-limit-reset": {
+			status:  429,
+			headers: map[string]string{"RateLimit-Reset": "2"},
+			wait:    2 * time.Second,
+			ok:      true,
+		},
+		"rate-limit-reset-without-rate-limiting": {
+			status:  500,
+			headers: map[string]string{"X-RateLimit-Reset": "2"},
+		},
+		"invalid-headers": {
+			status:  429,
+			headers: map[string]string{"Retry-After": "soon", "X-RateLimit-Reset": "later"},
+		},
+		"no-headers": {
+			status: 429,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			resp := &http.Response{StatusCode: tc.status, Header: make(http.Header)}
+			for k, v := range tc.headers {
+				resp.Header.Set(k, v)
+			}
+
+			wait, ok := RetryAfter(resp)
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.wait, wait)
+		})
+	}
+
+	t.Run("rate-limit-reset-timestamp", func(t *testing.T) {
+		resp := &http.Response{StatusCode: 429, Header: make(http.Header)}
+		resp.Header.Set("X-RateLimit-Reset", strconv.FormatInt(time.Now().Add(10*time.Second).Unix(), 10))
+
+		wait, ok := RetryAfter(resp)
+		assert.True(t, ok)
+		assert.InDelta(t, 10*time.Second, wait, float64(time.Second))
+	})
+
+	t.Run("without response", func(t *testing.T) {
+		_, ok := RetryAfter(nil)
+		assert.False(t, ok)
+	})
+}
+
+func TestClient_retryBackoff(t *testing.T) {
+	ctx := context.Background()
+
+	// rateLimitServer rate limits the first limited requests.
+	rateLimitServer := func(t *testing.T, limited int, reset string) (*httptest.Server, *int) {
+		calls := 0
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			if calls <= limited {
+				w.Header().Set("X-RateLimit-Reset", reset)
+				w.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+			w.Header().Set("Content-Type", "application/vnd.api+json")
+			_, _ = w.Write([]byte(`{"data":{"id":"tag-123","type":"tags"}}`))
+		}))
+		t.Cleanup(ts.Close)
+		return ts, &calls
+	}
+
+	t.Run("with the configured backoff", func(t *testing.T) {
+		ts, calls := rateLimitServer(t, 2, "0.01")
+
+		var waits []time.Duration
+		var retries []int
+		client, err := NewClient(&Config{
+			Address:    ts.URL,
+			Token:      "dummy-token",
+			HTTPClient: ts.Client(),
+			Backoff: func(attemptNum int, resp *http.Response) time.Duration {
+				wait, ok := RetryAfter(resp)
+				require.True(t, ok)
+				waits = append(waits, wait)
+				return wait
+			},
+			RetryLogHook: func(attemptNum int, resp *http.Response) {
+				retries = append(retries, attemptNum)
+			},
+		})
+		require.NoError(t, err)
+
+		tag, err := client.Tags.Read(ctx, "tag-123")
+		require.NoError(t, err)
+		assert.Equal(t, "tag-123", tag.ID)
+		assert.Equal(t, 3, *calls)
+		assert.Equal(t, []time.Duration{10 * time.Millisecond, 10 * time.Millisecond}, waits)
+		assert.Equal(t, []int{1, 2}, retries)
+	})
+
+	t.Run("when the retry budget is spent", func(t *testing.T) {
+		ts, calls := rateLimitServer(t, 100, "60")
+
+		client, err := NewClient(&Config{
+			Address:          ts.URL,
+			Token:            "dummy-token",
+			HTTPClient:       ts.Client(),
+			RetryMaxDuration: 50 * time.Millisecond,
+		})
+		require.NoError(t, err)
+
+		start := time.Now()
+		_, err = client.Tags.Read(ctx, "tag-123")
+		assert.Less(t, time.Since(start), 50*time.Millisecond)
+
+		var apiErr *APIError
+		require.True(t, errors.As(err, &apiErr))
+		assert.Equal(t, http.StatusTooManyRequests, apiErr.StatusCode)
+		assert.Equal(t, 1, *calls)
+	})
+
+	t.Run("when the wait exceeds the rest of the retry budget", func(t *testing.T) {
+		ts, calls := rateLimitServer(t, 100, "0.2")
+
+		client, err := NewClient(&Config{
+			Address:          ts.URL,
+			Token:            "dummy-token",
+			HTTPClient:       ts.Client(),
+			RetryMaxDuration: 300 * time.Millisecond,
+		})
+		require.NoError(t, err)
+
+		// The first retry fits in the budget, the second one would not.
+		start := time.Now()
+		_, err = client.Tags.Read(ctx, "tag-123")
+		assert.Less(t, time.Since(start), 300*time.Millisecond)
+
+		var apiErr *APIError
+		require.True(t, errors.As(err, &apiErr))
+		assert.Equal(t, http.StatusTooManyRequests, apiErr.StatusCode)
+		assert.Equal(t, 2, *calls)
+	})
+
+	t.Run("when a request fails without a response", func(t *testing.T) {
+		calls := 0
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			// Drop the connection without responding.
+			conn, _, err := w.(http.Hijacker).Hijack()
+			require.NoError(t, err)
+			conn.Close()
+		}))
+		t.Cleanup(ts.Close)
+
+		client, err := NewClient(&Config{
+			Address:    ts.URL,
+			Token:      "dummy-token",
+			HTTPClient: ts.Client(),
+			Backoff: func(attemptNum int, resp *http.Response) time.Duration {
+				return time.Minute
+			},
+			RetryMaxDuration: 50 * time.Millisecond,
+		})
+		require.NoError(t, err)
+		client.RetryServerErrors(true)
+
+		start := time.Now()
+		_, err = client.Tags.Read(ctx, "tag-123")
+		assert.Error(t, err)
+		assert.Less(t, time.Since(start), 50*time.Millisecond)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("without a retry budget", func(t *testing.T) {
+		ts, calls := rateLimitServer(t, 2, "0")
+
+		client, err := NewClient(&Config{
+			Address:    ts.URL,
+			Token:      "dummy-token",
+			HTTPClient: ts.Client(),
+			Backoff: func(attemptNum int, resp *http.Response) time.Duration {
+				return 30 * time.Millisecond
+			},
+			RetryMaxDuration: -1,
+		})
+		require.NoError(t, err)
+
+		start := time.Now()
+		_, err = client.Tags.Read(ctx, "tag-123")
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, time.Since(start), 60*time.Millisecond)
+		assert.Equal(t, 3, *calls)
+	})
+}
@@ -13,10 +13,20 @@ var _ Runs = (*runs)(nil)
 
 // Runs describes all the run related methods that the Scalr API supports.
 type Runs interface {
+	// List all the runs matching the given options.
+	List(ctx context.Context, options RunListOptions) (*RunList, error)
 	// Read a run by its ID.
 	Read(ctx context.Context, runID string) (*Run, error)
 	// Create a new run with the given options.
 	Create(ctx context.Context, options RunCreateOptions) (*Run, error)
+	// Cancel a run by its ID.
+	Cancel(ctx context.Context, runID string, options RunCancelOptions) error
+	// Discard a run by its ID.
+	Discard(ctx context.Context, runID string, options RunDiscardOptions) error
+	// Confirm a run by its ID, allowing it to proceed to the apply stage.
+	Confirm(ctx context.Context, runID string, options RunConfirmOptions) error
+	// ForceRun cancels all prior pending runs and moves the run to the top of the queue.
+	ForceRun(ctx context.Context, runID string, options RunForceOptions) error
 }
 
 // runs implements Runs.
@@ -42,6 +52,7 @@ const (
 	RunPlanQueued         RunStatus = "plan_queued"
 	RunPlanned            RunStatus = "planned"
 	RunPlannedAndFinished RunStatus = "planned_and_finished"
+	RunPlannedAndSaved    RunStatus = "planned_and_saved"
 	RunPlanning           RunStatus = "planning"
 	RunPolicyChecked      RunStatus = "policy_checked"
 	RunPolicyChecking     RunStatus = "policy_checking"
@@ -61,14 +72,28 @@ const (
 	RunSourceCLI                  RunSource = "cli"
 )
 
+// RunList represents a list of runs.
+type RunList struct {
+	*Pagination
+	Items []*Run
+}
+
 // Run represents a Scalr run.
 type Run struct {
-	ID        string    `jsonapi:"primary,runs"`
-	Source    RunSource `jsonapi:"attr,source"`
-	Message   string    `jsonapi:"attr,message"`
-	IsDestroy bool      `jsonapi:"attr,is-destroy"`
-	CreatedAt time.Time `jsonapi:"attr,created-at,iso8601"`
-	Status    RunStatus `jsonapi:"attr,status"`
+	ID           string         `jsonapi:"primary,runs"`
+	Source       RunSource      `jsonapi:"attr,source"`
+	Message      string         `jsonapi:"attr,message"`
+	IsDestroy    bool           `jsonapi:"attr,is-destroy"`
+	IsDry        bool           `jsonapi:"attr,is-dry"`
+	AutoApply    bool           `jsonapi:"attr,auto-apply"`
+	RefreshOnly  bool           `jsonapi:"attr,refresh-only"`
+	HasChanges   bool           `jsonapi:"attr,has-changes"`
+	TargetAddrs  []string       `jsonapi:"attr,target-addrs"`
+	ReplaceAddrs []string       `jsonapi:"attr,replace-addrs"`
+	Variables    []*RunVariable `jsonapi:"attr,variables"`
+	ErrorMessage string         `jsonapi:"attr,error-message"`
+	CreatedAt    time.Time      `jsonapi:"attr,created-at,iso8601"`
+	Status       RunStatus      `jsonapi:"attr,status"`
 
 	// Relations
 	VcsRevision          *VcsRevision          `jsonapi:"relation,vcs-revision"`
@@ -80,12 +105,45 @@ type Run struct {
 	Workspace            *Workspace            `jsonapi:"relation,workspace"`
 }
 
+// RunVariable represents a run scoped Terraform variable.
+type RunVariable struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// RunListOptions represents the options for listing runs.
+type RunListOptions struct {
+	ListOptions
+
+	Workspace   *string    `url:"filter[workspace],omitempty"`
+	Environment *string    `url:"filter[environment],omitempty"`
+	Status      *RunStatus `url:"filter[status],omitempty"`
+	Source      *RunSource `url:"filter[source],omitempty"`
+	Include     string     `url:"include,omitempty"`
+}
+
 // RunCreateOptions represents the options for creating a new run.
 type RunCreateOptions struct {
 	// For internal use only!
 	ID        string `jsonapi:"primary,runs"`
 	IsDestroy *bool  `jsonapi:"attr,is-destroy,omitempty"`
 
+	// Specifies the message to be associated with this run.
+	Message *string `jsonapi:"attr,message,omitempty"`
+	// Resource addresses to plan and apply exclusively.
+	TargetAddrs []string `jsonapi:"attr,target-addrs,omitempty"`
+	// Resource addresses to force replacement of.
+	ReplaceAddrs []string `jsonapi:"attr,replace-addrs,omitempty"`
+	// Whether to use the refresh-only plan mode.
+	RefreshOnly *bool `jsonapi:"attr,refresh-only,omitempty"`
+	// Whether to apply automatically once the plan succeeds.
+	// Defaults to the workspace's auto-apply setting.
+	AutoApply *bool `jsonapi:"attr,auto-apply,omitempty"`
+	// Whether the run is a dry run that stops after the plan stage.
+	IsDry *bool `jsonapi:"attr,is-dry,omitempty"`
+	// Run scoped Terraform variables.
+	Variables []*RunVariable `jsonapi:"attr,variables,omitempty"`
+
 	// Specifies the configuration version to use for this run.
 	ConfigurationVersion *ConfigurationVersion `jsonapi:"relation,configuration-version,omitempty"`
 	// Specifies the workspace where the run will be executed.
@@ -102,9 +160,63 @@ func (o RunCreateOptions) valid() error {
 	if o.ConfigurationVersion != nil && !validStringID(&o.ConfigurationVersion.ID) {
 		return errors.New("invalid value for configuration-version ID")
 	}
+	for i, v := range o.Variables {
+		if v == nil || !validString(&v.Key) {
+			return fmt.Errorf("%d: invalid value for run variable key", i)
+		}
+	}
 	return nil
 }
 
+// RunCancelOptions represents the options for canceling a run.
+type RunCancelOptions struct {
+	// An optional explanation for why the run was canceled.
+	Comment *string `json:"comment,omitempty"`
+}
+
+// RunDiscardOptions represents the options for discarding a run.
+type RunDiscardOptions struct {
+	// An optional explanation for why the run was discarded.
+	Comment *string `json:"comment,omitempty"`
+}
+
+// RunConfirmOptions represents the options for confirming a run.
+type RunConfirmOptions struct {
+	// An optional comment about the run.
+	Comment *string `json:"comment,omitempty"`
+	// The time at which the apply should be queued.
+	ApplyAt *time.Time `json:"apply-at,omitempty"`
+}
+
+// RunForceOptions represents the options for force running a run.
+type RunForceOptions struct {
+	// An optional comment about the run.
+	Comment *string `json:"comment,omitempty"`
+}
+
+// List all the runs matching the given options.
+func (s *runs) List(ctx context.Context, options RunListOptions) (*RunList, error) {
+	if options.Workspace != nil && !validStringID(options.Workspace) {
+		return nil, errors.New("invalid value for workspace ID")
+	}
+	if options.Environment != nil && !validStringID(options.Environment) {
+		return nil, errors.New("invalid value for environment ID")
+	}
+
+	req, err := s.client.newRequest("GET", "runs", &options)
+	if err != nil {
+		return nil, err
+	}
+
+	rl := &RunList{}
+	err = s.client.do(ctx, req, rl)
+	if err != nil {
+		return nil, err
+	}
+
+	return rl, nil
+}
+
 // Create a new run with the given options.
 func (s *runs) Create(ctx context.Context, options RunCreateOptions) (*Run, error) {
 	if err := options.valid(); err != nil {
@@ -154,3 +266,38 @@ func (s *runs) Read(ctx context.Context, runID string) (*Run, error) {
 
 	return r, nil
 }
+
+// Cancel a run by its ID.
+func (s *runs) Cancel(ctx context.Context, runID string, options RunCancelOptions) error {
+	return s.action(ctx, runID, "cancel", &options)
+}
+
+// Discard a run by its ID.
+func (s *runs) Discard(ctx context.Context, runID string, options RunDiscardOptions) error {
+	return s.action(ctx, runID, "discard", &options)
+}
+
+// Confirm a run by its ID.
+func (s *runs) Confirm(ctx context.Context, runID string, options RunConfirmOptions) error {
+	return s.action(ctx, runID, "apply", &options)
+}
+
+// ForceRun cancels all prior pending runs and moves the run to the top of the queue.
+func (s *runs) ForceRun(ctx context.Context, runID string, options RunForceOptions) error {
+	return s.action(ctx, runID, "force", &options)
+}
+
+// action posts the options to the given run action endpoint.
+func (s *runs) action(ctx context.Context, runID, action string, options interface{}) error {
+	if !validStringID(&runID) {
+		return errors.New("invalid value for run ID")
+	}
+
+	u := fmt.Sprintf("runs/%s/actions/%s", url.QueryEscape(runID), action)
+	req, err := s.client.newJsonRequest("POST", u, options)
+	if err != nil {
+		return err
+	}
+
+	return s.client.do(ctx, req, nil)
+}
@@ -2,7 +2,12 @@ package scalr
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -87,6 +92,18 @@ func TestRunsCreate(t *testing.T) {
 		assert.EqualError(t, err, "invalid value for workspace ID")
 	})
 
+	t.Run("with invalid run variable", func(t *testing.T) {
+		options := RunCreateOptions{
+			ConfigurationVersion: cvTest,
+			Workspace:            wsTest,
+			Variables:            []*RunVariable{{Key: " "}},
+		}
+
+		r, err := client.Runs.Create(ctx, options)
+		assert.Nil(t, r)
+		assert.EqualError(t, err, "0: invalid value for run variable key")
+	})
+
 	t.Run("with valid options", func(t *testing.T) {
 		options := RunCreateOptions{
 			ConfigurationVersion: cvTest,
@@ -97,4 +114,206 @@ func TestRunsCreate(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, cvTest.ID, r.ConfigurationVersion.ID)
 	})
+
+	t.Run("with extended options", func(t *testing.T) {
+		options := RunCreateOptions{
+			ConfigurationVersion: cvTest,
+			Workspace:            wsTest,
+			Message:              String("go-scalr test run"),
+			TargetAddrs:          []string{"null_resource.this"},
+			IsDry:                Bool(true),
+			Variables:            []*RunVariable{{Key: "foo", Value: "bar"}},
+		}
+
+		r, err := client.Runs.Create(ctx, options)
+		require.NoError(t, err)
+		assert.Equal(t, *options.Message, r.Message)
+		assert.Equal(t, options.TargetAddrs, r.TargetAddrs)
+		assert.True(t, r.IsDry)
+	})
+}
+
+func TestRunsList(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	wsTest, wsTestCleanup := createWorkspace(t, client, nil)
+	defer wsTestCleanup()
+
+	runTest1, _ := createRun(t, client, wsTest, nil)
+	runTest2, _ := createRun(t, client, wsTest, nil)
+
+	t.Run("with workspace filter", func(t *testing.T) {
+		rl, err := client.Runs.List(ctx, RunListOptions{Workspace: String(wsTest.ID)})
+		require.NoError(t, err)
+		runIDs := make([]string, 0, len(rl.Items))
+		for _, r := range rl.Items {
+			runIDs = append(runIDs, r.ID)
+		}
+		assert.Contains(t, runIDs, runTest1.ID)
+		assert.Contains(t, runIDs, runTest2.ID)
+		assert.Equal(t, 1, rl.CurrentPage)
+		assert.Equal(t, 2, rl.TotalCount)
+	})
+
+	t.Run("with pagination", func(t *testing.T) {
+		rl, err := client.Runs.List(ctx, RunListOptions{
+			ListOptions: ListOptions{PageNumber: 2, PageSize: 1},
+			Workspace:   String(wsTest.ID),
+		})
+		require.NoError(t, err)
+		assert.Len(t, rl.Items, 1)
+		assert.Equal(t, 2, rl.CurrentPage)
+		assert.Equal(t, 2, rl.TotalCount)
+	})
+
+	t.Run("with invalid workspace ID", func(t *testing.T) {
+		rl, err := client.Runs.List(ctx, RunListOptions{Workspace: String(badIdentifier)})
+		assert.Nil(t, rl)
+		assert.EqualError(t, err, "invalid value for workspace ID")
+	})
+}
+
+func TestRunsCancel(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	runTest, runTestCleanup := createRun(t, client, nil, nil)
+	defer runTestCleanup()
+
+	t.Run("when the run exists", func(t *testing.T) {
+		err := client.Runs.Cancel(ctx, runTest.ID, RunCancelOptions{Comment: String("canceled by test")})
+		require.NoError(t, err)
+	})
+
+	t.Run("with invalid run ID", func(t *testing.T) {
+		err := client.Runs.Cancel(ctx, badIdentifier, RunCancelOptions{})
+		assert.EqualError(t, err, "invalid value for run ID")
+	})
+}
+
+func TestRunsDiscard(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	t.Run("with invalid run ID", func(t *testing.T) {
+		err := client.Runs.Discard(ctx, badIdentifier, RunDiscardOptions{})
+		assert.EqualError(t, err, "invalid value for run ID")
+	})
+}
+
+func TestRunsConfirm(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	t.Run("with invalid run ID", func(t *testing.T) {
+		err := client.Runs.Confirm(ctx, badIdentifier, RunConfirmOptions{})
+		assert.EqualError(t, err, "invalid value for run ID")
+	})
+}
+
+func TestRunsForceRun(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	t.Run("with invalid run ID", func(t *testing.T) {
+		err := client.Runs.ForceRun(ctx, badIdentifier, RunForceOptions{})
+		assert.EqualError(t, err, "invalid value for run ID")
+	})
+}
+
+// runServer serves an empty list of runs and records the requests it receives.
+func runServer(t *testing.T) (*Client, *[]*http.Request, *[]string) {
+	var requests []*http.Request
+	var bodies []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests = append(requests, r)
+		bodies = append(bodies, string(body))
+
+		if r.Method == "GET" {
+			w.Header().Set("Content-Type", "application/vnd.api+json")
+			_, _ = w.Write([]byte(`{"data":[],"meta":{"pagination":{"current-page":1,"total-count":0}}}`))
+			return
+		}
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	t.Cleanup(ts.Close)
+
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "dummy-token",
+		HTTPClient: ts.Client(),
+	})
+	require.NoError(t, err)
+
+	return client, &requests, &bodies
+}
+
+func TestRunsActions(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		action func(client *Client) error
+		path   string
+	}{
+		{
+			name: "discard",
+			action: func(client *Client) error {
+				return client.Runs.Discard(ctx, "run-123", RunDiscardOptions{Comment: String("by test")})
+			},
+			path: "/api/iacp/v3/runs/run-123/actions/discard",
+		},
+		{
+			name: "confirm",
+			action: func(client *Client) error {
+				return client.Runs.Confirm(ctx, "run-123", RunConfirmOptions{Comment: String("by test")})
+			},
+			path: "/api/iacp/v3/runs/run-123/actions/apply",
+		},
+		{
+			name: "force",
+			action: func(client *Client) error {
+				return client.Runs.ForceRun(ctx, "run-123", RunForceOptions{Comment: String("by test")})
+			},
+			path: "/api/iacp/v3/runs/run-123/actions/force",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, requests, bodies := runServer(t)
+
+			require.NoError(t, tt.action(client))
+			require.Len(t, *requests, 1)
+			assert.Equal(t, "POST", (*requests)[0].Method)
+			assert.Equal(t, tt.path, (*requests)[0].URL.Path)
+
+			var payload map[string]interface{}
+			require.NoError(t, json.Unmarshal([]byte((*bodies)[0]), &payload))
+			assert.Equal(t, map[string]interface{}{"comment": "by test"}, payload)
+		})
+	}
+}
+
+func TestRunsList_filters(t *testing.T) {
+	ctx := context.Background()
+	client, requests, _ := runServer(t)
+
+	_, err := client.Runs.List(ctx, RunListOptions{
+		Workspace:   String("ws-123"),
+		Environment: String("env-123"),
+		Status:      RunStatusPtr(RunPlanned),
+		Source:      RunSourcePtr(RunSourceVCS),
+	})
+	require.NoError(t, err)
+	require.Len(t, *requests, 1)
+
+	assert.Equal(t, url.Values{
+		"filter[workspace]":   {"ws-123"},
+		"filter[environment]": {"env-123"},
+		"filter[status]":      {"planned"},
+		"filter[source]":      {"vcs"},
+	}, (*requests)[0].URL.Query())
 }
@@ -0,0 +1,139 @@
+package scalr
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"time"
+)
+
+const (
+	defaultRunWaitMinInterval = 1 * time.Second
+	defaultRunWaitMaxInterval = 15 * time.Second
+)
+
+// RunWaitOutcome describes why WaitForRun stopped polling a run.
+type RunWaitOutcome string
+
+// List all available run wait outcomes.
+const (
+	// RunWaitFinished means the run reached a final status and will not change anymore.
+	RunWaitFinished RunWaitOutcome = "finished"
+	// RunWaitAwaitingConfirmation means the run is paused until it is confirmed,
+	// discarded or, for a policy override, approved.
+	RunWaitAwaitingConfirmation RunWaitOutcome = "awaiting_confirmation"
+)
+
+// RunStatusChangeFunc is invoked by WaitForRun each time the status of the run changes.
+// The previous status is empty for the first observed status.
+type RunStatusChangeFunc func(run *Run, previous RunStatus)
+
+// RunWaitOptions represents the options for waiting on a run.
+type RunWaitOptions struct {
+	// OnStatusChange is invoked each time the run status changes.
+	OnStatusChange RunStatusChangeFunc
+
+	// MinInterval is the initial delay between two polls. Default: 1s.
+	MinInterval time.Duration
+
+	// MaxInterval caps the delay between two polls. Default: 15s.
+	// A value below MinInterval is raised to MinInterval.
+	MaxInterval time.Duration
+}
+
+// RunWaitResult represents the state of a run when WaitForRun returns.
+type RunWaitResult struct {
+	Run     *Run
+	Outcome RunWaitOutcome
+}
+
+// IsFinal reports whether the status is a final run status.
+func (s RunStatus) IsFinal() bool {
+	switch s {
+	case RunApplied, RunPlannedAndFinished, RunErrored, RunCanceled, RunDiscarded:
+		return true
+	}
+	return false
+}
+
+// WaitForRun polls the run until it reaches a final status or is paused
+// waiting for confirmation. The delay between polls grows exponentially
+// with jitter, from options.MinInterval up to options.MaxInterval.
+//
+// A run in the planned status is only considered as awaiting confirmation
+// when auto-apply is disabled for it, as otherwise it proceeds on its own.
+// A saved plan run always awaits confirmation once planned.
+//
+// The provided ctx must be non-nil. If it is canceled or times out, ctx.Err()
+// will be returned.
+func WaitForRun(ctx context.Context, runs Runs, runID string, options RunWaitOptions) (*RunWaitResult, error) {
+	if runs == nil {
+		return nil, errors.New("runs service is required")
+	}
+	if !validStringID(&runID) {
+		return nil, errors.New("invalid value for run ID")
+	}
+
+	minInterval := options.MinInterval
+	if minInterval <= 0 {
+		minInterval = defaultRunWaitMinInterval
+	}
+	maxInterval := options.MaxInterval
+	if maxInterval <= 0 {
+		maxInterval = defaultRunWaitMaxInterval
+	}
+	if maxInterval < minInterval {
+		maxInterval = minInterval
+	}
+
+	var previous RunStatus
+	interval := minInterval
+
+	for {
+		r, err := runs.Read(ctx, runID)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			return nil, err
+		}
+
+		if r.Status != previous {
+			if options.OnStatusChange != nil {
+				options.OnStatusChange(r, previous)
+			}
+			previous = r.Status
+			// Poll faster again while the run is moving.
+			interval = minInterval
+		}
+
+		if r.Status.IsFinal() {
+			return &RunWaitResult{Run: r, Outcome: RunWaitFinished}, nil
+		}
+		if r.Status == RunPolicyOverride || r.Status == RunPlannedAndSaved || (r.Status == RunPlanned && !r.AutoApply) {
+			return &RunWaitResult{Run: r, Outcome: RunWaitAwaitingConfirmation}, nil
+		}
+
+		timer := time.NewTimer(jitter(interval))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+
+		interval *= 2
+		if interval > maxInterval {
+			interval = maxInterval
+		}
+	}
+}
+
+// jitter returns a random duration in the [d/2, d] range.
+func jitter(d time.Duration) time.Duration {
+	half := int64(d / 2)
+	if half <= 0 {
+		return d
+	}
+	return time.Duration(half + rand.Int63n(half+1))
+}
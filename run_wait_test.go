@@ -0,0 +1,113 @@
+package scalr
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// runStatusServer serves the given statuses for run-123, one per request,
+// repeating the last one once the sequence is exhausted.
+func runStatusServer(t *testing.T, autoApply bool, statuses ...RunStatus) (*Client, *int) {
+	calls := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		status := statuses[len(statuses)-1]
+		if calls < len(statuses) {
+			status = statuses[calls]
+		}
+		calls++
+
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		fmt.Fprintf(w, `{"data":{"id":"run-123","type":"runs","attributes":{"status":%q,"auto-apply":%t}}}`, status, autoApply)
+	}))
+	t.Cleanup(ts.Close)
+
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "dummy-token",
+		HTTPClient: ts.Client(),
+	})
+	require.NoError(t, err)
+
+	return client, &calls
+}
+
+func TestWaitForRun(t *testing.T) {
+	ctx := context.Background()
+	options := RunWaitOptions{MinInterval: time.Millisecond, MaxInterval: 2 * time.Millisecond}
+
+	t.Run("until the run is finished", func(t *testing.T) {
+		client, calls := runStatusServer(t, true, RunPending, RunPlanning, RunPlanning, RunPlanned, RunApplying, RunApplied)
+
+		var transitions []string
+		opts := options
+		opts.OnStatusChange = func(r *Run, previous RunStatus) {
+			transitions = append(transitions, fmt.Sprintf("%s->%s", previous, r.Status))
+		}
+
+		res, err := WaitForRun(ctx, client.Runs, "run-123", opts)
+		require.NoError(t, err)
+		assert.Equal(t, RunWaitFinished, res.Outcome)
+		assert.Equal(t, RunApplied, res.Run.Status)
+		assert.Equal(t, 6, *calls)
+		assert.Equal(t, []string{
+			"->pending",
+			"pending->planning",
+			"planning->planned",
+			"planned->applying",
+			"applying->applied",
+		}, transitions)
+	})
+
+	t.Run("until the run awaits confirmation", func(t *testing.T) {
+		client, _ := runStatusServer(t, false, RunPending, RunPlanning, RunPlanned)
+
+		res, err := WaitForRun(ctx, client.Runs, "run-123", options)
+		require.NoError(t, err)
+		assert.Equal(t, RunWaitAwaitingConfirmation, res.Outcome)
+		assert.Equal(t, RunPlanned, res.Run.Status)
+	})
+
+	t.Run("until a policy override is required", func(t *testing.T) {
+		client, _ := runStatusServer(t, true, RunPolicyChecking, RunPolicyOverride)
+
+		res, err := WaitForRun(ctx, client.Runs, "run-123", options)
+		require.NoError(t, err)
+		assert.Equal(t, RunWaitAwaitingConfirmation, res.Outcome)
+		assert.Equal(t, RunPolicyOverride, res.Run.Status)
+	})
+
+	t.Run("until a saved plan awaits confirmation", func(t *testing.T) {
+		client, _ := runStatusServer(t, true, RunPlanning, RunPlannedAndSaved)
+
+		res, err := WaitForRun(ctx, client.Runs, "run-123", options)
+		require.NoError(t, err)
+		assert.Equal(t, RunWaitAwaitingConfirmation, res.Outcome)
+		assert.Equal(t, RunPlannedAndSaved, res.Run.Status)
+	})
+
+	t.Run("when the context is canceled", func(t *testing.T) {
+		client, _ := runStatusServer(t, true, RunPlanning)
+
+		ctx, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+		defer cancel()
+
+		res, err := WaitForRun(ctx, client.Runs, "run-123", options)
+		assert.Nil(t, res)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("with invalid run ID", func(t *testing.T) {
+		client, _ := runStatusServer(t, true, RunApplied)
+
+		res, err := WaitForRun(ctx, client.Runs, badIdentifier, options)
+		assert.Nil(t, res)
+		assert.EqualError(t, err, "invalid value for run ID")
+	})
+}
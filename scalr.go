@@ -7,7 +7,6 @@ import (
 	"errors"
 	"fmt"
 	"io"
-	"log"
 	"net/http"
 	"net/url"
 	"os"
@@ -60,7 +59,9 @@ func (e ResourceNotFoundError) Unwrap() error {
 	return ErrResourceNotFound
 }
 
-// RetryLogHook allows a function to run before each retry.
+// RetryLogHook allows a function to run before each retry. It is invoked once the
+// wait before the retry is known, with the number of the upcoming retry and the
+// response that triggered it, which is nil when the request failed without one.
 type RetryLogHook func(attemptNum int, resp *http.Response)
 
 // Config provides configuration details to the API client.
@@ -82,6 +83,26 @@ type Config struct {
 
 	// RetryLogHook is invoked each time a request is retried.
 	RetryLogHook RetryLogHook
+
+	// Backoff computes the wait before each retry. Defaults to DefaultBackoff.
+	Backoff Backoff
+
+	// RetryMaxDuration caps the total time spent retrying a single request,
+	// waits included. A retry which would wait past the cap is not made, the
+	// last response or error is returned instead. Defaults to 2 minutes, a
+	// negative value disables the cap.
+	RetryMaxDuration time.Duration
+
+	// Middlewares are run around every API call, the first one being the outermost.
+	Middlewares []Middleware
+
+	// ReadOnly rejects every call which would mutate Scalr (POST, PATCH, PUT
+	// and DELETE) with a MutationBlockedError, without sending it.
+	ReadOnly bool
+
+	// DryRun simulates every call which would mutate Scalr: it is recorded into
+	// the plan instead of being sent, and fails with a MutationBlockedError.
+	DryRun *MutationPlan
 }
 
 // DefaultConfig returns a default config structure.
@@ -92,6 +113,9 @@ func DefaultConfig() *Config {
 		Token:      os.Getenv("SCALR_TOKEN"),
 		Headers:    make(http.Header),
 		HTTPClient: cleanhttp.DefaultPooledClient(),
+
+		Backoff:          DefaultBackoff,
+		RetryMaxDuration: defaultRetryMaxDuration,
 	}
 
 	// Set the default address if none is given.
@@ -114,6 +138,11 @@ type Client struct {
 	http              *retryablehttp.Client
 	retryLogHook      RetryLogHook
 	retryServerErrors bool
+	backoff           Backoff
+	retryMaxDuration  time.Duration
+	middlewares       []Middleware
+	readOnly          bool
+	dryRun            *MutationPlan
 
 	AccessPolicies                  AccessPolicies
 	AccessTokens                    AccessTokens
@@ -121,9 +150,11 @@ type Client struct {
 	Accounts                        Accounts
 	AgentPoolTokens                 AgentPoolTokens
 	AgentPools                      AgentPools
+	Applies                         Applies
 	AssumeServiceAccountPolicies    AssumeServiceAccountPolicies
 	ConfigurationVersions           ConfigurationVersions
 	CheckovIntegrations             CheckovIntegrations
+	CostEstimates                   CostEstimates
 	DriftDetections                 DriftDetections
 	EnvironmentHooks                EnvironmentHooks
 	EnvironmentTags                 EnvironmentTags
@@ -135,6 +166,8 @@ type Client struct {
 	ModuleNamespaces                ModuleNamespaces
 	ModuleVersions                  ModuleVersions
 	Modules                         Modules
+	Plans                           Plans
+	PolicyChecks                    PolicyChecks
 	PolicyGroupEnvironments         PolicyGroupEnvironments
 	PolicyGroups                    PolicyGroups
 	ProviderConfigurationLinks      ProviderConfigurationLinks
@@ -150,6 +183,7 @@ type Client struct {
 	ServiceAccountTokens            ServiceAccountTokens
 	ServiceAccounts                 ServiceAccounts
 	SlackIntegrations               SlackIntegrations
+	StateVersions                   StateVersions
 	StorageProfiles                 StorageProfiles
 	Tags                            Tags
 	Teams                           Teams
@@ -187,6 +221,15 @@ func NewClient(cfg *Config) (*Client, error) {
 		if cfg.RetryLogHook != nil {
 			config.RetryLogHook = cfg.RetryLogHook
 		}
+		if cfg.Backoff != nil {
+			config.Backoff = cfg.Backoff
+		}
+		if cfg.RetryMaxDuration != 0 {
+			config.RetryMaxDuration = cfg.RetryMaxDuration
+		}
+		config.Middlewares = append(config.Middlewares, cfg.Middlewares...)
+		config.ReadOnly = cfg.ReadOnly
+		config.DryRun = cfg.DryRun
 	}
 
 	// Parse the address to make sure its a valid URL.
@@ -210,19 +253,24 @@ func NewClient(cfg *Config) (*Client, error) {
 
 	// Create the client.
 	client := &Client{
-		baseURL:      baseURL,
-		token:        config.Token,
-		headers:      config.Headers,
-		retryLogHook: config.RetryLogHook,
-	}
-
+		baseURL:          baseURL,
+		token:            config.Token,
+		headers:          config.Headers,
+		retryLogHook:     config.RetryLogHook,
+		backoff:          config.Backoff,
+		retryMaxDuration: config.RetryMaxDuration,
+		middlewares:      config.Middlewares,
+		readOnly:         config.ReadOnly,
+		dryRun:           config.DryRun,
+	}
+
+	// The waits between retries are computed per call, see retryClient.
 	client.http = &retryablehttp.Client{
-		Backoff:      retryablehttp.DefaultBackoff,
 		CheckRetry:   client.retryHTTPCheck,
 		ErrorHandler: retryablehttp.PassthroughErrorHandler,
 		HTTPClient:   config.HTTPClient,
-		RetryWaitMin: 100 * time.Millisecond,
-		RetryWaitMax: 400 * time.Millisecond,
+		RetryWaitMin: defaultRetryWaitMin,
+		RetryWaitMax: defaultRetryWaitMax,
 		RetryMax:     30,
 	}
 
@@ -233,9 +281,11 @@ func NewClient(cfg *Config) (*Client, error) {
 	client.Accounts = &accounts{client: client}
 	client.AgentPoolTokens = &agentPoolTokens{client: client}
 	client.AgentPools = &agentPools{client: client}
+	client.Applies = &applies{client: client}
 	client.AssumeServiceAccountPolicies = &assumeServiceAccountPolicies{client: client}
 	client.ConfigurationVersions = &configurationVersions{client: client}
 	client.CheckovIntegrations = &checkovIntegrations{client: client}
+	client.CostEstimates = &costEstimates{client: client}
 	client.DriftDetections = &driftDetections{client: client}
 	client.EnvironmentHooks = &environmentHooks{client: client}
 	client.EnvironmentTags = &environmentTag{client: client}
@@ -247,6 +297,8 @@ func NewClient(cfg *Config) (*Client, error) {
 	client.ModuleNamespaces = &moduleNamespaces{client: client}
 	client.ModuleVersions = &moduleVersions{client: client}
 	client.Modules = &modules{client: client}
+	client.Plans = &plans{client: client}
+	client.PolicyChecks = &policyChecks{client: client}
 	client.PolicyGroupEnvironments = &policyGroupEnvironment{client: client}
 	client.PolicyGroups = &policyGroups{client: client}
 	client.ProviderConfigurationLinks = &providerConfigurationLinks{client: client}
@@ -262,6 +314,7 @@ func NewClient(cfg *Config) (*Client, error) {
 	client.ServiceAccountTokens = &serviceAccountTokens{client: client}
 	client.ServiceAccounts = &serviceAccounts{client: client}
 	client.SlackIntegrations = &slackIntegrations{client: client}
+	client.StateVersions = &stateVersions{client: client}
 	client.StorageProfiles = &storageProfiles{client: client}
 	client.Tags = &tags{client: client}
 	client.Teams = &teams{client: client}
@@ -290,14 +343,15 @@ func (c *Client) retryHTTPCheck(ctx context.Context, resp *http.Response, err er
 		return false, ctx.Err()
 	}
 	if err != nil {
+		if budget, ok := c.retryBudget(ctx); ok && budget <= 0 {
+			return false, err
+		}
 		return c.retryServerErrors, err
 	}
 	if resp.StatusCode == 429 || (c.retryServerErrors && resp.StatusCode >= 500) {
-		if resp.StatusCode == 429 {
-			log.Printf(
-				"[DEBUG] API rate limit reached for %s%s, retrying...",
-				resp.Request.URL.Host, resp.Request.URL.Path,
-			)
+		// Give up once the retry budget of the request is spent.
+		if budget, ok := c.retryBudget(ctx); ok && budget <= 0 {
+			return false, nil
 		}
 		return true, nil
 	}
@@ -378,6 +432,29 @@ func (c *Client) newJsonRequest(method, path string, v interface{}) (*retryableh
 	return c.createRequest(method, u.String(), body, reqHeaders)
 }
 
+// newUploadRequest creates a PUT request to an upload URL, whose body is set by the caller.
+// Upload URLs are presigned, so the API token is never sent along, and the Authorization
+// default header is dropped when the URL is not on the API host.
+func (c *Client) newUploadRequest(uploadURL string) (*retryablehttp.Request, error) {
+	u, err := c.baseURL.Parse(uploadURL)
+	if err != nil {
+		return nil, err
+	}
+
+	reqHeaders := make(http.Header)
+	reqHeaders.Set("Content-Type", "application/octet-stream")
+
+	req, err := c.createRequest("PUT", u.String(), nil, reqHeaders)
+	if err != nil {
+		return nil, err
+	}
+	if u.Host != c.baseURL.Host {
+		req.Header.Del("Authorization")
+	}
+
+	return req, nil
+}
+
 func (c *Client) createRequest(method, url string, rawBody interface{}, reqHeaders http.Header) (*retryablehttp.Request, error) {
 
 	req, err := retryablehttp.NewRequest(method, url, rawBody)
@@ -408,27 +485,11 @@ func (c *Client) createRequest(method, url string, rawBody interface{}, reqHeade
 // The provided ctx must be non-nil. If it is canceled or times out, ctx.Err()
 // will be returned.
 func (c *Client) do(ctx context.Context, req *retryablehttp.Request, v interface{}) error {
-	// Add the context to the request.
-	req = req.WithContext(ctx)
-
-	// Execute the request and check the response.
-	resp, err := c.http.Do(req)
+	resp, err := c.send(ctx, req)
 	if err != nil {
-		// If we got an error, and the context has been canceled,
-		// the context's error is probably more useful.
-		select {
-		case <-ctx.Done():
-			return ctx.Err()
-		default:
-			return err
-		}
-	}
-	defer resp.Body.Close()
-
-	// Basic response checking.
-	if err := checkResponseCode(resp); err != nil {
 		return err
 	}
+	defer resp.Body.Close()
 
 	// Return here if decoding the response isn't needed.
 	if v == nil {
@@ -499,6 +560,20 @@ func (c *Client) do(ctx context.Context, req *retryablehttp.Request, v interface
 	return nil
 }
 
+// doStream sends an API request and returns the raw response body without
+// attempting to decode it. The caller is responsible for closing the body.
+//
+// The provided ctx must be non-nil. If it is canceled or times out, ctx.Err()
+// will be returned.
+func (c *Client) doStream(ctx context.Context, req *retryablehttp.Request) (io.ReadCloser, error) {
+	resp, err := c.send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Body, nil
+}
+
 // ListOptions is used to specify pagination options when making API requests.
 // Pagination allows breaking up large result sets into chunks, or "pages".
 type ListOptions struct {
@@ -539,72 +614,5 @@ func checkResponseCode(r *http.Response) error {
 		return nil
 	}
 
-	switch r.StatusCode {
-	case 401:
-		return ErrUnauthorized
-	case 409:
-		switch {
-		case strings.HasSuffix(r.Request.URL.Path, "actions/lock"):
-			return ErrWorkspaceLocked
-		case strings.HasSuffix(r.Request.URL.Path, "actions/unlock"):
-			return ErrWorkspaceNotLocked
-		case strings.HasSuffix(r.Request.URL.Path, "actions/force-unlock"):
-			return ErrWorkspaceNotLocked
-		}
-	}
-
-	// Decode the error payload. Use a local struct to capture source.pointer,
-	// which the jsonapi.ErrorObject type does not include.
-	type errorSource struct {
-		Pointer string `json:"pointer"`
-	}
-	type errorObject struct {
-		Title  string      `json:"title"`
-		Detail string      `json:"detail"`
-		Source errorSource `json:"source"`
-	}
-	type errorsPayload struct {
-		Errors []errorObject `json:"errors"`
-	}
-
-	var errPayload errorsPayload
-	err := json.NewDecoder(r.Body).Decode(&errPayload)
-	if err != nil || len(errPayload.Errors) == 0 {
-		if r.StatusCode == 404 {
-			return ResourceNotFoundError{}
-		} else {
-			return fmt.Errorf(r.Status)
-		}
-	}
-
-	// Parse and format the errors.
-	var errs []string
-	for _, e := range errPayload.Errors {
-		msg := e.Title
-		if e.Source.Pointer != "" {
-			msg += fmt.Sprintf(" (source: %s)", e.Source.Pointer)
-		}
-		if e.Detail != "" {
-			msg += fmt.Sprintf("\n\n%s", e.Detail)
-		}
-		errs = append(errs, msg)
-	}
-
-	if r.StatusCode == 404 {
-		return ResourceNotFoundError{
-			Message: fmt.Sprint(strings.Join(errs, "\n")),
-		}
-	}
-
-	if r.StatusCode == 403 {
-		return fmt.Errorf(
-			"The Scalr Terraform provider has been configured with an access token that lacks sufficient permissions." +
-				" If you are running remotely, follow the documentation (https://docs.scalr.io/docs/scalr) on how to " +
-				"enable the Scalr provider configuration in the remote workspace. " +
-				"If running locally, ensure you have enough permissions to perform actions." +
-				"\n Errors: " + strings.Join(errs, "\n"),
-		)
-	}
-
-	return fmt.Errorf(strings.Join(errs, "\n"))
+	return newAPIError(r)
 }
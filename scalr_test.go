@@ -263,27 +263,101 @@ func TestClient_retryHTTPCheck(t *testing.T) {
 
 func TestClient_errorWithoutMessage(t *testing.T) {
 	cases := map[string]struct {
-		resp *http.Response
-		err  error
+		resp     *http.Response
+		sentinel error
+		message  string
 	}{
 		"404-not-found-error": {
-			resp: &http.Response{StatusCode: 404, Body: ioutil.NopCloser(bytes.NewBufferString("test body"))},
-			err:  ResourceNotFoundError{},
+			resp:     &http.Response{StatusCode: 404, Body: ioutil.NopCloser(bytes.NewBufferString("test body"))},
+			sentinel: ErrResourceNotFound,
+			message:  "resource not found",
 		},
 		"500-server-error": {
-			resp: &http.Response{StatusCode: 500, Body: ioutil.NopCloser(bytes.NewBufferString("test body"))},
-			err:  errors.New(""),
+			resp:    &http.Response{StatusCode: 500, Body: ioutil.NopCloser(bytes.NewBufferString("test body"))},
+			message: "500 Internal Server Error",
 		},
 	}
 
-	for _, tc := range cases {
-		err := checkResponseCode(tc.resp)
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := checkResponseCode(tc.resp)
+
+			var apiErr *APIError
+			assert.True(t, errors.As(err, &apiErr))
+			assert.Equal(t, tc.resp.StatusCode, apiErr.StatusCode)
+			assert.Empty(t, apiErr.Errors)
+			assert.EqualError(t, err, tc.message)
+			if tc.sentinel != nil {
+				assert.True(t, errors.Is(err, tc.sentinel))
+			}
+		})
+	}
+}
 
-		if err != nil {
-			assert.Error(t, err)
-			assert.Equal(t, err, tc.err)
+func TestClient_apiError(t *testing.T) {
+	newResponse := func(status int, method, path, body string) *http.Response {
+		req, _ := http.NewRequest(method, "https://scalr.test/api/iacp/v3/"+path, nil)
+		return &http.Response{
+			StatusCode: status,
+			Header:     http.Header{"X-Request-Id": []string{"req-123"}},
+			Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+			Request:    req,
 		}
 	}
+
+	t.Run("with error objects", func(t *testing.T) {
+		err := checkResponseCode(newResponse(422, "POST", "workspaces", `{"errors":[
+			{"status":"422","code":"invalid","title":"Invalid Attribute","detail":"name is taken","source":{"pointer":"/data/attributes/name"}},
+			{"title":"Invalid Relationship"}
+		]}`))
+
+		var apiErr *APIError
+		assert.True(t, errors.As(err, &apiErr))
+		assert.Equal(t, 422, apiErr.StatusCode)
+		assert.Equal(t, "POST", apiErr.Method)
+		assert.Equal(t, "/api/iacp/v3/workspaces", apiErr.Path)
+		assert.Equal(t, "req-123", apiErr.RequestID)
+		assert.Len(t, apiErr.Errors, 2)
+		assert.Equal(t, "invalid", apiErr.Errors[0].Code)
+		assert.Equal(t, "/data/attributes/name", apiErr.Errors[0].Source.Pointer)
+		assert.EqualError(t, err, "Invalid Attribute (source: /data/attributes/name)\n\nname is taken\nInvalid Relationship")
+	})
+
+	t.Run("when not found", func(t *testing.T) {
+		err := checkResponseCode(newResponse(404, "GET", "workspaces/ws-123", `{"errors":[{"title":"Not found"}]}`))
+
+		assert.True(t, errors.Is(err, ErrResourceNotFound))
+
+		var notFound ResourceNotFoundError
+		assert.True(t, errors.As(err, &notFound))
+		assert.Equal(t, "Not found", notFound.Message)
+	})
+
+	t.Run("when unauthorized", func(t *testing.T) {
+		err := checkResponseCode(newResponse(401, "GET", "workspaces", `{"errors":[{"title":"Invalid token"}]}`))
+		assert.True(t, errors.Is(err, ErrUnauthorized))
+		assert.EqualError(t, err, "unauthorized")
+	})
+
+	t.Run("when the workspace is locked", func(t *testing.T) {
+		err := checkResponseCode(newResponse(409, "POST", "workspaces/ws-123/actions/lock", `{"errors":[{"title":"Conflict"}]}`))
+		assert.True(t, errors.Is(err, ErrWorkspaceLocked))
+		assert.False(t, errors.Is(err, ErrWorkspaceNotLocked))
+		assert.EqualError(t, err, "workspace already locked")
+	})
+
+	t.Run("when forbidden", func(t *testing.T) {
+		err := checkResponseCode(newResponse(403, "DELETE", "workspaces/ws-123", `{"errors":[{"title":"Forbidden","detail":"missing permission"}]}`))
+		assert.EqualError(t, err, "The Scalr Terraform provider has been configured with an access token that lacks sufficient permissions."+
+			" If you are running remotely, follow the documentation (https://docs.scalr.io/docs/scalr) on how to "+
+			"enable the Scalr provider configuration in the remote workspace. "+
+			"If running locally, ensure you have enough permissions to perform actions."+
+			"\n Errors: Forbidden\n\nmissing permission")
+
+		var apiErr *APIError
+		assert.True(t, errors.As(err, &apiErr))
+		assert.Equal(t, "missing permission", apiErr.Errors[0].Detail)
+	})
 }
 
 func setupEnvVars(token, address string) func() {
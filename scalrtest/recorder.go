@@ -0,0 +1,334 @@
+package scalrtest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// RecorderMode selects whether a Recorder talks to the API or replays a cassette.
+type RecorderMode int
+
+const (
+	// ModeReplay serves responses from the cassette, without any network access.
+	ModeReplay RecorderMode = iota
+	// ModeRecord sends requests to the API and records them into the cassette.
+	ModeRecord
+)
+
+// redacted replaces scrubbed values in cassettes.
+const redacted = "REDACTED"
+
+// DefaultSensitiveAttributes are the JSON attributes scrubbed from recorded bodies.
+// The value of variables marked as sensitive is scrubbed as well.
+var DefaultSensitiveAttributes = []string{
+	"client-secret",
+	"password",
+	"private-key",
+	"secret",
+	"secret-key",
+	"ssh-key",
+	"token",
+}
+
+// Interaction is a recorded request and response pair.
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest is the part of a request stored in a cassette.
+type RecordedRequest struct {
+	Method  string      `json:"method"`
+	Path    string      `json:"path"`
+	Query   string      `json:"query,omitempty"`
+	Headers http.Header `json:"headers,omitempty"`
+	Body    string      `json:"body,omitempty"`
+}
+
+// RecordedResponse is the part of a response stored in a cassette.
+type RecordedResponse struct {
+	StatusCode int         `json:"status_code"`
+	Headers    http.Header `json:"headers,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+// cassette is the file format of the recorded interactions.
+type cassette struct {
+	Interactions []*Interaction `json:"interactions"`
+	// Values generated while recording, by name.
+	Values map[string]string `json:"values,omitempty"`
+}
+
+// Recorder is an http.RoundTripper recording API calls into a cassette file,
+// or replaying them from it. It plugs into the v1 client through Config.HTTPClient
+// and into the v2 client through client.WithHTTPClient:
+//
+//	rec, err := scalrtest.NewRecorder("testdata/cassettes/TestWorkspaces.json", scalrtest.ModeReplay)
+//	client, err := scalr.NewClient(&scalr.Config{HTTPClient: rec.Client()})
+//
+// Requests are matched by method, path, query and body. Identical requests are
+// answered in the order they were recorded, which supports polling.
+type Recorder struct {
+	path      string
+	mode      RecorderMode
+	transport http.RoundTripper
+	sensitive map[string]bool
+
+	mu           sync.Mutex
+	interactions []*Interaction
+	used         []bool
+	values       map[string]string
+}
+
+// RecorderOption configures a Recorder.
+type RecorderOption func(*Recorder)
+
+// WithTransport sets the transport used to reach the API in record mode.
+// Defaults to http.DefaultTransport.
+func WithTransport(transport http.RoundTripper) RecorderOption {
+	return func(r *Recorder) {
+		r.transport = transport
+	}
+}
+
+// WithSensitiveAttributes adds JSON attributes to scrub from recorded bodies.
+func WithSensitiveAttributes(names ...string) RecorderOption {
+	return func(r *Recorder) {
+		for _, name := range names {
+			r.sensitive[strings.ToLower(name)] = true
+		}
+	}
+}
+
+// NewRecorder creates a recorder for the cassette at path. In replay mode
+// the cassette must exist.
+func NewRecorder(path string, mode RecorderMode, opts ...RecorderOption) (*Recorder, error) {
+	r := &Recorder{
+		path:      path,
+		mode:      mode,
+		transport: http.DefaultTransport,
+		sensitive: make(map[string]bool),
+		values:    make(map[string]string),
+	}
+	for _, name := range DefaultSensitiveAttributes {
+		r.sensitive[name] = true
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	if mode == ModeReplay {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("scalrtest: failed to read cassette: %v", err)
+		}
+		var c cassette
+		if err := json.Unmarshal(data, &c); err != nil {
+			return nil, fmt.Errorf("scalrtest: invalid cassette %s: %v", path, err)
+		}
+		r.interactions = c.Interactions
+		r.used = make([]bool, len(c.Interactions))
+		if c.Values != nil {
+			r.values = c.Values
+		}
+	}
+
+	return r, nil
+}
+
+// Client returns an HTTP client using the recorder as transport.
+func (r *Recorder) Client() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+// Interactions returns the recorded interactions.
+func (r *Recorder) Interactions() []*Interaction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]*Interaction(nil), r.interactions...)
+}
+
+// Value returns the value of the given name. In record mode, it is generated
+// once and saved into the cassette, in replay mode it is read from it. This
+// keeps requests made with random values, like resource names, replayable.
+func (r *Recorder) Value(name string, generate func() (string, error)) (string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if v, ok := r.values[name]; ok {
+		return v, nil
+	}
+	if r.mode == ModeReplay {
+		return "", fmt.Errorf("scalrtest: no recorded value %q in %s", name, r.path)
+	}
+
+	v, err := generate()
+	if err != nil {
+		return "", err
+	}
+	r.values[name] = v
+	return v, nil
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		if body, err = io.ReadAll(req.Body); err != nil {
+			return nil, err
+		}
+		req.Body.Close()
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	recorded := RecordedRequest{
+		Method:  req.Method,
+		Path:    req.URL.Path,
+		Query:   req.URL.Query().Encode(),
+		Headers: scrubHeaders(req.Header, "Authorization", "Cookie"),
+		Body:    r.scrubBody(body),
+	}
+
+	if r.mode == ModeReplay {
+		return r.replay(req, recorded)
+	}
+
+	resp, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	r.mu.Lock()
+	r.interactions = append(r.interactions, &Interaction{
+		Request: recorded,
+		Response: RecordedResponse{
+			StatusCode: resp.StatusCode,
+			Headers:    scrubHeaders(resp.Header, "Set-Cookie"),
+			Body:       r.scrubBody(respBody),
+		},
+	})
+	r.mu.Unlock()
+
+	return resp, nil
+}
+
+func (r *Recorder) replay(req *http.Request, recorded RecordedRequest) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, interaction := range r.interactions {
+		if r.used[i] || !matchRequest(interaction.Request, recorded) {
+			continue
+		}
+		r.used[i] = true
+
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+			StatusCode:    interaction.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        interaction.Response.Headers.Clone(),
+			Body:          io.NopCloser(strings.NewReader(interaction.Response.Body)),
+			ContentLength: int64(len(interaction.Response.Body)),
+			Request:       req,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("scalrtest: no recorded interaction left for %s %s?%s in %s",
+		recorded.Method, recorded.Path, recorded.Query, r.path)
+}
+
+// Save writes the recorded interactions to the cassette. It is a no-op in replay mode.
+func (r *Recorder) Save() error {
+	if r.mode != ModeRecord {
+		return nil
+	}
+
+	r.mu.Lock()
+	data, err := json.MarshalIndent(cassette{Interactions: r.interactions, Values: r.values}, "", "  ")
+	r.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(r.path), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(r.path, append(data, '\n'), 0644)
+}
+
+func matchRequest(recorded, req RecordedRequest) bool {
+	return recorded.Method == req.Method &&
+		recorded.Path == req.Path &&
+		recorded.Query == req.Query &&
+		recorded.Body == req.Body
+}
+
+func scrubHeaders(headers http.Header, names ...string) http.Header {
+	result := headers.Clone()
+	for _, name := range names {
+		if result.Get(name) != "" {
+			result.Set(name, redacted)
+		}
+	}
+	return result
+}
+
+// scrubBody redacts the sensitive attributes of a JSON body. The JSON is
+// re-encoded so that recorded and replayed bodies compare equal regardless
+// of the key order. Other bodies are returned as is.
+func (r *Recorder) scrubBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err != nil {
+		return string(body)
+	}
+
+	data, err := json.Marshal(r.scrub(v))
+	if err != nil {
+		return string(body)
+	}
+	return string(data)
+}
+
+func (r *Recorder) scrub(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, item := range v {
+			if _, ok := item.(string); ok && r.sensitive[strings.ToLower(k)] {
+				v[k] = redacted
+				continue
+			}
+			v[k] = r.scrub(item)
+		}
+		if sensitive, _ := v["sensitive"].(bool); sensitive {
+			if _, ok := v["value"]; ok {
+				v["value"] = redacted
+			}
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = r.scrub(item)
+		}
+	}
+	return v
+}
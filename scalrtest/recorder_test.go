@@ -0,0 +1,175 @@
+package scalrtest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	scalr "github.com/scalr/go-scalr"
+)
+
+func TestRecorder(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "cassettes", "TestRecorder.json")
+
+	srv := NewServer(t, &Resource{
+		Type:       "environments",
+		ID:         "env-123",
+		Attributes: map[string]interface{}{"name": "production"},
+	})
+
+	// The calls made against the cassette, in record then in replay mode.
+	calls := func(t *testing.T, client *scalr.Client) {
+		env, err := client.Environments.Read(ctx, "env-123")
+		require.NoError(t, err)
+		assert.Equal(t, "production", env.Name)
+
+		v, err := client.Variables.Create(ctx, scalr.VariableCreateOptions{
+			Key:         scalr.String("db_password"),
+			Value:       scalr.String("s3cr3t"),
+			Category:    scalr.Category(scalr.CategoryTerraform),
+			Sensitive:   scalr.Bool(true),
+			Environment: &scalr.Environment{ID: "env-123"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "db_password", v.Key)
+
+		_, err = client.Environments.Read(ctx, "env-404")
+		assert.ErrorIs(t, err, scalr.ErrResourceNotFound)
+	}
+
+	t.Run("record", func(t *testing.T) {
+		rec, err := NewRecorder(path, ModeRecord, WithTransport(srv.Client().Transport))
+		require.NoError(t, err)
+
+		client, err := scalr.NewClient(&scalr.Config{
+			Address:    srv.URL,
+			Token:      srv.Token,
+			HTTPClient: rec.Client(),
+		})
+		require.NoError(t, err)
+
+		calls(t, client)
+		require.Len(t, rec.Interactions(), 3)
+		require.NoError(t, rec.Save())
+
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.NotContains(t, string(data), srv.Token)
+		assert.NotContains(t, string(data), "s3cr3t")
+		assert.Contains(t, string(data), "db_password")
+	})
+
+	t.Run("replay", func(t *testing.T) {
+		rec, err := NewRecorder(path, ModeReplay)
+		require.NoError(t, err)
+
+		// No server is needed anymore, nor a valid token.
+		client, err := scalr.NewClient(&scalr.Config{
+			Address:    "https://scalr.invalid",
+			Token:      "other-token",
+			HTTPClient: rec.Client(),
+		})
+		require.NoError(t, err)
+
+		calls(t, client)
+
+		// Every interaction has been replayed.
+		_, err = client.Environments.Read(ctx, "env-123")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "no recorded interaction left for GET")
+	})
+
+	t.Run("replay matches the body", func(t *testing.T) {
+		rec, err := NewRecorder(path, ModeReplay)
+		require.NoError(t, err)
+
+		client, err := scalr.NewClient(&scalr.Config{
+			Token:      "other-token",
+			HTTPClient: rec.Client(),
+		})
+		require.NoError(t, err)
+
+		_, err = client.Variables.Create(ctx, scalr.VariableCreateOptions{
+			Key:         scalr.String("other"),
+			Category:    scalr.Category(scalr.CategoryTerraform),
+			Environment: &scalr.Environment{ID: "env-123"},
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "no recorded interaction left for POST")
+	})
+
+	t.Run("missing cassette", func(t *testing.T) {
+		_, err := NewRecorder(filepath.Join(t.TempDir(), "missing.json"), ModeReplay)
+		require.Error(t, err)
+	})
+}
+
+func TestRecorder_scrub(t *testing.T) {
+	rec, err := NewRecorder("", ModeRecord, WithSensitiveAttributes("Webhook-Secret"))
+	require.NoError(t, err)
+
+	body := rec.scrubBody([]byte(`{
+		"data": [
+			{"attributes": {"key": "a", "value": "plain", "sensitive": false}},
+			{"attributes": {"key": "b", "value": "hidden", "sensitive": true}},
+			{"attributes": {"token": "tok", "webhook-secret": "whs", "name": "n"}}
+		]
+	}`))
+	assert.Equal(t,
+		`{"data":[`+
+			`{"attributes":{"key":"a","sensitive":false,"value":"plain"}},`+
+			`{"attributes":{"key":"b","sensitive":true,"value":"REDACTED"}},`+
+			`{"attributes":{"name":"n","token":"REDACTED","webhook-secret":"REDACTED"}}]}`,
+		body,
+	)
+
+	assert.Equal(t, "plain text", rec.scrubBody([]byte("plain text")))
+
+	headers := scrubHeaders(http.Header{"Authorization": {"Bearer tok"}, "Accept": {"*/*"}}, "Authorization")
+	assert.Equal(t, "REDACTED", headers.Get("Authorization"))
+	assert.False(t, strings.Contains(headers.Get("Accept"), redacted))
+}
+
+func TestRecorder_Value(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "TestRecorder_Value.json")
+	generated := 0
+	generate := func() (string, error) {
+		generated++
+		return fmt.Sprintf("value-%d", generated), nil
+	}
+
+	rec, err := NewRecorder(path, ModeRecord)
+	require.NoError(t, err)
+
+	v, err := rec.Value("name-1", generate)
+	require.NoError(t, err)
+	assert.Equal(t, "value-1", v)
+
+	// A value is generated once per name.
+	v, err = rec.Value("name-1", generate)
+	require.NoError(t, err)
+	assert.Equal(t, "value-1", v)
+	v, err = rec.Value("name-2", generate)
+	require.NoError(t, err)
+	assert.Equal(t, "value-2", v)
+	require.NoError(t, rec.Save())
+
+	rec, err = NewRecorder(path, ModeReplay)
+	require.NoError(t, err)
+
+	v, err = rec.Value("name-2", generate)
+	require.NoError(t, err)
+	assert.Equal(t, "value-2", v)
+	assert.Equal(t, 2, generated)
+
+	_, err = rec.Value("name-3", generate)
+	assert.EqualError(t, err, fmt.Sprintf("scalrtest: no recorded value %q in %s", "name-3", path))
+}
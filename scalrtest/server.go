@@ -0,0 +1,855 @@
+// Package scalrtest provides an in-process fake of the Scalr API, to test code
+// using the v1 or v2 clients without a live Scalr.
+//
+// The fake speaks JSON:API for the core resources: accounts, environments,
+// workspaces, variables, tags, runs, configuration versions and agent pools.
+// It supports pagination, filters, sorting, includes and error payloads.
+//
+//	srv := scalrtest.NewServer(t, &scalrtest.Resource{
+//		Type:       "environments",
+//		ID:         "env-123",
+//		Attributes: map[string]interface{}{"name": "production"},
+//	})
+//
+//	// v1
+//	client, err := scalr.NewClient(&scalr.Config{
+//		Address:    srv.URL,
+//		Token:      srv.Token,
+//		HTTPClient: srv.Client(),
+//	})
+//
+//	// v2
+//	client := scalr.NewClient(srv.Domain(), srv.Token, client.WithHTTPClient(srv.Client()))
+package scalrtest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+const (
+	// BasePath on which the fake API is served.
+	BasePath = "/api/iacp/v3/"
+
+	// DefaultToken is the API token accepted by the fake.
+	DefaultToken = "scalrtest-token"
+
+	defaultPageSize = 10
+	maxPageSize     = 100
+	uploadPath      = "/uploads/"
+)
+
+// collections lists the supported resource types and the prefix of their IDs.
+var collections = map[string]string{
+	"accounts":               "acc",
+	"agent-pools":            "apool",
+	"configuration-versions": "cv",
+	"environments":           "env",
+	"runs":                   "run",
+	"tags":                   "tag",
+	"vars":                   "var",
+	"workspaces":             "ws",
+}
+
+// Ref identifies a related resource.
+type Ref struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+}
+
+// Resource is a JSON:API resource stored by the fake.
+type Resource struct {
+	Type       string
+	ID         string
+	Attributes map[string]interface{}
+	// Relationships map a relationship name to a Ref for a to-one relationship,
+	// a []Ref for a to-many relationship, or nil.
+	Relationships map[string]interface{}
+}
+
+// Error is a JSON:API error object returned by the fake.
+type Error struct {
+	Status string       `json:"status,omitempty"`
+	Code   string       `json:"code,omitempty"`
+	Title  string       `json:"title"`
+	Detail string       `json:"detail,omitempty"`
+	Source *ErrorSource `json:"source,omitempty"`
+}
+
+// ErrorSource points to the part of the request causing an error.
+type ErrorSource struct {
+	Pointer   string `json:"pointer,omitempty"`
+	Parameter string `json:"parameter,omitempty"`
+}
+
+// injectedError is returned for the next request matching its method and path.
+type injectedError struct {
+	method string
+	path   string
+	status int
+	errs   []*Error
+}
+
+// Server is a fake Scalr API server.
+type Server struct {
+	*httptest.Server
+
+	// Token is the API token clients must authenticate with.
+	Token string
+
+	mu        sync.Mutex
+	resources map[string][]*Resource
+	sequence  int
+	injected  []*injectedError
+}
+
+// NewServer starts a fake Scalr API server seeded with the given fixtures.
+// The server is closed when the test completes.
+func NewServer(t testing.TB, fixtures ...*Resource) *Server {
+	t.Helper()
+
+	s := &Server{
+		Token:     DefaultToken,
+		resources: make(map[string][]*Resource),
+	}
+	s.Server = httptest.NewTLSServer(http.HandlerFunc(s.serveHTTP))
+	t.Cleanup(s.Close)
+
+	if err := s.Seed(fixtures...); err != nil {
+		t.Fatal(err)
+	}
+
+	return s
+}
+
+// Domain returns the host and port of the server, as expected by the v2 client.
+func (s *Server) Domain() string {
+	return strings.TrimPrefix(s.URL, "https://")
+}
+
+// Seed stores the given resources, replacing those with the same type and ID.
+// Resources without an ID get a generated one.
+func (s *Server) Seed(resources ...*Resource) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, r := range resources {
+		if _, ok := collections[r.Type]; !ok {
+			return fmt.Errorf("scalrtest: unsupported resource type %q", r.Type)
+		}
+		s.store(copyResource(r))
+	}
+
+	return nil
+}
+
+// SeedJSON stores the resources of a JSON:API document, whose data is either
+// a single resource or a list of resources. Included resources are stored too.
+func (s *Server) SeedJSON(data []byte) error {
+	var doc struct {
+		Data     json.RawMessage   `json:"data"`
+		Included []json.RawMessage `json:"included"`
+	}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return fmt.Errorf("scalrtest: invalid document: %v", err)
+	}
+
+	raws := doc.Included
+	if strings.HasPrefix(strings.TrimSpace(string(doc.Data)), "[") {
+		var items []json.RawMessage
+		if err := json.Unmarshal(doc.Data, &items); err != nil {
+			return fmt.Errorf("scalrtest: invalid document: %v", err)
+		}
+		raws = append(items, raws...)
+	} else if len(doc.Data) > 0 {
+		raws = append([]json.RawMessage{doc.Data}, raws...)
+	}
+
+	resources := make([]*Resource, 0, len(raws))
+	for _, raw := range raws {
+		r, err := decodeResource(raw)
+		if err != nil {
+			return err
+		}
+		resources = append(resources, r)
+	}
+
+	return s.Seed(resources...)
+}
+
+// Get returns a copy of the stored resource, or nil if it does not exist.
+func (s *Server) Get(typ, id string) *Resource {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if r := s.find(typ, id); r != nil {
+		return copyResource(r)
+	}
+	return nil
+}
+
+// List returns a copy of all the stored resources of the given type.
+func (s *Server) List(typ string) []*Resource {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	result := make([]*Resource, 0, len(s.resources[typ]))
+	for _, r := range s.resources[typ] {
+		result = append(result, copyResource(r))
+	}
+	return result
+}
+
+// Update merges the attributes into the stored resource, e.g. to move a run
+// to another status. It reports whether the resource exists.
+func (s *Server) Update(typ, id string, attributes map[string]interface{}) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r := s.find(typ, id)
+	if r == nil {
+		return false
+	}
+	for k, v := range attributes {
+		r.Attributes[k] = v
+	}
+	return true
+}
+
+// InjectError makes the next request with the given method and path, relative
+// to BasePath, fail with the status code and error objects.
+func (s *Server) InjectError(method, path string, status int, errs ...*Error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(errs) == 0 {
+		errs = []*Error{{Title: http.StatusText(status)}}
+	}
+	s.injected = append(s.injected, &injectedError{
+		method: method,
+		path:   strings.Trim(path, "/"),
+		status: status,
+		errs:   errs,
+	})
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Upload URLs are pre-signed, so they do not require a token.
+	if strings.HasPrefix(r.URL.Path, uploadPath) && r.Method == http.MethodPut {
+		s.upload(w, strings.TrimPrefix(r.URL.Path, uploadPath))
+		return
+	}
+
+	if r.Header.Get("Authorization") != "Bearer "+s.Token {
+		writeErrors(w, http.StatusUnauthorized, &Error{Title: "Unauthorized", Detail: "Invalid or missing API token."})
+		return
+	}
+
+	if !strings.HasPrefix(r.URL.Path, BasePath) {
+		writeErrors(w, http.StatusNotFound, &Error{Title: "Not Found"})
+		return
+	}
+	path := strings.Trim(strings.TrimPrefix(r.URL.Path, BasePath), "/")
+
+	for i, e := range s.injected {
+		if e.method == r.Method && e.path == path {
+			s.injected = append(s.injected[:i], s.injected[i+1:]...)
+			writeErrors(w, e.status, e.errs...)
+			return
+		}
+	}
+
+	segments := strings.Split(path, "/")
+	typ := segments[0]
+	if _, ok := collections[typ]; !ok {
+		writeErrors(w, http.StatusNotFound, &Error{Title: "Not Found", Detail: fmt.Sprintf("Unsupported endpoint %s.", r.URL.Path)})
+		return
+	}
+
+	switch {
+	case len(segments) == 1 && r.Method == http.MethodGet:
+		s.list(w, r, typ)
+	case len(segments) == 1 && r.Method == http.MethodPost:
+		s.create(w, r, typ)
+	case len(segments) == 2 && r.Method == http.MethodGet:
+		s.read(w, r, typ, segments[1])
+	case len(segments) == 2 && r.Method == http.MethodPatch:
+		s.update(w, r, typ, segments[1])
+	case len(segments) == 2 && r.Method == http.MethodDelete:
+		s.delete(w, typ, segments[1])
+	case len(segments) == 4 && typ == "runs" && segments[2] == "actions" && r.Method == http.MethodPost:
+		s.runAction(w, segments[1], segments[3])
+	default:
+		writeErrors(w, http.StatusMethodNotAllowed, &Error{Title: "Method Not Allowed"})
+	}
+}
+
+func (s *Server) list(w http.ResponseWriter, r *http.Request, typ string) {
+	q := r.URL.Query()
+
+	filters := s.filters(typ, q)
+
+	var matched []*Resource
+	for _, res := range s.resources[typ] {
+		if matchFilters(res, filters) {
+			matched = append(matched, res)
+		}
+	}
+
+	if by := q.Get("sort"); by != "" {
+		sortResources(matched, by)
+	}
+
+	number, size, err := pageParams(q)
+	if err != nil {
+		writeErrors(w, http.StatusBadRequest, err)
+		return
+	}
+
+	total := len(matched)
+	pages := (total + size - 1) / size
+	start := (number - 1) * size
+	if start > total {
+		start = total
+	}
+	end := start + size
+	if end > total {
+		end = total
+	}
+	page := matched[start:end]
+
+	pagination := map[string]interface{}{
+		"current-page": number,
+		"page-size":    size,
+		"prev-page":    nil,
+		"next-page":    nil,
+		"total-pages":  pages,
+		"total-count":  total,
+	}
+	if number > 1 {
+		pagination["prev-page"] = number - 1
+	}
+	if number < pages {
+		pagination["next-page"] = number + 1
+	}
+
+	data := make([]interface{}, 0, len(page))
+	for _, res := range page {
+		data = append(data, encodeResource(res))
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"data":     data,
+		"included": s.included(page, q.Get("include")),
+		"meta":     map[string]interface{}{"pagination": pagination},
+	})
+}
+
+func (s *Server) read(w http.ResponseWriter, r *http.Request, typ, id string) {
+	res := s.find(typ, id)
+	if res == nil {
+		writeNotFound(w, typ, id)
+		return
+	}
+	s.writeResource(w, http.StatusOK, res, r.URL.Query().Get("include"))
+}
+
+func (s *Server) create(w http.ResponseWriter, r *http.Request, typ string) {
+	res, err := decodeBody(r, typ)
+	if err != nil {
+		writeErrors(w, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	res.ID = ""
+	if _, ok := res.Attributes["created-at"]; !ok {
+		res.Attributes["created-at"] = time.Now().UTC().Format(time.RFC3339)
+	}
+
+	switch typ {
+	case "runs":
+		res.Attributes["status"] = "pending"
+	case "configuration-versions":
+		res.Attributes["status"] = "pending"
+	}
+
+	s.store(res)
+
+	if typ == "configuration-versions" {
+		res.Attributes["upload-url"] = s.URL + uploadPath + res.ID
+	}
+
+	s.writeResource(w, http.StatusCreated, res, "")
+}
+
+func (s *Server) update(w http.ResponseWriter, r *http.Request, typ, id string) {
+	res := s.find(typ, id)
+	if res == nil {
+		writeNotFound(w, typ, id)
+		return
+	}
+
+	patch, err := decodeBody(r, typ)
+	if err != nil {
+		writeErrors(w, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if patch.ID != "" && patch.ID != id {
+		writeErrors(w, http.StatusConflict, &Error{
+			Title:  "Conflict",
+			Detail: fmt.Sprintf("The ID %q does not match the endpoint.", patch.ID),
+			Source: &ErrorSource{Pointer: "/data/id"},
+		})
+		return
+	}
+
+	for k, v := range patch.Attributes {
+		res.Attributes[k] = v
+	}
+	for k, v := range patch.Relationships {
+		res.Relationships[k] = v
+	}
+
+	s.writeResource(w, http.StatusOK, res, "")
+}
+
+func (s *Server) delete(w http.ResponseWriter, typ, id string) {
+	for i, res := range s.resources[typ] {
+		if res.ID == id {
+			s.resources[typ] = append(s.resources[typ][:i], s.resources[typ][i+1:]...)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+	}
+	writeNotFound(w, typ, id)
+}
+
+// runStatuses maps run actions to the status they lead to. Forcing a run keeps
+// its status, but cancels the other pending runs of its workspace.
+var runStatuses = map[string]string{
+	"apply":   "applying",
+	"cancel":  "canceled",
+	"discard": "discarded",
+	"force":   "",
+}
+
+func (s *Server) runAction(w http.ResponseWriter, id, action string) {
+	res := s.find("runs", id)
+	if res == nil {
+		writeNotFound(w, "runs", id)
+		return
+	}
+
+	status, ok := runStatuses[action]
+	if !ok {
+		writeErrors(w, http.StatusNotFound, &Error{Title: "Not Found", Detail: fmt.Sprintf("Unsupported run action %q.", action)})
+		return
+	}
+	if action == "force" {
+		s.cancelPendingRuns(res)
+	} else {
+		res.Attributes["status"] = status
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// cancelPendingRuns cancels the pending runs of the workspace of run, but run.
+func (s *Server) cancelPendingRuns(run *Resource) {
+	ws := refs(run.Relationships["workspace"])
+	if len(ws) == 0 {
+		return
+	}
+	for _, other := range s.resources["runs"] {
+		if other.ID == run.ID || other.Attributes["status"] != "pending" {
+			continue
+		}
+		if otherWs := refs(other.Relationships["workspace"]); len(otherWs) > 0 && otherWs[0].ID == ws[0].ID {
+			other.Attributes["status"] = "canceled"
+		}
+	}
+}
+
+func (s *Server) upload(w http.ResponseWriter, id string) {
+	res := s.find("configuration-versions", id)
+	if res == nil {
+		writeNotFound(w, "configuration-versions", id)
+		return
+	}
+	res.Attributes["status"] = "uploaded"
+	w.WriteHeader(http.StatusOK)
+}
+
+// store saves the resource, generating an ID if needed. The lock must be held.
+func (s *Server) store(res *Resource) {
+	if res.ID == "" {
+		s.sequence++
+		res.ID = fmt.Sprintf("%s-%d", collections[res.Type], s.sequence)
+	}
+	for i, existing := range s.resources[res.Type] {
+		if existing.ID == res.ID {
+			s.resources[res.Type][i] = res
+			return
+		}
+	}
+	s.resources[res.Type] = append(s.resources[res.Type], res)
+}
+
+// find returns the stored resource. The lock must be held.
+func (s *Server) find(typ, id string) *Resource {
+	for _, res := range s.resources[typ] {
+		if res.ID == id {
+			return res
+		}
+	}
+	return nil
+}
+
+func (s *Server) writeResource(w http.ResponseWriter, status int, res *Resource, include string) {
+	writeJSON(w, status, map[string]interface{}{
+		"data":     encodeResource(res),
+		"included": s.included([]*Resource{res}, include),
+	})
+}
+
+// included resolves the comma separated include paths, which can be nested
+// with dots, e.g. "workspace.environment". The lock must be held.
+func (s *Server) included(primary []*Resource, include string) []interface{} {
+	result := []interface{}{}
+	if include == "" {
+		return result
+	}
+
+	seen := make(map[Ref]bool)
+	for _, res := range primary {
+		seen[Ref{Type: res.Type, ID: res.ID}] = true
+	}
+
+	for _, path := range strings.Split(include, ",") {
+		current := primary
+		for _, name := range strings.Split(strings.TrimSpace(path), ".") {
+			var next []*Resource
+			for _, res := range current {
+				for _, ref := range refs(res.Relationships[name]) {
+					related := s.find(ref.Type, ref.ID)
+					if related == nil {
+						continue
+					}
+					next = append(next, related)
+					if !seen[ref] {
+						seen[ref] = true
+						result = append(result, encodeResource(related))
+					}
+				}
+			}
+			current = next
+		}
+	}
+
+	return result
+}
+
+// filters returns the accepted values of the filter[...] parameters, by name.
+// Values are comma separated, optionally prefixed with the "in:" operator.
+// Filters on a name that no resource of the type has are ignored, like Scalr
+// ignores unknown filters.
+func (s *Server) filters(typ string, q url.Values) map[string][]string {
+	filters := make(map[string][]string)
+	for key, values := range q {
+		if !strings.HasPrefix(key, "filter[") || !strings.HasSuffix(key, "]") {
+			continue
+		}
+		name := key[len("filter[") : len(key)-1]
+		if !s.filterable(typ, name) {
+			continue
+		}
+
+		var accepted []string
+		for _, v := range values {
+			accepted = append(accepted, strings.Split(strings.TrimPrefix(v, "in:"), ",")...)
+		}
+		filters[name] = accepted
+	}
+	return filters
+}
+
+// filterable reports whether resources of the type can be filtered by name.
+func (s *Server) filterable(typ, name string) bool {
+	if name == "id" || name == singular(typ) {
+		return true
+	}
+	for _, res := range s.resources[typ] {
+		if _, ok := res.Relationships[name]; ok {
+			return true
+		}
+		if _, ok := res.Attributes[name]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// matchFilters reports whether the resource matches all filters.
+// A filter on the singular resource type or on "id" matches the ID, other filters
+// match a relationship or an attribute. A filter with several values matches any of them.
+func matchFilters(res *Resource, filters map[string][]string) bool {
+	for name, accepted := range filters {
+		var actual []string
+		switch {
+		case name == "id" || name == singular(res.Type):
+			actual = []string{res.ID}
+		case res.Relationships[name] != nil:
+			for _, ref := range refs(res.Relationships[name]) {
+				actual = append(actual, ref.ID)
+			}
+		case res.Attributes[name] != nil:
+			actual = []string{fmt.Sprint(res.Attributes[name])}
+		}
+
+		if !intersects(accepted, actual) {
+			return false
+		}
+	}
+	return true
+}
+
+func intersects(a, b []string) bool {
+	for _, x := range a {
+		for _, y := range b {
+			if x == y {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// sortResources sorts by the attribute, in descending order if prefixed with "-".
+func sortResources(resources []*Resource, by string) {
+	desc := strings.HasPrefix(by, "-")
+	by = strings.TrimPrefix(by, "-")
+
+	sort.SliceStable(resources, func(i, j int) bool {
+		a, b := sortKey(resources[i], by), sortKey(resources[j], by)
+		if desc {
+			return a > b
+		}
+		return a < b
+	})
+}
+
+func sortKey(res *Resource, attr string) string {
+	if attr == "id" {
+		return res.ID
+	}
+	if v, ok := res.Attributes[attr]; ok && v != nil {
+		return fmt.Sprint(v)
+	}
+	return ""
+}
+
+func pageParams(q url.Values) (number, size int, e *Error) {
+	number, size = 1, defaultPageSize
+
+	if v := q.Get("page[number]"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 {
+			return 0, 0, &Error{Title: "Invalid page number", Source: &ErrorSource{Parameter: "page[number]"}}
+		}
+		number = n
+	}
+	if v := q.Get("page[size]"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 || n > maxPageSize {
+			return 0, 0, &Error{
+				Title:  "Invalid page size",
+				Detail: fmt.Sprintf("Page size must be between 1 and %d.", maxPageSize),
+				Source: &ErrorSource{Parameter: "page[size]"},
+			}
+		}
+		size = n
+	}
+
+	return number, size, nil
+}
+
+// singular returns the name of a single resource of the type, e.g. workspace.
+func singular(typ string) string {
+	return strings.TrimSuffix(typ, "s")
+}
+
+// refs returns the references of a relationship value.
+func refs(rel interface{}) []Ref {
+	switch v := rel.(type) {
+	case Ref:
+		return []Ref{v}
+	case *Ref:
+		if v != nil {
+			return []Ref{*v}
+		}
+	case []Ref:
+		return v
+	}
+	return nil
+}
+
+func encodeResource(res *Resource) map[string]interface{} {
+	relationships := make(map[string]interface{}, len(res.Relationships))
+	for name, rel := range res.Relationships {
+		var data interface{}
+		switch v := rel.(type) {
+		case []Ref:
+			data = v
+		case Ref, *Ref:
+			if r := refs(v); len(r) == 1 {
+				data = r[0]
+			}
+		}
+		relationships[name] = map[string]interface{}{"data": data}
+	}
+
+	return map[string]interface{}{
+		"type":          res.Type,
+		"id":            res.ID,
+		"attributes":    res.Attributes,
+		"relationships": relationships,
+	}
+}
+
+// decodeBody decodes the resource of a create or update request.
+func decodeBody(r *http.Request, typ string) (*Resource, *Error) {
+	var doc struct {
+		Data json.RawMessage `json:"data"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&doc); err != nil || len(doc.Data) == 0 {
+		return nil, &Error{Title: "Invalid document", Detail: "The request body must be a JSON:API document.", Source: &ErrorSource{Pointer: "/data"}}
+	}
+
+	res, err := decodeResource(doc.Data)
+	if err != nil {
+		return nil, &Error{Title: "Invalid document", Detail: err.Error(), Source: &ErrorSource{Pointer: "/data"}}
+	}
+	if res.Type != typ {
+		return nil, &Error{
+			Title:  "Invalid type",
+			Detail: fmt.Sprintf("Expected resource type %q, got %q.", typ, res.Type),
+			Source: &ErrorSource{Pointer: "/data/type"},
+		}
+	}
+
+	return res, nil
+}
+
+func decodeResource(raw json.RawMessage) (*Resource, error) {
+	var data struct {
+		Type          string                                `json:"type"`
+		ID            string                                `json:"id"`
+		Attributes    map[string]interface{}                `json:"attributes"`
+		Relationships map[string]map[string]json.RawMessage `json:"relationships"`
+	}
+	if err := json.Unmarshal(raw, &data); err != nil {
+		return nil, fmt.Errorf("scalrtest: invalid resource: %v", err)
+	}
+
+	res := &Resource{
+		Type:          data.Type,
+		ID:            data.ID,
+		Attributes:    data.Attributes,
+		Relationships: make(map[string]interface{}, len(data.Relationships)),
+	}
+	if res.Attributes == nil {
+		res.Attributes = make(map[string]interface{})
+	}
+
+	for name, rel := range data.Relationships {
+		d := strings.TrimSpace(string(rel["data"]))
+		switch {
+		case d == "" || d == "null":
+			res.Relationships[name] = nil
+		case strings.HasPrefix(d, "["):
+			var many []Ref
+			if err := json.Unmarshal(rel["data"], &many); err != nil {
+				return nil, fmt.Errorf("scalrtest: invalid relationship %s: %v", name, err)
+			}
+			res.Relationships[name] = many
+		default:
+			var one Ref
+			if err := json.Unmarshal(rel["data"], &one); err != nil {
+				return nil, fmt.Errorf("scalrtest: invalid relationship %s: %v", name, err)
+			}
+			res.Relationships[name] = one
+		}
+	}
+
+	return res, nil
+}
+
+func copyResource(r *Resource) *Resource {
+	c := &Resource{
+		Type:          r.Type,
+		ID:            r.ID,
+		Attributes:    make(map[string]interface{}, len(r.Attributes)),
+		Relationships: make(map[string]interface{}, len(r.Relationships)),
+	}
+	for k, v := range r.Attributes {
+		c.Attributes[k] = v
+	}
+	for k, v := range r.Relationships {
+		if many, ok := v.([]Ref); ok {
+			v = append([]Ref(nil), many...)
+		}
+		c.Relationships[k] = v
+	}
+	return c
+}
+
+func writeNotFound(w http.ResponseWriter, typ, id string) {
+	writeErrors(w, http.StatusNotFound, &Error{
+		Status: "404",
+		Title:  fmt.Sprintf("%s with ID '%s' not found or user unauthorized.", modelName(typ), id),
+	})
+}
+
+// modelName returns the name Scalr uses for a type in error messages, e.g. ConfigurationVersion.
+func modelName(typ string) string {
+	if typ == "vars" {
+		return "Variable"
+	}
+	var b strings.Builder
+	for _, part := range strings.Split(singular(typ), "-") {
+		if part != "" {
+			b.WriteString(strings.ToUpper(part[:1]) + part[1:])
+		}
+	}
+	return b.String()
+}
+
+func writeErrors(w http.ResponseWriter, status int, errs ...*Error) {
+	// Copy the errors, they may be shared with the caller of InjectError.
+	payload := make([]Error, len(errs))
+	for i, e := range errs {
+		payload[i] = *e
+		if payload[i].Status == "" {
+			payload[i].Status = strconv.Itoa(status)
+		}
+	}
+	writeJSON(w, status, map[string]interface{}{"errors": payload})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/vnd.api+json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,237 @@
+package scalrtest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	scalr "github.com/scalr/go-scalr"
+)
+
+func newClient(t *testing.T, srv *Server) *scalr.Client {
+	client, err := scalr.NewClient(&scalr.Config{
+		Address:    srv.URL,
+		Token:      srv.Token,
+		HTTPClient: srv.Client(),
+	})
+	require.NoError(t, err)
+	return client
+}
+
+func TestServer(t *testing.T) {
+	ctx := context.Background()
+
+	srv := NewServer(t,
+		&Resource{Type: "accounts", ID: "acc-123", Attributes: map[string]interface{}{"name": "main"}},
+		&Resource{
+			Type:          "environments",
+			ID:            "env-123",
+			Attributes:    map[string]interface{}{"name": "production"},
+			Relationships: map[string]interface{}{"account": Ref{Type: "accounts", ID: "acc-123"}},
+		},
+	)
+	client := newClient(t, srv)
+
+	for i := 0; i < 15; i++ {
+		require.NoError(t, srv.Seed(&Resource{
+			Type:       "workspaces",
+			Attributes: map[string]interface{}{"name": fmt.Sprintf("ws-%02d", i), "auto-apply": i%2 == 0},
+			Relationships: map[string]interface{}{
+				"environment": Ref{Type: "environments", ID: "env-123"},
+			},
+		}))
+	}
+
+	t.Run("read with include", func(t *testing.T) {
+		env, err := client.Environments.Read(ctx, "env-123")
+		require.NoError(t, err)
+		assert.Equal(t, "production", env.Name)
+		require.NotNil(t, env.Account)
+		assert.Equal(t, "acc-123", env.Account.ID)
+	})
+
+	t.Run("list with pagination", func(t *testing.T) {
+		wl, err := client.Workspaces.List(ctx, scalr.WorkspaceListOptions{})
+		require.NoError(t, err)
+		assert.Len(t, wl.Items, 10)
+		assert.Equal(t, 2, wl.NextPage)
+		assert.Equal(t, 15, wl.TotalCount)
+
+		all, err := scalr.Collect(scalr.Iterate[*scalr.Workspace](ctx, client.Workspaces.List, scalr.WorkspaceListOptions{
+			ListOptions: scalr.ListOptions{PageSize: 4},
+		}))
+		require.NoError(t, err)
+		assert.Len(t, all, 15)
+	})
+
+	t.Run("list with filters and include", func(t *testing.T) {
+		wl, err := client.Workspaces.List(ctx, scalr.WorkspaceListOptions{
+			Include: "environment.account",
+			Filter:  &scalr.WorkspaceFilter{Environment: scalr.String("env-123"), Name: scalr.String("ws-03,ws-04")},
+		})
+		require.NoError(t, err)
+		require.Len(t, wl.Items, 2)
+		assert.Equal(t, "ws-03", wl.Items[0].Name)
+		assert.False(t, wl.Items[0].AutoApply)
+		assert.Equal(t, "production", wl.Items[0].Environment.Name)
+
+		wl, err = client.Workspaces.List(ctx, scalr.WorkspaceListOptions{
+			Filter: &scalr.WorkspaceFilter{Environment: scalr.String("env-other")},
+		})
+		require.NoError(t, err)
+		assert.Empty(t, wl.Items)
+	})
+
+	t.Run("list with the in operator and unknown filters", func(t *testing.T) {
+		wl, err := client.Workspaces.List(ctx, scalr.WorkspaceListOptions{
+			Filter: &scalr.WorkspaceFilter{Name: scalr.String("in:ws-03,ws-04,ws-05")},
+		})
+		require.NoError(t, err)
+		assert.Len(t, wl.Items, 3)
+
+		// Filters on names no workspace has are ignored.
+		wl, err = client.Workspaces.List(ctx, scalr.WorkspaceListOptions{
+			Filter: &scalr.WorkspaceFilter{Name: scalr.String("ws-03"), Tag: scalr.String("tag-123")},
+		})
+		require.NoError(t, err)
+		assert.Len(t, wl.Items, 1)
+	})
+
+	t.Run("create, update and delete", func(t *testing.T) {
+		tag, err := client.Tags.Create(ctx, scalr.TagCreateOptions{
+			Name:    scalr.String("critical"),
+			Account: &scalr.Account{ID: "acc-123"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "critical", tag.Name)
+		assert.NotNil(t, srv.Get("tags", tag.ID))
+
+		tag, err = client.Tags.Update(ctx, tag.ID, scalr.TagUpdateOptions{Name: scalr.String("major")})
+		require.NoError(t, err)
+		assert.Equal(t, "major", tag.Name)
+
+		require.NoError(t, client.Tags.Delete(ctx, tag.ID))
+		assert.Nil(t, srv.Get("tags", tag.ID))
+	})
+
+	t.Run("when the resource does not exist", func(t *testing.T) {
+		_, err := client.Workspaces.ReadByID(ctx, "ws-missing")
+		assert.True(t, errors.Is(err, scalr.ErrResourceNotFound))
+		assert.EqualError(t, err, "Workspace with ID 'ws-missing' not found or user unauthorized.")
+	})
+
+	t.Run("with an injected error", func(t *testing.T) {
+		srv.InjectError("GET", "environments/env-123", http.StatusUnprocessableEntity, &Error{
+			Title:  "Invalid Attribute",
+			Detail: "name is invalid",
+			Source: &ErrorSource{Pointer: "/data/attributes/name"},
+		})
+
+		_, err := client.Environments.Read(ctx, "env-123")
+		var apiErr *scalr.APIError
+		require.True(t, errors.As(err, &apiErr))
+		assert.Equal(t, http.StatusUnprocessableEntity, apiErr.StatusCode)
+		assert.Equal(t, "/data/attributes/name", apiErr.Errors[0].Source.Pointer)
+
+		// Only the next request fails.
+		_, err = client.Environments.Read(ctx, "env-123")
+		assert.NoError(t, err)
+	})
+
+	t.Run("with an injected error reused", func(t *testing.T) {
+		conflict := &Error{Title: "Conflict"}
+		srv.InjectError("GET", "environments/env-123", http.StatusUnprocessableEntity, conflict)
+		srv.InjectError("GET", "accounts/acc-123", http.StatusConflict, conflict)
+
+		var apiErr *scalr.APIError
+		_, err := client.Environments.Read(ctx, "env-123")
+		require.True(t, errors.As(err, &apiErr))
+		assert.Equal(t, "422", apiErr.Errors[0].Status)
+
+		_, err = client.Accounts.Read(ctx, "acc-123")
+		require.True(t, errors.As(err, &apiErr))
+		assert.Equal(t, "409", apiErr.Errors[0].Status)
+		assert.Empty(t, conflict.Status)
+	})
+
+	t.Run("with an invalid token", func(t *testing.T) {
+		other, err := scalr.NewClient(&scalr.Config{Address: srv.URL, Token: "invalid", HTTPClient: srv.Client()})
+		require.NoError(t, err)
+
+		_, err = other.Environments.Read(ctx, "env-123")
+		assert.True(t, errors.Is(err, scalr.ErrUnauthorized))
+	})
+}
+
+func TestServerRuns(t *testing.T) {
+	ctx := context.Background()
+	srv := NewServer(t, &Resource{Type: "workspaces", ID: "ws-123", Attributes: map[string]interface{}{"name": "app"}})
+	client := newClient(t, srv)
+
+	cv, err := client.ConfigurationVersions.Create(ctx, scalr.ConfigurationVersionCreateOptions{
+		Workspace: &scalr.Workspace{ID: "ws-123"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, scalr.ConfigurationPending, cv.Status)
+
+	cv, err = client.ConfigurationVersions.Upload(ctx, cv.ID, "../test-fixtures/config-version")
+	require.NoError(t, err)
+	assert.Equal(t, scalr.ConfigurationUploaded, cv.Status)
+
+	run, err := client.Runs.Create(ctx, scalr.RunCreateOptions{
+		Workspace:            &scalr.Workspace{ID: "ws-123"},
+		ConfigurationVersion: cv,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, scalr.RunPending, run.Status)
+
+	require.True(t, srv.Update("runs", run.ID, map[string]interface{}{"status": "planned"}))
+	require.NoError(t, client.Runs.Discard(ctx, run.ID, scalr.RunDiscardOptions{}))
+
+	run, err = client.Runs.Read(ctx, run.ID)
+	require.NoError(t, err)
+	assert.Equal(t, scalr.RunDiscarded, run.Status)
+
+	// Forcing a run cancels the other pending runs of the workspace.
+	var pending []*scalr.Run
+	for i := 0; i < 2; i++ {
+		r, err := client.Runs.Create(ctx, scalr.RunCreateOptions{
+			Workspace:            &scalr.Workspace{ID: "ws-123"},
+			ConfigurationVersion: cv,
+		})
+		require.NoError(t, err)
+		pending = append(pending, r)
+	}
+	require.NoError(t, client.Runs.ForceRun(ctx, pending[1].ID, scalr.RunForceOptions{}))
+	assert.Equal(t, "canceled", srv.Get("runs", pending[0].ID).Attributes["status"])
+	assert.Equal(t, "pending", srv.Get("runs", pending[1].ID).Attributes["status"])
+
+	rl, err := client.Runs.List(ctx, scalr.RunListOptions{Workspace: scalr.String("ws-123")})
+	require.NoError(t, err)
+	assert.Len(t, rl.Items, 3)
+}
+
+func TestSeedJSON(t *testing.T) {
+	srv := NewServer(t)
+
+	err := srv.SeedJSON([]byte(`{
+		"data": [{"type": "vars", "id": "var-123", "attributes": {"key": "region", "value": "us-east-1"},
+			"relationships": {"workspace": {"data": {"type": "workspaces", "id": "ws-123"}}}}],
+		"included": [{"type": "workspaces", "id": "ws-123", "attributes": {"name": "app"}}]
+	}`))
+	require.NoError(t, err)
+
+	v := srv.Get("vars", "var-123")
+	require.NotNil(t, v)
+	assert.Equal(t, "region", v.Attributes["key"])
+	assert.Equal(t, Ref{Type: "workspaces", ID: "ws-123"}, v.Relationships["workspace"])
+	assert.Len(t, srv.List("workspaces"), 1)
+
+	err = srv.SeedJSON([]byte(`{"data": {"type": "teams", "id": "team-123"}}`))
+	assert.EqualError(t, err, `scalrtest: unsupported resource type "teams"`)
+}
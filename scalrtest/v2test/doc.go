@@ -0,0 +1,4 @@
+// Package v2test tests the v2 client against the scalrtest fake.
+//
+// It is a separate module, so neither the v1 nor the v2 module requires the other.
+package v2test
@@ -0,0 +1,20 @@
+module github.com/scalr/go-scalr/scalrtest/v2test
+
+go 1.24.0
+
+require (
+	github.com/scalr/go-scalr v0.0.0
+	github.com/scalr/go-scalr/v2 v2.0.0
+	github.com/stretchr/testify v1.7.0
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
+
+replace (
+	github.com/scalr/go-scalr => ../..
+	github.com/scalr/go-scalr/v2 => ../../v2
+)
@@ -0,0 +1,77 @@
+package v2test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scalr/go-scalr/scalrtest"
+	"github.com/scalr/go-scalr/v2/scalr"
+	"github.com/scalr/go-scalr/v2/scalr/client"
+	"github.com/scalr/go-scalr/v2/scalr/ops/workspace"
+)
+
+func TestServerV2(t *testing.T) {
+	ctx := context.Background()
+
+	srv := scalrtest.NewServer(t, &scalrtest.Resource{
+		Type:       "environments",
+		ID:         "env-123",
+		Attributes: map[string]interface{}{"name": "production"},
+	})
+	for i := 0; i < 15; i++ {
+		env := "env-123"
+		if i%3 == 0 {
+			env = "env-456"
+		}
+		require.NoError(t, srv.Seed(&scalrtest.Resource{
+			Type:          "workspaces",
+			ID:            fmt.Sprintf("ws-%02d", i),
+			Attributes:    map[string]interface{}{"name": fmt.Sprintf("app-%02d", i), "auto-apply": i%2 == 0},
+			Relationships: map[string]interface{}{"environment": scalrtest.Ref{Type: "environments", ID: env}},
+		}))
+	}
+
+	c := scalr.NewClient(srv.Domain(), srv.Token, client.WithHTTPClient(srv.Client()))
+
+	t.Run("get with include", func(t *testing.T) {
+		ws, err := c.Workspace.GetWorkspace(ctx, "ws-01", &workspace.GetWorkspaceOptions{
+			Include: []string{workspace.GetWorkspaceIncludeEnvironment},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "app-01", ws.Attributes.Name)
+		assert.False(t, ws.Attributes.AutoApply)
+		require.NotNil(t, ws.Relationships.Environment)
+		assert.Equal(t, "production", ws.Relationships.Environment.Attributes.Name)
+	})
+
+	t.Run("list with filters", func(t *testing.T) {
+		items, err := c.Workspace.GetWorkspaces(ctx, &workspace.GetWorkspacesOptions{
+			Filter: map[string]string{"environment": "env-123", "name": "in:app-01,app-03,app-04"},
+			Sort:   []string{"-name"},
+		})
+		require.NoError(t, err)
+		require.Len(t, items, 2)
+		assert.Equal(t, "app-04", items[0].Attributes.Name)
+		assert.Equal(t, "app-01", items[1].Attributes.Name)
+	})
+
+	t.Run("iterate over pages", func(t *testing.T) {
+		var names []string
+		for ws, err := range c.Workspace.GetWorkspacesIter(ctx, &workspace.GetWorkspacesOptions{PageSize: 4}) {
+			require.NoError(t, err)
+			names = append(names, ws.Attributes.Name)
+		}
+		assert.Len(t, names, 15)
+		assert.Equal(t, "app-14", names[14])
+	})
+
+	t.Run("when the workspace does not exist", func(t *testing.T) {
+		_, err := c.Workspace.GetWorkspace(ctx, "ws-missing", nil)
+		assert.True(t, errors.Is(err, client.ErrNotFound))
+	})
+}
@@ -0,0 +1,228 @@
+package scalr
+
+import (
+	"archive/tar"
+	"bufio"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// defaultIgnoreRules are always applied before the rules of the .terraformignore file.
+var defaultIgnoreRules = []string{".git/", ".terraform/"}
+
+// ignoreRule is a single compiled .terraformignore pattern.
+type ignoreRule struct {
+	re     *regexp.Regexp
+	negate bool
+}
+
+// packContents writes the contents of the src directory to w as a gzipped tarball,
+// skipping files matched by the .terraformignore rules.
+func packContents(src string, w io.Writer) error {
+	info, err := os.Stat(src)
+	if err != nil {
+		return err
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("%s is not a directory", src)
+	}
+
+	rules, err := parseIgnoreRules(src)
+	if err != nil {
+		return err
+	}
+
+	gzw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gzw)
+
+	err = filepath.Walk(src, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		if rel == "." {
+			return nil
+		}
+		rel = filepath.ToSlash(rel)
+
+		if matchIgnoreRules(rules, rel, info.IsDir()) {
+			if info.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		var link string
+		if info.Mode()&os.ModeSymlink != 0 {
+			if link, err = os.Readlink(path); err != nil {
+				return err
+			}
+			// Links are stored as is, so they must not point outside the directory.
+			target := link
+			if !filepath.IsAbs(target) {
+				target = filepath.Join(filepath.Dir(path), target)
+			}
+			if r, err := filepath.Rel(src, target); err != nil || r == ".." || strings.HasPrefix(r, ".."+string(filepath.Separator)) {
+				return fmt.Errorf("symlink %s points outside of %s", rel, src)
+			}
+		}
+
+		header, err := tar.FileInfoHeader(info, link)
+		if err != nil {
+			return err
+		}
+		header.Name = rel
+		if info.IsDir() {
+			header.Name += "/"
+		}
+		// Do not leak local user and group names into the archive.
+		header.Uname, header.Gname = "", ""
+
+		if err := tw.WriteHeader(header); err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		_, err = io.Copy(tw, f)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gzw.Close()
+}
+
+// parseIgnoreRules returns the default ignore rules followed by
+// the rules of the .terraformignore file in src, if any.
+func parseIgnoreRules(src string) ([]ignoreRule, error) {
+	patterns := append([]string{}, defaultIgnoreRules...)
+
+	f, err := os.Open(filepath.Join(src, ".terraformignore"))
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	if err == nil {
+		defer f.Close()
+
+		scanner := bufio.NewScanner(f)
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			if line == "" || strings.HasPrefix(line, "#") {
+				continue
+			}
+			patterns = append(patterns, line)
+		}
+		if err := scanner.Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	rules := make([]ignoreRule, 0, len(patterns))
+	for _, p := range patterns {
+		rule, err := compileIgnoreRule(p)
+		if err != nil {
+			return nil, fmt.Errorf("invalid .terraformignore pattern %q: %v", p, err)
+		}
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
+
+// compileIgnoreRule translates a .terraformignore pattern into a regular expression.
+// Patterns follow the .gitignore syntax: a leading slash anchors the pattern to the
+// root directory, a trailing slash only matches directories, "*" and "?" do not match
+// a slash and "**" matches any number of directories.
+func compileIgnoreRule(pattern string) (ignoreRule, error) {
+	rule := ignoreRule{}
+	if strings.HasPrefix(pattern, "!") {
+		rule.negate = true
+		pattern = pattern[1:]
+	}
+
+	dirOnly := strings.HasSuffix(pattern, "/")
+	pattern = strings.TrimSuffix(pattern, "/")
+
+	var expr strings.Builder
+	expr.WriteString("^")
+	if strings.HasPrefix(pattern, "/") {
+		pattern = pattern[1:]
+	} else {
+		expr.WriteString("(.*/)?")
+	}
+
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; c {
+		case '*':
+			if i+1 < len(pattern) && pattern[i+1] == '*' {
+				i++
+				if i+1 < len(pattern) && pattern[i+1] == '/' {
+					i++
+					expr.WriteString("(.*/)?")
+				} else {
+					expr.WriteString(".*")
+				}
+			} else {
+				expr.WriteString("[^/]*")
+			}
+		case '?':
+			expr.WriteString("[^/]")
+		default:
+			expr.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+
+	// Directories are matched with a trailing slash, which also
+	// makes a matched directory match everything below it.
+	if dirOnly {
+		expr.WriteString("/.*$")
+	} else {
+		expr.WriteString("(/.*)?$")
+	}
+
+	re, err := regexp.Compile(expr.String())
+	if err != nil {
+		return rule, err
+	}
+	rule.re = re
+
+	return rule, nil
+}
+
+// matchIgnoreRules reports whether the path relative to the root directory is ignored.
+// The last matching rule wins.
+func matchIgnoreRules(rules []ignoreRule, path string, isDir bool) bool {
+	if isDir {
+		path += "/"
+	}
+
+	ignored := false
+	for _, rule := range rules {
+		if rule.re.MatchString(path) {
+			ignored = !rule.negate
+		}
+	}
+
+	return ignored
+}
@@ -0,0 +1,128 @@
+package scalr
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// unpackNames returns the entry names of a gzipped tarball with their modes.
+func unpackNames(t *testing.T, r io.Reader) map[string]os.FileMode {
+	gzr, err := gzip.NewReader(r)
+	require.NoError(t, err)
+
+	entries := make(map[string]os.FileMode)
+	tr := tar.NewReader(gzr)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		entries[header.Name] = header.FileInfo().Mode()
+	}
+	return entries
+}
+
+func keys(m map[string]os.FileMode) []string {
+	result := make([]string, 0, len(m))
+	for k := range m {
+		result = append(result, k)
+	}
+	sort.Strings(result)
+	return result
+}
+
+func TestPackContents(t *testing.T) {
+	t.Run("with the archive fixture", func(t *testing.T) {
+		buf := bytes.NewBuffer(nil)
+		require.NoError(t, packContents("test-fixtures/archive-dir", buf))
+
+		entries := unpackNames(t, buf)
+		assert.Equal(t, []string{"bar.txt", "exe", "foo.txt", "sub/", "sub/foo.txt", "sub/zip.txt"}, keys(entries))
+		assert.NotZero(t, entries["exe"]&0100, "executable bit is preserved")
+		assert.NotZero(t, entries["sub/foo.txt"]&os.ModeSymlink, "symlinks are not followed")
+	})
+
+	t.Run("with ignored files", func(t *testing.T) {
+		dir := t.TempDir()
+		files := map[string]string{
+			".terraformignore":            "# comment\n*.log\n/build/\ncache/\n!keep.log\n",
+			"main.tf":                     "",
+			"debug.log":                   "",
+			"keep.log":                    "",
+			"build/out.txt":               "",
+			"modules/build/main.tf":       "",
+			"modules/cache/data":          "",
+			"cache":                       "",
+			".git/HEAD":                   "",
+			".terraform/modules/m.json":   "",
+			"modules/.terraform/lock.hcl": "",
+		}
+		for name, content := range files {
+			path := filepath.Join(dir, filepath.FromSlash(name))
+			require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+			require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+		}
+
+		buf := bytes.NewBuffer(nil)
+		require.NoError(t, packContents(dir, buf))
+
+		assert.Equal(t, []string{
+			".terraformignore",
+			"cache",
+			"keep.log",
+			"main.tf",
+			"modules/",
+			"modules/build/",
+			"modules/build/main.tf",
+		}, keys(unpackNames(t, buf)))
+	})
+
+	t.Run("with a symlink outside of the directory", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, os.Symlink("../outside.tf", filepath.Join(dir, "main.tf")))
+
+		err := packContents(dir, io.Discard)
+		assert.EqualError(t, err, "symlink main.tf points outside of "+dir)
+	})
+
+	t.Run("when the path is not a directory", func(t *testing.T) {
+		err := packContents("test-fixtures/archive-dir/foo.txt", io.Discard)
+		assert.EqualError(t, err, "test-fixtures/archive-dir/foo.txt is not a directory")
+	})
+}
+
+func TestCompileIgnoreRule(t *testing.T) {
+	tests := []struct {
+		pattern string
+		path    string
+		isDir   bool
+		ignored bool
+	}{
+		{"*.tfvars", "prod.tfvars", false, true},
+		{"*.tfvars", "env/prod.tfvars", false, true},
+		{"/*.tfvars", "env/prod.tfvars", false, false},
+		{"docs/", "docs", true, true},
+		{"docs/", "docs", false, false},
+		{"a/**/b", "a/b", false, true},
+		{"a/**/b", "a/x/y/b", false, true},
+		{"**/tmp", "x/tmp", true, true},
+		{"file?.txt", "file1.txt", false, true},
+		{"file?.txt", "file10.txt", false, false},
+	}
+
+	for _, tt := range tests {
+		rule, err := compileIgnoreRule(tt.pattern)
+		require.NoError(t, err)
+		assert.Equal(t, tt.ignored, matchIgnoreRules([]ignoreRule{rule}, tt.path, tt.isDir), "%s ~ %s", tt.pattern, tt.path)
+	}
+}
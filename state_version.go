@@ -0,0 +1,304 @@
+package scalr
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/hashicorp/go-uuid"
+)
+
+// Compile-time proof of interface implementation.
+var _ StateVersions = (*stateVersions)(nil)
+
+// StateVersions describes all the state version related methods that the
+// Scalr API supports.
+type StateVersions interface {
+	// List all the state versions of the given workspace.
+	List(ctx context.Context, options StateVersionListOptions) (*StateVersionList, error)
+	// Read a state version by its ID.
+	Read(ctx context.Context, svID string) (*StateVersion, error)
+	// ReadCurrent reads the latest state version of the given workspace.
+	ReadCurrent(ctx context.Context, workspaceID string) (*StateVersion, error)
+	// Download the Terraform state of a state version.
+	Download(ctx context.Context, svID string) (*State, error)
+	// Create a new state version from a local state file.
+	Create(ctx context.Context, options StateVersionCreateOptions) (*StateVersion, error)
+}
+
+// stateVersions implements StateVersions.
+type stateVersions struct {
+	client *Client
+}
+
+// StateVersionList represents a list of state versions.
+type StateVersionList struct {
+	*Pagination
+	Items []*StateVersion
+}
+
+// StateVersion represents a Scalr state version.
+type StateVersion struct {
+	ID        string    `jsonapi:"primary,state-versions"`
+	CreatedAt time.Time `jsonapi:"attr,created-at,iso8601"`
+	Force     bool      `jsonapi:"attr,force"`
+	Lineage   string    `jsonapi:"attr,lineage"`
+	MD5       string    `jsonapi:"attr,md5"`
+	Serial    int64     `jsonapi:"attr,serial"`
+	Size      int       `jsonapi:"attr,size"`
+
+	// Relations
+	Run                  *Run          `jsonapi:"relation,run"`
+	Workspace            *Workspace    `jsonapi:"relation,workspace"`
+	PreviousStateVersion *StateVersion `jsonapi:"relation,previous-state-version"`
+	NextStateVersion     *StateVersion `jsonapi:"relation,next-state-version"`
+}
+
+// State represents the content of a terraform.tfstate file.
+type State struct {
+	Version          int                     `json:"version"`
+	TerraformVersion string                  `json:"terraform_version"`
+	Serial           int64                   `json:"serial"`
+	Lineage          string                  `json:"lineage"`
+	Outputs          map[string]*StateOutput `json:"outputs"`
+	Resources        []*StateResource        `json:"resources"`
+}
+
+// StateOutput represents a root module output value of a Terraform state.
+type StateOutput struct {
+	Value     interface{}     `json:"value"`
+	Type      json.RawMessage `json:"type"`
+	Sensitive bool            `json:"sensitive"`
+}
+
+// StateResource represents a resource of a Terraform state.
+type StateResource struct {
+	Module    string                   `json:"module,omitempty"`
+	Mode      string                   `json:"mode"`
+	Type      string                   `json:"type"`
+	Name      string                   `json:"name"`
+	Provider  string                   `json:"provider"`
+	Instances []*StateResourceInstance `json:"instances"`
+}
+
+// StateResourceInstance represents a single instance of a resource of a Terraform state.
+type StateResourceInstance struct {
+	// IndexKey is either a number for count or a string for for_each, nil otherwise.
+	IndexKey            interface{}            `json:"index_key,omitempty"`
+	Status              string                 `json:"status,omitempty"`
+	Deposed             string                 `json:"deposed,omitempty"`
+	SchemaVersion       int                    `json:"schema_version"`
+	Attributes          map[string]interface{} `json:"attributes"`
+	SensitiveAttributes json.RawMessage        `json:"sensitive_attributes,omitempty"`
+	Private             string                 `json:"private,omitempty"`
+	Dependencies        []string               `json:"dependencies,omitempty"`
+	CreateBeforeDestroy bool                   `json:"create_before_destroy,omitempty"`
+}
+
+// StateVersionListOptions represents the options for listing state versions.
+type StateVersionListOptions struct {
+	ListOptions
+
+	Workspace *string `url:"filter[workspace],omitempty"`
+}
+
+func (o StateVersionListOptions) valid() error {
+	if !validStringID(o.Workspace) {
+		return errors.New("invalid value for workspace ID")
+	}
+	return nil
+}
+
+// StateVersionCreateOptions represents the options for creating a state version.
+type StateVersionCreateOptions struct {
+	// For internal use only!
+	ID string `jsonapi:"primary,state-versions"`
+
+	// Path to the local terraform.tfstate file to upload.
+	Path string
+
+	// Replace the current state even if it has a higher serial or another lineage.
+	Force *bool `jsonapi:"attr,force,omitempty"`
+
+	// Computed from the state file, for internal use only!
+	Lineage *string `jsonapi:"attr,lineage,omitempty"`
+	MD5     *string `jsonapi:"attr,md5,omitempty"`
+	Serial  *int64  `jsonapi:"attr,serial,omitempty"`
+	State   *string `jsonapi:"attr,state,omitempty"`
+
+	Workspace *Workspace `jsonapi:"relation,workspace"`
+	Run       *Run       `jsonapi:"relation,run,omitempty"`
+}
+
+func (o StateVersionCreateOptions) valid() error {
+	if o.Workspace == nil {
+		return errors.New("workspace is required")
+	}
+	if !validStringID(&o.Workspace.ID) {
+		return errors.New("invalid value for workspace ID")
+	}
+	if o.Run != nil && !validStringID(&o.Run.ID) {
+		return errors.New("invalid value for run ID")
+	}
+	if o.Path == "" {
+		return errors.New("path is required")
+	}
+	return nil
+}
+
+// List all the state versions of the given workspace.
+func (s *stateVersions) List(ctx context.Context, options StateVersionListOptions) (*StateVersionList, error) {
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.newRequest("GET", "state-versions", &options)
+	if err != nil {
+		return nil, err
+	}
+
+	svl := &StateVersionList{}
+	err = s.client.do(ctx, req, svl)
+	if err != nil {
+		return nil, err
+	}
+
+	return svl, nil
+}
+
+// Read a state version by its ID.
+func (s *stateVersions) Read(ctx context.Context, svID string) (*StateVersion, error) {
+	if !validStringID(&svID) {
+		return nil, errors.New("invalid value for state version ID")
+	}
+
+	u := fmt.Sprintf("state-versions/%s", url.QueryEscape(svID))
+	return s.read(ctx, u)
+}
+
+// ReadCurrent reads the latest state version of the given workspace.
+func (s *stateVersions) ReadCurrent(ctx context.Context, workspaceID string) (*StateVersion, error) {
+	if !validStringID(&workspaceID) {
+		return nil, errors.New("invalid value for workspace ID")
+	}
+
+	u := fmt.Sprintf("workspaces/%s/current-state-version", url.QueryEscape(workspaceID))
+	return s.read(ctx, u)
+}
+
+func (s *stateVersions) read(ctx context.Context, u string) (*StateVersion, error) {
+	req, err := s.client.newRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	sv := &StateVersion{}
+	err = s.client.do(ctx, req, sv)
+	if err != nil {
+		return nil, err
+	}
+
+	return sv, nil
+}
+
+// Download the Terraform state of a state version.
+func (s *stateVersions) Download(ctx context.Context, svID string) (*State, error) {
+	if !validStringID(&svID) {
+		return nil, errors.New("invalid value for state version ID")
+	}
+
+	u := fmt.Sprintf("state-versions/%s/download", url.QueryEscape(svID))
+	req, err := s.client.newRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	body, err := s.client.doStream(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	state := &State{}
+	if err := json.NewDecoder(body).Decode(state); err != nil {
+		return nil, fmt.Errorf("failed to decode state: %w", err)
+	}
+
+	return state, nil
+}
+
+// Create a new state version from the local state file at options.Path.
+// Only version 4 states, as written by Terraform 0.12 and later, are supported.
+// The serial and lineage are read from the state file. If the state does not
+// have a lineage yet, a new one is generated and written into the uploaded state.
+func (s *stateVersions) Create(ctx context.Context, options StateVersionCreateOptions) (*StateVersion, error) {
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
+
+	raw, err := os.ReadFile(options.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	state := &State{}
+	if err := json.Unmarshal(raw, state); err != nil {
+		return nil, fmt.Errorf("failed to decode state: %w", err)
+	}
+	if state.Version != 4 {
+		return nil, fmt.Errorf("unsupported state version %d, only version 4 is supported", state.Version)
+	}
+	if state.Lineage == "" {
+		if state.Lineage, err = uuid.GenerateUUID(); err != nil {
+			return nil, err
+		}
+		// The uploaded state and its checksum must carry the lineage too.
+		if raw, err = setStateLineage(raw, state.Lineage); err != nil {
+			return nil, err
+		}
+	}
+
+	// Make sure we don't send a user provided ID.
+	options.ID = ""
+
+	options.Lineage = String(state.Lineage)
+	options.MD5 = String(fmt.Sprintf("%x", md5.Sum(raw)))
+	options.Serial = Int64(state.Serial)
+	options.State = String(base64.StdEncoding.EncodeToString(raw))
+
+	req, err := s.client.newRequest("POST", "state-versions", &options)
+	if err != nil {
+		return nil, err
+	}
+
+	sv := &StateVersion{}
+	err = s.client.do(ctx, req, sv)
+	if err != nil {
+		return nil, err
+	}
+
+	return sv, nil
+}
+
+// setStateLineage sets the lineage of a raw state, keeping all its other
+// attributes as they are.
+func setStateLineage(raw []byte, lineage string) ([]byte, error) {
+	attrs := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(raw, &attrs); err != nil {
+		return nil, fmt.Errorf("failed to decode state: %w", err)
+	}
+
+	var err error
+	if attrs["lineage"], err = json.Marshal(lineage); err != nil {
+		return nil, err
+	}
+
+	return json.MarshalIndent(attrs, "", "  ")
+}
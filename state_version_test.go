@@ -0,0 +1,209 @@
+package scalr
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stateVersionServer records the created state version payload and serves
+// the given state as download.
+func stateVersionServer(t *testing.T, state string) (*Client, *map[string]interface{}) {
+	payload := map[string]interface{}{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/api/iacp/v3/state-versions":
+			body, _ := io.ReadAll(r.Body)
+			require.NoError(t, json.Unmarshal(body, &payload))
+
+			w.Header().Set("Content-Type", "application/vnd.api+json")
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"data":{"id":"sv-123","type":"state-versions","attributes":{"serial":1}}}`)
+		case r.URL.Path == "/api/iacp/v3/state-versions/sv-123/download":
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, state)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(ts.Close)
+
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "dummy-token",
+		HTTPClient: ts.Client(),
+	})
+	require.NoError(t, err)
+
+	return client, &payload
+}
+
+func TestStateVersionsCreate(t *testing.T) {
+	ctx := context.Background()
+	client, payload := stateVersionServer(t, "")
+
+	t.Run("with a local state file", func(t *testing.T) {
+		path := "test-fixtures/state-version/terraform.tfstate"
+		raw, err := os.ReadFile(path)
+		require.NoError(t, err)
+
+		sv, err := client.StateVersions.Create(ctx, StateVersionCreateOptions{
+			Path:      path,
+			Workspace: &Workspace{ID: "ws-123"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "sv-123", sv.ID)
+
+		attrs := (*payload)["data"].(map[string]interface{})["attributes"].(map[string]interface{})
+		assert.Equal(t, "741c4949-60b9-5bb1-5bf8-b14f4bb14af3", attrs["lineage"])
+		assert.Equal(t, float64(1), attrs["serial"])
+		assert.Equal(t, fmt.Sprintf("%x", md5.Sum(raw)), attrs["md5"])
+		assert.Equal(t, base64.StdEncoding.EncodeToString(raw), attrs["state"])
+		assert.NotContains(t, attrs, "force")
+	})
+
+	t.Run("without lineage", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "terraform.tfstate")
+		require.NoError(t, os.WriteFile(path, []byte(`{"version":4,"serial":3}`), 0644))
+
+		_, err := client.StateVersions.Create(ctx, StateVersionCreateOptions{
+			Path:      path,
+			Workspace: &Workspace{ID: "ws-123"},
+			Force:     Bool(true),
+		})
+		require.NoError(t, err)
+
+		attrs := (*payload)["data"].(map[string]interface{})["attributes"].(map[string]interface{})
+		assert.Len(t, attrs["lineage"], 36)
+		assert.Equal(t, float64(3), attrs["serial"])
+		assert.Equal(t, true, attrs["force"])
+
+		// The generated lineage is part of the uploaded state and its checksum.
+		uploaded, err := base64.StdEncoding.DecodeString(attrs["state"].(string))
+		require.NoError(t, err)
+		assert.Equal(t, fmt.Sprintf("%x", md5.Sum(uploaded)), attrs["md5"])
+
+		state := &State{}
+		require.NoError(t, json.Unmarshal(uploaded, state))
+		assert.Equal(t, attrs["lineage"], state.Lineage)
+		assert.Equal(t, int64(3), state.Serial)
+	})
+
+	t.Run("with an unsupported state version", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "terraform.tfstate")
+		require.NoError(t, os.WriteFile(path, []byte(`{"version":3,"serial":3,"lineage":"abc"}`), 0644))
+
+		_, err := client.StateVersions.Create(ctx, StateVersionCreateOptions{
+			Path:      path,
+			Workspace: &Workspace{ID: "ws-123"},
+		})
+		assert.EqualError(t, err, "unsupported state version 3, only version 4 is supported")
+	})
+
+	t.Run("when the state file is invalid", func(t *testing.T) {
+		_, err := client.StateVersions.Create(ctx, StateVersionCreateOptions{
+			Path:      "test-fixtures/config-version/main.tf",
+			Workspace: &Workspace{ID: "ws-123"},
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to decode state")
+	})
+
+	t.Run("without a path", func(t *testing.T) {
+		_, err := client.StateVersions.Create(ctx, StateVersionCreateOptions{Workspace: &Workspace{ID: "ws-123"}})
+		assert.EqualError(t, err, "path is required")
+	})
+
+	t.Run("without a workspace", func(t *testing.T) {
+		_, err := client.StateVersions.Create(ctx, StateVersionCreateOptions{Path: "terraform.tfstate"})
+		assert.EqualError(t, err, "workspace is required")
+	})
+}
+
+func TestStateVersionsDownload(t *testing.T) {
+	ctx := context.Background()
+	client, _ := stateVersionServer(t, `{
+		"version": 4,
+		"terraform_version": "1.5.7",
+		"serial": 7,
+		"lineage": "d2f0b5a4-7fa6-4c2e-8d9c-1e2f3a4b5c6d",
+		"outputs": {"id": {"value": "8959853686594715514", "type": "string"}},
+		"resources": [{
+			"mode": "managed",
+			"type": "null_resource",
+			"name": "test",
+			"provider": "provider[\"registry.terraform.io/hashicorp/null\"]",
+			"instances": [{"index_key": 0, "schema_version": 0, "attributes": {"id": "8959853686594715514"}}]
+		}]
+	}`)
+
+	t.Run("when the state version exists", func(t *testing.T) {
+		state, err := client.StateVersions.Download(ctx, "sv-123")
+		require.NoError(t, err)
+		assert.Equal(t, int64(7), state.Serial)
+		assert.Equal(t, "d2f0b5a4-7fa6-4c2e-8d9c-1e2f3a4b5c6d", state.Lineage)
+		assert.Equal(t, "8959853686594715514", state.Outputs["id"].Value)
+
+		require.Len(t, state.Resources, 1)
+		assert.Equal(t, "null_resource", state.Resources[0].Type)
+		require.Len(t, state.Resources[0].Instances, 1)
+		assert.Equal(t, float64(0), state.Resources[0].Instances[0].IndexKey)
+		assert.Equal(t, "8959853686594715514", state.Resources[0].Instances[0].Attributes["id"])
+	})
+
+	t.Run("when the state version does not exist", func(t *testing.T) {
+		state, err := client.StateVersions.Download(ctx, "sv-nonexisting")
+		assert.Nil(t, state)
+		assert.Error(t, err)
+	})
+
+	t.Run("with invalid state version ID", func(t *testing.T) {
+		state, err := client.StateVersions.Download(ctx, badIdentifier)
+		assert.Nil(t, state)
+		assert.EqualError(t, err, "invalid value for state version ID")
+	})
+}
+
+func TestStateVersionsReadCurrent(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	wsTest, wsTestCleanup := createWorkspace(t, client, nil)
+	defer wsTestCleanup()
+
+	svTest, err := client.StateVersions.Create(ctx, StateVersionCreateOptions{
+		Path:      "test-fixtures/state-version/terraform.tfstate",
+		Workspace: wsTest,
+	})
+	require.NoError(t, err)
+
+	t.Run("when the workspace has a state", func(t *testing.T) {
+		sv, err := client.StateVersions.ReadCurrent(ctx, wsTest.ID)
+		require.NoError(t, err)
+		assert.Equal(t, svTest.ID, sv.ID)
+	})
+
+	t.Run("when listing the workspace state versions", func(t *testing.T) {
+		svl, err := client.StateVersions.List(ctx, StateVersionListOptions{Workspace: String(wsTest.ID)})
+		require.NoError(t, err)
+		require.Len(t, svl.Items, 1)
+		assert.Equal(t, svTest.ID, svl.Items[0].ID)
+	})
+
+	t.Run("with invalid workspace ID", func(t *testing.T) {
+		sv, err := client.StateVersions.ReadCurrent(ctx, badIdentifier)
+		assert.Nil(t, sv)
+		assert.EqualError(t, err, "invalid value for workspace ID")
+	})
+}
@@ -59,3 +59,13 @@ func AwsDefaultTagsStrategyPtr(v AwsDefaultTagsStrategy) *AwsDefaultTagsStrategy
 func GoogleDefaultLabelsStrategyPtr(v GoogleDefaultLabelsStrategy) *GoogleDefaultLabelsStrategy {
 	return &v
 }
+
+// RunStatusPtr returns a pointer to the given RunStatus
+func RunStatusPtr(v RunStatus) *RunStatus {
+	return &v
+}
+
+// RunSourcePtr returns a pointer to the given RunSource
+func RunSourcePtr(v RunSource) *RunSource {
+	return &v
+}
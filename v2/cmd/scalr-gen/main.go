@@ -14,6 +14,11 @@ import (
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "diff" {
+		runDiff(os.Args[2:])
+		return
+	}
+
 	var (
 		specPath = flag.String("spec", "", "Path to OpenAPI spec file (required)")
 		pkgName  = flag.String("package", "scalr", "API client package name. Default: scalr")
@@ -51,6 +56,27 @@ func main() {
 	log.Println("- Done.")
 }
 
+// runDiff prints the changes of the generated client between two versions of the spec as Markdown
+func runDiff(args []string) {
+	flags := flag.NewFlagSet("diff", flag.ExitOnError)
+	var (
+		oldSpecPath = flags.String("old", "", "Path to the previous OpenAPI spec file (required)")
+		newSpecPath = flags.String("new", "", "Path to the new OpenAPI spec file (required)")
+	)
+	_ = flags.Parse(args)
+
+	if *oldSpecPath == "" || *newSpecPath == "" {
+		log.Fatal("--old and --new flags are required")
+	}
+
+	report, err := generator.Diff(*oldSpecPath, *newSpecPath)
+	if err != nil {
+		log.Fatalf("Diff failed: %v", err)
+	}
+
+	fmt.Print(report.Markdown())
+}
+
 // sanitizePackageName ensures the package name is valid as Go package and as directory name
 func sanitizePackageName(name string) (string, error) {
 	if name == "" {
@@ -0,0 +1,430 @@
+package generator
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/iancoleman/strcase"
+)
+
+// Change is a change of the generated client between two versions of the spec
+type Change struct {
+	Subject  string // Go identifier the change applies to, e.g. "workspace.GetWorkspace"
+	Message  string
+	Breaking bool // Whether code using the previous client may stop compiling or working
+}
+
+// DiffReport lists the changes of the generated client between two versions of the spec
+type DiffReport struct {
+	Changes []Change
+}
+
+// HasBreaking reports whether any of the changes is breaking
+func (r *DiffReport) HasBreaking() bool {
+	for _, change := range r.Changes {
+		if change.Breaking {
+			return true
+		}
+	}
+	return false
+}
+
+// Markdown renders the report as CHANGELOG sections, breaking changes first
+func (r *DiffReport) Markdown() string {
+	if len(r.Changes) == 0 {
+		return "No API changes.\n"
+	}
+
+	var breaking, nonBreaking []Change
+	for _, change := range r.Changes {
+		if change.Breaking {
+			breaking = append(breaking, change)
+		} else {
+			nonBreaking = append(nonBreaking, change)
+		}
+	}
+
+	var sb strings.Builder
+	writeSection := func(title string, changes []Change) {
+		if len(changes) == 0 {
+			return
+		}
+		if sb.Len() > 0 {
+			sb.WriteString("\n")
+		}
+		sb.WriteString("### " + title + "\n\n")
+		for _, change := range changes {
+			fmt.Fprintf(&sb, "- `%s`: %s\n", change.Subject, change.Message)
+		}
+	}
+	writeSection("Breaking changes", breaking)
+	writeSection("Non-breaking changes", nonBreaking)
+
+	return sb.String()
+}
+
+// Diff loads two versions of a spec and reports the changes of the client generated from them
+func Diff(oldSpecPath, newSpecPath string) (*DiffReport, error) {
+	oldDoc, err := loadSpec(oldSpecPath)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", oldSpecPath, err)
+	}
+	newDoc, err := loadSpec(newSpecPath)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", newSpecPath, err)
+	}
+	return DiffSpecs(oldDoc, newDoc), nil
+}
+
+// DiffSpecs reports the changes of the client generated from two versions of a spec.
+// It compares operations, their parameters and types, resource attributes and
+// relationships, required fields and enum values.
+func DiffSpecs(oldDoc, newDoc *openapi3.T) *DiffReport {
+	oldAPI := buildAPIModel(oldDoc)
+	newAPI := buildAPIModel(newDoc)
+
+	d := &differ{}
+	d.diffOperations(oldAPI.operations, newAPI.operations)
+	d.diffSchemas(oldAPI.schemas, newAPI.schemas)
+
+	sort.SliceStable(d.changes, func(i, j int) bool {
+		return d.changes[i].Subject < d.changes[j].Subject
+	})
+	return &DiffReport{Changes: d.changes}
+}
+
+// apiModel is the part of a spec that shapes the generated client
+type apiModel struct {
+	operations map[string]diffOperation // By qualified method name, e.g. "workspace.GetWorkspace"
+	schemas    map[string]diffSchema    // By schema name, e.g. "Workspace"
+}
+
+// diffOperation is an operation along with its required query parameters
+type diffOperation struct {
+	Operation
+	required map[string]bool
+}
+
+// diffSchema is a resource schema along with its required attributes and relationships
+type diffSchema struct {
+	SchemaData
+	required map[string]bool
+}
+
+// buildAPIModel parses a spec the same way the generator does
+func buildAPIModel(doc *openapi3.T) apiModel {
+	g := New("", "scalr")
+	g.buildTypeToSchemaMap(doc)
+
+	model := apiModel{
+		operations: make(map[string]diffOperation),
+		schemas:    make(map[string]diffSchema),
+	}
+
+	for path, pathItem := range doc.Paths.Map() {
+		for method, op := range pathItem.Operations() {
+			if op == nil {
+				continue
+			}
+
+			resource := getResourceName(op)
+			pkg := "misc"
+			if resource != "" {
+				pkg = strcase.ToSnake(resource)
+			}
+
+			operation := g.parseOperation(path, method, op, doc, resource)
+			required := make(map[string]bool)
+			for _, paramRef := range op.Parameters {
+				if paramRef.Value != nil && paramRef.Value.In == "query" && paramRef.Value.Required {
+					required[paramRef.Value.Name] = true
+				}
+			}
+			model.operations[pkg+"."+operation.Name] = diffOperation{Operation: operation, required: required}
+		}
+	}
+
+	topLevelSchemas := make(map[string]bool)
+	for name := range doc.Components.Schemas {
+		topLevelSchemas[name] = true
+	}
+	for name, schemaRef := range doc.Components.Schemas {
+		if schemaRef.Value == nil || !isResourceSchema(schemaRef.Value) {
+			continue
+		}
+
+		required := make(map[string]bool)
+		for _, section := range []string{"attributes", "relationships"} {
+			if prop := schemaRef.Value.Properties[section]; prop != nil && prop.Value != nil {
+				for _, field := range prop.Value.Required {
+					required[section+"."+field] = true
+				}
+			}
+		}
+		model.schemas[name] = diffSchema{
+			SchemaData: g.buildSchemaData(name, schemaRef.Value, topLevelSchemas),
+			required:   required,
+		}
+	}
+
+	return model
+}
+
+// differ collects the changes found while comparing two API models
+type differ struct {
+	changes []Change
+}
+
+func (d *differ) add(subject string, breaking bool, format string, args ...any) {
+	d.changes = append(d.changes, Change{
+		Subject:  subject,
+		Message:  fmt.Sprintf(format, args...),
+		Breaking: breaking,
+	})
+}
+
+func (d *differ) diffOperations(oldOps, newOps map[string]diffOperation) {
+	for _, name := range sortedKeys(oldOps) {
+		if _, ok := newOps[name]; !ok {
+			d.add(name, true, "removed operation (`%s %s`)", oldOps[name].Method, oldOps[name].Path)
+		}
+	}
+
+	for _, name := range sortedKeys(newOps) {
+		newOp := newOps[name]
+		oldOp, ok := oldOps[name]
+		if !ok {
+			d.add(name, false, "added operation (`%s %s`)", newOp.Method, newOp.Path)
+			continue
+		}
+
+		if oldOp.Method != newOp.Method || oldOp.Path != newOp.Path {
+			d.add(name, false, "moved from `%s %s` to `%s %s`", oldOp.Method, oldOp.Path, newOp.Method, newOp.Path)
+		}
+		if oldParams, newParams := formatParameters(oldOp.PathParameters), formatParameters(newOp.PathParameters); oldParams != newParams {
+			d.add(name, true, "changed path parameters from `(%s)` to `(%s)`", oldParams, newParams)
+		}
+		if oldOp.Returns != newOp.Returns {
+			d.add(name, true, "changed return type from %s to %s", codeOrNone(oldOp.Returns), codeOrNone(newOp.Returns))
+		}
+		if oldOp.RequestType != newOp.RequestType {
+			d.add(name, true, "changed request type from %s to %s", codeOrNone(oldOp.RequestType), codeOrNone(newOp.RequestType))
+		}
+
+		d.diffQueryParams(name, oldOp, newOp)
+
+		d.diffEnumTypes(func(string) string { return name }, oldOp.FilterEnums, newOp.FilterEnums)
+	}
+}
+
+func (d *differ) diffQueryParams(name string, oldOp, newOp diffOperation) {
+	oldParams := make(map[string]QueryParam)
+	for _, param := range oldOp.QueryParams {
+		oldParams[param.Name] = param
+	}
+	newParams := make(map[string]QueryParam)
+	for _, param := range newOp.QueryParams {
+		newParams[param.Name] = param
+	}
+
+	for _, param := range oldOp.QueryParams {
+		if _, ok := newParams[param.Name]; !ok {
+			d.add(name, true, "removed option `%s` (`%s`)", param.GoName, param.Name)
+		}
+	}
+
+	for _, param := range newOp.QueryParams {
+		old, ok := oldParams[param.Name]
+		switch {
+		case !ok && newOp.required[param.Name]:
+			d.add(name, true, "added required option `%s` (`%s`)", param.GoName, param.Name)
+		case !ok:
+			d.add(name, false, "added option `%s` (`%s`)", param.GoName, param.Name)
+		default:
+			if old.Type != param.Type {
+				d.add(name, true, "changed type of option `%s` from `%s` to `%s`", param.GoName, old.Type, param.Type)
+			}
+			if !oldOp.required[param.Name] && newOp.required[param.Name] {
+				d.add(name, true, "option `%s` is now required", param.GoName)
+			}
+		}
+	}
+}
+
+func (d *differ) diffSchemas(oldSchemas, newSchemas map[string]diffSchema) {
+	for _, name := range sortedKeys(oldSchemas) {
+		if _, ok := newSchemas[name]; !ok {
+			d.add("schemas."+name, true, "removed resource (`%s`)", oldSchemas[name].TypeName)
+		}
+	}
+
+	for _, name := range sortedKeys(newSchemas) {
+		subject := "schemas." + name
+		newSchema := newSchemas[name]
+		oldSchema, ok := oldSchemas[name]
+		if !ok {
+			d.add(subject, false, "added resource (`%s`)", newSchema.TypeName)
+			continue
+		}
+
+		d.diffAttributes(subject, oldSchema, newSchema)
+		d.diffRelationships(subject, oldSchema, newSchema)
+
+		d.diffEnumTypes(func(enum string) string { return "schemas." + enum }, oldSchema.EnumTypes, newSchema.EnumTypes)
+	}
+}
+
+func (d *differ) diffAttributes(subject string, oldSchema, newSchema diffSchema) {
+	oldAttrs := make(map[string]Attribute)
+	for _, attr := range oldSchema.Attributes {
+		oldAttrs[attr.JSONName] = attr
+	}
+	newAttrs := make(map[string]Attribute)
+	for _, attr := range newSchema.Attributes {
+		newAttrs[attr.JSONName] = attr
+	}
+
+	for _, attr := range oldSchema.Attributes {
+		if _, ok := newAttrs[attr.JSONName]; !ok {
+			d.add(subject, true, "removed attribute `%s` (`%s`)", attr.Name, attr.JSONName)
+		}
+	}
+
+	for _, attr := range newSchema.Attributes {
+		required := !attr.ReadOnly && newSchema.required["attributes."+attr.JSONName]
+		old, ok := oldAttrs[attr.JSONName]
+		switch {
+		case !ok && required:
+			d.add(subject, true, "added required attribute `%s` (`%s`)", attr.Name, attr.JSONName)
+		case !ok:
+			d.add(subject, false, "added attribute `%s` (`%s`)", attr.Name, attr.JSONName)
+		default:
+			if old.ResponseType != attr.ResponseType {
+				d.add(subject, true, "changed type of attribute `%s` from `%s` to `%s`", attr.Name, old.ResponseType, attr.ResponseType)
+			}
+			if !old.ReadOnly && attr.ReadOnly {
+				d.add(subject, true, "attribute `%s` is now read-only", attr.Name)
+			}
+			if required && (old.ReadOnly || !oldSchema.required["attributes."+attr.JSONName]) {
+				d.add(subject, true, "attribute `%s` is now required", attr.Name)
+			}
+		}
+	}
+}
+
+func (d *differ) diffRelationships(subject string, oldSchema, newSchema diffSchema) {
+	oldRels := make(map[string]Relationship)
+	for _, rel := range oldSchema.Relationships {
+		oldRels[rel.JSONName] = rel
+	}
+	newRels := make(map[string]Relationship)
+	for _, rel := range newSchema.Relationships {
+		newRels[rel.JSONName] = rel
+	}
+
+	for _, rel := range oldSchema.Relationships {
+		if _, ok := newRels[rel.JSONName]; !ok {
+			d.add(subject, true, "removed relationship `%s` (`%s`)", rel.Name, rel.JSONName)
+		}
+	}
+
+	for _, rel := range newSchema.Relationships {
+		required := !rel.ReadOnly && newSchema.required["relationships."+rel.JSONName]
+		old, ok := oldRels[rel.JSONName]
+		switch {
+		case !ok && required:
+			d.add(subject, true, "added required relationship `%s` (`%s`)", rel.Name, rel.JSONName)
+		case !ok:
+			d.add(subject, false, "added relationship `%s` (`%s`)", rel.Name, rel.JSONName)
+		default:
+			if old.Type != rel.Type || old.ToMany != rel.ToMany {
+				d.add(subject, true, "changed type of relationship `%s` from `%s` to `%s`", rel.Name, relationshipType(old), relationshipType(rel))
+			}
+			if !old.ReadOnly && rel.ReadOnly {
+				d.add(subject, true, "relationship `%s` is now read-only", rel.Name)
+			}
+			if required && (old.ReadOnly || !oldSchema.required["relationships."+rel.JSONName]) {
+				d.add(subject, true, "relationship `%s` is now required", rel.Name)
+			}
+		}
+	}
+}
+
+// diffEnum reports removed enum values as breaking, since the API rejects them, and added ones as not
+// diffEnumTypes reports the enum types removed, added or changed,
+// subject returns the subject of the changes of an enum type
+func (d *differ) diffEnumTypes(subject func(enum string) string, oldEnums, newEnums []EnumType) {
+	newByName := make(map[string]EnumType)
+	for _, enum := range newEnums {
+		newByName[enum.Name] = enum
+	}
+	oldByName := make(map[string]EnumType)
+	for _, enum := range oldEnums {
+		oldByName[enum.Name] = enum
+		if _, ok := newByName[enum.Name]; !ok {
+			d.add(subject(enum.Name), true, "removed enum type `%s`", enum.Name)
+		}
+	}
+
+	for _, enum := range newEnums {
+		old, ok := oldByName[enum.Name]
+		if !ok {
+			d.add(subject(enum.Name), false, "added enum type `%s`", enum.Name)
+			continue
+		}
+		d.diffEnum(subject(enum.Name), old, enum)
+	}
+}
+
+func (d *differ) diffEnum(subject string, oldEnum, newEnum EnumType) {
+	newValues := make(map[string]bool)
+	for _, value := range newEnum.Values {
+		newValues[value.Value] = true
+	}
+	oldValues := make(map[string]bool)
+	for _, value := range oldEnum.Values {
+		oldValues[value.Value] = true
+		if !newValues[value.Value] {
+			d.add(subject, true, "removed enum value `%s` (`%s`)", value.Name, value.Value)
+		}
+	}
+	for _, value := range newEnum.Values {
+		if !oldValues[value.Value] {
+			d.add(subject, false, "added enum value `%s` (`%s`)", value.Name, value.Value)
+		}
+	}
+}
+
+// formatParameters formats path parameters as they appear in the method signature
+func formatParameters(params []Parameter) string {
+	parts := make([]string, 0, len(params))
+	for _, param := range params {
+		parts = append(parts, param.GoName+" "+param.Type)
+	}
+	return strings.Join(parts, ", ")
+}
+
+func relationshipType(rel Relationship) string {
+	if rel.ToMany {
+		return "[]" + rel.Type
+	}
+	return rel.Type
+}
+
+func codeOrNone(typeName string) string {
+	if typeName == "" {
+		return "none"
+	}
+	return "`" + typeName + "`"
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
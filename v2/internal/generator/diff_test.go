@@ -0,0 +1,216 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const diffOldSpec = `
+openapi: 3.0.0
+info: {title: Scalr, version: "1"}
+servers:
+  - url: https://{domain}/api/iacp/v3
+paths:
+  /workspaces:
+    get:
+      operationId: get-workspaces
+      x-resource: Workspace
+      parameters:
+        - {name: query, in: query, schema: {type: string}}
+        - {name: "filter[status]", in: query, schema: {type: string, enum: [active, locked]}}
+        - {name: "filter[source]", in: query, schema: {type: string, enum: [vcs, cli]}}
+        - {name: "page[number]", in: query, schema: {type: integer}}
+        - {name: "page[size]", in: query, schema: {type: integer}}
+      responses:
+        "200":
+          description: OK
+          content:
+            application/vnd.api+json:
+              schema:
+                type: object
+                properties:
+                  data: {type: array, items: {$ref: "#/components/schemas/Workspace"}}
+  /workspaces/{workspace}:
+    delete:
+      operationId: delete-workspace
+      x-resource: Workspace
+      parameters:
+        - {name: workspace, in: path, required: true, schema: {type: string}}
+      responses:
+        "204": {description: No Content}
+components:
+  schemas:
+    Workspace:
+      type: object
+      properties:
+        id: {type: string}
+        type: {type: string, enum: [workspaces]}
+        attributes:
+          type: object
+          properties:
+            name: {type: string}
+            auto-apply: {type: boolean}
+            execution-mode: {type: string, enum: [remote, local]}
+            vcs-provider: {type: string, enum: [github, gitlab]}
+        relationships:
+          type: object
+          properties:
+            environment:
+              type: object
+              properties:
+                data:
+                  type: object
+                  properties:
+                    id: {type: string}
+                    type: {type: string, enum: [environments]}
+`
+
+const diffNewSpec = `
+openapi: 3.0.0
+info: {title: Scalr, version: "2"}
+servers:
+  - url: https://{domain}/api/iacp/v3
+paths:
+  /workspaces:
+    get:
+      operationId: get-workspaces
+      x-resource: Workspace
+      parameters:
+        - {name: query, in: query, schema: {type: integer}}
+        - {name: "filter[status]", in: query, schema: {type: string, enum: [active, archived]}}
+        - {name: "filter[source]", in: query, schema: {type: string}}
+        - {name: sort, in: query, schema: {type: string}}
+        - {name: "page[number]", in: query, schema: {type: integer}}
+        - {name: "page[size]", in: query, schema: {type: integer}}
+      responses:
+        "200":
+          description: OK
+          content:
+            application/vnd.api+json:
+              schema:
+                type: object
+                properties:
+                  data: {type: array, items: {$ref: "#/components/schemas/Workspace"}}
+  /workspaces/{workspace}/lock:
+    post:
+      operationId: lock-workspace
+      x-resource: Workspace
+      parameters:
+        - {name: workspace, in: path, required: true, schema: {type: string}}
+        - {name: reason, in: query, required: true, schema: {type: string}}
+      responses:
+        "204": {description: No Content}
+components:
+  schemas:
+    Workspace:
+      type: object
+      properties:
+        id: {type: string}
+        type: {type: string, enum: [workspaces]}
+        attributes:
+          type: object
+          required: [name, terraform-version]
+          properties:
+            name: {type: string}
+            auto-apply: {type: string}
+            execution-mode: {type: string, enum: [remote, agent]}
+            vcs-provider: {type: string}
+            iac-platform: {type: string, enum: [terraform, opentofu]}
+            terraform-version: {type: string}
+            locked: {type: boolean, readOnly: true}
+        relationships:
+          type: object
+          properties:
+            environment:
+              type: object
+              properties:
+                data:
+                  type: object
+                  properties:
+                    id: {type: string}
+                    type: {type: string, enum: [environments]}
+`
+
+func writeSpec(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+// TestDiff tests reporting the client changes between two versions of a spec
+func TestDiff(t *testing.T) {
+	report, err := Diff(writeSpec(t, "old.yml", diffOldSpec), writeSpec(t, "new.yml", diffNewSpec))
+	if err != nil {
+		t.Fatalf("Diff() error = %v", err)
+	}
+
+	want := []Change{
+		{Subject: "schemas.Workspace", Message: "changed type of attribute `AutoApply` from `bool` to `string`", Breaking: true},
+		{Subject: "schemas.Workspace", Message: "attribute `Name` is now required", Breaking: true},
+		{Subject: "schemas.Workspace", Message: "added required attribute `TerraformVersion` (`terraform-version`)", Breaking: true},
+		{Subject: "schemas.Workspace", Message: "added attribute `Locked` (`locked`)"},
+		{Subject: "schemas.WorkspaceExecutionMode", Message: "removed enum value `WorkspaceExecutionModeLocal` (`local`)", Breaking: true},
+		{Subject: "schemas.WorkspaceExecutionMode", Message: "added enum value `WorkspaceExecutionModeAgent` (`agent`)"},
+		{Subject: "schemas.WorkspaceVcsProvider", Message: "removed enum type `WorkspaceVcsProvider`", Breaking: true},
+		{Subject: "schemas.WorkspaceIacPlatform", Message: "added enum type `WorkspaceIacPlatform`"},
+		{Subject: "workspace.DeleteWorkspace", Message: "removed operation (`DELETE /workspaces/{workspace}`)", Breaking: true},
+		{Subject: "workspace.GetWorkspaces", Message: "added option `Sort` (`sort`)"},
+		{Subject: "workspace.GetWorkspaces", Message: "changed type of option `Query` from `string` to `int`", Breaking: true},
+		{Subject: "workspace.GetWorkspaces", Message: "removed enum value `GetWorkspacesFilterStatusLocked` (`locked`)", Breaking: true},
+		{Subject: "workspace.GetWorkspaces", Message: "added enum value `GetWorkspacesFilterStatusArchived` (`archived`)"},
+		{Subject: "workspace.GetWorkspaces", Message: "removed enum type `GetWorkspacesFilterSource`", Breaking: true},
+		{Subject: "workspace.LockWorkspace", Message: "added operation (`POST /workspaces/{workspace}/lock`)"},
+	}
+
+	got := make(map[Change]bool)
+	for _, change := range report.Changes {
+		got[change] = true
+	}
+	for _, change := range want {
+		if !got[change] {
+			t.Errorf("Missing change %+v", change)
+		}
+	}
+
+	if !report.HasBreaking() {
+		t.Error("Expected breaking changes")
+	}
+
+	markdown := report.Markdown()
+	breaking := strings.Index(markdown, "### Breaking changes\n\n")
+	nonBreaking := strings.Index(markdown, "### Non-breaking changes\n\n")
+	if breaking == -1 || nonBreaking == -1 || breaking > nonBreaking {
+		t.Fatalf("Expected breaking changes followed by non-breaking changes, got:\n%s", markdown)
+	}
+	for _, line := range []string{
+		"- `workspace.DeleteWorkspace`: removed operation (`DELETE /workspaces/{workspace}`)\n",
+		"- `workspace.LockWorkspace`: added operation (`POST /workspaces/{workspace}/lock`)\n",
+	} {
+		if !strings.Contains(markdown, line) {
+			t.Errorf("Expected Markdown to contain %q, got:\n%s", line, markdown)
+		}
+	}
+	if strings.Index(markdown, "removed operation") > nonBreaking || strings.Index(markdown, "added operation") < nonBreaking {
+		t.Errorf("Changes are listed in the wrong sections:\n%s", markdown)
+	}
+}
+
+// TestDiffNoChanges tests diffing a spec against itself
+func TestDiffNoChanges(t *testing.T) {
+	path := writeSpec(t, "spec.yml", diffOldSpec)
+	report, err := Diff(path, path)
+	if err != nil {
+		t.Fatalf("Diff() error = %v", err)
+	}
+	if len(report.Changes) != 0 {
+		t.Errorf("Expected no changes, got %+v", report.Changes)
+	}
+	if got := report.Markdown(); got != "No API changes.\n" {
+		t.Errorf("Markdown() = %q, want %q", got, "No API changes.\n")
+	}
+}
@@ -35,12 +35,9 @@ func New(out, pkg string) *Generator {
 func (g *Generator) Generate(specPath string) error {
 	log.Printf("Reading OpenAPI spec from %s", specPath)
 
-	loader := openapi3.NewLoader()
-	loader.IsExternalRefsAllowed = true
-
-	doc, err := loader.LoadFromFile(specPath)
+	doc, err := loadSpec(specPath)
 	if err != nil {
-		return fmt.Errorf("failed to load spec: %w", err)
+		return err
 	}
 
 	log.Printf("Loaded %d schemas, %d paths", len(doc.Components.Schemas), len(doc.Paths.Map()))
@@ -146,6 +143,18 @@ func IsGoReservedWord(word string) bool {
 	return reserved[word]
 }
 
+// loadSpec loads an OpenAPI spec file, resolving its references
+func loadSpec(specPath string) (*openapi3.T, error) {
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+
+	doc, err := loader.LoadFromFile(specPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load spec: %w", err)
+	}
+	return doc, nil
+}
+
 // parseSpecMetadata extracts API configuration from the spec
 func (g *Generator) parseSpecMetadata(doc *openapi3.T) error {
 	if len(doc.Servers) == 0 {
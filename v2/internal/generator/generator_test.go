@@ -71,6 +71,26 @@ func TestSchemaToGoType(t *testing.T) {
 			schema:   &openapi3.Schema{Type: &openapi3.Types{"object"}},
 			expected: "map[string]interface{}", // Objects without properties become maps
 		},
+		{
+			name: "object with typed additional properties (map)",
+			schema: &openapi3.Schema{
+				Type: &openapi3.Types{"object"},
+				AdditionalProperties: openapi3.AdditionalProperties{
+					Schema: &openapi3.SchemaRef{Value: &openapi3.Schema{Type: &openapi3.Types{"boolean"}}},
+				},
+			},
+			expected: "map[string]bool",
+		},
+		{
+			name: "nullable reference (oneOf with null)",
+			schema: &openapi3.Schema{
+				OneOf: openapi3.SchemaRefs{
+					{Ref: "#/components/schemas/Workspace", Value: &openapi3.Schema{Type: &openapi3.Types{"object"}}},
+					{Value: &openapi3.Schema{Type: &openapi3.Types{"null"}}},
+				},
+			},
+			expected: "*Workspace",
+		},
 		{
 			name:     "nullable string",
 			schema:   &openapi3.Schema{Type: &openapi3.Types{"string"}, Nullable: true},
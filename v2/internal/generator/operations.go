@@ -17,6 +17,9 @@ import (
 //go:embed templates/operations.tpl
 var operationsTemplate string
 
+//go:embed templates/api.tpl
+var apiTemplate string
+
 // generateOperations generates resource client files
 func (g *Generator) generateOperations(doc *openapi3.T, outputDir string) error {
 	// Group operations by x-resource
@@ -45,11 +48,14 @@ func (g *Generator) generateOperations(doc *openapi3.T, outputDir string) error
 	// Each resource operation resides in its own package
 	tmpl, err := template.New("operations").Funcs(template.FuncMap{
 		"trimPrefix": strings.TrimPrefix,
-		"contains":   strings.Contains,
 	}).Parse(operationsTemplate)
 	if err != nil {
 		return fmt.Errorf("failed to parse template: %w", err)
 	}
+	apiTmpl, err := template.New("api").Parse(apiTemplate)
+	if err != nil {
+		return fmt.Errorf("failed to parse API template: %w", err)
+	}
 
 	for resource, ops := range resourceOps {
 		resourceDir := filepath.Join(outputDir, strcase.ToSnake(resource))
@@ -70,6 +76,7 @@ func (g *Generator) generateOperations(doc *openapi3.T, outputDir string) error
 			ResourceName:   resource,
 			ApiPackageName: g.pkgName,
 			Operations:     ops,
+			Methods:        buildMethods(ops),
 		}
 
 		var buf bytes.Buffer
@@ -80,6 +87,10 @@ func (g *Generator) generateOperations(doc *openapi3.T, outputDir string) error
 		if err := os.WriteFile(filePath, buf.Bytes(), 0644); err != nil {
 			return fmt.Errorf("failed to write %s: %w", fileName, err)
 		}
+
+		if err := writeAPI(apiTmpl, data, resourceDir); err != nil {
+			return err
+		}
 	}
 
 	// Generate standalone operations (without x-resource)
@@ -101,6 +112,7 @@ func (g *Generator) generateOperations(doc *openapi3.T, outputDir string) error
 			ResourceName:   "Misc",
 			ApiPackageName: g.pkgName,
 			Operations:     standaloneOps,
+			Methods:        buildMethods(standaloneOps),
 		}
 
 		var buf bytes.Buffer
@@ -111,8 +123,110 @@ func (g *Generator) generateOperations(doc *openapi3.T, outputDir string) error
 		if err := os.WriteFile(filePath, buf.Bytes(), 0644); err != nil {
 			return fmt.Errorf("failed to write misc.gen.go: %w", err)
 		}
+
+		if err := writeAPI(apiTmpl, data, standaloneDir); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// writeAPI writes the API interface and the fake of a resource client, next to the client
+func writeAPI(tmpl *template.Template, data ResourceClientData, resourceDir string) error {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return fmt.Errorf("failed to execute API template for %s: %w", data.ResourceName, err)
+	}
+
+	fileName := data.PackageName + "_api.gen.go"
+	if err := os.WriteFile(filepath.Join(resourceDir, fileName), buf.Bytes(), 0644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", fileName, err)
+	}
+	return nil
+}
+
+// buildMethods lists the methods of the client generated for the operations, in order
+func buildMethods(ops []Operation) []Method {
+	var methods []Method
+	for _, op := range ops {
+		methods = append(methods, op.Methods...)
+	}
+	return methods
+}
+
+// operationMethods lists the methods generated for an operation, in order. The client,
+// its API interface and its fake all take their signatures from here.
+func operationMethods(op Operation) []Method {
+	var pathParams, pathArgs []string
+	for _, p := range op.PathParameters {
+		pathParams = append(pathParams, p.GoName+" "+p.Type)
+		pathArgs = append(pathArgs, p.GoName)
+	}
+
+	params := append([]string{}, pathParams...)
+	args := append([]string{}, pathArgs...)
+	if op.HasBody {
+		params = append(params, "req "+op.RequestType)
+		args = append(args, "req")
+	}
+	if len(op.QueryParams) > 0 {
+		params = append(params, "opts *"+op.Name+"Options")
+		args = append(args, "opts")
+	}
+
+	method := func(kind MethodKind, name string, params, args []string, results string) Method {
+		return Method{
+			Kind:    kind,
+			Name:    name,
+			Params:  strings.Join(append([]string{"ctx context.Context"}, params...), ", "),
+			Args:    strings.Join(append([]string{"ctx"}, args...), ", "),
+			Results: results,
+		}
+	}
+
+	result := "error"
+	if op.ReturnsData {
+		result = "(" + op.Returns + ", error)"
+	}
+	methods := []Method{
+		method(MethodRaw, op.Name+"Raw", params, args, "(*client.Response, error)"),
+		method(MethodCall, op.Name, params, args, result),
+	}
+
+	if op.Streams {
+		methods = append(methods,
+			method(MethodStream, op.Name+"Stream", params, args, "(io.ReadCloser, error)"),
+			method(MethodTo, op.Name+"To", append([]string{"w io.Writer"}, params...), append([]string{"w"}, args...), "(int64, error)"),
+		)
+	}
+
+	hasPagination := false
+	for _, qp := range op.QueryParams {
+		if qp.IsPagination {
+			hasPagination = true
+		}
+	}
+	if op.IsList && op.ReturnsData && hasPagination && !strings.Contains(op.Returns, "interface{}") {
+		item := strings.TrimPrefix(op.Returns, "[]*")
+		// The iterators never take a request body
+		iterParams := append(append([]string{}, pathParams...), "opts *"+op.Name+"Options")
+		iterArgs := append(append([]string{}, pathArgs...), "opts")
+		methods = append(methods,
+			method(MethodIter, op.Name+"Iter", iterParams, iterArgs, "iter.Seq2["+item+", error]"),
+			method(MethodPaged, op.Name+"Paged", iterParams, iterArgs, "*client.Iterator["+item+"]"),
+		)
 	}
+	return methods
+}
 
+// findMethod returns the first of the methods of the given kind, or nil
+func findMethod(methods []Method, kind MethodKind) *Method {
+	for i := range methods {
+		if methods[i].Kind == kind {
+			return &methods[i]
+		}
+	}
 	return nil
 }
 
@@ -122,6 +236,44 @@ type ResourceClientData struct {
 	ResourceName   string
 	ApiPackageName string
 	Operations     []Operation
+	Methods        []Method // Methods of the client, for its API interface and fake
+}
+
+// MethodOf returns the first method of the client of the given kind, or nil
+func (d ResourceClientData) MethodOf(kind MethodKind) *Method {
+	return findMethod(d.Methods, kind)
+}
+
+// MethodKind is the kind of a method generated for an operation
+type MethodKind string
+
+const (
+	MethodRaw    MethodKind = "raw"    // Returns the raw response
+	MethodCall   MethodKind = "call"   // Returns the decoded response
+	MethodStream MethodKind = "stream" // Streams the response
+	MethodTo     MethodKind = "to"     // Copies the response to a writer
+	MethodIter   MethodKind = "iter"   // Iterates over the items of every page
+	MethodPaged  MethodKind = "paged"  // Iterates over the items, with a resumable cursor
+)
+
+// Method is a method of a resource client
+type Method struct {
+	Kind    MethodKind
+	Name    string
+	Params  string // Parameters, e.g. "ctx context.Context, workspace string"
+	Args    string // Arguments passed on, e.g. "ctx, workspace"
+	Results string // e.g. "(*schemas.Workspace, error)"
+}
+
+// Signature returns the name, parameters and results of the method, e.g.
+// "GetWorkspace(ctx context.Context, workspace string) (*schemas.Workspace, error)"
+func (m Method) Signature() string {
+	return m.Name + "(" + m.Params + ") " + m.Results
+}
+
+// Call returns a call of the method passing on the parameters, e.g. "GetWorkspace(ctx, workspace)"
+func (m Method) Call() string {
+	return m.Name + "(" + m.Args + ")"
 }
 
 // Operation represents an API operation
@@ -132,15 +284,37 @@ type Operation struct {
 	Description          string
 	PathParameters       []Parameter
 	QueryParams          []QueryParam
-	Returns              string // Return type
-	RequestType          string // Request body type (schemas.WorkspaceRequest, schemas.TagRelationshipFieldsetsListingDocument, etc.)
-	IsRelationshipOp     bool   // Is this a relationship operation (needs special handling)
-	IsList               bool   // Is this a listing operation
-	HasBody              bool   // Has request body
-	ReturnsData          bool   // Returns data (vs void)
-	ReturnsText          bool   // Returns plain text (not JSON)
-	ReturnsRelationships bool   // Whether the return type has relationships field
-	UsesPlainJSON        bool   // True if request body is plain JSON (not JSON:API)
+	Returns              string       // Return type
+	RequestType          string       // Request body type (schemas.WorkspaceRequest, schemas.TagRelationshipFieldsetsListingDocument, etc.)
+	IsRelationshipOp     bool         // Is this a relationship operation (needs special handling)
+	IsList               bool         // Is this a listing operation
+	HasBody              bool         // Has request body
+	ReturnsData          bool         // Returns data (vs void)
+	ReturnsText          bool         // Returns plain text (not JSON)
+	ContentType          string       // Content type of a non-JSON:API response, e.g. "application/octet-stream"
+	Streams              bool         // Has Stream and To variants, for text and binary downloads
+	IsBinary             bool         // Returns binary content, which should not be buffered into a string
+	ReturnsRelationships bool         // Whether the return type has relationships field
+	UsesPlainJSON        bool         // True if request body is plain JSON (not JSON:API)
+	UploadContentType    string       // Content type of a request body sent as is, e.g. "application/octet-stream"
+	IsMultipart          bool         // True if the request body is multipart/form-data
+	Filters              []QueryParam // Typed filter[...] parameters
+	FilterEnums          []EnumType   // Enum types of the typed filters
+	Includes             []Include    // Relationship paths accepted by the include parameter
+	ValidatesRequest     bool         // The request body has Validate methods, called before sending
+	Patterns             []Validation // Patterns of the query parameters, compiled once per package
+	Methods              []Method     // Methods generated for the operation, see operationMethods
+}
+
+// MethodOf returns the method of the operation of the given kind, or nil if it is not generated
+func (op Operation) MethodOf(kind MethodKind) *Method {
+	return findMethod(op.Methods, kind)
+}
+
+// Include represents a relationship path of the include parameter, e.g. "workspace.environment"
+type Include struct {
+	Name string // Constant name, e.g. GetRunsIncludeWorkspaceEnvironment
+	Path string
 }
 
 // Parameter represents an operation path parameter
@@ -157,9 +331,12 @@ type QueryParam struct {
 	Type         string
 	Description  string
 	IsFilter     bool
+	FilterKey    string // Key of a filter[...] parameter, e.g. "environment"
 	IsSort       bool
 	IsInclude    bool
+	IsFields     bool
 	IsPagination bool
+	Validation   Validation // Constraints checked by the Validate method of the options
 }
 
 // parseOperation parses an OpenAPI operation
@@ -187,17 +364,37 @@ func (g *Generator) parseOperation(path, method string, op *openapi3.Operation,
 				Type:   "string",
 			})
 		case "query":
-			operation.QueryParams = append(operation.QueryParams, g.parseQueryParam(param))
+			qp := g.parseQueryParam(param)
+			if qp.IsFilter {
+				g.addFilter(&operation, qp, param)
+			} else {
+				qp.Validation = queryParamValidation(operation.Name, qp, param)
+				if qp.Validation.Pattern != "" {
+					operation.Patterns = append(operation.Patterns, qp.Validation)
+				}
+			}
+			operation.QueryParams = append(operation.QueryParams, qp)
 		}
 	}
 
 	// Check if has request body and extract request type
 	if op.RequestBody != nil && op.RequestBody.Value != nil {
 		operation.HasBody = true
-		reqType, isRelationship, usesPlainJSON := g.getRequestBodyType(op.RequestBody.Value, doc, resourceName, path, method)
-		operation.RequestType = reqType
-		operation.IsRelationshipOp = isRelationship
-		operation.UsesPlainJSON = usesPlainJSON
+		if contentType := uploadContentType(op.RequestBody.Value); contentType != "" {
+			// Uploads take a reader, rewound on retries if it implements io.Seeker
+			operation.UploadContentType = contentType
+			operation.IsMultipart = contentType == "multipart/form-data"
+			operation.RequestType = "io.Reader"
+			if operation.IsMultipart {
+				operation.RequestType = "*client.Multipart"
+			}
+		} else {
+			reqType, isRelationship, usesPlainJSON := g.getRequestBodyType(op.RequestBody.Value, doc, resourceName, path, method)
+			operation.RequestType = reqType
+			operation.IsRelationshipOp = isRelationship
+			operation.UsesPlainJSON = usesPlainJSON
+			operation.ValidatesRequest = !isRelationship && isResourceRequestType(reqType, doc)
+		}
 	}
 
 	// Determine return type from response
@@ -211,6 +408,11 @@ func (g *Generator) parseOperation(path, method string, op *openapi3.Operation,
 			returnType, isText := g.getResponseType(resp.Value, doc, path, method)
 			operation.Returns = returnType
 			operation.ReturnsText = isText
+			if isText {
+				operation.ContentType = responseContentType(resp.Value)
+				operation.Streams = !isJSONContentType(operation.ContentType)
+				operation.IsBinary = operation.Streams && operation.ContentType != "" && !strings.HasPrefix(operation.ContentType, "text/")
+			}
 			operation.IsList = strings.Contains(operation.Returns, "[]")
 			operation.ReturnsRelationships = g.schemaHasRelationships(resp.Value, doc)
 		} else if responses.Status(204) != nil {
@@ -218,9 +420,65 @@ func (g *Generator) parseOperation(path, method string, op *openapi3.Operation,
 		}
 	}
 
+	for _, qp := range operation.QueryParams {
+		if qp.IsInclude && operation.ReturnsRelationships {
+			operation.Includes = g.buildIncludes(operation.Name, operation.Returns, doc)
+			break
+		}
+	}
+
+	operation.Methods = operationMethods(operation)
+
 	return operation
 }
 
+// maxIncludeDepth limits the nesting of the generated include paths
+const maxIncludeDepth = 2
+
+// buildIncludes lists the include paths of the returned resource, from its
+// relationships and the relationships of the related resources
+func (g *Generator) buildIncludes(operationName, returns string, doc *openapi3.T) []Include {
+	schemaName := strings.TrimPrefix(strings.TrimLeft(returns, "[]*"), "schemas.")
+
+	var paths []string
+	var walk func(schemaName, prefix string, depth int)
+	walk = func(schemaName, prefix string, depth int) {
+		schemaRef, ok := doc.Components.Schemas[schemaName]
+		if !ok || schemaRef.Value == nil {
+			return
+		}
+		relSchema := schemaRef.Value.Properties["relationships"]
+		if relSchema == nil || relSchema.Value == nil {
+			return
+		}
+		for relName, relRef := range relSchema.Value.Properties {
+			if relRef.Value == nil {
+				continue
+			}
+			rel := g.parseRelationship(relName, relRef.Value)
+			if rel.Type == "" {
+				continue
+			}
+			path := prefix + rel.JSONName
+			paths = append(paths, path)
+			if depth < maxIncludeDepth {
+				walk(rel.Type, path+".", depth+1)
+			}
+		}
+	}
+	walk(schemaName, "", 1)
+
+	sort.Strings(paths)
+	includes := make([]Include, 0, len(paths))
+	for _, path := range paths {
+		includes = append(includes, Include{
+			Name: operationName + "Include" + strcase.ToCamel(strings.ReplaceAll(path, ".", "_")),
+			Path: path,
+		})
+	}
+	return includes
+}
+
 // parseQueryParam parses a query parameter
 func (g *Generator) parseQueryParam(param *openapi3.Parameter) QueryParam {
 	qp := QueryParam{
@@ -233,13 +491,27 @@ func (g *Generator) parseQueryParam(param *openapi3.Parameter) QueryParam {
 	switch {
 	case strings.HasPrefix(param.Name, "filter["):
 		qp.IsFilter = true
+		qp.FilterKey = strings.TrimSuffix(strings.TrimPrefix(param.Name, "filter["), "]")
+		qp.GoName = strcase.ToCamel(qp.FilterKey)
 		qp.Type = "string"
+		if param.Schema != nil && param.Schema.Value != nil {
+			switch {
+			case param.Schema.Value.Type.Is("boolean"):
+				qp.Type = "*bool"
+			case param.Schema.Value.Type.Is("integer"):
+				qp.Type = "*int"
+			}
+		}
 	case param.Name == "sort":
 		qp.IsSort = true
 		qp.Type = "[]string"
 	case param.Name == "include":
 		qp.IsInclude = true
 		qp.Type = "[]string"
+	case param.Name == "fields":
+		// Sparse fieldsets, encoded as fields[resource-type]
+		qp.IsFields = true
+		qp.Type = "client.Fields"
 	case param.Name == "page[number]":
 		qp.IsPagination = true
 		qp.GoName = "PageNumber"
@@ -263,6 +535,59 @@ func (g *Generator) parseQueryParam(param *openapi3.Parameter) QueryParam {
 	return qp
 }
 
+// queryParamValidation collects the constraints of a query parameter. Only the parameters
+// encoded from the options are checked: strings and pagination numbers.
+func queryParamValidation(operationName string, qp QueryParam, param *openapi3.Parameter) Validation {
+	if qp.IsSort || qp.IsInclude || qp.IsFields || param.Schema == nil || param.Schema.Value == nil {
+		return Validation{}
+	}
+	if qp.Type != "string" && !qp.IsPagination {
+		return Validation{}
+	}
+
+	v := buildValidation(operationName+"Options", qp.GoName, param.Name, qp.Type, param.Schema.Value, param.Required)
+	// An unset number can not be told apart from zero, it is only checked when set
+	v.Required = v.Required && qp.Type == "string"
+	return v
+}
+
+// isResourceRequestType reports whether a request type is the request struct of a
+// resource schema, e.g. *schemas.WorkspaceRequest, which has Validate methods
+func isResourceRequestType(requestType string, doc *openapi3.T) bool {
+	name, ok := strings.CutPrefix(requestType, "*schemas.")
+	if !ok {
+		return false
+	}
+	name, ok = strings.CutSuffix(name, "Request")
+	if !ok || doc.Components == nil {
+		return false
+	}
+	schemaRef := doc.Components.Schemas[name]
+	return schemaRef != nil && schemaRef.Value != nil && isResourceSchema(schemaRef.Value)
+}
+
+// addFilter adds a filter[...] parameter to the typed filters of the operation.
+// String enums get their own type, named after the operation and the filter.
+func (g *Generator) addFilter(operation *Operation, qp QueryParam, param *openapi3.Parameter) {
+	for _, f := range operation.Filters {
+		if f.GoName == qp.GoName {
+			// Keys only differing by their separators, keep the first one
+			return
+		}
+	}
+
+	if schema := param.Schema; schema != nil && schema.Value != nil && qp.Type == "string" && len(schema.Value.Enum) > 0 {
+		enumType := g.buildEnumType(operation.Name+"Filter"+qp.GoName, schema.Value)
+		if enumType.Description == "" {
+			enumType.Description = qp.Description
+		}
+		operation.FilterEnums = append(operation.FilterEnums, enumType)
+		qp.Type = enumType.Name
+	}
+
+	operation.Filters = append(operation.Filters, qp)
+}
+
 // getResponseType extracts the return type from a response
 // Returns (type, isPlainText)
 func (g *Generator) getResponseType(resp *openapi3.Response, doc *openapi3.T, path, method string) (string, bool) {
@@ -332,6 +657,63 @@ func (g *Generator) getResponseType(resp *openapi3.Response, doc *openapi3.T, pa
 	return "string", true
 }
 
+// responseContentType returns the content type of a non-JSON:API response.
+// JSON is preferred when several are listed, then text, so that the string variant stays usable.
+func responseContentType(resp *openapi3.Response) string {
+	contentTypes := make([]string, 0, len(resp.Content))
+	for contentType := range resp.Content {
+		contentTypes = append(contentTypes, contentType)
+	}
+	sort.Slice(contentTypes, func(i, j int) bool {
+		rank := func(contentType string) int {
+			switch {
+			case isJSONContentType(contentType):
+				return 0
+			case strings.HasPrefix(contentType, "text/"):
+				return 1
+			}
+			return 2
+		}
+		if ri, rj := rank(contentTypes[i]), rank(contentTypes[j]); ri != rj {
+			return ri < rj
+		}
+		return contentTypes[i] < contentTypes[j]
+	})
+	if len(contentTypes) == 0 {
+		return ""
+	}
+	return contentTypes[0]
+}
+
+// isJSONContentType reports whether the content type is JSON, e.g. "application/json" or "application/problem+json"
+func isJSONContentType(contentType string) bool {
+	return contentType == "application/json" || strings.HasSuffix(contentType, "+json")
+}
+
+// uploadContentType returns the content type of a request body which is not JSON,
+// such as application/octet-stream or multipart/form-data, or "" for JSON bodies
+func uploadContentType(reqBody *openapi3.RequestBody) string {
+	contentTypes := make([]string, 0, len(reqBody.Content))
+	for contentType := range reqBody.Content {
+		if contentType == "application/vnd.api+json" || isJSONContentType(contentType) {
+			return ""
+		}
+		contentTypes = append(contentTypes, contentType)
+	}
+	if len(contentTypes) == 0 {
+		return ""
+	}
+
+	// Prefer multipart forms, which carry the file name
+	sort.Strings(contentTypes)
+	for _, contentType := range contentTypes {
+		if contentType == "multipart/form-data" {
+			return contentType
+		}
+	}
+	return contentTypes[0]
+}
+
 // getRequestBodyType extracts the request body type from a request body
 // Returns (requestType, isRelationshipOp, usesPlainJSON)
 func (g *Generator) getRequestBodyType(reqBody *openapi3.RequestBody, doc *openapi3.T, resourceName, path, method string) (string, bool, bool) {
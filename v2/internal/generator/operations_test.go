@@ -1,10 +1,20 @@
 package generator
 
 import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"net/url"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
 	"github.com/getkin/kin-openapi/openapi3"
+	"golang.org/x/tools/imports"
+
+	"github.com/scalr/go-scalr/v2/internal/generator/static/client"
 )
 
 // TestGetHTTPMethod tests HTTP method extraction from operations
@@ -476,3 +486,916 @@ func TestMethodSorting(t *testing.T) {
 		t.Errorf("Expected 5 sorted operations, got %d", len(sortedNames))
 	}
 }
+
+// TestSparseFieldsets tests generating the typed fields[resource-type] parameter
+func TestSparseFieldsets(t *testing.T) {
+	g := &Generator{pkgName: "scalr"}
+
+	fieldsParam := &openapi3.Parameter{
+		Name:  "fields",
+		In:    "query",
+		Style: "deepObject",
+		Schema: &openapi3.SchemaRef{
+			Value: &openapi3.Schema{
+				Type: &openapi3.Types{"object"},
+				AdditionalProperties: openapi3.AdditionalProperties{
+					Schema: &openapi3.SchemaRef{Value: &openapi3.Schema{Type: &openapi3.Types{"string"}}},
+				},
+			},
+		},
+	}
+
+	qp := g.parseQueryParam(fieldsParam)
+	if !qp.IsFields {
+		t.Error("fields parameter should be detected as sparse fieldsets")
+	}
+	if qp.Type != "client.Fields" {
+		t.Errorf("Expected type %q, got %q", "client.Fields", qp.Type)
+	}
+
+	t.Run("generated code", func(t *testing.T) {
+		paths := openapi3.NewPaths()
+		paths.Set("/workspaces", &openapi3.PathItem{
+			Get: &openapi3.Operation{
+				OperationID: "get-workspaces",
+				Extensions:  map[string]interface{}{"x-resource": "Workspace"},
+				Parameters: openapi3.Parameters{
+					{Value: fieldsParam},
+					{Value: &openapi3.Parameter{Name: "include", In: "query"}},
+				},
+				Responses: openapi3.NewResponses(),
+			},
+		})
+		doc := &openapi3.T{
+			Paths:      paths,
+			Components: &openapi3.Components{Schemas: openapi3.Schemas{}},
+		}
+
+		outputDir := t.TempDir()
+		if err := g.generateOperations(doc, outputDir); err != nil {
+			t.Fatalf("generateOperations() error = %v", err)
+		}
+
+		content, err := os.ReadFile(filepath.Join(outputDir, "workspace", "workspace.gen.go"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		code := string(content)
+
+		if !strings.Contains(code, "opts.Fields.Encode(params)") {
+			t.Error("Expected fields to be encoded into the query parameters")
+		}
+		if !strings.Contains(code, "Fields client.Fields") {
+			t.Error("Expected a typed Fields option")
+		}
+		if strings.Contains(code, "skip for now") {
+			t.Error("Fields parameter should not be skipped")
+		}
+	})
+
+	t.Run("query string", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			fields   client.Fields
+			expected string
+		}{
+			{
+				name:     "no fields",
+				fields:   nil,
+				expected: "",
+			},
+			{
+				name:     "single resource type",
+				fields:   client.Fields{"workspaces": {"name", "auto-apply"}},
+				expected: "fields%5Bworkspaces%5D=name%2Cauto-apply",
+			},
+			{
+				name: "included resource types",
+				fields: client.Fields{}.
+					Set("workspaces", "name", "environment").
+					Set("environments", "name"),
+				expected: "fields%5Benvironments%5D=name&fields%5Bworkspaces%5D=name%2Cenvironment",
+			},
+			{
+				name:     "no fields of a resource type",
+				fields:   client.Fields{}.Set("environments"),
+				expected: "fields%5Benvironments%5D=",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				params := url.Values{}
+				tt.fields.Encode(params)
+				if got := params.Encode(); got != tt.expected {
+					t.Errorf("Expected query %q, got %q", tt.expected, got)
+				}
+			})
+		}
+	})
+}
+
+// TestTypedFilters tests generating typed filter structs from filter[...] parameters
+func TestTypedFilters(t *testing.T) {
+	g := &Generator{pkgName: "scalr"}
+
+	stringSchema := func(enum ...interface{}) *openapi3.SchemaRef {
+		return &openapi3.SchemaRef{Value: &openapi3.Schema{Type: &openapi3.Types{"string"}, Enum: enum}}
+	}
+
+	paths := openapi3.NewPaths()
+	paths.Set("/runs", &openapi3.PathItem{
+		Get: &openapi3.Operation{
+			OperationID: "get-runs",
+			Extensions:  map[string]interface{}{"x-resource": "Run"},
+			Parameters: openapi3.Parameters{
+				{Value: &openapi3.Parameter{Name: "filter[workspace]", In: "query", Description: "The ID of the workspace.", Schema: stringSchema()}},
+				{Value: &openapi3.Parameter{Name: "filter[status]", In: "query", Schema: stringSchema("planned", "applied")}},
+				{Value: &openapi3.Parameter{Name: "filter[is-dry]", In: "query", Schema: &openapi3.SchemaRef{Value: &openapi3.Schema{Type: &openapi3.Types{"boolean"}}}}},
+				{Value: &openapi3.Parameter{Name: "filter[source.count]", In: "query", Schema: &openapi3.SchemaRef{Value: &openapi3.Schema{Type: &openapi3.Types{"integer"}}}}},
+				{Value: &openapi3.Parameter{Name: "page[size]", In: "query"}},
+			},
+			Responses: openapi3.NewResponses(),
+		},
+	})
+	doc := &openapi3.T{
+		Paths:      paths,
+		Components: &openapi3.Components{Schemas: openapi3.Schemas{}},
+	}
+
+	op := g.parseOperation("/runs", "get", paths.Value("/runs").Get, doc, "Run")
+
+	expected := []struct{ goName, key, typ string }{
+		{"Workspace", "workspace", "string"},
+		{"Status", "status", "GetRunsFilterStatus"},
+		{"IsDry", "is-dry", "*bool"},
+		{"SourceCount", "source.count", "*int"},
+	}
+	if len(op.Filters) != len(expected) {
+		t.Fatalf("Expected %d filters, got %d", len(expected), len(op.Filters))
+	}
+	for i, want := range expected {
+		f := op.Filters[i]
+		if f.GoName != want.goName || f.FilterKey != want.key || f.Type != want.typ {
+			t.Errorf("Filter %d: expected %s %s (%s), got %s %s (%s)", i, want.goName, want.typ, want.key, f.GoName, f.Type, f.FilterKey)
+		}
+	}
+
+	if len(op.FilterEnums) != 1 || len(op.FilterEnums[0].Values) != 2 {
+		t.Fatalf("Expected one filter enum with 2 values, got %+v", op.FilterEnums)
+	}
+	if name := op.FilterEnums[0].Values[0].Name; name != "GetRunsFilterStatusPlanned" {
+		t.Errorf("Expected enum constant %q, got %q", "GetRunsFilterStatusPlanned", name)
+	}
+
+	outputDir := t.TempDir()
+	if err := g.generateOperations(doc, outputDir); err != nil {
+		t.Fatalf("generateOperations() error = %v", err)
+	}
+	path := filepath.Join(outputDir, "run", "run.gen.go")
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The generated code must be valid Go
+	formatted, err := imports.Process(path, content, nil)
+	if err != nil {
+		t.Fatalf("generated code is invalid: %v\n%s", err, content)
+	}
+	code := string(formatted)
+
+	for _, want := range []string{
+		"type GetRunsFilter struct {",
+		"\t// The ID of the workspace.\n\tWorkspace   string\n",
+		"type GetRunsFilterStatus string",
+		`GetRunsFilterStatusApplied GetRunsFilterStatus = "applied"`,
+		"Filters GetRunsFilter",
+		"Filter  map[string]string",
+		"opts.Filters.Encode(params)",
+		`params.Set("filter[status]", string(f.Status))`,
+		`params.Set("filter[is-dry]", fmt.Sprintf("%t", *f.IsDry))`,
+		`params.Set("filter[source.count]", fmt.Sprintf("%d", *f.SourceCount))`,
+	} {
+		if !strings.Contains(code, want) {
+			t.Errorf("Expected generated code to contain %q", want)
+		}
+	}
+
+	// Raw filters are applied after the typed ones, so they take precedence
+	if strings.Index(code, "opts.Filters.Encode(params)") > strings.Index(code, "range opts.Filter {") {
+		t.Error("Expected raw filters to be encoded after the typed ones")
+	}
+}
+
+// TestIncludes tests generating include path constants and resolving included resources
+func TestIncludes(t *testing.T) {
+	g := &Generator{pkgName: "scalr", typeToSchemaMap: map[string]string{
+		"workspaces":   "Workspace",
+		"environments": "Environment",
+		"accounts":     "Account",
+		"tags":         "Tag",
+	}}
+
+	relationship := func(resourceType string, toMany bool) *openapi3.SchemaRef {
+		identifier := &openapi3.SchemaRef{Value: &openapi3.Schema{
+			Type: &openapi3.Types{"object"},
+			Properties: openapi3.Schemas{
+				"type": {Value: &openapi3.Schema{Type: &openapi3.Types{"string"}, Enum: []interface{}{resourceType}}},
+				"id":   {Value: &openapi3.Schema{Type: &openapi3.Types{"string"}}},
+			},
+		}}
+		data := identifier
+		if toMany {
+			data = &openapi3.SchemaRef{Value: &openapi3.Schema{Type: &openapi3.Types{"array"}, Items: identifier}}
+		}
+		return &openapi3.SchemaRef{Value: &openapi3.Schema{
+			Type:       &openapi3.Types{"object"},
+			Properties: openapi3.Schemas{"data": data},
+		}}
+	}
+	resource := func(resourceType string, relationships openapi3.Schemas) *openapi3.SchemaRef {
+		properties := openapi3.Schemas{
+			"id":         {Value: &openapi3.Schema{Type: &openapi3.Types{"string"}}},
+			"type":       {Value: &openapi3.Schema{Type: &openapi3.Types{"string"}, Enum: []interface{}{resourceType}}},
+			"attributes": {Value: &openapi3.Schema{Type: &openapi3.Types{"object"}}},
+		}
+		if relationships != nil {
+			properties["relationships"] = &openapi3.SchemaRef{Value: &openapi3.Schema{
+				Type:       &openapi3.Types{"object"},
+				Properties: relationships,
+			}}
+		}
+		return &openapi3.SchemaRef{Value: &openapi3.Schema{Type: &openapi3.Types{"object"}, Properties: properties}}
+	}
+
+	responses := openapi3.NewResponses()
+	responses.Set("200", &openapi3.ResponseRef{Value: &openapi3.Response{
+		Content: openapi3.Content{
+			"application/vnd.api+json": &openapi3.MediaType{
+				Schema: &openapi3.SchemaRef{Ref: "#/components/schemas/WorkspaceListingDocument"},
+			},
+		},
+	}})
+
+	paths := openapi3.NewPaths()
+	paths.Set("/workspaces", &openapi3.PathItem{
+		Get: &openapi3.Operation{
+			OperationID: "get-workspaces",
+			Extensions:  map[string]interface{}{"x-resource": "Workspace"},
+			Parameters: openapi3.Parameters{
+				{Value: &openapi3.Parameter{Name: "include", In: "query"}},
+				{Value: &openapi3.Parameter{Name: "page[number]", In: "query"}},
+				{Value: &openapi3.Parameter{Name: "page[size]", In: "query"}},
+			},
+			Responses: responses,
+		},
+	})
+	doc := &openapi3.T{
+		Paths: paths,
+		Components: &openapi3.Components{Schemas: openapi3.Schemas{
+			"Workspace": resource("workspaces", openapi3.Schemas{
+				"environment": relationship("environments", false),
+				"tags":        relationship("tags", true),
+			}),
+			"Environment": resource("environments", openapi3.Schemas{
+				"account":    relationship("accounts", false),
+				"workspaces": relationship("workspaces", true),
+			}),
+			"Account": resource("accounts", openapi3.Schemas{
+				"environments": relationship("environments", true),
+			}),
+			"Tag":                      resource("tags", nil),
+			"WorkspaceListingDocument": {Value: &openapi3.Schema{Type: &openapi3.Types{"object"}}},
+		}},
+	}
+
+	op := g.parseOperation("/workspaces", "get", paths.Value("/workspaces").Get, doc, "Workspace")
+
+	expected := []Include{
+		{Name: "GetWorkspacesIncludeEnvironment", Path: "environment"},
+		{Name: "GetWorkspacesIncludeEnvironmentAccount", Path: "environment.account"},
+		{Name: "GetWorkspacesIncludeEnvironmentWorkspaces", Path: "environment.workspaces"},
+		{Name: "GetWorkspacesIncludeTags", Path: "tags"},
+	}
+	if len(op.Includes) != len(expected) {
+		t.Fatalf("Expected %d includes, got %+v", len(expected), op.Includes)
+	}
+	for i, want := range expected {
+		if op.Includes[i] != want {
+			t.Errorf("Include %d: expected %+v, got %+v", i, want, op.Includes[i])
+		}
+	}
+
+	outputDir := t.TempDir()
+	if err := g.generateOperations(doc, outputDir); err != nil {
+		t.Fatalf("generateOperations() error = %v", err)
+	}
+	path := filepath.Join(outputDir, "workspace", "workspace.gen.go")
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	formatted, err := imports.Process(path, content, nil)
+	if err != nil {
+		t.Fatalf("generated code is invalid: %v\n%s", err, content)
+	}
+	code := string(formatted)
+
+	for _, want := range []string{
+		`GetWorkspacesIncludeEnvironmentAccount    = "environment.account"`,
+		"Included []json.RawMessage `json:\"included\"`",
+		"included := client.NewIncluded(result.Included)",
+		"resources[i].ResolveIncludes(included)",
+		"items[i].ResolveIncludes(included)",
+		"it := c.GetWorkspacesPaged(ctx, opts)",
+		"Prefetch(c.httpClient.Prefetch()).",
+		`filters := client.HashOptions("GetWorkspaces", listOpts)`,
+		"FiltersHash(filters)",
+	} {
+		if !strings.Contains(code, want) {
+			t.Errorf("Expected generated code to contain %q", want)
+		}
+	}
+	if strings.Contains(code, "PopulateIncludes") {
+		t.Error("Expected included resources to be indexed once per response")
+	}
+
+	if err := g.generateSchemas(doc, outputDir); err != nil {
+		t.Fatalf("generateSchemas() error = %v", err)
+	}
+	schema, err := os.ReadFile(filepath.Join(outputDir, "workspace.gen.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		"func (r Workspace) Loaded() bool",
+		"func (r *Workspace) ResolveIncludes(included *client.Included)",
+		"client.Resolve[Environment](included, r.Environment.Type, r.Environment.ID)",
+		"client.Resolve[Tag](included, rel.Type, rel.ID)",
+		"stub: true,",
+	} {
+		if !strings.Contains(string(schema), want) {
+			t.Errorf("Expected generated schema to contain %q", want)
+		}
+	}
+}
+
+// TestStreamingDownloads tests generating streaming variants of non-JSON responses
+func TestStreamingDownloads(t *testing.T) {
+	g := &Generator{pkgName: "scalr"}
+
+	download := func(operationID string, contentTypes ...string) *openapi3.Operation {
+		content := openapi3.Content{}
+		for _, contentType := range contentTypes {
+			content[contentType] = &openapi3.MediaType{}
+		}
+		responses := openapi3.NewResponses()
+		responses.Set("200", &openapi3.ResponseRef{Value: &openapi3.Response{Content: content}})
+		return &openapi3.Operation{
+			OperationID: operationID,
+			Extensions:  map[string]interface{}{"x-resource": "ConfigurationVersion"},
+			Responses:   responses,
+		}
+	}
+
+	tests := []struct {
+		name        string
+		op          *openapi3.Operation
+		contentType string
+		streams     bool
+		binary      bool
+	}{
+		{"binary", download("download-configuration-version", "application/octet-stream"), "application/octet-stream", true, true},
+		{"text", download("get-log", "text/plain"), "text/plain", true, false},
+		{"text preferred", download("get-output", "application/gzip", "text/plain"), "text/plain", true, false},
+		{"plain JSON", download("get-metrics", "application/json", "text/plain"), "application/json", false, false},
+		{"undeclared", download("get-changelog"), "", true, false},
+	}
+
+	doc := &openapi3.T{Components: &openapi3.Components{Schemas: openapi3.Schemas{}}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			op := g.parseOperation("/configuration-versions/{configuration_version}/download", "get", tt.op, doc, "ConfigurationVersion")
+			if !op.ReturnsText {
+				t.Fatal("Expected a non-JSON:API response")
+			}
+			if op.ContentType != tt.contentType || op.Streams != tt.streams || op.IsBinary != tt.binary {
+				t.Errorf("Expected %q (streams: %t, binary: %t), got %q (streams: %t, binary: %t)",
+					tt.contentType, tt.streams, tt.binary, op.ContentType, op.Streams, op.IsBinary)
+			}
+		})
+	}
+
+	paths := openapi3.NewPaths()
+	paths.Set("/configuration-versions/{configuration_version}/download", &openapi3.PathItem{
+		Get: tests[0].op,
+	})
+	tests[0].op.Parameters = openapi3.Parameters{
+		{Value: &openapi3.Parameter{Name: "configuration_version", In: "path", Required: true}},
+	}
+	tests[0].op.Description = "Download tar.gz archive with terraform configuration templates."
+	doc.Paths = paths
+
+	outputDir := t.TempDir()
+	if err := g.generateOperations(doc, outputDir); err != nil {
+		t.Fatalf("generateOperations() error = %v", err)
+	}
+	path := filepath.Join(outputDir, "configuration_version", "configuration_version.gen.go")
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	formatted, err := imports.Process(path, content, nil)
+	if err != nil {
+		t.Fatalf("generated code is invalid: %v\n%s", err, content)
+	}
+	code := string(formatted)
+
+	for _, want := range []string{
+		"func (c *Client) DownloadConfigurationVersionStream(ctx context.Context, configurationVersion string) (io.ReadCloser, error) {",
+		"func (c *Client) DownloadConfigurationVersionTo(ctx context.Context, w io.Writer, configurationVersion string) (int64, error) {",
+		"return io.Copy(w, body)",
+		`c.httpClient.Get(ctx, path, map[string]string{"Accept": "application/octet-stream"})`,
+		"// Download tar.gz archive with terraform configuration templates.\n//\n// Deprecated: the application/octet-stream content is buffered in memory",
+	} {
+		if !strings.Contains(code, want) {
+			t.Errorf("Expected generated code to contain %q", want)
+		}
+	}
+}
+
+// TestUploads tests generating operations with octet-stream and multipart request bodies
+func TestUploads(t *testing.T) {
+	g := &Generator{pkgName: "scalr"}
+
+	upload := func(operationID string, contentTypes ...string) *openapi3.Operation {
+		content := openapi3.Content{}
+		for _, contentType := range contentTypes {
+			content[contentType] = &openapi3.MediaType{}
+		}
+		responses := openapi3.NewResponses()
+		responses.Set("204", &openapi3.ResponseRef{Value: &openapi3.Response{}})
+		return &openapi3.Operation{
+			OperationID: operationID,
+			Extensions:  map[string]interface{}{"x-resource": "ConfigurationVersion"},
+			Parameters: openapi3.Parameters{
+				{Value: &openapi3.Parameter{Name: "configuration_version", In: "path", Required: true}},
+			},
+			RequestBody: &openapi3.RequestBodyRef{Value: &openapi3.RequestBody{Content: content}},
+			Responses:   responses,
+		}
+	}
+
+	paths := openapi3.NewPaths()
+	paths.Set("/configuration-versions/{configuration_version}/upload", &openapi3.PathItem{
+		Put:  upload("upload-configuration-version", "application/octet-stream"),
+		Post: upload("upload-configuration-version-form", "multipart/form-data", "application/octet-stream"),
+	})
+	doc := &openapi3.T{
+		Paths:      paths,
+		Components: &openapi3.Components{Schemas: openapi3.Schemas{}},
+	}
+
+	raw := g.parseOperation("/configuration-versions/{configuration_version}/upload", "put", paths.Value("/configuration-versions/{configuration_version}/upload").Put, doc, "ConfigurationVersion")
+	if raw.RequestType != "io.Reader" || raw.UploadContentType != "application/octet-stream" || raw.IsMultipart {
+		t.Errorf("Expected an io.Reader octet-stream upload, got %s %q (multipart: %t)", raw.RequestType, raw.UploadContentType, raw.IsMultipart)
+	}
+	form := g.parseOperation("/configuration-versions/{configuration_version}/upload", "post", paths.Value("/configuration-versions/{configuration_version}/upload").Post, doc, "ConfigurationVersion")
+	if form.RequestType != "*client.Multipart" || !form.IsMultipart {
+		t.Errorf("Expected a multipart upload, got %s %q", form.RequestType, form.UploadContentType)
+	}
+
+	outputDir := t.TempDir()
+	if err := g.generateOperations(doc, outputDir); err != nil {
+		t.Fatalf("generateOperations() error = %v", err)
+	}
+	path := filepath.Join(outputDir, "configuration_version", "configuration_version.gen.go")
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	formatted, err := imports.Process(path, content, nil)
+	if err != nil {
+		t.Fatalf("generated code is invalid: %v\n%s", err, content)
+	}
+	code := string(formatted)
+
+	for _, want := range []string{
+		"func (c *Client) UploadConfigurationVersion(ctx context.Context, configurationVersion string, req io.Reader) error {",
+		`c.httpClient.Put(ctx, path, client.NewRawBody(req, "application/octet-stream"), nil)`,
+		"func (c *Client) UploadConfigurationVersionForm(ctx context.Context, configurationVersion string, req *client.Multipart) error {",
+		"c.httpClient.Post(ctx, path, req, nil)",
+	} {
+		if !strings.Contains(code, want) {
+			t.Errorf("Expected generated code to contain %q", want)
+		}
+	}
+}
+
+// TestResourceAPI tests generating the API interface and the fake of a resource client
+func TestResourceAPI(t *testing.T) {
+	g := &Generator{pkgName: "scalr"}
+
+	listResponses := openapi3.NewResponses()
+	listResponses.Set("200", &openapi3.ResponseRef{Value: &openapi3.Response{
+		Content: openapi3.Content{
+			"application/vnd.api+json": &openapi3.MediaType{
+				Schema: &openapi3.SchemaRef{Ref: "#/components/schemas/WorkspaceListingDocument"},
+			},
+		},
+	}})
+	logResponses := openapi3.NewResponses()
+	logResponses.Set("200", &openapi3.ResponseRef{Value: &openapi3.Response{
+		Content: openapi3.Content{"text/plain": &openapi3.MediaType{}},
+	}})
+	deleteResponses := openapi3.NewResponses()
+	deleteResponses.Set("204", &openapi3.ResponseRef{Value: &openapi3.Response{}})
+
+	paths := openapi3.NewPaths()
+	paths.Set("/workspaces", &openapi3.PathItem{
+		Get: &openapi3.Operation{
+			OperationID: "get-workspaces",
+			Extensions:  map[string]interface{}{"x-resource": "Workspace"},
+			Parameters: openapi3.Parameters{
+				{Value: &openapi3.Parameter{Name: "page[number]", In: "query"}},
+				{Value: &openapi3.Parameter{Name: "page[size]", In: "query"}},
+			},
+			Responses: listResponses,
+		},
+	})
+	paths.Set("/workspaces/{workspace}", &openapi3.PathItem{
+		Delete: &openapi3.Operation{
+			OperationID: "delete-workspace",
+			Extensions:  map[string]interface{}{"x-resource": "Workspace"},
+			Parameters: openapi3.Parameters{
+				{Value: &openapi3.Parameter{Name: "workspace", In: "path", Required: true}},
+			},
+			Responses: deleteResponses,
+		},
+	})
+	paths.Set("/workspaces/{workspace}/log", &openapi3.PathItem{
+		Get: &openapi3.Operation{
+			OperationID: "get-workspace-log",
+			Extensions:  map[string]interface{}{"x-resource": "Workspace"},
+			Parameters: openapi3.Parameters{
+				{Value: &openapi3.Parameter{Name: "workspace", In: "path", Required: true}},
+			},
+			Responses: logResponses,
+		},
+	})
+	doc := &openapi3.T{
+		Paths: paths,
+		Components: &openapi3.Components{Schemas: openapi3.Schemas{
+			"Workspace": {Value: &openapi3.Schema{Type: &openapi3.Types{"object"}}},
+		}},
+	}
+
+	outputDir := t.TempDir()
+	if err := g.generateOperations(doc, outputDir); err != nil {
+		t.Fatalf("generateOperations() error = %v", err)
+	}
+
+	// The interface lists every method of the client, with the same signature
+	signatures := func(path string, recv string) []string {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		formatted, err := imports.Process(path, content, nil)
+		if err != nil {
+			t.Fatalf("generated code is invalid: %v\n%s", err, content)
+		}
+		fset := token.NewFileSet()
+		file, err := parser.ParseFile(fset, path, formatted, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var sigs []string
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv == nil || types.ExprString(fn.Recv.List[0].Type) != recv {
+				continue
+			}
+			sigs = append(sigs, fn.Name.Name+types.ExprString(fn.Type)[len("func"):])
+		}
+		return sigs
+	}
+
+	dir := filepath.Join(outputDir, "workspace")
+	clientMethods := signatures(filepath.Join(dir, "workspace.gen.go"), "*Client")
+	fakeMethods := signatures(filepath.Join(dir, "workspace_api.gen.go"), "*Fake")
+
+	expected := []string{
+		"DeleteWorkspaceRaw", "DeleteWorkspace",
+		"GetWorkspaceLogRaw", "GetWorkspaceLog", "GetWorkspaceLogStream", "GetWorkspaceLogTo",
+		"GetWorkspacesRaw", "GetWorkspaces", "GetWorkspacesIter", "GetWorkspacesPaged",
+	}
+	if len(clientMethods) != len(expected) {
+		t.Fatalf("Expected %d client methods, got %v", len(expected), clientMethods)
+	}
+	for i, name := range expected {
+		if !strings.HasPrefix(clientMethods[i], name+"(") {
+			t.Errorf("Method %d: expected %s, got %s", i, name, clientMethods[i])
+		}
+	}
+	if strings.Join(fakeMethods, "\n") != strings.Join(clientMethods, "\n") {
+		t.Errorf("Fake methods differ from the client methods:\n%s\n\n%s", strings.Join(fakeMethods, "\n"), strings.Join(clientMethods, "\n"))
+	}
+
+	content, err := os.ReadFile(filepath.Join(dir, "workspace_api.gen.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		"type WorkspaceAPI interface {",
+		"var _ WorkspaceAPI = (*Client)(nil)",
+		"var _ WorkspaceAPI = (*Fake)(nil)",
+		`panic("workspace.Fake: DeleteWorkspace is not implemented")`,
+		// The example sets the first method returning the decoded response
+		"//\t\tDeleteWorkspaceFunc: func(ctx context.Context, workspace string) error {",
+	} {
+		if !strings.Contains(string(content), want) {
+			t.Errorf("Expected the API file to contain %q", want)
+		}
+	}
+}
+
+// TestOperationMethods tests the kinds and signatures of the methods generated for an operation
+func TestOperationMethods(t *testing.T) {
+	pagination := []QueryParam{{Name: "page[number]", IsPagination: true}}
+
+	tests := []struct {
+		name       string
+		operation  Operation
+		signatures map[MethodKind]string
+	}{
+		{
+			name: "plain operation",
+			operation: Operation{
+				Name:           "DeleteWorkspace",
+				PathParameters: []Parameter{{GoName: "workspace", Type: "string"}},
+			},
+			signatures: map[MethodKind]string{
+				MethodRaw:  "DeleteWorkspaceRaw(ctx context.Context, workspace string) (*client.Response, error)",
+				MethodCall: "DeleteWorkspace(ctx context.Context, workspace string) error",
+			},
+		},
+		{
+			name: "streamed download",
+			operation: Operation{
+				Name:           "GetRunLog",
+				PathParameters: []Parameter{{GoName: "run", Type: "string"}},
+				QueryParams:    []QueryParam{{Name: "clean"}},
+				ReturnsData:    true,
+				Returns:        "string",
+				Streams:        true,
+			},
+			signatures: map[MethodKind]string{
+				MethodRaw:    "GetRunLogRaw(ctx context.Context, run string, opts *GetRunLogOptions) (*client.Response, error)",
+				MethodCall:   "GetRunLog(ctx context.Context, run string, opts *GetRunLogOptions) (string, error)",
+				MethodStream: "GetRunLogStream(ctx context.Context, run string, opts *GetRunLogOptions) (io.ReadCloser, error)",
+				MethodTo:     "GetRunLogTo(ctx context.Context, w io.Writer, run string, opts *GetRunLogOptions) (int64, error)",
+			},
+		},
+		{
+			name: "paginated list",
+			operation: Operation{
+				Name:        "GetWorkspaces",
+				QueryParams: pagination,
+				IsList:      true,
+				ReturnsData: true,
+				Returns:     "[]*schemas.Workspace",
+			},
+			signatures: map[MethodKind]string{
+				MethodRaw:   "GetWorkspacesRaw(ctx context.Context, opts *GetWorkspacesOptions) (*client.Response, error)",
+				MethodCall:  "GetWorkspaces(ctx context.Context, opts *GetWorkspacesOptions) ([]*schemas.Workspace, error)",
+				MethodIter:  "GetWorkspacesIter(ctx context.Context, opts *GetWorkspacesOptions) iter.Seq2[schemas.Workspace, error]",
+				MethodPaged: "GetWorkspacesPaged(ctx context.Context, opts *GetWorkspacesOptions) *client.Iterator[schemas.Workspace]",
+			},
+		},
+		{
+			name: "list of untyped items",
+			operation: Operation{
+				Name:        "GetTags",
+				QueryParams: pagination,
+				IsList:      true,
+				ReturnsData: true,
+				Returns:     "[]interface{}",
+			},
+			signatures: map[MethodKind]string{
+				MethodRaw:  "GetTagsRaw(ctx context.Context, opts *GetTagsOptions) (*client.Response, error)",
+				MethodCall: "GetTags(ctx context.Context, opts *GetTagsOptions) ([]interface{}, error)",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.operation.Methods = operationMethods(tt.operation)
+
+			if len(tt.operation.Methods) != len(tt.signatures) {
+				t.Fatalf("Expected %d methods, got %v", len(tt.signatures), tt.operation.Methods)
+			}
+			for kind, want := range tt.signatures {
+				m := tt.operation.MethodOf(kind)
+				if m == nil {
+					t.Errorf("Expected a %s method", kind)
+					continue
+				}
+				if got := m.Signature(); got != want {
+					t.Errorf("%s method: expected %q, got %q", kind, want, got)
+				}
+			}
+		})
+	}
+}
+
+// TestRequestValidation tests generating Validate methods from the constraints of the spec
+func TestRequestValidation(t *testing.T) {
+	g := &Generator{pkgName: "scalr", typeToSchemaMap: map[string]string{"environments": "Environment"}}
+
+	maxLength := uint64(64)
+	pageSizeMax := 100.0
+	pageSizeMin := 1.0
+	stringSchema := func(schema openapi3.Schema) *openapi3.SchemaRef {
+		schema.Type = &openapi3.Types{"string"}
+		return &openapi3.SchemaRef{Value: &schema}
+	}
+
+	workspace := &openapi3.Schema{
+		Type: &openapi3.Types{"object"},
+		Properties: openapi3.Schemas{
+			"id":   stringSchema(openapi3.Schema{}),
+			"type": stringSchema(openapi3.Schema{Enum: []interface{}{"workspaces"}}),
+			"attributes": {Value: &openapi3.Schema{
+				Type:     &openapi3.Types{"object"},
+				Required: []string{"name", "created-at"},
+				Properties: openapi3.Schemas{
+					"name":           stringSchema(openapi3.Schema{Pattern: "^[a-z0-9-]+$", MinLength: 3, MaxLength: &maxLength}),
+					"execution-mode": stringSchema(openapi3.Schema{Enum: []interface{}{"remote", "local"}}),
+					"created-at":     stringSchema(openapi3.Schema{ReadOnly: true}),
+					"vcs-repo": {Value: &openapi3.Schema{
+						Type:     &openapi3.Types{"object"},
+						Required: []string{"identifier"},
+						Properties: openapi3.Schemas{
+							"identifier":   stringSchema(openapi3.Schema{}),
+							"trigger-type": stringSchema(openapi3.Schema{Enum: []interface{}{"default", "git-tag"}}),
+						},
+					}},
+				},
+			}},
+			"relationships": {Value: &openapi3.Schema{
+				Type:     &openapi3.Types{"object"},
+				Required: []string{"environment"},
+				Properties: openapi3.Schemas{
+					"environment": {Value: &openapi3.Schema{
+						Type: &openapi3.Types{"object"},
+						Properties: openapi3.Schemas{
+							"data": {Value: &openapi3.Schema{
+								Type: &openapi3.Types{"object"},
+								Properties: openapi3.Schemas{
+									"type": stringSchema(openapi3.Schema{Enum: []interface{}{"environments"}}),
+									"id":   stringSchema(openapi3.Schema{}),
+								},
+							}},
+						},
+					}},
+				},
+			}},
+		},
+	}
+
+	body := &openapi3.RequestBodyRef{Value: &openapi3.RequestBody{Content: openapi3.Content{
+		"application/vnd.api+json": &openapi3.MediaType{
+			Schema: &openapi3.SchemaRef{Ref: "#/components/schemas/WorkspaceDocument"},
+		},
+	}}}
+	responses := openapi3.NewResponses()
+	responses.Set("204", &openapi3.ResponseRef{Value: &openapi3.Response{}})
+
+	paths := openapi3.NewPaths()
+	paths.Set("/workspaces", &openapi3.PathItem{
+		Get: &openapi3.Operation{
+			OperationID: "get-workspaces",
+			Extensions:  map[string]interface{}{"x-resource": "Workspace"},
+			Parameters: openapi3.Parameters{
+				{Value: &openapi3.Parameter{Name: "query", In: "query", Schema: stringSchema(openapi3.Schema{MaxLength: &maxLength})}},
+				{Value: &openapi3.Parameter{Name: "page[size]", In: "query", Schema: &openapi3.SchemaRef{Value: &openapi3.Schema{Type: &openapi3.Types{"integer"}, Min: &pageSizeMin, Max: &pageSizeMax}}}},
+				{Value: &openapi3.Parameter{Name: "filter[execution-mode]", In: "query", Schema: stringSchema(openapi3.Schema{Enum: []interface{}{"remote", "local"}})}},
+			},
+			Responses: responses,
+		},
+		Post: &openapi3.Operation{
+			OperationID: "create-workspace",
+			Extensions:  map[string]interface{}{"x-resource": "Workspace"},
+			RequestBody: body,
+			Responses:   responses,
+		},
+	})
+	paths.Set("/workspaces/{workspace}", &openapi3.PathItem{
+		Patch: &openapi3.Operation{
+			OperationID: "update-workspace",
+			Extensions:  map[string]interface{}{"x-resource": "Workspace"},
+			Parameters: openapi3.Parameters{
+				{Value: &openapi3.Parameter{Name: "workspace", In: "path", Required: true}},
+			},
+			RequestBody: body,
+			Responses:   responses,
+		},
+	})
+	doc := &openapi3.T{
+		Paths: paths,
+		Components: &openapi3.Components{Schemas: openapi3.Schemas{
+			"Workspace": {Value: workspace},
+			"Environment": {Value: &openapi3.Schema{
+				Type: &openapi3.Types{"object"},
+				Properties: openapi3.Schemas{
+					"type":       stringSchema(openapi3.Schema{Enum: []interface{}{"environments"}}),
+					"attributes": {Value: &openapi3.Schema{Type: &openapi3.Types{"object"}}},
+				},
+			}},
+		}},
+	}
+
+	list := g.parseOperation("/workspaces", "get", paths.Value("/workspaces").Get, doc, "Workspace")
+	if v := list.QueryParams[1].Validation; v.Pointer != "page[size]" || v.Minimum != "1" || v.Maximum != "100" {
+		t.Errorf("Expected page[size] to be checked between 1 and 100, got %+v", v)
+	}
+	create := g.parseOperation("/workspaces", "post", paths.Value("/workspaces").Post, doc, "Workspace")
+	if !create.ValidatesRequest {
+		t.Errorf("Expected the %s request to be validated", create.RequestType)
+	}
+
+	data := g.buildSchemaData("Workspace", workspace, map[string]bool{"Workspace": true})
+	attrs := map[string]Validation{}
+	for _, attr := range data.Attributes {
+		attrs[attr.JSONName] = attr.Validation
+	}
+	if v := attrs["name"]; !v.Required || v.MinLength != "3" || v.MaxLength != "64" || v.Pattern != "workspaceAttributesNamePattern" {
+		t.Errorf("Unexpected constraints of name: %+v", v)
+	}
+	if v := attrs["created-at"]; v.Required {
+		t.Error("Expected a read-only attribute not to be required")
+	}
+	if v := attrs["execution-mode"]; !v.Enum {
+		t.Errorf("Expected execution-mode to be checked as an enum, got %+v", v)
+	}
+	if v := attrs["vcs-repo"]; !v.Nested {
+		t.Errorf("Expected vcs-repo to be checked as a nested struct, got %+v", v)
+	}
+
+	outputDir := t.TempDir()
+	if err := g.generateOperations(doc, outputDir); err != nil {
+		t.Fatalf("generateOperations() error = %v", err)
+	}
+	if err := g.generateSchemas(doc, outputDir); err != nil {
+		t.Fatalf("generateSchemas() error = %v", err)
+	}
+
+	for path, wants := range map[string][]string{
+		filepath.Join(outputDir, "workspace", "workspace.gen.go"): {
+			"if c.httpClient.ValidatesRequests() {",
+			"if err := req.Validate(); err != nil {",
+			"if err := req.ValidatePartial(); err != nil {",
+			"if err := opts.Validate(); err != nil {",
+			"func (o *GetWorkspacesOptions) Validate() error {",
+			`v.MaxLength("query", val, 64)`,
+			`v.Maximum("page[size]", float64(val), 100, false)`,
+			`client.Filter(&v, "filter[execution-mode]", o.Filters.ExecutionMode, GetWorkspacesFilterExecutionMode.IsValid)`,
+		},
+		filepath.Join(outputDir, "workspace.gen.go"): {
+			`workspaceAttributesNamePattern = regexp.MustCompile("^[a-z0-9-]+$")`,
+			"func (e WorkspaceExecutionMode) IsValid() bool {",
+			"func (r WorkspaceRequest) Validate() error {",
+			`r.Attributes.validate(&v, "/data/attributes", partial)`,
+			`v.Required(pointer+"/name", r.Name.IsSet())`,
+			`v.Pattern(pointer+"/name", val, workspaceAttributesNamePattern)`,
+			`v.Enum(pointer+"/execution-mode", val, val.IsValid())`,
+			`val.validate(v, pointer+"/vcs-repo")`,
+			`v.Required(pointer+"/identifier", r.Identifier.IsSet())`,
+			`v.OneOf(pointer+"/trigger-type", val, "default", "git-tag")`,
+			`v.Required(pointer+"/environment", r.Environment.IsSet())`,
+		},
+	} {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		formatted, err := imports.Process(path, content, nil)
+		if err != nil {
+			t.Fatalf("generated code is invalid: %v\n%s", err, content)
+		}
+		for _, want := range wants {
+			if !strings.Contains(string(formatted), want) {
+				t.Errorf("Expected %s to contain %q", filepath.Base(path), want)
+			}
+		}
+		if strings.Contains(string(formatted), "created-at\"") && strings.Contains(string(formatted), `pointer+"/created-at"`) {
+			t.Error("Expected read-only attributes not to be validated")
+		}
+	}
+}
@@ -4,9 +4,13 @@ import (
 	"bytes"
 	_ "embed"
 	"fmt"
+	"log"
 	"os"
 	"path/filepath"
+	"regexp"
+	"slices"
 	"sort"
+	"strconv"
 	"strings"
 	"text/template"
 
@@ -36,45 +40,7 @@ func (g *Generator) generateSchemas(doc *openapi3.T, outputDir string) error {
 		topLevelSchemas[name] = true
 	}
 
-	// Collect canonical resource names from x-resource in operations
-	// These are the "main" schemas for each resource
-	canonicalResources := make(map[string]bool)
-	for _, pathItem := range doc.Paths.Map() {
-		for _, op := range pathItem.Operations() {
-			if op == nil {
-				continue
-			}
-			if resource := getResourceName(op); resource != "" {
-				canonicalResources[resource] = true
-			}
-		}
-	}
-
-	// Build mapping of JSON:API type -> Schema name
-	// This is used for relationships to find the correct Go type
-	// When multiple schemas share the same JSON:API type, prefer the one referenced in x-resource
-	g.typeToSchemaMap = make(map[string]string)
-	for name, schemaRef := range doc.Components.Schemas {
-		if schemaRef.Value == nil || !isResourceSchema(schemaRef.Value) {
-			continue
-		}
-
-		// Extract the JSON:API type from the schema's type field
-		typeName := extractTypeName(schemaRef.Value)
-		if typeName != "" {
-			// If type is already mapped, prefer the canonical resource (from x-resource)
-			if existing, ok := g.typeToSchemaMap[typeName]; ok {
-				// Replace if current schema is canonical and existing is not
-				if canonicalResources[name] && !canonicalResources[existing] {
-					g.typeToSchemaMap[typeName] = name
-				}
-				// Keep existing if it's canonical or both/neither are canonical
-			} else {
-				// First time seeing this type
-				g.typeToSchemaMap[typeName] = name
-			}
-		}
-	}
+	g.buildTypeToSchemaMap(doc)
 
 	// Collect schema names used in request bodies
 	requestBodySchemas := make(map[string]bool)
@@ -183,6 +149,50 @@ func (g *Generator) generateSchemas(doc *openapi3.T, outputDir string) error {
 	return nil
 }
 
+// buildTypeToSchemaMap builds the mapping of JSON:API types to schema names, used for
+// relationships to find the correct Go type
+func (g *Generator) buildTypeToSchemaMap(doc *openapi3.T) {
+	// Collect canonical resource names from x-resource in operations
+	// These are the "main" schemas for each resource
+	canonicalResources := make(map[string]bool)
+	for _, pathItem := range doc.Paths.Map() {
+		for _, op := range pathItem.Operations() {
+			if op == nil {
+				continue
+			}
+			if resource := getResourceName(op); resource != "" {
+				canonicalResources[resource] = true
+			}
+		}
+	}
+
+	// Build mapping of JSON:API type -> Schema name
+	// This is used for relationships to find the correct Go type
+	// When multiple schemas share the same JSON:API type, prefer the one referenced in x-resource
+	g.typeToSchemaMap = make(map[string]string)
+	for name, schemaRef := range doc.Components.Schemas {
+		if schemaRef.Value == nil || !isResourceSchema(schemaRef.Value) {
+			continue
+		}
+
+		// Extract the JSON:API type from the schema's type field
+		typeName := extractTypeName(schemaRef.Value)
+		if typeName != "" {
+			// If type is already mapped, prefer the canonical resource (from x-resource)
+			if existing, ok := g.typeToSchemaMap[typeName]; ok {
+				// Replace if current schema is canonical and existing is not
+				if canonicalResources[name] && !canonicalResources[existing] {
+					g.typeToSchemaMap[typeName] = name
+				}
+				// Keep existing if it's canonical or both/neither are canonical
+			} else {
+				// First time seeing this type
+				g.typeToSchemaMap[typeName] = name
+			}
+		}
+	}
+}
+
 // isResourceSchema checks if a schema is a JSON:API resource
 func isResourceSchema(schema *openapi3.Schema) bool {
 	if schema.Properties == nil {
@@ -226,6 +236,15 @@ type SchemaData struct {
 	NestedStructs        []NestedStruct
 	RequestNestedStructs []NestedStruct
 	EnumTypes            []EnumType
+	Unions               []UnionType   // oneOf/anyOf types of the attributes
+	Fields               []SchemaField // Fields selectable with sparse fieldsets
+	Patterns             []Validation  // Validations with a pattern, compiled once per package
+}
+
+// SchemaField represents a field name of a resource, used in sparse fieldsets
+type SchemaField struct {
+	Name     string
+	JSONName string
 }
 
 // EnumType represents an enum type definition
@@ -251,6 +270,7 @@ type Attribute struct {
 	RequestType  string // Type for request structs (Value wrapped)
 	Description  string
 	ReadOnly     bool
+	Validation   Validation
 }
 
 // NestedStruct represents a nested object structure within attributes
@@ -267,6 +287,44 @@ type NestedField struct {
 	Type        string
 	Description string
 	ReadOnly    bool
+	Validation  Validation
+}
+
+// UnionType represents a oneOf/anyOf schema, a struct holding one of its variants
+type UnionType struct {
+	Name          string
+	Description   string
+	Discriminator string // Property selecting the variant, if every variant has its values
+	Variants      []UnionVariant
+}
+
+// UnionVariant represents a variant of a union type
+type UnionVariant struct {
+	Name   string   // Field name, e.g. "Vcs"
+	Type   string   // Go type of the variant, held by pointer
+	Kind   string   // JSON kind of the variant ("object", "string", ...), empty if any
+	Values []string // Discriminator values selecting the variant
+}
+
+// addNestedStruct adds a nested struct unless it is already declared: the response and
+// request versions of an object declare the same types for their fields
+func (d *SchemaData) addNestedStruct(nested NestedStruct) {
+	for _, s := range d.NestedStructs {
+		if s.Name == nested.Name {
+			return
+		}
+	}
+	d.NestedStructs = append(d.NestedStructs, nested)
+}
+
+// addUnion adds a union type unless it is already declared
+func (d *SchemaData) addUnion(union UnionType) {
+	for _, u := range d.Unions {
+		if u.Name == union.Name {
+			return
+		}
+	}
+	d.Unions = append(d.Unions, union)
 }
 
 // Relationship represents a schema relationship
@@ -277,6 +335,33 @@ type Relationship struct {
 	Description string
 	ToMany      bool
 	ReadOnly    bool // If true, only include in response, not in request
+	Validation  Validation
+}
+
+// Validation holds the constraints of a request field from the spec, checked before sending
+type Validation struct {
+	Field            string   // Go field name
+	Pointer          string   // JSON pointer token of the field, e.g. "auto-apply"
+	Required         bool     // Required and not read-only
+	Partial          bool     // The required check is skipped when updating a resource
+	Enum             bool     // The value is an enum type, checked with IsValid
+	EnumSlice        bool     // The value is a slice of an enum type
+	OneOf            []string // Allowed values of a plain string
+	Nested           bool     // The value is a nested request struct with its own checks
+	Pattern          string   // Name of the compiled pattern variable
+	PatternExpr      string
+	MinLength        string
+	MaxLength        string
+	Minimum          string
+	Maximum          string
+	ExclusiveMinimum bool
+	ExclusiveMaximum bool
+}
+
+// HasValueChecks reports whether the value of the field is checked, when it is set
+func (v Validation) HasValueChecks() bool {
+	return v.Enum || v.EnumSlice || len(v.OneOf) > 0 || v.Nested || v.Pattern != "" ||
+		v.MinLength != "" || v.MaxLength != "" || v.Minimum != "" || v.Maximum != ""
 }
 
 // buildSchemaData builds template data from OpenAPI schema
@@ -297,6 +382,7 @@ func (g *Generator) buildSchemaData(name string, schema *openapi3.Schema, topLev
 
 			// Check if this is a nested object that should be a struct
 			var responseType, requestType string
+			var nested, enum, enumSlice bool
 			if attrRef.Value.Type.Is("object") && attrRef.Value.Properties != nil && len(attrRef.Value.Properties) > 0 {
 				// Create nested structs for this object (both response and request versions)
 				baseStructName := name + strcase.ToCamel(attrName)
@@ -307,8 +393,8 @@ func (g *Generator) buildSchemaData(name string, schema *openapi3.Schema, topLev
 				}
 
 				// Response version (plain types)
-				responseNested := g.buildNestedStruct(baseStructName, attrRef.Value, false)
-				data.NestedStructs = append(data.NestedStructs, responseNested)
+				responseNested := g.buildNestedStruct(baseStructName, attrRef.Value, false, &data)
+				data.addNestedStruct(responseNested)
 
 				// Add pointer if nullable for response type
 				if attrRef.Value.Nullable {
@@ -319,12 +405,13 @@ func (g *Generator) buildSchemaData(name string, schema *openapi3.Schema, topLev
 
 				// Request version (Value types)
 				requestStructName := baseStructName + "Request"
-				requestNested := g.buildNestedStruct(requestStructName, attrRef.Value, true)
+				requestNested := g.buildNestedStruct(baseStructName, attrRef.Value, true, &data)
 				data.RequestNestedStructs = append(data.RequestNestedStructs, requestNested)
 
 				// Request type: value.Value handles null, so inner type doesn't need pointer
 				// value.Value already provides tri-state: unset/null/set
 				requestType = "*value.Value[" + requestStructName + "]"
+				nested = true
 			} else if attrRef.Value.Type.Is("array") && attrRef.Value.Items != nil && attrRef.Value.Items.Value != nil && len(attrRef.Value.Items.Value.Enum) > 0 {
 				// Array of enum
 				enumTypeName := name + strcase.ToCamel(attrName)
@@ -337,6 +424,7 @@ func (g *Generator) buildSchemaData(name string, schema *openapi3.Schema, topLev
 					responseType = sliceType
 				}
 				requestType = "*value.Value[[]" + enumTypeName + "]"
+				enumSlice = true
 			} else {
 				// Check if this is a scalar enum field
 				var enumTypeName string
@@ -355,15 +443,15 @@ func (g *Generator) buildSchemaData(name string, schema *openapi3.Schema, topLev
 						responseType = enumTypeName
 					}
 					requestType = "*value.Value[" + enumTypeName + "]"
+					enum = true
 				} else {
-					// Use standard type
-					responseType = g.schemaToGoType(attrRef.Value)
+					// Use standard type, declaring the unions and the structs of arrays and maps
+					typeName := name + strcase.ToCamel(attrName)
+					responseType = g.buildGoType(typeName, attrRef.Value, &data)
 
 					// For request, get base type without nullable pointer
-					// We need to call schemaToGoType with nullable=false to get base type
-					baseAttrSchema := *attrRef.Value
-					baseAttrSchema.Nullable = false
-					baseType := g.schemaToGoType(&baseAttrSchema)
+					// We need to call buildGoType without null to get base type
+					baseType := g.buildGoType(typeName, withoutNull(attrRef.Value), &data)
 
 					requestType = "*value.Value[" + baseType + "]"
 				}
@@ -378,6 +466,14 @@ func (g *Generator) buildSchemaData(name string, schema *openapi3.Schema, topLev
 				ReadOnly:     attrRef.Value.ReadOnly,
 			}
 
+			// Constraints checked before sending, required ones only when creating a resource
+			required := slices.Contains(attrSchema.Value.Required, attrName)
+			attr.Validation = buildValidation(name+"Attributes", attr.Name, attrName, requestValueType(requestType), attrRef.Value, required)
+			attr.Validation.Partial = true
+			attr.Validation.Nested = nested
+			attr.Validation.Enum = enum
+			attr.Validation.EnumSlice = enumSlice
+
 			data.Attributes = append(data.Attributes, attr)
 		}
 
@@ -398,6 +494,9 @@ func (g *Generator) buildSchemaData(name string, schema *openapi3.Schema, topLev
 		sort.Slice(data.RequestNestedStructs, func(i, j int) bool {
 			return data.RequestNestedStructs[i].Name < data.RequestNestedStructs[j].Name
 		})
+		sort.Slice(data.Unions, func(i, j int) bool {
+			return data.Unions[i].Name < data.Unions[j].Name
+		})
 	}
 
 	// Process relationships
@@ -418,6 +517,10 @@ func (g *Generator) buildSchemaData(name string, schema *openapi3.Schema, topLev
 			if relRef.Value.ReadOnly {
 				rel.ReadOnly = true
 			}
+
+			required := slices.Contains(relSchema.Value.Required, relName)
+			rel.Validation = buildValidation(name+"Relationships", rel.Name, relName, "", relRef.Value, required)
+			rel.Validation.Partial = true
 			data.Relationships = append(data.Relationships, rel)
 		}
 
@@ -427,9 +530,50 @@ func (g *Generator) buildSchemaData(name string, schema *openapi3.Schema, topLev
 		})
 	}
 
+	data.Fields = buildSchemaFields(data.Attributes, data.Relationships)
+
+	// Collect the patterns of the request fields
+	for _, attr := range data.Attributes {
+		if !attr.ReadOnly && attr.Validation.Pattern != "" {
+			data.Patterns = append(data.Patterns, attr.Validation)
+		}
+	}
+	for _, nested := range data.RequestNestedStructs {
+		for _, field := range nested.Fields {
+			if !field.ReadOnly && field.Validation.Pattern != "" {
+				data.Patterns = append(data.Patterns, field.Validation)
+			}
+		}
+	}
+
 	return data
 }
 
+// buildSchemaFields lists the fields of a resource which can be selected with
+// sparse fieldsets: its attributes and relationships, sorted by name
+func buildSchemaFields(attributes []Attribute, relationships []Relationship) []SchemaField {
+	seen := make(map[string]bool)
+	var fields []SchemaField
+	add := func(name, jsonName string) {
+		if seen[jsonName] {
+			return
+		}
+		seen[jsonName] = true
+		fields = append(fields, SchemaField{Name: name, JSONName: jsonName})
+	}
+	for _, attr := range attributes {
+		add(attr.Name, attr.JSONName)
+	}
+	for _, rel := range relationships {
+		add(rel.Name, rel.JSONName)
+	}
+
+	sort.Slice(fields, func(i, j int) bool {
+		return fields[i].Name < fields[j].Name
+	})
+	return fields
+}
+
 // buildEnumType creates an enum type definition from a schema with enum values
 func (g *Generator) buildEnumType(typeName string, schema *openapi3.Schema) EnumType {
 	enumType := EnumType{
@@ -469,13 +613,19 @@ func (g *Generator) buildEnumType(typeName string, schema *openapi3.Schema) Enum
 }
 
 // buildNestedStruct creates a nested struct definition for an object attribute
-// If useValue is true, all fields will be wrapped in value.Value (for request structs)
+// If useValue is true, all fields will be wrapped in value.Value (for request structs),
+// and the struct name gets a Request suffix
 // If useValue is false, fields will use plain types (for response structs)
-func (g *Generator) buildNestedStruct(name string, schema *openapi3.Schema, useValue bool) NestedStruct {
+// The types of the fields, such as nested objects and unions, are declared in data
+// and shared by both versions.
+func (g *Generator) buildNestedStruct(name string, schema *openapi3.Schema, useValue bool, data *SchemaData) NestedStruct {
 	nested := NestedStruct{
 		Name:        name,
 		Description: cleanDescription(schema.Description),
 	}
+	if useValue {
+		nested.Name = name + "Request"
+	}
 
 	// Process all properties of the nested object
 	for fieldName, fieldRef := range schema.Properties {
@@ -484,16 +634,15 @@ func (g *Generator) buildNestedStruct(name string, schema *openapi3.Schema, useV
 		}
 
 		var fieldType string
+		typeName := name + strcase.ToCamel(fieldName)
 
 		if useValue {
 			// For request structs: value.Value handles null, so get base type without nullable pointer
-			baseFieldSchema := *fieldRef.Value
-			baseFieldSchema.Nullable = false
-			baseType := g.schemaToGoType(&baseFieldSchema)
+			baseType := g.buildGoType(typeName, withoutNull(fieldRef.Value), data)
 			fieldType = "*value.Value[" + baseType + "]"
 		} else {
 			// For response structs: include nullable pointer if needed
-			fieldType = g.schemaToGoType(fieldRef.Value)
+			fieldType = g.buildGoType(typeName, fieldRef.Value, data)
 		}
 
 		field := NestedField{
@@ -503,6 +652,10 @@ func (g *Generator) buildNestedStruct(name string, schema *openapi3.Schema, useV
 			Description: cleanDescription(fieldRef.Value.Description),
 			ReadOnly:    fieldRef.Value.ReadOnly,
 		}
+		if useValue {
+			required := slices.Contains(schema.Required, fieldName)
+			field.Validation = buildValidation(nested.Name, field.Name, fieldName, requestValueType(fieldType), fieldRef.Value, required)
+		}
 
 		nested.Fields = append(nested.Fields, field)
 	}
@@ -569,6 +722,55 @@ func (g *Generator) parseRelationship(name string, schema *openapi3.Schema) Rela
 	return rel
 }
 
+// buildValidation collects the constraints of a request field from its schema.
+// String and number constraints only apply to plain string and number types,
+// the caller marks enum and nested struct types, checked with their own methods.
+func buildValidation(structName, fieldName, jsonName, goType string, schema *openapi3.Schema, required bool) Validation {
+	v := Validation{
+		Field:    fieldName,
+		Pointer:  strings.NewReplacer("~", "~0", "/", "~1").Replace(jsonName),
+		Required: required && !schema.ReadOnly,
+	}
+
+	switch goType {
+	case "string":
+		for _, value := range schema.Enum {
+			v.OneOf = append(v.OneOf, fmt.Sprintf("%v", value))
+		}
+		if schema.Pattern != "" {
+			// Patterns are ECMA-262 regular expressions, skip those which Go does not support
+			if _, err := regexp.Compile(schema.Pattern); err == nil {
+				v.Pattern = strcase.ToLowerCamel(structName+fieldName) + "Pattern"
+				v.PatternExpr = schema.Pattern
+			} else {
+				log.Printf("Skipping the pattern of %s.%s: %v", structName, fieldName, err)
+			}
+		}
+		if schema.MinLength > 0 {
+			v.MinLength = strconv.FormatUint(schema.MinLength, 10)
+		}
+		if schema.MaxLength != nil {
+			v.MaxLength = strconv.FormatUint(*schema.MaxLength, 10)
+		}
+	case "int", "float64":
+		if schema.Min != nil {
+			v.Minimum = strconv.FormatFloat(*schema.Min, 'f', -1, 64)
+			v.ExclusiveMinimum = schema.ExclusiveMin
+		}
+		if schema.Max != nil {
+			v.Maximum = strconv.FormatFloat(*schema.Max, 'f', -1, 64)
+			v.ExclusiveMaximum = schema.ExclusiveMax
+		}
+	}
+
+	return v
+}
+
+// requestValueType returns the type wrapped by value.Value in a request field type
+func requestValueType(requestType string) string {
+	return strings.TrimSuffix(strings.TrimPrefix(requestType, "*value.Value["), "]")
+}
+
 // schemaToGoType converts OpenAPI schema type to Go type
 func (g *Generator) schemaToGoType(schema *openapi3.Schema) string {
 	if schema.Title != "" {
@@ -582,21 +784,20 @@ func (g *Generator) schemaToGoType(schema *openapi3.Schema) string {
 
 	var baseType string
 
+	// A union of a single type, such as a nullable reference, is that type.
+	// Other unions need a name to be declared, see buildGoType.
+	if variants, nullable := unionVariants(schema); len(variants) == 1 {
+		variantType := g.refGoType(variants[0])
+		if (nullable || schema.Nullable) && !strings.HasPrefix(variantType, "*") {
+			return "*" + variantType
+		}
+		return variantType
+	}
+
 	if schema.Type.Is("array") {
-		if schema.Items != nil {
-			// Check if items has a $ref
-			if schema.Items.Ref != "" {
-				// Extract schema name from $ref
-				parts := strings.Split(schema.Items.Ref, "/")
-				schemaName := parts[len(parts)-1]
-				baseType = "[]" + schemaName
-			} else if schema.Items.Value != nil {
-				// Otherwise recursively process the items schema
-				itemType := g.schemaToGoType(schema.Items.Value)
-				baseType = "[]" + itemType
-			} else {
-				baseType = "[]interface{}"
-			}
+		if schema.Items != nil && (schema.Items.Ref != "" || schema.Items.Value != nil) {
+			// Items are either a $ref or recursively processed
+			baseType = "[]" + g.refGoType(schema.Items)
 		} else {
 			baseType = "[]interface{}"
 		}
@@ -632,9 +833,10 @@ func (g *Generator) schemaToGoType(schema *openapi3.Schema) string {
 	case schema.Type.Is("boolean"):
 		baseType = "bool"
 	case schema.Type.Is("object"):
-		// For nested objects, use interface{} or map
-		if schema.AdditionalProperties.Has != nil && *schema.AdditionalProperties.Has {
-			baseType = "map[string]interface{}"
+		// Maps with a typed value, other objects are generic maps unless buildGoType
+		// declares a struct for their properties
+		if ap := schema.AdditionalProperties.Schema; ap != nil && (ap.Ref != "" || ap.Value != nil) {
+			baseType = "map[string]" + g.refGoType(ap)
 		} else {
 			baseType = "map[string]interface{}"
 		}
@@ -650,6 +852,230 @@ func (g *Generator) schemaToGoType(schema *openapi3.Schema) string {
 	return baseType
 }
 
+// refGoType returns the Go type of a schema reference: the schema name of a $ref,
+// or the type of the inline schema
+func (g *Generator) refGoType(ref *openapi3.SchemaRef) string {
+	if ref.Ref != "" {
+		parts := strings.Split(ref.Ref, "/")
+		return parts[len(parts)-1]
+	}
+	if ref.Value == nil {
+		return "interface{}"
+	}
+	return g.schemaToGoType(ref.Value)
+}
+
+// buildGoType returns the Go type of a field schema like schemaToGoType, declaring in data
+// the named types it needs: unions for oneOf/anyOf, structs for objects with properties,
+// and the item and value types of arrays and maps of those. The types are named after
+// the field, e.g. RunSource, RunInputsItem or RunPermissionsValue.
+func (g *Generator) buildGoType(name string, schema *openapi3.Schema, data *SchemaData) string {
+	if schema.Title != "" {
+		return g.schemaToGoType(schema)
+	}
+
+	pointer := func(goType string) string {
+		if schema.Nullable {
+			return "*" + goType
+		}
+		return goType
+	}
+
+	variants, nullable := unionVariants(schema)
+	switch {
+	case len(variants) > 1:
+		data.addUnion(g.buildUnionType(name, schema, variants, data))
+		if nullable || schema.Nullable {
+			return "*" + name
+		}
+		return name
+	case len(variants) == 1 && variants[0].Ref == "" && variants[0].Value != nil:
+		// The single variant of an inline union, e.g. anyOf a type and null
+		variant := *variants[0].Value
+		variant.Nullable = variant.Nullable || nullable || schema.Nullable
+		return g.buildGoType(name, &variant, data)
+	case len(variants) == 1:
+		return g.schemaToGoType(schema)
+	case schema.Type.Is("array") && schema.Items != nil && schema.Items.Ref == "" && schema.Items.Value != nil:
+		return pointer("[]" + g.buildGoType(name+"Item", schema.Items.Value, data))
+	case schema.Type.Is("object") && len(schema.Properties) > 0:
+		data.addNestedStruct(g.buildNestedStruct(name, schema, false, data))
+		return pointer(name)
+	case schema.Type.Is("object") && schema.AdditionalProperties.Schema != nil &&
+		schema.AdditionalProperties.Schema.Ref == "" && schema.AdditionalProperties.Schema.Value != nil:
+		return pointer("map[string]" + g.buildGoType(name+"Value", schema.AdditionalProperties.Schema.Value, data))
+	}
+
+	return g.schemaToGoType(schema)
+}
+
+// unionVariants returns the variants of a oneOf/anyOf schema, except for the null type,
+// reported as nullable
+func unionVariants(schema *openapi3.Schema) ([]*openapi3.SchemaRef, bool) {
+	refs := schema.OneOf
+	if len(refs) == 0 {
+		refs = schema.AnyOf
+	}
+
+	var variants []*openapi3.SchemaRef
+	nullable := false
+	for _, ref := range refs {
+		if ref.Value != nil && ref.Value.Type.Is("null") {
+			nullable = true
+			continue
+		}
+		variants = append(variants, ref)
+	}
+	return variants, nullable
+}
+
+// withoutNull returns a copy of a schema which does not accept null, for the request
+// fields wrapped in value.Value, which handles null, and the variants of unions
+func withoutNull(schema *openapi3.Schema) *openapi3.Schema {
+	copied := *schema
+	copied.Nullable = false
+	if variants, nullable := unionVariants(schema); nullable {
+		if len(copied.OneOf) > 0 {
+			copied.OneOf = variants
+		} else {
+			copied.AnyOf = variants
+		}
+	}
+	return &copied
+}
+
+// buildUnionType creates a union type holding one of the variants of a oneOf/anyOf schema.
+// A variant is selected by the discriminator property when every variant has its values,
+// otherwise by the first variant the JSON value decodes into.
+func (g *Generator) buildUnionType(name string, schema *openapi3.Schema, variants []*openapi3.SchemaRef, data *SchemaData) UnionType {
+	union := UnionType{
+		Name:        name,
+		Description: cleanDescription(schema.Description),
+	}
+
+	var property string
+	if schema.Discriminator != nil {
+		property = schema.Discriminator.PropertyName
+	}
+	discriminated := property != ""
+
+	names := make(map[string]bool)
+	for _, ref := range variants {
+		// The union holds variants by pointer, null is a union without variant
+		variantSchema := &openapi3.Schema{}
+		if ref.Value != nil {
+			variantSchema = withoutNull(ref.Value)
+		}
+
+		refName := ""
+		if ref.Ref != "" {
+			parts := strings.Split(ref.Ref, "/")
+			refName = parts[len(parts)-1]
+		}
+
+		variant := UnionVariant{Kind: jsonKind(variantSchema)}
+		if property != "" {
+			variant.Values = discriminatorValues(schema.Discriminator, ref, refName)
+			discriminated = discriminated && len(variant.Values) > 0
+		}
+
+		// Name the variant after its schema, discriminator value or kind
+		switch {
+		case refName != "":
+			variant.Name = strcase.ToCamel(strings.TrimPrefix(refName, name))
+		case variantSchema.Title != "":
+			variant.Name = strcase.ToCamel(variantSchema.Title)
+		case len(variant.Values) > 0:
+			variant.Name = strcase.ToCamel(variant.Values[0])
+		case len(variantSchema.Properties) > 0:
+			variant.Name = "Object"
+		default:
+			variant.Name = variantTypeName(g.schemaToGoType(variantSchema))
+		}
+		for base, i := variant.Name, 2; names[variant.Name]; i++ {
+			variant.Name = base + strconv.Itoa(i)
+		}
+		names[variant.Name] = true
+
+		if ref.Ref != "" && isResourceSchema(variantSchema) {
+			variant.Type = refName
+		} else {
+			variant.Type = g.buildGoType(name+variant.Name, variantSchema, data)
+		}
+
+		union.Variants = append(union.Variants, variant)
+	}
+
+	if discriminated {
+		union.Discriminator = property
+	} else {
+		for i := range union.Variants {
+			union.Variants[i].Values = nil
+		}
+	}
+
+	return union
+}
+
+// variantTypeName names a union variant after its Go type, e.g. String, Time or StringList
+func variantTypeName(goType string) string {
+	if item, ok := strings.CutPrefix(goType, "[]"); ok {
+		return variantTypeName(item) + "List"
+	}
+	switch {
+	case strings.HasPrefix(goType, "map["):
+		return "Map"
+	case goType == "interface{}":
+		return "Value"
+	}
+	return strcase.ToCamel(goType[strings.LastIndex(goType, ".")+1:])
+}
+
+// discriminatorValues returns the discriminator values selecting a variant: the keys of
+// the mapping to its $ref, or else the enum of its discriminator property or its schema name
+func discriminatorValues(discriminator *openapi3.Discriminator, ref *openapi3.SchemaRef, refName string) []string {
+	var values []string
+	for value, target := range discriminator.Mapping {
+		if ref.Ref != "" && target == ref.Ref {
+			values = append(values, value)
+		}
+	}
+	if len(values) > 0 {
+		sort.Strings(values)
+		return values
+	}
+
+	if ref.Value != nil {
+		if prop := ref.Value.Properties[discriminator.PropertyName]; prop != nil && prop.Value != nil {
+			for _, value := range prop.Value.Enum {
+				values = append(values, fmt.Sprintf("%v", value))
+			}
+		}
+	}
+	if len(values) == 0 && refName != "" {
+		values = append(values, refName)
+	}
+	return values
+}
+
+// jsonKind returns the JSON kind of the values of a schema, as reported by client.JSONKind,
+// or an empty string if it is unknown
+func jsonKind(schema *openapi3.Schema) string {
+	switch {
+	case schema.Type.Is("object"), len(schema.Properties) > 0:
+		return "object"
+	case schema.Type.Is("array"):
+		return "array"
+	case schema.Type.Is("string"):
+		return "string"
+	case schema.Type.Is("integer"), schema.Type.Is("number"):
+		return "number"
+	case schema.Type.Is("boolean"):
+		return "boolean"
+	}
+	return ""
+}
+
 // generateDocumentSchema generates a simple Document schema (e.g., TagRelationshipFieldsetsListingDocument)
 // These are wrapper types used in request/response bodies, or plain schemas (like Reason)
 func (g *Generator) generateDocumentSchema(name string, schema *openapi3.Schema, outputDir string) error {
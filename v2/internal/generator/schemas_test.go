@@ -1,7 +1,12 @@
 package generator
 
 import (
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
 	"sort"
+	"strings"
 	"testing"
 
 	"github.com/getkin/kin-openapi/openapi3"
@@ -502,3 +507,311 @@ func TestTypeEnumExtraction(t *testing.T) {
 		})
 	}
 }
+
+// TestSchemaFields tests the field names generated for sparse fieldsets
+func TestSchemaFields(t *testing.T) {
+	fields := buildSchemaFields(
+		[]Attribute{
+			{Name: "Name", JSONName: "name"},
+			{Name: "AutoApply", JSONName: "auto-apply"},
+			{Name: "Module", JSONName: "module"},
+		},
+		[]Relationship{
+			{Name: "Environment", JSONName: "environment"},
+			{Name: "Module", JSONName: "module"},
+		},
+	)
+
+	expected := []SchemaField{
+		{Name: "AutoApply", JSONName: "auto-apply"},
+		{Name: "Environment", JSONName: "environment"},
+		{Name: "Module", JSONName: "module"},
+		{Name: "Name", JSONName: "name"},
+	}
+
+	if len(fields) != len(expected) {
+		t.Fatalf("Expected %d fields, got %d: %v", len(expected), len(fields), fields)
+	}
+	for i, field := range fields {
+		if field != expected[i] {
+			t.Errorf("Field %d: expected %v, got %v", i, expected[i], field)
+		}
+	}
+}
+
+// TestSimpleSchemaResourceType tests the resource type constant of relationship schemas
+func TestSimpleSchemaResourceType(t *testing.T) {
+	g := New("", "test")
+	schema := &openapi3.Schema{
+		Type: &openapi3.Types{"object"},
+		Properties: openapi3.Schemas{
+			"id":   {Value: &openapi3.Schema{Type: &openapi3.Types{"string"}}},
+			"type": {Value: &openapi3.Schema{Type: &openapi3.Types{"string"}, Enum: []interface{}{"users"}}},
+		},
+	}
+
+	outputDir := t.TempDir()
+	if err := g.generateSimpleSchema("UserRelationship", schema, outputDir); err != nil {
+		t.Fatalf("generateSimpleSchema() error = %v", err)
+	}
+	content, err := os.ReadFile(filepath.Join(outputDir, "user_relationship.gen.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `const UserRelationshipResourceType = "users"`; !strings.Contains(string(content), want) {
+		t.Errorf("Expected generated code to contain %q, got:\n%s", want, content)
+	}
+}
+
+// unionTestSchema returns a run resource with union, map and nested object attributes
+func unionTestSchema() *openapi3.Schema {
+	typed := func(typ string) *openapi3.SchemaRef {
+		return &openapi3.SchemaRef{Value: &openapi3.Schema{Type: &openapi3.Types{typ}}}
+	}
+	object := func(properties openapi3.Schemas) *openapi3.Schema {
+		return &openapi3.Schema{Type: &openapi3.Types{"object"}, Properties: properties}
+	}
+	source := func(kind string, field string) *openapi3.SchemaRef {
+		return &openapi3.SchemaRef{
+			Ref: "#/components/schemas/" + strings.ToUpper(kind[:1]) + kind[1:] + "Source",
+			Value: object(openapi3.Schemas{
+				"kind": {Value: &openapi3.Schema{Type: &openapi3.Types{"string"}, Enum: []interface{}{kind}}},
+				field:  typed("string"),
+			}),
+		}
+	}
+
+	return object(openapi3.Schemas{
+		"id":   typed("string"),
+		"type": {Value: &openapi3.Schema{Type: &openapi3.Types{"string"}, Enum: []interface{}{"runs"}}},
+		"attributes": {Value: object(openapi3.Schemas{
+			"source": {Value: &openapi3.Schema{
+				OneOf:         openapi3.SchemaRefs{source("vcs", "repository"), source("api", "client")},
+				Discriminator: &openapi3.Discriminator{PropertyName: "kind"},
+			}},
+			"target": {Value: &openapi3.Schema{
+				AnyOf: openapi3.SchemaRefs{
+					typed("integer"),
+					typed("string"),
+					{Value: &openapi3.Schema{Type: &openapi3.Types{"array"}, Items: typed("string")}},
+					{Value: object(openapi3.Schemas{"address": typed("string")})},
+				},
+			}},
+			"message": {Value: &openapi3.Schema{AnyOf: openapi3.SchemaRefs{typed("string"), typed("null")}}},
+			"permissions": {Value: &openapi3.Schema{
+				Type:                 &openapi3.Types{"object"},
+				AdditionalProperties: openapi3.AdditionalProperties{Schema: typed("boolean")},
+			}},
+			"status-timestamps": {Value: &openapi3.Schema{
+				Type: &openapi3.Types{"object"},
+				AdditionalProperties: openapi3.AdditionalProperties{Schema: &openapi3.SchemaRef{Value: &openapi3.Schema{
+					Type: &openapi3.Types{"string"}, Format: "date-time",
+				}}},
+			}},
+			"inputs": {Value: &openapi3.Schema{
+				Type:     &openapi3.Types{"array"},
+				Nullable: true,
+				Items: &openapi3.SchemaRef{Value: object(openapi3.Schemas{
+					"name":  typed("string"),
+					"value": typed("string"),
+				})},
+			}},
+			"settings": {Value: object(openapi3.Schemas{
+				"limits": {Value: object(openapi3.Schemas{"timeout": typed("integer")})},
+			})},
+		})},
+	})
+}
+
+// TestUnionTypes tests generating union, map and nested object types
+func TestUnionTypes(t *testing.T) {
+	g := New("", "scalr")
+	data := g.buildSchemaData("Run", unionTestSchema(), map[string]bool{"Run": true})
+
+	types := make(map[string]string)
+	for _, attr := range data.Attributes {
+		types[attr.JSONName] = attr.ResponseType + " / " + attr.RequestType
+	}
+	expected := map[string]string{
+		"source":            "RunSource / *value.Value[RunSource]",
+		"target":            "RunTarget / *value.Value[RunTarget]",
+		"message":           "*string / *value.Value[string]",
+		"permissions":       "map[string]bool / *value.Value[map[string]bool]",
+		"status-timestamps": "map[string]time.Time / *value.Value[map[string]time.Time]",
+		"inputs":            "*[]RunInputsItem / *value.Value[[]RunInputsItem]",
+		"settings":          "RunSettings / *value.Value[RunSettingsRequest]",
+	}
+	for name, want := range expected {
+		if types[name] != want {
+			t.Errorf("Attribute %s: expected %s, got %s", name, want, types[name])
+		}
+	}
+
+	if len(data.Unions) != 2 {
+		t.Fatalf("Expected 2 unions, got %+v", data.Unions)
+	}
+	source := data.Unions[0]
+	if source.Name != "RunSource" || source.Discriminator != "kind" {
+		t.Errorf("Expected RunSource discriminated by kind, got %+v", source)
+	}
+	for i, want := range []UnionVariant{
+		{Name: "VcsSource", Type: "RunSourceVcsSource", Kind: "object", Values: []string{"vcs"}},
+		{Name: "ApiSource", Type: "RunSourceApiSource", Kind: "object", Values: []string{"api"}},
+	} {
+		got := source.Variants[i]
+		if got.Name != want.Name || got.Type != want.Type || got.Kind != want.Kind || strings.Join(got.Values, ",") != strings.Join(want.Values, ",") {
+			t.Errorf("Variant %d: expected %+v, got %+v", i, want, got)
+		}
+	}
+	var variants []string
+	for _, v := range data.Unions[1].Variants {
+		variants = append(variants, v.Name+" "+v.Type)
+	}
+	if got := strings.Join(variants, ", "); got != "Int int, String string, StringList []string, Object RunTargetObject" {
+		t.Errorf("Unexpected RunTarget variants: %s", got)
+	}
+
+	var nested []string
+	for _, s := range data.NestedStructs {
+		nested = append(nested, s.Name)
+	}
+	if got := strings.Join(nested, ", "); got != "RunInputsItem, RunSettings, RunSettingsLimits, RunSourceApiSource, RunSourceVcsSource, RunTargetObject" {
+		t.Errorf("Unexpected nested structs: %s", got)
+	}
+}
+
+// TestUnionTypesRoundTrip tests the generated types decode and encode JSON without loss,
+// by compiling them in a temporary module and running a test against them
+func TestUnionTypesRoundTrip(t *testing.T) {
+	if testing.Short() {
+		t.Skip("compiles the generated code")
+	}
+	goBin, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go command not found")
+	}
+
+	dir := t.TempDir()
+	g := New(dir, "scalr")
+	doc := &openapi3.T{
+		Paths:      openapi3.NewPaths(),
+		Components: &openapi3.Components{Schemas: openapi3.Schemas{"Run": {Value: unionTestSchema()}}},
+	}
+
+	apiDir := filepath.Join(dir, "scalr")
+	if err := os.MkdirAll(filepath.Join(apiDir, "schemas"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := g.generateSchemas(doc, filepath.Join(apiDir, "schemas")); err != nil {
+		t.Fatalf("generateSchemas() error = %v", err)
+	}
+	if err := g.generateStatic(apiDir); err != nil {
+		t.Fatalf("generateStatic() error = %v", err)
+	}
+	if err := g.formatCode(apiDir); err != nil {
+		t.Fatal(err)
+	}
+
+	// The value package imports the client from its static source path
+	err = fs.WalkDir(staticFiles, "static/client", func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || strings.HasSuffix(path, "_test.go") {
+			return err
+		}
+		content, err := staticFiles.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		dst := filepath.Join(dir, "internal", "generator", path)
+		if err := os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+			return err
+		}
+		return os.WriteFile(dst, content, 0644)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	files := map[string]string{
+		"go.mod": "module github.com/scalr/go-scalr/v2\n\ngo 1.24\n",
+		"scalr/schemas/roundtrip_test.go": `package schemas
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestRoundTrip(t *testing.T) {
+	for _, doc := range []string{
+		` + "`" + `{"id":"run-1","type":"runs","attributes":{"source":{"kind":"vcs","repository":"org/repo"},"target":42,"message":"hi","permissions":{"runs:apply":true},"status-timestamps":{"queued-at":"2024-01-02T03:04:05Z"},"inputs":[{"name":"a","value":"b"}],"settings":{"limits":{"timeout":60}}}}` + "`" + `,
+		` + "`" + `{"id":"run-2","type":"runs","attributes":{"source":{"kind":"api","client":"cli"},"target":["a","b"],"message":null,"permissions":null,"status-timestamps":null,"inputs":null,"settings":{"limits":null}}}` + "`" + `,
+		` + "`" + `{"id":"run-3","type":"runs","attributes":{"source":null,"target":{"address":"module.a"},"message":null,"permissions":null,"status-timestamps":null,"inputs":null,"settings":{"limits":null}}}` + "`" + `,
+		` + "`" + `{"id":"run-4","type":"runs","attributes":{"source":null,"target":"text","message":null,"permissions":null,"status-timestamps":null,"inputs":null,"settings":{"limits":null}}}` + "`" + `,
+	} {
+		var run Run
+		if err := json.Unmarshal([]byte(doc), &run); err != nil {
+			t.Fatalf("Unmarshal(%s) error = %v", doc, err)
+		}
+		encoded, err := json.Marshal(run.Attributes)
+		if err != nil {
+			t.Fatalf("Marshal() error = %v", err)
+		}
+		var decoded RunAttributes
+		if err := json.Unmarshal(encoded, &decoded); err != nil {
+			t.Fatalf("Unmarshal(%s) error = %v", encoded, err)
+		}
+		if !reflect.DeepEqual(decoded, run.Attributes) {
+			t.Errorf("Round trip of %s changed the attributes:\n%+v\n%+v", doc, run.Attributes, decoded)
+		}
+
+		switch run.ID {
+		case "run-1":
+			a := run.Attributes
+			if a.Source.VcsSource == nil || a.Source.VcsSource.Repository != "org/repo" || a.Source.ApiSource != nil {
+				t.Errorf("Expected a VCS source, got %+v", a.Source)
+			}
+			if a.Target.Int == nil || *a.Target.Int != 42 {
+				t.Errorf("Expected an int target, got %+v", a.Target)
+			}
+			if !a.Permissions["runs:apply"] || a.StatusTimestamps["queued-at"].Year() != 2024 {
+				t.Errorf("Unexpected maps %v %v", a.Permissions, a.StatusTimestamps)
+			}
+			if a.Inputs == nil || (*a.Inputs)[0].Name != "a" || a.Settings.Limits.Timeout != 60 {
+				t.Errorf("Unexpected objects %+v %+v", a.Inputs, a.Settings)
+			}
+		case "run-2":
+			if run.Attributes.Source.ApiSource == nil || run.Attributes.Target.StringList == nil || run.Attributes.Message != nil {
+				t.Errorf("Expected an API source and a list target, got %+v", run.Attributes)
+			}
+		case "run-3":
+			if run.Attributes.Source.VcsSource != nil || run.Attributes.Target.Object == nil {
+				t.Errorf("Expected no source and an object target, got %+v", run.Attributes)
+			}
+		case "run-4":
+			if run.Attributes.Target.String == nil || *run.Attributes.Target.String != "text" {
+				t.Errorf("Expected a string target, got %+v", run.Attributes.Target)
+			}
+		}
+	}
+
+	var source RunSource
+	if err := json.Unmarshal([]byte(` + "`" + `{"kind":"git"}` + "`" + `), &source); err == nil {
+		t.Error("Expected an unknown discriminator value to fail")
+	}
+}
+`,
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	cmd := exec.Command(goBin, "test", "./scalr/schemas/")
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GOFLAGS=-mod=mod", "GOWORK=off")
+	if out, err := cmd.CombinedOutput(); err != nil {
+		schema, _ := os.ReadFile(filepath.Join(apiDir, "schemas", "run.gen.go"))
+		t.Fatalf("round trip test failed: %v\n%s\n%s", err, out, schema)
+	}
+}
@@ -0,0 +1,95 @@
+package client
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// Config locates the Scalr API
+type Config struct {
+	// Address of Scalr, either a hostname such as "example.scalr.io", optionally
+	// with a port, or a full URL such as "https://example.scalr.io"
+	Address string
+	// BasePath on which the API is served. Defaults to the path of the Address
+	// URL if it has one, or to the base path of the API specification.
+	BasePath string
+	// AllowHTTP allows plain http:// addresses, e.g. for test servers and local proxies
+	AllowHTTP bool
+}
+
+// BaseURL returns the URL requests are sent to, without a trailing slash
+func (cfg Config) BaseURL(defaultBasePath string) (string, error) {
+	address := strings.TrimSpace(cfg.Address)
+	if address == "" {
+		return "", fmt.Errorf("invalid address: address is required")
+	}
+
+	// A bare hostname, which may have a port, is served over HTTPS
+	if !strings.Contains(address, "://") {
+		address = "https://" + address
+	}
+
+	u, err := url.Parse(address)
+	if err != nil {
+		return "", fmt.Errorf("invalid address: %w", err)
+	}
+
+	switch u.Scheme {
+	case "https":
+	case "http":
+		if !cfg.AllowHTTP {
+			return "", fmt.Errorf("invalid address %q: plain HTTP is not allowed, use WithAllowHTTP", cfg.Address)
+		}
+	default:
+		return "", fmt.Errorf("invalid address %q: unsupported scheme %q", cfg.Address, u.Scheme)
+	}
+	if u.Host == "" {
+		return "", fmt.Errorf("invalid address %q: missing host", cfg.Address)
+	}
+
+	basePath := cfg.BasePath
+	if basePath == "" && strings.Trim(u.Path, "/") == "" {
+		basePath = defaultBasePath
+	}
+	if basePath != "" {
+		u.Path = "/" + strings.Trim(basePath, "/")
+	}
+	u.Path = strings.TrimSuffix(u.Path, "/")
+	u.RawPath = ""
+	u.RawQuery = ""
+	u.Fragment = ""
+
+	return u.String(), nil
+}
+
+// WithBasePath overrides the base path on which the API is served.
+// It applies to clients created with NewHTTPClientForAddress.
+func WithBasePath(basePath string) HTTPClientOption {
+	return func(c *HTTPClient) {
+		c.config.BasePath = basePath
+	}
+}
+
+// WithAllowHTTP allows plain http:// addresses, e.g. for test servers and local proxies.
+// It applies to clients created with NewHTTPClientForAddress.
+func WithAllowHTTP() HTTPClientOption {
+	return func(c *HTTPClient) {
+		c.config.AllowHTTP = true
+	}
+}
+
+// NewHTTPClientForAddress creates a new HTTP client for the API at address,
+// which is either a hostname or a full URL, see Config. An invalid address
+// fails every request, the error is also available through Err.
+func NewHTTPClientForAddress(address, defaultBasePath, token string, opts ...HTTPClientOption) *HTTPClient {
+	c := NewHTTPClient("", token, opts...)
+	c.config.Address = address
+	c.baseURL, c.err = c.config.BaseURL(defaultBasePath)
+	return c
+}
+
+// Err returns the error of an invalid address, if any
+func (c *HTTPClient) Err() error {
+	return c.err
+}
@@ -0,0 +1,122 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// TestConfigBaseURL tests resolving the base URL from an address
+func TestConfigBaseURL(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     Config
+		want    string
+		wantErr string
+	}{
+		{
+			name: "hostname",
+			cfg:  Config{Address: "example.scalr.io"},
+			want: "https://example.scalr.io/api/iacp/v3",
+		},
+		{
+			name: "hostname with port",
+			cfg:  Config{Address: "localhost:8443"},
+			want: "https://localhost:8443/api/iacp/v3",
+		},
+		{
+			name: "full URL",
+			cfg:  Config{Address: "https://example.scalr.io/"},
+			want: "https://example.scalr.io/api/iacp/v3",
+		},
+		{
+			name: "full URL with path",
+			cfg:  Config{Address: "https://proxy.example.com/scalr/api/"},
+			want: "https://proxy.example.com/scalr/api",
+		},
+		{
+			name: "base path override",
+			cfg:  Config{Address: "https://example.scalr.io/ignored", BasePath: "api/v4/"},
+			want: "https://example.scalr.io/api/v4",
+		},
+		{
+			name: "plain HTTP allowed",
+			cfg:  Config{Address: "http://127.0.0.1:8080", AllowHTTP: true},
+			want: "http://127.0.0.1:8080/api/iacp/v3",
+		},
+		{
+			name:    "plain HTTP not allowed",
+			cfg:     Config{Address: "http://127.0.0.1:8080"},
+			wantErr: "plain HTTP is not allowed",
+		},
+		{
+			name:    "unsupported scheme",
+			cfg:     Config{Address: "ftp://example.scalr.io"},
+			wantErr: "unsupported scheme",
+		},
+		{
+			name:    "missing host",
+			cfg:     Config{Address: "https:///api"},
+			wantErr: "missing host",
+		},
+		{
+			name:    "empty",
+			cfg:     Config{},
+			wantErr: "address is required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.cfg.BaseURL("/api/iacp/v3")
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("BaseURL() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("BaseURL() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("BaseURL() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestNewHTTPClientForAddress tests sending requests to a plain HTTP test server
+func TestNewHTTPClientForAddress(t *testing.T) {
+	var gotPath string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := NewHTTPClientForAddress(server.URL, "/api/iacp/v3", "token", WithAllowHTTP(), WithBasePath("/custom"))
+	if err := client.Err(); err != nil {
+		t.Fatalf("Err() = %v", err)
+	}
+
+	resp, err := client.Get(context.Background(), "/workspaces", nil)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	_ = resp.Body.Close()
+
+	if gotPath != "/custom/workspaces" {
+		t.Errorf("path = %q, want %q", gotPath, "/custom/workspaces")
+	}
+
+	// Without the option, the plain HTTP address fails every request
+	insecure := NewHTTPClientForAddress(server.URL, "/api/iacp/v3", "token")
+	if insecure.Err() == nil {
+		t.Fatal("Err() = nil, want an error")
+	}
+	if _, err := insecure.WithHeader("X-Test", "1").Get(context.Background(), "/workspaces", nil); err == nil {
+		t.Error("Get() error = nil, want an error")
+	}
+}
@@ -0,0 +1,118 @@
+package client
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrCheckpointMismatch is returned when resuming from a checkpoint taken with
+	// other options, page size or listing
+	ErrCheckpointMismatch = errors.New("checkpoint does not match the iterator options")
+
+	// ErrCheckpointStale is returned when the result set shrank since the checkpoint
+	// was taken, so that resuming would skip items
+	ErrCheckpointStale = errors.New("result set shrank since the checkpoint was taken")
+)
+
+// Checkpoint is the position of an Iterator, to resume an interrupted iteration
+// from where it stopped, e.g. after a restart. It is opaque: store it as a string
+// with String, or with any text or JSON encoder, and restore it with ParseCheckpoint.
+type Checkpoint struct {
+	page       int    // Page of the next item
+	offset     int    // Items of the page already consumed
+	pageSize   int    // Page size of the iterator
+	totalCount int    // Number of items when the checkpoint was taken
+	filters    string // Hash of the iterator options
+}
+
+// checkpointData is the serialized form of a Checkpoint
+type checkpointData struct {
+	Page       int    `json:"p"`
+	Offset     int    `json:"o,omitempty"`
+	PageSize   int    `json:"s"`
+	TotalCount int    `json:"t,omitempty"`
+	Filters    string `json:"f,omitempty"`
+}
+
+// ParseCheckpoint restores a checkpoint from its String form
+func ParseCheckpoint(s string) (Checkpoint, error) {
+	var cp Checkpoint
+	err := cp.UnmarshalText([]byte(s))
+	return cp, err
+}
+
+// String returns the checkpoint as an opaque URL-safe string
+func (cp Checkpoint) String() string {
+	text, _ := cp.MarshalText()
+	return string(text)
+}
+
+// MarshalText implements encoding.TextMarshaler
+func (cp Checkpoint) MarshalText() ([]byte, error) {
+	data, err := json.Marshal(checkpointData{
+		Page:       cp.page,
+		Offset:     cp.offset,
+		PageSize:   cp.pageSize,
+		TotalCount: cp.totalCount,
+		Filters:    cp.filters,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return []byte(base64.RawURLEncoding.EncodeToString(data)), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (cp *Checkpoint) UnmarshalText(text []byte) error {
+	data, err := base64.RawURLEncoding.DecodeString(string(text))
+	if err != nil {
+		return fmt.Errorf("invalid checkpoint: %w", err)
+	}
+	var d checkpointData
+	if err := json.Unmarshal(data, &d); err != nil {
+		return fmt.Errorf("invalid checkpoint: %w", err)
+	}
+	if d.Page < 1 || d.Offset < 0 || d.PageSize < 1 || d.TotalCount < 0 {
+		return errors.New("invalid checkpoint: position out of range")
+	}
+
+	*cp = Checkpoint{
+		page:       d.Page,
+		offset:     d.Offset,
+		pageSize:   d.PageSize,
+		totalCount: d.TotalCount,
+		filters:    d.Filters,
+	}
+	return nil
+}
+
+// check returns an error if the items of the page fetched on resume show that
+// the result set shrank since the checkpoint was taken
+func (cp *Checkpoint) check(items int, pagination *Pagination) error {
+	if pagination != nil && pagination.TotalCount < cp.totalCount {
+		return fmt.Errorf("%w: %d items, there were %d", ErrCheckpointStale, pagination.TotalCount, cp.totalCount)
+	}
+	if cp.offset > 0 && items <= cp.offset {
+		return fmt.Errorf("%w: page %d has %d items, %d were consumed", ErrCheckpointStale, cp.page, items, cp.offset)
+	}
+	return nil
+}
+
+// HashOptions returns a hash of the values selecting the items of a listing,
+// e.g. the operation name, path parameters and options without the page number
+// and size. The values must be JSON-encodable; maps are hashed in key order.
+func HashOptions(values ...any) string {
+	h := sha256.New()
+	enc := json.NewEncoder(h)
+	for _, v := range values {
+		if err := enc.Encode(v); err != nil {
+			fmt.Fprintf(h, "%#v\n", v)
+		}
+	}
+	return hex.EncodeToString(h.Sum(nil)[:16])
+}
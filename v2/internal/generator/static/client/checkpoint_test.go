@@ -0,0 +1,251 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// checkpointPages returns a fetch function of total items split into pages of size,
+// named after their position
+func checkpointPages(total, size int) func(context.Context, int) ([]*string, *Pagination, error) {
+	return func(ctx context.Context, pageNum int) ([]*string, *Pagination, error) {
+		totalPages := (total + size - 1) / size
+		var items []*string
+		for i := (pageNum - 1) * size; i < total && i < pageNum*size; i++ {
+			items = append(items, ptr(fmt.Sprintf("item%d", i+1)))
+		}
+		var next *int
+		if pageNum < totalPages {
+			next = ptr(pageNum + 1)
+		}
+		return items, &Pagination{CurrentPage: pageNum, TotalPages: totalPages, TotalCount: total, NextPage: next}, nil
+	}
+}
+
+// consume returns the next n items of an iterator
+func consume(t *testing.T, it *Iterator[string], n int) []string {
+	t.Helper()
+	var items []string
+	for len(items) < n && it.Next() {
+		items = append(items, *it.Value())
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	return items
+}
+
+// TestIteratorResume tests resuming an iteration from checkpoints taken at various positions
+func TestIteratorResume(t *testing.T) {
+	tests := []struct {
+		name     string
+		consumed int
+		expected string
+	}{
+		{"not started", 0, "item1 item2 item3 item4 item5 item6 item7"},
+		{"within a page", 4, "item5 item6 item7"},
+		{"end of a page", 3, "item4 item5 item6 item7"},
+		{"last item", 7, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			it := NewIterator(context.Background(), 3, checkpointPages(7, 3)).FiltersHash("abc")
+			consume(t, it, tt.consumed)
+
+			// The checkpoint survives a restart as a string
+			cp, err := ParseCheckpoint(it.Checkpoint().String())
+			if err != nil {
+				t.Fatalf("ParseCheckpoint() error: %v", err)
+			}
+
+			resumed := NewIterator(context.Background(), 3, checkpointPages(7, 3)).FiltersHash("abc").Resume(cp)
+			if got := strings.Join(consume(t, resumed, 100), " "); got != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+// TestIteratorCheckpointAfterEnd tests that a checkpoint taken after the iteration
+// ended resumes without items
+func TestIteratorCheckpointAfterEnd(t *testing.T) {
+	it := NewIterator(context.Background(), 3, checkpointPages(6, 3))
+	consume(t, it, 100)
+	if it.Next() {
+		t.Fatal("Next() should return false after the end")
+	}
+
+	resumed := NewIterator(context.Background(), 3, checkpointPages(6, 3)).Resume(it.Checkpoint())
+	if items := consume(t, resumed, 100); len(items) != 0 {
+		t.Errorf("Expected no items, got %v", items)
+	}
+}
+
+// TestIteratorCheckpointAfterError tests that a checkpoint taken after a failed
+// fetch resumes with the failed page
+func TestIteratorCheckpointAfterError(t *testing.T) {
+	pages := checkpointPages(9, 3)
+	failing := func(ctx context.Context, pageNum int) ([]*string, *Pagination, error) {
+		if pageNum == 2 {
+			return nil, nil, errors.New("connection reset")
+		}
+		return pages(ctx, pageNum)
+	}
+
+	it := NewIterator(context.Background(), 3, failing)
+	for it.Next() {
+	}
+	if it.Err() == nil {
+		t.Fatal("Expected an error")
+	}
+
+	resumed := NewIterator(context.Background(), 3, pages).Resume(it.Checkpoint())
+	if got := strings.Join(consume(t, resumed, 100), " "); got != "item4 item5 item6 item7 item8 item9" {
+		t.Errorf("Unexpected items: %q", got)
+	}
+}
+
+// TestIteratorCheckpointBeforeResume tests that an iterator not started yet
+// returns the checkpoint it resumes from
+func TestIteratorCheckpointBeforeResume(t *testing.T) {
+	it := NewIterator(context.Background(), 3, checkpointPages(9, 3))
+	consume(t, it, 5)
+	cp := it.Checkpoint()
+
+	resumed := NewIterator(context.Background(), 3, checkpointPages(9, 3)).Resume(cp)
+	if got := resumed.Checkpoint(); got != cp {
+		t.Errorf("Expected checkpoint %v, got %v", cp, got)
+	}
+}
+
+// TestIteratorResumeWithPrefetch tests resuming an iteration fetching pages in the background
+func TestIteratorResumeWithPrefetch(t *testing.T) {
+	it := NewIterator(context.Background(), 2, checkpointPages(9, 2))
+	consume(t, it, 3)
+
+	resumed := NewIterator(context.Background(), 2, checkpointPages(9, 2)).Prefetch(2).Resume(it.Checkpoint())
+	if got := strings.Join(consume(t, resumed, 100), " "); got != "item4 item5 item6 item7 item8 item9" {
+		t.Errorf("Unexpected items: %q", got)
+	}
+}
+
+// TestIteratorResumeMismatch tests resuming from the checkpoint of another listing
+func TestIteratorResumeMismatch(t *testing.T) {
+	it := NewIterator(context.Background(), 3, checkpointPages(9, 3)).FiltersHash("abc")
+	consume(t, it, 4)
+	cp := it.Checkpoint()
+
+	tests := []struct {
+		name string
+		it   *Iterator[string]
+	}{
+		{"other options", NewIterator(context.Background(), 3, checkpointPages(9, 3)).FiltersHash("def")},
+		{"other page size", NewIterator(context.Background(), 5, checkpointPages(9, 5)).FiltersHash("abc")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resumed := tt.it.Resume(cp)
+			if resumed.Next() {
+				t.Fatal("Next() should return false")
+			}
+			if !errors.Is(resumed.Err(), ErrCheckpointMismatch) {
+				t.Errorf("Expected ErrCheckpointMismatch, got %v", resumed.Err())
+			}
+		})
+	}
+
+	resumed := NewIterator(context.Background(), 3, checkpointPages(9, 3)).Resume(Checkpoint{})
+	if resumed.Next() || !errors.Is(resumed.Err(), ErrCheckpointMismatch) {
+		t.Errorf("Expected ErrCheckpointMismatch for an empty checkpoint, got %v", resumed.Err())
+	}
+}
+
+// TestIteratorResumeStale tests resuming after the result set shrank
+func TestIteratorResumeStale(t *testing.T) {
+	it := NewIterator(context.Background(), 3, checkpointPages(9, 3))
+	consume(t, it, 5)
+	cp := it.Checkpoint()
+
+	// Growing is fine, the new items are listed
+	resumed := NewIterator(context.Background(), 3, checkpointPages(10, 3)).Resume(cp)
+	if got := strings.Join(consume(t, resumed, 100), " "); got != "item6 item7 item8 item9 item10" {
+		t.Errorf("Unexpected items: %q", got)
+	}
+
+	tests := []struct {
+		name      string
+		fetchPage func(context.Context, int) ([]*string, *Pagination, error)
+	}{
+		{"fewer items", checkpointPages(8, 3)},
+		{"page consumed", func(ctx context.Context, pageNum int) ([]*string, *Pagination, error) {
+			// No total count to compare with
+			items, _, err := checkpointPages(4, 3)(ctx, pageNum)
+			return items, nil, err
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resumed := NewIterator(context.Background(), 3, tt.fetchPage).Resume(cp)
+			if resumed.Next() {
+				t.Fatal("Next() should return false")
+			}
+			if !errors.Is(resumed.Err(), ErrCheckpointStale) {
+				t.Errorf("Expected ErrCheckpointStale, got %v", resumed.Err())
+			}
+		})
+	}
+}
+
+// TestCheckpointEncoding tests the serialized forms of a checkpoint
+func TestCheckpointEncoding(t *testing.T) {
+	cp := Checkpoint{page: 3, offset: 2, pageSize: 50, totalCount: 420, filters: HashOptions("ListWorkspaces")}
+
+	data, err := json.Marshal(map[string]Checkpoint{"workspaces": cp})
+	if err != nil {
+		t.Fatalf("Marshal() error: %v", err)
+	}
+	var decoded map[string]Checkpoint
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Unmarshal() error: %v", err)
+	}
+	if decoded["workspaces"] != cp {
+		t.Errorf("Expected %v, got %v", cp, decoded["workspaces"])
+	}
+
+	for _, invalid := range []string{"", "not base64!", "bm90IGpzb24", Checkpoint{page: 0, pageSize: 10}.String()} {
+		if _, err := ParseCheckpoint(invalid); err == nil {
+			t.Errorf("Expected an error for %q", invalid)
+		}
+	}
+}
+
+// TestHashOptions tests hashing the options of a listing
+func TestHashOptions(t *testing.T) {
+	type options struct {
+		Query  string
+		Filter map[string]string
+	}
+
+	hash := HashOptions("ListWorkspaces", "env-1", options{Filter: map[string]string{"a": "1", "b": "2"}})
+	same := HashOptions("ListWorkspaces", "env-1", options{Filter: map[string]string{"b": "2", "a": "1"}})
+	if hash != same {
+		t.Errorf("Expected the same hash for the same options, got %s and %s", hash, same)
+	}
+
+	for _, other := range []string{
+		HashOptions("ListWorkspaces", "env-2", options{Filter: map[string]string{"a": "1", "b": "2"}}),
+		HashOptions("ListWorkspaces", "env-1", options{Filter: map[string]string{"a": "1"}}),
+		HashOptions("ListRuns", "env-1", options{Filter: map[string]string{"a": "1", "b": "2"}}),
+	} {
+		if other == hash {
+			t.Errorf("Expected a different hash than %s", hash)
+		}
+	}
+}
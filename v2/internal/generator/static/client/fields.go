@@ -0,0 +1,32 @@
+package client
+
+import (
+	"net/url"
+	"strings"
+)
+
+// Fields selects the fields returned for each resource type, as JSON:API sparse
+// fieldsets. Each entry is encoded as fields[resource-type]=field1,field2.
+// An empty list requests no fields at all for the resource type.
+//
+// Example:
+//
+//	opts := &workspace.GetWorkspacesOptions{
+//		Fields: client.Fields{
+//			schemas.WorkspaceResourceType: {schemas.WorkspaceFieldName, schemas.WorkspaceFieldEnvironment},
+//		},
+//	}
+type Fields map[string][]string
+
+// Set selects the fields of a resource type, replacing those selected before
+func (f Fields) Set(resourceType string, fields ...string) Fields {
+	f[resourceType] = fields
+	return f
+}
+
+// Encode adds the fields[resource-type] parameters to the query parameters
+func (f Fields) Encode(params url.Values) {
+	for resourceType, fields := range f {
+		params.Set("fields["+resourceType+"]", strings.Join(fields, ","))
+	}
+}
@@ -0,0 +1,22 @@
+package client
+
+import "strings"
+
+// In builds a filter value matching any of the values, e.g. "in:ws-1,ws-2".
+// It works with plain strings and with the generated filter enum types:
+//
+//	opts := &run.GetRunsOptions{
+//		Filters: run.GetRunsFilter{
+//			Status: client.In(run.GetRunsFilterStatusPlanned, run.GetRunsFilterStatusApplied),
+//		},
+//	}
+//
+// Only the in: operator has a helper: the API spec does not document the syntax of the
+// other operators, such as date ranges, whose values are passed to the filters as is.
+func In[T ~string](values ...T) T {
+	parts := make([]string, len(values))
+	for i, v := range values {
+		parts[i] = string(v)
+	}
+	return T("in:" + strings.Join(parts, ","))
+}
@@ -0,0 +1,17 @@
+package client
+
+import "testing"
+
+type testStatus string
+
+// TestIn tests building multi-value filters
+func TestIn(t *testing.T) {
+	if got := In("ws-1", "ws-2"); got != "in:ws-1,ws-2" {
+		t.Errorf("In() = %q, want %q", got, "in:ws-1,ws-2")
+	}
+
+	var status testStatus = In(testStatus("planned"), testStatus("applied"))
+	if status != "in:planned,applied" {
+		t.Errorf("In() = %q, want %q", status, "in:planned,applied")
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"math"
@@ -24,6 +25,13 @@ type HTTPClient struct {
 	logger            Logger
 	userAgent         string
 	sleepFunc         func(time.Duration) // For testing - allows mocking sleep
+	readOnly          bool
+	dryRun            *MutationPlan
+	skipValidation    bool
+	prefetch          int             // Pages fetched ahead by the iterators
+	rateLimit         *rateLimitPause // Shared by the clients derived with WithHeader
+	config            Config          // Address the base URL was resolved from, if any
+	err               error           // Error of an invalid address, returned by every request
 }
 
 type HTTPClientOption func(*HTTPClient)
@@ -49,7 +57,8 @@ func WithLogger(logger Logger) HTTPClientOption {
 	}
 }
 
-// WithTimeout sets the request timeout. Default: 30 seconds
+// WithTimeout sets how long each attempt waits for the response headers. Reading the
+// response body, such as a streamed download, is only bounded by the context. Default: 30 seconds
 func WithTimeout(timeout time.Duration) HTTPClientOption {
 	return func(c *HTTPClient) {
 		c.timeout = timeout
@@ -98,6 +107,39 @@ func WithAppInfo(appName, appVersion string) HTTPClientOption {
 	}
 }
 
+// WithReadOnly rejects every POST, PATCH, PUT and DELETE request with a
+// MutationBlockedError, without sending it
+func WithReadOnly() HTTPClientOption {
+	return func(c *HTTPClient) {
+		c.readOnly = true
+	}
+}
+
+// WithDryRun simulates every POST, PATCH, PUT and DELETE request: it is recorded
+// into the plan instead of being sent, and fails with a MutationBlockedError
+func WithDryRun(plan *MutationPlan) HTTPClientOption {
+	return func(c *HTTPClient) {
+		c.dryRun = plan
+	}
+}
+
+// WithoutValidation sends requests without checking them against the constraints
+// of the API spec first, leaving their validation to the server
+func WithoutValidation() HTTPClientOption {
+	return func(c *HTTPClient) {
+		c.skipValidation = true
+	}
+}
+
+// WithPrefetch makes the iterators of list operations fetch up to n pages ahead
+// in the background, keeping the order of the items. Default: 0, pages are fetched
+// one at a time. Requests backing off from a 429 response hold back the others.
+func WithPrefetch(n int) HTTPClientOption {
+	return func(c *HTTPClient) {
+		c.prefetch = n
+	}
+}
+
 // withSleepFunc sets a custom sleep function (for testing only - not exported)
 func withSleepFunc(fn func(time.Duration)) HTTPClientOption {
 	return func(c *HTTPClient) {
@@ -117,13 +159,21 @@ func NewHTTPClient(baseURL, token string, opts ...HTTPClientOption) *HTTPClient
 		logger:         NewNoOpLogger(), // Default: no logging
 		userAgent:      UserAgent(),     // Default User-Agent
 		sleepFunc:      time.Sleep,      // Default: real sleep
+		rateLimit:      &rateLimitPause{},
 	}
 
 	for _, opt := range opts {
 		opt(client)
 	}
 
-	client.httpClient.Timeout = client.timeout
+	// Configure a shallow copy, the client given to WithHTTPClient may be shared
+	httpClient := *client.httpClient
+	// The timeout applies to each attempt, a client-wide one would cut off streamed downloads
+	httpClient.Timeout = 0
+	if httpClient.CheckRedirect == nil {
+		httpClient.CheckRedirect = checkRedirect
+	}
+	client.httpClient = &httpClient
 
 	return client
 }
@@ -141,6 +191,13 @@ func (c *HTTPClient) WithHeader(key, value string) *HTTPClient {
 		logger:            c.logger,
 		userAgent:         c.userAgent,
 		sleepFunc:         c.sleepFunc,
+		readOnly:          c.readOnly,
+		dryRun:            c.dryRun,
+		skipValidation:    c.skipValidation,
+		prefetch:          c.prefetch,
+		rateLimit:         c.rateLimit,
+		config:            c.config,
+		err:               c.err,
 	}
 
 	// Copy existing default headers
@@ -154,6 +211,11 @@ func (c *HTTPClient) WithHeader(key, value string) *HTTPClient {
 	return newClient
 }
 
+// Prefetch returns the number of pages the iterators fetch ahead, see WithPrefetch
+func (c *HTTPClient) Prefetch() int {
+	return c.prefetch
+}
+
 // Get performs a GET request
 func (c *HTTPClient) Get(ctx context.Context, path string, headers map[string]string) (*http.Response, error) {
 	return c.do(ctx, "GET", path, nil, headers)
@@ -180,6 +242,10 @@ func (c *HTTPClient) Delete(ctx context.Context, path string, body interface{},
 }
 
 func (c *HTTPClient) do(ctx context.Context, method, path string, body interface{}, headers map[string]string) (*http.Response, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+
 	url := c.baseURL + path
 
 	// Log request start
@@ -190,8 +256,11 @@ func (c *HTTPClient) do(ctx context.Context, method, path string, body interface
 	)
 
 	var bodyReader io.Reader
-	if body != nil {
-		bodyBytes, err := json.Marshal(body)
+	var bodyBytes []byte
+	rawBody, isRawBody := body.(RequestBody)
+	if body != nil && !isRawBody {
+		var err error
+		bodyBytes, err = json.Marshal(body)
 		if err != nil {
 			c.logger.Error("Failed to marshal request body",
 				"error", err,
@@ -209,10 +278,35 @@ func (c *HTTPClient) do(ctx context.Context, method, path string, body interface
 		)
 	}
 
+	// Never send mutations in read-only and dry-run modes
+	var contentType string
+	switch {
+	case isRawBody:
+		contentType = rawBody.contentType()
+	case bodyBytes != nil:
+		contentType = "application/vnd.api+json"
+	}
+	if err := c.guardMutation(method, path, bodyBytes, contentType); err != nil {
+		return nil, err
+	}
+
 	var lastErr error
 	var lastStatusCode int
 
 	for attempt := 0; attempt <= c.retryMax; attempt++ {
+		// Wait for concurrent requests backing off from a rate limit
+		if wait := c.rateLimit.remaining(); attempt == 0 && wait > 0 {
+			c.logger.Debug("Waiting for rate limit backoff",
+				"method", method,
+				"path", path,
+				"wait", wait.String(),
+			)
+			c.sleepFunc(wait)
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+		}
+
 		if attempt > 0 {
 			// Exponential backoff with jitter: base * 2^attempt + jitter
 			// e.g., attempt 1: 1-2s, attempt 2: 2-4s, attempt 3: 4-8s
@@ -246,18 +340,49 @@ func (c *HTTPClient) do(ctx context.Context, method, path string, body interface
 				return nil, ctx.Err()
 			}
 
-			// Sleep (can be mocked in tests)
-			c.sleepFunc(backoff)
+			// Sleep (can be mocked in tests), holding back new requests on rate limit
+			if lastStatusCode == 429 {
+				until := c.rateLimit.hold(backoff)
+				c.sleepFunc(backoff)
+				c.rateLimit.release(until)
+			} else {
+				c.sleepFunc(backoff)
+			}
 
 			// Reset body reader for retry
-			if body != nil {
+			if body != nil && !isRawBody {
 				bodyBytes, _ := json.Marshal(body)
 				bodyReader = bytes.NewReader(bodyBytes)
 			}
 		}
 
-		req, err := http.NewRequestWithContext(ctx, method, url, bodyReader)
+		// Raw bodies are opened on every attempt, rewound on retries
+		contentLength := int64(-1)
+		if isRawBody {
+			var err error
+			bodyReader, contentLength, err = rawBody.open(attempt)
+			if err != nil {
+				c.logger.Error("Failed to open request body",
+					"error", err,
+					"method", method,
+					"path", path,
+					"attempt", attempt,
+				)
+				if lastErr != nil {
+					return nil, fmt.Errorf("cannot retry after %w: %w", lastErr, err)
+				}
+				return nil, err
+			}
+		}
+
+		attemptCtx, cancel, timer := c.attemptContext(ctx)
+		req, err := http.NewRequestWithContext(attemptCtx, method, url, bodyReader)
 		if err != nil {
+			timer.Stop()
+			cancel(nil)
+			if closer, ok := bodyReader.(io.Closer); ok && isRawBody {
+				_ = closer.Close()
+			}
 			c.logger.Error("Failed to create HTTP request",
 				"error", err,
 				"method", method,
@@ -278,6 +403,13 @@ func (c *HTTPClient) do(ctx context.Context, method, path string, body interface
 			req.Header.Set(key, value)
 		}
 
+		if isRawBody {
+			req.Header.Set("Content-Type", rawBody.contentType())
+			if contentLength >= 0 {
+				req.ContentLength = contentLength
+			}
+		}
+
 		// Set custom headers (can override default headers)
 		for key, value := range headers {
 			req.Header.Set(key, value)
@@ -289,7 +421,12 @@ func (c *HTTPClient) do(ctx context.Context, method, path string, body interface
 		)
 
 		resp, err := c.httpClient.Do(req)
+		timer.Stop()
 		if err != nil {
+			cancel(nil)
+			if cause := context.Cause(attemptCtx); errors.Is(cause, context.DeadlineExceeded) && ctx.Err() == nil {
+				err = cause
+			}
 			lastErr = err
 			c.logger.Error("HTTP request failed",
 				"error", err,
@@ -300,6 +437,9 @@ func (c *HTTPClient) do(ctx context.Context, method, path string, body interface
 			continue
 		}
 
+		// The body is read after the headers, with only the context of the caller as deadline
+		resp.Body = &cancelBody{ReadCloser: resp.Body, cancel: cancel}
+
 		c.logger.Debug("Received HTTP response",
 			"method", method,
 			"path", path,
@@ -452,3 +592,29 @@ func (c *HTTPClient) shouldRetry(statusCode int) bool {
 
 	return false
 }
+
+// attemptContext returns the context of an attempt, canceled if the response headers
+// do not arrive within the timeout. The returned timer must be stopped once they arrive.
+func (c *HTTPClient) attemptContext(ctx context.Context) (context.Context, context.CancelCauseFunc, *time.Timer) {
+	attemptCtx, cancel := context.WithCancelCause(ctx)
+	timeout := c.timeout
+	if timeout <= 0 {
+		timeout = math.MaxInt64
+	}
+	timer := time.AfterFunc(timeout, func() {
+		cancel(fmt.Errorf("no response after %s: %w", c.timeout, context.DeadlineExceeded))
+	})
+	return attemptCtx, cancel, timer
+}
+
+// cancelBody releases the context of an attempt when its response body is closed
+type cancelBody struct {
+	io.ReadCloser
+	cancel context.CancelCauseFunc
+}
+
+func (b *cancelBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel(nil)
+	return err
+}
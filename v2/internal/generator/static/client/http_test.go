@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 )
@@ -44,7 +45,7 @@ func TestNewHTTPClient(t *testing.T) {
 
 // TestHTTPClientOptions tests HTTP client options
 func TestHTTPClientOptions(t *testing.T) {
-	customHTTP := &http.Client{Timeout: 10 * time.Second}
+	customHTTP := &http.Client{Timeout: 10 * time.Second, Transport: &http.Transport{}}
 	headers := map[string]string{"X-Custom": "value"}
 
 	client := NewHTTPClient(
@@ -55,6 +56,7 @@ func TestHTTPClientOptions(t *testing.T) {
 		WithTimeout(60*time.Second),
 		WithHTTPClient(customHTTP),
 		WithHeaders(headers),
+		WithPrefetch(3),
 	)
 
 	if client.retryMax != 10 {
@@ -69,13 +71,25 @@ func TestHTTPClientOptions(t *testing.T) {
 		t.Errorf("timeout = %v, want %v", client.timeout, 60*time.Second)
 	}
 
-	if client.httpClient != customHTTP {
-		t.Error("httpClient should be the custom client")
+	if client.httpClient == customHTTP {
+		t.Error("httpClient should be a copy of the custom client")
+	}
+
+	if client.httpClient.Transport != customHTTP.Transport {
+		t.Error("httpClient should use the transport of the custom client")
+	}
+
+	if customHTTP.Timeout != 10*time.Second || customHTTP.CheckRedirect != nil {
+		t.Error("the custom client should not be modified")
 	}
 
 	if client.defaultHeaders["X-Custom"] != "value" {
 		t.Error("defaultHeaders should contain custom header")
 	}
+
+	if client.Prefetch() != 3 {
+		t.Errorf("Prefetch() = %d, want 3", client.Prefetch())
+	}
 }
 
 // TestWithHeader tests creating a client with additional headers
@@ -108,6 +122,10 @@ func TestWithHeader(t *testing.T) {
 	if withHeader.token != original.token {
 		t.Error("token should be copied")
 	}
+
+	if withHeader.rateLimit != original.rateLimit {
+		t.Error("rate limit backoff should be shared")
+	}
 }
 
 // TestHTTPClientGet tests GET requests
@@ -354,6 +372,66 @@ func TestHTTPClientRetryOn429(t *testing.T) {
 	}
 }
 
+// TestHTTPClientRateLimitHoldsConcurrentRequests tests that requests wait while
+// another request backs off from a 429 response
+func TestHTTPClientRateLimitHoldsConcurrentRequests(t *testing.T) {
+	var limited atomic.Bool
+	var secondRequests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/second" {
+			secondRequests.Add(1)
+		} else if limited.CompareAndSwap(false, true) {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	sleeps := make(chan time.Duration)
+	release := make(chan struct{})
+	client := NewHTTPClient(server.URL, "test-token", withSleepFunc(func(d time.Duration) {
+		sleeps <- d
+		<-release
+	}))
+
+	results := make(chan error, 2)
+	get := func(c *HTTPClient, path string) {
+		resp, err := c.Get(context.Background(), path, nil)
+		if err == nil {
+			_ = resp.Body.Close()
+		}
+		results <- err
+	}
+
+	// The first request backs off from the 429 response
+	go get(client, "/first")
+	backoff := <-sleeps
+
+	// A concurrent request of a derived client waits for the backoff to end
+	go get(client.WithHeader("X-Test", "value"), "/second")
+	wait := <-sleeps
+	if wait <= 0 || wait > backoff {
+		t.Errorf("Expected to wait at most %v, got %v", backoff, wait)
+	}
+	if n := secondRequests.Load(); n != 0 {
+		t.Errorf("Expected no request sent during the backoff, got %d", n)
+	}
+
+	close(release)
+	for range 2 {
+		if err := <-results; err != nil {
+			t.Errorf("Get() error: %v", err)
+		}
+	}
+	if n := secondRequests.Load(); n != 1 {
+		t.Errorf("Expected the request to be sent after the backoff, got %d", n)
+	}
+	if wait := client.rateLimit.remaining(); wait != 0 {
+		t.Errorf("Expected the backoff to be released, %v remaining", wait)
+	}
+}
+
 // TestHTTPClientRetryOn5xx tests retry logic for 5xx responses (when enabled)
 func TestHTTPClientRetryOn5xx(t *testing.T) {
 	attempts := 0
@@ -460,6 +538,64 @@ func TestHTTPClientContextCancellation(t *testing.T) {
 	}
 }
 
+// TestHTTPClientTimeoutSparesStreamedBodies tests the timeout only bounds the wait for
+// the response headers, so downloads streaming for longer are not cut off
+func TestHTTPClientTimeoutSparesStreamedBodies(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.WriteHeader(http.StatusOK)
+		for i := 0; i < 5; i++ {
+			_, _ = w.Write([]byte("chunk\n"))
+			w.(http.Flusher).Flush()
+			time.Sleep(40 * time.Millisecond)
+		}
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(server.URL, "test-token", WithTimeout(50*time.Millisecond))
+	if client.httpClient.Timeout != 0 {
+		t.Errorf("http.Client.Timeout = %v, want none", client.httpClient.Timeout)
+	}
+
+	resp, err := client.Get(context.Background(), "/download", nil)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	defer resp.Body.Close()
+
+	start := time.Now()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("ReadAll() error = %v after %s", err, time.Since(start))
+	}
+	if got := strings.Count(string(body), "chunk\n"); got != 5 {
+		t.Errorf("Expected 5 chunks, got %d", got)
+	}
+	if elapsed := time.Since(start); elapsed < 50*time.Millisecond {
+		t.Errorf("Expected the body to stream for longer than the timeout, took %s", elapsed)
+	}
+}
+
+// TestHTTPClientTimeoutWaitingForHeaders tests an attempt fails when the response
+// headers do not arrive within the timeout
+func TestHTTPClientTimeoutWaitingForHeaders(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+
+	client := NewHTTPClient(server.URL, "test-token", WithRetryMax(0), WithTimeout(20*time.Millisecond))
+	_, err := client.Get(context.Background(), "/slow", nil)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected context.DeadlineExceeded, got: %v", err)
+	}
+}
+
 // TestHTTPClientCustomHeaders tests custom headers
 func TestHTTPClientCustomHeaders(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -0,0 +1,95 @@
+package client
+
+import "encoding/json"
+
+// IncludeResolver is implemented by the generated resources, which replace
+// their relationships with the matching included resources
+type IncludeResolver interface {
+	ResolveIncludes(included *Included)
+}
+
+// Included indexes the resources included in a JSON:API response by type and ID
+type Included struct {
+	resources map[ResourceIdentifier]json.RawMessage
+	// resolving holds the resources being resolved, to stop at relationship cycles
+	resolving map[ResourceIdentifier]bool
+}
+
+// NewIncluded indexes the included member of a response, e.g. JSONAPIDocument.Included
+func NewIncluded(included []json.RawMessage) *Included {
+	in := &Included{
+		resources: make(map[ResourceIdentifier]json.RawMessage, len(included)),
+		resolving: make(map[ResourceIdentifier]bool),
+	}
+	for _, raw := range included {
+		var id ResourceIdentifier
+		if err := json.Unmarshal(raw, &id); err != nil || id.ID == "" || id.Type == "" {
+			continue
+		}
+		in.resources[id] = raw
+	}
+	return in
+}
+
+// NewIncludedFromMaps indexes included resources decoded as generic maps
+func NewIncludedFromMaps(included []map[string]interface{}) *Included {
+	raw := make([]json.RawMessage, 0, len(included))
+	for _, inc := range included {
+		data, err := json.Marshal(inc)
+		if err != nil {
+			continue
+		}
+		raw = append(raw, data)
+	}
+	return NewIncluded(raw)
+}
+
+// Len returns the number of included resources
+func (in *Included) Len() int {
+	if in == nil {
+		return 0
+	}
+	return len(in.resources)
+}
+
+// Raw returns the included resource with the given type and ID, as sent by the API
+func (in *Included) Raw(resourceType, id string) (json.RawMessage, bool) {
+	if in == nil {
+		return nil, false
+	}
+	raw, ok := in.resources[ResourceIdentifier{ID: id, Type: resourceType}]
+	return raw, ok
+}
+
+// Resolve decodes the included resource with the given type and ID. Its own
+// relationships are resolved in turn, which handles nested include paths such
+// as "workspace.environment". It returns false if the resource is not included.
+//
+// Example:
+//
+//	env, ok := client.Resolve[schemas.Environment](included, "environments", "env-123")
+func Resolve[T any, PT interface {
+	*T
+	IncludeResolver
+}](in *Included, resourceType, id string) (*T, bool) {
+	raw, ok := in.Raw(resourceType, id)
+	if !ok {
+		return nil, false
+	}
+
+	var v T
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return nil, false
+	}
+
+	// A resource already being resolved is part of a relationship cycle,
+	// its relationships are left as identifiers.
+	key := ResourceIdentifier{ID: id, Type: resourceType}
+	if !in.resolving[key] {
+		in.resolving[key] = true
+		PT(&v).ResolveIncludes(in)
+		delete(in.resolving, key)
+	}
+
+	return &v, true
+}
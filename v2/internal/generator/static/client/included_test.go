@@ -0,0 +1,94 @@
+package client
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// testWorkspace and testEnvironment mimic the generated resources
+type testWorkspace struct {
+	ID            string `json:"id"`
+	Type          string `json:"type"`
+	Relationships struct {
+		Environment *testEnvironment `json:"environment"`
+	} `json:"relationships"`
+}
+
+func (r *testWorkspace) ResolveIncludes(included *Included) {
+	if env := r.Relationships.Environment; env != nil {
+		if full, ok := Resolve[testEnvironment](included, env.Type, env.ID); ok {
+			r.Relationships.Environment = full
+		}
+	}
+}
+
+type testEnvironment struct {
+	ID         string `json:"id"`
+	Type       string `json:"type"`
+	Attributes struct {
+		Name string `json:"name"`
+	} `json:"attributes"`
+	Relationships struct {
+		Workspace *testWorkspace `json:"workspace"`
+	} `json:"relationships"`
+}
+
+func (r *testEnvironment) ResolveIncludes(included *Included) {
+	if ws := r.Relationships.Workspace; ws != nil {
+		if full, ok := Resolve[testWorkspace](included, ws.Type, ws.ID); ok {
+			r.Relationships.Workspace = full
+		}
+	}
+}
+
+// TestIncluded tests indexing and resolving included resources
+func TestIncluded(t *testing.T) {
+	var doc struct {
+		Included []json.RawMessage `json:"included"`
+	}
+	err := json.Unmarshal([]byte(`{"included": [
+		{"id": "ws-1", "type": "workspaces", "relationships": {"environment": {"id": "env-1", "type": "environments"}}},
+		{"id": "env-1", "type": "environments", "attributes": {"name": "prod"}, "relationships": {"workspace": {"id": "ws-1", "type": "workspaces"}}},
+		{"type": "invalid"}
+	]}`), &doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	included := NewIncluded(doc.Included)
+	if included.Len() != 2 {
+		t.Fatalf("Len() = %d, want 2", included.Len())
+	}
+	if _, ok := included.Raw("environments", "env-1"); !ok {
+		t.Error("Raw() did not find the included environment")
+	}
+
+	t.Run("nested", func(t *testing.T) {
+		ws, ok := Resolve[testWorkspace](included, "workspaces", "ws-1")
+		if !ok {
+			t.Fatal("Resolve() did not find the included workspace")
+		}
+		env := ws.Relationships.Environment
+		if env == nil || env.Attributes.Name != "prod" {
+			t.Fatalf("environment = %+v, want the included environment", env)
+		}
+		// The cycle back to the workspace is decoded, but not resolved any further
+		back := env.Relationships.Workspace
+		if back == nil || back.ID != "ws-1" {
+			t.Fatalf("workspace = %+v, want the included workspace", back)
+		}
+		if again := back.Relationships.Environment; again == nil || again.Attributes.Name != "" {
+			t.Errorf("environment = %+v, want an identifier", again)
+		}
+	})
+
+	t.Run("not included", func(t *testing.T) {
+		if _, ok := Resolve[testWorkspace](included, "workspaces", "ws-2"); ok {
+			t.Error("Resolve() found a workspace which was not included")
+		}
+		var empty *Included
+		if _, ok := Resolve[testWorkspace](empty, "workspaces", "ws-1"); ok || empty.Len() != 0 {
+			t.Error("Resolve() found a workspace in a nil index")
+		}
+	})
+}
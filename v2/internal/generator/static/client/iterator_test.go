@@ -3,7 +3,10 @@ package client
 import (
 	"context"
 	"errors"
+	"fmt"
+	"sync/atomic"
 	"testing"
+	"time"
 )
 
 // TestIteratorBasic tests basic iterator functionality
@@ -447,6 +450,166 @@ func TestIteratorSinglePage(t *testing.T) {
 }
 
 // Helper function to create string pointer
+// prefetchPages returns a fetch function of total pages with 2 items each, named
+// after their page, calling before for each page before returning it
+func prefetchPages(total int, before func(ctx context.Context, pageNum int) error) func(context.Context, int) ([]*string, *Pagination, error) {
+	return func(ctx context.Context, pageNum int) ([]*string, *Pagination, error) {
+		if err := before(ctx, pageNum); err != nil {
+			return nil, nil, err
+		}
+
+		var next *int
+		if pageNum < total {
+			next = ptr(pageNum + 1)
+		}
+		items := []*string{ptr(fmt.Sprintf("%d-a", pageNum)), ptr(fmt.Sprintf("%d-b", pageNum))}
+		return items, &Pagination{CurrentPage: pageNum, TotalPages: total, TotalCount: 2 * total, NextPage: next}, nil
+	}
+}
+
+// TestIteratorPrefetchOrder tests that prefetched pages keep their order and that
+// no more pages than allowed are fetched at once
+func TestIteratorPrefetchOrder(t *testing.T) {
+	const total, prefetch = 8, 3
+
+	var inFlight, maxInFlight atomic.Int32
+	fetchPage := prefetchPages(total, func(ctx context.Context, pageNum int) error {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			m := maxInFlight.Load()
+			if n <= m || maxInFlight.CompareAndSwap(m, n) {
+				break
+			}
+		}
+		// Later pages are returned first
+		time.Sleep(time.Duration(total-pageNum) * time.Millisecond)
+		return nil
+	})
+
+	iter := NewIterator(context.Background(), 2, fetchPage).Prefetch(prefetch)
+	var collected []string
+	for iter.Next() {
+		collected = append(collected, *iter.Value())
+	}
+	if err := iter.Err(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(collected) != 2*total {
+		t.Fatalf("Expected %d items, got %v", 2*total, collected)
+	}
+	for i, item := range collected {
+		if want := fmt.Sprintf("%d-%c", i/2+1, 'a'+i%2); item != want {
+			t.Errorf("Item %d: expected %q, got %q", i, want, item)
+		}
+	}
+	if got := maxInFlight.Load(); got > prefetch+1 {
+		t.Errorf("Expected at most %d pages fetched at once, got %d", prefetch+1, got)
+	}
+	if got := maxInFlight.Load(); got < 2 {
+		t.Errorf("Expected pages to be fetched concurrently, got %d at most", got)
+	}
+}
+
+// TestIteratorPrefetchError tests that the first error in page order stops the iteration
+func TestIteratorPrefetchError(t *testing.T) {
+	expectedErr := errors.New("page 3 failed")
+	fetchPage := prefetchPages(10, func(ctx context.Context, pageNum int) error {
+		switch pageNum {
+		case 3:
+			time.Sleep(5 * time.Millisecond)
+			return expectedErr
+		case 4:
+			return errors.New("page 4 failed")
+		}
+		return nil
+	})
+
+	iter := NewIterator(context.Background(), 2, fetchPage).Prefetch(2)
+	var collected []string
+	for iter.Next() {
+		collected = append(collected, *iter.Value())
+	}
+
+	if !errors.Is(iter.Err(), expectedErr) {
+		t.Errorf("Expected error %v, got %v", expectedErr, iter.Err())
+	}
+	if len(collected) != 4 {
+		t.Errorf("Expected the items of the first 2 pages, got %v", collected)
+	}
+	if iter.Next() {
+		t.Error("Next() should keep returning false after an error")
+	}
+}
+
+// TestIteratorPrefetchContextCancellation tests that canceling the context stops
+// the iteration while pages are fetched in the background
+func TestIteratorPrefetchContextCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stopped := make(chan error, 1)
+	fetchPage := prefetchPages(5, func(ctx context.Context, pageNum int) error {
+		if pageNum != 2 {
+			return nil
+		}
+		<-ctx.Done()
+		stopped <- ctx.Err()
+		return ctx.Err()
+	})
+
+	iter := NewIterator(ctx, 2, fetchPage).Prefetch(2)
+	if !iter.Next() || !iter.Next() {
+		t.Fatalf("Expected the items of the first page, error: %v", iter.Err())
+	}
+
+	time.AfterFunc(5*time.Millisecond, cancel)
+	if iter.Next() {
+		t.Fatal("Next() should return false after cancellation")
+	}
+	if !errors.Is(iter.Err(), context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", iter.Err())
+	}
+	if err := <-stopped; !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected the background fetch to be canceled, got %v", err)
+	}
+}
+
+// TestIteratorPrefetchClose tests that Close stops the background fetches
+func TestIteratorPrefetchClose(t *testing.T) {
+	started := make(chan struct{})
+	stopped := make(chan error, 1)
+	fetchPage := prefetchPages(5, func(ctx context.Context, pageNum int) error {
+		if pageNum != 3 {
+			return nil
+		}
+		close(started)
+		<-ctx.Done()
+		stopped <- ctx.Err()
+		return ctx.Err()
+	})
+
+	iter := NewIterator(context.Background(), 2, fetchPage).Prefetch(2)
+	for range 3 {
+		if !iter.Next() {
+			t.Fatalf("Expected an item, error: %v", iter.Err())
+		}
+	}
+
+	// Page 3 is fetched in the background along with page 2
+	<-started
+	iter.Close()
+	iter.Close()
+
+	if err := <-stopped; !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected the background fetch to be canceled, got %v", err)
+	}
+	if err := iter.Err(); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
 func ptr[T any](v T) *T {
 	return &v
 }
@@ -0,0 +1,140 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// ErrMutationBlocked indicates a call which would have mutated Scalr was not
+// sent because the client is read-only or in dry-run mode
+var ErrMutationBlocked = errors.New("mutation blocked")
+
+// Mutation is a call which would have mutated Scalr
+type Mutation struct {
+	// Method is POST, PATCH, PUT or DELETE
+	Method string
+	// Path of the called resource, relative to the base URL
+	Path string
+	// Body that would have been sent, if any. It is nil for raw and multipart
+	// bodies, which are not read in read-only and dry-run modes.
+	Body []byte
+	// ContentType of the body that would have been sent, if any
+	ContentType string
+}
+
+// MutationBlockedError is returned instead of sending a call which would have mutated Scalr
+type MutationBlockedError struct {
+	Mutation Mutation
+}
+
+func (e *MutationBlockedError) Error() string {
+	return fmt.Sprintf("mutation blocked: %s %s", e.Mutation.Method, e.Mutation.Path)
+}
+
+func (e *MutationBlockedError) Is(target error) bool { return target == ErrMutationBlocked }
+
+// MutationPlan records the calls simulated by a client in dry-run mode.
+// The zero value is an empty plan ready to use.
+type MutationPlan struct {
+	mu        sync.Mutex
+	mutations []Mutation
+}
+
+// Mutations returns the recorded mutations, in the order they were attempted
+func (p *MutationPlan) Mutations() []Mutation {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return append([]Mutation(nil), p.mutations...)
+}
+
+// Reset empties the plan
+func (p *MutationPlan) Reset() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.mutations = nil
+}
+
+func (p *MutationPlan) add(m Mutation) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.mutations = append(p.mutations, m)
+}
+
+// String renders the plan in a Terraform plan fashion: one line per mutation,
+// prefixed with "+" for creations, "~" for changes and "-" for deletions and
+// followed by its body, then a summary of the number of changes.
+func (p *MutationPlan) String() string {
+	mutations := p.Mutations()
+	if len(mutations) == 0 {
+		return "No changes."
+	}
+
+	var b strings.Builder
+	var create, change, destroy int
+	for _, m := range mutations {
+		symbol := "~"
+		switch m.Method {
+		case http.MethodPost:
+			symbol = "+"
+			create++
+		case http.MethodDelete:
+			symbol = "-"
+			destroy++
+		default:
+			change++
+		}
+
+		fmt.Fprintf(&b, "%s %s %s\n", symbol, m.Method, m.Path)
+		if len(m.Body) == 0 && m.ContentType != "" {
+			mediaType, _, _ := strings.Cut(m.ContentType, ";")
+			fmt.Fprintf(&b, "    <%s body>\n", mediaType)
+		}
+		if len(m.Body) > 0 {
+			var body bytes.Buffer
+			if err := json.Indent(&body, m.Body, "", "  "); err != nil {
+				body.Reset()
+				fmt.Fprintf(&body, "(%d bytes)", len(m.Body))
+			}
+			for _, line := range strings.Split(body.String(), "\n") {
+				fmt.Fprintf(&b, "    %s\n", line)
+			}
+		}
+	}
+	fmt.Fprintf(&b, "\nPlan: %d to create, %d to change, %d to destroy.", create, change, destroy)
+
+	return b.String()
+}
+
+// guardMutation returns a MutationBlockedError for calls mutating Scalr when
+// the client is read-only or in dry-run mode, recording them into the plan of the latter
+func (c *HTTPClient) guardMutation(method, path string, body []byte, contentType string) error {
+	switch method {
+	case http.MethodPost, http.MethodPatch, http.MethodPut, http.MethodDelete:
+	default:
+		return nil
+	}
+	if !c.readOnly && c.dryRun == nil {
+		return nil
+	}
+
+	m := Mutation{Method: method, Path: path, Body: body, ContentType: contentType}
+	if c.dryRun != nil {
+		c.dryRun.add(m)
+	}
+
+	c.logger.Info("Mutation blocked",
+		"method", method,
+		"path", path,
+		"dryRun", c.dryRun != nil,
+	)
+
+	return &MutationBlockedError{Mutation: m}
+}
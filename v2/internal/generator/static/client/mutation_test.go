@@ -0,0 +1,132 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func newMutationServer(t *testing.T) (*httptest.Server, *int32) {
+	var mutations int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			atomic.AddInt32(&mutations, 1)
+		}
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		_, _ = w.Write([]byte(`{"data":{"id":"ws-1","type":"workspaces"}}`))
+	}))
+	t.Cleanup(server.Close)
+	return server, &mutations
+}
+
+// TestReadOnly tests that mutations are rejected without being sent
+func TestReadOnly(t *testing.T) {
+	server, mutations := newMutationServer(t)
+	client := NewHTTPClient(server.URL, "token", WithReadOnly())
+	ctx := context.Background()
+
+	resp, err := client.Get(ctx, "/workspaces/ws-1", nil)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	_ = resp.Body.Close()
+
+	_, err = client.Patch(ctx, "/workspaces/ws-1", map[string]string{"name": "new"}, nil)
+	if !errors.Is(err, ErrMutationBlocked) {
+		t.Fatalf("Patch() error = %v, want ErrMutationBlocked", err)
+	}
+
+	var blocked *MutationBlockedError
+	if !errors.As(err, &blocked) {
+		t.Fatalf("Patch() error type = %T, want *MutationBlockedError", err)
+	}
+	if blocked.Mutation.Method != http.MethodPatch || blocked.Mutation.Path != "/workspaces/ws-1" {
+		t.Errorf("Mutation = %s %s, want PATCH /workspaces/ws-1", blocked.Mutation.Method, blocked.Mutation.Path)
+	}
+	if string(blocked.Mutation.Body) != `{"name":"new"}` || blocked.Mutation.ContentType != "application/vnd.api+json" {
+		t.Errorf("Mutation.Body = %s (%s)", blocked.Mutation.Body, blocked.Mutation.ContentType)
+	}
+
+	// Derived clients keep the guard
+	if _, err := client.WithHeader("X-Test", "1").Delete(ctx, "/workspaces/ws-1", nil, nil); !errors.Is(err, ErrMutationBlocked) {
+		t.Errorf("Delete() error = %v, want ErrMutationBlocked", err)
+	}
+
+	if n := atomic.LoadInt32(mutations); n != 0 {
+		t.Errorf("server received %d mutations, want 0", n)
+	}
+}
+
+// TestDryRun tests that mutations are recorded into the plan without being sent
+func TestDryRun(t *testing.T) {
+	server, mutations := newMutationServer(t)
+	plan := &MutationPlan{}
+	client := NewHTTPClient(server.URL, "token", WithDryRun(plan))
+	ctx := context.Background()
+
+	if got := plan.String(); got != "No changes." {
+		t.Errorf("empty plan = %q", got)
+	}
+
+	if _, err := client.Post(ctx, "/workspaces", map[string]string{"name": "ws"}, nil); !errors.Is(err, ErrMutationBlocked) {
+		t.Errorf("Post() error = %v, want ErrMutationBlocked", err)
+	}
+	if _, err := client.Put(ctx, "/workspaces/ws-1/tags", []string{"tag-1"}, nil); !errors.Is(err, ErrMutationBlocked) {
+		t.Errorf("Put() error = %v, want ErrMutationBlocked", err)
+	}
+	if _, err := client.Delete(ctx, "/workspaces/ws-1", nil, nil); !errors.Is(err, ErrMutationBlocked) {
+		t.Errorf("Delete() error = %v, want ErrMutationBlocked", err)
+	}
+	// Raw bodies are recorded by their content type, without being read
+	archive := &failingReader{}
+	if _, err := client.Put(ctx, "/configuration-versions/cv-1/upload", NewMultipart("file", "config.tar.gz", archive), nil); !errors.Is(err, ErrMutationBlocked) {
+		t.Errorf("Put() error = %v, want ErrMutationBlocked", err)
+	}
+
+	if n := atomic.LoadInt32(mutations); n != 0 {
+		t.Errorf("server received %d mutations, want 0", n)
+	}
+	if archive.reads != 0 {
+		t.Errorf("raw body read %d times, want 0", archive.reads)
+	}
+
+	if got := len(plan.Mutations()); got != 4 {
+		t.Fatalf("len(Mutations()) = %d, want 4", got)
+	}
+	if m := plan.Mutations()[3]; m.Body != nil || !strings.HasPrefix(m.ContentType, "multipart/form-data; boundary=") {
+		t.Errorf("Mutation = %q (%s), want no body and a multipart content type", m.Body, m.ContentType)
+	}
+
+	out := plan.String()
+	for _, want := range []string{
+		"+ POST /workspaces\n",
+		`    {` + "\n" + `      "name": "ws"` + "\n" + `    }`,
+		"~ PUT /workspaces/ws-1/tags\n",
+		"- DELETE /workspaces/ws-1\n",
+		"~ PUT /configuration-versions/cv-1/upload\n    <multipart/form-data body>\n",
+		"Plan: 1 to create, 2 to change, 1 to destroy.",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("plan does not contain %q:\n%s", want, out)
+		}
+	}
+
+	plan.Reset()
+	if got := len(plan.Mutations()); got != 0 {
+		t.Errorf("len(Mutations()) after Reset() = %d, want 0", got)
+	}
+}
+
+// failingReader counts its reads, which fail
+type failingReader struct {
+	reads int
+}
+
+func (r *failingReader) Read(p []byte) (int, error) {
+	r.reads++
+	return 0, errors.New("read failed")
+}
@@ -0,0 +1,56 @@
+package client
+
+import (
+	"sync"
+	"time"
+)
+
+// rateLimitPause holds back new requests while another request backs off from a
+// 429 response, so that concurrent requests, e.g. prefetched pages, don't keep
+// hitting the rate limit
+type rateLimitPause struct {
+	mu    sync.Mutex
+	until time.Time
+}
+
+// hold pauses new requests for d, unless they are already paused for longer.
+// It returns the end of the pause, to be passed to release.
+func (p *rateLimitPause) hold(d time.Duration) time.Time {
+	if p == nil {
+		return time.Time{}
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if until := time.Now().Add(d); until.After(p.until) {
+		p.until = until
+	}
+	return p.until
+}
+
+// release ends the pause once its backoff is over, unless another request extended it
+func (p *rateLimitPause) release(until time.Time) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.until.Equal(until) {
+		p.until = time.Time{}
+	}
+}
+
+// remaining returns how long new requests must still wait
+func (p *rateLimitPause) remaining() time.Duration {
+	if p == nil {
+		return 0
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.until.IsZero() {
+		return 0
+	}
+	return time.Until(p.until)
+}
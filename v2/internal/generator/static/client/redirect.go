@@ -0,0 +1,22 @@
+package client
+
+import (
+	"errors"
+	"net/http"
+)
+
+// maxRedirects matches the redirect policy of net/http
+const maxRedirects = 10
+
+// checkRedirect follows redirects to temporary download URLs, such as presigned
+// storage URLs, without forwarding the bearer token to another host.
+// Unlike net/http, the token is not forwarded to subdomains either.
+func checkRedirect(req *http.Request, via []*http.Request) error {
+	if len(via) >= maxRedirects {
+		return errors.New("stopped after 10 redirects")
+	}
+	if req.URL.Host != via[0].URL.Host {
+		req.Header.Del("Authorization")
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestRedirectWithoutToken tests following a redirect to a download URL on another host
+func TestRedirectWithoutToken(t *testing.T) {
+	var storageAuth string
+	storage := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		storageAuth = r.Header.Get("Authorization")
+		_, _ = w.Write([]byte("archive"))
+	}))
+	defer storage.Close()
+
+	var apiAuth string
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/download":
+			http.Redirect(w, r, storage.URL+"/presigned?signature=abc", http.StatusFound)
+		case "/moved":
+			http.Redirect(w, r, "/final", http.StatusMovedPermanently)
+		default:
+			apiAuth = r.Header.Get("Authorization")
+			_, _ = w.Write([]byte("final"))
+		}
+	}))
+	defer api.Close()
+
+	client := NewHTTPClient(api.URL, "test-token", WithRetryMax(0))
+
+	resp, err := client.Get(context.Background(), "/download", nil)
+	if err != nil {
+		t.Fatalf("Get() error: %v", err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+
+	if string(body) != "archive" {
+		t.Errorf("body = %q, want %q", body, "archive")
+	}
+	if storageAuth != "" {
+		t.Errorf("Authorization forwarded to the download URL: %q", storageAuth)
+	}
+
+	// Redirects within the API keep the token
+	resp, err = client.Get(context.Background(), "/moved", nil)
+	if err != nil {
+		t.Fatalf("Get() error: %v", err)
+	}
+	_ = resp.Body.Close()
+
+	if apiAuth != "Bearer test-token" {
+		t.Errorf("Authorization = %q, want the bearer token", apiAuth)
+	}
+}
@@ -0,0 +1,150 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"time"
+)
+
+// RunWaitOutcome describes why WaitForRun stopped polling a run
+type RunWaitOutcome string
+
+const (
+	// RunWaitFinished means the run reached a final status and will not change anymore
+	RunWaitFinished RunWaitOutcome = "finished"
+	// RunWaitAwaitingConfirmation means the run is paused until it is confirmed,
+	// discarded or, for a policy override, approved
+	RunWaitAwaitingConfirmation RunWaitOutcome = "awaiting_confirmation"
+)
+
+// RunState is the subset of run attributes WaitForRun needs to make decisions
+type RunState struct {
+	Status    string
+	AutoApply bool
+}
+
+// RunWaitOptions configures WaitForRun
+type RunWaitOptions struct {
+	// OnStatusChange is invoked each time the run status changes.
+	// The previous status is empty for the first observed status.
+	OnStatusChange func(status, previous string)
+
+	// MinInterval is the initial delay between two polls. Default: 1s
+	MinInterval time.Duration
+
+	// MaxInterval caps the delay between two polls. Default: 15s.
+	// A value below MinInterval is raised to MinInterval
+	MaxInterval time.Duration
+}
+
+// RunWaitResult holds the last fetched run and the reason waiting stopped
+type RunWaitResult[T any] struct {
+	Run     *T
+	Status  string
+	Outcome RunWaitOutcome
+}
+
+// IsFinalRunStatus reports whether the run status is final
+func IsFinalRunStatus(status string) bool {
+	switch status {
+	case "applied", "planned_and_finished", "errored", "canceled", "discarded":
+		return true
+	}
+	return false
+}
+
+// WaitForRun polls a run until it reaches a final status or is paused waiting for confirmation.
+// The delay between polls grows exponentially with jitter, from MinInterval up to MaxInterval,
+// and is reset whenever the status changes.
+//
+// A run in the planned status is only considered as awaiting confirmation when auto-apply
+// is disabled for it, as otherwise it proceeds on its own. A saved plan run always awaits
+// confirmation once planned.
+//
+// Example:
+//
+//	res, err := client.WaitForRun(ctx,
+//	    func(ctx context.Context) (*schemas.Run, error) {
+//	        return c.Run.GetRun(ctx, runID, nil)
+//	    },
+//	    func(r *schemas.Run) client.RunState {
+//	        return client.RunState{Status: string(r.Attributes.Status), AutoApply: r.Attributes.AutoApply}
+//	    },
+//	    nil,
+//	)
+func WaitForRun[T any](
+	ctx context.Context,
+	fetch func(context.Context) (*T, error),
+	state func(*T) RunState,
+	opts *RunWaitOptions,
+) (*RunWaitResult[T], error) {
+	if fetch == nil || state == nil {
+		return nil, errors.New("fetch and state functions are required")
+	}
+
+	minInterval := time.Second
+	maxInterval := 15 * time.Second
+	var onStatusChange func(status, previous string)
+	if opts != nil {
+		if opts.MinInterval > 0 {
+			minInterval = opts.MinInterval
+		}
+		if opts.MaxInterval > 0 {
+			maxInterval = opts.MaxInterval
+		}
+		onStatusChange = opts.OnStatusChange
+	}
+	if maxInterval < minInterval {
+		maxInterval = minInterval
+	}
+
+	var previous string
+	interval := minInterval
+
+	for {
+		run, err := fetch(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			return nil, err
+		}
+
+		s := state(run)
+		if s.Status != previous {
+			if onStatusChange != nil {
+				onStatusChange(s.Status, previous)
+			}
+			previous = s.Status
+			// Poll faster again while the run is moving
+			interval = minInterval
+		}
+
+		switch {
+		case IsFinalRunStatus(s.Status):
+			return &RunWaitResult[T]{Run: run, Status: s.Status, Outcome: RunWaitFinished}, nil
+		case s.Status == "policy_override", s.Status == "planned_and_saved", s.Status == "planned" && !s.AutoApply:
+			return &RunWaitResult[T]{Run: run, Status: s.Status, Outcome: RunWaitAwaitingConfirmation}, nil
+		}
+
+		// Equal jitter in the [interval/2, interval] range
+		wait := interval
+		if half := int64(interval / 2); half > 0 {
+			wait = time.Duration(half + rand.Int63n(half+1))
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+
+		interval *= 2
+		if interval > maxInterval {
+			interval = maxInterval
+		}
+	}
+}
@@ -0,0 +1,119 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+type testRun struct {
+	status    string
+	autoApply bool
+}
+
+// runSequence returns a fetch function yielding the given statuses in order,
+// repeating the last one once the sequence is exhausted
+func runSequence(autoApply bool, statuses ...string) (func(context.Context) (*testRun, error), *int) {
+	calls := 0
+	return func(ctx context.Context) (*testRun, error) {
+		status := statuses[len(statuses)-1]
+		if calls < len(statuses) {
+			status = statuses[calls]
+		}
+		calls++
+		return &testRun{status: status, autoApply: autoApply}, nil
+	}, &calls
+}
+
+func testRunState(r *testRun) RunState {
+	return RunState{Status: r.status, AutoApply: r.autoApply}
+}
+
+var fastWait = &RunWaitOptions{MinInterval: time.Millisecond, MaxInterval: 2 * time.Millisecond}
+
+// TestWaitForRunFinished tests waiting until a final status and status callbacks
+func TestWaitForRunFinished(t *testing.T) {
+	fetch, calls := runSequence(true, "pending", "planning", "planning", "planned", "applying", "applied")
+
+	var transitions []string
+	opts := *fastWait
+	opts.OnStatusChange = func(status, previous string) {
+		transitions = append(transitions, previous+"->"+status)
+	}
+
+	res, err := WaitForRun(context.Background(), fetch, testRunState, &opts)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if res.Outcome != RunWaitFinished || res.Status != "applied" {
+		t.Errorf("Expected finished/applied, got %s/%s", res.Outcome, res.Status)
+	}
+	if *calls != 6 {
+		t.Errorf("Expected 6 fetches, got %d", *calls)
+	}
+
+	expected := []string{"->pending", "pending->planning", "planning->planned", "planned->applying", "applying->applied"}
+	if len(transitions) != len(expected) {
+		t.Fatalf("Expected transitions %v, got %v", expected, transitions)
+	}
+	for i := range expected {
+		if transitions[i] != expected[i] {
+			t.Errorf("Transition %d: expected %q, got %q", i, expected[i], transitions[i])
+		}
+	}
+}
+
+// TestWaitForRunAwaitingConfirmation tests statuses that pause a run
+func TestWaitForRunAwaitingConfirmation(t *testing.T) {
+	tests := []struct {
+		name      string
+		autoApply bool
+		statuses  []string
+	}{
+		{name: "planned without auto-apply", autoApply: false, statuses: []string{"planning", "planned"}},
+		{name: "policy override", autoApply: true, statuses: []string{"policy_checking", "policy_override"}},
+		{name: "saved plan", autoApply: false, statuses: []string{"planning", "planned_and_saved"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fetch, _ := runSequence(tt.autoApply, tt.statuses...)
+
+			res, err := WaitForRun(context.Background(), fetch, testRunState, fastWait)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if res.Outcome != RunWaitAwaitingConfirmation {
+				t.Errorf("Expected outcome %q, got %q", RunWaitAwaitingConfirmation, res.Outcome)
+			}
+			if res.Status != tt.statuses[len(tt.statuses)-1] {
+				t.Errorf("Unexpected status %q", res.Status)
+			}
+		})
+	}
+}
+
+// TestWaitForRunFetchError tests that fetch errors are returned as-is
+func TestWaitForRunFetchError(t *testing.T) {
+	fetchErr := errors.New("boom")
+	fetch := func(ctx context.Context) (*testRun, error) { return nil, fetchErr }
+
+	_, err := WaitForRun(context.Background(), fetch, testRunState, fastWait)
+	if !errors.Is(err, fetchErr) {
+		t.Errorf("Expected fetch error, got %v", err)
+	}
+}
+
+// TestWaitForRunContextCancellation tests that waiting stops when ctx is done
+func TestWaitForRunContextCancellation(t *testing.T) {
+	fetch, _ := runSequence(true, "planning")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err := WaitForRun(ctx, fetch, testRunState, fastWait)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected deadline exceeded, got %v", err)
+	}
+}
@@ -0,0 +1,58 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// JSONKind returns the kind of a JSON value: "object", "array", "string", "number",
+// "boolean" or "null". The generated union types use it to pick their variant.
+func JSONKind(data []byte) string {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 {
+		return ""
+	}
+
+	switch data[0] {
+	case '{':
+		return "object"
+	case '[':
+		return "array"
+	case '"':
+		return "string"
+	case 't', 'f':
+		return "boolean"
+	case 'n':
+		return "null"
+	default:
+		return "number"
+	}
+}
+
+// DecodeStrict decodes a JSON value into v, failing on fields unknown to v.
+// It tells apart the object variants of a union without discriminator.
+func DecodeStrict(data []byte, v any) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	return dec.Decode(v)
+}
+
+// Discriminator returns the value of the discriminator property of a JSON object,
+// which selects the variant of a union
+func Discriminator(data []byte, property string) (string, error) {
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(data, &object); err != nil {
+		return "", err
+	}
+
+	raw, ok := object[property]
+	if !ok {
+		return "", fmt.Errorf("missing discriminator property %q", property)
+	}
+	var value string
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return "", fmt.Errorf("invalid discriminator property %q: %w", property, err)
+	}
+	return value, nil
+}
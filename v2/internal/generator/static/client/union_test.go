@@ -0,0 +1,51 @@
+package client
+
+import "testing"
+
+// TestJSONKind tests detecting the kind of JSON values
+func TestJSONKind(t *testing.T) {
+	tests := map[string]string{
+		`{"a":1}`: "object",
+		` [1]`:    "array",
+		`"text"`:  "string",
+		`-1.5`:    "number",
+		`true`:    "boolean",
+		`false`:   "boolean",
+		`null`:    "null",
+		``:        "",
+	}
+
+	for data, want := range tests {
+		if got := JSONKind([]byte(data)); got != want {
+			t.Errorf("JSONKind(%q) = %q, want %q", data, got, want)
+		}
+	}
+}
+
+// TestDecodeStrict tests rejecting fields unknown to the decoded type
+func TestDecodeStrict(t *testing.T) {
+	var v struct {
+		Name string `json:"name"`
+	}
+
+	if err := DecodeStrict([]byte(`{"name":"a"}`), &v); err != nil || v.Name != "a" {
+		t.Errorf("DecodeStrict() = %v (%+v), want the name decoded", err, v)
+	}
+	if err := DecodeStrict([]byte(`{"name":"a","other":1}`), &v); err == nil {
+		t.Error("Expected an unknown field to fail")
+	}
+}
+
+// TestDiscriminator tests reading the discriminator property of a JSON object
+func TestDiscriminator(t *testing.T) {
+	value, err := Discriminator([]byte(`{"kind":"vcs","repository":"org/repo"}`), "kind")
+	if err != nil || value != "vcs" {
+		t.Errorf("Discriminator() = %q, %v, want %q", value, err, "vcs")
+	}
+
+	for _, data := range []string{`{"repository":"org/repo"}`, `{"kind":1}`, `["vcs"]`} {
+		if _, err := Discriminator([]byte(data), "kind"); err == nil {
+			t.Errorf("Discriminator(%s): expected an error", data)
+		}
+	}
+}
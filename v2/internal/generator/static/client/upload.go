@@ -0,0 +1,224 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"sort"
+	"sync"
+)
+
+// ErrBodyNotRewindable indicates a failed request could not be retried because
+// its body was already consumed and does not implement io.Seeker
+var ErrBodyNotRewindable = errors.New("request body is not rewindable")
+
+// RequestBody is a request body sent as is instead of being marshalled to
+// JSON:API, see RawBody and Multipart. It can be passed to Post, Patch and Put.
+type RequestBody interface {
+	// open returns the body of an attempt, rewound on retries, with its length or -1 if unknown
+	open(attempt int) (io.Reader, int64, error)
+	contentType() string
+}
+
+// rewinder rewinds a reader to the offset it had on the first attempt
+type rewinder struct {
+	start int64
+}
+
+// rewind records the offset of r on the first attempt and seeks back to it on retries.
+// It returns the remaining length of r, or -1 if unknown.
+func (rw *rewinder) rewind(r io.Reader, attempt int) (int64, error) {
+	seeker, ok := r.(io.Seeker)
+	if !ok {
+		if attempt > 0 {
+			return 0, ErrBodyNotRewindable
+		}
+		return -1, nil
+	}
+
+	var err error
+	if attempt == 0 {
+		rw.start, err = seeker.Seek(0, io.SeekCurrent)
+	} else {
+		_, err = seeker.Seek(rw.start, io.SeekStart)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to rewind request body: %w", err)
+	}
+
+	end, err := seeker.Seek(0, io.SeekEnd)
+	if err != nil {
+		return 0, fmt.Errorf("failed to rewind request body: %w", err)
+	}
+	if _, err := seeker.Seek(rw.start, io.SeekStart); err != nil {
+		return 0, fmt.Errorf("failed to rewind request body: %w", err)
+	}
+	return end - rw.start, nil
+}
+
+// RawBody is a request body sent as is, e.g. an archive as application/octet-stream.
+// Retries rewind the reader if it implements io.Seeker, such as *os.File and
+// *bytes.Reader, and fail with ErrBodyNotRewindable otherwise.
+type RawBody struct {
+	reader   io.Reader
+	mimeType string
+	rewinder rewinder
+	// body is the body of the previous attempt
+	body *attemptBody
+}
+
+// NewRawBody creates a request body sending r with the given content type,
+// which defaults to application/octet-stream
+func NewRawBody(r io.Reader, contentType string) *RawBody {
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	return &RawBody{reader: r, mimeType: contentType}
+}
+
+func (b *RawBody) open(attempt int) (io.Reader, int64, error) {
+	// The transport may still be reading the body of the previous attempt,
+	// stop it before the reader is rewound
+	if b.body != nil {
+		_ = b.body.Close()
+		b.body = nil
+	}
+
+	length, err := b.rewinder.rewind(b.reader, attempt)
+	if err != nil {
+		return nil, 0, err
+	}
+	b.body = &attemptBody{reader: b.reader}
+	return b.body, length, nil
+}
+
+func (b *RawBody) contentType() string {
+	return b.mimeType
+}
+
+// attemptBody is the body of an attempt reading a reader owned by the caller.
+// Closing it waits for a read in progress and fails the later ones with io.ErrClosedPipe,
+// so the reader can be rewound for the next attempt.
+type attemptBody struct {
+	mu     sync.Mutex
+	reader io.Reader
+	closed bool
+}
+
+func (b *attemptBody) Read(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		return 0, io.ErrClosedPipe
+	}
+	return b.reader.Read(p)
+}
+
+// Close stops the reads without closing the reader, which belongs to the caller
+func (b *attemptBody) Close() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.closed = true
+	return nil
+}
+
+// MultipartFile is a file part of a Multipart request body
+type MultipartFile struct {
+	// Field is the form field name
+	Field string
+	// Name is the file name sent to the API
+	Name string
+	// Reader holds the file content. Retries rewind it if it implements io.Seeker.
+	Reader io.Reader
+
+	rewinder rewinder
+}
+
+// Multipart is a multipart/form-data request body, streamed while it is sent.
+// Retries fail with ErrBodyNotRewindable if a file reader does not implement io.Seeker.
+type Multipart struct {
+	// Fields holds the form values
+	Fields map[string]string
+	// Files holds the file parts, sent after the form values
+	Files []*MultipartFile
+
+	boundary string
+	// pipe and done belong to the writer of the previous attempt
+	pipe *io.PipeReader
+	done chan struct{}
+}
+
+// NewMultipart creates a multipart/form-data request body with a single file
+func NewMultipart(field, fileName string, r io.Reader) *Multipart {
+	return &Multipart{
+		Files: []*MultipartFile{{Field: field, Name: fileName, Reader: r}},
+	}
+}
+
+func (m *Multipart) open(attempt int) (io.Reader, int64, error) {
+	// The writer of the previous attempt may still be copying the files,
+	// stop it before they are rewound
+	if m.done != nil {
+		m.pipe.Close()
+		<-m.done
+		m.pipe, m.done = nil, nil
+	}
+
+	for _, file := range m.Files {
+		if _, err := file.rewinder.rewind(file.Reader, attempt); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	pr, pw := io.Pipe()
+	writer := multipart.NewWriter(pw)
+	if m.boundary == "" {
+		m.boundary = writer.Boundary()
+	} else if err := writer.SetBoundary(m.boundary); err != nil {
+		return nil, 0, err
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		pw.CloseWithError(m.write(writer))
+	}()
+	m.pipe, m.done = pr, done
+
+	return pr, -1, nil
+}
+
+// write encodes the form values, in a stable order, and the files
+func (m *Multipart) write(writer *multipart.Writer) error {
+	keys := make([]string, 0, len(m.Fields))
+	for key := range m.Fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if err := writer.WriteField(key, m.Fields[key]); err != nil {
+			return err
+		}
+	}
+
+	for _, file := range m.Files {
+		part, err := writer.CreateFormFile(file.Field, file.Name)
+		if err != nil {
+			return err
+		}
+		if _, err := io.Copy(part, file.Reader); err != nil {
+			return err
+		}
+	}
+
+	return writer.Close()
+}
+
+func (m *Multipart) contentType() string {
+	if m.boundary == "" {
+		m.boundary = multipart.NewWriter(io.Discard).Boundary()
+	}
+	return "multipart/form-data; boundary=" + m.boundary
+}
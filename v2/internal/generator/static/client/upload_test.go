@@ -0,0 +1,235 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// TestRawBody tests uploading a body as is, rewound on retries
+func TestRawBody(t *testing.T) {
+	var attempts int
+	var bodies []string
+	var contentType string
+	var contentLength int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		contentType = r.Header.Get("Content-Type")
+		contentLength = r.ContentLength
+		if attempts == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(server.URL, "test-token", WithRetryMax(2), withSleepFunc(func(time.Duration) {}))
+
+	t.Run("rewindable", func(t *testing.T) {
+		attempts, bodies = 0, nil
+
+		archive := bytes.NewReader([]byte("..archive"))
+		_, _ = archive.Seek(2, io.SeekStart) // Sent from the current offset
+
+		resp, err := client.Put(context.Background(), "/upload", NewRawBody(archive, ""), nil)
+		if err != nil {
+			t.Fatalf("Put() error: %v", err)
+		}
+		_ = resp.Body.Close()
+
+		if len(bodies) != 2 || bodies[0] != "archive" || bodies[1] != "archive" {
+			t.Errorf("bodies = %q, want the archive twice", bodies)
+		}
+		if contentType != "application/octet-stream" {
+			t.Errorf("Content-Type = %q, want %q", contentType, "application/octet-stream")
+		}
+		if contentLength != int64(len("archive")) {
+			t.Errorf("Content-Length = %d, want %d", contentLength, len("archive"))
+		}
+	})
+
+	t.Run("not rewindable", func(t *testing.T) {
+		attempts, bodies = 0, nil
+
+		archive := io.MultiReader(strings.NewReader("archive"))
+		_, err := client.Put(context.Background(), "/upload", NewRawBody(archive, "application/gzip"), nil)
+		if !errors.Is(err, ErrBodyNotRewindable) {
+			t.Fatalf("Put() error = %v, want ErrBodyNotRewindable", err)
+		}
+		if attempts != 1 {
+			t.Errorf("attempts = %d, want 1", attempts)
+		}
+		if contentType != "application/gzip" {
+			t.Errorf("Content-Type = %q, want %q", contentType, "application/gzip")
+		}
+	})
+}
+
+// TestMultipart tests uploading multipart/form-data bodies
+func TestMultipart(t *testing.T) {
+	var attempts int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			t.Errorf("ParseMultipartForm() error: %v", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if got := r.FormValue("version"); got != "1.0.0" {
+			t.Errorf("version = %q, want %q", got, "1.0.0")
+		}
+		file, header, err := r.FormFile("file")
+		if err != nil {
+			t.Fatalf("FormFile() error: %v", err)
+		}
+		content, _ := io.ReadAll(file)
+		if header.Filename != "module.tar.gz" || string(content) != "archive" {
+			t.Errorf("file = %s %q, want module.tar.gz %q", header.Filename, content, "archive")
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(server.URL, "test-token", WithRetryMax(2), withSleepFunc(func(time.Duration) {}))
+
+	body := NewMultipart("file", "module.tar.gz", strings.NewReader("archive"))
+	body.Fields = map[string]string{"version": "1.0.0"}
+
+	resp, err := client.Post(context.Background(), "/upload", body, nil)
+	if err != nil {
+		t.Fatalf("Post() error: %v", err)
+	}
+	_ = resp.Body.Close()
+
+	if attempts != 2 {
+		t.Errorf("attempts = %d, want 2", attempts)
+	}
+}
+
+// TestMultipartReopen tests that a retry stops the writer of the previous attempt
+// before rewinding the files
+func TestMultipartReopen(t *testing.T) {
+	archive := bytes.Repeat([]byte("archive"), 1<<12)
+	body := NewMultipart("file", "module.tar.gz", bytes.NewReader(archive))
+
+	first, _, err := body.open(0)
+	if err != nil {
+		t.Fatalf("open(0) error: %v", err)
+	}
+	// Leave the first writer blocked in the middle of the file
+	if _, err := io.ReadFull(first, make([]byte, 1024)); err != nil {
+		t.Fatalf("ReadFull() error: %v", err)
+	}
+
+	second, _, err := body.open(1)
+	if err != nil {
+		t.Fatalf("open(1) error: %v", err)
+	}
+	if _, err := first.Read(make([]byte, 1)); !errors.Is(err, io.ErrClosedPipe) {
+		t.Errorf("first attempt Read() error = %v, want io.ErrClosedPipe", err)
+	}
+
+	part, err := multipart.NewReader(second, body.boundary).NextPart()
+	if err != nil {
+		t.Fatalf("NextPart() error: %v", err)
+	}
+	content, err := io.ReadAll(part)
+	if err != nil {
+		t.Fatalf("ReadAll() error: %v", err)
+	}
+	if !bytes.Equal(content, archive) {
+		t.Errorf("second attempt sent %d bytes, want the %d bytes of the archive", len(content), len(archive))
+	}
+}
+
+// TestRawBodyReopen tests that a retry stops the reads of the previous attempt
+// before rewinding the reader
+func TestRawBodyReopen(t *testing.T) {
+	archive := bytes.Repeat([]byte("archive"), 1<<12)
+	body := NewRawBody(bytes.NewReader(archive), "")
+
+	first, _, err := body.open(0)
+	if err != nil {
+		t.Fatalf("open(0) error: %v", err)
+	}
+	if _, err := io.ReadFull(first, make([]byte, 1024)); err != nil {
+		t.Fatalf("ReadFull() error: %v", err)
+	}
+
+	second, length, err := body.open(1)
+	if err != nil {
+		t.Fatalf("open(1) error: %v", err)
+	}
+	if _, err := first.Read(make([]byte, 1)); !errors.Is(err, io.ErrClosedPipe) {
+		t.Errorf("first attempt Read() error = %v, want io.ErrClosedPipe", err)
+	}
+
+	content, err := io.ReadAll(second)
+	if err != nil {
+		t.Fatalf("ReadAll() error: %v", err)
+	}
+	if !bytes.Equal(content, archive) || length != int64(len(archive)) {
+		t.Errorf("second attempt sent %d bytes of length %d, want the %d bytes of the archive", len(content), length, len(archive))
+	}
+}
+
+// lingeringTransport answers the first attempts with a 503 at once and keeps reading
+// their body in the background, as transports may after RoundTrip returns
+type lingeringTransport struct {
+	attempts int32
+	body     []byte
+}
+
+func (lt *lingeringTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	status := http.StatusOK
+	if atomic.AddInt32(&lt.attempts, 1) < 3 {
+		status = http.StatusServiceUnavailable
+		go func() {
+			_, _ = io.Copy(io.Discard, req.Body)
+			_ = req.Body.Close()
+		}()
+	} else {
+		lt.body, _ = io.ReadAll(req.Body)
+		_ = req.Body.Close()
+	}
+	return &http.Response{StatusCode: status, Header: http.Header{}, Body: http.NoBody, Request: req}, nil
+}
+
+// TestRawBodyRetryWhileSending tests retrying an upload while the transport is still
+// reading the body of the previous attempt. Run with -race.
+func TestRawBodyRetryWhileSending(t *testing.T) {
+	archive := bytes.Repeat([]byte("archive"), 1<<17)
+	transport := &lingeringTransport{}
+	client := NewHTTPClient("https://example.com", "test-token",
+		WithHTTPClient(&http.Client{Transport: transport}),
+		WithRetryMax(2), WithRetryServerErrors(true), withSleepFunc(func(time.Duration) {}))
+
+	resp, err := client.Put(context.Background(), "/upload", NewRawBody(bytes.NewReader(archive), ""), nil)
+	if err != nil {
+		t.Fatalf("Put() error: %v", err)
+	}
+	_ = resp.Body.Close()
+
+	if transport.attempts != 3 {
+		t.Errorf("attempts = %d, want 3", transport.attempts)
+	}
+	if !bytes.Equal(transport.body, archive) {
+		t.Errorf("last attempt sent %d bytes, want the %d bytes of the archive", len(transport.body), len(archive))
+	}
+}
@@ -0,0 +1,156 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// ErrInvalidRequest indicates a request violating the constraints of the API spec,
+// which was not sent, see ValidationError
+var ErrInvalidRequest = errors.New("invalid request")
+
+// ValidationError lists the constraint violations of a request, found by the generated
+// Validate methods before sending it. Like the errors of a 422 response, each error
+// has a source: a JSON pointer to the invalid field, e.g. "/data/attributes/name",
+// or the name of the invalid query parameter, e.g. "page[size]".
+type ValidationError struct {
+	Errors []*JSONAPIError
+}
+
+func (e *ValidationError) Error() string {
+	details := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		details[i] = err.Detail
+		if source := err.Source; source != nil {
+			if source.Pointer != "" {
+				details[i] = fmt.Sprintf("%s (%s)", err.Detail, source.Pointer)
+			} else if source.Parameter != "" {
+				details[i] = fmt.Sprintf("%s (%s)", err.Detail, source.Parameter)
+			}
+		}
+	}
+	return "invalid request: " + strings.Join(details, "; ")
+}
+
+func (e *ValidationError) Is(target error) bool { return target == ErrInvalidRequest }
+
+// ValidatesRequests reports whether requests are checked against the constraints
+// of the API spec before being sent, unless disabled with WithoutValidation
+func (c *HTTPClient) ValidatesRequests() bool {
+	return !c.skipValidation
+}
+
+// Validator collects the constraint violations of a request, for the generated Validate methods.
+// A field is either a JSON pointer, starting with a slash, or the name of a query parameter.
+type Validator struct {
+	errors []*JSONAPIError
+}
+
+// Err returns a *ValidationError with the violations, or nil if there are none
+func (v *Validator) Err() error {
+	if len(v.errors) == 0 {
+		return nil
+	}
+	return &ValidationError{Errors: v.errors}
+}
+
+// Fail records a violation of a field
+func (v *Validator) Fail(field, detail string) {
+	source := &JSONAPIErrorSource{Parameter: field}
+	if strings.HasPrefix(field, "/") {
+		source = &JSONAPIErrorSource{Pointer: field}
+	}
+	v.errors = append(v.errors, &JSONAPIError{
+		Title:  "Invalid request",
+		Detail: detail,
+		Source: source,
+	})
+}
+
+// Required checks a required field is set
+func (v *Validator) Required(field string, set bool) {
+	if !set {
+		v.Fail(field, "is required")
+	}
+}
+
+// Enum checks a value is one of the constants of its enum type
+func (v *Validator) Enum(field string, value any, valid bool) {
+	if !valid {
+		v.Fail(field, fmt.Sprintf("%q is not an allowed value", fmt.Sprint(value)))
+	}
+}
+
+// OneOf checks a value is one of the allowed values
+func (v *Validator) OneOf(field, value string, allowed ...string) {
+	for _, a := range allowed {
+		if value == a {
+			return
+		}
+	}
+	v.Fail(field, fmt.Sprintf("%q is not one of %s", value, strings.Join(allowed, ", ")))
+}
+
+// Pattern checks a value matches a regular expression
+func (v *Validator) Pattern(field, value string, pattern *regexp.Regexp) {
+	if !pattern.MatchString(value) {
+		v.Fail(field, fmt.Sprintf("must match the pattern %s", pattern))
+	}
+}
+
+// MinLength checks a value has at least min characters
+func (v *Validator) MinLength(field, value string, min int) {
+	if utf8.RuneCountInString(value) < min {
+		v.Fail(field, fmt.Sprintf("must be at least %d characters long", min))
+	}
+}
+
+// MaxLength checks a value has at most max characters
+func (v *Validator) MaxLength(field, value string, max int) {
+	if utf8.RuneCountInString(value) > max {
+		v.Fail(field, fmt.Sprintf("must be at most %d characters long", max))
+	}
+}
+
+// Minimum checks a value is at least min, or greater than min if exclusive
+func (v *Validator) Minimum(field string, value, min float64, exclusive bool) {
+	switch {
+	case exclusive && value <= min:
+		v.Fail(field, "must be greater than "+formatNumber(min))
+	case value < min:
+		v.Fail(field, "must be at least "+formatNumber(min))
+	}
+}
+
+// Maximum checks a value is at most max, or less than max if exclusive
+func (v *Validator) Maximum(field string, value, max float64, exclusive bool) {
+	switch {
+	case exclusive && value >= max:
+		v.Fail(field, "must be less than "+formatNumber(max))
+	case value > max:
+		v.Fail(field, "must be at most "+formatNumber(max))
+	}
+}
+
+// Filter checks the values of a typed filter: a single value or a list built with In.
+// Values with other operators are left to the server.
+func Filter[T ~string](v *Validator, field string, value T, valid func(T) bool) {
+	values := []string{string(value)}
+	if list, ok := strings.CutPrefix(string(value), "in:"); ok {
+		values = strings.Split(list, ",")
+	} else if strings.Contains(string(value), ":") {
+		return
+	}
+
+	for _, value := range values {
+		v.Enum(field, value, valid(T(value)))
+	}
+}
+
+func formatNumber(n float64) string {
+	return strconv.FormatFloat(n, 'f', -1, 64)
+}
@@ -0,0 +1,96 @@
+package client
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+)
+
+// TestValidator tests collecting constraint violations with their sources
+func TestValidator(t *testing.T) {
+	var v Validator
+	if err := v.Err(); err != nil {
+		t.Fatalf("Err() = %v, want nil without violations", err)
+	}
+
+	v.Required("/data/attributes/name", false)
+	v.Required("/data/attributes/description", true)
+	v.OneOf("/data/attributes/vcs-repo/trigger-type", "tag", "default", "git-tag")
+	v.Pattern("/data/attributes/name", "Name", regexp.MustCompile("^[a-z]+$"))
+	v.MinLength("/data/attributes/name", "ab", 3)
+	v.MaxLength("/data/attributes/name", "ключ", 4)
+	v.Minimum("page[size]", 0, 1, false)
+	v.Maximum("page[size]", 100, 100, true)
+
+	err := v.Err()
+	if !errors.Is(err, ErrInvalidRequest) {
+		t.Fatalf("Err() = %v, want ErrInvalidRequest", err)
+	}
+	var validationErr *ValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("Err() = %T, want *ValidationError", err)
+	}
+
+	expected := []struct{ pointer, parameter, detail string }{
+		{pointer: "/data/attributes/name", detail: "is required"},
+		{pointer: "/data/attributes/vcs-repo/trigger-type", detail: `"tag" is not one of default, git-tag`},
+		{pointer: "/data/attributes/name", detail: "must match the pattern ^[a-z]+$"},
+		{pointer: "/data/attributes/name", detail: "must be at least 3 characters long"},
+		{parameter: "page[size]", detail: "must be at least 1"},
+		{parameter: "page[size]", detail: "must be less than 100"},
+	}
+	if len(validationErr.Errors) != len(expected) {
+		t.Fatalf("Expected %d errors, got %d: %v", len(expected), len(validationErr.Errors), err)
+	}
+	for i, want := range expected {
+		got := validationErr.Errors[i]
+		if got.Source.Pointer != want.pointer || got.Source.Parameter != want.parameter || got.Detail != want.detail {
+			t.Errorf("Error %d: expected %+v, got %q (%+v)", i, want, got.Detail, *got.Source)
+		}
+	}
+
+	want := "invalid request: is required (/data/attributes/name); "
+	if msg := err.Error(); len(msg) < len(want) || msg[:len(want)] != want {
+		t.Errorf("Error() = %q, want prefix %q", msg, want)
+	}
+}
+
+// TestFilterValidation tests checking typed filter values
+func TestFilterValidation(t *testing.T) {
+	type status string
+	valid := func(s status) bool { return s == "planned" || s == "applied" }
+
+	tests := []struct {
+		value  status
+		errors int
+	}{
+		{value: "planned"},
+		{value: "unknown", errors: 1},
+		{value: In[status]("planned", "applied")},
+		{value: In[status]("planned", "unknown", "other"), errors: 2},
+		{value: "ne:unknown"},
+	}
+
+	for _, tt := range tests {
+		var v Validator
+		Filter(&v, "filter[status]", tt.value, valid)
+		if got := len(v.errors); got != tt.errors {
+			t.Errorf("Filter(%q): expected %d errors, got %d", tt.value, tt.errors, got)
+		}
+	}
+}
+
+// TestWithoutValidation tests disabling the validation of requests
+func TestWithoutValidation(t *testing.T) {
+	if !NewHTTPClient("https://example.scalr.io", "token").ValidatesRequests() {
+		t.Error("Expected requests to be validated by default")
+	}
+
+	c := NewHTTPClient("https://example.scalr.io", "token", WithoutValidation())
+	if c.ValidatesRequests() {
+		t.Error("Expected WithoutValidation to disable the validation")
+	}
+	if c.WithHeader("X-Test", "1").ValidatesRequests() {
+		t.Error("Expected WithHeader to keep the validation disabled")
+	}
+}
@@ -0,0 +1,135 @@
+// Package iterx provides combinators for the iter.Seq2[T, error] sequences returned
+// by the generated XIter functions.
+//
+// All of them stop at the first error, which is yielded with the zero value of the
+// item, and stop pulling items from their source as soon as the consumer breaks out
+// of the loop, so that no more pages are fetched than needed.
+//
+// Example:
+//
+//	unlocked := iterx.Filter(client.Workspace.GetWorkspacesIter(ctx, opts), func(ws schemas.Workspace) bool {
+//	    return !ws.Attributes.Locked
+//	})
+//	for batch, err := range iterx.Chunk(unlocked, 50) {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    // Process up to 50 workspaces
+//	}
+package iterx
+
+import "iter"
+
+// Filter yields the items of seq for which keep returns true
+func Filter[T any](seq iter.Seq2[T, error], keep func(T) bool) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for item, err := range seq {
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			if keep(item) && !yield(item, nil) {
+				return
+			}
+		}
+	}
+}
+
+// Map yields the result of fn for each item of seq. An error returned by fn
+// stops the iteration like an error of seq.
+func Map[T, U any](seq iter.Seq2[T, error], fn func(T) (U, error)) iter.Seq2[U, error] {
+	return func(yield func(U, error) bool) {
+		var zero U
+		for item, err := range seq {
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+			mapped, err := fn(item)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+			if !yield(mapped, nil) {
+				return
+			}
+		}
+	}
+}
+
+// Take yields the first n items of seq
+func Take[T any](seq iter.Seq2[T, error], n int) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		if n <= 0 {
+			return
+		}
+		taken := 0
+		for item, err := range seq {
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			if !yield(item, nil) {
+				return
+			}
+			if taken++; taken == n {
+				return
+			}
+		}
+	}
+}
+
+// Chunk yields the items of seq in batches of size, the last one possibly smaller,
+// e.g. to process them with batched API calls. On error, the items batched so far
+// are yielded before the error. It panics if size is less than 1.
+func Chunk[T any](seq iter.Seq2[T, error], size int) iter.Seq2[[]T, error] {
+	if size < 1 {
+		panic("iterx: Chunk size must be at least 1")
+	}
+
+	return func(yield func([]T, error) bool) {
+		var batch []T
+		for item, err := range seq {
+			if err != nil {
+				if len(batch) > 0 && !yield(batch, nil) {
+					return
+				}
+				yield(nil, err)
+				return
+			}
+
+			batch = append(batch, item)
+			if len(batch) == size {
+				if !yield(batch, nil) {
+					return
+				}
+				batch = nil // The yielded batch belongs to the consumer
+			}
+		}
+		if len(batch) > 0 {
+			yield(batch, nil)
+		}
+	}
+}
+
+// CollectN returns the first n items of seq, or all of them if n is negative.
+// It returns nil and the error if the iteration fails.
+func CollectN[T any](seq iter.Seq2[T, error], n int) ([]T, error) {
+	if n == 0 {
+		return nil, nil
+	}
+
+	var result []T
+	for item, err := range seq {
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, item)
+		if len(result) == n {
+			break
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,200 @@
+package iterx
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"slices"
+	"testing"
+)
+
+var errList = errors.New("list failed")
+
+// numbers returns a sequence of 1..n, failing with err after them if not nil.
+// The number of items pulled from it is counted into pulled, if not nil.
+func numbers(n int, err error, pulled *int) iter.Seq2[int, error] {
+	return func(yield func(int, error) bool) {
+		for i := 1; i <= n; i++ {
+			if pulled != nil {
+				*pulled++
+			}
+			if !yield(i, nil) {
+				return
+			}
+		}
+		if err != nil {
+			yield(0, err)
+		}
+	}
+}
+
+// collect returns all the items of a sequence and the errors yielded
+func collect[T any](seq iter.Seq2[T, error]) ([]T, []error) {
+	var items []T
+	var errs []error
+	for item, err := range seq {
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		items = append(items, item)
+	}
+	return items, errs
+}
+
+// TestFilter tests filtering items and passing errors through
+func TestFilter(t *testing.T) {
+	even := func(n int) bool { return n%2 == 0 }
+
+	items, errs := collect(Filter(numbers(7, errList, nil), even))
+	if !slices.Equal(items, []int{2, 4, 6}) {
+		t.Errorf("Unexpected items: %v", items)
+	}
+	if len(errs) != 1 || !errors.Is(errs[0], errList) {
+		t.Errorf("Expected the list error once, got %v", errs)
+	}
+
+	pulled := 0
+	for n, err := range Filter(numbers(10, nil, &pulled), even) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n == 4 {
+			break
+		}
+	}
+	if pulled != 4 {
+		t.Errorf("Expected 4 items pulled, got %d", pulled)
+	}
+}
+
+// TestMap tests mapping items and stopping on the first error
+func TestMap(t *testing.T) {
+	double := func(n int) (string, error) { return fmt.Sprint(n * 2), nil }
+
+	items, errs := collect(Map(numbers(3, nil, nil), double))
+	if !slices.Equal(items, []string{"2", "4", "6"}) || len(errs) != 0 {
+		t.Errorf("Unexpected items %v, errors %v", items, errs)
+	}
+
+	errMap := errors.New("map failed")
+	pulled := 0
+	items, errs = collect(Map(numbers(10, nil, &pulled), func(n int) (string, error) {
+		if n == 3 {
+			return "", errMap
+		}
+		return double(n)
+	}))
+	if !slices.Equal(items, []string{"2", "4"}) {
+		t.Errorf("Unexpected items: %v", items)
+	}
+	if len(errs) != 1 || !errors.Is(errs[0], errMap) {
+		t.Errorf("Expected the map error once, got %v", errs)
+	}
+	if pulled != 3 {
+		t.Errorf("Expected 3 items pulled, got %d", pulled)
+	}
+
+	_, errs = collect(Map(numbers(2, errList, nil), double))
+	if len(errs) != 1 || !errors.Is(errs[0], errList) {
+		t.Errorf("Expected the list error once, got %v", errs)
+	}
+}
+
+// TestTake tests taking the first items without pulling more
+func TestTake(t *testing.T) {
+	tests := []struct {
+		name     string
+		seq      iter.Seq2[int, error]
+		n        int
+		expected []int
+		err      error
+	}{
+		{"fewer than available", numbers(10, errList, nil), 3, []int{1, 2, 3}, nil},
+		{"more than available", numbers(2, nil, nil), 5, []int{1, 2}, nil},
+		{"error before n", numbers(2, errList, nil), 5, []int{1, 2}, errList},
+		{"none", numbers(2, nil, nil), 0, nil, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			items, errs := collect(Take(tt.seq, tt.n))
+			if !slices.Equal(items, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, items)
+			}
+			if tt.err == nil && len(errs) != 0 || tt.err != nil && (len(errs) != 1 || !errors.Is(errs[0], tt.err)) {
+				t.Errorf("Expected error %v, got %v", tt.err, errs)
+			}
+		})
+	}
+
+	pulled := 0
+	collect(Take(numbers(10, nil, &pulled), 3))
+	if pulled != 3 {
+		t.Errorf("Expected 3 items pulled, got %d", pulled)
+	}
+}
+
+// TestChunk tests batching items
+func TestChunk(t *testing.T) {
+	batches, errs := collect(Chunk(numbers(7, nil, nil), 3))
+	if len(errs) != 0 {
+		t.Fatalf("Unexpected errors: %v", errs)
+	}
+	expected := [][]int{{1, 2, 3}, {4, 5, 6}, {7}}
+	if !slices.EqualFunc(batches, expected, slices.Equal) {
+		t.Errorf("Expected %v, got %v", expected, batches)
+	}
+
+	// The items batched before an error are yielded first
+	batches, errs = collect(Chunk(numbers(4, errList, nil), 3))
+	expected = [][]int{{1, 2, 3}, {4}}
+	if !slices.EqualFunc(batches, expected, slices.Equal) {
+		t.Errorf("Expected %v, got %v", expected, batches)
+	}
+	if len(errs) != 1 || !errors.Is(errs[0], errList) {
+		t.Errorf("Expected the list error once, got %v", errs)
+	}
+
+	pulled := 0
+	for range Chunk(numbers(10, nil, &pulled), 2) {
+		break
+	}
+	if pulled != 2 {
+		t.Errorf("Expected 2 items pulled, got %d", pulled)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected a panic for a size of 0")
+		}
+	}()
+	Chunk(numbers(1, nil, nil), 0)
+}
+
+// TestCollectN tests collecting a number of items
+func TestCollectN(t *testing.T) {
+	pulled := 0
+	items, err := CollectN(numbers(10, errList, &pulled), 4)
+	if err != nil || !slices.Equal(items, []int{1, 2, 3, 4}) {
+		t.Errorf("Unexpected items %v, error %v", items, err)
+	}
+	if pulled != 4 {
+		t.Errorf("Expected 4 items pulled, got %d", pulled)
+	}
+
+	items, err = CollectN(numbers(3, nil, nil), -1)
+	if err != nil || !slices.Equal(items, []int{1, 2, 3}) {
+		t.Errorf("Unexpected items %v, error %v", items, err)
+	}
+
+	items, err = CollectN(numbers(3, errList, nil), 5)
+	if !errors.Is(err, errList) || items != nil {
+		t.Errorf("Expected the list error without items, got %v, %v", items, err)
+	}
+
+	pulled = 0
+	if items, err := CollectN(numbers(3, nil, &pulled), 0); err != nil || items != nil || pulled != 0 {
+		t.Errorf("Expected nothing pulled, got %v, %v, %d pulled", items, err, pulled)
+	}
+}
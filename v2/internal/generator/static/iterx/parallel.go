@@ -0,0 +1,137 @@
+package iterx
+
+import (
+	"context"
+	"iter"
+	"sync"
+)
+
+// result holds an item computed or received from another goroutine
+type result[T any] struct {
+	item T
+	err  error
+}
+
+// ParallelMap yields the result of fn for each item of seq, calling fn for up to
+// workers items at once, e.g. to make an API call per listed resource. Results keep
+// the order of the items. The first error, of seq or fn, stops the iteration and
+// cancels the context passed to the pending calls, as does breaking out of the loop.
+// It panics if workers is less than 1.
+func ParallelMap[T, U any](ctx context.Context, seq iter.Seq2[T, error], workers int, fn func(context.Context, T) (U, error)) iter.Seq2[U, error] {
+	if workers < 1 {
+		panic("iterx: ParallelMap workers must be at least 1")
+	}
+
+	return func(yield func(U, error) bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		// Results in the order of the items, each received once fn returns
+		pending := make(chan chan result[U], workers)
+		slots := make(chan struct{}, workers)
+
+		var produced sync.WaitGroup
+		produced.Add(1)
+		go func() {
+			defer produced.Done()
+			defer close(pending)
+
+			for item, err := range seq {
+				next := make(chan result[U], 1)
+				if err != nil {
+					next <- result[U]{err: err}
+				} else {
+					// Wait for a free worker
+					select {
+					case slots <- struct{}{}:
+					case <-ctx.Done():
+						return
+					}
+					go func() {
+						mapped, err := fn(ctx, item)
+						<-slots
+						next <- result[U]{item: mapped, err: err}
+					}()
+				}
+
+				select {
+				case pending <- next:
+				case <-ctx.Done():
+					return
+				}
+				if err != nil {
+					return
+				}
+			}
+		}()
+
+		// Stop the source before returning, so it isn't used after the loop ends
+		defer produced.Wait()
+		defer cancel()
+
+		var zero U
+		for next := range pending {
+			var r result[U]
+			select {
+			case r = <-next:
+			case <-ctx.Done():
+				r.err = ctx.Err()
+			}
+
+			if r.err != nil {
+				yield(zero, r.err)
+				return
+			}
+			if !yield(r.item, nil) {
+				return
+			}
+		}
+	}
+}
+
+// Merge yields the items of several sequences, e.g. the workspaces of several
+// environments, pulling them concurrently. Items of each sequence keep their order,
+// but are interleaved with those of the others. The first error stops all of them.
+func Merge[T any](seqs ...iter.Seq2[T, error]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		items := make(chan result[T])
+		done := make(chan struct{})
+
+		var pulled sync.WaitGroup
+		for _, seq := range seqs {
+			pulled.Add(1)
+			go func() {
+				defer pulled.Done()
+				for item, err := range seq {
+					select {
+					case items <- result[T]{item: item, err: err}:
+					case <-done:
+						return
+					}
+					if err != nil {
+						return
+					}
+				}
+			}()
+		}
+		go func() {
+			pulled.Wait()
+			close(items)
+		}()
+
+		// Stop the sequences still pulled before returning
+		defer pulled.Wait()
+		defer close(done)
+
+		var zero T
+		for r := range items {
+			if r.err != nil {
+				yield(zero, r.err)
+				return
+			}
+			if !yield(r.item, nil) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,218 @@
+package iterx
+
+import (
+	"context"
+	"errors"
+	"iter"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// TestParallelMap tests that results keep the order of the items and that no more
+// than the given number of calls run at once
+func TestParallelMap(t *testing.T) {
+	const workers = 3
+
+	var running, maxRunning atomic.Int32
+	square := func(ctx context.Context, n int) (int, error) {
+		r := running.Add(1)
+		defer running.Add(-1)
+		for {
+			m := maxRunning.Load()
+			if r <= m || maxRunning.CompareAndSwap(m, r) {
+				break
+			}
+		}
+		// Later items are done first
+		time.Sleep(time.Duration(10-n) * time.Millisecond)
+		return n * n, nil
+	}
+
+	items, errs := collect(ParallelMap(context.Background(), numbers(10, nil, nil), workers, square))
+	if len(errs) != 0 {
+		t.Fatalf("Unexpected errors: %v", errs)
+	}
+	if expected := []int{1, 4, 9, 16, 25, 36, 49, 64, 81, 100}; !slices.Equal(items, expected) {
+		t.Errorf("Expected %v, got %v", expected, items)
+	}
+	if got := maxRunning.Load(); got > workers || got < 2 {
+		t.Errorf("Expected between 2 and %d calls at once, got %d", workers, got)
+	}
+}
+
+// TestParallelMapError tests that the first error stops the iteration and cancels
+// the pending calls
+func TestParallelMapError(t *testing.T) {
+	errMap := errors.New("map failed")
+	canceled := make(chan error, 1)
+
+	fn := func(ctx context.Context, n int) (int, error) {
+		switch n {
+		case 2:
+			time.Sleep(5 * time.Millisecond)
+			return 0, errMap
+		case 3:
+			<-ctx.Done()
+			canceled <- ctx.Err()
+			return 0, ctx.Err()
+		}
+		return n, nil
+	}
+
+	items, errs := collect(ParallelMap(context.Background(), numbers(3, nil, nil), 3, fn))
+	if !slices.Equal(items, []int{1}) {
+		t.Errorf("Expected the items before the error, got %v", items)
+	}
+	if len(errs) != 1 || !errors.Is(errs[0], errMap) {
+		t.Errorf("Expected the map error once, got %v", errs)
+	}
+	if err := <-canceled; !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected the pending call to be canceled, got %v", err)
+	}
+
+	// Errors of the source are yielded after the items before them
+	items, errs = collect(ParallelMap(context.Background(), numbers(4, errList, nil), 2, func(ctx context.Context, n int) (int, error) {
+		return n, nil
+	}))
+	if !slices.Equal(items, []int{1, 2, 3, 4}) {
+		t.Errorf("Expected the items before the error, got %v", items)
+	}
+	if len(errs) != 1 || !errors.Is(errs[0], errList) {
+		t.Errorf("Expected the list error once, got %v", errs)
+	}
+}
+
+// TestParallelMapEarlyExit tests that breaking out of the loop stops pulling items
+func TestParallelMapEarlyExit(t *testing.T) {
+	pulled := 0
+	identity := func(ctx context.Context, n int) (int, error) { return n, nil }
+
+	for n, err := range ParallelMap(context.Background(), numbers(1000, nil, &pulled), 2, identity) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n == 3 {
+			break
+		}
+	}
+	// The source is done once the loop ends, at most a few items ahead
+	if pulled > 10 {
+		t.Errorf("Expected the source to stop, %d items pulled", pulled)
+	}
+}
+
+// TestParallelMapContextCancellation tests that canceling the context stops the iteration
+func TestParallelMapContextCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	blocked := func(ctx context.Context, n int) (int, error) {
+		if n > 1 {
+			<-ctx.Done()
+			return 0, ctx.Err()
+		}
+		return n, nil
+	}
+
+	time.AfterFunc(5*time.Millisecond, cancel)
+	items, errs := collect(ParallelMap(ctx, numbers(5, nil, nil), 2, blocked))
+	if !slices.Equal(items, []int{1}) {
+		t.Errorf("Expected the items before the cancellation, got %v", items)
+	}
+	if len(errs) != 1 || !errors.Is(errs[0], context.Canceled) {
+		t.Errorf("Expected context.Canceled once, got %v", errs)
+	}
+}
+
+// TestMerge tests merging sequences, keeping the order of each of them
+func TestMerge(t *testing.T) {
+	letters := func(yield func(string, error) bool) {
+		for _, s := range []string{"a", "b", "c"} {
+			if !yield(s, nil) {
+				return
+			}
+		}
+	}
+	words := func(yield func(string, error) bool) {
+		for _, s := range []string{"x", "y"} {
+			if !yield(s, nil) {
+				return
+			}
+		}
+	}
+
+	items, errs := collect(Merge[string](letters, words))
+	if len(errs) != 0 {
+		t.Fatalf("Unexpected errors: %v", errs)
+	}
+	if len(items) != 5 {
+		t.Fatalf("Expected 5 items, got %v", items)
+	}
+	for _, seq := range [][]string{{"a", "b", "c"}, {"x", "y"}} {
+		var got []string
+		for _, item := range items {
+			if slices.Contains(seq, item) {
+				got = append(got, item)
+			}
+		}
+		if !slices.Equal(got, seq) {
+			t.Errorf("Expected %v in order, got %v", seq, items)
+		}
+	}
+
+	if items, errs := collect(Merge[int]()); len(items) != 0 || len(errs) != 0 {
+		t.Errorf("Expected nothing, got %v, %v", items, errs)
+	}
+}
+
+// TestMergeError tests that the first error stops all the sequences
+func TestMergeError(t *testing.T) {
+	var pulled atomic.Int32
+	endless := func(yield func(int, error) bool) {
+		for i := 0; ; i++ {
+			pulled.Add(1)
+			if !yield(i, nil) {
+				return
+			}
+		}
+	}
+
+	items, errs := collect(Merge(endless, numbers(0, errList, nil)))
+	if len(errs) != 1 || !errors.Is(errs[0], errList) {
+		t.Errorf("Expected the list error once, got %v", errs)
+	}
+
+	// The endless sequence has stopped once Merge returned
+	after := pulled.Load()
+	time.Sleep(5 * time.Millisecond)
+	if pulled.Load() != after {
+		t.Error("Expected the other sequences to stop")
+	}
+	if len(items) > int(after) {
+		t.Errorf("Expected at most %d items, got %d", after, len(items))
+	}
+}
+
+// TestMergeEarlyExit tests that breaking out of the loop stops all the sequences
+func TestMergeEarlyExit(t *testing.T) {
+	var stopped atomic.Int32
+	endless := func() iter.Seq2[int, error] {
+		return func(yield func(int, error) bool) {
+			defer stopped.Add(1)
+			for i := 0; yield(i, nil); i++ {
+			}
+		}
+	}
+
+	for _, err := range Merge(endless(), endless(), endless()) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		break
+	}
+	if n := stopped.Load(); n != 3 {
+		t.Errorf("Expected all the sequences to stop, %d did", n)
+	}
+}
@@ -9,27 +9,55 @@ import (
 	{{end}}
 )
 
-// Client manages communication with the Scalr API
+// Client manages communication with the Scalr API.
+// The resource clients are typed by their API interfaces, so that fakes can be
+// substituted in tests, e.g. &workspace.Fake{...}.
 //
 // API base path: {{ .BasePath }}
 type Client struct {
 	httpClient *client.HTTPClient
 	
 	{{range .Resources -}}
-	{{ . }} *{{ toSnake . }}.Client
+	{{ . }} {{ toSnake . }}.{{ . }}API
 	{{end}}
 }
 
-// NewClient creates a new API client
+// DefaultBasePath is the path on which the API is served, unless overridden
+// with client.WithBasePath or client.Config.BasePath
+const DefaultBasePath = "{{ .BasePath }}"
+
+// NewClient creates a new API client. The {{ .ServerVariable | toLower }} is either a hostname,
+// e.g. "example.scalr.io", or a full URL, e.g. "https://example.scalr.io".
+// Plain http:// URLs require the client.WithAllowHTTP option.
+// An invalid {{ .ServerVariable | toLower }} fails every request, use NewClientWithConfig to check it upfront.
 func NewClient({{ .ServerVariable | toLower }} string, token string, opts ...client.HTTPClientOption) *Client {
-	baseURL := "https://" + {{ .ServerVariable | toLower }} + "{{ .BasePath }}"
-	httpClient := client.NewHTTPClient(baseURL, token, opts...)
-	
+	return newClient(client.NewHTTPClientForAddress({{ .ServerVariable | toLower }}, DefaultBasePath, token, opts...))
+}
+
+// NewClientWithConfig creates a new API client for the API located by the config.
+// It fails if the address is invalid.
+func NewClientWithConfig(cfg client.Config, token string, opts ...client.HTTPClientOption) (*Client, error) {
+	if cfg.BasePath != "" {
+		opts = append(opts, client.WithBasePath(cfg.BasePath))
+	}
+	if cfg.AllowHTTP {
+		opts = append(opts, client.WithAllowHTTP())
+	}
+
+	httpClient := client.NewHTTPClientForAddress(cfg.Address, DefaultBasePath, token, opts...)
+	if err := httpClient.Err(); err != nil {
+		return nil, err
+	}
+
+	return newClient(httpClient), nil
+}
+
+func newClient(httpClient *client.HTTPClient) *Client {
 	{{ if .PreferHeader -}}
 	// Set profile header for all requests
 	httpClient = httpClient.WithHeader("Prefer", "{{ .PreferHeader }}")
 	{{ end -}}
-	
+
 	return &Client{
 		httpClient: httpClient,
 		{{range .Resources -}}
@@ -29,11 +29,27 @@ func New(httpClient *client.HTTPClient) *Client {
 {{range .Operations -}}
 {{if .Description}}// {{ .Description }}
 {{end -}}
-func (c *Client) {{ .Name }}Raw(ctx context.Context{{range .PathParameters}}, {{.GoName}} {{.Type}}{{end}}{{if .HasBody}}, req {{.RequestType}}{{end}}{{if .QueryParams}}, opts *{{ .Name }}Options{{end}}) (*client.Response, error) {
+func (c *Client) {{ (.MethodOf "raw").Signature }} {
 	path := "{{ .Path }}"
 	{{range .PathParameters -}}
 	path = strings.ReplaceAll(path, "{{`{`}}{{.Name}}{{`}`}}", url.PathEscape({{.GoName}}))
 	{{end}}
+	{{if or .ValidatesRequest .QueryParams -}}
+	if c.httpClient.ValidatesRequests() {
+		{{if .ValidatesRequest -}}
+		if req != nil {
+			if err := req.{{if eq .Method "PATCH"}}ValidatePartial{{else}}Validate{{end}}(); err != nil {
+				return nil, err
+			}
+		}
+		{{end -}}
+		{{if .QueryParams -}}
+		if err := opts.Validate(); err != nil {
+			return nil, err
+		}
+		{{end -}}
+	}
+	{{end}}
 	
 	{{if .QueryParams -}}
 	params := url.Values{}
@@ -44,6 +60,8 @@ func (c *Client) {{ .Name }}Raw(ctx context.Context{{range .PathParameters}}, {{
 		if len(opts.Include) > 0 {
 			params.Set("include", strings.Join(opts.Include, ","))
 		}
+		{{else if .IsFields -}}
+		opts.Fields.Encode(params)
 		{{else if .IsSort -}}
 		if len(opts.Sort) > 0 {
 			params.Set("sort", strings.Join(opts.Sort, ","))
@@ -65,6 +83,9 @@ func (c *Client) {{ .Name }}Raw(ctx context.Context{{range .PathParameters}}, {{
 		{{end -}}
 		{{end -}}
 		{{end -}}
+		{{if .Filters -}}
+		opts.Filters.Encode(params)
+		{{end -}}
 		// Add filters
 		for k, v := range opts.Filter {
 			params.Set("filter["+k+"]", v)
@@ -76,7 +97,13 @@ func (c *Client) {{ .Name }}Raw(ctx context.Context{{range .PathParameters}}, {{
 	{{end}}
 
 	{{if .HasBody -}}
-	{{if .UsesPlainJSON -}}
+	{{if .IsMultipart -}}
+	// Multipart upload, streamed while it is sent
+	httpResp, err := c.httpClient.{{if eq .Method "POST"}}Post{{else if eq .Method "PATCH"}}Patch{{else if eq .Method "PUT"}}Put{{else if eq .Method "DELETE"}}Delete{{else}}Post{{end}}(ctx, path, req, nil)
+	{{else if .UploadContentType -}}
+	// Upload sent as is, rewound on retries if it implements io.Seeker
+	httpResp, err := c.httpClient.{{if eq .Method "POST"}}Post{{else if eq .Method "PATCH"}}Patch{{else if eq .Method "PUT"}}Put{{else if eq .Method "DELETE"}}Delete{{else}}Post{{end}}(ctx, path, client.NewRawBody(req, "{{ .UploadContentType }}"), nil)
+	{{else if .UsesPlainJSON -}}
 	// Plain JSON request (not JSON:API)
 	headers := map[string]string{"Content-Type": "application/json"}
 	httpResp, err := c.httpClient.{{if eq .Method "POST"}}Post{{else if eq .Method "PATCH"}}Patch{{else if eq .Method "PUT"}}Put{{else if eq .Method "DELETE"}}Delete{{else}}Post{{end}}(ctx, path, req, headers)
@@ -99,6 +126,8 @@ func (c *Client) {{ .Name }}Raw(ctx context.Context{{range .PathParameters}}, {{
 	{{else -}}
 	{{if eq .Method "DELETE" -}}
 	httpResp, err := c.httpClient.Delete(ctx, path, nil, nil)
+	{{else if and .Streams .ContentType -}}
+	httpResp, err := c.httpClient.Get(ctx, path, map[string]string{"Accept": "{{ .ContentType }}"})
 	{{else -}}
 	httpResp, err := c.httpClient.Get(ctx, path, nil)
 	{{end -}}
@@ -111,8 +140,13 @@ func (c *Client) {{ .Name }}Raw(ctx context.Context{{range .PathParameters}}, {{
 
 {{if .Description}}// {{ .Description }}
 {{end -}}
-func (c *Client) {{ .Name }}(ctx context.Context{{range .PathParameters}}, {{.GoName}} {{.Type}}{{end}}{{if .HasBody}}, req {{.RequestType}}{{end}}{{if .QueryParams}}, opts *{{ .Name }}Options{{end}}) ({{if .ReturnsData}}{{.Returns}}, {{end}}error) {
-	resp, err := c.{{ .Name }}Raw(ctx{{range .PathParameters}}, {{.GoName}}{{end}}{{if .HasBody}}, req{{end}}{{if .QueryParams}}, opts{{end}})
+{{if .IsBinary -}}
+{{if .Description}}//
+{{end -}}
+// Deprecated: the {{ .ContentType }} content is buffered in memory, use {{ .Name }}Stream or {{ .Name }}To instead.
+{{end -}}
+func (c *Client) {{ (.MethodOf "call").Signature }} {
+	resp, err := c.{{ (.MethodOf "raw").Call }}
 	if err != nil {
 		return {{if .ReturnsData}}{{if .ReturnsText}}"", {{else}}nil, {{end}}{{end}}err
 	}
@@ -133,12 +167,12 @@ func (c *Client) {{ .Name }}(ctx context.Context{{range .PathParameters}}, {{.Go
 		Meta struct {
 			Pagination *client.Pagination `json:"pagination"`
 		} `json:"meta"`
-		Included []map[string]interface{} `json:"included"`
+		Included []json.RawMessage `json:"included"`
 	}
 	{{else -}}
 	var result struct {
 		Data {{trimPrefix .Returns "*"}} `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Included []json.RawMessage `json:"included"`
 	}
 	{{end -}}
 	
@@ -147,23 +181,22 @@ func (c *Client) {{ .Name }}(ctx context.Context{{range .PathParameters}}, {{.Go
 	}
 	
 	{{if .IsList -}}
+	{{if .ReturnsRelationships -}}
+	// Index included resources once to resolve the relationships
+	included := client.NewIncluded(result.Included)
+	{{end -}}
 	resources := make({{.Returns}}, len(result.Data))
 	for i := range result.Data {
 		resources[i] = &result.Data[i]
 		{{if .ReturnsRelationships -}}
-		// Populate included resources into relationships
-		if len(result.Included) > 0 {
-			resources[i].Relationships.PopulateIncludes(result.Included)
-		}
+		resources[i].ResolveIncludes(included)
 		{{end -}}
 	}
 	return resources, nil
 	{{else -}}
 	{{if .ReturnsRelationships -}}
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	{{end -}}
 	return &result.Data, nil
 	{{end -}}
@@ -173,17 +206,36 @@ func (c *Client) {{ .Name }}(ctx context.Context{{range .PathParameters}}, {{.Go
 	{{end -}}
 }
 
-{{$hasPagination := false -}}
-{{range .QueryParams -}}
-{{if .IsPagination -}}
-{{$hasPagination = true -}}
-{{end -}}
+{{if .Streams -}}
+// {{ .Name }}Stream streams the response of {{ .Name }} instead of buffering it in memory.
+// The caller must close the returned body.
+func (c *Client) {{ (.MethodOf "stream").Signature }} {
+	resp, err := c.{{ (.MethodOf "raw").Call }}
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+// {{ .Name }}To copies the response of {{ .Name }} to w and returns the number of bytes written.
+func (c *Client) {{ (.MethodOf "to").Signature }} {
+	body, err := c.{{ (.MethodOf "stream").Call }}
+	if err != nil {
+		return 0, err
+	}
+	defer body.Close()
+
+	return io.Copy(w, body)
+}
+
 {{end -}}
-{{if and .IsList .ReturnsData .QueryParams $hasPagination (not (contains .Returns "interface{}")) -}}
+
+{{if .MethodOf "iter" -}}
 // {{ .Name }}Iter returns an iterator for paginated results using Go 1.23+ range over iter.Seq2 feature.
 // This is the recommended and simple way to iterate through all the results.
 //
 // The iterator automatically fetches pages as needed and handles errors inline.
+// Pages are fetched ahead in the background when the client is created with client.WithPrefetch.
 //
 // Example:
 //
@@ -193,77 +245,25 @@ func (c *Client) {{ .Name }}(ctx context.Context{{range .PathParameters}}, {{.Go
 //	    }
 //	    // Process item
 //	}
-func (c *Client) {{ .Name }}Iter(ctx context.Context{{range .PathParameters}}, {{.GoName}} {{.Type}}{{end}}, opts *{{ .Name }}Options) iter.Seq2[{{trimPrefix .Returns "[]*"}}, error] {
+func (c *Client) {{ (.MethodOf "iter").Signature }} {
 	return func(yield func({{trimPrefix .Returns "[]*"}}, error) bool) {
-		// Determine page size from opts or use default
-		pageSize := 20
-		if opts != nil && opts.PageSize > 0 {
-			pageSize = opts.PageSize
-		}
-
-		pageNum := 1
-
-		for {
-			// Check context
-			if err := ctx.Err(); err != nil {
-				yield({{trimPrefix .Returns "[]*"}}{}, err)
-				return
-			}
-
-			// Copy options and set page number
-			pageOpts := &{{ .Name }}Options{}
-			if opts != nil {
-				*pageOpts = *opts
-			}
-			pageOpts.PageNumber = pageNum
-			pageOpts.PageSize = pageSize
-
-			// Fetch page using Raw method to get pagination metadata
-			resp, err := c.{{ .Name }}Raw(ctx{{range .PathParameters}}, {{.GoName}}{{end}}, pageOpts)
-			if err != nil {
-				yield({{trimPrefix .Returns "[]*"}}{}, err)
-				return
-			}
-			defer resp.Body.Close()
-
-			// Decode response
-			var result struct {
-				Data []{{trimPrefix .Returns "[]*"}} `json:"data"`
-				Meta struct {
-					Pagination *client.Pagination `json:"pagination"`
-				} `json:"meta"`
-				Included []map[string]interface{} `json:"included"`
-			}
-			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-				yield({{trimPrefix .Returns "[]*"}}{}, fmt.Errorf("failed to decode response: %w", err))
-				return
-			}
+		it := c.{{ (.MethodOf "paged").Call }}
+		defer it.Close() // Stops the prefetching on early exit
 
-			// Yield each item
-			for i := range result.Data {
-				{{if .ReturnsRelationships -}}
-				// Populate included resources into relationships
-				if len(result.Included) > 0 {
-					result.Data[i].Relationships.PopulateIncludes(result.Included)
-				}
-				{{end -}}
-				if !yield(result.Data[i], nil) {
-					return // Consumer requested early exit
-				}
+		for it.Next() {
+			if !yield(*it.Value(), nil) {
+				return // Consumer requested early exit
 			}
-
-			// Check if there are more pages
-			if result.Meta.Pagination == nil || result.Meta.Pagination.NextPage == nil {
-				break
-			}
-
-			pageNum++
+		}
+		if err := it.Err(); err != nil {
+			yield({{trimPrefix .Returns "[]*"}}{}, err)
 		}
 	}
 }
 
 // {{ .Name }}Paged returns a stateful iterator with access to pagination metadata.
-// Use this when you need PageInfo(), Remaining(), or Collect() methods.
+// Use this when you need PageInfo(), Remaining(), or Collect() methods, or to resume
+// an interrupted iteration from the iterator Checkpoint().
 //
 // Example:
 //
@@ -276,7 +276,11 @@ func (c *Client) {{ .Name }}Iter(ctx context.Context{{range .PathParameters}}, {
 //	if err := iter.Err(); err != nil {
 //	    // Handle error
 //	}
-func (c *Client) {{ .Name }}Paged(ctx context.Context{{range .PathParameters}}, {{.GoName}} {{.Type}}{{end}}, opts *{{ .Name }}Options) *client.Iterator[{{trimPrefix .Returns "[]*"}}] {
+//
+// Resuming from a checkpoint saved by an iterator with the same options:
+//
+//	iter := client.{{ $.ResourceName }}.{{ .Name }}Paged(ctx{{range .PathParameters}}, {{.GoName}}{{end}}, opts).Resume(checkpoint)
+func (c *Client) {{ (.MethodOf "paged").Signature }} {
 	// Determine page size from opts or use default
 	pageSize := 20
 	if opts != nil && opts.PageSize > 0 {
@@ -306,28 +310,38 @@ func (c *Client) {{ .Name }}Paged(ctx context.Context{{range .PathParameters}},
 			Meta struct {
 				Pagination *client.Pagination `json:"pagination"`
 			} `json:"meta"`
-			Included []map[string]interface{} `json:"included"`
+			Included []json.RawMessage `json:"included"`
 		}
 		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 			return nil, nil, fmt.Errorf("failed to decode response: %w", err)
 		}
 
-		// Convert to slice of pointers and populate includes
+		// Convert to slice of pointers and resolve includes
+		{{if .ReturnsRelationships -}}
+		included := client.NewIncluded(result.Included)
+		{{end -}}
 		items := make({{.Returns}}, len(result.Data))
 		for i := range result.Data {
 			items[i] = &result.Data[i]
 			{{if .ReturnsRelationships -}}
-			// Populate included resources into relationships
-			if len(result.Included) > 0 {
-				items[i].Relationships.PopulateIncludes(result.Included)
-			}
+			items[i].ResolveIncludes(included)
 			{{end -}}
 		}
 
 		return items, result.Meta.Pagination, nil
 	}
 
-	return client.NewIterator[{{trimPrefix .Returns "[]*"}}](ctx, pageSize, fetchPage)
+	// Checkpoints only resume the iterators of the same listing
+	listOpts := {{ .Name }}Options{}
+	if opts != nil {
+		listOpts = *opts
+	}
+	listOpts.PageNumber, listOpts.PageSize = 0, 0
+	filters := client.HashOptions("{{ .Name }}"{{range .PathParameters}}, {{.GoName}}{{end}}, listOpts)
+
+	return client.NewIterator[{{trimPrefix .Returns "[]*"}}](ctx, pageSize, fetchPage).
+		Prefetch(c.httpClient.Prefetch()).
+		FiltersHash(filters)
 }
 {{end -}}
 
@@ -341,8 +355,132 @@ type {{ .Name }}Options struct {
 	{{.GoName}} {{.Type}}
 	{{end -}}
 	{{end -}}
+	{{if .Filters -}}
+	// Filters holds the typed filter[...] parameters. Filter entries take precedence over them.
+	Filters {{ .Name }}Filter
+	{{end -}}
 	Filter map[string]string
 }
+
+// Validate checks the options against the constraints of the API spec.
+// The returned *client.ValidationError names the invalid parameters, e.g. "page[size]".
+func (o *{{ .Name }}Options) Validate() error {
+	if o == nil {
+		o = &{{ .Name }}Options{}
+	}
+
+	var v client.Validator
+	{{range .QueryParams -}}
+	{{with .Validation -}}
+	{{if .Required -}}
+	v.Required("{{ .Pointer }}", o.{{ .Field }} != "")
+	{{end -}}
+	{{if .HasValueChecks -}}
+	if val := o.{{ .Field }}; val != {{if or .Minimum .Maximum}}0{{else}}""{{end}} {
+		{{if .OneOf -}}
+		v.OneOf("{{ .Pointer }}", val{{range .OneOf}}, {{ printf "%q" . }}{{end}})
+		{{end -}}
+		{{if .Pattern -}}
+		v.Pattern("{{ .Pointer }}", val, {{ .Pattern }})
+		{{end -}}
+		{{if .MinLength -}}
+		v.MinLength("{{ .Pointer }}", val, {{ .MinLength }})
+		{{end -}}
+		{{if .MaxLength -}}
+		v.MaxLength("{{ .Pointer }}", val, {{ .MaxLength }})
+		{{end -}}
+		{{if .Minimum -}}
+		v.Minimum("{{ .Pointer }}", float64(val), {{ .Minimum }}, {{ .ExclusiveMinimum }})
+		{{end -}}
+		{{if .Maximum -}}
+		v.Maximum("{{ .Pointer }}", float64(val), {{ .Maximum }}, {{ .ExclusiveMaximum }})
+		{{end -}}
+	}
+	{{end -}}
+	{{end -}}
+	{{end -}}
+	{{range .Filters -}}
+	{{if not (or (eq .Type "string") (eq .Type "*bool") (eq .Type "*int")) -}}
+	if o.Filters.{{.GoName}} != "" {
+		client.Filter(&v, "filter[{{.FilterKey}}]", o.Filters.{{.GoName}}, {{.Type}}.IsValid)
+	}
+	{{end -}}
+	{{end -}}
+	return v.Err()
+}
+{{end}}
+{{if .Patterns -}}
+// Patterns of the {{ .Name }} parameters
+var (
+	{{range .Patterns -}}
+	{{ .Pattern }} = regexp.MustCompile({{ printf "%q" .PatternExpr }})
+	{{end -}}
+)
+{{end}}
+{{if .Includes -}}
+// Relationship paths accepted by the Include option of {{ .Name }}
+const (
+	{{range .Includes -}}
+	{{.Name}} = "{{.Path}}"
+	{{end -}}
+)
+{{end}}
+{{if .Filters -}}
+{{range .FilterEnums}}
+// {{ .Name }} represents the values of a filter
+{{if .Description}}// {{ .Description }}
+{{end -}}
+type {{ .Name }} string
+
+// {{ .Name }} constants
+const (
+{{- $enumTypeName := .Name}}
+{{- range .Values}}
+	{{.Name}} {{$enumTypeName}} = "{{.Value}}"
+{{- end}}
+)
+
+// IsValid reports whether the value is one of the {{ .Name }} constants
+func (e {{ .Name }}) IsValid() bool {
+	switch e {
+	case {{range $i, $v := .Values}}{{if $i}}, {{end}}{{$v.Name}}{{end}}:
+		return true
+	}
+	return false
+}
+{{end}}
+// {{ .Name }}Filter holds the typed filters of {{ .Name }}.
+// Use client.In to match any of several values.
+type {{ .Name }}Filter struct {
+	{{range .Filters -}}
+	{{if .Description}}// {{ .Description }}
+	{{end -}}
+	{{.GoName}} {{.Type}}
+	{{end -}}
+}
+
+// Encode adds the filter[...] parameters to the query parameters
+func (f {{ .Name }}Filter) Encode(params url.Values) {
+	{{range .Filters -}}
+	{{if eq .Type "*bool" -}}
+	if f.{{.GoName}} != nil {
+		params.Set("filter[{{.FilterKey}}]", fmt.Sprintf("%t", *f.{{.GoName}}))
+	}
+	{{else if eq .Type "*int" -}}
+	if f.{{.GoName}} != nil {
+		params.Set("filter[{{.FilterKey}}]", fmt.Sprintf("%d", *f.{{.GoName}}))
+	}
+	{{else if eq .Type "string" -}}
+	if f.{{.GoName}} != "" {
+		params.Set("filter[{{.FilterKey}}]", f.{{.GoName}})
+	}
+	{{else -}}
+	if f.{{.GoName}} != "" {
+		params.Set("filter[{{.FilterKey}}]", string(f.{{.GoName}}))
+	}
+	{{end -}}
+	{{end -}}
+}
 {{end}}
 
 {{end -}}
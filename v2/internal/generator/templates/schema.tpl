@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"time"
 	
+	"github.com/scalr/go-scalr/v2/{{ .ApiPackageName }}/client"
 	"github.com/scalr/go-scalr/v2/{{ .ApiPackageName }}/value"
 )
 
@@ -36,6 +37,9 @@ type {{ .Name }} struct {
 	Type          string                   `json:"type"`
 	{{if .Attributes}}Attributes    {{ .Name }}Attributes     `json:"attributes"`{{end}}
 	{{if .Relationships}}Relationships {{ .Name }}Relationships `json:"relationships"`{{end}}
+
+	// stub is set for a related resource which was not included, only its ID and type are known
+	stub bool
 }
 
 // GetID returns the resource ID (implements client.ResourceLike)
@@ -51,6 +55,34 @@ func (r {{ .Name }}) GetResourceType() string {
 	return "{{ .TypeName }}"
 }
 
+// Loaded reports whether the resource holds its attributes and relationships.
+// It is false for a related resource which was not included in the response,
+// only its ID and type are known then.
+func (r {{ .Name }}) Loaded() bool {
+	return !r.stub
+}
+
+// ResolveIncludes replaces the relationships with the included resources (implements client.IncludeResolver)
+func (r *{{ .Name }}) ResolveIncludes(included *client.Included) {
+	{{if .Relationships -}}
+	r.Relationships.ResolveIncludes(included)
+	{{- end}}
+}
+
+{{if .TypeName}}
+// {{ .Name }}ResourceType is the JSON:API resource type of {{ .Name }}, the key of its sparse fieldset
+const {{ .Name }}ResourceType = "{{ .TypeName }}"
+{{end}}
+
+{{if .Fields}}
+// {{ .Name }} field names, to select with sparse fieldsets
+const (
+{{- range .Fields}}
+	{{ $.Name }}Field{{ .Name }} = "{{ .JSONName }}"
+{{- end}}
+)
+{{end}}
+
 {{if .Attributes}}
 // {{ .Name }}Attributes holds the attributes for {{ .Name }} (response)
 type {{ .Name }}Attributes struct {
@@ -103,6 +135,7 @@ func (r *{{ .Name }}Relationships) UnmarshalJSON(data []byte) error {
 				r.{{.Name}}[i] = &{{ .Type }}{
 					ID:   d.ID,
 					Type: d.Type,
+					stub: true,
 				}
 			}
 		}
@@ -121,6 +154,7 @@ func (r *{{ .Name }}Relationships) UnmarshalJSON(data []byte) error {
 			r.{{.Name}} = &{{ .Type }}{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 		{{end -}}
@@ -130,57 +164,38 @@ func (r *{{ .Name }}Relationships) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-// PopulateIncludes merges included resources into the relationships
-func (r *{{ .Name }}Relationships) PopulateIncludes(included []map[string]interface{}) {
-	if r == nil || len(included) == 0 {
+// ResolveIncludes replaces the relationships with the included resources, along
+// with their own relationships. Relationships which were not included are kept as is.
+func (r *{{ .Name }}Relationships) ResolveIncludes(included *client.Included) {
+	if r == nil || included.Len() == 0 {
 		return
 	}
 
-	// Build a map of included resources by type+id for fast lookup
-	includedMap := make(map[string]map[string]interface{})
-	for _, inc := range included {
-		if id, ok := inc["id"].(string); ok {
-			if typ, ok := inc["type"].(string); ok {
-				key := typ + ":" + id
-				includedMap[key] = inc
-			}
-		}
-	}
-
 	{{range .Relationships -}}
 	{{if .ToMany -}}
-	// Populate to-many relationship: {{.Name}}
-	if r.{{.Name}} != nil {
-		for i, resource := range r.{{.Name}} {
-			if resource != nil && resource.ID != "" {
-				key := resource.Type + ":" + resource.ID
-				if fullResource, ok := includedMap[key]; ok {
-					// Unmarshal the full resource
-					data, _ := json.Marshal(fullResource)
-					var full {{ .Type }}
-					if err := json.Unmarshal(data, &full); err == nil {
-						r.{{.Name}}[i] = &full
-					}
-				}
-			}
+	for i, rel := range r.{{.Name}} {
+		if rel == nil {
+			continue
+		}
+		if full, ok := client.Resolve[{{ .Type }}](included, rel.Type, rel.ID); ok {
+			r.{{.Name}}[i] = full
 		}
 	}
 	{{else -}}
-	// Populate to-one relationship: {{.Name}}
-	if r.{{.Name}} != nil && r.{{.Name}}.ID != "" {
-		key := r.{{.Name}}.Type + ":" + r.{{.Name}}.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full {{ .Type }}
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.{{.Name}} = &full
-			}
+	if r.{{.Name}} != nil {
+		if full, ok := client.Resolve[{{ .Type }}](included, r.{{.Name}}.Type, r.{{.Name}}.ID); ok {
+			r.{{.Name}} = full
 		}
 	}
 	{{end -}}
 	{{end -}}
 }
+
+// PopulateIncludes merges included resources into the relationships.
+// Prefer ResolveIncludes with an index built once per response.
+func (r *{{ .Name }}Relationships) PopulateIncludes(included []map[string]interface{}) {
+	r.ResolveIncludes(client.NewIncludedFromMaps(included))
+}
 {{end}}
 
 // Request version - used when marshalling for API requests
@@ -253,6 +268,68 @@ type {{ .Name }} struct {
 
 {{end}}
 
+{{range .Unions}}
+// {{ .Name }} holds one of its variants: {{range $i, $v := .Variants}}{{if $i}}, {{end}}{{$v.Name}}{{end}}.
+// The other variants are nil, and null is a {{ .Name }} without variant.
+{{if .Description}}// {{ .Description }}
+{{end -}}
+type {{ .Name }} struct {
+{{range .Variants -}}
+	{{.Name}} *{{.Type}}
+{{end -}}
+}
+
+// MarshalJSON encodes the variant which is set, or null
+func (u {{ .Name }}) MarshalJSON() ([]byte, error) {
+	{{range .Variants -}}
+	if u.{{.Name}} != nil {
+		return json.Marshal(u.{{.Name}})
+	}
+	{{end -}}
+	return []byte("null"), nil
+}
+
+{{if .Discriminator -}}
+// UnmarshalJSON decodes the variant selected by the {{ .Discriminator }} property
+{{else -}}
+// UnmarshalJSON decodes the first variant matching the JSON value
+{{end -}}
+func (u *{{ .Name }}) UnmarshalJSON(data []byte) error {
+	*u = {{ .Name }}{}
+	kind := client.JSONKind(data)
+	if kind == "null" {
+		return nil
+	}
+
+	{{if .Discriminator -}}
+	value, err := client.Discriminator(data, "{{ .Discriminator }}")
+	if err != nil {
+		return fmt.Errorf("failed to decode {{ .Name }}: %w", err)
+	}
+	switch value {
+	{{range .Variants -}}
+	case {{range $i, $v := .Values}}{{if $i}}, {{end}}{{printf "%q" $v}}{{end}}:
+		u.{{.Name}} = new({{.Type}})
+		return json.Unmarshal(data, u.{{.Name}})
+	{{end -}}
+	}
+	return fmt.Errorf("failed to decode {{ .Name }}: unknown {{ .Discriminator }} %q", value)
+	{{else -}}
+	{{range .Variants -}}
+	{{if .Kind}}if kind == "{{.Kind}}" {{end}}{
+		var v {{.Type}}
+		if err := client.DecodeStrict(data, &v); err == nil {
+			u.{{.Name}} = &v
+			return nil
+		}
+	}
+	{{end -}}
+	return fmt.Errorf("failed to decode {{ .Name }}: no variant matches the %s", kind)
+	{{end -}}
+}
+
+{{end}}
+
 {{range .RequestNestedStructs}}
 {{if .Description}}// {{ .Description }} (for requests){{end}}
 type {{ .Name }} struct {
@@ -266,3 +343,119 @@ type {{ .Name }} struct {
 }
 
 {{end}}
+{{range .EnumTypes}}
+// IsValid reports whether the value is one of the {{ .Name }} constants
+func (e {{ .Name }}) IsValid() bool {
+	switch e {
+	case {{range $i, $v := .Values}}{{if $i}}, {{end}}{{$v.Name}}{{end}}:
+		return true
+	}
+	return false
+}
+{{end}}
+
+{{if .Patterns}}
+// Patterns of the request fields
+var (
+{{- range .Patterns}}
+	{{ .Pattern }} = regexp.MustCompile({{ printf "%q" .PatternExpr }})
+{{- end}}
+)
+{{end}}
+
+// Validate checks the request against the constraints of the API spec for creating
+// a resource: required fields, enum values, patterns, lengths and ranges.
+// The returned *client.ValidationError points to the invalid fields, e.g. "/data/attributes/name".
+func (r {{ .Name }}Request) Validate() error {
+	return r.validate(false)
+}
+
+// ValidatePartial checks the request like Validate, except for the required fields,
+// which may be left unset when updating a resource
+func (r {{ .Name }}Request) ValidatePartial() error {
+	return r.validate(true)
+}
+
+func (r {{ .Name }}Request) validate(partial bool) error {
+	var v client.Validator
+	{{if .Attributes -}}
+	r.Attributes.validate(&v, "/data/attributes", partial)
+	{{end -}}
+	{{if .Relationships -}}
+	r.Relationships.validate(&v, "/data/relationships", partial)
+	{{end -}}
+	return v.Err()
+}
+
+{{if .Attributes}}
+func (r {{ .Name }}AttributesRequest) validate(v *client.Validator, pointer string, partial bool) {
+	{{range .Attributes -}}
+	{{if not .ReadOnly -}}
+	{{template "checks" .Validation}}
+	{{- end -}}
+	{{end -}}
+}
+{{end}}
+
+{{if .Relationships}}
+func (r {{ .Name }}RelationshipsRequest) validate(v *client.Validator, pointer string, partial bool) {
+	{{range .Relationships -}}
+	{{if not .ReadOnly -}}
+	{{template "checks" .Validation}}
+	{{- end -}}
+	{{end -}}
+}
+{{end}}
+
+{{range .RequestNestedStructs}}
+func (r {{ .Name }}) validate(v *client.Validator, pointer string) {
+	{{range .Fields -}}
+	{{if not .ReadOnly -}}
+	{{template "checks" .Validation}}
+	{{- end -}}
+	{{end -}}
+}
+{{end}}
+
+{{- define "checks" -}}
+{{if and .Required .Partial -}}
+	if !partial {
+		v.Required(pointer+"/{{ .Pointer }}", r.{{ .Field }}.IsSet())
+	}
+{{else if .Required -}}
+	v.Required(pointer+"/{{ .Pointer }}", r.{{ .Field }}.IsSet())
+{{end -}}
+{{if .HasValueChecks -}}
+	if val, ok := r.{{ .Field }}.Value(); ok {
+		{{if .Enum -}}
+		v.Enum(pointer+"/{{ .Pointer }}", val, val.IsValid())
+		{{end -}}
+		{{if .EnumSlice -}}
+		for i, item := range val {
+			v.Enum(pointer+"/{{ .Pointer }}/"+strconv.Itoa(i), item, item.IsValid())
+		}
+		{{end -}}
+		{{if .OneOf -}}
+		v.OneOf(pointer+"/{{ .Pointer }}", val{{range .OneOf}}, {{ printf "%q" . }}{{end}})
+		{{end -}}
+		{{if .Pattern -}}
+		v.Pattern(pointer+"/{{ .Pointer }}", val, {{ .Pattern }})
+		{{end -}}
+		{{if .MinLength -}}
+		v.MinLength(pointer+"/{{ .Pointer }}", val, {{ .MinLength }})
+		{{end -}}
+		{{if .MaxLength -}}
+		v.MaxLength(pointer+"/{{ .Pointer }}", val, {{ .MaxLength }})
+		{{end -}}
+		{{if .Minimum -}}
+		v.Minimum(pointer+"/{{ .Pointer }}", float64(val), {{ .Minimum }}, {{ .ExclusiveMinimum }})
+		{{end -}}
+		{{if .Maximum -}}
+		v.Maximum(pointer+"/{{ .Pointer }}", float64(val), {{ .Maximum }}, {{ .ExclusiveMaximum }})
+		{{end -}}
+		{{if .Nested -}}
+		val.validate(v, pointer+"/{{ .Pointer }}")
+		{{end -}}
+	}
+{{end -}}
+{{- end}}
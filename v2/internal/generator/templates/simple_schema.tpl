@@ -28,3 +28,8 @@ func (r {{ .Name }}) GetResourceType() string {
 {{- end}}
 }
 {{- end}}
+{{- if .TypeEnum}}
+
+// {{ .Name }}ResourceType is the JSON:API resource type of {{ .Name }}, the key of its sparse fieldset
+const {{ .Name }}ResourceType = "{{ .TypeEnum }}"
+{{- end}}
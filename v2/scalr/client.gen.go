@@ -77,90 +77,118 @@ import (
 	"github.com/scalr/go-scalr/v2/scalr/ops/workspace"
 )
 
-// Client manages communication with the Scalr API
+// Client manages communication with the Scalr API.
+// The resource clients are typed by their API interfaces, so that fakes can be
+// substituted in tests, e.g. &workspace.Fake{...}.
 //
 // API base path: /api/iacp/v3
 type Client struct {
 	httpClient *client.HTTPClient
 
-	AWSEventBridgeIntegration           *aws_event_bridge_integration.Client
-	AccessPolicy                        *access_policy.Client
-	AccessToken                         *access_token.Client
-	AccessTokenUsage                    *access_token_usage.Client
-	Account                             *account.Client
-	Agent                               *agent.Client
-	AgentPool                           *agent_pool.Client
-	AiUsage                             *ai_usage.Client
-	Apply                               *apply.Client
-	BillingUsage                        *billing_usage.Client
-	CheckovIntegration                  *checkov_integration.Client
-	ConfigurationVersion                *configuration_version.Client
-	CostEstimate                        *cost_estimate.Client
-	DatadogIntegration                  *datadog_integration.Client
-	DockerIntegration                   *docker_integration.Client
-	DriftDetectionSchedule              *drift_detection_schedule.Client
-	Environment                         *environment.Client
-	EventDefinition                     *event_definition.Client
-	GPGKey                              *gpg_key.Client
-	Hook                                *hook.Client
-	HookEnvironmentLink                 *hook_environment_link.Client
-	InfracostIntegration                *infracost_integration.Client
-	Module                              *module.Client
-	ModuleNamespace                     *module_namespace.Client
-	ModuleTestProviderConfigurationLink *module_test_provider_configuration_link.Client
-	ModuleUsageNamespace                *module_usage_namespace.Client
-	ModuleVersion                       *module_version.Client
-	Permission                          *permission.Client
-	Plan                                *plan.Client
-	Policy                              *policy.Client
-	PolicyCheck                         *policy_check.Client
-	PolicyCheckResult                   *policy_check_result.Client
-	PolicyGroup                         *policy_group.Client
-	Provider                            *provider.Client
-	ProviderConfiguration               *provider_configuration.Client
-	ProviderConfigurationLink           *provider_configuration_link.Client
-	ProviderConfigurationParameter      *provider_configuration_parameter.Client
-	ProviderVersion                     *provider_version.Client
-	Role                                *role.Client
-	Run                                 *run.Client
-	RunScheduleRule                     *run_schedule_rule.Client
-	RunTrigger                          *run_trigger.Client
-	SSHKey                              *ssh_key.Client
-	SamlIntegration                     *saml_integration.Client
-	SecurityRules                       *security_rules.Client
-	ServiceAccount                      *service_account.Client
-	SlackConnection                     *slack_connection.Client
-	SlackIntegration                    *slack_integration.Client
-	SoftwareVersion                     *software_version.Client
-	StateVersion                        *state_version.Client
-	StorageProfile                      *storage_profile.Client
-	Tag                                 *tag.Client
-	Team                                *team.Client
-	TerraformModuleUsage                *terraform_module_usage.Client
-	TerraformModuleVersionUsage         *terraform_module_version_usage.Client
-	TerraformProviderUsage              *terraform_provider_usage.Client
-	TerraformProviderVersionUsage       *terraform_provider_version_usage.Client
-	TerraformResourceInstanceUsage      *terraform_resource_instance_usage.Client
-	TerraformResourceUsage              *terraform_resource_usage.Client
-	TerraformVersionUsage               *terraform_version_usage.Client
-	UsageStatistic                      *usage_statistic.Client
-	User                                *user.Client
-	Variable                            *variable.Client
-	VariableSet                         *variable_set.Client
-	VariableSetVariable                 *variable_set_variable.Client
-	VcsProvider                         *vcs_provider.Client
-	WebhookIntegration                  *webhook_integration.Client
-	WebhookIntegrationDelivery          *webhook_integration_delivery.Client
-	WorkloadIdentityProvider            *workload_identity_provider.Client
-	Workspace                           *workspace.Client
-	Misc                                *misc.Client
+	AWSEventBridgeIntegration           aws_event_bridge_integration.AWSEventBridgeIntegrationAPI
+	AccessPolicy                        access_policy.AccessPolicyAPI
+	AccessToken                         access_token.AccessTokenAPI
+	AccessTokenUsage                    access_token_usage.AccessTokenUsageAPI
+	Account                             account.AccountAPI
+	Agent                               agent.AgentAPI
+	AgentPool                           agent_pool.AgentPoolAPI
+	AiUsage                             ai_usage.AiUsageAPI
+	Apply                               apply.ApplyAPI
+	BillingUsage                        billing_usage.BillingUsageAPI
+	CheckovIntegration                  checkov_integration.CheckovIntegrationAPI
+	ConfigurationVersion                configuration_version.ConfigurationVersionAPI
+	CostEstimate                        cost_estimate.CostEstimateAPI
+	DatadogIntegration                  datadog_integration.DatadogIntegrationAPI
+	DockerIntegration                   docker_integration.DockerIntegrationAPI
+	DriftDetectionSchedule              drift_detection_schedule.DriftDetectionScheduleAPI
+	Environment                         environment.EnvironmentAPI
+	EventDefinition                     event_definition.EventDefinitionAPI
+	GPGKey                              gpg_key.GPGKeyAPI
+	Hook                                hook.HookAPI
+	HookEnvironmentLink                 hook_environment_link.HookEnvironmentLinkAPI
+	InfracostIntegration                infracost_integration.InfracostIntegrationAPI
+	Module                              module.ModuleAPI
+	ModuleNamespace                     module_namespace.ModuleNamespaceAPI
+	ModuleTestProviderConfigurationLink module_test_provider_configuration_link.ModuleTestProviderConfigurationLinkAPI
+	ModuleUsageNamespace                module_usage_namespace.ModuleUsageNamespaceAPI
+	ModuleVersion                       module_version.ModuleVersionAPI
+	Permission                          permission.PermissionAPI
+	Plan                                plan.PlanAPI
+	Policy                              policy.PolicyAPI
+	PolicyCheck                         policy_check.PolicyCheckAPI
+	PolicyCheckResult                   policy_check_result.PolicyCheckResultAPI
+	PolicyGroup                         policy_group.PolicyGroupAPI
+	Provider                            provider.ProviderAPI
+	ProviderConfiguration               provider_configuration.ProviderConfigurationAPI
+	ProviderConfigurationLink           provider_configuration_link.ProviderConfigurationLinkAPI
+	ProviderConfigurationParameter      provider_configuration_parameter.ProviderConfigurationParameterAPI
+	ProviderVersion                     provider_version.ProviderVersionAPI
+	Role                                role.RoleAPI
+	Run                                 run.RunAPI
+	RunScheduleRule                     run_schedule_rule.RunScheduleRuleAPI
+	RunTrigger                          run_trigger.RunTriggerAPI
+	SSHKey                              ssh_key.SSHKeyAPI
+	SamlIntegration                     saml_integration.SamlIntegrationAPI
+	SecurityRules                       security_rules.SecurityRulesAPI
+	ServiceAccount                      service_account.ServiceAccountAPI
+	SlackConnection                     slack_connection.SlackConnectionAPI
+	SlackIntegration                    slack_integration.SlackIntegrationAPI
+	SoftwareVersion                     software_version.SoftwareVersionAPI
+	StateVersion                        state_version.StateVersionAPI
+	StorageProfile                      storage_profile.StorageProfileAPI
+	Tag                                 tag.TagAPI
+	Team                                team.TeamAPI
+	TerraformModuleUsage                terraform_module_usage.TerraformModuleUsageAPI
+	TerraformModuleVersionUsage         terraform_module_version_usage.TerraformModuleVersionUsageAPI
+	TerraformProviderUsage              terraform_provider_usage.TerraformProviderUsageAPI
+	TerraformProviderVersionUsage       terraform_provider_version_usage.TerraformProviderVersionUsageAPI
+	TerraformResourceInstanceUsage      terraform_resource_instance_usage.TerraformResourceInstanceUsageAPI
+	TerraformResourceUsage              terraform_resource_usage.TerraformResourceUsageAPI
+	TerraformVersionUsage               terraform_version_usage.TerraformVersionUsageAPI
+	UsageStatistic                      usage_statistic.UsageStatisticAPI
+	User                                user.UserAPI
+	Variable                            variable.VariableAPI
+	VariableSet                         variable_set.VariableSetAPI
+	VariableSetVariable                 variable_set_variable.VariableSetVariableAPI
+	VcsProvider                         vcs_provider.VcsProviderAPI
+	WebhookIntegration                  webhook_integration.WebhookIntegrationAPI
+	WebhookIntegrationDelivery          webhook_integration_delivery.WebhookIntegrationDeliveryAPI
+	WorkloadIdentityProvider            workload_identity_provider.WorkloadIdentityProviderAPI
+	Workspace                           workspace.WorkspaceAPI
+	Misc                                misc.MiscAPI
 }
 
-// NewClient creates a new API client
+// DefaultBasePath is the path on which the API is served, unless overridden
+// with client.WithBasePath or client.Config.BasePath
+const DefaultBasePath = "/api/iacp/v3"
+
+// NewClient creates a new API client. The domain is either a hostname,
+// e.g. "example.scalr.io", or a full URL, e.g. "https://example.scalr.io".
+// Plain http:// URLs require the client.WithAllowHTTP option.
+// An invalid domain fails every request, use NewClientWithConfig to check it upfront.
 func NewClient(domain string, token string, opts ...client.HTTPClientOption) *Client {
-	baseURL := "https://" + domain + "/api/iacp/v3"
-	httpClient := client.NewHTTPClient(baseURL, token, opts...)
+	return newClient(client.NewHTTPClientForAddress(domain, DefaultBasePath, token, opts...))
+}
+
+// NewClientWithConfig creates a new API client for the API located by the config.
+// It fails if the address is invalid.
+func NewClientWithConfig(cfg client.Config, token string, opts ...client.HTTPClientOption) (*Client, error) {
+	if cfg.BasePath != "" {
+		opts = append(opts, client.WithBasePath(cfg.BasePath))
+	}
+	if cfg.AllowHTTP {
+		opts = append(opts, client.WithAllowHTTP())
+	}
+
+	httpClient := client.NewHTTPClientForAddress(cfg.Address, DefaultBasePath, token, opts...)
+	if err := httpClient.Err(); err != nil {
+		return nil, err
+	}
+
+	return newClient(httpClient), nil
+}
 
+func newClient(httpClient *client.HTTPClient) *Client {
 	return &Client{
 		httpClient:                          httpClient,
 		AWSEventBridgeIntegration:           aws_event_bridge_integration.New(httpClient),
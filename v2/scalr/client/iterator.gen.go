@@ -20,6 +20,9 @@ import (
 //	if err := iter.Err(); err != nil {
 //	    log.Fatal(err)
 //	}
+//
+// Pages are fetched one after another, unless Prefetch is used to fetch the next
+// pages in the background. An interrupted iteration can be resumed from a Checkpoint.
 type Iterator[T any] struct {
 	ctx context.Context
 
@@ -38,6 +41,25 @@ type Iterator[T any] struct {
 
 	// Function to get the next page
 	fetchPage func(ctx context.Context, pageNum int) ([]*T, *Pagination, error)
+
+	// Checkpoint state
+	filters   string      // Hash of the options, see FiltersHash
+	resume    *Checkpoint // Checkpoint to resume from on the first fetch
+	itemsPage int         // Page of the current items
+	returned  int         // Current items returned by Next, kept when the iteration stops
+
+	// Prefetch state
+	prefetch int                     // Number of pages fetched ahead, 0 to fetch them one at a time
+	pending  map[int]chan fetched[T] // Pages being fetched in the background, by page number
+	fetchCtx context.Context         // Context of the background fetches
+	cancel   context.CancelFunc      // Stops the background fetches
+}
+
+// fetched holds the result of a page fetched in the background
+type fetched[T any] struct {
+	items      []*T
+	pagination *Pagination
+	err        error
 }
 
 // NewIterator creates a new iterator with the given fetch function.
@@ -51,12 +73,81 @@ func NewIterator[T any](ctx context.Context, pageSize int, fetchPage func(contex
 	}
 }
 
+// Prefetch makes the iterator fetch up to n pages ahead of the current one in the
+// background, once the first page tells the total number of pages. Items keep their
+// order, and the iteration stops on the first error or when the context is canceled.
+// The fetch function must be safe for concurrent use; the generated ones are, and
+// their requests share the rate limit handling of the client.
+// It must be called before Next. A value of 0 or less fetches pages one at a time.
+func (it *Iterator[T]) Prefetch(n int) *Iterator[T] {
+	it.prefetch = n
+	return it
+}
+
+// FiltersHash sets the hash of the options selecting the items, see HashOptions.
+// It is recorded into the checkpoints, so that they can't resume another listing.
+func (it *Iterator[T]) FiltersHash(hash string) *Iterator[T] {
+	it.filters = hash
+	return it
+}
+
+// Checkpoint returns the position of the iterator: resuming from it continues
+// with the item after the current one.
+func (it *Iterator[T]) Checkpoint() Checkpoint {
+	if it.resume != nil {
+		// Not started yet, still at the checkpoint to resume from
+		return *it.resume
+	}
+
+	cp := Checkpoint{
+		page:       it.itemsPage,
+		offset:     it.returned,
+		pageSize:   it.pageSize,
+		totalCount: it.totalCount,
+		filters:    it.filters,
+	}
+	if cp.page == 0 || cp.offset >= len(it.items) {
+		// The current page is consumed, continue with the next one
+		cp.page++
+		cp.offset = 0
+	}
+	return cp
+}
+
+// Resume makes the iterator start from a checkpoint taken by an iterator of the same
+// listing. It must be called before Next. The iteration fails with ErrCheckpointMismatch
+// if the checkpoint was taken with other options or page size, and with ErrCheckpointStale
+// if the result set shrank since then.
+func (it *Iterator[T]) Resume(cp Checkpoint) *Iterator[T] {
+	switch {
+	case cp.page < 1:
+		it.err = fmt.Errorf("%w: empty checkpoint", ErrCheckpointMismatch)
+	case cp.pageSize != it.pageSize:
+		it.err = fmt.Errorf("%w: page size %d, the iterator has %d", ErrCheckpointMismatch, cp.pageSize, it.pageSize)
+	case cp.filters != it.filters:
+		it.err = fmt.Errorf("%w: the options differ", ErrCheckpointMismatch)
+	default:
+		it.currentPage = cp.page - 1
+		it.resume = &cp
+	}
+	return it
+}
+
+// Close stops fetching pages in the background. It is only needed when the iteration
+// is abandoned before Next returns false, and can be called more than once.
+func (it *Iterator[T]) Close() {
+	if it.cancel != nil {
+		it.cancel()
+	}
+}
+
 // Next advances the iterator to the next item.
 func (it *Iterator[T]) Next() bool {
 	// Check if context is canceled
 	if err := it.ctx.Err(); err != nil {
 		it.err = err
 		it.index = 0
+		it.Close()
 		return false
 	}
 
@@ -69,27 +160,45 @@ func (it *Iterator[T]) Next() bool {
 	// If there are items in the current batch, return the next one
 	if it.index < len(it.items) {
 		it.index++
+		it.returned = it.index
 		return true
 	}
 
 	// Stop if there are no more pages to fetch
 	if it.done {
+		it.items = nil // Don't restart the last page on the next call
 		it.index = 0
+		it.Close()
 		return false
 	}
 
 	// Need to fetch the next page
 	it.currentPage++
-	items, pagination, err := it.fetchPage(it.ctx, it.currentPage)
+	items, pagination, err := it.fetchNext()
 	if err != nil {
 		it.err = err
 		it.index = 0
+		it.Close()
 		return false
 	}
 
+	// Skip the items consumed before the checkpoint
+	skip := 0
+	if cp := it.resume; cp != nil {
+		it.resume = nil
+		if err := cp.check(len(items), pagination); err != nil {
+			it.err = err
+			it.Close()
+			return false
+		}
+		skip = cp.offset
+	}
+
 	// Update state
 	it.items = items
+	it.itemsPage = it.currentPage
 	it.index = 0
+	it.returned = 0
 	it.fetched = true
 
 	// Update pagination info
@@ -107,14 +216,58 @@ func (it *Iterator[T]) Next() bool {
 	if len(items) == 0 {
 		it.done = true
 		it.index = 0
+		it.Close()
 		return false
 	}
 
 	// Move to the first item
-	it.index = 1
+	it.index = skip + 1
+	it.returned = it.index
 	return true
 }
 
+// fetchNext fetches the current page, or receives it from the background fetches
+// in prefetch mode, starting those of the following pages
+func (it *Iterator[T]) fetchNext() ([]*T, *Pagination, error) {
+	pageNum := it.currentPage
+	if it.prefetch <= 0 || pageNum > it.totalPages {
+		// The total number of pages is unknown until the first page is fetched
+		return it.fetchPage(it.ctx, pageNum)
+	}
+
+	if it.pending == nil {
+		it.fetchCtx, it.cancel = context.WithCancel(it.ctx)
+		it.pending = make(map[int]chan fetched[T])
+	}
+
+	// Keep this page and the next ones up to the prefetch limit in flight
+	for p := pageNum; p <= pageNum+it.prefetch && p <= it.totalPages; p++ {
+		if _, ok := it.pending[p]; !ok {
+			it.pending[p] = it.fetchInBackground(p)
+		}
+	}
+
+	result := it.pending[pageNum]
+	delete(it.pending, pageNum)
+
+	select {
+	case page := <-result:
+		return page.items, page.pagination, page.err
+	case <-it.ctx.Done():
+		return nil, nil, it.ctx.Err()
+	}
+}
+
+// fetchInBackground starts fetching a page, its result is sent to the returned channel
+func (it *Iterator[T]) fetchInBackground(pageNum int) chan fetched[T] {
+	result := make(chan fetched[T], 1)
+	go func() {
+		items, pagination, err := it.fetchPage(it.fetchCtx, pageNum)
+		result <- fetched[T]{items: items, pagination: pagination, err: err}
+	}()
+	return result
+}
+
 // Value returns the current item.
 // It should only be called after Next() returns true, panics otherwise.
 func (it *Iterator[T]) Value() *T {
@@ -60,17 +60,15 @@ func (c *Client) CreateAccessPolicy(ctx context.Context, req *schemas.AccessPoli
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.AccessPolicy     `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.AccessPolicy `json:"data"`
+		Included []json.RawMessage    `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -81,6 +79,58 @@ type CreateAccessPolicyOptions struct {
 	Filter  map[string]string
 }
 
+// Relationship paths accepted by the Include option of CreateAccessPolicy
+const (
+	CreateAccessPolicyIncludeAccount                                  = "account"
+	CreateAccessPolicyIncludeAccountBillingPlan                       = "account.billing-plan"
+	CreateAccessPolicyIncludeAccountIdentityProvider                  = "account.identity-provider"
+	CreateAccessPolicyIncludeAccountOwner                             = "account.owner"
+	CreateAccessPolicyIncludeEnvironment                              = "environment"
+	CreateAccessPolicyIncludeEnvironmentAccount                       = "environment.account"
+	CreateAccessPolicyIncludeEnvironmentCreatedBy                     = "environment.created-by"
+	CreateAccessPolicyIncludeEnvironmentDefaultProviderConfigurations = "environment.default-provider-configurations"
+	CreateAccessPolicyIncludeEnvironmentDefaultWorkspaceAgentPool     = "environment.default-workspace-agent-pool"
+	CreateAccessPolicyIncludeEnvironmentDriftDetectionSchedules       = "environment.drift-detection-schedules"
+	CreateAccessPolicyIncludeEnvironmentLockedBy                      = "environment.locked-by"
+	CreateAccessPolicyIncludeEnvironmentPolicyGroups                  = "environment.policy-groups"
+	CreateAccessPolicyIncludeEnvironmentProviderConfigurations        = "environment.provider-configurations"
+	CreateAccessPolicyIncludeEnvironmentStorageProfile                = "environment.storage-profile"
+	CreateAccessPolicyIncludeEnvironmentTags                          = "environment.tags"
+	CreateAccessPolicyIncludeEnvironmentUpdatedBy                     = "environment.updated-by"
+	CreateAccessPolicyIncludeRoles                                    = "roles"
+	CreateAccessPolicyIncludeRolesAccount                             = "roles.account"
+	CreateAccessPolicyIncludeRolesPermissions                         = "roles.permissions"
+	CreateAccessPolicyIncludeServiceAccount                           = "service-account"
+	CreateAccessPolicyIncludeServiceAccountAccount                    = "service-account.account"
+	CreateAccessPolicyIncludeServiceAccountCreatedBy                  = "service-account.created-by"
+	CreateAccessPolicyIncludeServiceAccountOwners                     = "service-account.owners"
+	CreateAccessPolicyIncludeTeam                                     = "team"
+	CreateAccessPolicyIncludeTeamAccount                              = "team.account"
+	CreateAccessPolicyIncludeTeamIdentityProvider                     = "team.identity-provider"
+	CreateAccessPolicyIncludeTeamUsers                                = "team.users"
+	CreateAccessPolicyIncludeUser                                     = "user"
+	CreateAccessPolicyIncludeUserIdentityProviders                    = "user.identity-providers"
+	CreateAccessPolicyIncludeUserTeams                                = "user.teams"
+	CreateAccessPolicyIncludeWorkspace                                = "workspace"
+	CreateAccessPolicyIncludeWorkspaceAgentPool                       = "workspace.agent-pool"
+	CreateAccessPolicyIncludeWorkspaceConfigurationVersion            = "workspace.configuration-version"
+	CreateAccessPolicyIncludeWorkspaceCreatedBy                       = "workspace.created-by"
+	CreateAccessPolicyIncludeWorkspaceCurrentRun                      = "workspace.current-run"
+	CreateAccessPolicyIncludeWorkspaceDriftReport                     = "workspace.drift-report"
+	CreateAccessPolicyIncludeWorkspaceEnvironment                     = "workspace.environment"
+	CreateAccessPolicyIncludeWorkspaceLatestConfigurationVersion      = "workspace.latest-configuration-version"
+	CreateAccessPolicyIncludeWorkspaceLatestRun                       = "workspace.latest-run"
+	CreateAccessPolicyIncludeWorkspaceLockedBy                        = "workspace.locked-by"
+	CreateAccessPolicyIncludeWorkspaceLockedByRun                     = "workspace.locked-by-run"
+	CreateAccessPolicyIncludeWorkspaceModule                          = "workspace.module"
+	CreateAccessPolicyIncludeWorkspaceModuleVersion                   = "workspace.module-version"
+	CreateAccessPolicyIncludeWorkspaceReadmeId                        = "workspace.readme-id"
+	CreateAccessPolicyIncludeWorkspaceSshKey                          = "workspace.ssh-key"
+	CreateAccessPolicyIncludeWorkspaceTags                            = "workspace.tags"
+	CreateAccessPolicyIncludeWorkspaceUpdatedBy                       = "workspace.updated-by"
+	CreateAccessPolicyIncludeWorkspaceVcsProvider                     = "workspace.vcs-provider"
+)
+
 func (c *Client) DeleteAccessPolicyRaw(ctx context.Context, accessPolicy string) (*client.Response, error) {
 	path := "/access-policies/{access_policy}"
 	path = strings.ReplaceAll(path, "{access_policy}", url.PathEscape(accessPolicy))
@@ -153,19 +203,18 @@ func (c *Client) GetAccessPolicies(ctx context.Context, opts *GetAccessPoliciesO
 		Meta struct {
 			Pagination *client.Pagination `json:"pagination"`
 		} `json:"meta"`
-		Included []map[string]interface{} `json:"included"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
+	// Index included resources once to resolve the relationships
+	included := client.NewIncluded(result.Included)
 	resources := make([]*schemas.AccessPolicy, len(result.Data))
 	for i := range result.Data {
 		resources[i] = &result.Data[i]
-		// Populate included resources into relationships
-		if len(result.Included) > 0 {
-			resources[i].Relationships.PopulateIncludes(result.Included)
-		}
+		resources[i].ResolveIncludes(included)
 	}
 	return resources, nil
 }
@@ -174,6 +223,7 @@ func (c *Client) GetAccessPolicies(ctx context.Context, opts *GetAccessPoliciesO
 // This is the recommended and simple way to iterate through all the results.
 //
 // The iterator automatically fetches pages as needed and handles errors inline.
+// Pages are fetched ahead in the background when the client is created with client.WithPrefetch.
 //
 // Example:
 //
@@ -185,73 +235,23 @@ func (c *Client) GetAccessPolicies(ctx context.Context, opts *GetAccessPoliciesO
 //	}
 func (c *Client) GetAccessPoliciesIter(ctx context.Context, opts *GetAccessPoliciesOptions) iter.Seq2[schemas.AccessPolicy, error] {
 	return func(yield func(schemas.AccessPolicy, error) bool) {
-		// Determine page size from opts or use default
-		pageSize := 20
-		if opts != nil && opts.PageSize > 0 {
-			pageSize = opts.PageSize
-		}
-
-		pageNum := 1
-
-		for {
-			// Check context
-			if err := ctx.Err(); err != nil {
-				yield(schemas.AccessPolicy{}, err)
-				return
-			}
+		it := c.GetAccessPoliciesPaged(ctx, opts)
+		defer it.Close() // Stops the prefetching on early exit
 
-			// Copy options and set page number
-			pageOpts := &GetAccessPoliciesOptions{}
-			if opts != nil {
-				*pageOpts = *opts
+		for it.Next() {
+			if !yield(*it.Value(), nil) {
+				return // Consumer requested early exit
 			}
-			pageOpts.PageNumber = pageNum
-			pageOpts.PageSize = pageSize
-
-			// Fetch page using Raw method to get pagination metadata
-			resp, err := c.GetAccessPoliciesRaw(ctx, pageOpts)
-			if err != nil {
-				yield(schemas.AccessPolicy{}, err)
-				return
-			}
-			defer resp.Body.Close()
-
-			// Decode response
-			var result struct {
-				Data []schemas.AccessPolicy `json:"data"`
-				Meta struct {
-					Pagination *client.Pagination `json:"pagination"`
-				} `json:"meta"`
-				Included []map[string]interface{} `json:"included"`
-			}
-			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-				yield(schemas.AccessPolicy{}, fmt.Errorf("failed to decode response: %w", err))
-				return
-			}
-
-			// Yield each item
-			for i := range result.Data {
-				// Populate included resources into relationships
-				if len(result.Included) > 0 {
-					result.Data[i].Relationships.PopulateIncludes(result.Included)
-				}
-				if !yield(result.Data[i], nil) {
-					return // Consumer requested early exit
-				}
-			}
-
-			// Check if there are more pages
-			if result.Meta.Pagination == nil || result.Meta.Pagination.NextPage == nil {
-				break
-			}
-
-			pageNum++
+		}
+		if err := it.Err(); err != nil {
+			yield(schemas.AccessPolicy{}, err)
 		}
 	}
 }
 
 // GetAccessPoliciesPaged returns a stateful iterator with access to pagination metadata.
-// Use this when you need PageInfo(), Remaining(), or Collect() methods.
+// Use this when you need PageInfo(), Remaining(), or Collect() methods, or to resume
+// an interrupted iteration from the iterator Checkpoint().
 //
 // Example:
 //
@@ -264,6 +264,10 @@ func (c *Client) GetAccessPoliciesIter(ctx context.Context, opts *GetAccessPolic
 //	if err := iter.Err(); err != nil {
 //	    // Handle error
 //	}
+//
+// Resuming from a checkpoint saved by an iterator with the same options:
+//
+//	iter := client.AccessPolicy.GetAccessPoliciesPaged(ctx, opts).Resume(checkpoint)
 func (c *Client) GetAccessPoliciesPaged(ctx context.Context, opts *GetAccessPoliciesOptions) *client.Iterator[schemas.AccessPolicy] {
 	// Determine page size from opts or use default
 	pageSize := 20
@@ -294,26 +298,34 @@ func (c *Client) GetAccessPoliciesPaged(ctx context.Context, opts *GetAccessPoli
 			Meta struct {
 				Pagination *client.Pagination `json:"pagination"`
 			} `json:"meta"`
-			Included []map[string]interface{} `json:"included"`
+			Included []json.RawMessage `json:"included"`
 		}
 		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 			return nil, nil, fmt.Errorf("failed to decode response: %w", err)
 		}
 
-		// Convert to slice of pointers and populate includes
+		// Convert to slice of pointers and resolve includes
+		included := client.NewIncluded(result.Included)
 		items := make([]*schemas.AccessPolicy, len(result.Data))
 		for i := range result.Data {
 			items[i] = &result.Data[i]
-			// Populate included resources into relationships
-			if len(result.Included) > 0 {
-				items[i].Relationships.PopulateIncludes(result.Included)
-			}
+			items[i].ResolveIncludes(included)
 		}
 
 		return items, result.Meta.Pagination, nil
 	}
 
-	return client.NewIterator[schemas.AccessPolicy](ctx, pageSize, fetchPage)
+	// Checkpoints only resume the iterators of the same listing
+	listOpts := GetAccessPoliciesOptions{}
+	if opts != nil {
+		listOpts = *opts
+	}
+	listOpts.PageNumber, listOpts.PageSize = 0, 0
+	filters := client.HashOptions("GetAccessPolicies", listOpts)
+
+	return client.NewIterator[schemas.AccessPolicy](ctx, pageSize, fetchPage).
+		Prefetch(c.httpClient.Prefetch()).
+		FiltersHash(filters)
 }
 
 // GetAccessPoliciesOptions holds optional parameters for GetAccessPolicies
@@ -331,6 +343,58 @@ type GetAccessPoliciesOptions struct {
 	Filter  map[string]string
 }
 
+// Relationship paths accepted by the Include option of GetAccessPolicies
+const (
+	GetAccessPoliciesIncludeAccount                                  = "account"
+	GetAccessPoliciesIncludeAccountBillingPlan                       = "account.billing-plan"
+	GetAccessPoliciesIncludeAccountIdentityProvider                  = "account.identity-provider"
+	GetAccessPoliciesIncludeAccountOwner                             = "account.owner"
+	GetAccessPoliciesIncludeEnvironment                              = "environment"
+	GetAccessPoliciesIncludeEnvironmentAccount                       = "environment.account"
+	GetAccessPoliciesIncludeEnvironmentCreatedBy                     = "environment.created-by"
+	GetAccessPoliciesIncludeEnvironmentDefaultProviderConfigurations = "environment.default-provider-configurations"
+	GetAccessPoliciesIncludeEnvironmentDefaultWorkspaceAgentPool     = "environment.default-workspace-agent-pool"
+	GetAccessPoliciesIncludeEnvironmentDriftDetectionSchedules       = "environment.drift-detection-schedules"
+	GetAccessPoliciesIncludeEnvironmentLockedBy                      = "environment.locked-by"
+	GetAccessPoliciesIncludeEnvironmentPolicyGroups                  = "environment.policy-groups"
+	GetAccessPoliciesIncludeEnvironmentProviderConfigurations        = "environment.provider-configurations"
+	GetAccessPoliciesIncludeEnvironmentStorageProfile                = "environment.storage-profile"
+	GetAccessPoliciesIncludeEnvironmentTags                          = "environment.tags"
+	GetAccessPoliciesIncludeEnvironmentUpdatedBy                     = "environment.updated-by"
+	GetAccessPoliciesIncludeRoles                                    = "roles"
+	GetAccessPoliciesIncludeRolesAccount                             = "roles.account"
+	GetAccessPoliciesIncludeRolesPermissions                         = "roles.permissions"
+	GetAccessPoliciesIncludeServiceAccount                           = "service-account"
+	GetAccessPoliciesIncludeServiceAccountAccount                    = "service-account.account"
+	GetAccessPoliciesIncludeServiceAccountCreatedBy                  = "service-account.created-by"
+	GetAccessPoliciesIncludeServiceAccountOwners                     = "service-account.owners"
+	GetAccessPoliciesIncludeTeam                                     = "team"
+	GetAccessPoliciesIncludeTeamAccount                              = "team.account"
+	GetAccessPoliciesIncludeTeamIdentityProvider                     = "team.identity-provider"
+	GetAccessPoliciesIncludeTeamUsers                                = "team.users"
+	GetAccessPoliciesIncludeUser                                     = "user"
+	GetAccessPoliciesIncludeUserIdentityProviders                    = "user.identity-providers"
+	GetAccessPoliciesIncludeUserTeams                                = "user.teams"
+	GetAccessPoliciesIncludeWorkspace                                = "workspace"
+	GetAccessPoliciesIncludeWorkspaceAgentPool                       = "workspace.agent-pool"
+	GetAccessPoliciesIncludeWorkspaceConfigurationVersion            = "workspace.configuration-version"
+	GetAccessPoliciesIncludeWorkspaceCreatedBy                       = "workspace.created-by"
+	GetAccessPoliciesIncludeWorkspaceCurrentRun                      = "workspace.current-run"
+	GetAccessPoliciesIncludeWorkspaceDriftReport                     = "workspace.drift-report"
+	GetAccessPoliciesIncludeWorkspaceEnvironment                     = "workspace.environment"
+	GetAccessPoliciesIncludeWorkspaceLatestConfigurationVersion      = "workspace.latest-configuration-version"
+	GetAccessPoliciesIncludeWorkspaceLatestRun                       = "workspace.latest-run"
+	GetAccessPoliciesIncludeWorkspaceLockedBy                        = "workspace.locked-by"
+	GetAccessPoliciesIncludeWorkspaceLockedByRun                     = "workspace.locked-by-run"
+	GetAccessPoliciesIncludeWorkspaceModule                          = "workspace.module"
+	GetAccessPoliciesIncludeWorkspaceModuleVersion                   = "workspace.module-version"
+	GetAccessPoliciesIncludeWorkspaceReadmeId                        = "workspace.readme-id"
+	GetAccessPoliciesIncludeWorkspaceSshKey                          = "workspace.ssh-key"
+	GetAccessPoliciesIncludeWorkspaceTags                            = "workspace.tags"
+	GetAccessPoliciesIncludeWorkspaceUpdatedBy                       = "workspace.updated-by"
+	GetAccessPoliciesIncludeWorkspaceVcsProvider                     = "workspace.vcs-provider"
+)
+
 // The endpoint returns [IAM](https://docs.scalr.io/docs/identity-and-access-management) access policy by ID.
 func (c *Client) GetAccessPolicyRaw(ctx context.Context, accessPolicy string, opts *GetAccessPolicyOptions) (*client.Response, error) {
 	path := "/access-policies/{access_policy}"
@@ -366,17 +430,15 @@ func (c *Client) GetAccessPolicy(ctx context.Context, accessPolicy string, opts
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.AccessPolicy     `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.AccessPolicy `json:"data"`
+		Included []json.RawMessage    `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -387,6 +449,58 @@ type GetAccessPolicyOptions struct {
 	Filter  map[string]string
 }
 
+// Relationship paths accepted by the Include option of GetAccessPolicy
+const (
+	GetAccessPolicyIncludeAccount                                  = "account"
+	GetAccessPolicyIncludeAccountBillingPlan                       = "account.billing-plan"
+	GetAccessPolicyIncludeAccountIdentityProvider                  = "account.identity-provider"
+	GetAccessPolicyIncludeAccountOwner                             = "account.owner"
+	GetAccessPolicyIncludeEnvironment                              = "environment"
+	GetAccessPolicyIncludeEnvironmentAccount                       = "environment.account"
+	GetAccessPolicyIncludeEnvironmentCreatedBy                     = "environment.created-by"
+	GetAccessPolicyIncludeEnvironmentDefaultProviderConfigurations = "environment.default-provider-configurations"
+	GetAccessPolicyIncludeEnvironmentDefaultWorkspaceAgentPool     = "environment.default-workspace-agent-pool"
+	GetAccessPolicyIncludeEnvironmentDriftDetectionSchedules       = "environment.drift-detection-schedules"
+	GetAccessPolicyIncludeEnvironmentLockedBy                      = "environment.locked-by"
+	GetAccessPolicyIncludeEnvironmentPolicyGroups                  = "environment.policy-groups"
+	GetAccessPolicyIncludeEnvironmentProviderConfigurations        = "environment.provider-configurations"
+	GetAccessPolicyIncludeEnvironmentStorageProfile                = "environment.storage-profile"
+	GetAccessPolicyIncludeEnvironmentTags                          = "environment.tags"
+	GetAccessPolicyIncludeEnvironmentUpdatedBy                     = "environment.updated-by"
+	GetAccessPolicyIncludeRoles                                    = "roles"
+	GetAccessPolicyIncludeRolesAccount                             = "roles.account"
+	GetAccessPolicyIncludeRolesPermissions                         = "roles.permissions"
+	GetAccessPolicyIncludeServiceAccount                           = "service-account"
+	GetAccessPolicyIncludeServiceAccountAccount                    = "service-account.account"
+	GetAccessPolicyIncludeServiceAccountCreatedBy                  = "service-account.created-by"
+	GetAccessPolicyIncludeServiceAccountOwners                     = "service-account.owners"
+	GetAccessPolicyIncludeTeam                                     = "team"
+	GetAccessPolicyIncludeTeamAccount                              = "team.account"
+	GetAccessPolicyIncludeTeamIdentityProvider                     = "team.identity-provider"
+	GetAccessPolicyIncludeTeamUsers                                = "team.users"
+	GetAccessPolicyIncludeUser                                     = "user"
+	GetAccessPolicyIncludeUserIdentityProviders                    = "user.identity-providers"
+	GetAccessPolicyIncludeUserTeams                                = "user.teams"
+	GetAccessPolicyIncludeWorkspace                                = "workspace"
+	GetAccessPolicyIncludeWorkspaceAgentPool                       = "workspace.agent-pool"
+	GetAccessPolicyIncludeWorkspaceConfigurationVersion            = "workspace.configuration-version"
+	GetAccessPolicyIncludeWorkspaceCreatedBy                       = "workspace.created-by"
+	GetAccessPolicyIncludeWorkspaceCurrentRun                      = "workspace.current-run"
+	GetAccessPolicyIncludeWorkspaceDriftReport                     = "workspace.drift-report"
+	GetAccessPolicyIncludeWorkspaceEnvironment                     = "workspace.environment"
+	GetAccessPolicyIncludeWorkspaceLatestConfigurationVersion      = "workspace.latest-configuration-version"
+	GetAccessPolicyIncludeWorkspaceLatestRun                       = "workspace.latest-run"
+	GetAccessPolicyIncludeWorkspaceLockedBy                        = "workspace.locked-by"
+	GetAccessPolicyIncludeWorkspaceLockedByRun                     = "workspace.locked-by-run"
+	GetAccessPolicyIncludeWorkspaceModule                          = "workspace.module"
+	GetAccessPolicyIncludeWorkspaceModuleVersion                   = "workspace.module-version"
+	GetAccessPolicyIncludeWorkspaceReadmeId                        = "workspace.readme-id"
+	GetAccessPolicyIncludeWorkspaceSshKey                          = "workspace.ssh-key"
+	GetAccessPolicyIncludeWorkspaceTags                            = "workspace.tags"
+	GetAccessPolicyIncludeWorkspaceUpdatedBy                       = "workspace.updated-by"
+	GetAccessPolicyIncludeWorkspaceVcsProvider                     = "workspace.vcs-provider"
+)
+
 func (c *Client) UpdateAccessPolicyRaw(ctx context.Context, accessPolicy string, req *schemas.AccessPolicyRequest, opts *UpdateAccessPolicyOptions) (*client.Response, error) {
 	path := "/access-policies/{access_policy}"
 	path = strings.ReplaceAll(path, "{access_policy}", url.PathEscape(accessPolicy))
@@ -422,17 +536,15 @@ func (c *Client) UpdateAccessPolicy(ctx context.Context, accessPolicy string, re
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.AccessPolicy     `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.AccessPolicy `json:"data"`
+		Included []json.RawMessage    `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -442,3 +554,55 @@ type UpdateAccessPolicyOptions struct {
 	Include []string
 	Filter  map[string]string
 }
+
+// Relationship paths accepted by the Include option of UpdateAccessPolicy
+const (
+	UpdateAccessPolicyIncludeAccount                                  = "account"
+	UpdateAccessPolicyIncludeAccountBillingPlan                       = "account.billing-plan"
+	UpdateAccessPolicyIncludeAccountIdentityProvider                  = "account.identity-provider"
+	UpdateAccessPolicyIncludeAccountOwner                             = "account.owner"
+	UpdateAccessPolicyIncludeEnvironment                              = "environment"
+	UpdateAccessPolicyIncludeEnvironmentAccount                       = "environment.account"
+	UpdateAccessPolicyIncludeEnvironmentCreatedBy                     = "environment.created-by"
+	UpdateAccessPolicyIncludeEnvironmentDefaultProviderConfigurations = "environment.default-provider-configurations"
+	UpdateAccessPolicyIncludeEnvironmentDefaultWorkspaceAgentPool     = "environment.default-workspace-agent-pool"
+	UpdateAccessPolicyIncludeEnvironmentDriftDetectionSchedules       = "environment.drift-detection-schedules"
+	UpdateAccessPolicyIncludeEnvironmentLockedBy                      = "environment.locked-by"
+	UpdateAccessPolicyIncludeEnvironmentPolicyGroups                  = "environment.policy-groups"
+	UpdateAccessPolicyIncludeEnvironmentProviderConfigurations        = "environment.provider-configurations"
+	UpdateAccessPolicyIncludeEnvironmentStorageProfile                = "environment.storage-profile"
+	UpdateAccessPolicyIncludeEnvironmentTags                          = "environment.tags"
+	UpdateAccessPolicyIncludeEnvironmentUpdatedBy                     = "environment.updated-by"
+	UpdateAccessPolicyIncludeRoles                                    = "roles"
+	UpdateAccessPolicyIncludeRolesAccount                             = "roles.account"
+	UpdateAccessPolicyIncludeRolesPermissions                         = "roles.permissions"
+	UpdateAccessPolicyIncludeServiceAccount                           = "service-account"
+	UpdateAccessPolicyIncludeServiceAccountAccount                    = "service-account.account"
+	UpdateAccessPolicyIncludeServiceAccountCreatedBy                  = "service-account.created-by"
+	UpdateAccessPolicyIncludeServiceAccountOwners                     = "service-account.owners"
+	UpdateAccessPolicyIncludeTeam                                     = "team"
+	UpdateAccessPolicyIncludeTeamAccount                              = "team.account"
+	UpdateAccessPolicyIncludeTeamIdentityProvider                     = "team.identity-provider"
+	UpdateAccessPolicyIncludeTeamUsers                                = "team.users"
+	UpdateAccessPolicyIncludeUser                                     = "user"
+	UpdateAccessPolicyIncludeUserIdentityProviders                    = "user.identity-providers"
+	UpdateAccessPolicyIncludeUserTeams                                = "user.teams"
+	UpdateAccessPolicyIncludeWorkspace                                = "workspace"
+	UpdateAccessPolicyIncludeWorkspaceAgentPool                       = "workspace.agent-pool"
+	UpdateAccessPolicyIncludeWorkspaceConfigurationVersion            = "workspace.configuration-version"
+	UpdateAccessPolicyIncludeWorkspaceCreatedBy                       = "workspace.created-by"
+	UpdateAccessPolicyIncludeWorkspaceCurrentRun                      = "workspace.current-run"
+	UpdateAccessPolicyIncludeWorkspaceDriftReport                     = "workspace.drift-report"
+	UpdateAccessPolicyIncludeWorkspaceEnvironment                     = "workspace.environment"
+	UpdateAccessPolicyIncludeWorkspaceLatestConfigurationVersion      = "workspace.latest-configuration-version"
+	UpdateAccessPolicyIncludeWorkspaceLatestRun                       = "workspace.latest-run"
+	UpdateAccessPolicyIncludeWorkspaceLockedBy                        = "workspace.locked-by"
+	UpdateAccessPolicyIncludeWorkspaceLockedByRun                     = "workspace.locked-by-run"
+	UpdateAccessPolicyIncludeWorkspaceModule                          = "workspace.module"
+	UpdateAccessPolicyIncludeWorkspaceModuleVersion                   = "workspace.module-version"
+	UpdateAccessPolicyIncludeWorkspaceReadmeId                        = "workspace.readme-id"
+	UpdateAccessPolicyIncludeWorkspaceSshKey                          = "workspace.ssh-key"
+	UpdateAccessPolicyIncludeWorkspaceTags                            = "workspace.tags"
+	UpdateAccessPolicyIncludeWorkspaceUpdatedBy                       = "workspace.updated-by"
+	UpdateAccessPolicyIncludeWorkspaceVcsProvider                     = "workspace.vcs-provider"
+)
@@ -89,17 +89,15 @@ func (c *Client) CreateAccessToken(ctx context.Context, req *schemas.AccessToken
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.AccessToken      `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.AccessToken `json:"data"`
+		Included []json.RawMessage   `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -110,6 +108,13 @@ type CreateAccessTokenOptions struct {
 	Filter  map[string]string
 }
 
+// Relationship paths accepted by the Include option of CreateAccessToken
+const (
+	CreateAccessTokenIncludeCreatedBy                  = "created-by"
+	CreateAccessTokenIncludeCreatedByIdentityProviders = "created-by.identity-providers"
+	CreateAccessTokenIncludeCreatedByTeams             = "created-by.teams"
+)
+
 // This endpoint creates agent pool's access token.
 func (c *Client) CreateAgentPoolTokenRaw(ctx context.Context, agentPool string, req *schemas.AccessTokenRequest, opts *CreateAgentPoolTokenOptions) (*client.Response, error) {
 	path := "/agent-pools/{agent_pool}/access-tokens"
@@ -147,17 +152,15 @@ func (c *Client) CreateAgentPoolToken(ctx context.Context, agentPool string, req
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.AccessToken      `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.AccessToken `json:"data"`
+		Included []json.RawMessage   `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -168,6 +171,13 @@ type CreateAgentPoolTokenOptions struct {
 	Filter  map[string]string
 }
 
+// Relationship paths accepted by the Include option of CreateAgentPoolToken
+const (
+	CreateAgentPoolTokenIncludeCreatedBy                  = "created-by"
+	CreateAgentPoolTokenIncludeCreatedByIdentityProviders = "created-by.identity-providers"
+	CreateAgentPoolTokenIncludeCreatedByTeams             = "created-by.teams"
+)
+
 // This endpoint creates service account's access token.
 func (c *Client) CreateServiceAccountTokenRaw(ctx context.Context, serviceAccount string, req *schemas.AccessTokenRequest, opts *CreateServiceAccountTokenOptions) (*client.Response, error) {
 	path := "/service-accounts/{service_account}/access-tokens"
@@ -205,17 +215,15 @@ func (c *Client) CreateServiceAccountToken(ctx context.Context, serviceAccount s
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.AccessToken      `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.AccessToken `json:"data"`
+		Included []json.RawMessage   `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -226,6 +234,13 @@ type CreateServiceAccountTokenOptions struct {
 	Filter  map[string]string
 }
 
+// Relationship paths accepted by the Include option of CreateServiceAccountToken
+const (
+	CreateServiceAccountTokenIncludeCreatedBy                  = "created-by"
+	CreateServiceAccountTokenIncludeCreatedByIdentityProviders = "created-by.identity-providers"
+	CreateServiceAccountTokenIncludeCreatedByTeams             = "created-by.teams"
+)
+
 // Delete an access token by ID.
 func (c *Client) DeleteAccessTokenRaw(ctx context.Context, accessToken string) (*client.Response, error) {
 	path := "/access-tokens/{access_token}"
@@ -284,17 +299,15 @@ func (c *Client) GetAccessToken(ctx context.Context, accessToken string, opts *G
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.AccessToken      `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.AccessToken `json:"data"`
+		Included []json.RawMessage   `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -305,6 +318,13 @@ type GetAccessTokenOptions struct {
 	Filter  map[string]string
 }
 
+// Relationship paths accepted by the Include option of GetAccessToken
+const (
+	GetAccessTokenIncludeCreatedBy                  = "created-by"
+	GetAccessTokenIncludeCreatedByIdentityProviders = "created-by.identity-providers"
+	GetAccessTokenIncludeCreatedByTeams             = "created-by.teams"
+)
+
 // This endpoint lists user access tokens.
 func (c *Client) ListAccessTokensRaw(ctx context.Context, opts *ListAccessTokensOptions) (*client.Response, error) {
 	path := "/access-tokens"
@@ -356,19 +376,18 @@ func (c *Client) ListAccessTokens(ctx context.Context, opts *ListAccessTokensOpt
 		Meta struct {
 			Pagination *client.Pagination `json:"pagination"`
 		} `json:"meta"`
-		Included []map[string]interface{} `json:"included"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
+	// Index included resources once to resolve the relationships
+	included := client.NewIncluded(result.Included)
 	resources := make([]*schemas.AccessToken, len(result.Data))
 	for i := range result.Data {
 		resources[i] = &result.Data[i]
-		// Populate included resources into relationships
-		if len(result.Included) > 0 {
-			resources[i].Relationships.PopulateIncludes(result.Included)
-		}
+		resources[i].ResolveIncludes(included)
 	}
 	return resources, nil
 }
@@ -377,6 +396,7 @@ func (c *Client) ListAccessTokens(ctx context.Context, opts *ListAccessTokensOpt
 // This is the recommended and simple way to iterate through all the results.
 //
 // The iterator automatically fetches pages as needed and handles errors inline.
+// Pages are fetched ahead in the background when the client is created with client.WithPrefetch.
 //
 // Example:
 //
@@ -388,73 +408,23 @@ func (c *Client) ListAccessTokens(ctx context.Context, opts *ListAccessTokensOpt
 //	}
 func (c *Client) ListAccessTokensIter(ctx context.Context, opts *ListAccessTokensOptions) iter.Seq2[schemas.AccessToken, error] {
 	return func(yield func(schemas.AccessToken, error) bool) {
-		// Determine page size from opts or use default
-		pageSize := 20
-		if opts != nil && opts.PageSize > 0 {
-			pageSize = opts.PageSize
-		}
-
-		pageNum := 1
-
-		for {
-			// Check context
-			if err := ctx.Err(); err != nil {
-				yield(schemas.AccessToken{}, err)
-				return
-			}
+		it := c.ListAccessTokensPaged(ctx, opts)
+		defer it.Close() // Stops the prefetching on early exit
 
-			// Copy options and set page number
-			pageOpts := &ListAccessTokensOptions{}
-			if opts != nil {
-				*pageOpts = *opts
-			}
-			pageOpts.PageNumber = pageNum
-			pageOpts.PageSize = pageSize
-
-			// Fetch page using Raw method to get pagination metadata
-			resp, err := c.ListAccessTokensRaw(ctx, pageOpts)
-			if err != nil {
-				yield(schemas.AccessToken{}, err)
-				return
-			}
-			defer resp.Body.Close()
-
-			// Decode response
-			var result struct {
-				Data []schemas.AccessToken `json:"data"`
-				Meta struct {
-					Pagination *client.Pagination `json:"pagination"`
-				} `json:"meta"`
-				Included []map[string]interface{} `json:"included"`
+		for it.Next() {
+			if !yield(*it.Value(), nil) {
+				return // Consumer requested early exit
 			}
-			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-				yield(schemas.AccessToken{}, fmt.Errorf("failed to decode response: %w", err))
-				return
-			}
-
-			// Yield each item
-			for i := range result.Data {
-				// Populate included resources into relationships
-				if len(result.Included) > 0 {
-					result.Data[i].Relationships.PopulateIncludes(result.Included)
-				}
-				if !yield(result.Data[i], nil) {
-					return // Consumer requested early exit
-				}
-			}
-
-			// Check if there are more pages
-			if result.Meta.Pagination == nil || result.Meta.Pagination.NextPage == nil {
-				break
-			}
-
-			pageNum++
+		}
+		if err := it.Err(); err != nil {
+			yield(schemas.AccessToken{}, err)
 		}
 	}
 }
 
 // ListAccessTokensPaged returns a stateful iterator with access to pagination metadata.
-// Use this when you need PageInfo(), Remaining(), or Collect() methods.
+// Use this when you need PageInfo(), Remaining(), or Collect() methods, or to resume
+// an interrupted iteration from the iterator Checkpoint().
 //
 // Example:
 //
@@ -467,6 +437,10 @@ func (c *Client) ListAccessTokensIter(ctx context.Context, opts *ListAccessToken
 //	if err := iter.Err(); err != nil {
 //	    // Handle error
 //	}
+//
+// Resuming from a checkpoint saved by an iterator with the same options:
+//
+//	iter := client.AccessToken.ListAccessTokensPaged(ctx, opts).Resume(checkpoint)
 func (c *Client) ListAccessTokensPaged(ctx context.Context, opts *ListAccessTokensOptions) *client.Iterator[schemas.AccessToken] {
 	// Determine page size from opts or use default
 	pageSize := 20
@@ -497,26 +471,34 @@ func (c *Client) ListAccessTokensPaged(ctx context.Context, opts *ListAccessToke
 			Meta struct {
 				Pagination *client.Pagination `json:"pagination"`
 			} `json:"meta"`
-			Included []map[string]interface{} `json:"included"`
+			Included []json.RawMessage `json:"included"`
 		}
 		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 			return nil, nil, fmt.Errorf("failed to decode response: %w", err)
 		}
 
-		// Convert to slice of pointers and populate includes
+		// Convert to slice of pointers and resolve includes
+		included := client.NewIncluded(result.Included)
 		items := make([]*schemas.AccessToken, len(result.Data))
 		for i := range result.Data {
 			items[i] = &result.Data[i]
-			// Populate included resources into relationships
-			if len(result.Included) > 0 {
-				items[i].Relationships.PopulateIncludes(result.Included)
-			}
+			items[i].ResolveIncludes(included)
 		}
 
 		return items, result.Meta.Pagination, nil
 	}
 
-	return client.NewIterator[schemas.AccessToken](ctx, pageSize, fetchPage)
+	// Checkpoints only resume the iterators of the same listing
+	listOpts := ListAccessTokensOptions{}
+	if opts != nil {
+		listOpts = *opts
+	}
+	listOpts.PageNumber, listOpts.PageSize = 0, 0
+	filters := client.HashOptions("ListAccessTokens", listOpts)
+
+	return client.NewIterator[schemas.AccessToken](ctx, pageSize, fetchPage).
+		Prefetch(c.httpClient.Prefetch()).
+		FiltersHash(filters)
 }
 
 // ListAccessTokensOptions holds optional parameters for ListAccessTokens
@@ -534,6 +516,13 @@ type ListAccessTokensOptions struct {
 	Filter map[string]string
 }
 
+// Relationship paths accepted by the Include option of ListAccessTokens
+const (
+	ListAccessTokensIncludeCreatedBy                  = "created-by"
+	ListAccessTokensIncludeCreatedByIdentityProviders = "created-by.identity-providers"
+	ListAccessTokensIncludeCreatedByTeams             = "created-by.teams"
+)
+
 func (c *Client) ListAgentPoolAccessTokensRaw(ctx context.Context, agentPool string, opts *ListAgentPoolAccessTokensOptions) (*client.Response, error) {
 	path := "/agent-pools/{agent_pool}/access-tokens"
 	path = strings.ReplaceAll(path, "{agent_pool}", url.PathEscape(agentPool))
@@ -584,19 +573,18 @@ func (c *Client) ListAgentPoolAccessTokens(ctx context.Context, agentPool string
 		Meta struct {
 			Pagination *client.Pagination `json:"pagination"`
 		} `json:"meta"`
-		Included []map[string]interface{} `json:"included"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
+	// Index included resources once to resolve the relationships
+	included := client.NewIncluded(result.Included)
 	resources := make([]*schemas.AccessToken, len(result.Data))
 	for i := range result.Data {
 		resources[i] = &result.Data[i]
-		// Populate included resources into relationships
-		if len(result.Included) > 0 {
-			resources[i].Relationships.PopulateIncludes(result.Included)
-		}
+		resources[i].ResolveIncludes(included)
 	}
 	return resources, nil
 }
@@ -605,6 +593,7 @@ func (c *Client) ListAgentPoolAccessTokens(ctx context.Context, agentPool string
 // This is the recommended and simple way to iterate through all the results.
 //
 // The iterator automatically fetches pages as needed and handles errors inline.
+// Pages are fetched ahead in the background when the client is created with client.WithPrefetch.
 //
 // Example:
 //
@@ -616,73 +605,23 @@ func (c *Client) ListAgentPoolAccessTokens(ctx context.Context, agentPool string
 //	}
 func (c *Client) ListAgentPoolAccessTokensIter(ctx context.Context, agentPool string, opts *ListAgentPoolAccessTokensOptions) iter.Seq2[schemas.AccessToken, error] {
 	return func(yield func(schemas.AccessToken, error) bool) {
-		// Determine page size from opts or use default
-		pageSize := 20
-		if opts != nil && opts.PageSize > 0 {
-			pageSize = opts.PageSize
-		}
+		it := c.ListAgentPoolAccessTokensPaged(ctx, agentPool, opts)
+		defer it.Close() // Stops the prefetching on early exit
 
-		pageNum := 1
-
-		for {
-			// Check context
-			if err := ctx.Err(); err != nil {
-				yield(schemas.AccessToken{}, err)
-				return
-			}
-
-			// Copy options and set page number
-			pageOpts := &ListAgentPoolAccessTokensOptions{}
-			if opts != nil {
-				*pageOpts = *opts
-			}
-			pageOpts.PageNumber = pageNum
-			pageOpts.PageSize = pageSize
-
-			// Fetch page using Raw method to get pagination metadata
-			resp, err := c.ListAgentPoolAccessTokensRaw(ctx, agentPool, pageOpts)
-			if err != nil {
-				yield(schemas.AccessToken{}, err)
-				return
-			}
-			defer resp.Body.Close()
-
-			// Decode response
-			var result struct {
-				Data []schemas.AccessToken `json:"data"`
-				Meta struct {
-					Pagination *client.Pagination `json:"pagination"`
-				} `json:"meta"`
-				Included []map[string]interface{} `json:"included"`
+		for it.Next() {
+			if !yield(*it.Value(), nil) {
+				return // Consumer requested early exit
 			}
-			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-				yield(schemas.AccessToken{}, fmt.Errorf("failed to decode response: %w", err))
-				return
-			}
-
-			// Yield each item
-			for i := range result.Data {
-				// Populate included resources into relationships
-				if len(result.Included) > 0 {
-					result.Data[i].Relationships.PopulateIncludes(result.Included)
-				}
-				if !yield(result.Data[i], nil) {
-					return // Consumer requested early exit
-				}
-			}
-
-			// Check if there are more pages
-			if result.Meta.Pagination == nil || result.Meta.Pagination.NextPage == nil {
-				break
-			}
-
-			pageNum++
+		}
+		if err := it.Err(); err != nil {
+			yield(schemas.AccessToken{}, err)
 		}
 	}
 }
 
 // ListAgentPoolAccessTokensPaged returns a stateful iterator with access to pagination metadata.
-// Use this when you need PageInfo(), Remaining(), or Collect() methods.
+// Use this when you need PageInfo(), Remaining(), or Collect() methods, or to resume
+// an interrupted iteration from the iterator Checkpoint().
 //
 // Example:
 //
@@ -695,6 +634,10 @@ func (c *Client) ListAgentPoolAccessTokensIter(ctx context.Context, agentPool st
 //	if err := iter.Err(); err != nil {
 //	    // Handle error
 //	}
+//
+// Resuming from a checkpoint saved by an iterator with the same options:
+//
+//	iter := client.AccessToken.ListAgentPoolAccessTokensPaged(ctx, agentPool, opts).Resume(checkpoint)
 func (c *Client) ListAgentPoolAccessTokensPaged(ctx context.Context, agentPool string, opts *ListAgentPoolAccessTokensOptions) *client.Iterator[schemas.AccessToken] {
 	// Determine page size from opts or use default
 	pageSize := 20
@@ -725,26 +668,34 @@ func (c *Client) ListAgentPoolAccessTokensPaged(ctx context.Context, agentPool s
 			Meta struct {
 				Pagination *client.Pagination `json:"pagination"`
 			} `json:"meta"`
-			Included []map[string]interface{} `json:"included"`
+			Included []json.RawMessage `json:"included"`
 		}
 		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 			return nil, nil, fmt.Errorf("failed to decode response: %w", err)
 		}
 
-		// Convert to slice of pointers and populate includes
+		// Convert to slice of pointers and resolve includes
+		included := client.NewIncluded(result.Included)
 		items := make([]*schemas.AccessToken, len(result.Data))
 		for i := range result.Data {
 			items[i] = &result.Data[i]
-			// Populate included resources into relationships
-			if len(result.Included) > 0 {
-				items[i].Relationships.PopulateIncludes(result.Included)
-			}
+			items[i].ResolveIncludes(included)
 		}
 
 		return items, result.Meta.Pagination, nil
 	}
 
-	return client.NewIterator[schemas.AccessToken](ctx, pageSize, fetchPage)
+	// Checkpoints only resume the iterators of the same listing
+	listOpts := ListAgentPoolAccessTokensOptions{}
+	if opts != nil {
+		listOpts = *opts
+	}
+	listOpts.PageNumber, listOpts.PageSize = 0, 0
+	filters := client.HashOptions("ListAgentPoolAccessTokens", agentPool, listOpts)
+
+	return client.NewIterator[schemas.AccessToken](ctx, pageSize, fetchPage).
+		Prefetch(c.httpClient.Prefetch()).
+		FiltersHash(filters)
 }
 
 // ListAgentPoolAccessTokensOptions holds optional parameters for ListAgentPoolAccessTokens
@@ -762,6 +713,13 @@ type ListAgentPoolAccessTokensOptions struct {
 	Filter map[string]string
 }
 
+// Relationship paths accepted by the Include option of ListAgentPoolAccessTokens
+const (
+	ListAgentPoolAccessTokensIncludeCreatedBy                  = "created-by"
+	ListAgentPoolAccessTokensIncludeCreatedByIdentityProviders = "created-by.identity-providers"
+	ListAgentPoolAccessTokensIncludeCreatedByTeams             = "created-by.teams"
+)
+
 // This endpoint lists service account's access tokens.
 func (c *Client) ListServiceAccountAccessTokensRaw(ctx context.Context, serviceAccount string, opts *ListServiceAccountAccessTokensOptions) (*client.Response, error) {
 	path := "/service-accounts/{service_account}/access-tokens"
@@ -814,19 +772,18 @@ func (c *Client) ListServiceAccountAccessTokens(ctx context.Context, serviceAcco
 		Meta struct {
 			Pagination *client.Pagination `json:"pagination"`
 		} `json:"meta"`
-		Included []map[string]interface{} `json:"included"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
+	// Index included resources once to resolve the relationships
+	included := client.NewIncluded(result.Included)
 	resources := make([]*schemas.AccessToken, len(result.Data))
 	for i := range result.Data {
 		resources[i] = &result.Data[i]
-		// Populate included resources into relationships
-		if len(result.Included) > 0 {
-			resources[i].Relationships.PopulateIncludes(result.Included)
-		}
+		resources[i].ResolveIncludes(included)
 	}
 	return resources, nil
 }
@@ -835,6 +792,7 @@ func (c *Client) ListServiceAccountAccessTokens(ctx context.Context, serviceAcco
 // This is the recommended and simple way to iterate through all the results.
 //
 // The iterator automatically fetches pages as needed and handles errors inline.
+// Pages are fetched ahead in the background when the client is created with client.WithPrefetch.
 //
 // Example:
 //
@@ -846,73 +804,23 @@ func (c *Client) ListServiceAccountAccessTokens(ctx context.Context, serviceAcco
 //	}
 func (c *Client) ListServiceAccountAccessTokensIter(ctx context.Context, serviceAccount string, opts *ListServiceAccountAccessTokensOptions) iter.Seq2[schemas.AccessToken, error] {
 	return func(yield func(schemas.AccessToken, error) bool) {
-		// Determine page size from opts or use default
-		pageSize := 20
-		if opts != nil && opts.PageSize > 0 {
-			pageSize = opts.PageSize
-		}
-
-		pageNum := 1
-
-		for {
-			// Check context
-			if err := ctx.Err(); err != nil {
-				yield(schemas.AccessToken{}, err)
-				return
-			}
+		it := c.ListServiceAccountAccessTokensPaged(ctx, serviceAccount, opts)
+		defer it.Close() // Stops the prefetching on early exit
 
-			// Copy options and set page number
-			pageOpts := &ListServiceAccountAccessTokensOptions{}
-			if opts != nil {
-				*pageOpts = *opts
+		for it.Next() {
+			if !yield(*it.Value(), nil) {
+				return // Consumer requested early exit
 			}
-			pageOpts.PageNumber = pageNum
-			pageOpts.PageSize = pageSize
-
-			// Fetch page using Raw method to get pagination metadata
-			resp, err := c.ListServiceAccountAccessTokensRaw(ctx, serviceAccount, pageOpts)
-			if err != nil {
-				yield(schemas.AccessToken{}, err)
-				return
-			}
-			defer resp.Body.Close()
-
-			// Decode response
-			var result struct {
-				Data []schemas.AccessToken `json:"data"`
-				Meta struct {
-					Pagination *client.Pagination `json:"pagination"`
-				} `json:"meta"`
-				Included []map[string]interface{} `json:"included"`
-			}
-			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-				yield(schemas.AccessToken{}, fmt.Errorf("failed to decode response: %w", err))
-				return
-			}
-
-			// Yield each item
-			for i := range result.Data {
-				// Populate included resources into relationships
-				if len(result.Included) > 0 {
-					result.Data[i].Relationships.PopulateIncludes(result.Included)
-				}
-				if !yield(result.Data[i], nil) {
-					return // Consumer requested early exit
-				}
-			}
-
-			// Check if there are more pages
-			if result.Meta.Pagination == nil || result.Meta.Pagination.NextPage == nil {
-				break
-			}
-
-			pageNum++
+		}
+		if err := it.Err(); err != nil {
+			yield(schemas.AccessToken{}, err)
 		}
 	}
 }
 
 // ListServiceAccountAccessTokensPaged returns a stateful iterator with access to pagination metadata.
-// Use this when you need PageInfo(), Remaining(), or Collect() methods.
+// Use this when you need PageInfo(), Remaining(), or Collect() methods, or to resume
+// an interrupted iteration from the iterator Checkpoint().
 //
 // Example:
 //
@@ -925,6 +833,10 @@ func (c *Client) ListServiceAccountAccessTokensIter(ctx context.Context, service
 //	if err := iter.Err(); err != nil {
 //	    // Handle error
 //	}
+//
+// Resuming from a checkpoint saved by an iterator with the same options:
+//
+//	iter := client.AccessToken.ListServiceAccountAccessTokensPaged(ctx, serviceAccount, opts).Resume(checkpoint)
 func (c *Client) ListServiceAccountAccessTokensPaged(ctx context.Context, serviceAccount string, opts *ListServiceAccountAccessTokensOptions) *client.Iterator[schemas.AccessToken] {
 	// Determine page size from opts or use default
 	pageSize := 20
@@ -955,26 +867,34 @@ func (c *Client) ListServiceAccountAccessTokensPaged(ctx context.Context, servic
 			Meta struct {
 				Pagination *client.Pagination `json:"pagination"`
 			} `json:"meta"`
-			Included []map[string]interface{} `json:"included"`
+			Included []json.RawMessage `json:"included"`
 		}
 		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 			return nil, nil, fmt.Errorf("failed to decode response: %w", err)
 		}
 
-		// Convert to slice of pointers and populate includes
+		// Convert to slice of pointers and resolve includes
+		included := client.NewIncluded(result.Included)
 		items := make([]*schemas.AccessToken, len(result.Data))
 		for i := range result.Data {
 			items[i] = &result.Data[i]
-			// Populate included resources into relationships
-			if len(result.Included) > 0 {
-				items[i].Relationships.PopulateIncludes(result.Included)
-			}
+			items[i].ResolveIncludes(included)
 		}
 
 		return items, result.Meta.Pagination, nil
 	}
 
-	return client.NewIterator[schemas.AccessToken](ctx, pageSize, fetchPage)
+	// Checkpoints only resume the iterators of the same listing
+	listOpts := ListServiceAccountAccessTokensOptions{}
+	if opts != nil {
+		listOpts = *opts
+	}
+	listOpts.PageNumber, listOpts.PageSize = 0, 0
+	filters := client.HashOptions("ListServiceAccountAccessTokens", serviceAccount, listOpts)
+
+	return client.NewIterator[schemas.AccessToken](ctx, pageSize, fetchPage).
+		Prefetch(c.httpClient.Prefetch()).
+		FiltersHash(filters)
 }
 
 // ListServiceAccountAccessTokensOptions holds optional parameters for ListServiceAccountAccessTokens
@@ -992,6 +912,13 @@ type ListServiceAccountAccessTokensOptions struct {
 	Filter map[string]string
 }
 
+// Relationship paths accepted by the Include option of ListServiceAccountAccessTokens
+const (
+	ListServiceAccountAccessTokensIncludeCreatedBy                  = "created-by"
+	ListServiceAccountAccessTokensIncludeCreatedByIdentityProviders = "created-by.identity-providers"
+	ListServiceAccountAccessTokensIncludeCreatedByTeams             = "created-by.teams"
+)
+
 // Update an access token by ID.
 func (c *Client) UpdateAccessTokenRaw(ctx context.Context, accessToken string, req *schemas.AccessTokenRequest, opts *UpdateAccessTokenOptions) (*client.Response, error) {
 	path := "/access-tokens/{access_token}"
@@ -1029,17 +956,15 @@ func (c *Client) UpdateAccessToken(ctx context.Context, accessToken string, req
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.AccessToken      `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.AccessToken `json:"data"`
+		Included []json.RawMessage   `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -1049,3 +974,10 @@ type UpdateAccessTokenOptions struct {
 	Include []string
 	Filter  map[string]string
 }
+
+// Relationship paths accepted by the Include option of UpdateAccessToken
+const (
+	UpdateAccessTokenIncludeCreatedBy                  = "created-by"
+	UpdateAccessTokenIncludeCreatedByIdentityProviders = "created-by.identity-providers"
+	UpdateAccessTokenIncludeCreatedByTeams             = "created-by.teams"
+)
@@ -76,7 +76,7 @@ func (c *Client) ListAccessTokenUsage(ctx context.Context, opts *ListAccessToken
 		Meta struct {
 			Pagination *client.Pagination `json:"pagination"`
 		} `json:"meta"`
-		Included []map[string]interface{} `json:"included"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
@@ -93,6 +93,7 @@ func (c *Client) ListAccessTokenUsage(ctx context.Context, opts *ListAccessToken
 // This is the recommended and simple way to iterate through all the results.
 //
 // The iterator automatically fetches pages as needed and handles errors inline.
+// Pages are fetched ahead in the background when the client is created with client.WithPrefetch.
 //
 // Example:
 //
@@ -104,69 +105,23 @@ func (c *Client) ListAccessTokenUsage(ctx context.Context, opts *ListAccessToken
 //	}
 func (c *Client) ListAccessTokenUsageIter(ctx context.Context, opts *ListAccessTokenUsageOptions) iter.Seq2[schemas.AccessTokenUsage, error] {
 	return func(yield func(schemas.AccessTokenUsage, error) bool) {
-		// Determine page size from opts or use default
-		pageSize := 20
-		if opts != nil && opts.PageSize > 0 {
-			pageSize = opts.PageSize
-		}
-
-		pageNum := 1
-
-		for {
-			// Check context
-			if err := ctx.Err(); err != nil {
-				yield(schemas.AccessTokenUsage{}, err)
-				return
-			}
-
-			// Copy options and set page number
-			pageOpts := &ListAccessTokenUsageOptions{}
-			if opts != nil {
-				*pageOpts = *opts
-			}
-			pageOpts.PageNumber = pageNum
-			pageOpts.PageSize = pageSize
-
-			// Fetch page using Raw method to get pagination metadata
-			resp, err := c.ListAccessTokenUsageRaw(ctx, pageOpts)
-			if err != nil {
-				yield(schemas.AccessTokenUsage{}, err)
-				return
-			}
-			defer resp.Body.Close()
-
-			// Decode response
-			var result struct {
-				Data []schemas.AccessTokenUsage `json:"data"`
-				Meta struct {
-					Pagination *client.Pagination `json:"pagination"`
-				} `json:"meta"`
-				Included []map[string]interface{} `json:"included"`
-			}
-			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-				yield(schemas.AccessTokenUsage{}, fmt.Errorf("failed to decode response: %w", err))
-				return
-			}
+		it := c.ListAccessTokenUsagePaged(ctx, opts)
+		defer it.Close() // Stops the prefetching on early exit
 
-			// Yield each item
-			for i := range result.Data {
-				if !yield(result.Data[i], nil) {
-					return // Consumer requested early exit
-				}
+		for it.Next() {
+			if !yield(*it.Value(), nil) {
+				return // Consumer requested early exit
 			}
-
-			// Check if there are more pages
-			if result.Meta.Pagination == nil || result.Meta.Pagination.NextPage == nil {
-				break
-			}
-
-			pageNum++
+		}
+		if err := it.Err(); err != nil {
+			yield(schemas.AccessTokenUsage{}, err)
 		}
 	}
 }
 
 // ListAccessTokenUsagePaged returns a stateful iterator with access to pagination metadata.
-// Use this when you need PageInfo(), Remaining(), or Collect() methods.
+// Use this when you need PageInfo(), Remaining(), or Collect() methods, or to resume
+// an interrupted iteration from the iterator Checkpoint().
 //
 // Example:
 //
@@ -179,6 +134,10 @@ func (c *Client) ListAccessTokenUsageIter(ctx context.Context, opts *ListAccessT
 //	if err := iter.Err(); err != nil {
 //	    // Handle error
 //	}
+//
+// Resuming from a checkpoint saved by an iterator with the same options:
+//
+//	iter := client.AccessTokenUsage.ListAccessTokenUsagePaged(ctx, opts).Resume(checkpoint)
 func (c *Client) ListAccessTokenUsagePaged(ctx context.Context, opts *ListAccessTokenUsageOptions) *client.Iterator[schemas.AccessTokenUsage] {
 	// Determine page size from opts or use default
 	pageSize := 20
@@ -209,13 +168,13 @@ func (c *Client) ListAccessTokenUsagePaged(ctx context.Context, opts *ListAccess
 			Meta struct {
 				Pagination *client.Pagination `json:"pagination"`
 			} `json:"meta"`
-			Included []map[string]interface{} `json:"included"`
+			Included []json.RawMessage `json:"included"`
 		}
 		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 			return nil, nil, fmt.Errorf("failed to decode response: %w", err)
 		}
 
-		// Convert to slice of pointers and populate includes
+		// Convert to slice of pointers and resolve includes
 		items := make([]*schemas.AccessTokenUsage, len(result.Data))
 		for i := range result.Data {
 			items[i] = &result.Data[i]
@@ -224,7 +183,17 @@ func (c *Client) ListAccessTokenUsagePaged(ctx context.Context, opts *ListAccess
 		return items, result.Meta.Pagination, nil
 	}
 
-	return client.NewIterator[schemas.AccessTokenUsage](ctx, pageSize, fetchPage)
+	// Checkpoints only resume the iterators of the same listing
+	listOpts := ListAccessTokenUsageOptions{}
+	if opts != nil {
+		listOpts = *opts
+	}
+	listOpts.PageNumber, listOpts.PageSize = 0, 0
+	filters := client.HashOptions("ListAccessTokenUsage", listOpts)
+
+	return client.NewIterator[schemas.AccessTokenUsage](ctx, pageSize, fetchPage).
+		Prefetch(c.httpClient.Prefetch()).
+		FiltersHash(filters)
 }
 
 // ListAccessTokenUsageOptions holds optional parameters for ListAccessTokenUsage
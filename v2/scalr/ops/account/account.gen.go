@@ -124,17 +124,15 @@ func (c *Client) GetAccount(ctx context.Context, account string, opts *GetAccoun
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.Account          `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.Account   `json:"data"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -145,6 +143,16 @@ type GetAccountOptions struct {
 	Filter  map[string]string
 }
 
+// Relationship paths accepted by the Include option of GetAccount
+const (
+	GetAccountIncludeBillingPlan             = "billing-plan"
+	GetAccountIncludeIdentityProvider        = "identity-provider"
+	GetAccountIncludeIdentityProviderAccount = "identity-provider.account"
+	GetAccountIncludeOwner                   = "owner"
+	GetAccountIncludeOwnerIdentityProviders  = "owner.identity-providers"
+	GetAccountIncludeOwnerTeams              = "owner.teams"
+)
+
 func (c *Client) GetAccountsRaw(ctx context.Context, opts *GetAccountsOptions) (*client.Response, error) {
 	path := "/accounts"
 
@@ -159,8 +167,7 @@ func (c *Client) GetAccountsRaw(ctx context.Context, opts *GetAccountsOptions) (
 		if len(opts.Include) > 0 {
 			params.Set("include", strings.Join(opts.Include, ","))
 		}
-		// Handle parameter: Fields (map[string]interface{})
-		// Complex type map[string]interface{} - skip for now
+		opts.Fields.Encode(params)
 		// Add filters
 		for k, v := range opts.Filter {
 			params.Set("filter["+k+"]", v)
@@ -189,19 +196,18 @@ func (c *Client) GetAccounts(ctx context.Context, opts *GetAccountsOptions) ([]*
 		Meta struct {
 			Pagination *client.Pagination `json:"pagination"`
 		} `json:"meta"`
-		Included []map[string]interface{} `json:"included"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
+	// Index included resources once to resolve the relationships
+	included := client.NewIncluded(result.Included)
 	resources := make([]*schemas.Account, len(result.Data))
 	for i := range result.Data {
 		resources[i] = &result.Data[i]
-		// Populate included resources into relationships
-		if len(result.Included) > 0 {
-			resources[i].Relationships.PopulateIncludes(result.Included)
-		}
+		resources[i].ResolveIncludes(included)
 	}
 	return resources, nil
 }
@@ -210,6 +216,7 @@ func (c *Client) GetAccounts(ctx context.Context, opts *GetAccountsOptions) ([]*
 // This is the recommended and simple way to iterate through all the results.
 //
 // The iterator automatically fetches pages as needed and handles errors inline.
+// Pages are fetched ahead in the background when the client is created with client.WithPrefetch.
 //
 // Example:
 //
@@ -221,73 +228,23 @@ func (c *Client) GetAccounts(ctx context.Context, opts *GetAccountsOptions) ([]*
 //	}
 func (c *Client) GetAccountsIter(ctx context.Context, opts *GetAccountsOptions) iter.Seq2[schemas.Account, error] {
 	return func(yield func(schemas.Account, error) bool) {
-		// Determine page size from opts or use default
-		pageSize := 20
-		if opts != nil && opts.PageSize > 0 {
-			pageSize = opts.PageSize
-		}
-
-		pageNum := 1
-
-		for {
-			// Check context
-			if err := ctx.Err(); err != nil {
-				yield(schemas.Account{}, err)
-				return
-			}
-
-			// Copy options and set page number
-			pageOpts := &GetAccountsOptions{}
-			if opts != nil {
-				*pageOpts = *opts
-			}
-			pageOpts.PageNumber = pageNum
-			pageOpts.PageSize = pageSize
-
-			// Fetch page using Raw method to get pagination metadata
-			resp, err := c.GetAccountsRaw(ctx, pageOpts)
-			if err != nil {
-				yield(schemas.Account{}, err)
-				return
-			}
-			defer resp.Body.Close()
-
-			// Decode response
-			var result struct {
-				Data []schemas.Account `json:"data"`
-				Meta struct {
-					Pagination *client.Pagination `json:"pagination"`
-				} `json:"meta"`
-				Included []map[string]interface{} `json:"included"`
-			}
-			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-				yield(schemas.Account{}, fmt.Errorf("failed to decode response: %w", err))
-				return
-			}
-
-			// Yield each item
-			for i := range result.Data {
-				// Populate included resources into relationships
-				if len(result.Included) > 0 {
-					result.Data[i].Relationships.PopulateIncludes(result.Included)
-				}
-				if !yield(result.Data[i], nil) {
-					return // Consumer requested early exit
-				}
-			}
+		it := c.GetAccountsPaged(ctx, opts)
+		defer it.Close() // Stops the prefetching on early exit
 
-			// Check if there are more pages
-			if result.Meta.Pagination == nil || result.Meta.Pagination.NextPage == nil {
-				break
+		for it.Next() {
+			if !yield(*it.Value(), nil) {
+				return // Consumer requested early exit
 			}
-
-			pageNum++
+		}
+		if err := it.Err(); err != nil {
+			yield(schemas.Account{}, err)
 		}
 	}
 }
 
 // GetAccountsPaged returns a stateful iterator with access to pagination metadata.
-// Use this when you need PageInfo(), Remaining(), or Collect() methods.
+// Use this when you need PageInfo(), Remaining(), or Collect() methods, or to resume
+// an interrupted iteration from the iterator Checkpoint().
 //
 // Example:
 //
@@ -300,6 +257,10 @@ func (c *Client) GetAccountsIter(ctx context.Context, opts *GetAccountsOptions)
 //	if err := iter.Err(); err != nil {
 //	    // Handle error
 //	}
+//
+// Resuming from a checkpoint saved by an iterator with the same options:
+//
+//	iter := client.Account.GetAccountsPaged(ctx, opts).Resume(checkpoint)
 func (c *Client) GetAccountsPaged(ctx context.Context, opts *GetAccountsOptions) *client.Iterator[schemas.Account] {
 	// Determine page size from opts or use default
 	pageSize := 20
@@ -330,26 +291,34 @@ func (c *Client) GetAccountsPaged(ctx context.Context, opts *GetAccountsOptions)
 			Meta struct {
 				Pagination *client.Pagination `json:"pagination"`
 			} `json:"meta"`
-			Included []map[string]interface{} `json:"included"`
+			Included []json.RawMessage `json:"included"`
 		}
 		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 			return nil, nil, fmt.Errorf("failed to decode response: %w", err)
 		}
 
-		// Convert to slice of pointers and populate includes
+		// Convert to slice of pointers and resolve includes
+		included := client.NewIncluded(result.Included)
 		items := make([]*schemas.Account, len(result.Data))
 		for i := range result.Data {
 			items[i] = &result.Data[i]
-			// Populate included resources into relationships
-			if len(result.Included) > 0 {
-				items[i].Relationships.PopulateIncludes(result.Included)
-			}
+			items[i].ResolveIncludes(included)
 		}
 
 		return items, result.Meta.Pagination, nil
 	}
 
-	return client.NewIterator[schemas.Account](ctx, pageSize, fetchPage)
+	// Checkpoints only resume the iterators of the same listing
+	listOpts := GetAccountsOptions{}
+	if opts != nil {
+		listOpts = *opts
+	}
+	listOpts.PageNumber, listOpts.PageSize = 0, 0
+	filters := client.HashOptions("GetAccounts", listOpts)
+
+	return client.NewIterator[schemas.Account](ctx, pageSize, fetchPage).
+		Prefetch(c.httpClient.Prefetch()).
+		FiltersHash(filters)
 }
 
 // GetAccountsOptions holds optional parameters for GetAccounts
@@ -361,10 +330,20 @@ type GetAccountsOptions struct {
 	// The comma-separated list of relationship paths.
 	Include []string
 	// The value of the fields[resource-type] parameter is a comma-separated list that refers to the name of the fields to be returned for the resource. An empty value indicates that no fields should be returned.
-	Fields map[string]interface{}
+	Fields client.Fields
 	Filter map[string]string
 }
 
+// Relationship paths accepted by the Include option of GetAccounts
+const (
+	GetAccountsIncludeBillingPlan             = "billing-plan"
+	GetAccountsIncludeIdentityProvider        = "identity-provider"
+	GetAccountsIncludeIdentityProviderAccount = "identity-provider.account"
+	GetAccountsIncludeOwner                   = "owner"
+	GetAccountsIncludeOwnerIdentityProviders  = "owner.identity-providers"
+	GetAccountsIncludeOwnerTeams              = "owner.teams"
+)
+
 func (c *Client) GetMetricsRaw(ctx context.Context, account string) (*client.Response, error) {
 	path := "/accounts/{account}/metrics"
 	path = strings.ReplaceAll(path, "{account}", url.PathEscape(account))
@@ -432,7 +411,7 @@ func (c *Client) ListSsoBypassUsers(ctx context.Context, account string, opts *L
 		Meta struct {
 			Pagination *client.Pagination `json:"pagination"`
 		} `json:"meta"`
-		Included []map[string]interface{} `json:"included"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
@@ -449,6 +428,7 @@ func (c *Client) ListSsoBypassUsers(ctx context.Context, account string, opts *L
 // This is the recommended and simple way to iterate through all the results.
 //
 // The iterator automatically fetches pages as needed and handles errors inline.
+// Pages are fetched ahead in the background when the client is created with client.WithPrefetch.
 //
 // Example:
 //
@@ -460,69 +440,23 @@ func (c *Client) ListSsoBypassUsers(ctx context.Context, account string, opts *L
 //	}
 func (c *Client) ListSsoBypassUsersIter(ctx context.Context, account string, opts *ListSsoBypassUsersOptions) iter.Seq2[schemas.User, error] {
 	return func(yield func(schemas.User, error) bool) {
-		// Determine page size from opts or use default
-		pageSize := 20
-		if opts != nil && opts.PageSize > 0 {
-			pageSize = opts.PageSize
-		}
-
-		pageNum := 1
-
-		for {
-			// Check context
-			if err := ctx.Err(); err != nil {
-				yield(schemas.User{}, err)
-				return
-			}
-
-			// Copy options and set page number
-			pageOpts := &ListSsoBypassUsersOptions{}
-			if opts != nil {
-				*pageOpts = *opts
-			}
-			pageOpts.PageNumber = pageNum
-			pageOpts.PageSize = pageSize
-
-			// Fetch page using Raw method to get pagination metadata
-			resp, err := c.ListSsoBypassUsersRaw(ctx, account, pageOpts)
-			if err != nil {
-				yield(schemas.User{}, err)
-				return
-			}
-			defer resp.Body.Close()
-
-			// Decode response
-			var result struct {
-				Data []schemas.User `json:"data"`
-				Meta struct {
-					Pagination *client.Pagination `json:"pagination"`
-				} `json:"meta"`
-				Included []map[string]interface{} `json:"included"`
-			}
-			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-				yield(schemas.User{}, fmt.Errorf("failed to decode response: %w", err))
-				return
-			}
-
-			// Yield each item
-			for i := range result.Data {
-				if !yield(result.Data[i], nil) {
-					return // Consumer requested early exit
-				}
-			}
+		it := c.ListSsoBypassUsersPaged(ctx, account, opts)
+		defer it.Close() // Stops the prefetching on early exit
 
-			// Check if there are more pages
-			if result.Meta.Pagination == nil || result.Meta.Pagination.NextPage == nil {
-				break
+		for it.Next() {
+			if !yield(*it.Value(), nil) {
+				return // Consumer requested early exit
 			}
-
-			pageNum++
+		}
+		if err := it.Err(); err != nil {
+			yield(schemas.User{}, err)
 		}
 	}
 }
 
 // ListSsoBypassUsersPaged returns a stateful iterator with access to pagination metadata.
-// Use this when you need PageInfo(), Remaining(), or Collect() methods.
+// Use this when you need PageInfo(), Remaining(), or Collect() methods, or to resume
+// an interrupted iteration from the iterator Checkpoint().
 //
 // Example:
 //
@@ -535,6 +469,10 @@ func (c *Client) ListSsoBypassUsersIter(ctx context.Context, account string, opt
 //	if err := iter.Err(); err != nil {
 //	    // Handle error
 //	}
+//
+// Resuming from a checkpoint saved by an iterator with the same options:
+//
+//	iter := client.Account.ListSsoBypassUsersPaged(ctx, account, opts).Resume(checkpoint)
 func (c *Client) ListSsoBypassUsersPaged(ctx context.Context, account string, opts *ListSsoBypassUsersOptions) *client.Iterator[schemas.User] {
 	// Determine page size from opts or use default
 	pageSize := 20
@@ -565,13 +503,13 @@ func (c *Client) ListSsoBypassUsersPaged(ctx context.Context, account string, op
 			Meta struct {
 				Pagination *client.Pagination `json:"pagination"`
 			} `json:"meta"`
-			Included []map[string]interface{} `json:"included"`
+			Included []json.RawMessage `json:"included"`
 		}
 		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 			return nil, nil, fmt.Errorf("failed to decode response: %w", err)
 		}
 
-		// Convert to slice of pointers and populate includes
+		// Convert to slice of pointers and resolve includes
 		items := make([]*schemas.User, len(result.Data))
 		for i := range result.Data {
 			items[i] = &result.Data[i]
@@ -580,7 +518,17 @@ func (c *Client) ListSsoBypassUsersPaged(ctx context.Context, account string, op
 		return items, result.Meta.Pagination, nil
 	}
 
-	return client.NewIterator[schemas.User](ctx, pageSize, fetchPage)
+	// Checkpoints only resume the iterators of the same listing
+	listOpts := ListSsoBypassUsersOptions{}
+	if opts != nil {
+		listOpts = *opts
+	}
+	listOpts.PageNumber, listOpts.PageSize = 0, 0
+	filters := client.HashOptions("ListSsoBypassUsers", account, listOpts)
+
+	return client.NewIterator[schemas.User](ctx, pageSize, fetchPage).
+		Prefetch(c.httpClient.Prefetch()).
+		FiltersHash(filters)
 }
 
 // ListSsoBypassUsersOptions holds optional parameters for ListSsoBypassUsers
@@ -645,16 +593,14 @@ func (c *Client) UpdateAccount(ctx context.Context, account string, req *schemas
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.Account          `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.Account   `json:"data"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
@@ -82,17 +82,15 @@ func (c *Client) GetAgent(ctx context.Context, agent string, opts *GetAgentOptio
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.Agent            `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.Agent     `json:"data"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -103,6 +101,17 @@ type GetAgentOptions struct {
 	Filter  map[string]string
 }
 
+// Relationship paths accepted by the Include option of GetAgent
+const (
+	GetAgentIncludePool                    = "pool"
+	GetAgentIncludePoolAccount             = "pool.account"
+	GetAgentIncludePoolAgents              = "pool.agents"
+	GetAgentIncludePoolDefaultEnvironments = "pool.default-environments"
+	GetAgentIncludePoolEnvironment         = "pool.environment"
+	GetAgentIncludePoolEnvironments        = "pool.environments"
+	GetAgentIncludePoolWorkspaces          = "pool.workspaces"
+)
+
 // The endpoint returns a list of agents by various filters.
 func (c *Client) GetAgentsRaw(ctx context.Context, opts *GetAgentsOptions) (*client.Response, error) {
 	path := "/agents"
@@ -150,19 +159,18 @@ func (c *Client) GetAgents(ctx context.Context, opts *GetAgentsOptions) ([]*sche
 		Meta struct {
 			Pagination *client.Pagination `json:"pagination"`
 		} `json:"meta"`
-		Included []map[string]interface{} `json:"included"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
+	// Index included resources once to resolve the relationships
+	included := client.NewIncluded(result.Included)
 	resources := make([]*schemas.Agent, len(result.Data))
 	for i := range result.Data {
 		resources[i] = &result.Data[i]
-		// Populate included resources into relationships
-		if len(result.Included) > 0 {
-			resources[i].Relationships.PopulateIncludes(result.Included)
-		}
+		resources[i].ResolveIncludes(included)
 	}
 	return resources, nil
 }
@@ -171,6 +179,7 @@ func (c *Client) GetAgents(ctx context.Context, opts *GetAgentsOptions) ([]*sche
 // This is the recommended and simple way to iterate through all the results.
 //
 // The iterator automatically fetches pages as needed and handles errors inline.
+// Pages are fetched ahead in the background when the client is created with client.WithPrefetch.
 //
 // Example:
 //
@@ -182,73 +191,23 @@ func (c *Client) GetAgents(ctx context.Context, opts *GetAgentsOptions) ([]*sche
 //	}
 func (c *Client) GetAgentsIter(ctx context.Context, opts *GetAgentsOptions) iter.Seq2[schemas.Agent, error] {
 	return func(yield func(schemas.Agent, error) bool) {
-		// Determine page size from opts or use default
-		pageSize := 20
-		if opts != nil && opts.PageSize > 0 {
-			pageSize = opts.PageSize
-		}
-
-		pageNum := 1
-
-		for {
-			// Check context
-			if err := ctx.Err(); err != nil {
-				yield(schemas.Agent{}, err)
-				return
-			}
-
-			// Copy options and set page number
-			pageOpts := &GetAgentsOptions{}
-			if opts != nil {
-				*pageOpts = *opts
-			}
-			pageOpts.PageNumber = pageNum
-			pageOpts.PageSize = pageSize
-
-			// Fetch page using Raw method to get pagination metadata
-			resp, err := c.GetAgentsRaw(ctx, pageOpts)
-			if err != nil {
-				yield(schemas.Agent{}, err)
-				return
-			}
-			defer resp.Body.Close()
-
-			// Decode response
-			var result struct {
-				Data []schemas.Agent `json:"data"`
-				Meta struct {
-					Pagination *client.Pagination `json:"pagination"`
-				} `json:"meta"`
-				Included []map[string]interface{} `json:"included"`
-			}
-			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-				yield(schemas.Agent{}, fmt.Errorf("failed to decode response: %w", err))
-				return
-			}
+		it := c.GetAgentsPaged(ctx, opts)
+		defer it.Close() // Stops the prefetching on early exit
 
-			// Yield each item
-			for i := range result.Data {
-				// Populate included resources into relationships
-				if len(result.Included) > 0 {
-					result.Data[i].Relationships.PopulateIncludes(result.Included)
-				}
-				if !yield(result.Data[i], nil) {
-					return // Consumer requested early exit
-				}
+		for it.Next() {
+			if !yield(*it.Value(), nil) {
+				return // Consumer requested early exit
 			}
-
-			// Check if there are more pages
-			if result.Meta.Pagination == nil || result.Meta.Pagination.NextPage == nil {
-				break
-			}
-
-			pageNum++
+		}
+		if err := it.Err(); err != nil {
+			yield(schemas.Agent{}, err)
 		}
 	}
 }
 
 // GetAgentsPaged returns a stateful iterator with access to pagination metadata.
-// Use this when you need PageInfo(), Remaining(), or Collect() methods.
+// Use this when you need PageInfo(), Remaining(), or Collect() methods, or to resume
+// an interrupted iteration from the iterator Checkpoint().
 //
 // Example:
 //
@@ -261,6 +220,10 @@ func (c *Client) GetAgentsIter(ctx context.Context, opts *GetAgentsOptions) iter
 //	if err := iter.Err(); err != nil {
 //	    // Handle error
 //	}
+//
+// Resuming from a checkpoint saved by an iterator with the same options:
+//
+//	iter := client.Agent.GetAgentsPaged(ctx, opts).Resume(checkpoint)
 func (c *Client) GetAgentsPaged(ctx context.Context, opts *GetAgentsOptions) *client.Iterator[schemas.Agent] {
 	// Determine page size from opts or use default
 	pageSize := 20
@@ -291,26 +254,34 @@ func (c *Client) GetAgentsPaged(ctx context.Context, opts *GetAgentsOptions) *cl
 			Meta struct {
 				Pagination *client.Pagination `json:"pagination"`
 			} `json:"meta"`
-			Included []map[string]interface{} `json:"included"`
+			Included []json.RawMessage `json:"included"`
 		}
 		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 			return nil, nil, fmt.Errorf("failed to decode response: %w", err)
 		}
 
-		// Convert to slice of pointers and populate includes
+		// Convert to slice of pointers and resolve includes
+		included := client.NewIncluded(result.Included)
 		items := make([]*schemas.Agent, len(result.Data))
 		for i := range result.Data {
 			items[i] = &result.Data[i]
-			// Populate included resources into relationships
-			if len(result.Included) > 0 {
-				items[i].Relationships.PopulateIncludes(result.Included)
-			}
+			items[i].ResolveIncludes(included)
 		}
 
 		return items, result.Meta.Pagination, nil
 	}
 
-	return client.NewIterator[schemas.Agent](ctx, pageSize, fetchPage)
+	// Checkpoints only resume the iterators of the same listing
+	listOpts := GetAgentsOptions{}
+	if opts != nil {
+		listOpts = *opts
+	}
+	listOpts.PageNumber, listOpts.PageSize = 0, 0
+	filters := client.HashOptions("GetAgents", listOpts)
+
+	return client.NewIterator[schemas.Agent](ctx, pageSize, fetchPage).
+		Prefetch(c.httpClient.Prefetch()).
+		FiltersHash(filters)
 }
 
 // GetAgentsOptions holds optional parameters for GetAgents
@@ -325,3 +296,14 @@ type GetAgentsOptions struct {
 	Sort   []string
 	Filter map[string]string
 }
+
+// Relationship paths accepted by the Include option of GetAgents
+const (
+	GetAgentsIncludePool                    = "pool"
+	GetAgentsIncludePoolAccount             = "pool.account"
+	GetAgentsIncludePoolAgents              = "pool.agents"
+	GetAgentsIncludePoolDefaultEnvironments = "pool.default-environments"
+	GetAgentsIncludePoolEnvironment         = "pool.environment"
+	GetAgentsIncludePoolEnvironments        = "pool.environments"
+	GetAgentsIncludePoolWorkspaces          = "pool.workspaces"
+)
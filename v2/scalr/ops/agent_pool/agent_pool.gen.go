@@ -60,17 +60,15 @@ func (c *Client) CreateAgentPool(ctx context.Context, req *schemas.AgentPoolRequ
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.AgentPool        `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.AgentPool `json:"data"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -81,6 +79,70 @@ type CreateAgentPoolOptions struct {
 	Filter  map[string]string
 }
 
+// Relationship paths accepted by the Include option of CreateAgentPool
+const (
+	CreateAgentPoolIncludeAccount                                          = "account"
+	CreateAgentPoolIncludeAccountBillingPlan                               = "account.billing-plan"
+	CreateAgentPoolIncludeAccountIdentityProvider                          = "account.identity-provider"
+	CreateAgentPoolIncludeAccountOwner                                     = "account.owner"
+	CreateAgentPoolIncludeAgents                                           = "agents"
+	CreateAgentPoolIncludeAgentsPool                                       = "agents.pool"
+	CreateAgentPoolIncludeDefaultEnvironments                              = "default-environments"
+	CreateAgentPoolIncludeDefaultEnvironmentsAccount                       = "default-environments.account"
+	CreateAgentPoolIncludeDefaultEnvironmentsCreatedBy                     = "default-environments.created-by"
+	CreateAgentPoolIncludeDefaultEnvironmentsDefaultProviderConfigurations = "default-environments.default-provider-configurations"
+	CreateAgentPoolIncludeDefaultEnvironmentsDefaultWorkspaceAgentPool     = "default-environments.default-workspace-agent-pool"
+	CreateAgentPoolIncludeDefaultEnvironmentsDriftDetectionSchedules       = "default-environments.drift-detection-schedules"
+	CreateAgentPoolIncludeDefaultEnvironmentsLockedBy                      = "default-environments.locked-by"
+	CreateAgentPoolIncludeDefaultEnvironmentsPolicyGroups                  = "default-environments.policy-groups"
+	CreateAgentPoolIncludeDefaultEnvironmentsProviderConfigurations        = "default-environments.provider-configurations"
+	CreateAgentPoolIncludeDefaultEnvironmentsStorageProfile                = "default-environments.storage-profile"
+	CreateAgentPoolIncludeDefaultEnvironmentsTags                          = "default-environments.tags"
+	CreateAgentPoolIncludeDefaultEnvironmentsUpdatedBy                     = "default-environments.updated-by"
+	CreateAgentPoolIncludeEnvironment                                      = "environment"
+	CreateAgentPoolIncludeEnvironmentAccount                               = "environment.account"
+	CreateAgentPoolIncludeEnvironmentCreatedBy                             = "environment.created-by"
+	CreateAgentPoolIncludeEnvironmentDefaultProviderConfigurations         = "environment.default-provider-configurations"
+	CreateAgentPoolIncludeEnvironmentDefaultWorkspaceAgentPool             = "environment.default-workspace-agent-pool"
+	CreateAgentPoolIncludeEnvironmentDriftDetectionSchedules               = "environment.drift-detection-schedules"
+	CreateAgentPoolIncludeEnvironmentLockedBy                              = "environment.locked-by"
+	CreateAgentPoolIncludeEnvironmentPolicyGroups                          = "environment.policy-groups"
+	CreateAgentPoolIncludeEnvironmentProviderConfigurations                = "environment.provider-configurations"
+	CreateAgentPoolIncludeEnvironmentStorageProfile                        = "environment.storage-profile"
+	CreateAgentPoolIncludeEnvironmentTags                                  = "environment.tags"
+	CreateAgentPoolIncludeEnvironmentUpdatedBy                             = "environment.updated-by"
+	CreateAgentPoolIncludeEnvironments                                     = "environments"
+	CreateAgentPoolIncludeEnvironmentsAccount                              = "environments.account"
+	CreateAgentPoolIncludeEnvironmentsCreatedBy                            = "environments.created-by"
+	CreateAgentPoolIncludeEnvironmentsDefaultProviderConfigurations        = "environments.default-provider-configurations"
+	CreateAgentPoolIncludeEnvironmentsDefaultWorkspaceAgentPool            = "environments.default-workspace-agent-pool"
+	CreateAgentPoolIncludeEnvironmentsDriftDetectionSchedules              = "environments.drift-detection-schedules"
+	CreateAgentPoolIncludeEnvironmentsLockedBy                             = "environments.locked-by"
+	CreateAgentPoolIncludeEnvironmentsPolicyGroups                         = "environments.policy-groups"
+	CreateAgentPoolIncludeEnvironmentsProviderConfigurations               = "environments.provider-configurations"
+	CreateAgentPoolIncludeEnvironmentsStorageProfile                       = "environments.storage-profile"
+	CreateAgentPoolIncludeEnvironmentsTags                                 = "environments.tags"
+	CreateAgentPoolIncludeEnvironmentsUpdatedBy                            = "environments.updated-by"
+	CreateAgentPoolIncludeWorkspaces                                       = "workspaces"
+	CreateAgentPoolIncludeWorkspacesAgentPool                              = "workspaces.agent-pool"
+	CreateAgentPoolIncludeWorkspacesConfigurationVersion                   = "workspaces.configuration-version"
+	CreateAgentPoolIncludeWorkspacesCreatedBy                              = "workspaces.created-by"
+	CreateAgentPoolIncludeWorkspacesCurrentRun                             = "workspaces.current-run"
+	CreateAgentPoolIncludeWorkspacesDriftReport                            = "workspaces.drift-report"
+	CreateAgentPoolIncludeWorkspacesEnvironment                            = "workspaces.environment"
+	CreateAgentPoolIncludeWorkspacesLatestConfigurationVersion             = "workspaces.latest-configuration-version"
+	CreateAgentPoolIncludeWorkspacesLatestRun                              = "workspaces.latest-run"
+	CreateAgentPoolIncludeWorkspacesLockedBy                               = "workspaces.locked-by"
+	CreateAgentPoolIncludeWorkspacesLockedByRun                            = "workspaces.locked-by-run"
+	CreateAgentPoolIncludeWorkspacesModule                                 = "workspaces.module"
+	CreateAgentPoolIncludeWorkspacesModuleVersion                          = "workspaces.module-version"
+	CreateAgentPoolIncludeWorkspacesReadmeId                               = "workspaces.readme-id"
+	CreateAgentPoolIncludeWorkspacesSshKey                                 = "workspaces.ssh-key"
+	CreateAgentPoolIncludeWorkspacesTags                                   = "workspaces.tags"
+	CreateAgentPoolIncludeWorkspacesUpdatedBy                              = "workspaces.updated-by"
+	CreateAgentPoolIncludeWorkspacesVcsProvider                            = "workspaces.vcs-provider"
+)
+
 // This endpoint deletes an [agent pool](/docs/agent-pools) by ID.
 func (c *Client) DeleteAgentPoolRaw(ctx context.Context, agentPool string) (*client.Response, error) {
 	path := "/agent-pools/{agent_pool}"
@@ -139,17 +201,15 @@ func (c *Client) GetAgentPool(ctx context.Context, agentPool string, opts *GetAg
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.AgentPool        `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.AgentPool `json:"data"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -160,6 +220,70 @@ type GetAgentPoolOptions struct {
 	Filter  map[string]string
 }
 
+// Relationship paths accepted by the Include option of GetAgentPool
+const (
+	GetAgentPoolIncludeAccount                                          = "account"
+	GetAgentPoolIncludeAccountBillingPlan                               = "account.billing-plan"
+	GetAgentPoolIncludeAccountIdentityProvider                          = "account.identity-provider"
+	GetAgentPoolIncludeAccountOwner                                     = "account.owner"
+	GetAgentPoolIncludeAgents                                           = "agents"
+	GetAgentPoolIncludeAgentsPool                                       = "agents.pool"
+	GetAgentPoolIncludeDefaultEnvironments                              = "default-environments"
+	GetAgentPoolIncludeDefaultEnvironmentsAccount                       = "default-environments.account"
+	GetAgentPoolIncludeDefaultEnvironmentsCreatedBy                     = "default-environments.created-by"
+	GetAgentPoolIncludeDefaultEnvironmentsDefaultProviderConfigurations = "default-environments.default-provider-configurations"
+	GetAgentPoolIncludeDefaultEnvironmentsDefaultWorkspaceAgentPool     = "default-environments.default-workspace-agent-pool"
+	GetAgentPoolIncludeDefaultEnvironmentsDriftDetectionSchedules       = "default-environments.drift-detection-schedules"
+	GetAgentPoolIncludeDefaultEnvironmentsLockedBy                      = "default-environments.locked-by"
+	GetAgentPoolIncludeDefaultEnvironmentsPolicyGroups                  = "default-environments.policy-groups"
+	GetAgentPoolIncludeDefaultEnvironmentsProviderConfigurations        = "default-environments.provider-configurations"
+	GetAgentPoolIncludeDefaultEnvironmentsStorageProfile                = "default-environments.storage-profile"
+	GetAgentPoolIncludeDefaultEnvironmentsTags                          = "default-environments.tags"
+	GetAgentPoolIncludeDefaultEnvironmentsUpdatedBy                     = "default-environments.updated-by"
+	GetAgentPoolIncludeEnvironment                                      = "environment"
+	GetAgentPoolIncludeEnvironmentAccount                               = "environment.account"
+	GetAgentPoolIncludeEnvironmentCreatedBy                             = "environment.created-by"
+	GetAgentPoolIncludeEnvironmentDefaultProviderConfigurations         = "environment.default-provider-configurations"
+	GetAgentPoolIncludeEnvironmentDefaultWorkspaceAgentPool             = "environment.default-workspace-agent-pool"
+	GetAgentPoolIncludeEnvironmentDriftDetectionSchedules               = "environment.drift-detection-schedules"
+	GetAgentPoolIncludeEnvironmentLockedBy                              = "environment.locked-by"
+	GetAgentPoolIncludeEnvironmentPolicyGroups                          = "environment.policy-groups"
+	GetAgentPoolIncludeEnvironmentProviderConfigurations                = "environment.provider-configurations"
+	GetAgentPoolIncludeEnvironmentStorageProfile                        = "environment.storage-profile"
+	GetAgentPoolIncludeEnvironmentTags                                  = "environment.tags"
+	GetAgentPoolIncludeEnvironmentUpdatedBy                             = "environment.updated-by"
+	GetAgentPoolIncludeEnvironments                                     = "environments"
+	GetAgentPoolIncludeEnvironmentsAccount                              = "environments.account"
+	GetAgentPoolIncludeEnvironmentsCreatedBy                            = "environments.created-by"
+	GetAgentPoolIncludeEnvironmentsDefaultProviderConfigurations        = "environments.default-provider-configurations"
+	GetAgentPoolIncludeEnvironmentsDefaultWorkspaceAgentPool            = "environments.default-workspace-agent-pool"
+	GetAgentPoolIncludeEnvironmentsDriftDetectionSchedules              = "environments.drift-detection-schedules"
+	GetAgentPoolIncludeEnvironmentsLockedBy                             = "environments.locked-by"
+	GetAgentPoolIncludeEnvironmentsPolicyGroups                         = "environments.policy-groups"
+	GetAgentPoolIncludeEnvironmentsProviderConfigurations               = "environments.provider-configurations"
+	GetAgentPoolIncludeEnvironmentsStorageProfile                       = "environments.storage-profile"
+	GetAgentPoolIncludeEnvironmentsTags                                 = "environments.tags"
+	GetAgentPoolIncludeEnvironmentsUpdatedBy                            = "environments.updated-by"
+	GetAgentPoolIncludeWorkspaces                                       = "workspaces"
+	GetAgentPoolIncludeWorkspacesAgentPool                              = "workspaces.agent-pool"
+	GetAgentPoolIncludeWorkspacesConfigurationVersion                   = "workspaces.configuration-version"
+	GetAgentPoolIncludeWorkspacesCreatedBy                              = "workspaces.created-by"
+	GetAgentPoolIncludeWorkspacesCurrentRun                             = "workspaces.current-run"
+	GetAgentPoolIncludeWorkspacesDriftReport                            = "workspaces.drift-report"
+	GetAgentPoolIncludeWorkspacesEnvironment                            = "workspaces.environment"
+	GetAgentPoolIncludeWorkspacesLatestConfigurationVersion             = "workspaces.latest-configuration-version"
+	GetAgentPoolIncludeWorkspacesLatestRun                              = "workspaces.latest-run"
+	GetAgentPoolIncludeWorkspacesLockedBy                               = "workspaces.locked-by"
+	GetAgentPoolIncludeWorkspacesLockedByRun                            = "workspaces.locked-by-run"
+	GetAgentPoolIncludeWorkspacesModule                                 = "workspaces.module"
+	GetAgentPoolIncludeWorkspacesModuleVersion                          = "workspaces.module-version"
+	GetAgentPoolIncludeWorkspacesReadmeId                               = "workspaces.readme-id"
+	GetAgentPoolIncludeWorkspacesSshKey                                 = "workspaces.ssh-key"
+	GetAgentPoolIncludeWorkspacesTags                                   = "workspaces.tags"
+	GetAgentPoolIncludeWorkspacesUpdatedBy                              = "workspaces.updated-by"
+	GetAgentPoolIncludeWorkspacesVcsProvider                            = "workspaces.vcs-provider"
+)
+
 // This endpoint returns a list of [agent pools](/docs/agent-pools) by various filters.
 func (c *Client) GetAgentPoolsRaw(ctx context.Context, opts *GetAgentPoolsOptions) (*client.Response, error) {
 	path := "/agent-pools"
@@ -211,19 +335,18 @@ func (c *Client) GetAgentPools(ctx context.Context, opts *GetAgentPoolsOptions)
 		Meta struct {
 			Pagination *client.Pagination `json:"pagination"`
 		} `json:"meta"`
-		Included []map[string]interface{} `json:"included"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
+	// Index included resources once to resolve the relationships
+	included := client.NewIncluded(result.Included)
 	resources := make([]*schemas.AgentPool, len(result.Data))
 	for i := range result.Data {
 		resources[i] = &result.Data[i]
-		// Populate included resources into relationships
-		if len(result.Included) > 0 {
-			resources[i].Relationships.PopulateIncludes(result.Included)
-		}
+		resources[i].ResolveIncludes(included)
 	}
 	return resources, nil
 }
@@ -232,6 +355,7 @@ func (c *Client) GetAgentPools(ctx context.Context, opts *GetAgentPoolsOptions)
 // This is the recommended and simple way to iterate through all the results.
 //
 // The iterator automatically fetches pages as needed and handles errors inline.
+// Pages are fetched ahead in the background when the client is created with client.WithPrefetch.
 //
 // Example:
 //
@@ -243,73 +367,23 @@ func (c *Client) GetAgentPools(ctx context.Context, opts *GetAgentPoolsOptions)
 //	}
 func (c *Client) GetAgentPoolsIter(ctx context.Context, opts *GetAgentPoolsOptions) iter.Seq2[schemas.AgentPool, error] {
 	return func(yield func(schemas.AgentPool, error) bool) {
-		// Determine page size from opts or use default
-		pageSize := 20
-		if opts != nil && opts.PageSize > 0 {
-			pageSize = opts.PageSize
-		}
-
-		pageNum := 1
-
-		for {
-			// Check context
-			if err := ctx.Err(); err != nil {
-				yield(schemas.AgentPool{}, err)
-				return
-			}
-
-			// Copy options and set page number
-			pageOpts := &GetAgentPoolsOptions{}
-			if opts != nil {
-				*pageOpts = *opts
-			}
-			pageOpts.PageNumber = pageNum
-			pageOpts.PageSize = pageSize
-
-			// Fetch page using Raw method to get pagination metadata
-			resp, err := c.GetAgentPoolsRaw(ctx, pageOpts)
-			if err != nil {
-				yield(schemas.AgentPool{}, err)
-				return
-			}
-			defer resp.Body.Close()
-
-			// Decode response
-			var result struct {
-				Data []schemas.AgentPool `json:"data"`
-				Meta struct {
-					Pagination *client.Pagination `json:"pagination"`
-				} `json:"meta"`
-				Included []map[string]interface{} `json:"included"`
-			}
-			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-				yield(schemas.AgentPool{}, fmt.Errorf("failed to decode response: %w", err))
-				return
-			}
+		it := c.GetAgentPoolsPaged(ctx, opts)
+		defer it.Close() // Stops the prefetching on early exit
 
-			// Yield each item
-			for i := range result.Data {
-				// Populate included resources into relationships
-				if len(result.Included) > 0 {
-					result.Data[i].Relationships.PopulateIncludes(result.Included)
-				}
-				if !yield(result.Data[i], nil) {
-					return // Consumer requested early exit
-				}
+		for it.Next() {
+			if !yield(*it.Value(), nil) {
+				return // Consumer requested early exit
 			}
-
-			// Check if there are more pages
-			if result.Meta.Pagination == nil || result.Meta.Pagination.NextPage == nil {
-				break
-			}
-
-			pageNum++
+		}
+		if err := it.Err(); err != nil {
+			yield(schemas.AgentPool{}, err)
 		}
 	}
 }
 
 // GetAgentPoolsPaged returns a stateful iterator with access to pagination metadata.
-// Use this when you need PageInfo(), Remaining(), or Collect() methods.
+// Use this when you need PageInfo(), Remaining(), or Collect() methods, or to resume
+// an interrupted iteration from the iterator Checkpoint().
 //
 // Example:
 //
@@ -322,6 +396,10 @@ func (c *Client) GetAgentPoolsIter(ctx context.Context, opts *GetAgentPoolsOptio
 //	if err := iter.Err(); err != nil {
 //	    // Handle error
 //	}
+//
+// Resuming from a checkpoint saved by an iterator with the same options:
+//
+//	iter := client.AgentPool.GetAgentPoolsPaged(ctx, opts).Resume(checkpoint)
 func (c *Client) GetAgentPoolsPaged(ctx context.Context, opts *GetAgentPoolsOptions) *client.Iterator[schemas.AgentPool] {
 	// Determine page size from opts or use default
 	pageSize := 20
@@ -352,26 +430,34 @@ func (c *Client) GetAgentPoolsPaged(ctx context.Context, opts *GetAgentPoolsOpti
 			Meta struct {
 				Pagination *client.Pagination `json:"pagination"`
 			} `json:"meta"`
-			Included []map[string]interface{} `json:"included"`
+			Included []json.RawMessage `json:"included"`
 		}
 		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 			return nil, nil, fmt.Errorf("failed to decode response: %w", err)
 		}
 
-		// Convert to slice of pointers and populate includes
+		// Convert to slice of pointers and resolve includes
+		included := client.NewIncluded(result.Included)
 		items := make([]*schemas.AgentPool, len(result.Data))
 		for i := range result.Data {
 			items[i] = &result.Data[i]
-			// Populate included resources into relationships
-			if len(result.Included) > 0 {
-				items[i].Relationships.PopulateIncludes(result.Included)
-			}
+			items[i].ResolveIncludes(included)
 		}
 
 		return items, result.Meta.Pagination, nil
 	}
 
-	return client.NewIterator[schemas.AgentPool](ctx, pageSize, fetchPage)
+	// Checkpoints only resume the iterators of the same listing
+	listOpts := GetAgentPoolsOptions{}
+	if opts != nil {
+		listOpts = *opts
+	}
+	listOpts.PageNumber, listOpts.PageSize = 0, 0
+	filters := client.HashOptions("GetAgentPools", listOpts)
+
+	return client.NewIterator[schemas.AgentPool](ctx, pageSize, fetchPage).
+		Prefetch(c.httpClient.Prefetch()).
+		FiltersHash(filters)
 }
 
 // GetAgentPoolsOptions holds optional parameters for GetAgentPools
@@ -389,6 +475,70 @@ type GetAgentPoolsOptions struct {
 	Filter map[string]string
 }
 
+// Relationship paths accepted by the Include option of GetAgentPools
+const (
+	GetAgentPoolsIncludeAccount                                          = "account"
+	GetAgentPoolsIncludeAccountBillingPlan                               = "account.billing-plan"
+	GetAgentPoolsIncludeAccountIdentityProvider                          = "account.identity-provider"
+	GetAgentPoolsIncludeAccountOwner                                     = "account.owner"
+	GetAgentPoolsIncludeAgents                                           = "agents"
+	GetAgentPoolsIncludeAgentsPool                                       = "agents.pool"
+	GetAgentPoolsIncludeDefaultEnvironments                              = "default-environments"
+	GetAgentPoolsIncludeDefaultEnvironmentsAccount                       = "default-environments.account"
+	GetAgentPoolsIncludeDefaultEnvironmentsCreatedBy                     = "default-environments.created-by"
+	GetAgentPoolsIncludeDefaultEnvironmentsDefaultProviderConfigurations = "default-environments.default-provider-configurations"
+	GetAgentPoolsIncludeDefaultEnvironmentsDefaultWorkspaceAgentPool     = "default-environments.default-workspace-agent-pool"
+	GetAgentPoolsIncludeDefaultEnvironmentsDriftDetectionSchedules       = "default-environments.drift-detection-schedules"
+	GetAgentPoolsIncludeDefaultEnvironmentsLockedBy                      = "default-environments.locked-by"
+	GetAgentPoolsIncludeDefaultEnvironmentsPolicyGroups                  = "default-environments.policy-groups"
+	GetAgentPoolsIncludeDefaultEnvironmentsProviderConfigurations        = "default-environments.provider-configurations"
+	GetAgentPoolsIncludeDefaultEnvironmentsStorageProfile                = "default-environments.storage-profile"
+	GetAgentPoolsIncludeDefaultEnvironmentsTags                          = "default-environments.tags"
+	GetAgentPoolsIncludeDefaultEnvironmentsUpdatedBy                     = "default-environments.updated-by"
+	GetAgentPoolsIncludeEnvironment                                      = "environment"
+	GetAgentPoolsIncludeEnvironmentAccount                               = "environment.account"
+	GetAgentPoolsIncludeEnvironmentCreatedBy                             = "environment.created-by"
+	GetAgentPoolsIncludeEnvironmentDefaultProviderConfigurations         = "environment.default-provider-configurations"
+	GetAgentPoolsIncludeEnvironmentDefaultWorkspaceAgentPool             = "environment.default-workspace-agent-pool"
+	GetAgentPoolsIncludeEnvironmentDriftDetectionSchedules               = "environment.drift-detection-schedules"
+	GetAgentPoolsIncludeEnvironmentLockedBy                              = "environment.locked-by"
+	GetAgentPoolsIncludeEnvironmentPolicyGroups                          = "environment.policy-groups"
+	GetAgentPoolsIncludeEnvironmentProviderConfigurations                = "environment.provider-configurations"
+	GetAgentPoolsIncludeEnvironmentStorageProfile                        = "environment.storage-profile"
+	GetAgentPoolsIncludeEnvironmentTags                                  = "environment.tags"
+	GetAgentPoolsIncludeEnvironmentUpdatedBy                             = "environment.updated-by"
+	GetAgentPoolsIncludeEnvironments                                     = "environments"
+	GetAgentPoolsIncludeEnvironmentsAccount                              = "environments.account"
+	GetAgentPoolsIncludeEnvironmentsCreatedBy                            = "environments.created-by"
+	GetAgentPoolsIncludeEnvironmentsDefaultProviderConfigurations        = "environments.default-provider-configurations"
+	GetAgentPoolsIncludeEnvironmentsDefaultWorkspaceAgentPool            = "environments.default-workspace-agent-pool"
+	GetAgentPoolsIncludeEnvironmentsDriftDetectionSchedules              = "environments.drift-detection-schedules"
+	GetAgentPoolsIncludeEnvironmentsLockedBy                             = "environments.locked-by"
+	GetAgentPoolsIncludeEnvironmentsPolicyGroups                         = "environments.policy-groups"
+	GetAgentPoolsIncludeEnvironmentsProviderConfigurations               = "environments.provider-configurations"
+	GetAgentPoolsIncludeEnvironmentsStorageProfile                       = "environments.storage-profile"
+	GetAgentPoolsIncludeEnvironmentsTags                                 = "environments.tags"
+	GetAgentPoolsIncludeEnvironmentsUpdatedBy                            = "environments.updated-by"
+	GetAgentPoolsIncludeWorkspaces                                       = "workspaces"
+	GetAgentPoolsIncludeWorkspacesAgentPool                              = "workspaces.agent-pool"
+	GetAgentPoolsIncludeWorkspacesConfigurationVersion                   = "workspaces.configuration-version"
+	GetAgentPoolsIncludeWorkspacesCreatedBy                              = "workspaces.created-by"
+	GetAgentPoolsIncludeWorkspacesCurrentRun                             = "workspaces.current-run"
+	GetAgentPoolsIncludeWorkspacesDriftReport                            = "workspaces.drift-report"
+	GetAgentPoolsIncludeWorkspacesEnvironment                            = "workspaces.environment"
+	GetAgentPoolsIncludeWorkspacesLatestConfigurationVersion             = "workspaces.latest-configuration-version"
+	GetAgentPoolsIncludeWorkspacesLatestRun                              = "workspaces.latest-run"
+	GetAgentPoolsIncludeWorkspacesLockedBy                               = "workspaces.locked-by"
+	GetAgentPoolsIncludeWorkspacesLockedByRun                            = "workspaces.locked-by-run"
+	GetAgentPoolsIncludeWorkspacesModule                                 = "workspaces.module"
+	GetAgentPoolsIncludeWorkspacesModuleVersion                          = "workspaces.module-version"
+	GetAgentPoolsIncludeWorkspacesReadmeId                               = "workspaces.readme-id"
+	GetAgentPoolsIncludeWorkspacesSshKey                                 = "workspaces.ssh-key"
+	GetAgentPoolsIncludeWorkspacesTags                                   = "workspaces.tags"
+	GetAgentPoolsIncludeWorkspacesUpdatedBy                              = "workspaces.updated-by"
+	GetAgentPoolsIncludeWorkspacesVcsProvider                            = "workspaces.vcs-provider"
+)
+
 // This endpoint updates an [agent pool](/docs/agent-pools) by ID.
 func (c *Client) UpdateAgentPoolRaw(ctx context.Context, agentPool string, req *schemas.AgentPoolRequest, opts *UpdateAgentPoolOptions) (*client.Response, error) {
 	path := "/agent-pools/{agent_pool}"
@@ -426,17 +576,15 @@ func (c *Client) UpdateAgentPool(ctx context.Context, agentPool string, req *sch
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.AgentPool        `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.AgentPool `json:"data"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -446,3 +594,67 @@ type UpdateAgentPoolOptions struct {
 	Include []string
 	Filter  map[string]string
 }
+
+// Relationship paths accepted by the Include option of UpdateAgentPool
+const (
+	UpdateAgentPoolIncludeAccount                                          = "account"
+	UpdateAgentPoolIncludeAccountBillingPlan                               = "account.billing-plan"
+	UpdateAgentPoolIncludeAccountIdentityProvider                          = "account.identity-provider"
+	UpdateAgentPoolIncludeAccountOwner                                     = "account.owner"
+	UpdateAgentPoolIncludeAgents                                           = "agents"
+	UpdateAgentPoolIncludeAgentsPool                                       = "agents.pool"
+	UpdateAgentPoolIncludeDefaultEnvironments                              = "default-environments"
+	UpdateAgentPoolIncludeDefaultEnvironmentsAccount                       = "default-environments.account"
+	UpdateAgentPoolIncludeDefaultEnvironmentsCreatedBy                     = "default-environments.created-by"
+	UpdateAgentPoolIncludeDefaultEnvironmentsDefaultProviderConfigurations = "default-environments.default-provider-configurations"
+	UpdateAgentPoolIncludeDefaultEnvironmentsDefaultWorkspaceAgentPool     = "default-environments.default-workspace-agent-pool"
+	UpdateAgentPoolIncludeDefaultEnvironmentsDriftDetectionSchedules       = "default-environments.drift-detection-schedules"
+	UpdateAgentPoolIncludeDefaultEnvironmentsLockedBy                      = "default-environments.locked-by"
+	UpdateAgentPoolIncludeDefaultEnvironmentsPolicyGroups                  = "default-environments.policy-groups"
+	UpdateAgentPoolIncludeDefaultEnvironmentsProviderConfigurations        = "default-environments.provider-configurations"
+	UpdateAgentPoolIncludeDefaultEnvironmentsStorageProfile                = "default-environments.storage-profile"
+	UpdateAgentPoolIncludeDefaultEnvironmentsTags                          = "default-environments.tags"
+	UpdateAgentPoolIncludeDefaultEnvironmentsUpdatedBy                     = "default-environments.updated-by"
+	UpdateAgentPoolIncludeEnvironment                                      = "environment"
+	UpdateAgentPoolIncludeEnvironmentAccount                               = "environment.account"
+	UpdateAgentPoolIncludeEnvironmentCreatedBy                             = "environment.created-by"
+	UpdateAgentPoolIncludeEnvironmentDefaultProviderConfigurations         = "environment.default-provider-configurations"
+	UpdateAgentPoolIncludeEnvironmentDefaultWorkspaceAgentPool             = "environment.default-workspace-agent-pool"
+	UpdateAgentPoolIncludeEnvironmentDriftDetectionSchedules               = "environment.drift-detection-schedules"
+	UpdateAgentPoolIncludeEnvironmentLockedBy                              = "environment.locked-by"
+	UpdateAgentPoolIncludeEnvironmentPolicyGroups                          = "environment.policy-groups"
+	UpdateAgentPoolIncludeEnvironmentProviderConfigurations                = "environment.provider-configurations"
+	UpdateAgentPoolIncludeEnvironmentStorageProfile                        = "environment.storage-profile"
+	UpdateAgentPoolIncludeEnvironmentTags                                  = "environment.tags"
+	UpdateAgentPoolIncludeEnvironmentUpdatedBy                             = "environment.updated-by"
+	UpdateAgentPoolIncludeEnvironments                                     = "environments"
+	UpdateAgentPoolIncludeEnvironmentsAccount                              = "environments.account"
+	UpdateAgentPoolIncludeEnvironmentsCreatedBy                            = "environments.created-by"
+	UpdateAgentPoolIncludeEnvironmentsDefaultProviderConfigurations        = "environments.default-provider-configurations"
+	UpdateAgentPoolIncludeEnvironmentsDefaultWorkspaceAgentPool            = "environments.default-workspace-agent-pool"
+	UpdateAgentPoolIncludeEnvironmentsDriftDetectionSchedules              = "environments.drift-detection-schedules"
+	UpdateAgentPoolIncludeEnvironmentsLockedBy                             = "environments.locked-by"
+	UpdateAgentPoolIncludeEnvironmentsPolicyGroups                         = "environments.policy-groups"
+	UpdateAgentPoolIncludeEnvironmentsProviderConfigurations               = "environments.provider-configurations"
+	UpdateAgentPoolIncludeEnvironmentsStorageProfile                       = "environments.storage-profile"
+	UpdateAgentPoolIncludeEnvironmentsTags                                 = "environments.tags"
+	UpdateAgentPoolIncludeEnvironmentsUpdatedBy                            = "environments.updated-by"
+	UpdateAgentPoolIncludeWorkspaces                                       = "workspaces"
+	UpdateAgentPoolIncludeWorkspacesAgentPool                              = "workspaces.agent-pool"
+	UpdateAgentPoolIncludeWorkspacesConfigurationVersion                   = "workspaces.configuration-version"
+	UpdateAgentPoolIncludeWorkspacesCreatedBy                              = "workspaces.created-by"
+	UpdateAgentPoolIncludeWorkspacesCurrentRun                             = "workspaces.current-run"
+	UpdateAgentPoolIncludeWorkspacesDriftReport                            = "workspaces.drift-report"
+	UpdateAgentPoolIncludeWorkspacesEnvironment                            = "workspaces.environment"
+	UpdateAgentPoolIncludeWorkspacesLatestConfigurationVersion             = "workspaces.latest-configuration-version"
+	UpdateAgentPoolIncludeWorkspacesLatestRun                              = "workspaces.latest-run"
+	UpdateAgentPoolIncludeWorkspacesLockedBy                               = "workspaces.locked-by"
+	UpdateAgentPoolIncludeWorkspacesLockedByRun                            = "workspaces.locked-by-run"
+	UpdateAgentPoolIncludeWorkspacesModule                                 = "workspaces.module"
+	UpdateAgentPoolIncludeWorkspacesModuleVersion                          = "workspaces.module-version"
+	UpdateAgentPoolIncludeWorkspacesReadmeId                               = "workspaces.readme-id"
+	UpdateAgentPoolIncludeWorkspacesSshKey                                 = "workspaces.ssh-key"
+	UpdateAgentPoolIncludeWorkspacesTags                                   = "workspaces.tags"
+	UpdateAgentPoolIncludeWorkspacesUpdatedBy                              = "workspaces.updated-by"
+	UpdateAgentPoolIncludeWorkspacesVcsProvider                            = "workspaces.vcs-provider"
+)
@@ -34,8 +34,7 @@ func (c *Client) GetAiUsageRaw(ctx context.Context, aiUsage string, opts *GetAiU
 		if len(opts.Include) > 0 {
 			params.Set("include", strings.Join(opts.Include, ","))
 		}
-		// Handle parameter: Fields (map[string]interface{})
-		// Complex type map[string]interface{} - skip for now
+		opts.Fields.Encode(params)
 		// Add filters
 		for k, v := range opts.Filter {
 			params.Set("filter["+k+"]", v)
@@ -61,17 +60,15 @@ func (c *Client) GetAiUsage(ctx context.Context, aiUsage string, opts *GetAiUsag
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.AiUsage          `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.AiUsage   `json:"data"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -80,10 +77,32 @@ type GetAiUsageOptions struct {
 	// The comma-separated list of relationship paths.
 	Include []string
 	// The value of the fields[resource-type] parameter is a comma-separated list that refers to the name of the fields to be returned for the resource. An empty value indicates that no fields should be returned.
-	Fields map[string]interface{}
+	Fields client.Fields
 	Filter map[string]string
 }
 
+// Relationship paths accepted by the Include option of GetAiUsage
+const (
+	GetAiUsageIncludeAccount                 = "account"
+	GetAiUsageIncludeAccountBillingPlan      = "account.billing-plan"
+	GetAiUsageIncludeAccountIdentityProvider = "account.identity-provider"
+	GetAiUsageIncludeAccountOwner            = "account.owner"
+	GetAiUsageIncludeRun                     = "run"
+	GetAiUsageIncludeRunApply                = "run.apply"
+	GetAiUsageIncludeRunConfigurationVersion = "run.configuration-version"
+	GetAiUsageIncludeRunCostEstimate         = "run.cost-estimate"
+	GetAiUsageIncludeRunCreatedBy            = "run.created-by"
+	GetAiUsageIncludeRunCreatedByRun         = "run.created-by-run"
+	GetAiUsageIncludeRunEnvironment          = "run.environment"
+	GetAiUsageIncludeRunPlan                 = "run.plan"
+	GetAiUsageIncludeRunPolicyChecks         = "run.policy-checks"
+	GetAiUsageIncludeRunStateVersions        = "run.state-versions"
+	GetAiUsageIncludeRunStatusTransitions    = "run.status-transitions"
+	GetAiUsageIncludeRunTags                 = "run.tags"
+	GetAiUsageIncludeRunVcsRevision          = "run.vcs-revision"
+	GetAiUsageIncludeRunWorkspace            = "run.workspace"
+)
+
 // This endpoint returns a list of AI usage for the account.
 func (c *Client) ListAiUsageRaw(ctx context.Context, opts *ListAiUsageOptions) (*client.Response, error) {
 	path := "/reports/ai-usage"
@@ -103,8 +122,7 @@ func (c *Client) ListAiUsageRaw(ctx context.Context, opts *ListAiUsageOptions) (
 		if len(opts.Sort) > 0 {
 			params.Set("sort", strings.Join(opts.Sort, ","))
 		}
-		// Handle parameter: Fields (map[string]interface{})
-		// Complex type map[string]interface{} - skip for now
+		opts.Fields.Encode(params)
 		if len(opts.Include) > 0 {
 			params.Set("include", strings.Join(opts.Include, ","))
 		}
@@ -137,19 +155,18 @@ func (c *Client) ListAiUsage(ctx context.Context, opts *ListAiUsageOptions) ([]*
 		Meta struct {
 			Pagination *client.Pagination `json:"pagination"`
 		} `json:"meta"`
-		Included []map[string]interface{} `json:"included"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
+	// Index included resources once to resolve the relationships
+	included := client.NewIncluded(result.Included)
 	resources := make([]*schemas.AiUsage, len(result.Data))
 	for i := range result.Data {
 		resources[i] = &result.Data[i]
-		// Populate included resources into relationships
-		if len(result.Included) > 0 {
-			resources[i].Relationships.PopulateIncludes(result.Included)
-		}
+		resources[i].ResolveIncludes(included)
 	}
 	return resources, nil
 }
@@ -158,6 +175,7 @@ func (c *Client) ListAiUsage(ctx context.Context, opts *ListAiUsageOptions) ([]*
 // This is the recommended and simple way to iterate through all the results.
 //
 // The iterator automatically fetches pages as needed and handles errors inline.
+// Pages are fetched ahead in the background when the client is created with client.WithPrefetch.
 //
 // Example:
 //
@@ -169,73 +187,23 @@ func (c *Client) ListAiUsage(ctx context.Context, opts *ListAiUsageOptions) ([]*
 //	}
 func (c *Client) ListAiUsageIter(ctx context.Context, opts *ListAiUsageOptions) iter.Seq2[schemas.AiUsage, error] {
 	return func(yield func(schemas.AiUsage, error) bool) {
-		// Determine page size from opts or use default
-		pageSize := 20
-		if opts != nil && opts.PageSize > 0 {
-			pageSize = opts.PageSize
-		}
-
-		pageNum := 1
-
-		for {
-			// Check context
-			if err := ctx.Err(); err != nil {
-				yield(schemas.AiUsage{}, err)
-				return
-			}
-
-			// Copy options and set page number
-			pageOpts := &ListAiUsageOptions{}
-			if opts != nil {
-				*pageOpts = *opts
-			}
-			pageOpts.PageNumber = pageNum
-			pageOpts.PageSize = pageSize
+		it := c.ListAiUsagePaged(ctx, opts)
+		defer it.Close() // Stops the prefetching on early exit
 
-			// Fetch page using Raw method to get pagination metadata
-			resp, err := c.ListAiUsageRaw(ctx, pageOpts)
-			if err != nil {
-				yield(schemas.AiUsage{}, err)
-				return
+		for it.Next() {
+			if !yield(*it.Value(), nil) {
+				return // Consumer requested early exit
 			}
-			defer resp.Body.Close()
-
-			// Decode response
-			var result struct {
-				Data []schemas.AiUsage `json:"data"`
-				Meta struct {
-					Pagination *client.Pagination `json:"pagination"`
-				} `json:"meta"`
-				Included []map[string]interface{} `json:"included"`
-			}
-			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-				yield(schemas.AiUsage{}, fmt.Errorf("failed to decode response: %w", err))
-				return
-			}
-
-			// Yield each item
-			for i := range result.Data {
-				// Populate included resources into relationships
-				if len(result.Included) > 0 {
-					result.Data[i].Relationships.PopulateIncludes(result.Included)
-				}
-				if !yield(result.Data[i], nil) {
-					return // Consumer requested early exit
-				}
-			}
-
-			// Check if there are more pages
-			if result.Meta.Pagination == nil || result.Meta.Pagination.NextPage == nil {
-				break
-			}
-
-			pageNum++
+		}
+		if err := it.Err(); err != nil {
+			yield(schemas.AiUsage{}, err)
 		}
 	}
 }
 
 // ListAiUsagePaged returns a stateful iterator with access to pagination metadata.
-// Use this when you need PageInfo(), Remaining(), or Collect() methods.
+// Use this when you need PageInfo(), Remaining(), or Collect() methods, or to resume
+// an interrupted iteration from the iterator Checkpoint().
 //
 // Example:
 //
@@ -248,6 +216,10 @@ func (c *Client) ListAiUsageIter(ctx context.Context, opts *ListAiUsageOptions)
 //	if err := iter.Err(); err != nil {
 //	    // Handle error
 //	}
+//
+// Resuming from a checkpoint saved by an iterator with the same options:
+//
+//	iter := client.AiUsage.ListAiUsagePaged(ctx, opts).Resume(checkpoint)
 func (c *Client) ListAiUsagePaged(ctx context.Context, opts *ListAiUsageOptions) *client.Iterator[schemas.AiUsage] {
 	// Determine page size from opts or use default
 	pageSize := 20
@@ -278,26 +250,34 @@ func (c *Client) ListAiUsagePaged(ctx context.Context, opts *ListAiUsageOptions)
 			Meta struct {
 				Pagination *client.Pagination `json:"pagination"`
 			} `json:"meta"`
-			Included []map[string]interface{} `json:"included"`
+			Included []json.RawMessage `json:"included"`
 		}
 		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 			return nil, nil, fmt.Errorf("failed to decode response: %w", err)
 		}
 
-		// Convert to slice of pointers and populate includes
+		// Convert to slice of pointers and resolve includes
+		included := client.NewIncluded(result.Included)
 		items := make([]*schemas.AiUsage, len(result.Data))
 		for i := range result.Data {
 			items[i] = &result.Data[i]
-			// Populate included resources into relationships
-			if len(result.Included) > 0 {
-				items[i].Relationships.PopulateIncludes(result.Included)
-			}
+			items[i].ResolveIncludes(included)
 		}
 
 		return items, result.Meta.Pagination, nil
 	}
 
-	return client.NewIterator[schemas.AiUsage](ctx, pageSize, fetchPage)
+	// Checkpoints only resume the iterators of the same listing
+	listOpts := ListAiUsageOptions{}
+	if opts != nil {
+		listOpts = *opts
+	}
+	listOpts.PageNumber, listOpts.PageSize = 0, 0
+	filters := client.HashOptions("ListAiUsage", listOpts)
+
+	return client.NewIterator[schemas.AiUsage](ctx, pageSize, fetchPage).
+		Prefetch(c.httpClient.Prefetch()).
+		FiltersHash(filters)
 }
 
 // ListAiUsageOptions holds optional parameters for ListAiUsage
@@ -311,8 +291,30 @@ type ListAiUsageOptions struct {
 	// The comma-separated list of attributes.
 	Sort []string
 	// The value of the fields[resource-type] parameter is a comma-separated list that refers to the name of the fields to be returned for the resource. An empty value indicates that no fields should be returned.
-	Fields map[string]interface{}
+	Fields client.Fields
 	// The comma-separated list of relationship paths.
 	Include []string
 	Filter  map[string]string
 }
+
+// Relationship paths accepted by the Include option of ListAiUsage
+const (
+	ListAiUsageIncludeAccount                 = "account"
+	ListAiUsageIncludeAccountBillingPlan      = "account.billing-plan"
+	ListAiUsageIncludeAccountIdentityProvider = "account.identity-provider"
+	ListAiUsageIncludeAccountOwner            = "account.owner"
+	ListAiUsageIncludeRun                     = "run"
+	ListAiUsageIncludeRunApply                = "run.apply"
+	ListAiUsageIncludeRunConfigurationVersion = "run.configuration-version"
+	ListAiUsageIncludeRunCostEstimate         = "run.cost-estimate"
+	ListAiUsageIncludeRunCreatedBy            = "run.created-by"
+	ListAiUsageIncludeRunCreatedByRun         = "run.created-by-run"
+	ListAiUsageIncludeRunEnvironment          = "run.environment"
+	ListAiUsageIncludeRunPlan                 = "run.plan"
+	ListAiUsageIncludeRunPolicyChecks         = "run.policy-checks"
+	ListAiUsageIncludeRunStateVersions        = "run.state-versions"
+	ListAiUsageIncludeRunStatusTransitions    = "run.status-transitions"
+	ListAiUsageIncludeRunTags                 = "run.tags"
+	ListAiUsageIncludeRunVcsRevision          = "run.vcs-revision"
+	ListAiUsageIncludeRunWorkspace            = "run.workspace"
+)
@@ -45,8 +45,8 @@ func (c *Client) GetApply(ctx context.Context, apply string) (*schemas.Apply, er
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.Apply            `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.Apply     `json:"data"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
@@ -95,6 +95,27 @@ func (c *Client) GetApplyLog(ctx context.Context, apply string, opts *GetApplyLo
 	return string(bodyBytes), nil
 }
 
+// GetApplyLogStream streams the response of GetApplyLog instead of buffering it in memory.
+// The caller must close the returned body.
+func (c *Client) GetApplyLogStream(ctx context.Context, apply string, opts *GetApplyLogOptions) (io.ReadCloser, error) {
+	resp, err := c.GetApplyLogRaw(ctx, apply, opts)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+// GetApplyLogTo copies the response of GetApplyLog to w and returns the number of bytes written.
+func (c *Client) GetApplyLogTo(ctx context.Context, w io.Writer, apply string, opts *GetApplyLogOptions) (int64, error) {
+	body, err := c.GetApplyLogStream(ctx, apply, opts)
+	if err != nil {
+		return 0, err
+	}
+	defer body.Close()
+
+	return io.Copy(w, body)
+}
+
 // GetApplyLogOptions holds optional parameters for GetApplyLog
 type GetApplyLogOptions struct {
 	// Strip ANSI escape codes.
@@ -47,16 +47,14 @@ func (c *Client) CreateAwsEventBridgeIntegration(ctx context.Context, req *schem
 
 	var result struct {
 		Data     schemas.AWSEventBridgeIntegration `json:"data"`
-		Included []map[string]interface{}          `json:"included"`
+		Included []json.RawMessage                 `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -103,16 +101,14 @@ func (c *Client) GetAwsEventBridgeIntegration(ctx context.Context, awsEventBridg
 
 	var result struct {
 		Data     schemas.AWSEventBridgeIntegration `json:"data"`
-		Included []map[string]interface{}          `json:"included"`
+		Included []json.RawMessage                 `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -160,19 +156,18 @@ func (c *Client) ListAwsEventBridgeIntegrations(ctx context.Context, opts *ListA
 		Meta struct {
 			Pagination *client.Pagination `json:"pagination"`
 		} `json:"meta"`
-		Included []map[string]interface{} `json:"included"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
+	// Index included resources once to resolve the relationships
+	included := client.NewIncluded(result.Included)
 	resources := make([]*schemas.AWSEventBridgeIntegration, len(result.Data))
 	for i := range result.Data {
 		resources[i] = &result.Data[i]
-		// Populate included resources into relationships
-		if len(result.Included) > 0 {
-			resources[i].Relationships.PopulateIncludes(result.Included)
-		}
+		resources[i].ResolveIncludes(included)
 	}
 	return resources, nil
 }
@@ -181,6 +176,7 @@ func (c *Client) ListAwsEventBridgeIntegrations(ctx context.Context, opts *ListA
 // This is the recommended and simple way to iterate through all the results.
 //
 // The iterator automatically fetches pages as needed and handles errors inline.
+// Pages are fetched ahead in the background when the client is created with client.WithPrefetch.
 //
 // Example:
 //
@@ -192,73 +188,23 @@ func (c *Client) ListAwsEventBridgeIntegrations(ctx context.Context, opts *ListA
 //	}
 func (c *Client) ListAwsEventBridgeIntegrationsIter(ctx context.Context, opts *ListAwsEventBridgeIntegrationsOptions) iter.Seq2[schemas.AWSEventBridgeIntegration, error] {
 	return func(yield func(schemas.AWSEventBridgeIntegration, error) bool) {
-		// Determine page size from opts or use default
-		pageSize := 20
-		if opts != nil && opts.PageSize > 0 {
-			pageSize = opts.PageSize
-		}
-
-		pageNum := 1
-
-		for {
-			// Check context
-			if err := ctx.Err(); err != nil {
-				yield(schemas.AWSEventBridgeIntegration{}, err)
-				return
-			}
-
-			// Copy options and set page number
-			pageOpts := &ListAwsEventBridgeIntegrationsOptions{}
-			if opts != nil {
-				*pageOpts = *opts
-			}
-			pageOpts.PageNumber = pageNum
-			pageOpts.PageSize = pageSize
-
-			// Fetch page using Raw method to get pagination metadata
-			resp, err := c.ListAwsEventBridgeIntegrationsRaw(ctx, pageOpts)
-			if err != nil {
-				yield(schemas.AWSEventBridgeIntegration{}, err)
-				return
-			}
-			defer resp.Body.Close()
-
-			// Decode response
-			var result struct {
-				Data []schemas.AWSEventBridgeIntegration `json:"data"`
-				Meta struct {
-					Pagination *client.Pagination `json:"pagination"`
-				} `json:"meta"`
-				Included []map[string]interface{} `json:"included"`
-			}
-			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-				yield(schemas.AWSEventBridgeIntegration{}, fmt.Errorf("failed to decode response: %w", err))
-				return
-			}
+		it := c.ListAwsEventBridgeIntegrationsPaged(ctx, opts)
+		defer it.Close() // Stops the prefetching on early exit
 
-			// Yield each item
-			for i := range result.Data {
-				// Populate included resources into relationships
-				if len(result.Included) > 0 {
-					result.Data[i].Relationships.PopulateIncludes(result.Included)
-				}
-				if !yield(result.Data[i], nil) {
-					return // Consumer requested early exit
-				}
+		for it.Next() {
+			if !yield(*it.Value(), nil) {
+				return // Consumer requested early exit
 			}
-
-			// Check if there are more pages
-			if result.Meta.Pagination == nil || result.Meta.Pagination.NextPage == nil {
-				break
-			}
-
-			pageNum++
+		}
+		if err := it.Err(); err != nil {
+			yield(schemas.AWSEventBridgeIntegration{}, err)
 		}
 	}
 }
 
 // ListAwsEventBridgeIntegrationsPaged returns a stateful iterator with access to pagination metadata.
-// Use this when you need PageInfo(), Remaining(), or Collect() methods.
+// Use this when you need PageInfo(), Remaining(), or Collect() methods, or to resume
+// an interrupted iteration from the iterator Checkpoint().
 //
 // Example:
 //
@@ -271,6 +217,10 @@ func (c *Client) ListAwsEventBridgeIntegrationsIter(ctx context.Context, opts *L
 //	if err := iter.Err(); err != nil {
 //	    // Handle error
 //	}
+//
+// Resuming from a checkpoint saved by an iterator with the same options:
+//
+//	iter := client.AWSEventBridgeIntegration.ListAwsEventBridgeIntegrationsPaged(ctx, opts).Resume(checkpoint)
 func (c *Client) ListAwsEventBridgeIntegrationsPaged(ctx context.Context, opts *ListAwsEventBridgeIntegrationsOptions) *client.Iterator[schemas.AWSEventBridgeIntegration] {
 	// Determine page size from opts or use default
 	pageSize := 20
@@ -301,26 +251,34 @@ func (c *Client) ListAwsEventBridgeIntegrationsPaged(ctx context.Context, opts *
 			Meta struct {
 				Pagination *client.Pagination `json:"pagination"`
 			} `json:"meta"`
-			Included []map[string]interface{} `json:"included"`
+			Included []json.RawMessage `json:"included"`
 		}
 		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 			return nil, nil, fmt.Errorf("failed to decode response: %w", err)
 		}
 
-		// Convert to slice of pointers and populate includes
+		// Convert to slice of pointers and resolve includes
+		included := client.NewIncluded(result.Included)
 		items := make([]*schemas.AWSEventBridgeIntegration, len(result.Data))
 		for i := range result.Data {
 			items[i] = &result.Data[i]
-			// Populate included resources into relationships
-			if len(result.Included) > 0 {
-				items[i].Relationships.PopulateIncludes(result.Included)
-			}
+			items[i].ResolveIncludes(included)
 		}
 
 		return items, result.Meta.Pagination, nil
 	}
 
-	return client.NewIterator[schemas.AWSEventBridgeIntegration](ctx, pageSize, fetchPage)
+	// Checkpoints only resume the iterators of the same listing
+	listOpts := ListAwsEventBridgeIntegrationsOptions{}
+	if opts != nil {
+		listOpts = *opts
+	}
+	listOpts.PageNumber, listOpts.PageSize = 0, 0
+	filters := client.HashOptions("ListAwsEventBridgeIntegrations", listOpts)
+
+	return client.NewIterator[schemas.AWSEventBridgeIntegration](ctx, pageSize, fetchPage).
+		Prefetch(c.httpClient.Prefetch()).
+		FiltersHash(filters)
 }
 
 // ListAwsEventBridgeIntegrationsOptions holds optional parameters for ListAwsEventBridgeIntegrations
@@ -358,15 +316,13 @@ func (c *Client) UpdateAwsEventBridgeIntegration(ctx context.Context, awsEventBr
 
 	var result struct {
 		Data     schemas.AWSEventBridgeIntegration `json:"data"`
-		Included []map[string]interface{}          `json:"included"`
+		Included []json.RawMessage                 `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
@@ -78,7 +78,7 @@ func (c *Client) ListBillingUsage(ctx context.Context, opts *ListBillingUsageOpt
 		Meta struct {
 			Pagination *client.Pagination `json:"pagination"`
 		} `json:"meta"`
-		Included []map[string]interface{} `json:"included"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
@@ -95,6 +95,7 @@ func (c *Client) ListBillingUsage(ctx context.Context, opts *ListBillingUsageOpt
 // This is the recommended and simple way to iterate through all the results.
 //
 // The iterator automatically fetches pages as needed and handles errors inline.
+// Pages are fetched ahead in the background when the client is created with client.WithPrefetch.
 //
 // Example:
 //
@@ -106,69 +107,23 @@ func (c *Client) ListBillingUsage(ctx context.Context, opts *ListBillingUsageOpt
 //	}
 func (c *Client) ListBillingUsageIter(ctx context.Context, opts *ListBillingUsageOptions) iter.Seq2[schemas.BillingUsage, error] {
 	return func(yield func(schemas.BillingUsage, error) bool) {
-		// Determine page size from opts or use default
-		pageSize := 20
-		if opts != nil && opts.PageSize > 0 {
-			pageSize = opts.PageSize
-		}
-
-		pageNum := 1
-
-		for {
-			// Check context
-			if err := ctx.Err(); err != nil {
-				yield(schemas.BillingUsage{}, err)
-				return
-			}
-
-			// Copy options and set page number
-			pageOpts := &ListBillingUsageOptions{}
-			if opts != nil {
-				*pageOpts = *opts
-			}
-			pageOpts.PageNumber = pageNum
-			pageOpts.PageSize = pageSize
-
-			// Fetch page using Raw method to get pagination metadata
-			resp, err := c.ListBillingUsageRaw(ctx, pageOpts)
-			if err != nil {
-				yield(schemas.BillingUsage{}, err)
-				return
-			}
-			defer resp.Body.Close()
-
-			// Decode response
-			var result struct {
-				Data []schemas.BillingUsage `json:"data"`
-				Meta struct {
-					Pagination *client.Pagination `json:"pagination"`
-				} `json:"meta"`
-				Included []map[string]interface{} `json:"included"`
-			}
-			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-				yield(schemas.BillingUsage{}, fmt.Errorf("failed to decode response: %w", err))
-				return
-			}
+		it := c.ListBillingUsagePaged(ctx, opts)
+		defer it.Close() // Stops the prefetching on early exit
 
-			// Yield each item
-			for i := range result.Data {
-				if !yield(result.Data[i], nil) {
-					return // Consumer requested early exit
-				}
+		for it.Next() {
+			if !yield(*it.Value(), nil) {
+				return // Consumer requested early exit
 			}
-
-			// Check if there are more pages
-			if result.Meta.Pagination == nil || result.Meta.Pagination.NextPage == nil {
-				break
-			}
-
-			pageNum++
+		}
+		if err := it.Err(); err != nil {
+			yield(schemas.BillingUsage{}, err)
 		}
 	}
 }
 
 // ListBillingUsagePaged returns a stateful iterator with access to pagination metadata.
-// Use this when you need PageInfo(), Remaining(), or Collect() methods.
+// Use this when you need PageInfo(), Remaining(), or Collect() methods, or to resume
+// an interrupted iteration from the iterator Checkpoint().
 //
 // Example:
 //
@@ -181,6 +136,10 @@ func (c *Client) ListBillingUsageIter(ctx context.Context, opts *ListBillingUsag
 //	if err := iter.Err(); err != nil {
 //	    // Handle error
 //	}
+//
+// Resuming from a checkpoint saved by an iterator with the same options:
+//
+//	iter := client.BillingUsage.ListBillingUsagePaged(ctx, opts).Resume(checkpoint)
 func (c *Client) ListBillingUsagePaged(ctx context.Context, opts *ListBillingUsageOptions) *client.Iterator[schemas.BillingUsage] {
 	// Determine page size from opts or use default
 	pageSize := 20
@@ -211,13 +170,13 @@ func (c *Client) ListBillingUsagePaged(ctx context.Context, opts *ListBillingUsa
 			Meta struct {
 				Pagination *client.Pagination `json:"pagination"`
 			} `json:"meta"`
-			Included []map[string]interface{} `json:"included"`
+			Included []json.RawMessage `json:"included"`
 		}
 		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 			return nil, nil, fmt.Errorf("failed to decode response: %w", err)
 		}
 
-		// Convert to slice of pointers and populate includes
+		// Convert to slice of pointers and resolve includes
 		items := make([]*schemas.BillingUsage, len(result.Data))
 		for i := range result.Data {
 			items[i] = &result.Data[i]
@@ -226,7 +185,17 @@ func (c *Client) ListBillingUsagePaged(ctx context.Context, opts *ListBillingUsa
 		return items, result.Meta.Pagination, nil
 	}
 
-	return client.NewIterator[schemas.BillingUsage](ctx, pageSize, fetchPage)
+	// Checkpoints only resume the iterators of the same listing
+	listOpts := ListBillingUsageOptions{}
+	if opts != nil {
+		listOpts = *opts
+	}
+	listOpts.PageNumber, listOpts.PageSize = 0, 0
+	filters := client.HashOptions("ListBillingUsage", listOpts)
+
+	return client.NewIterator[schemas.BillingUsage](ctx, pageSize, fetchPage).
+		Prefetch(c.httpClient.Prefetch()).
+		FiltersHash(filters)
 }
 
 // ListBillingUsageOptions holds optional parameters for ListBillingUsage
@@ -61,16 +61,14 @@ func (c *Client) CreateCheckovIntegration(ctx context.Context, req *schemas.Chec
 
 	var result struct {
 		Data     schemas.CheckovIntegration `json:"data"`
-		Included []map[string]interface{}   `json:"included"`
+		Included []json.RawMessage          `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -81,6 +79,26 @@ type CreateCheckovIntegrationOptions struct {
 	Filter  map[string]string
 }
 
+// Relationship paths accepted by the Include option of CreateCheckovIntegration
+const (
+	CreateCheckovIntegrationIncludeEnvironments                              = "environments"
+	CreateCheckovIntegrationIncludeEnvironmentsAccount                       = "environments.account"
+	CreateCheckovIntegrationIncludeEnvironmentsCreatedBy                     = "environments.created-by"
+	CreateCheckovIntegrationIncludeEnvironmentsDefaultProviderConfigurations = "environments.default-provider-configurations"
+	CreateCheckovIntegrationIncludeEnvironmentsDefaultWorkspaceAgentPool     = "environments.default-workspace-agent-pool"
+	CreateCheckovIntegrationIncludeEnvironmentsDriftDetectionSchedules       = "environments.drift-detection-schedules"
+	CreateCheckovIntegrationIncludeEnvironmentsLockedBy                      = "environments.locked-by"
+	CreateCheckovIntegrationIncludeEnvironmentsPolicyGroups                  = "environments.policy-groups"
+	CreateCheckovIntegrationIncludeEnvironmentsProviderConfigurations        = "environments.provider-configurations"
+	CreateCheckovIntegrationIncludeEnvironmentsStorageProfile                = "environments.storage-profile"
+	CreateCheckovIntegrationIncludeEnvironmentsTags                          = "environments.tags"
+	CreateCheckovIntegrationIncludeEnvironmentsUpdatedBy                     = "environments.updated-by"
+	CreateCheckovIntegrationIncludeVcsProvider                               = "vcs-provider"
+	CreateCheckovIntegrationIncludeVcsProviderAccount                        = "vcs-provider.account"
+	CreateCheckovIntegrationIncludeVcsProviderAgentPool                      = "vcs-provider.agent-pool"
+	CreateCheckovIntegrationIncludeVcsProviderEnvironments                   = "vcs-provider.environments"
+)
+
 func (c *Client) DeleteCheckovIntegrationRaw(ctx context.Context, integration string) (*client.Response, error) {
 	path := "/integrations/checkov/{integration}"
 	path = strings.ReplaceAll(path, "{integration}", url.PathEscape(integration))
@@ -138,16 +156,14 @@ func (c *Client) GetCheckovIntegration(ctx context.Context, integration string,
 
 	var result struct {
 		Data     schemas.CheckovIntegration `json:"data"`
-		Included []map[string]interface{}   `json:"included"`
+		Included []json.RawMessage          `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -158,6 +174,26 @@ type GetCheckovIntegrationOptions struct {
 	Filter  map[string]string
 }
 
+// Relationship paths accepted by the Include option of GetCheckovIntegration
+const (
+	GetCheckovIntegrationIncludeEnvironments                              = "environments"
+	GetCheckovIntegrationIncludeEnvironmentsAccount                       = "environments.account"
+	GetCheckovIntegrationIncludeEnvironmentsCreatedBy                     = "environments.created-by"
+	GetCheckovIntegrationIncludeEnvironmentsDefaultProviderConfigurations = "environments.default-provider-configurations"
+	GetCheckovIntegrationIncludeEnvironmentsDefaultWorkspaceAgentPool     = "environments.default-workspace-agent-pool"
+	GetCheckovIntegrationIncludeEnvironmentsDriftDetectionSchedules       = "environments.drift-detection-schedules"
+	GetCheckovIntegrationIncludeEnvironmentsLockedBy                      = "environments.locked-by"
+	GetCheckovIntegrationIncludeEnvironmentsPolicyGroups                  = "environments.policy-groups"
+	GetCheckovIntegrationIncludeEnvironmentsProviderConfigurations        = "environments.provider-configurations"
+	GetCheckovIntegrationIncludeEnvironmentsStorageProfile                = "environments.storage-profile"
+	GetCheckovIntegrationIncludeEnvironmentsTags                          = "environments.tags"
+	GetCheckovIntegrationIncludeEnvironmentsUpdatedBy                     = "environments.updated-by"
+	GetCheckovIntegrationIncludeVcsProvider                               = "vcs-provider"
+	GetCheckovIntegrationIncludeVcsProviderAccount                        = "vcs-provider.account"
+	GetCheckovIntegrationIncludeVcsProviderAgentPool                      = "vcs-provider.agent-pool"
+	GetCheckovIntegrationIncludeVcsProviderEnvironments                   = "vcs-provider.environments"
+)
+
 // This endpoint returns a list of Checkov integrations.
 func (c *Client) ListCheckovIntegrationsRaw(ctx context.Context, opts *ListCheckovIntegrationsOptions) (*client.Response, error) {
 	path := "/integrations/checkov"
@@ -205,19 +241,18 @@ func (c *Client) ListCheckovIntegrations(ctx context.Context, opts *ListCheckovI
 		Meta struct {
 			Pagination *client.Pagination `json:"pagination"`
 		} `json:"meta"`
-		Included []map[string]interface{} `json:"included"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
+	// Index included resources once to resolve the relationships
+	included := client.NewIncluded(result.Included)
 	resources := make([]*schemas.CheckovIntegration, len(result.Data))
 	for i := range result.Data {
 		resources[i] = &result.Data[i]
-		// Populate included resources into relationships
-		if len(result.Included) > 0 {
-			resources[i].Relationships.PopulateIncludes(result.Included)
-		}
+		resources[i].ResolveIncludes(included)
 	}
 	return resources, nil
 }
@@ -226,6 +261,7 @@ func (c *Client) ListCheckovIntegrations(ctx context.Context, opts *ListCheckovI
 // This is the recommended and simple way to iterate through all the results.
 //
 // The iterator automatically fetches pages as needed and handles errors inline.
+// Pages are fetched ahead in the background when the client is created with client.WithPrefetch.
 //
 // Example:
 //
@@ -237,73 +273,23 @@ func (c *Client) ListCheckovIntegrations(ctx context.Context, opts *ListCheckovI
 //	}
 func (c *Client) ListCheckovIntegrationsIter(ctx context.Context, opts *ListCheckovIntegrationsOptions) iter.Seq2[schemas.CheckovIntegration, error] {
 	return func(yield func(schemas.CheckovIntegration, error) bool) {
-		// Determine page size from opts or use default
-		pageSize := 20
-		if opts != nil && opts.PageSize > 0 {
-			pageSize = opts.PageSize
-		}
-
-		pageNum := 1
-
-		for {
-			// Check context
-			if err := ctx.Err(); err != nil {
-				yield(schemas.CheckovIntegration{}, err)
-				return
-			}
-
-			// Copy options and set page number
-			pageOpts := &ListCheckovIntegrationsOptions{}
-			if opts != nil {
-				*pageOpts = *opts
-			}
-			pageOpts.PageNumber = pageNum
-			pageOpts.PageSize = pageSize
-
-			// Fetch page using Raw method to get pagination metadata
-			resp, err := c.ListCheckovIntegrationsRaw(ctx, pageOpts)
-			if err != nil {
-				yield(schemas.CheckovIntegration{}, err)
-				return
-			}
-			defer resp.Body.Close()
-
-			// Decode response
-			var result struct {
-				Data []schemas.CheckovIntegration `json:"data"`
-				Meta struct {
-					Pagination *client.Pagination `json:"pagination"`
-				} `json:"meta"`
-				Included []map[string]interface{} `json:"included"`
-			}
-			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-				yield(schemas.CheckovIntegration{}, fmt.Errorf("failed to decode response: %w", err))
-				return
-			}
+		it := c.ListCheckovIntegrationsPaged(ctx, opts)
+		defer it.Close() // Stops the prefetching on early exit
 
-			// Yield each item
-			for i := range result.Data {
-				// Populate included resources into relationships
-				if len(result.Included) > 0 {
-					result.Data[i].Relationships.PopulateIncludes(result.Included)
-				}
-				if !yield(result.Data[i], nil) {
-					return // Consumer requested early exit
-				}
+		for it.Next() {
+			if !yield(*it.Value(), nil) {
+				return // Consumer requested early exit
 			}
-
-			// Check if there are more pages
-			if result.Meta.Pagination == nil || result.Meta.Pagination.NextPage == nil {
-				break
-			}
-
-			pageNum++
+		}
+		if err := it.Err(); err != nil {
+			yield(schemas.CheckovIntegration{}, err)
 		}
 	}
 }
 
 // ListCheckovIntegrationsPaged returns a stateful iterator with access to pagination metadata.
-// Use this when you need PageInfo(), Remaining(), or Collect() methods.
+// Use this when you need PageInfo(), Remaining(), or Collect() methods, or to resume
+// an interrupted iteration from the iterator Checkpoint().
 //
 // Example:
 //
@@ -316,6 +302,10 @@ func (c *Client) ListCheckovIntegrationsIter(ctx context.Context, opts *ListChec
 //	if err := iter.Err(); err != nil {
 //	    // Handle error
 //	}
+//
+// Resuming from a checkpoint saved by an iterator with the same options:
+//
+//	iter := client.CheckovIntegration.ListCheckovIntegrationsPaged(ctx, opts).Resume(checkpoint)
 func (c *Client) ListCheckovIntegrationsPaged(ctx context.Context, opts *ListCheckovIntegrationsOptions) *client.Iterator[schemas.CheckovIntegration] {
 	// Determine page size from opts or use default
 	pageSize := 20
@@ -346,26 +336,34 @@ func (c *Client) ListCheckovIntegrationsPaged(ctx context.Context, opts *ListChe
 			Meta struct {
 				Pagination *client.Pagination `json:"pagination"`
 			} `json:"meta"`
-			Included []map[string]interface{} `json:"included"`
+			Included []json.RawMessage `json:"included"`
 		}
 		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 			return nil, nil, fmt.Errorf("failed to decode response: %w", err)
 		}
 
-		// Convert to slice of pointers and populate includes
+		// Convert to slice of pointers and resolve includes
+		included := client.NewIncluded(result.Included)
 		items := make([]*schemas.CheckovIntegration, len(result.Data))
 		for i := range result.Data {
 			items[i] = &result.Data[i]
-			// Populate included resources into relationships
-			if len(result.Included) > 0 {
-				items[i].Relationships.PopulateIncludes(result.Included)
-			}
+			items[i].ResolveIncludes(included)
 		}
 
 		return items, result.Meta.Pagination, nil
 	}
 
-	return client.NewIterator[schemas.CheckovIntegration](ctx, pageSize, fetchPage)
+	// Checkpoints only resume the iterators of the same listing
+	listOpts := ListCheckovIntegrationsOptions{}
+	if opts != nil {
+		listOpts = *opts
+	}
+	listOpts.PageNumber, listOpts.PageSize = 0, 0
+	filters := client.HashOptions("ListCheckovIntegrations", listOpts)
+
+	return client.NewIterator[schemas.CheckovIntegration](ctx, pageSize, fetchPage).
+		Prefetch(c.httpClient.Prefetch()).
+		FiltersHash(filters)
 }
 
 // ListCheckovIntegrationsOptions holds optional parameters for ListCheckovIntegrations
@@ -381,6 +379,26 @@ type ListCheckovIntegrationsOptions struct {
 	Filter map[string]string
 }
 
+// Relationship paths accepted by the Include option of ListCheckovIntegrations
+const (
+	ListCheckovIntegrationsIncludeEnvironments                              = "environments"
+	ListCheckovIntegrationsIncludeEnvironmentsAccount                       = "environments.account"
+	ListCheckovIntegrationsIncludeEnvironmentsCreatedBy                     = "environments.created-by"
+	ListCheckovIntegrationsIncludeEnvironmentsDefaultProviderConfigurations = "environments.default-provider-configurations"
+	ListCheckovIntegrationsIncludeEnvironmentsDefaultWorkspaceAgentPool     = "environments.default-workspace-agent-pool"
+	ListCheckovIntegrationsIncludeEnvironmentsDriftDetectionSchedules       = "environments.drift-detection-schedules"
+	ListCheckovIntegrationsIncludeEnvironmentsLockedBy                      = "environments.locked-by"
+	ListCheckovIntegrationsIncludeEnvironmentsPolicyGroups                  = "environments.policy-groups"
+	ListCheckovIntegrationsIncludeEnvironmentsProviderConfigurations        = "environments.provider-configurations"
+	ListCheckovIntegrationsIncludeEnvironmentsStorageProfile                = "environments.storage-profile"
+	ListCheckovIntegrationsIncludeEnvironmentsTags                          = "environments.tags"
+	ListCheckovIntegrationsIncludeEnvironmentsUpdatedBy                     = "environments.updated-by"
+	ListCheckovIntegrationsIncludeVcsProvider                               = "vcs-provider"
+	ListCheckovIntegrationsIncludeVcsProviderAccount                        = "vcs-provider.account"
+	ListCheckovIntegrationsIncludeVcsProviderAgentPool                      = "vcs-provider.agent-pool"
+	ListCheckovIntegrationsIncludeVcsProviderEnvironments                   = "vcs-provider.environments"
+)
+
 func (c *Client) ResyncCheckovIntegrationRaw(ctx context.Context, integration string) (*client.Response, error) {
 	path := "/integrations/checkov/{integration}/actions/resync"
 	path = strings.ReplaceAll(path, "{integration}", url.PathEscape(integration))
@@ -440,16 +458,14 @@ func (c *Client) UpdateCheckovIntegration(ctx context.Context, integration strin
 
 	var result struct {
 		Data     schemas.CheckovIntegration `json:"data"`
-		Included []map[string]interface{}   `json:"included"`
+		Included []json.RawMessage          `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -459,3 +475,23 @@ type UpdateCheckovIntegrationOptions struct {
 	Include []string
 	Filter  map[string]string
 }
+
+// Relationship paths accepted by the Include option of UpdateCheckovIntegration
+const (
+	UpdateCheckovIntegrationIncludeEnvironments                              = "environments"
+	UpdateCheckovIntegrationIncludeEnvironmentsAccount                       = "environments.account"
+	UpdateCheckovIntegrationIncludeEnvironmentsCreatedBy                     = "environments.created-by"
+	UpdateCheckovIntegrationIncludeEnvironmentsDefaultProviderConfigurations = "environments.default-provider-configurations"
+	UpdateCheckovIntegrationIncludeEnvironmentsDefaultWorkspaceAgentPool     = "environments.default-workspace-agent-pool"
+	UpdateCheckovIntegrationIncludeEnvironmentsDriftDetectionSchedules       = "environments.drift-detection-schedules"
+	UpdateCheckovIntegrationIncludeEnvironmentsLockedBy                      = "environments.locked-by"
+	UpdateCheckovIntegrationIncludeEnvironmentsPolicyGroups                  = "environments.policy-groups"
+	UpdateCheckovIntegrationIncludeEnvironmentsProviderConfigurations        = "environments.provider-configurations"
+	UpdateCheckovIntegrationIncludeEnvironmentsStorageProfile                = "environments.storage-profile"
+	UpdateCheckovIntegrationIncludeEnvironmentsTags                          = "environments.tags"
+	UpdateCheckovIntegrationIncludeEnvironmentsUpdatedBy                     = "environments.updated-by"
+	UpdateCheckovIntegrationIncludeVcsProvider                               = "vcs-provider"
+	UpdateCheckovIntegrationIncludeVcsProviderAccount                        = "vcs-provider.account"
+	UpdateCheckovIntegrationIncludeVcsProviderAgentPool                      = "vcs-provider.agent-pool"
+	UpdateCheckovIntegrationIncludeVcsProviderEnvironments                   = "vcs-provider.environments"
+)
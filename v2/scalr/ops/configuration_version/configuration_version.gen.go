@@ -48,16 +48,14 @@ func (c *Client) CreateConfigurationVersion(ctx context.Context, req *schemas.Co
 
 	var result struct {
 		Data     schemas.ConfigurationVersion `json:"data"`
-		Included []map[string]interface{}     `json:"included"`
+		Included []json.RawMessage            `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -88,6 +86,27 @@ func (c *Client) DownloadConfigurationVersion(ctx context.Context, configuration
 	return string(bodyBytes), nil
 }
 
+// DownloadConfigurationVersionStream streams the response of DownloadConfigurationVersion instead of buffering it in memory.
+// The caller must close the returned body.
+func (c *Client) DownloadConfigurationVersionStream(ctx context.Context, configurationVersion string) (io.ReadCloser, error) {
+	resp, err := c.DownloadConfigurationVersionRaw(ctx, configurationVersion)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+// DownloadConfigurationVersionTo copies the response of DownloadConfigurationVersion to w and returns the number of bytes written.
+func (c *Client) DownloadConfigurationVersionTo(ctx context.Context, w io.Writer, configurationVersion string) (int64, error) {
+	body, err := c.DownloadConfigurationVersionStream(ctx, configurationVersion)
+	if err != nil {
+		return 0, err
+	}
+	defer body.Close()
+
+	return io.Copy(w, body)
+}
+
 // Show details of a specific Configuration Version.
 func (c *Client) GetConfigurationVersionRaw(ctx context.Context, configurationVersion string, opts *GetConfigurationVersionOptions) (*client.Response, error) {
 	path := "/configuration-versions/{configuration_version}"
@@ -124,16 +143,14 @@ func (c *Client) GetConfigurationVersion(ctx context.Context, configurationVersi
 
 	var result struct {
 		Data     schemas.ConfigurationVersion `json:"data"`
-		Included []map[string]interface{}     `json:"included"`
+		Included []json.RawMessage            `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -144,6 +161,29 @@ type GetConfigurationVersionOptions struct {
 	Filter  map[string]string
 }
 
+// Relationship paths accepted by the Include option of GetConfigurationVersion
+const (
+	GetConfigurationVersionIncludeVcsRevision                         = "vcs-revision"
+	GetConfigurationVersionIncludeWorkspace                           = "workspace"
+	GetConfigurationVersionIncludeWorkspaceAgentPool                  = "workspace.agent-pool"
+	GetConfigurationVersionIncludeWorkspaceConfigurationVersion       = "workspace.configuration-version"
+	GetConfigurationVersionIncludeWorkspaceCreatedBy                  = "workspace.created-by"
+	GetConfigurationVersionIncludeWorkspaceCurrentRun                 = "workspace.current-run"
+	GetConfigurationVersionIncludeWorkspaceDriftReport                = "workspace.drift-report"
+	GetConfigurationVersionIncludeWorkspaceEnvironment                = "workspace.environment"
+	GetConfigurationVersionIncludeWorkspaceLatestConfigurationVersion = "workspace.latest-configuration-version"
+	GetConfigurationVersionIncludeWorkspaceLatestRun                  = "workspace.latest-run"
+	GetConfigurationVersionIncludeWorkspaceLockedBy                   = "workspace.locked-by"
+	GetConfigurationVersionIncludeWorkspaceLockedByRun                = "workspace.locked-by-run"
+	GetConfigurationVersionIncludeWorkspaceModule                     = "workspace.module"
+	GetConfigurationVersionIncludeWorkspaceModuleVersion              = "workspace.module-version"
+	GetConfigurationVersionIncludeWorkspaceReadmeId                   = "workspace.readme-id"
+	GetConfigurationVersionIncludeWorkspaceSshKey                     = "workspace.ssh-key"
+	GetConfigurationVersionIncludeWorkspaceTags                       = "workspace.tags"
+	GetConfigurationVersionIncludeWorkspaceUpdatedBy                  = "workspace.updated-by"
+	GetConfigurationVersionIncludeWorkspaceVcsProvider                = "workspace.vcs-provider"
+)
+
 func (c *Client) GetConfigurationVersionsRaw(ctx context.Context, opts *GetConfigurationVersionsOptions) (*client.Response, error) {
 	path := "/configuration-versions"
 
@@ -186,19 +226,18 @@ func (c *Client) GetConfigurationVersions(ctx context.Context, opts *GetConfigur
 		Meta struct {
 			Pagination *client.Pagination `json:"pagination"`
 		} `json:"meta"`
-		Included []map[string]interface{} `json:"included"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
+	// Index included resources once to resolve the relationships
+	included := client.NewIncluded(result.Included)
 	resources := make([]*schemas.ConfigurationVersion, len(result.Data))
 	for i := range result.Data {
 		resources[i] = &result.Data[i]
-		// Populate included resources into relationships
-		if len(result.Included) > 0 {
-			resources[i].Relationships.PopulateIncludes(result.Included)
-		}
+		resources[i].ResolveIncludes(included)
 	}
 	return resources, nil
 }
@@ -207,6 +246,7 @@ func (c *Client) GetConfigurationVersions(ctx context.Context, opts *GetConfigur
 // This is the recommended and simple way to iterate through all the results.
 //
 // The iterator automatically fetches pages as needed and handles errors inline.
+// Pages are fetched ahead in the background when the client is created with client.WithPrefetch.
 //
 // Example:
 //
@@ -218,73 +258,23 @@ func (c *Client) GetConfigurationVersions(ctx context.Context, opts *GetConfigur
 //	}
 func (c *Client) GetConfigurationVersionsIter(ctx context.Context, opts *GetConfigurationVersionsOptions) iter.Seq2[schemas.ConfigurationVersion, error] {
 	return func(yield func(schemas.ConfigurationVersion, error) bool) {
-		// Determine page size from opts or use default
-		pageSize := 20
-		if opts != nil && opts.PageSize > 0 {
-			pageSize = opts.PageSize
-		}
+		it := c.GetConfigurationVersionsPaged(ctx, opts)
+		defer it.Close() // Stops the prefetching on early exit
 
-		pageNum := 1
-
-		for {
-			// Check context
-			if err := ctx.Err(); err != nil {
-				yield(schemas.ConfigurationVersion{}, err)
-				return
+		for it.Next() {
+			if !yield(*it.Value(), nil) {
+				return // Consumer requested early exit
 			}
-
-			// Copy options and set page number
-			pageOpts := &GetConfigurationVersionsOptions{}
-			if opts != nil {
-				*pageOpts = *opts
-			}
-			pageOpts.PageNumber = pageNum
-			pageOpts.PageSize = pageSize
-
-			// Fetch page using Raw method to get pagination metadata
-			resp, err := c.GetConfigurationVersionsRaw(ctx, pageOpts)
-			if err != nil {
-				yield(schemas.ConfigurationVersion{}, err)
-				return
-			}
-			defer resp.Body.Close()
-
-			// Decode response
-			var result struct {
-				Data []schemas.ConfigurationVersion `json:"data"`
-				Meta struct {
-					Pagination *client.Pagination `json:"pagination"`
-				} `json:"meta"`
-				Included []map[string]interface{} `json:"included"`
-			}
-			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-				yield(schemas.ConfigurationVersion{}, fmt.Errorf("failed to decode response: %w", err))
-				return
-			}
-
-			// Yield each item
-			for i := range result.Data {
-				// Populate included resources into relationships
-				if len(result.Included) > 0 {
-					result.Data[i].Relationships.PopulateIncludes(result.Included)
-				}
-				if !yield(result.Data[i], nil) {
-					return // Consumer requested early exit
-				}
-			}
-
-			// Check if there are more pages
-			if result.Meta.Pagination == nil || result.Meta.Pagination.NextPage == nil {
-				break
-			}
-
-			pageNum++
+		}
+		if err := it.Err(); err != nil {
+			yield(schemas.ConfigurationVersion{}, err)
 		}
 	}
 }
 
 // GetConfigurationVersionsPaged returns a stateful iterator with access to pagination metadata.
-// Use this when you need PageInfo(), Remaining(), or Collect() methods.
+// Use this when you need PageInfo(), Remaining(), or Collect() methods, or to resume
+// an interrupted iteration from the iterator Checkpoint().
 //
 // Example:
 //
@@ -297,6 +287,10 @@ func (c *Client) GetConfigurationVersionsIter(ctx context.Context, opts *GetConf
 //	if err := iter.Err(); err != nil {
 //	    // Handle error
 //	}
+//
+// Resuming from a checkpoint saved by an iterator with the same options:
+//
+//	iter := client.ConfigurationVersion.GetConfigurationVersionsPaged(ctx, opts).Resume(checkpoint)
 func (c *Client) GetConfigurationVersionsPaged(ctx context.Context, opts *GetConfigurationVersionsOptions) *client.Iterator[schemas.ConfigurationVersion] {
 	// Determine page size from opts or use default
 	pageSize := 20
@@ -327,26 +321,34 @@ func (c *Client) GetConfigurationVersionsPaged(ctx context.Context, opts *GetCon
 			Meta struct {
 				Pagination *client.Pagination `json:"pagination"`
 			} `json:"meta"`
-			Included []map[string]interface{} `json:"included"`
+			Included []json.RawMessage `json:"included"`
 		}
 		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 			return nil, nil, fmt.Errorf("failed to decode response: %w", err)
 		}
 
-		// Convert to slice of pointers and populate includes
+		// Convert to slice of pointers and resolve includes
+		included := client.NewIncluded(result.Included)
 		items := make([]*schemas.ConfigurationVersion, len(result.Data))
 		for i := range result.Data {
 			items[i] = &result.Data[i]
-			// Populate included resources into relationships
-			if len(result.Included) > 0 {
-				items[i].Relationships.PopulateIncludes(result.Included)
-			}
+			items[i].ResolveIncludes(included)
 		}
 
 		return items, result.Meta.Pagination, nil
 	}
 
-	return client.NewIterator[schemas.ConfigurationVersion](ctx, pageSize, fetchPage)
+	// Checkpoints only resume the iterators of the same listing
+	listOpts := GetConfigurationVersionsOptions{}
+	if opts != nil {
+		listOpts = *opts
+	}
+	listOpts.PageNumber, listOpts.PageSize = 0, 0
+	filters := client.HashOptions("GetConfigurationVersions", listOpts)
+
+	return client.NewIterator[schemas.ConfigurationVersion](ctx, pageSize, fetchPage).
+		Prefetch(c.httpClient.Prefetch()).
+		FiltersHash(filters)
 }
 
 // GetConfigurationVersionsOptions holds optional parameters for GetConfigurationVersions
@@ -359,3 +361,26 @@ type GetConfigurationVersionsOptions struct {
 	Include []string
 	Filter  map[string]string
 }
+
+// Relationship paths accepted by the Include option of GetConfigurationVersions
+const (
+	GetConfigurationVersionsIncludeVcsRevision                         = "vcs-revision"
+	GetConfigurationVersionsIncludeWorkspace                           = "workspace"
+	GetConfigurationVersionsIncludeWorkspaceAgentPool                  = "workspace.agent-pool"
+	GetConfigurationVersionsIncludeWorkspaceConfigurationVersion       = "workspace.configuration-version"
+	GetConfigurationVersionsIncludeWorkspaceCreatedBy                  = "workspace.created-by"
+	GetConfigurationVersionsIncludeWorkspaceCurrentRun                 = "workspace.current-run"
+	GetConfigurationVersionsIncludeWorkspaceDriftReport                = "workspace.drift-report"
+	GetConfigurationVersionsIncludeWorkspaceEnvironment                = "workspace.environment"
+	GetConfigurationVersionsIncludeWorkspaceLatestConfigurationVersion = "workspace.latest-configuration-version"
+	GetConfigurationVersionsIncludeWorkspaceLatestRun                  = "workspace.latest-run"
+	GetConfigurationVersionsIncludeWorkspaceLockedBy                   = "workspace.locked-by"
+	GetConfigurationVersionsIncludeWorkspaceLockedByRun                = "workspace.locked-by-run"
+	GetConfigurationVersionsIncludeWorkspaceModule                     = "workspace.module"
+	GetConfigurationVersionsIncludeWorkspaceModuleVersion              = "workspace.module-version"
+	GetConfigurationVersionsIncludeWorkspaceReadmeId                   = "workspace.readme-id"
+	GetConfigurationVersionsIncludeWorkspaceSshKey                     = "workspace.ssh-key"
+	GetConfigurationVersionsIncludeWorkspaceTags                       = "workspace.tags"
+	GetConfigurationVersionsIncludeWorkspaceUpdatedBy                  = "workspace.updated-by"
+	GetConfigurationVersionsIncludeWorkspaceVcsProvider                = "workspace.vcs-provider"
+)
@@ -45,8 +45,8 @@ func (c *Client) GetCostEstimate(ctx context.Context, costEstimate string) (*sch
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.CostEstimate     `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.CostEstimate `json:"data"`
+		Included []json.RawMessage    `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
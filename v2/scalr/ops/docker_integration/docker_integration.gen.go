@@ -47,16 +47,14 @@ func (c *Client) CreateDockerIntegration(ctx context.Context, req *schemas.Docke
 
 	var result struct {
 		Data     schemas.DockerIntegration `json:"data"`
-		Included []map[string]interface{}  `json:"included"`
+		Included []json.RawMessage         `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -90,8 +88,7 @@ func (c *Client) GetDockerIntegrationRaw(ctx context.Context, dockerIntegration
 
 	params := url.Values{}
 	if opts != nil {
-		// Handle parameter: Fields (map[string]interface{})
-		// Complex type map[string]interface{} - skip for now
+		opts.Fields.Encode(params)
 		// Add filters
 		for k, v := range opts.Filter {
 			params.Set("filter["+k+"]", v)
@@ -118,23 +115,21 @@ func (c *Client) GetDockerIntegration(ctx context.Context, dockerIntegration str
 
 	var result struct {
 		Data     schemas.DockerIntegration `json:"data"`
-		Included []map[string]interface{}  `json:"included"`
+		Included []json.RawMessage         `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
 // GetDockerIntegrationOptions holds optional parameters for GetDockerIntegration
 type GetDockerIntegrationOptions struct {
 	// The value of the fields[resource-type] parameter is a comma-separated list that refers to the name of the fields to be returned for the resource. An empty value indicates that no fields should be returned.
-	Fields map[string]interface{}
+	Fields client.Fields
 	Filter map[string]string
 }
 
@@ -153,8 +148,7 @@ func (c *Client) ListDockerIntegrationsRaw(ctx context.Context, opts *ListDocker
 		if len(opts.Sort) > 0 {
 			params.Set("sort", strings.Join(opts.Sort, ","))
 		}
-		// Handle parameter: Fields (map[string]interface{})
-		// Complex type map[string]interface{} - skip for now
+		opts.Fields.Encode(params)
 		// Add filters
 		for k, v := range opts.Filter {
 			params.Set("filter["+k+"]", v)
@@ -184,19 +178,18 @@ func (c *Client) ListDockerIntegrations(ctx context.Context, opts *ListDockerInt
 		Meta struct {
 			Pagination *client.Pagination `json:"pagination"`
 		} `json:"meta"`
-		Included []map[string]interface{} `json:"included"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
+	// Index included resources once to resolve the relationships
+	included := client.NewIncluded(result.Included)
 	resources := make([]*schemas.DockerIntegration, len(result.Data))
 	for i := range result.Data {
 		resources[i] = &result.Data[i]
-		// Populate included resources into relationships
-		if len(result.Included) > 0 {
-			resources[i].Relationships.PopulateIncludes(result.Included)
-		}
+		resources[i].ResolveIncludes(included)
 	}
 	return resources, nil
 }
@@ -205,6 +198,7 @@ func (c *Client) ListDockerIntegrations(ctx context.Context, opts *ListDockerInt
 // This is the recommended and simple way to iterate through all the results.
 //
 // The iterator automatically fetches pages as needed and handles errors inline.
+// Pages are fetched ahead in the background when the client is created with client.WithPrefetch.
 //
 // Example:
 //
@@ -216,73 +210,23 @@ func (c *Client) ListDockerIntegrations(ctx context.Context, opts *ListDockerInt
 //	}
 func (c *Client) ListDockerIntegrationsIter(ctx context.Context, opts *ListDockerIntegrationsOptions) iter.Seq2[schemas.DockerIntegration, error] {
 	return func(yield func(schemas.DockerIntegration, error) bool) {
-		// Determine page size from opts or use default
-		pageSize := 20
-		if opts != nil && opts.PageSize > 0 {
-			pageSize = opts.PageSize
-		}
-
-		pageNum := 1
-
-		for {
-			// Check context
-			if err := ctx.Err(); err != nil {
-				yield(schemas.DockerIntegration{}, err)
-				return
-			}
-
-			// Copy options and set page number
-			pageOpts := &ListDockerIntegrationsOptions{}
-			if opts != nil {
-				*pageOpts = *opts
-			}
-			pageOpts.PageNumber = pageNum
-			pageOpts.PageSize = pageSize
-
-			// Fetch page using Raw method to get pagination metadata
-			resp, err := c.ListDockerIntegrationsRaw(ctx, pageOpts)
-			if err != nil {
-				yield(schemas.DockerIntegration{}, err)
-				return
-			}
-			defer resp.Body.Close()
-
-			// Decode response
-			var result struct {
-				Data []schemas.DockerIntegration `json:"data"`
-				Meta struct {
-					Pagination *client.Pagination `json:"pagination"`
-				} `json:"meta"`
-				Included []map[string]interface{} `json:"included"`
-			}
-			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-				yield(schemas.DockerIntegration{}, fmt.Errorf("failed to decode response: %w", err))
-				return
-			}
+		it := c.ListDockerIntegrationsPaged(ctx, opts)
+		defer it.Close() // Stops the prefetching on early exit
 
-			// Yield each item
-			for i := range result.Data {
-				// Populate included resources into relationships
-				if len(result.Included) > 0 {
-					result.Data[i].Relationships.PopulateIncludes(result.Included)
-				}
-				if !yield(result.Data[i], nil) {
-					return // Consumer requested early exit
-				}
+		for it.Next() {
+			if !yield(*it.Value(), nil) {
+				return // Consumer requested early exit
 			}
-
-			// Check if there are more pages
-			if result.Meta.Pagination == nil || result.Meta.Pagination.NextPage == nil {
-				break
-			}
-
-			pageNum++
+		}
+		if err := it.Err(); err != nil {
+			yield(schemas.DockerIntegration{}, err)
 		}
 	}
 }
 
 // ListDockerIntegrationsPaged returns a stateful iterator with access to pagination metadata.
-// Use this when you need PageInfo(), Remaining(), or Collect() methods.
+// Use this when you need PageInfo(), Remaining(), or Collect() methods, or to resume
+// an interrupted iteration from the iterator Checkpoint().
 //
 // Example:
 //
@@ -295,6 +239,10 @@ func (c *Client) ListDockerIntegrationsIter(ctx context.Context, opts *ListDocke
 //	if err := iter.Err(); err != nil {
 //	    // Handle error
 //	}
+//
+// Resuming from a checkpoint saved by an iterator with the same options:
+//
+//	iter := client.DockerIntegration.ListDockerIntegrationsPaged(ctx, opts).Resume(checkpoint)
 func (c *Client) ListDockerIntegrationsPaged(ctx context.Context, opts *ListDockerIntegrationsOptions) *client.Iterator[schemas.DockerIntegration] {
 	// Determine page size from opts or use default
 	pageSize := 20
@@ -325,26 +273,34 @@ func (c *Client) ListDockerIntegrationsPaged(ctx context.Context, opts *ListDock
 			Meta struct {
 				Pagination *client.Pagination `json:"pagination"`
 			} `json:"meta"`
-			Included []map[string]interface{} `json:"included"`
+			Included []json.RawMessage `json:"included"`
 		}
 		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 			return nil, nil, fmt.Errorf("failed to decode response: %w", err)
 		}
 
-		// Convert to slice of pointers and populate includes
+		// Convert to slice of pointers and resolve includes
+		included := client.NewIncluded(result.Included)
 		items := make([]*schemas.DockerIntegration, len(result.Data))
 		for i := range result.Data {
 			items[i] = &result.Data[i]
-			// Populate included resources into relationships
-			if len(result.Included) > 0 {
-				items[i].Relationships.PopulateIncludes(result.Included)
-			}
+			items[i].ResolveIncludes(included)
 		}
 
 		return items, result.Meta.Pagination, nil
 	}
 
-	return client.NewIterator[schemas.DockerIntegration](ctx, pageSize, fetchPage)
+	// Checkpoints only resume the iterators of the same listing
+	listOpts := ListDockerIntegrationsOptions{}
+	if opts != nil {
+		listOpts = *opts
+	}
+	listOpts.PageNumber, listOpts.PageSize = 0, 0
+	filters := client.HashOptions("ListDockerIntegrations", listOpts)
+
+	return client.NewIterator[schemas.DockerIntegration](ctx, pageSize, fetchPage).
+		Prefetch(c.httpClient.Prefetch()).
+		FiltersHash(filters)
 }
 
 // ListDockerIntegrationsOptions holds optional parameters for ListDockerIntegrations
@@ -356,7 +312,7 @@ type ListDockerIntegrationsOptions struct {
 	// The comma-separated list of attributes.
 	Sort []string
 	// The value of the fields[resource-type] parameter is a comma-separated list that refers to the name of the fields to be returned for the resource. An empty value indicates that no fields should be returned.
-	Fields map[string]interface{}
+	Fields client.Fields
 	Filter map[string]string
 }
 
@@ -384,15 +340,13 @@ func (c *Client) UpdateDockerIntegration(ctx context.Context, dockerIntegration
 
 	var result struct {
 		Data     schemas.DockerIntegration `json:"data"`
-		Included []map[string]interface{}  `json:"included"`
+		Included []json.RawMessage         `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
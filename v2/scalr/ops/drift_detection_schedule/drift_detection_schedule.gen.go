@@ -29,8 +29,7 @@ func (c *Client) CreateDriftDetectionScheduleRaw(ctx context.Context, req *schem
 
 	params := url.Values{}
 	if opts != nil {
-		// Handle parameter: Fields (map[string]interface{})
-		// Complex type map[string]interface{} - skip for now
+		opts.Fields.Encode(params)
 		// Add filters
 		for k, v := range opts.Filter {
 			params.Set("filter["+k+"]", v)
@@ -59,23 +58,21 @@ func (c *Client) CreateDriftDetectionSchedule(ctx context.Context, req *schemas.
 
 	var result struct {
 		Data     schemas.DriftDetectionSchedule `json:"data"`
-		Included []map[string]interface{}       `json:"included"`
+		Included []json.RawMessage              `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
 // CreateDriftDetectionScheduleOptions holds optional parameters for CreateDriftDetectionSchedule
 type CreateDriftDetectionScheduleOptions struct {
 	// The value of the fields[resource-type] parameter is a comma-separated list that refers to the name of the fields to be returned for the resource. An empty value indicates that no fields should be returned.
-	Fields map[string]interface{}
+	Fields client.Fields
 	Filter map[string]string
 }
 
@@ -120,16 +117,14 @@ func (c *Client) GetDriftDetectionSchedule(ctx context.Context, driftDetectionSc
 
 	var result struct {
 		Data     schemas.DriftDetectionSchedule `json:"data"`
-		Included []map[string]interface{}       `json:"included"`
+		Included []json.RawMessage              `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -155,15 +150,13 @@ func (c *Client) UpdateDriftDetectionSchedule(ctx context.Context, driftDetectio
 
 	var result struct {
 		Data     schemas.DriftDetectionSchedule `json:"data"`
-		Included []map[string]interface{}       `json:"included"`
+		Included []json.RawMessage              `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
@@ -77,17 +77,15 @@ func (c *Client) AddEnvironmentToFavorites(ctx context.Context, environment stri
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.Environment      `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.Environment `json:"data"`
+		Included []json.RawMessage   `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -127,8 +125,7 @@ func (c *Client) CreateEnvironmentRaw(ctx context.Context, req *schemas.Environm
 
 	params := url.Values{}
 	if opts != nil {
-		// Handle parameter: Fields (map[string]interface{})
-		// Complex type map[string]interface{} - skip for now
+		opts.Fields.Encode(params)
 		// Add filters
 		for k, v := range opts.Filter {
 			params.Set("filter["+k+"]", v)
@@ -156,24 +153,22 @@ func (c *Client) CreateEnvironment(ctx context.Context, req *schemas.Environment
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.Environment      `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.Environment `json:"data"`
+		Included []json.RawMessage   `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
 // CreateEnvironmentOptions holds optional parameters for CreateEnvironment
 type CreateEnvironmentOptions struct {
 	// The value of the fields[resource-type] parameter is a comma-separated list that refers to the name of the fields to be returned for the resource. An empty value indicates that no fields should be returned.
-	Fields map[string]interface{}
+	Fields client.Fields
 	Filter map[string]string
 }
 
@@ -274,8 +269,7 @@ func (c *Client) GetEnvironmentRaw(ctx context.Context, environment string, opts
 		if len(opts.Include) > 0 {
 			params.Set("include", strings.Join(opts.Include, ","))
 		}
-		// Handle parameter: Fields (map[string]interface{})
-		// Complex type map[string]interface{} - skip for now
+		opts.Fields.Encode(params)
 		// Add filters
 		for k, v := range opts.Filter {
 			params.Set("filter["+k+"]", v)
@@ -301,17 +295,15 @@ func (c *Client) GetEnvironment(ctx context.Context, environment string, opts *G
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.Environment      `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.Environment `json:"data"`
+		Included []json.RawMessage   `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -322,10 +314,57 @@ type GetEnvironmentOptions struct {
 	// The comma-separated list of relationship paths.
 	Include []string
 	// The value of the fields[resource-type] parameter is a comma-separated list that refers to the name of the fields to be returned for the resource. An empty value indicates that no fields should be returned.
-	Fields map[string]interface{}
+	Fields client.Fields
 	Filter map[string]string
 }
 
+// Relationship paths accepted by the Include option of GetEnvironment
+const (
+	GetEnvironmentIncludeAccount                                      = "account"
+	GetEnvironmentIncludeAccountBillingPlan                           = "account.billing-plan"
+	GetEnvironmentIncludeAccountIdentityProvider                      = "account.identity-provider"
+	GetEnvironmentIncludeAccountOwner                                 = "account.owner"
+	GetEnvironmentIncludeCreatedBy                                    = "created-by"
+	GetEnvironmentIncludeCreatedByIdentityProviders                   = "created-by.identity-providers"
+	GetEnvironmentIncludeCreatedByTeams                               = "created-by.teams"
+	GetEnvironmentIncludeDefaultProviderConfigurations                = "default-provider-configurations"
+	GetEnvironmentIncludeDefaultProviderConfigurationsAccount         = "default-provider-configurations.account"
+	GetEnvironmentIncludeDefaultProviderConfigurationsEnvironments    = "default-provider-configurations.environments"
+	GetEnvironmentIncludeDefaultProviderConfigurationsOwners          = "default-provider-configurations.owners"
+	GetEnvironmentIncludeDefaultProviderConfigurationsParameters      = "default-provider-configurations.parameters"
+	GetEnvironmentIncludeDefaultProviderConfigurationsTags            = "default-provider-configurations.tags"
+	GetEnvironmentIncludeDefaultWorkspaceAgentPool                    = "default-workspace-agent-pool"
+	GetEnvironmentIncludeDefaultWorkspaceAgentPoolAccount             = "default-workspace-agent-pool.account"
+	GetEnvironmentIncludeDefaultWorkspaceAgentPoolAgents              = "default-workspace-agent-pool.agents"
+	GetEnvironmentIncludeDefaultWorkspaceAgentPoolDefaultEnvironments = "default-workspace-agent-pool.default-environments"
+	GetEnvironmentIncludeDefaultWorkspaceAgentPoolEnvironment         = "default-workspace-agent-pool.environment"
+	GetEnvironmentIncludeDefaultWorkspaceAgentPoolEnvironments        = "default-workspace-agent-pool.environments"
+	GetEnvironmentIncludeDefaultWorkspaceAgentPoolWorkspaces          = "default-workspace-agent-pool.workspaces"
+	GetEnvironmentIncludeDriftDetectionSchedules                      = "drift-detection-schedules"
+	GetEnvironmentIncludeDriftDetectionSchedulesEnvironment           = "drift-detection-schedules.environment"
+	GetEnvironmentIncludeLockedBy                                     = "locked-by"
+	GetEnvironmentIncludeLockedByIdentityProviders                    = "locked-by.identity-providers"
+	GetEnvironmentIncludeLockedByTeams                                = "locked-by.teams"
+	GetEnvironmentIncludePolicyGroups                                 = "policy-groups"
+	GetEnvironmentIncludePolicyGroupsAccount                          = "policy-groups.account"
+	GetEnvironmentIncludePolicyGroupsEnvironments                     = "policy-groups.environments"
+	GetEnvironmentIncludePolicyGroupsPolicies                         = "policy-groups.policies"
+	GetEnvironmentIncludePolicyGroupsVcsProvider                      = "policy-groups.vcs-provider"
+	GetEnvironmentIncludePolicyGroupsVcsRevision                      = "policy-groups.vcs-revision"
+	GetEnvironmentIncludeProviderConfigurations                       = "provider-configurations"
+	GetEnvironmentIncludeProviderConfigurationsAccount                = "provider-configurations.account"
+	GetEnvironmentIncludeProviderConfigurationsEnvironments           = "provider-configurations.environments"
+	GetEnvironmentIncludeProviderConfigurationsOwners                 = "provider-configurations.owners"
+	GetEnvironmentIncludeProviderConfigurationsParameters             = "provider-configurations.parameters"
+	GetEnvironmentIncludeProviderConfigurationsTags                   = "provider-configurations.tags"
+	GetEnvironmentIncludeStorageProfile                               = "storage-profile"
+	GetEnvironmentIncludeTags                                         = "tags"
+	GetEnvironmentIncludeTagsAccount                                  = "tags.account"
+	GetEnvironmentIncludeUpdatedBy                                    = "updated-by"
+	GetEnvironmentIncludeUpdatedByIdentityProviders                   = "updated-by.identity-providers"
+	GetEnvironmentIncludeUpdatedByTeams                               = "updated-by.teams"
+)
+
 // This endpoint returns a list of [tags](/docs/tags-1), assigned to an environment.
 func (c *Client) ListEnvironmentTagsRaw(ctx context.Context, environment string, opts *ListEnvironmentTagsOptions) (*client.Response, error) {
 	path := "/environments/{environment}/relationships/tags"
@@ -368,7 +407,7 @@ func (c *Client) ListEnvironmentTags(ctx context.Context, environment string, op
 		Meta struct {
 			Pagination *client.Pagination `json:"pagination"`
 		} `json:"meta"`
-		Included []map[string]interface{} `json:"included"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
@@ -385,6 +424,7 @@ func (c *Client) ListEnvironmentTags(ctx context.Context, environment string, op
 // This is the recommended and simple way to iterate through all the results.
 //
 // The iterator automatically fetches pages as needed and handles errors inline.
+// Pages are fetched ahead in the background when the client is created with client.WithPrefetch.
 //
 // Example:
 //
@@ -396,69 +436,23 @@ func (c *Client) ListEnvironmentTags(ctx context.Context, environment string, op
 //	}
 func (c *Client) ListEnvironmentTagsIter(ctx context.Context, environment string, opts *ListEnvironmentTagsOptions) iter.Seq2[schemas.Tag, error] {
 	return func(yield func(schemas.Tag, error) bool) {
-		// Determine page size from opts or use default
-		pageSize := 20
-		if opts != nil && opts.PageSize > 0 {
-			pageSize = opts.PageSize
-		}
-
-		pageNum := 1
-
-		for {
-			// Check context
-			if err := ctx.Err(); err != nil {
-				yield(schemas.Tag{}, err)
-				return
-			}
+		it := c.ListEnvironmentTagsPaged(ctx, environment, opts)
+		defer it.Close() // Stops the prefetching on early exit
 
-			// Copy options and set page number
-			pageOpts := &ListEnvironmentTagsOptions{}
-			if opts != nil {
-				*pageOpts = *opts
+		for it.Next() {
+			if !yield(*it.Value(), nil) {
+				return // Consumer requested early exit
 			}
-			pageOpts.PageNumber = pageNum
-			pageOpts.PageSize = pageSize
-
-			// Fetch page using Raw method to get pagination metadata
-			resp, err := c.ListEnvironmentTagsRaw(ctx, environment, pageOpts)
-			if err != nil {
-				yield(schemas.Tag{}, err)
-				return
-			}
-			defer resp.Body.Close()
-
-			// Decode response
-			var result struct {
-				Data []schemas.Tag `json:"data"`
-				Meta struct {
-					Pagination *client.Pagination `json:"pagination"`
-				} `json:"meta"`
-				Included []map[string]interface{} `json:"included"`
-			}
-			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-				yield(schemas.Tag{}, fmt.Errorf("failed to decode response: %w", err))
-				return
-			}
-
-			// Yield each item
-			for i := range result.Data {
-				if !yield(result.Data[i], nil) {
-					return // Consumer requested early exit
-				}
-			}
-
-			// Check if there are more pages
-			if result.Meta.Pagination == nil || result.Meta.Pagination.NextPage == nil {
-				break
-			}
-
-			pageNum++
+		}
+		if err := it.Err(); err != nil {
+			yield(schemas.Tag{}, err)
 		}
 	}
 }
 
 // ListEnvironmentTagsPaged returns a stateful iterator with access to pagination metadata.
-// Use this when you need PageInfo(), Remaining(), or Collect() methods.
+// Use this when you need PageInfo(), Remaining(), or Collect() methods, or to resume
+// an interrupted iteration from the iterator Checkpoint().
 //
 // Example:
 //
@@ -471,6 +465,10 @@ func (c *Client) ListEnvironmentTagsIter(ctx context.Context, environment string
 //	if err := iter.Err(); err != nil {
 //	    // Handle error
 //	}
+//
+// Resuming from a checkpoint saved by an iterator with the same options:
+//
+//	iter := client.Environment.ListEnvironmentTagsPaged(ctx, environment, opts).Resume(checkpoint)
 func (c *Client) ListEnvironmentTagsPaged(ctx context.Context, environment string, opts *ListEnvironmentTagsOptions) *client.Iterator[schemas.Tag] {
 	// Determine page size from opts or use default
 	pageSize := 20
@@ -501,13 +499,13 @@ func (c *Client) ListEnvironmentTagsPaged(ctx context.Context, environment strin
 			Meta struct {
 				Pagination *client.Pagination `json:"pagination"`
 			} `json:"meta"`
-			Included []map[string]interface{} `json:"included"`
+			Included []json.RawMessage `json:"included"`
 		}
 		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 			return nil, nil, fmt.Errorf("failed to decode response: %w", err)
 		}
 
-		// Convert to slice of pointers and populate includes
+		// Convert to slice of pointers and resolve includes
 		items := make([]*schemas.Tag, len(result.Data))
 		for i := range result.Data {
 			items[i] = &result.Data[i]
@@ -516,7 +514,17 @@ func (c *Client) ListEnvironmentTagsPaged(ctx context.Context, environment strin
 		return items, result.Meta.Pagination, nil
 	}
 
-	return client.NewIterator[schemas.Tag](ctx, pageSize, fetchPage)
+	// Checkpoints only resume the iterators of the same listing
+	listOpts := ListEnvironmentTagsOptions{}
+	if opts != nil {
+		listOpts = *opts
+	}
+	listOpts.PageNumber, listOpts.PageSize = 0, 0
+	filters := client.HashOptions("ListEnvironmentTags", environment, listOpts)
+
+	return client.NewIterator[schemas.Tag](ctx, pageSize, fetchPage).
+		Prefetch(c.httpClient.Prefetch()).
+		FiltersHash(filters)
 }
 
 // ListEnvironmentTagsOptions holds optional parameters for ListEnvironmentTags
@@ -554,8 +562,7 @@ func (c *Client) ListEnvironmentsRaw(ctx context.Context, opts *ListEnvironments
 		if len(opts.Include) > 0 {
 			params.Set("include", strings.Join(opts.Include, ","))
 		}
-		// Handle parameter: Fields (map[string]interface{})
-		// Complex type map[string]interface{} - skip for now
+		opts.Fields.Encode(params)
 		// Add filters
 		for k, v := range opts.Filter {
 			params.Set("filter["+k+"]", v)
@@ -585,19 +592,18 @@ func (c *Client) ListEnvironments(ctx context.Context, opts *ListEnvironmentsOpt
 		Meta struct {
 			Pagination *client.Pagination `json:"pagination"`
 		} `json:"meta"`
-		Included []map[string]interface{} `json:"included"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
+	// Index included resources once to resolve the relationships
+	included := client.NewIncluded(result.Included)
 	resources := make([]*schemas.Environment, len(result.Data))
 	for i := range result.Data {
 		resources[i] = &result.Data[i]
-		// Populate included resources into relationships
-		if len(result.Included) > 0 {
-			resources[i].Relationships.PopulateIncludes(result.Included)
-		}
+		resources[i].ResolveIncludes(included)
 	}
 	return resources, nil
 }
@@ -606,6 +612,7 @@ func (c *Client) ListEnvironments(ctx context.Context, opts *ListEnvironmentsOpt
 // This is the recommended and simple way to iterate through all the results.
 //
 // The iterator automatically fetches pages as needed and handles errors inline.
+// Pages are fetched ahead in the background when the client is created with client.WithPrefetch.
 //
 // Example:
 //
@@ -617,73 +624,23 @@ func (c *Client) ListEnvironments(ctx context.Context, opts *ListEnvironmentsOpt
 //	}
 func (c *Client) ListEnvironmentsIter(ctx context.Context, opts *ListEnvironmentsOptions) iter.Seq2[schemas.Environment, error] {
 	return func(yield func(schemas.Environment, error) bool) {
-		// Determine page size from opts or use default
-		pageSize := 20
-		if opts != nil && opts.PageSize > 0 {
-			pageSize = opts.PageSize
-		}
-
-		pageNum := 1
+		it := c.ListEnvironmentsPaged(ctx, opts)
+		defer it.Close() // Stops the prefetching on early exit
 
-		for {
-			// Check context
-			if err := ctx.Err(); err != nil {
-				yield(schemas.Environment{}, err)
-				return
+		for it.Next() {
+			if !yield(*it.Value(), nil) {
+				return // Consumer requested early exit
 			}
-
-			// Copy options and set page number
-			pageOpts := &ListEnvironmentsOptions{}
-			if opts != nil {
-				*pageOpts = *opts
-			}
-			pageOpts.PageNumber = pageNum
-			pageOpts.PageSize = pageSize
-
-			// Fetch page using Raw method to get pagination metadata
-			resp, err := c.ListEnvironmentsRaw(ctx, pageOpts)
-			if err != nil {
-				yield(schemas.Environment{}, err)
-				return
-			}
-			defer resp.Body.Close()
-
-			// Decode response
-			var result struct {
-				Data []schemas.Environment `json:"data"`
-				Meta struct {
-					Pagination *client.Pagination `json:"pagination"`
-				} `json:"meta"`
-				Included []map[string]interface{} `json:"included"`
-			}
-			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-				yield(schemas.Environment{}, fmt.Errorf("failed to decode response: %w", err))
-				return
-			}
-
-			// Yield each item
-			for i := range result.Data {
-				// Populate included resources into relationships
-				if len(result.Included) > 0 {
-					result.Data[i].Relationships.PopulateIncludes(result.Included)
-				}
-				if !yield(result.Data[i], nil) {
-					return // Consumer requested early exit
-				}
-			}
-
-			// Check if there are more pages
-			if result.Meta.Pagination == nil || result.Meta.Pagination.NextPage == nil {
-				break
-			}
-
-			pageNum++
+		}
+		if err := it.Err(); err != nil {
+			yield(schemas.Environment{}, err)
 		}
 	}
 }
 
 // ListEnvironmentsPaged returns a stateful iterator with access to pagination metadata.
-// Use this when you need PageInfo(), Remaining(), or Collect() methods.
+// Use this when you need PageInfo(), Remaining(), or Collect() methods, or to resume
+// an interrupted iteration from the iterator Checkpoint().
 //
 // Example:
 //
@@ -696,6 +653,10 @@ func (c *Client) ListEnvironmentsIter(ctx context.Context, opts *ListEnvironment
 //	if err := iter.Err(); err != nil {
 //	    // Handle error
 //	}
+//
+// Resuming from a checkpoint saved by an iterator with the same options:
+//
+//	iter := client.Environment.ListEnvironmentsPaged(ctx, opts).Resume(checkpoint)
 func (c *Client) ListEnvironmentsPaged(ctx context.Context, opts *ListEnvironmentsOptions) *client.Iterator[schemas.Environment] {
 	// Determine page size from opts or use default
 	pageSize := 20
@@ -726,26 +687,34 @@ func (c *Client) ListEnvironmentsPaged(ctx context.Context, opts *ListEnvironmen
 			Meta struct {
 				Pagination *client.Pagination `json:"pagination"`
 			} `json:"meta"`
-			Included []map[string]interface{} `json:"included"`
+			Included []json.RawMessage `json:"included"`
 		}
 		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 			return nil, nil, fmt.Errorf("failed to decode response: %w", err)
 		}
 
-		// Convert to slice of pointers and populate includes
+		// Convert to slice of pointers and resolve includes
+		included := client.NewIncluded(result.Included)
 		items := make([]*schemas.Environment, len(result.Data))
 		for i := range result.Data {
 			items[i] = &result.Data[i]
-			// Populate included resources into relationships
-			if len(result.Included) > 0 {
-				items[i].Relationships.PopulateIncludes(result.Included)
-			}
+			items[i].ResolveIncludes(included)
 		}
 
 		return items, result.Meta.Pagination, nil
 	}
 
-	return client.NewIterator[schemas.Environment](ctx, pageSize, fetchPage)
+	// Checkpoints only resume the iterators of the same listing
+	listOpts := ListEnvironmentsOptions{}
+	if opts != nil {
+		listOpts = *opts
+	}
+	listOpts.PageNumber, listOpts.PageSize = 0, 0
+	filters := client.HashOptions("ListEnvironments", listOpts)
+
+	return client.NewIterator[schemas.Environment](ctx, pageSize, fetchPage).
+		Prefetch(c.httpClient.Prefetch()).
+		FiltersHash(filters)
 }
 
 // ListEnvironmentsOptions holds optional parameters for ListEnvironments
@@ -763,10 +732,57 @@ type ListEnvironmentsOptions struct {
 	// The comma-separated list of relationship paths.
 	Include []string
 	// The value of the fields[resource-type] parameter is a comma-separated list that refers to the name of the fields to be returned for the resource. An empty value indicates that no fields should be returned.
-	Fields map[string]interface{}
+	Fields client.Fields
 	Filter map[string]string
 }
 
+// Relationship paths accepted by the Include option of ListEnvironments
+const (
+	ListEnvironmentsIncludeAccount                                      = "account"
+	ListEnvironmentsIncludeAccountBillingPlan                           = "account.billing-plan"
+	ListEnvironmentsIncludeAccountIdentityProvider                      = "account.identity-provider"
+	ListEnvironmentsIncludeAccountOwner                                 = "account.owner"
+	ListEnvironmentsIncludeCreatedBy                                    = "created-by"
+	ListEnvironmentsIncludeCreatedByIdentityProviders                   = "created-by.identity-providers"
+	ListEnvironmentsIncludeCreatedByTeams                               = "created-by.teams"
+	ListEnvironmentsIncludeDefaultProviderConfigurations                = "default-provider-configurations"
+	ListEnvironmentsIncludeDefaultProviderConfigurationsAccount         = "default-provider-configurations.account"
+	ListEnvironmentsIncludeDefaultProviderConfigurationsEnvironments    = "default-provider-configurations.environments"
+	ListEnvironmentsIncludeDefaultProviderConfigurationsOwners          = "default-provider-configurations.owners"
+	ListEnvironmentsIncludeDefaultProviderConfigurationsParameters      = "default-provider-configurations.parameters"
+	ListEnvironmentsIncludeDefaultProviderConfigurationsTags            = "default-provider-configurations.tags"
+	ListEnvironmentsIncludeDefaultWorkspaceAgentPool                    = "default-workspace-agent-pool"
+	ListEnvironmentsIncludeDefaultWorkspaceAgentPoolAccount             = "default-workspace-agent-pool.account"
+	ListEnvironmentsIncludeDefaultWorkspaceAgentPoolAgents              = "default-workspace-agent-pool.agents"
+	ListEnvironmentsIncludeDefaultWorkspaceAgentPoolDefaultEnvironments = "default-workspace-agent-pool.default-environments"
+	ListEnvironmentsIncludeDefaultWorkspaceAgentPoolEnvironment         = "default-workspace-agent-pool.environment"
+	ListEnvironmentsIncludeDefaultWorkspaceAgentPoolEnvironments        = "default-workspace-agent-pool.environments"
+	ListEnvironmentsIncludeDefaultWorkspaceAgentPoolWorkspaces          = "default-workspace-agent-pool.workspaces"
+	ListEnvironmentsIncludeDriftDetectionSchedules                      = "drift-detection-schedules"
+	ListEnvironmentsIncludeDriftDetectionSchedulesEnvironment           = "drift-detection-schedules.environment"
+	ListEnvironmentsIncludeLockedBy                                     = "locked-by"
+	ListEnvironmentsIncludeLockedByIdentityProviders                    = "locked-by.identity-providers"
+	ListEnvironmentsIncludeLockedByTeams                                = "locked-by.teams"
+	ListEnvironmentsIncludePolicyGroups                                 = "policy-groups"
+	ListEnvironmentsIncludePolicyGroupsAccount                          = "policy-groups.account"
+	ListEnvironmentsIncludePolicyGroupsEnvironments                     = "policy-groups.environments"
+	ListEnvironmentsIncludePolicyGroupsPolicies                         = "policy-groups.policies"
+	ListEnvironmentsIncludePolicyGroupsVcsProvider                      = "policy-groups.vcs-provider"
+	ListEnvironmentsIncludePolicyGroupsVcsRevision                      = "policy-groups.vcs-revision"
+	ListEnvironmentsIncludeProviderConfigurations                       = "provider-configurations"
+	ListEnvironmentsIncludeProviderConfigurationsAccount                = "provider-configurations.account"
+	ListEnvironmentsIncludeProviderConfigurationsEnvironments           = "provider-configurations.environments"
+	ListEnvironmentsIncludeProviderConfigurationsOwners                 = "provider-configurations.owners"
+	ListEnvironmentsIncludeProviderConfigurationsParameters             = "provider-configurations.parameters"
+	ListEnvironmentsIncludeProviderConfigurationsTags                   = "provider-configurations.tags"
+	ListEnvironmentsIncludeStorageProfile                               = "storage-profile"
+	ListEnvironmentsIncludeTags                                         = "tags"
+	ListEnvironmentsIncludeTagsAccount                                  = "tags.account"
+	ListEnvironmentsIncludeUpdatedBy                                    = "updated-by"
+	ListEnvironmentsIncludeUpdatedByIdentityProviders                   = "updated-by.identity-providers"
+	ListEnvironmentsIncludeUpdatedByTeams                               = "updated-by.teams"
+)
+
 func (c *Client) ListFederatedEnvironmentsRaw(ctx context.Context, environment string, opts *ListFederatedEnvironmentsOptions) (*client.Response, error) {
 	path := "/environments/{environment}/relationships/federated-environments"
 	path = strings.ReplaceAll(path, "{environment}", url.PathEscape(environment))
@@ -807,7 +823,7 @@ func (c *Client) ListFederatedEnvironments(ctx context.Context, environment stri
 		Meta struct {
 			Pagination *client.Pagination `json:"pagination"`
 		} `json:"meta"`
-		Included []map[string]interface{} `json:"included"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
@@ -824,6 +840,7 @@ func (c *Client) ListFederatedEnvironments(ctx context.Context, environment stri
 // This is the recommended and simple way to iterate through all the results.
 //
 // The iterator automatically fetches pages as needed and handles errors inline.
+// Pages are fetched ahead in the background when the client is created with client.WithPrefetch.
 //
 // Example:
 //
@@ -835,69 +852,23 @@ func (c *Client) ListFederatedEnvironments(ctx context.Context, environment stri
 //	}
 func (c *Client) ListFederatedEnvironmentsIter(ctx context.Context, environment string, opts *ListFederatedEnvironmentsOptions) iter.Seq2[schemas.Environment, error] {
 	return func(yield func(schemas.Environment, error) bool) {
-		// Determine page size from opts or use default
-		pageSize := 20
-		if opts != nil && opts.PageSize > 0 {
-			pageSize = opts.PageSize
-		}
-
-		pageNum := 1
+		it := c.ListFederatedEnvironmentsPaged(ctx, environment, opts)
+		defer it.Close() // Stops the prefetching on early exit
 
-		for {
-			// Check context
-			if err := ctx.Err(); err != nil {
-				yield(schemas.Environment{}, err)
-				return
-			}
-
-			// Copy options and set page number
-			pageOpts := &ListFederatedEnvironmentsOptions{}
-			if opts != nil {
-				*pageOpts = *opts
-			}
-			pageOpts.PageNumber = pageNum
-			pageOpts.PageSize = pageSize
-
-			// Fetch page using Raw method to get pagination metadata
-			resp, err := c.ListFederatedEnvironmentsRaw(ctx, environment, pageOpts)
-			if err != nil {
-				yield(schemas.Environment{}, err)
-				return
-			}
-			defer resp.Body.Close()
-
-			// Decode response
-			var result struct {
-				Data []schemas.Environment `json:"data"`
-				Meta struct {
-					Pagination *client.Pagination `json:"pagination"`
-				} `json:"meta"`
-				Included []map[string]interface{} `json:"included"`
+		for it.Next() {
+			if !yield(*it.Value(), nil) {
+				return // Consumer requested early exit
 			}
-			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-				yield(schemas.Environment{}, fmt.Errorf("failed to decode response: %w", err))
-				return
-			}
-
-			// Yield each item
-			for i := range result.Data {
-				if !yield(result.Data[i], nil) {
-					return // Consumer requested early exit
-				}
-			}
-
-			// Check if there are more pages
-			if result.Meta.Pagination == nil || result.Meta.Pagination.NextPage == nil {
-				break
-			}
-
-			pageNum++
+		}
+		if err := it.Err(); err != nil {
+			yield(schemas.Environment{}, err)
 		}
 	}
 }
 
 // ListFederatedEnvironmentsPaged returns a stateful iterator with access to pagination metadata.
-// Use this when you need PageInfo(), Remaining(), or Collect() methods.
+// Use this when you need PageInfo(), Remaining(), or Collect() methods, or to resume
+// an interrupted iteration from the iterator Checkpoint().
 //
 // Example:
 //
@@ -910,6 +881,10 @@ func (c *Client) ListFederatedEnvironmentsIter(ctx context.Context, environment
 //	if err := iter.Err(); err != nil {
 //	    // Handle error
 //	}
+//
+// Resuming from a checkpoint saved by an iterator with the same options:
+//
+//	iter := client.Environment.ListFederatedEnvironmentsPaged(ctx, environment, opts).Resume(checkpoint)
 func (c *Client) ListFederatedEnvironmentsPaged(ctx context.Context, environment string, opts *ListFederatedEnvironmentsOptions) *client.Iterator[schemas.Environment] {
 	// Determine page size from opts or use default
 	pageSize := 20
@@ -940,13 +915,13 @@ func (c *Client) ListFederatedEnvironmentsPaged(ctx context.Context, environment
 			Meta struct {
 				Pagination *client.Pagination `json:"pagination"`
 			} `json:"meta"`
-			Included []map[string]interface{} `json:"included"`
+			Included []json.RawMessage `json:"included"`
 		}
 		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 			return nil, nil, fmt.Errorf("failed to decode response: %w", err)
 		}
 
-		// Convert to slice of pointers and populate includes
+		// Convert to slice of pointers and resolve includes
 		items := make([]*schemas.Environment, len(result.Data))
 		for i := range result.Data {
 			items[i] = &result.Data[i]
@@ -955,7 +930,17 @@ func (c *Client) ListFederatedEnvironmentsPaged(ctx context.Context, environment
 		return items, result.Meta.Pagination, nil
 	}
 
-	return client.NewIterator[schemas.Environment](ctx, pageSize, fetchPage)
+	// Checkpoints only resume the iterators of the same listing
+	listOpts := ListFederatedEnvironmentsOptions{}
+	if opts != nil {
+		listOpts = *opts
+	}
+	listOpts.PageNumber, listOpts.PageSize = 0, 0
+	filters := client.HashOptions("ListFederatedEnvironments", environment, listOpts)
+
+	return client.NewIterator[schemas.Environment](ctx, pageSize, fetchPage).
+		Prefetch(c.httpClient.Prefetch()).
+		FiltersHash(filters)
 }
 
 // ListFederatedEnvironmentsOptions holds optional parameters for ListFederatedEnvironments
@@ -990,17 +975,15 @@ func (c *Client) LockEnvironment(ctx context.Context, environment string, req *s
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.Environment      `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.Environment `json:"data"`
+		Included []json.RawMessage   `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -1025,17 +1008,15 @@ func (c *Client) RemoveEnvironmentFromFavorites(ctx context.Context, environment
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.Environment      `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.Environment `json:"data"`
+		Included []json.RawMessage   `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -1122,17 +1103,15 @@ func (c *Client) UnlockEnvironment(ctx context.Context, environment string) (*sc
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.Environment      `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.Environment `json:"data"`
+		Included []json.RawMessage   `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -1142,8 +1121,7 @@ func (c *Client) UpdateEnvironmentRaw(ctx context.Context, environment string, r
 
 	params := url.Values{}
 	if opts != nil {
-		// Handle parameter: Fields (map[string]interface{})
-		// Complex type map[string]interface{} - skip for now
+		opts.Fields.Encode(params)
 		// Add filters
 		for k, v := range opts.Filter {
 			params.Set("filter["+k+"]", v)
@@ -1170,23 +1148,21 @@ func (c *Client) UpdateEnvironment(ctx context.Context, environment string, req
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.Environment      `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.Environment `json:"data"`
+		Included []json.RawMessage   `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
 // UpdateEnvironmentOptions holds optional parameters for UpdateEnvironment
 type UpdateEnvironmentOptions struct {
 	// The value of the fields[resource-type] parameter is a comma-separated list that refers to the name of the fields to be returned for the resource. An empty value indicates that no fields should be returned.
-	Fields map[string]interface{}
+	Fields client.Fields
 	Filter map[string]string
 }
@@ -46,8 +46,8 @@ func (c *Client) CreateGpgKey(ctx context.Context, req *schemas.GPGKeyRequest) (
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.GPGKey           `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.GPGKey    `json:"data"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
@@ -86,8 +86,7 @@ func (c *Client) GetGpgKeyRaw(ctx context.Context, gpgKey string, opts *GetGpgKe
 
 	params := url.Values{}
 	if opts != nil {
-		// Handle parameter: Fields (map[string]interface{})
-		// Complex type map[string]interface{} - skip for now
+		opts.Fields.Encode(params)
 		// Add filters
 		for k, v := range opts.Filter {
 			params.Set("filter["+k+"]", v)
@@ -113,8 +112,8 @@ func (c *Client) GetGpgKey(ctx context.Context, gpgKey string, opts *GetGpgKeyOp
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.GPGKey           `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.GPGKey    `json:"data"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
@@ -126,7 +125,7 @@ func (c *Client) GetGpgKey(ctx context.Context, gpgKey string, opts *GetGpgKeyOp
 // GetGpgKeyOptions holds optional parameters for GetGpgKey
 type GetGpgKeyOptions struct {
 	// The value of the fields[resource-type] parameter is a comma-separated list that refers to the name of the fields to be returned for the resource. An empty value indicates that no fields should be returned.
-	Fields map[string]interface{}
+	Fields client.Fields
 	Filter map[string]string
 }
 
@@ -149,8 +148,7 @@ func (c *Client) ListGpgKeysRaw(ctx context.Context, opts *ListGpgKeysOptions) (
 		if len(opts.Sort) > 0 {
 			params.Set("sort", strings.Join(opts.Sort, ","))
 		}
-		// Handle parameter: Fields (map[string]interface{})
-		// Complex type map[string]interface{} - skip for now
+		opts.Fields.Encode(params)
 		// Add filters
 		for k, v := range opts.Filter {
 			params.Set("filter["+k+"]", v)
@@ -180,7 +178,7 @@ func (c *Client) ListGpgKeys(ctx context.Context, opts *ListGpgKeysOptions) ([]*
 		Meta struct {
 			Pagination *client.Pagination `json:"pagination"`
 		} `json:"meta"`
-		Included []map[string]interface{} `json:"included"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
@@ -197,6 +195,7 @@ func (c *Client) ListGpgKeys(ctx context.Context, opts *ListGpgKeysOptions) ([]*
 // This is the recommended and simple way to iterate through all the results.
 //
 // The iterator automatically fetches pages as needed and handles errors inline.
+// Pages are fetched ahead in the background when the client is created with client.WithPrefetch.
 //
 // Example:
 //
@@ -208,69 +207,23 @@ func (c *Client) ListGpgKeys(ctx context.Context, opts *ListGpgKeysOptions) ([]*
 //	}
 func (c *Client) ListGpgKeysIter(ctx context.Context, opts *ListGpgKeysOptions) iter.Seq2[schemas.GPGKey, error] {
 	return func(yield func(schemas.GPGKey, error) bool) {
-		// Determine page size from opts or use default
-		pageSize := 20
-		if opts != nil && opts.PageSize > 0 {
-			pageSize = opts.PageSize
-		}
-
-		pageNum := 1
-
-		for {
-			// Check context
-			if err := ctx.Err(); err != nil {
-				yield(schemas.GPGKey{}, err)
-				return
-			}
-
-			// Copy options and set page number
-			pageOpts := &ListGpgKeysOptions{}
-			if opts != nil {
-				*pageOpts = *opts
-			}
-			pageOpts.PageNumber = pageNum
-			pageOpts.PageSize = pageSize
-
-			// Fetch page using Raw method to get pagination metadata
-			resp, err := c.ListGpgKeysRaw(ctx, pageOpts)
-			if err != nil {
-				yield(schemas.GPGKey{}, err)
-				return
-			}
-			defer resp.Body.Close()
-
-			// Decode response
-			var result struct {
-				Data []schemas.GPGKey `json:"data"`
-				Meta struct {
-					Pagination *client.Pagination `json:"pagination"`
-				} `json:"meta"`
-				Included []map[string]interface{} `json:"included"`
-			}
-			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-				yield(schemas.GPGKey{}, fmt.Errorf("failed to decode response: %w", err))
-				return
-			}
+		it := c.ListGpgKeysPaged(ctx, opts)
+		defer it.Close() // Stops the prefetching on early exit
 
-			// Yield each item
-			for i := range result.Data {
-				if !yield(result.Data[i], nil) {
-					return // Consumer requested early exit
-				}
+		for it.Next() {
+			if !yield(*it.Value(), nil) {
+				return // Consumer requested early exit
 			}
-
-			// Check if there are more pages
-			if result.Meta.Pagination == nil || result.Meta.Pagination.NextPage == nil {
-				break
-			}
-
-			pageNum++
+		}
+		if err := it.Err(); err != nil {
+			yield(schemas.GPGKey{}, err)
 		}
 	}
 }
 
 // ListGpgKeysPaged returns a stateful iterator with access to pagination metadata.
-// Use this when you need PageInfo(), Remaining(), or Collect() methods.
+// Use this when you need PageInfo(), Remaining(), or Collect() methods, or to resume
+// an interrupted iteration from the iterator Checkpoint().
 //
 // Example:
 //
@@ -283,6 +236,10 @@ func (c *Client) ListGpgKeysIter(ctx context.Context, opts *ListGpgKeysOptions)
 //	if err := iter.Err(); err != nil {
 //	    // Handle error
 //	}
+//
+// Resuming from a checkpoint saved by an iterator with the same options:
+//
+//	iter := client.GPGKey.ListGpgKeysPaged(ctx, opts).Resume(checkpoint)
 func (c *Client) ListGpgKeysPaged(ctx context.Context, opts *ListGpgKeysOptions) *client.Iterator[schemas.GPGKey] {
 	// Determine page size from opts or use default
 	pageSize := 20
@@ -313,13 +270,13 @@ func (c *Client) ListGpgKeysPaged(ctx context.Context, opts *ListGpgKeysOptions)
 			Meta struct {
 				Pagination *client.Pagination `json:"pagination"`
 			} `json:"meta"`
-			Included []map[string]interface{} `json:"included"`
+			Included []json.RawMessage `json:"included"`
 		}
 		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 			return nil, nil, fmt.Errorf("failed to decode response: %w", err)
 		}
 
-		// Convert to slice of pointers and populate includes
+		// Convert to slice of pointers and resolve includes
 		items := make([]*schemas.GPGKey, len(result.Data))
 		for i := range result.Data {
 			items[i] = &result.Data[i]
@@ -328,7 +285,17 @@ func (c *Client) ListGpgKeysPaged(ctx context.Context, opts *ListGpgKeysOptions)
 		return items, result.Meta.Pagination, nil
 	}
 
-	return client.NewIterator[schemas.GPGKey](ctx, pageSize, fetchPage)
+	// Checkpoints only resume the iterators of the same listing
+	listOpts := ListGpgKeysOptions{}
+	if opts != nil {
+		listOpts = *opts
+	}
+	listOpts.PageNumber, listOpts.PageSize = 0, 0
+	filters := client.HashOptions("ListGpgKeys", listOpts)
+
+	return client.NewIterator[schemas.GPGKey](ctx, pageSize, fetchPage).
+		Prefetch(c.httpClient.Prefetch()).
+		FiltersHash(filters)
 }
 
 // ListGpgKeysOptions holds optional parameters for ListGpgKeys
@@ -342,7 +309,7 @@ type ListGpgKeysOptions struct {
 	// The comma-separated list of attributes.
 	Sort []string
 	// The value of the fields[resource-type] parameter is a comma-separated list that refers to the name of the fields to be returned for the resource. An empty value indicates that no fields should be returned.
-	Fields map[string]interface{}
+	Fields client.Fields
 	Filter map[string]string
 }
 
@@ -369,8 +336,8 @@ func (c *Client) UpdateGpgKey(ctx context.Context, gpgKey string, req *schemas.G
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.GPGKey           `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.GPGKey    `json:"data"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
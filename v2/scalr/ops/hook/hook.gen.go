@@ -46,17 +46,15 @@ func (c *Client) CreateHook(ctx context.Context, req *schemas.HookRequest) (*sch
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.Hook             `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.Hook      `json:"data"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -93,8 +91,7 @@ func (c *Client) GetHookRaw(ctx context.Context, hook string, opts *GetHookOptio
 		if len(opts.Include) > 0 {
 			params.Set("include", strings.Join(opts.Include, ","))
 		}
-		// Handle parameter: Fields (map[string]interface{})
-		// Complex type map[string]interface{} - skip for now
+		opts.Fields.Encode(params)
 		// Add filters
 		for k, v := range opts.Filter {
 			params.Set("filter["+k+"]", v)
@@ -120,17 +117,15 @@ func (c *Client) GetHook(ctx context.Context, hook string, opts *GetHookOptions)
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.Hook             `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.Hook      `json:"data"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -139,10 +134,39 @@ type GetHookOptions struct {
 	// The comma-separated list of relationship paths.
 	Include []string
 	// The value of the fields[resource-type] parameter is a comma-separated list that refers to the name of the fields to be returned for the resource. An empty value indicates that no fields should be returned.
-	Fields map[string]interface{}
+	Fields client.Fields
 	Filter map[string]string
 }
 
+// Relationship paths accepted by the Include option of GetHook
+const (
+	GetHookIncludeAccount                                   = "account"
+	GetHookIncludeAccountBillingPlan                        = "account.billing-plan"
+	GetHookIncludeAccountIdentityProvider                   = "account.identity-provider"
+	GetHookIncludeAccountOwner                              = "account.owner"
+	GetHookIncludeEnvironments                              = "environments"
+	GetHookIncludeEnvironmentsAccount                       = "environments.account"
+	GetHookIncludeEnvironmentsCreatedBy                     = "environments.created-by"
+	GetHookIncludeEnvironmentsDefaultProviderConfigurations = "environments.default-provider-configurations"
+	GetHookIncludeEnvironmentsDefaultWorkspaceAgentPool     = "environments.default-workspace-agent-pool"
+	GetHookIncludeEnvironmentsDriftDetectionSchedules       = "environments.drift-detection-schedules"
+	GetHookIncludeEnvironmentsLockedBy                      = "environments.locked-by"
+	GetHookIncludeEnvironmentsPolicyGroups                  = "environments.policy-groups"
+	GetHookIncludeEnvironmentsProviderConfigurations        = "environments.provider-configurations"
+	GetHookIncludeEnvironmentsStorageProfile                = "environments.storage-profile"
+	GetHookIncludeEnvironmentsTags                          = "environments.tags"
+	GetHookIncludeEnvironmentsUpdatedBy                     = "environments.updated-by"
+	GetHookIncludeReadme                                    = "readme"
+	GetHookIncludeUpdatedBy                                 = "updated-by"
+	GetHookIncludeUpdatedByIdentityProviders                = "updated-by.identity-providers"
+	GetHookIncludeUpdatedByTeams                            = "updated-by.teams"
+	GetHookIncludeVcsProvider                               = "vcs-provider"
+	GetHookIncludeVcsProviderAccount                        = "vcs-provider.account"
+	GetHookIncludeVcsProviderAgentPool                      = "vcs-provider.agent-pool"
+	GetHookIncludeVcsProviderEnvironments                   = "vcs-provider.environments"
+	GetHookIncludeVcsRevision                               = "vcs-revision"
+)
+
 // This endpoint returns a list of hooks by various filters.
 func (c *Client) ListHooksRaw(ctx context.Context, opts *ListHooksOptions) (*client.Response, error) {
 	path := "/hooks"
@@ -165,8 +189,7 @@ func (c *Client) ListHooksRaw(ctx context.Context, opts *ListHooksOptions) (*cli
 		if len(opts.Include) > 0 {
 			params.Set("include", strings.Join(opts.Include, ","))
 		}
-		// Handle parameter: Fields (map[string]interface{})
-		// Complex type map[string]interface{} - skip for now
+		opts.Fields.Encode(params)
 		// Add filters
 		for k, v := range opts.Filter {
 			params.Set("filter["+k+"]", v)
@@ -196,19 +219,18 @@ func (c *Client) ListHooks(ctx context.Context, opts *ListHooksOptions) ([]*sche
 		Meta struct {
 			Pagination *client.Pagination `json:"pagination"`
 		} `json:"meta"`
-		Included []map[string]interface{} `json:"included"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
+	// Index included resources once to resolve the relationships
+	included := client.NewIncluded(result.Included)
 	resources := make([]*schemas.Hook, len(result.Data))
 	for i := range result.Data {
 		resources[i] = &result.Data[i]
-		// Populate included resources into relationships
-		if len(result.Included) > 0 {
-			resources[i].Relationships.PopulateIncludes(result.Included)
-		}
+		resources[i].ResolveIncludes(included)
 	}
 	return resources, nil
 }
@@ -217,6 +239,7 @@ func (c *Client) ListHooks(ctx context.Context, opts *ListHooksOptions) ([]*sche
 // This is the recommended and simple way to iterate through all the results.
 //
 // The iterator automatically fetches pages as needed and handles errors inline.
+// Pages are fetched ahead in the background when the client is created with client.WithPrefetch.
 //
 // Example:
 //
@@ -228,73 +251,23 @@ func (c *Client) ListHooks(ctx context.Context, opts *ListHooksOptions) ([]*sche
 //	}
 func (c *Client) ListHooksIter(ctx context.Context, opts *ListHooksOptions) iter.Seq2[schemas.Hook, error] {
 	return func(yield func(schemas.Hook, error) bool) {
-		// Determine page size from opts or use default
-		pageSize := 20
-		if opts != nil && opts.PageSize > 0 {
-			pageSize = opts.PageSize
-		}
-
-		pageNum := 1
-
-		for {
-			// Check context
-			if err := ctx.Err(); err != nil {
-				yield(schemas.Hook{}, err)
-				return
-			}
-
-			// Copy options and set page number
-			pageOpts := &ListHooksOptions{}
-			if opts != nil {
-				*pageOpts = *opts
-			}
-			pageOpts.PageNumber = pageNum
-			pageOpts.PageSize = pageSize
-
-			// Fetch page using Raw method to get pagination metadata
-			resp, err := c.ListHooksRaw(ctx, pageOpts)
-			if err != nil {
-				yield(schemas.Hook{}, err)
-				return
-			}
-			defer resp.Body.Close()
-
-			// Decode response
-			var result struct {
-				Data []schemas.Hook `json:"data"`
-				Meta struct {
-					Pagination *client.Pagination `json:"pagination"`
-				} `json:"meta"`
-				Included []map[string]interface{} `json:"included"`
-			}
-			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-				yield(schemas.Hook{}, fmt.Errorf("failed to decode response: %w", err))
-				return
-			}
+		it := c.ListHooksPaged(ctx, opts)
+		defer it.Close() // Stops the prefetching on early exit
 
-			// Yield each item
-			for i := range result.Data {
-				// Populate included resources into relationships
-				if len(result.Included) > 0 {
-					result.Data[i].Relationships.PopulateIncludes(result.Included)
-				}
-				if !yield(result.Data[i], nil) {
-					return // Consumer requested early exit
-				}
+		for it.Next() {
+			if !yield(*it.Value(), nil) {
+				return // Consumer requested early exit
 			}
-
-			// Check if there are more pages
-			if result.Meta.Pagination == nil || result.Meta.Pagination.NextPage == nil {
-				break
-			}
-
-			pageNum++
+		}
+		if err := it.Err(); err != nil {
+			yield(schemas.Hook{}, err)
 		}
 	}
 }
 
 // ListHooksPaged returns a stateful iterator with access to pagination metadata.
-// Use this when you need PageInfo(), Remaining(), or Collect() methods.
+// Use this when you need PageInfo(), Remaining(), or Collect() methods, or to resume
+// an interrupted iteration from the iterator Checkpoint().
 //
 // Example:
 //
@@ -307,6 +280,10 @@ func (c *Client) ListHooksIter(ctx context.Context, opts *ListHooksOptions) iter
 //	if err := iter.Err(); err != nil {
 //	    // Handle error
 //	}
+//
+// Resuming from a checkpoint saved by an iterator with the same options:
+//
+//	iter := client.Hook.ListHooksPaged(ctx, opts).Resume(checkpoint)
 func (c *Client) ListHooksPaged(ctx context.Context, opts *ListHooksOptions) *client.Iterator[schemas.Hook] {
 	// Determine page size from opts or use default
 	pageSize := 20
@@ -337,26 +314,34 @@ func (c *Client) ListHooksPaged(ctx context.Context, opts *ListHooksOptions) *cl
 			Meta struct {
 				Pagination *client.Pagination `json:"pagination"`
 			} `json:"meta"`
-			Included []map[string]interface{} `json:"included"`
+			Included []json.RawMessage `json:"included"`
 		}
 		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 			return nil, nil, fmt.Errorf("failed to decode response: %w", err)
 		}
 
-		// Convert to slice of pointers and populate includes
+		// Convert to slice of pointers and resolve includes
+		included := client.NewIncluded(result.Included)
 		items := make([]*schemas.Hook, len(result.Data))
 		for i := range result.Data {
 			items[i] = &result.Data[i]
-			// Populate included resources into relationships
-			if len(result.Included) > 0 {
-				items[i].Relationships.PopulateIncludes(result.Included)
-			}
+			items[i].ResolveIncludes(included)
 		}
 
 		return items, result.Meta.Pagination, nil
 	}
 
-	return client.NewIterator[schemas.Hook](ctx, pageSize, fetchPage)
+	// Checkpoints only resume the iterators of the same listing
+	listOpts := ListHooksOptions{}
+	if opts != nil {
+		listOpts = *opts
+	}
+	listOpts.PageNumber, listOpts.PageSize = 0, 0
+	filters := client.HashOptions("ListHooks", listOpts)
+
+	return client.NewIterator[schemas.Hook](ctx, pageSize, fetchPage).
+		Prefetch(c.httpClient.Prefetch()).
+		FiltersHash(filters)
 }
 
 // ListHooksOptions holds optional parameters for ListHooks
@@ -372,10 +357,39 @@ type ListHooksOptions struct {
 	// The comma-separated list of relationship paths.
 	Include []string
 	// The value of the fields[resource-type] parameter is a comma-separated list that refers to the name of the fields to be returned for the resource. An empty value indicates that no fields should be returned.
-	Fields map[string]interface{}
+	Fields client.Fields
 	Filter map[string]string
 }
 
+// Relationship paths accepted by the Include option of ListHooks
+const (
+	ListHooksIncludeAccount                                   = "account"
+	ListHooksIncludeAccountBillingPlan                        = "account.billing-plan"
+	ListHooksIncludeAccountIdentityProvider                   = "account.identity-provider"
+	ListHooksIncludeAccountOwner                              = "account.owner"
+	ListHooksIncludeEnvironments                              = "environments"
+	ListHooksIncludeEnvironmentsAccount                       = "environments.account"
+	ListHooksIncludeEnvironmentsCreatedBy                     = "environments.created-by"
+	ListHooksIncludeEnvironmentsDefaultProviderConfigurations = "environments.default-provider-configurations"
+	ListHooksIncludeEnvironmentsDefaultWorkspaceAgentPool     = "environments.default-workspace-agent-pool"
+	ListHooksIncludeEnvironmentsDriftDetectionSchedules       = "environments.drift-detection-schedules"
+	ListHooksIncludeEnvironmentsLockedBy                      = "environments.locked-by"
+	ListHooksIncludeEnvironmentsPolicyGroups                  = "environments.policy-groups"
+	ListHooksIncludeEnvironmentsProviderConfigurations        = "environments.provider-configurations"
+	ListHooksIncludeEnvironmentsStorageProfile                = "environments.storage-profile"
+	ListHooksIncludeEnvironmentsTags                          = "environments.tags"
+	ListHooksIncludeEnvironmentsUpdatedBy                     = "environments.updated-by"
+	ListHooksIncludeReadme                                    = "readme"
+	ListHooksIncludeUpdatedBy                                 = "updated-by"
+	ListHooksIncludeUpdatedByIdentityProviders                = "updated-by.identity-providers"
+	ListHooksIncludeUpdatedByTeams                            = "updated-by.teams"
+	ListHooksIncludeVcsProvider                               = "vcs-provider"
+	ListHooksIncludeVcsProviderAccount                        = "vcs-provider.account"
+	ListHooksIncludeVcsProviderAgentPool                      = "vcs-provider.agent-pool"
+	ListHooksIncludeVcsProviderEnvironments                   = "vcs-provider.environments"
+	ListHooksIncludeVcsRevision                               = "vcs-revision"
+)
+
 // Triggers a resync of the Hook.
 func (c *Client) ResyncHookRaw(ctx context.Context, hook string) (*client.Response, error) {
 	path := "/hooks/{hook}/actions/resync"
@@ -422,16 +436,14 @@ func (c *Client) UpdateHook(ctx context.Context, hook string, req *schemas.HookR
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.Hook             `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.Hook      `json:"data"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
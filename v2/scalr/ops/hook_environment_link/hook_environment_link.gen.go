@@ -47,16 +47,14 @@ func (c *Client) CreateHookEnvironmentLink(ctx context.Context, req *schemas.Hoo
 
 	var result struct {
 		Data     schemas.HookEnvironmentLink `json:"data"`
-		Included []map[string]interface{}    `json:"included"`
+		Included []json.RawMessage           `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -93,8 +91,7 @@ func (c *Client) GetHookEnvironmentLinkRaw(ctx context.Context, hookEnvironmentL
 		if len(opts.Include) > 0 {
 			params.Set("include", strings.Join(opts.Include, ","))
 		}
-		// Handle parameter: Fields (map[string]interface{})
-		// Complex type map[string]interface{} - skip for now
+		opts.Fields.Encode(params)
 		// Add filters
 		for k, v := range opts.Filter {
 			params.Set("filter["+k+"]", v)
@@ -121,16 +118,14 @@ func (c *Client) GetHookEnvironmentLink(ctx context.Context, hookEnvironmentLink
 
 	var result struct {
 		Data     schemas.HookEnvironmentLink `json:"data"`
-		Included []map[string]interface{}    `json:"included"`
+		Included []json.RawMessage           `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -139,10 +134,38 @@ type GetHookEnvironmentLinkOptions struct {
 	// The comma-separated list of relationship paths.
 	Include []string
 	// The value of the fields[resource-type] parameter is a comma-separated list that refers to the name of the fields to be returned for the resource. An empty value indicates that no fields should be returned.
-	Fields map[string]interface{}
+	Fields client.Fields
 	Filter map[string]string
 }
 
+// Relationship paths accepted by the Include option of GetHookEnvironmentLink
+const (
+	GetHookEnvironmentLinkIncludeEnvironment                              = "environment"
+	GetHookEnvironmentLinkIncludeEnvironmentAccount                       = "environment.account"
+	GetHookEnvironmentLinkIncludeEnvironmentCreatedBy                     = "environment.created-by"
+	GetHookEnvironmentLinkIncludeEnvironmentDefaultProviderConfigurations = "environment.default-provider-configurations"
+	GetHookEnvironmentLinkIncludeEnvironmentDefaultWorkspaceAgentPool     = "environment.default-workspace-agent-pool"
+	GetHookEnvironmentLinkIncludeEnvironmentDriftDetectionSchedules       = "environment.drift-detection-schedules"
+	GetHookEnvironmentLinkIncludeEnvironmentLockedBy                      = "environment.locked-by"
+	GetHookEnvironmentLinkIncludeEnvironmentPolicyGroups                  = "environment.policy-groups"
+	GetHookEnvironmentLinkIncludeEnvironmentProviderConfigurations        = "environment.provider-configurations"
+	GetHookEnvironmentLinkIncludeEnvironmentStorageProfile                = "environment.storage-profile"
+	GetHookEnvironmentLinkIncludeEnvironmentTags                          = "environment.tags"
+	GetHookEnvironmentLinkIncludeEnvironmentUpdatedBy                     = "environment.updated-by"
+	GetHookEnvironmentLinkIncludeHook                                     = "hook"
+	GetHookEnvironmentLinkIncludeHookAccount                              = "hook.account"
+	GetHookEnvironmentLinkIncludeHookEnvironments                         = "hook.environments"
+	GetHookEnvironmentLinkIncludeHookReadme                               = "hook.readme"
+	GetHookEnvironmentLinkIncludeHookUpdatedBy                            = "hook.updated-by"
+	GetHookEnvironmentLinkIncludeHookVcsProvider                          = "hook.vcs-provider"
+	GetHookEnvironmentLinkIncludeHookVcsRevision                          = "hook.vcs-revision"
+	GetHookEnvironmentLinkIncludeVcsProvider                              = "vcs-provider"
+	GetHookEnvironmentLinkIncludeVcsProviderAccount                       = "vcs-provider.account"
+	GetHookEnvironmentLinkIncludeVcsProviderAgentPool                     = "vcs-provider.agent-pool"
+	GetHookEnvironmentLinkIncludeVcsProviderEnvironments                  = "vcs-provider.environments"
+	GetHookEnvironmentLinkIncludeVcsRevision                              = "vcs-revision"
+)
+
 // List all hook-environment links.
 func (c *Client) ListHookEnvironmentLinksRaw(ctx context.Context, opts *ListHookEnvironmentLinksOptions) (*client.Response, error) {
 	path := "/hook-environment-links"
@@ -165,8 +188,7 @@ func (c *Client) ListHookEnvironmentLinksRaw(ctx context.Context, opts *ListHook
 		if len(opts.Include) > 0 {
 			params.Set("include", strings.Join(opts.Include, ","))
 		}
-		// Handle parameter: Fields (map[string]interface{})
-		// Complex type map[string]interface{} - skip for now
+		opts.Fields.Encode(params)
 		// Add filters
 		for k, v := range opts.Filter {
 			params.Set("filter["+k+"]", v)
@@ -196,19 +218,18 @@ func (c *Client) ListHookEnvironmentLinks(ctx context.Context, opts *ListHookEnv
 		Meta struct {
 			Pagination *client.Pagination `json:"pagination"`
 		} `json:"meta"`
-		Included []map[string]interface{} `json:"included"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
+	// Index included resources once to resolve the relationships
+	included := client.NewIncluded(result.Included)
 	resources := make([]*schemas.HookEnvironmentLink, len(result.Data))
 	for i := range result.Data {
 		resources[i] = &result.Data[i]
-		// Populate included resources into relationships
-		if len(result.Included) > 0 {
-			resources[i].Relationships.PopulateIncludes(result.Included)
-		}
+		resources[i].ResolveIncludes(included)
 	}
 	return resources, nil
 }
@@ -217,6 +238,7 @@ func (c *Client) ListHookEnvironmentLinks(ctx context.Context, opts *ListHookEnv
 // This is the recommended and simple way to iterate through all the results.
 //
 // The iterator automatically fetches pages as needed and handles errors inline.
+// Pages are fetched ahead in the background when the client is created with client.WithPrefetch.
 //
 // Example:
 //
@@ -228,73 +250,23 @@ func (c *Client) ListHookEnvironmentLinks(ctx context.Context, opts *ListHookEnv
 //	}
 func (c *Client) ListHookEnvironmentLinksIter(ctx context.Context, opts *ListHookEnvironmentLinksOptions) iter.Seq2[schemas.HookEnvironmentLink, error] {
 	return func(yield func(schemas.HookEnvironmentLink, error) bool) {
-		// Determine page size from opts or use default
-		pageSize := 20
-		if opts != nil && opts.PageSize > 0 {
-			pageSize = opts.PageSize
-		}
-
-		pageNum := 1
-
-		for {
-			// Check context
-			if err := ctx.Err(); err != nil {
-				yield(schemas.HookEnvironmentLink{}, err)
-				return
-			}
-
-			// Copy options and set page number
-			pageOpts := &ListHookEnvironmentLinksOptions{}
-			if opts != nil {
-				*pageOpts = *opts
-			}
-			pageOpts.PageNumber = pageNum
-			pageOpts.PageSize = pageSize
-
-			// Fetch page using Raw method to get pagination metadata
-			resp, err := c.ListHookEnvironmentLinksRaw(ctx, pageOpts)
-			if err != nil {
-				yield(schemas.HookEnvironmentLink{}, err)
-				return
-			}
-			defer resp.Body.Close()
-
-			// Decode response
-			var result struct {
-				Data []schemas.HookEnvironmentLink `json:"data"`
-				Meta struct {
-					Pagination *client.Pagination `json:"pagination"`
-				} `json:"meta"`
-				Included []map[string]interface{} `json:"included"`
-			}
-			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-				yield(schemas.HookEnvironmentLink{}, fmt.Errorf("failed to decode response: %w", err))
-				return
-			}
+		it := c.ListHookEnvironmentLinksPaged(ctx, opts)
+		defer it.Close() // Stops the prefetching on early exit
 
-			// Yield each item
-			for i := range result.Data {
-				// Populate included resources into relationships
-				if len(result.Included) > 0 {
-					result.Data[i].Relationships.PopulateIncludes(result.Included)
-				}
-				if !yield(result.Data[i], nil) {
-					return // Consumer requested early exit
-				}
+		for it.Next() {
+			if !yield(*it.Value(), nil) {
+				return // Consumer requested early exit
 			}
-
-			// Check if there are more pages
-			if result.Meta.Pagination == nil || result.Meta.Pagination.NextPage == nil {
-				break
-			}
-
-			pageNum++
+		}
+		if err := it.Err(); err != nil {
+			yield(schemas.HookEnvironmentLink{}, err)
 		}
 	}
 }
 
 // ListHookEnvironmentLinksPaged returns a stateful iterator with access to pagination metadata.
-// Use this when you need PageInfo(), Remaining(), or Collect() methods.
+// Use this when you need PageInfo(), Remaining(), or Collect() methods, or to resume
+// an interrupted iteration from the iterator Checkpoint().
 //
 // Example:
 //
@@ -307,6 +279,10 @@ func (c *Client) ListHookEnvironmentLinksIter(ctx context.Context, opts *ListHoo
 //	if err := iter.Err(); err != nil {
 //	    // Handle error
 //	}
+//
+// Resuming from a checkpoint saved by an iterator with the same options:
+//
+//	iter := client.HookEnvironmentLink.ListHookEnvironmentLinksPaged(ctx, opts).Resume(checkpoint)
 func (c *Client) ListHookEnvironmentLinksPaged(ctx context.Context, opts *ListHookEnvironmentLinksOptions) *client.Iterator[schemas.HookEnvironmentLink] {
 	// Determine page size from opts or use default
 	pageSize := 20
@@ -337,26 +313,34 @@ func (c *Client) ListHookEnvironmentLinksPaged(ctx context.Context, opts *ListHo
 			Meta struct {
 				Pagination *client.Pagination `json:"pagination"`
 			} `json:"meta"`
-			Included []map[string]interface{} `json:"included"`
+			Included []json.RawMessage `json:"included"`
 		}
 		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 			return nil, nil, fmt.Errorf("failed to decode response: %w", err)
 		}
 
-		// Convert to slice of pointers and populate includes
+		// Convert to slice of pointers and resolve includes
+		included := client.NewIncluded(result.Included)
 		items := make([]*schemas.HookEnvironmentLink, len(result.Data))
 		for i := range result.Data {
 			items[i] = &result.Data[i]
-			// Populate included resources into relationships
-			if len(result.Included) > 0 {
-				items[i].Relationships.PopulateIncludes(result.Included)
-			}
+			items[i].ResolveIncludes(included)
 		}
 
 		return items, result.Meta.Pagination, nil
 	}
 
-	return client.NewIterator[schemas.HookEnvironmentLink](ctx, pageSize, fetchPage)
+	// Checkpoints only resume the iterators of the same listing
+	listOpts := ListHookEnvironmentLinksOptions{}
+	if opts != nil {
+		listOpts = *opts
+	}
+	listOpts.PageNumber, listOpts.PageSize = 0, 0
+	filters := client.HashOptions("ListHookEnvironmentLinks", listOpts)
+
+	return client.NewIterator[schemas.HookEnvironmentLink](ctx, pageSize, fetchPage).
+		Prefetch(c.httpClient.Prefetch()).
+		FiltersHash(filters)
 }
 
 // ListHookEnvironmentLinksOptions holds optional parameters for ListHookEnvironmentLinks
@@ -372,10 +356,38 @@ type ListHookEnvironmentLinksOptions struct {
 	// The comma-separated list of relationship paths.
 	Include []string
 	// The value of the fields[resource-type] parameter is a comma-separated list that refers to the name of the fields to be returned for the resource. An empty value indicates that no fields should be returned.
-	Fields map[string]interface{}
+	Fields client.Fields
 	Filter map[string]string
 }
 
+// Relationship paths accepted by the Include option of ListHookEnvironmentLinks
+const (
+	ListHookEnvironmentLinksIncludeEnvironment                              = "environment"
+	ListHookEnvironmentLinksIncludeEnvironmentAccount                       = "environment.account"
+	ListHookEnvironmentLinksIncludeEnvironmentCreatedBy                     = "environment.created-by"
+	ListHookEnvironmentLinksIncludeEnvironmentDefaultProviderConfigurations = "environment.default-provider-configurations"
+	ListHookEnvironmentLinksIncludeEnvironmentDefaultWorkspaceAgentPool     = "environment.default-workspace-agent-pool"
+	ListHookEnvironmentLinksIncludeEnvironmentDriftDetectionSchedules       = "environment.drift-detection-schedules"
+	ListHookEnvironmentLinksIncludeEnvironmentLockedBy                      = "environment.locked-by"
+	ListHookEnvironmentLinksIncludeEnvironmentPolicyGroups                  = "environment.policy-groups"
+	ListHookEnvironmentLinksIncludeEnvironmentProviderConfigurations        = "environment.provider-configurations"
+	ListHookEnvironmentLinksIncludeEnvironmentStorageProfile                = "environment.storage-profile"
+	ListHookEnvironmentLinksIncludeEnvironmentTags                          = "environment.tags"
+	ListHookEnvironmentLinksIncludeEnvironmentUpdatedBy                     = "environment.updated-by"
+	ListHookEnvironmentLinksIncludeHook                                     = "hook"
+	ListHookEnvironmentLinksIncludeHookAccount                              = "hook.account"
+	ListHookEnvironmentLinksIncludeHookEnvironments                         = "hook.environments"
+	ListHookEnvironmentLinksIncludeHookReadme                               = "hook.readme"
+	ListHookEnvironmentLinksIncludeHookUpdatedBy                            = "hook.updated-by"
+	ListHookEnvironmentLinksIncludeHookVcsProvider                          = "hook.vcs-provider"
+	ListHookEnvironmentLinksIncludeHookVcsRevision                          = "hook.vcs-revision"
+	ListHookEnvironmentLinksIncludeVcsProvider                              = "vcs-provider"
+	ListHookEnvironmentLinksIncludeVcsProviderAccount                       = "vcs-provider.account"
+	ListHookEnvironmentLinksIncludeVcsProviderAgentPool                     = "vcs-provider.agent-pool"
+	ListHookEnvironmentLinksIncludeVcsProviderEnvironments                  = "vcs-provider.environments"
+	ListHookEnvironmentLinksIncludeVcsRevision                              = "vcs-revision"
+)
+
 // Update a hook-environment link.
 func (c *Client) UpdateHookEnvironmentLinkRaw(ctx context.Context, hookEnvironmentLink string, req *schemas.HookEnvironmentLinkRequest) (*client.Response, error) {
 	path := "/hook-environment-links/{hook_environment_link}"
@@ -400,15 +412,13 @@ func (c *Client) UpdateHookEnvironmentLink(ctx context.Context, hookEnvironmentL
 
 	var result struct {
 		Data     schemas.HookEnvironmentLink `json:"data"`
-		Included []map[string]interface{}    `json:"included"`
+		Included []json.RawMessage           `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
@@ -61,16 +61,14 @@ func (c *Client) CreateInfracostIntegration(ctx context.Context, req *schemas.In
 
 	var result struct {
 		Data     schemas.InfracostIntegration `json:"data"`
-		Included []map[string]interface{}     `json:"included"`
+		Included []json.RawMessage            `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -81,6 +79,22 @@ type CreateInfracostIntegrationOptions struct {
 	Filter  map[string]string
 }
 
+// Relationship paths accepted by the Include option of CreateInfracostIntegration
+const (
+	CreateInfracostIntegrationIncludeEnvironments                              = "environments"
+	CreateInfracostIntegrationIncludeEnvironmentsAccount                       = "environments.account"
+	CreateInfracostIntegrationIncludeEnvironmentsCreatedBy                     = "environments.created-by"
+	CreateInfracostIntegrationIncludeEnvironmentsDefaultProviderConfigurations = "environments.default-provider-configurations"
+	CreateInfracostIntegrationIncludeEnvironmentsDefaultWorkspaceAgentPool     = "environments.default-workspace-agent-pool"
+	CreateInfracostIntegrationIncludeEnvironmentsDriftDetectionSchedules       = "environments.drift-detection-schedules"
+	CreateInfracostIntegrationIncludeEnvironmentsLockedBy                      = "environments.locked-by"
+	CreateInfracostIntegrationIncludeEnvironmentsPolicyGroups                  = "environments.policy-groups"
+	CreateInfracostIntegrationIncludeEnvironmentsProviderConfigurations        = "environments.provider-configurations"
+	CreateInfracostIntegrationIncludeEnvironmentsStorageProfile                = "environments.storage-profile"
+	CreateInfracostIntegrationIncludeEnvironmentsTags                          = "environments.tags"
+	CreateInfracostIntegrationIncludeEnvironmentsUpdatedBy                     = "environments.updated-by"
+)
+
 func (c *Client) DeleteInfracostIntegrationRaw(ctx context.Context, infracostIntegration string) (*client.Response, error) {
 	path := "/integrations/infracost/{infracost_integration}"
 	path = strings.ReplaceAll(path, "{infracost_integration}", url.PathEscape(infracostIntegration))
@@ -138,16 +152,14 @@ func (c *Client) GetInfracostIntegration(ctx context.Context, infracostIntegrati
 
 	var result struct {
 		Data     schemas.InfracostIntegration `json:"data"`
-		Included []map[string]interface{}     `json:"included"`
+		Included []json.RawMessage            `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -158,6 +170,22 @@ type GetInfracostIntegrationOptions struct {
 	Filter  map[string]string
 }
 
+// Relationship paths accepted by the Include option of GetInfracostIntegration
+const (
+	GetInfracostIntegrationIncludeEnvironments                              = "environments"
+	GetInfracostIntegrationIncludeEnvironmentsAccount                       = "environments.account"
+	GetInfracostIntegrationIncludeEnvironmentsCreatedBy                     = "environments.created-by"
+	GetInfracostIntegrationIncludeEnvironmentsDefaultProviderConfigurations = "environments.default-provider-configurations"
+	GetInfracostIntegrationIncludeEnvironmentsDefaultWorkspaceAgentPool     = "environments.default-workspace-agent-pool"
+	GetInfracostIntegrationIncludeEnvironmentsDriftDetectionSchedules       = "environments.drift-detection-schedules"
+	GetInfracostIntegrationIncludeEnvironmentsLockedBy                      = "environments.locked-by"
+	GetInfracostIntegrationIncludeEnvironmentsPolicyGroups                  = "environments.policy-groups"
+	GetInfracostIntegrationIncludeEnvironmentsProviderConfigurations        = "environments.provider-configurations"
+	GetInfracostIntegrationIncludeEnvironmentsStorageProfile                = "environments.storage-profile"
+	GetInfracostIntegrationIncludeEnvironmentsTags                          = "environments.tags"
+	GetInfracostIntegrationIncludeEnvironmentsUpdatedBy                     = "environments.updated-by"
+)
+
 // This endpoint returns a list of Infracost integrations.
 func (c *Client) ListInfracostIntegrationsRaw(ctx context.Context, opts *ListInfracostIntegrationsOptions) (*client.Response, error) {
 	path := "/integrations/infracost"
@@ -205,19 +233,18 @@ func (c *Client) ListInfracostIntegrations(ctx context.Context, opts *ListInfrac
 		Meta struct {
 			Pagination *client.Pagination `json:"pagination"`
 		} `json:"meta"`
-		Included []map[string]interface{} `json:"included"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
+	// Index included resources once to resolve the relationships
+	included := client.NewIncluded(result.Included)
 	resources := make([]*schemas.InfracostIntegration, len(result.Data))
 	for i := range result.Data {
 		resources[i] = &result.Data[i]
-		// Populate included resources into relationships
-		if len(result.Included) > 0 {
-			resources[i].Relationships.PopulateIncludes(result.Included)
-		}
+		resources[i].ResolveIncludes(included)
 	}
 	return resources, nil
 }
@@ -226,6 +253,7 @@ func (c *Client) ListInfracostIntegrations(ctx context.Context, opts *ListInfrac
 // This is the recommended and simple way to iterate through all the results.
 //
 // The iterator automatically fetches pages as needed and handles errors inline.
+// Pages are fetched ahead in the background when the client is created with client.WithPrefetch.
 //
 // Example:
 //
@@ -237,73 +265,23 @@ func (c *Client) ListInfracostIntegrations(ctx context.Context, opts *ListInfrac
 //	}
 func (c *Client) ListInfracostIntegrationsIter(ctx context.Context, opts *ListInfracostIntegrationsOptions) iter.Seq2[schemas.InfracostIntegration, error] {
 	return func(yield func(schemas.InfracostIntegration, error) bool) {
-		// Determine page size from opts or use default
-		pageSize := 20
-		if opts != nil && opts.PageSize > 0 {
-			pageSize = opts.PageSize
-		}
-
-		pageNum := 1
-
-		for {
-			// Check context
-			if err := ctx.Err(); err != nil {
-				yield(schemas.InfracostIntegration{}, err)
-				return
-			}
-
-			// Copy options and set page number
-			pageOpts := &ListInfracostIntegrationsOptions{}
-			if opts != nil {
-				*pageOpts = *opts
-			}
-			pageOpts.PageNumber = pageNum
-			pageOpts.PageSize = pageSize
-
-			// Fetch page using Raw method to get pagination metadata
-			resp, err := c.ListInfracostIntegrationsRaw(ctx, pageOpts)
-			if err != nil {
-				yield(schemas.InfracostIntegration{}, err)
-				return
-			}
-			defer resp.Body.Close()
-
-			// Decode response
-			var result struct {
-				Data []schemas.InfracostIntegration `json:"data"`
-				Meta struct {
-					Pagination *client.Pagination `json:"pagination"`
-				} `json:"meta"`
-				Included []map[string]interface{} `json:"included"`
-			}
-			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-				yield(schemas.InfracostIntegration{}, fmt.Errorf("failed to decode response: %w", err))
-				return
-			}
+		it := c.ListInfracostIntegrationsPaged(ctx, opts)
+		defer it.Close() // Stops the prefetching on early exit
 
-			// Yield each item
-			for i := range result.Data {
-				// Populate included resources into relationships
-				if len(result.Included) > 0 {
-					result.Data[i].Relationships.PopulateIncludes(result.Included)
-				}
-				if !yield(result.Data[i], nil) {
-					return // Consumer requested early exit
-				}
+		for it.Next() {
+			if !yield(*it.Value(), nil) {
+				return // Consumer requested early exit
 			}
-
-			// Check if there are more pages
-			if result.Meta.Pagination == nil || result.Meta.Pagination.NextPage == nil {
-				break
-			}
-
-			pageNum++
+		}
+		if err := it.Err(); err != nil {
+			yield(schemas.InfracostIntegration{}, err)
 		}
 	}
 }
 
 // ListInfracostIntegrationsPaged returns a stateful iterator with access to pagination metadata.
-// Use this when you need PageInfo(), Remaining(), or Collect() methods.
+// Use this when you need PageInfo(), Remaining(), or Collect() methods, or to resume
+// an interrupted iteration from the iterator Checkpoint().
 //
 // Example:
 //
@@ -316,6 +294,10 @@ func (c *Client) ListInfracostIntegrationsIter(ctx context.Context, opts *ListIn
 //	if err := iter.Err(); err != nil {
 //	    // Handle error
 //	}
+//
+// Resuming from a checkpoint saved by an iterator with the same options:
+//
+//	iter := client.InfracostIntegration.ListInfracostIntegrationsPaged(ctx, opts).Resume(checkpoint)
 func (c *Client) ListInfracostIntegrationsPaged(ctx context.Context, opts *ListInfracostIntegrationsOptions) *client.Iterator[schemas.InfracostIntegration] {
 	// Determine page size from opts or use default
 	pageSize := 20
@@ -346,26 +328,34 @@ func (c *Client) ListInfracostIntegrationsPaged(ctx context.Context, opts *ListI
 			Meta struct {
 				Pagination *client.Pagination `json:"pagination"`
 			} `json:"meta"`
-			Included []map[string]interface{} `json:"included"`
+			Included []json.RawMessage `json:"included"`
 		}
 		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 			return nil, nil, fmt.Errorf("failed to decode response: %w", err)
 		}
 
-		// Convert to slice of pointers and populate includes
+		// Convert to slice of pointers and resolve includes
+		included := client.NewIncluded(result.Included)
 		items := make([]*schemas.InfracostIntegration, len(result.Data))
 		for i := range result.Data {
 			items[i] = &result.Data[i]
-			// Populate included resources into relationships
-			if len(result.Included) > 0 {
-				items[i].Relationships.PopulateIncludes(result.Included)
-			}
+			items[i].ResolveIncludes(included)
 		}
 
 		return items, result.Meta.Pagination, nil
 	}
 
-	return client.NewIterator[schemas.InfracostIntegration](ctx, pageSize, fetchPage)
+	// Checkpoints only resume the iterators of the same listing
+	listOpts := ListInfracostIntegrationsOptions{}
+	if opts != nil {
+		listOpts = *opts
+	}
+	listOpts.PageNumber, listOpts.PageSize = 0, 0
+	filters := client.HashOptions("ListInfracostIntegrations", listOpts)
+
+	return client.NewIterator[schemas.InfracostIntegration](ctx, pageSize, fetchPage).
+		Prefetch(c.httpClient.Prefetch()).
+		FiltersHash(filters)
 }
 
 // ListInfracostIntegrationsOptions holds optional parameters for ListInfracostIntegrations
@@ -381,6 +371,22 @@ type ListInfracostIntegrationsOptions struct {
 	Filter map[string]string
 }
 
+// Relationship paths accepted by the Include option of ListInfracostIntegrations
+const (
+	ListInfracostIntegrationsIncludeEnvironments                              = "environments"
+	ListInfracostIntegrationsIncludeEnvironmentsAccount                       = "environments.account"
+	ListInfracostIntegrationsIncludeEnvironmentsCreatedBy                     = "environments.created-by"
+	ListInfracostIntegrationsIncludeEnvironmentsDefaultProviderConfigurations = "environments.default-provider-configurations"
+	ListInfracostIntegrationsIncludeEnvironmentsDefaultWorkspaceAgentPool     = "environments.default-workspace-agent-pool"
+	ListInfracostIntegrationsIncludeEnvironmentsDriftDetectionSchedules       = "environments.drift-detection-schedules"
+	ListInfracostIntegrationsIncludeEnvironmentsLockedBy                      = "environments.locked-by"
+	ListInfracostIntegrationsIncludeEnvironmentsPolicyGroups                  = "environments.policy-groups"
+	ListInfracostIntegrationsIncludeEnvironmentsProviderConfigurations        = "environments.provider-configurations"
+	ListInfracostIntegrationsIncludeEnvironmentsStorageProfile                = "environments.storage-profile"
+	ListInfracostIntegrationsIncludeEnvironmentsTags                          = "environments.tags"
+	ListInfracostIntegrationsIncludeEnvironmentsUpdatedBy                     = "environments.updated-by"
+)
+
 // This endpoint updates Infracost integration.
 func (c *Client) UpdateInfracostIntegrationRaw(ctx context.Context, infracostIntegration string, req *schemas.InfracostIntegrationRequest, opts *UpdateInfracostIntegrationOptions) (*client.Response, error) {
 	path := "/integrations/infracost/{infracost_integration}"
@@ -419,16 +425,14 @@ func (c *Client) UpdateInfracostIntegration(ctx context.Context, infracostIntegr
 
 	var result struct {
 		Data     schemas.InfracostIntegration `json:"data"`
-		Included []map[string]interface{}     `json:"included"`
+		Included []json.RawMessage            `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -438,3 +442,19 @@ type UpdateInfracostIntegrationOptions struct {
 	Include []string
 	Filter  map[string]string
 }
+
+// Relationship paths accepted by the Include option of UpdateInfracostIntegration
+const (
+	UpdateInfracostIntegrationIncludeEnvironments                              = "environments"
+	UpdateInfracostIntegrationIncludeEnvironmentsAccount                       = "environments.account"
+	UpdateInfracostIntegrationIncludeEnvironmentsCreatedBy                     = "environments.created-by"
+	UpdateInfracostIntegrationIncludeEnvironmentsDefaultProviderConfigurations = "environments.default-provider-configurations"
+	UpdateInfracostIntegrationIncludeEnvironmentsDefaultWorkspaceAgentPool     = "environments.default-workspace-agent-pool"
+	UpdateInfracostIntegrationIncludeEnvironmentsDriftDetectionSchedules       = "environments.drift-detection-schedules"
+	UpdateInfracostIntegrationIncludeEnvironmentsLockedBy                      = "environments.locked-by"
+	UpdateInfracostIntegrationIncludeEnvironmentsPolicyGroups                  = "environments.policy-groups"
+	UpdateInfracostIntegrationIncludeEnvironmentsProviderConfigurations        = "environments.provider-configurations"
+	UpdateInfracostIntegrationIncludeEnvironmentsStorageProfile                = "environments.storage-profile"
+	UpdateInfracostIntegrationIncludeEnvironmentsTags                          = "environments.tags"
+	UpdateInfracostIntegrationIncludeEnvironmentsUpdatedBy                     = "environments.updated-by"
+)
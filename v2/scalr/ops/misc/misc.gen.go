@@ -69,17 +69,15 @@ func (c *Client) CreateWorkspaceSshKeyLink(ctx context.Context, workspace string
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.Workspace        `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.Workspace `json:"data"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -47,17 +47,15 @@ func (c *Client) CreateModule(ctx context.Context, req *schemas.ModuleRequest) (
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.Module           `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.Module    `json:"data"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -119,17 +117,15 @@ func (c *Client) GetModule(ctx context.Context, module string, opts *GetModuleOp
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.Module           `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.Module    `json:"data"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -140,6 +136,48 @@ type GetModuleOptions struct {
 	Filter  map[string]string
 }
 
+// Relationship paths accepted by the Include option of GetModule
+const (
+	GetModuleIncludeAccount                                  = "account"
+	GetModuleIncludeAccountBillingPlan                       = "account.billing-plan"
+	GetModuleIncludeAccountIdentityProvider                  = "account.identity-provider"
+	GetModuleIncludeAccountOwner                             = "account.owner"
+	GetModuleIncludeCreatedBy                                = "created-by"
+	GetModuleIncludeCreatedByIdentityProviders               = "created-by.identity-providers"
+	GetModuleIncludeCreatedByTeams                           = "created-by.teams"
+	GetModuleIncludeDockerIntegration                        = "docker-integration"
+	GetModuleIncludeDockerIntegrationAccount                 = "docker-integration.account"
+	GetModuleIncludeEnvironment                              = "environment"
+	GetModuleIncludeEnvironmentAccount                       = "environment.account"
+	GetModuleIncludeEnvironmentCreatedBy                     = "environment.created-by"
+	GetModuleIncludeEnvironmentDefaultProviderConfigurations = "environment.default-provider-configurations"
+	GetModuleIncludeEnvironmentDefaultWorkspaceAgentPool     = "environment.default-workspace-agent-pool"
+	GetModuleIncludeEnvironmentDriftDetectionSchedules       = "environment.drift-detection-schedules"
+	GetModuleIncludeEnvironmentLockedBy                      = "environment.locked-by"
+	GetModuleIncludeEnvironmentPolicyGroups                  = "environment.policy-groups"
+	GetModuleIncludeEnvironmentProviderConfigurations        = "environment.provider-configurations"
+	GetModuleIncludeEnvironmentStorageProfile                = "environment.storage-profile"
+	GetModuleIncludeEnvironmentTags                          = "environment.tags"
+	GetModuleIncludeEnvironmentUpdatedBy                     = "environment.updated-by"
+	GetModuleIncludeLatestModuleVersion                      = "latest-module-version"
+	GetModuleIncludeLatestModuleVersionModule                = "latest-module-version.module"
+	GetModuleIncludeLatestModuleVersionVcsRevision           = "latest-module-version.vcs-revision"
+	GetModuleIncludeModuleVersion                            = "module-version"
+	GetModuleIncludeModuleVersionModule                      = "module-version.module"
+	GetModuleIncludeModuleVersionVcsRevision                 = "module-version.vcs-revision"
+	GetModuleIncludeModuleVersions                           = "module-versions"
+	GetModuleIncludeModuleVersionsModule                     = "module-versions.module"
+	GetModuleIncludeModuleVersionsVcsRevision                = "module-versions.vcs-revision"
+	GetModuleIncludeNamespace                                = "namespace"
+	GetModuleIncludeNamespaceEnvironments                    = "namespace.environments"
+	GetModuleIncludeNamespaceModules                         = "namespace.modules"
+	GetModuleIncludeNamespaceOwners                          = "namespace.owners"
+	GetModuleIncludeVcsProvider                              = "vcs-provider"
+	GetModuleIncludeVcsProviderAccount                       = "vcs-provider.account"
+	GetModuleIncludeVcsProviderAgentPool                     = "vcs-provider.agent-pool"
+	GetModuleIncludeVcsProviderEnvironments                  = "vcs-provider.environments"
+)
+
 // Returns the changelog content for the module.
 func (c *Client) GetModuleChangelogRaw(ctx context.Context, module string) (*client.Response, error) {
 	path := "/modules/{module}/changelog"
@@ -189,8 +227,7 @@ func (c *Client) ListModulesRaw(ctx context.Context, opts *ListModulesOptions) (
 		if len(opts.Sort) > 0 {
 			params.Set("sort", strings.Join(opts.Sort, ","))
 		}
-		// Handle parameter: Fields (map[string]interface{})
-		// Complex type map[string]interface{} - skip for now
+		opts.Fields.Encode(params)
 		// Add filters
 		for k, v := range opts.Filter {
 			params.Set("filter["+k+"]", v)
@@ -220,19 +257,18 @@ func (c *Client) ListModules(ctx context.Context, opts *ListModulesOptions) ([]*
 		Meta struct {
 			Pagination *client.Pagination `json:"pagination"`
 		} `json:"meta"`
-		Included []map[string]interface{} `json:"included"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
+	// Index included resources once to resolve the relationships
+	included := client.NewIncluded(result.Included)
 	resources := make([]*schemas.Module, len(result.Data))
 	for i := range result.Data {
 		resources[i] = &result.Data[i]
-		// Populate included resources into relationships
-		if len(result.Included) > 0 {
-			resources[i].Relationships.PopulateIncludes(result.Included)
-		}
+		resources[i].ResolveIncludes(included)
 	}
 	return resources, nil
 }
@@ -241,6 +277,7 @@ func (c *Client) ListModules(ctx context.Context, opts *ListModulesOptions) ([]*
 // This is the recommended and simple way to iterate through all the results.
 //
 // The iterator automatically fetches pages as needed and handles errors inline.
+// Pages are fetched ahead in the background when the client is created with client.WithPrefetch.
 //
 // Example:
 //
@@ -252,73 +289,23 @@ func (c *Client) ListModules(ctx context.Context, opts *ListModulesOptions) ([]*
 //	}
 func (c *Client) ListModulesIter(ctx context.Context, opts *ListModulesOptions) iter.Seq2[schemas.Module, error] {
 	return func(yield func(schemas.Module, error) bool) {
-		// Determine page size from opts or use default
-		pageSize := 20
-		if opts != nil && opts.PageSize > 0 {
-			pageSize = opts.PageSize
-		}
-
-		pageNum := 1
-
-		for {
-			// Check context
-			if err := ctx.Err(); err != nil {
-				yield(schemas.Module{}, err)
-				return
-			}
-
-			// Copy options and set page number
-			pageOpts := &ListModulesOptions{}
-			if opts != nil {
-				*pageOpts = *opts
-			}
-			pageOpts.PageNumber = pageNum
-			pageOpts.PageSize = pageSize
-
-			// Fetch page using Raw method to get pagination metadata
-			resp, err := c.ListModulesRaw(ctx, pageOpts)
-			if err != nil {
-				yield(schemas.Module{}, err)
-				return
-			}
-			defer resp.Body.Close()
-
-			// Decode response
-			var result struct {
-				Data []schemas.Module `json:"data"`
-				Meta struct {
-					Pagination *client.Pagination `json:"pagination"`
-				} `json:"meta"`
-				Included []map[string]interface{} `json:"included"`
-			}
-			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-				yield(schemas.Module{}, fmt.Errorf("failed to decode response: %w", err))
-				return
-			}
-
-			// Yield each item
-			for i := range result.Data {
-				// Populate included resources into relationships
-				if len(result.Included) > 0 {
-					result.Data[i].Relationships.PopulateIncludes(result.Included)
-				}
-				if !yield(result.Data[i], nil) {
-					return // Consumer requested early exit
-				}
-			}
+		it := c.ListModulesPaged(ctx, opts)
+		defer it.Close() // Stops the prefetching on early exit
 
-			// Check if there are more pages
-			if result.Meta.Pagination == nil || result.Meta.Pagination.NextPage == nil {
-				break
+		for it.Next() {
+			if !yield(*it.Value(), nil) {
+				return // Consumer requested early exit
 			}
-
-			pageNum++
+		}
+		if err := it.Err(); err != nil {
+			yield(schemas.Module{}, err)
 		}
 	}
 }
 
 // ListModulesPaged returns a stateful iterator with access to pagination metadata.
-// Use this when you need PageInfo(), Remaining(), or Collect() methods.
+// Use this when you need PageInfo(), Remaining(), or Collect() methods, or to resume
+// an interrupted iteration from the iterator Checkpoint().
 //
 // Example:
 //
@@ -331,6 +318,10 @@ func (c *Client) ListModulesIter(ctx context.Context, opts *ListModulesOptions)
 //	if err := iter.Err(); err != nil {
 //	    // Handle error
 //	}
+//
+// Resuming from a checkpoint saved by an iterator with the same options:
+//
+//	iter := client.Module.ListModulesPaged(ctx, opts).Resume(checkpoint)
 func (c *Client) ListModulesPaged(ctx context.Context, opts *ListModulesOptions) *client.Iterator[schemas.Module] {
 	// Determine page size from opts or use default
 	pageSize := 20
@@ -361,26 +352,34 @@ func (c *Client) ListModulesPaged(ctx context.Context, opts *ListModulesOptions)
 			Meta struct {
 				Pagination *client.Pagination `json:"pagination"`
 			} `json:"meta"`
-			Included []map[string]interface{} `json:"included"`
+			Included []json.RawMessage `json:"included"`
 		}
 		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 			return nil, nil, fmt.Errorf("failed to decode response: %w", err)
 		}
 
-		// Convert to slice of pointers and populate includes
+		// Convert to slice of pointers and resolve includes
+		included := client.NewIncluded(result.Included)
 		items := make([]*schemas.Module, len(result.Data))
 		for i := range result.Data {
 			items[i] = &result.Data[i]
-			// Populate included resources into relationships
-			if len(result.Included) > 0 {
-				items[i].Relationships.PopulateIncludes(result.Included)
-			}
+			items[i].ResolveIncludes(included)
 		}
 
 		return items, result.Meta.Pagination, nil
 	}
 
-	return client.NewIterator[schemas.Module](ctx, pageSize, fetchPage)
+	// Checkpoints only resume the iterators of the same listing
+	listOpts := ListModulesOptions{}
+	if opts != nil {
+		listOpts = *opts
+	}
+	listOpts.PageNumber, listOpts.PageSize = 0, 0
+	filters := client.HashOptions("ListModules", listOpts)
+
+	return client.NewIterator[schemas.Module](ctx, pageSize, fetchPage).
+		Prefetch(c.httpClient.Prefetch()).
+		FiltersHash(filters)
 }
 
 // ListModulesOptions holds optional parameters for ListModules
@@ -396,10 +395,52 @@ type ListModulesOptions struct {
 	// The comma-separated list of attributes.
 	Sort []string
 	// The value of the fields[resource-type] parameter is a comma-separated list that refers to the name of the fields to be returned for the resource. An empty value indicates that no fields should be returned.
-	Fields map[string]interface{}
+	Fields client.Fields
 	Filter map[string]string
 }
 
+// Relationship paths accepted by the Include option of ListModules
+const (
+	ListModulesIncludeAccount                                  = "account"
+	ListModulesIncludeAccountBillingPlan                       = "account.billing-plan"
+	ListModulesIncludeAccountIdentityProvider                  = "account.identity-provider"
+	ListModulesIncludeAccountOwner                             = "account.owner"
+	ListModulesIncludeCreatedBy                                = "created-by"
+	ListModulesIncludeCreatedByIdentityProviders               = "created-by.identity-providers"
+	ListModulesIncludeCreatedByTeams                           = "created-by.teams"
+	ListModulesIncludeDockerIntegration                        = "docker-integration"
+	ListModulesIncludeDockerIntegrationAccount                 = "docker-integration.account"
+	ListModulesIncludeEnvironment                              = "environment"
+	ListModulesIncludeEnvironmentAccount                       = "environment.account"
+	ListModulesIncludeEnvironmentCreatedBy                     = "environment.created-by"
+	ListModulesIncludeEnvironmentDefaultProviderConfigurations = "environment.default-provider-configurations"
+	ListModulesIncludeEnvironmentDefaultWorkspaceAgentPool     = "environment.default-workspace-agent-pool"
+	ListModulesIncludeEnvironmentDriftDetectionSchedules       = "environment.drift-detection-schedules"
+	ListModulesIncludeEnvironmentLockedBy                      = "environment.locked-by"
+	ListModulesIncludeEnvironmentPolicyGroups                  = "environment.policy-groups"
+	ListModulesIncludeEnvironmentProviderConfigurations        = "environment.provider-configurations"
+	ListModulesIncludeEnvironmentStorageProfile                = "environment.storage-profile"
+	ListModulesIncludeEnvironmentTags                          = "environment.tags"
+	ListModulesIncludeEnvironmentUpdatedBy                     = "environment.updated-by"
+	ListModulesIncludeLatestModuleVersion                      = "latest-module-version"
+	ListModulesIncludeLatestModuleVersionModule                = "latest-module-version.module"
+	ListModulesIncludeLatestModuleVersionVcsRevision           = "latest-module-version.vcs-revision"
+	ListModulesIncludeModuleVersion                            = "module-version"
+	ListModulesIncludeModuleVersionModule                      = "module-version.module"
+	ListModulesIncludeModuleVersionVcsRevision                 = "module-version.vcs-revision"
+	ListModulesIncludeModuleVersions                           = "module-versions"
+	ListModulesIncludeModuleVersionsModule                     = "module-versions.module"
+	ListModulesIncludeModuleVersionsVcsRevision                = "module-versions.vcs-revision"
+	ListModulesIncludeNamespace                                = "namespace"
+	ListModulesIncludeNamespaceEnvironments                    = "namespace.environments"
+	ListModulesIncludeNamespaceModules                         = "namespace.modules"
+	ListModulesIncludeNamespaceOwners                          = "namespace.owners"
+	ListModulesIncludeVcsProvider                              = "vcs-provider"
+	ListModulesIncludeVcsProviderAccount                       = "vcs-provider.account"
+	ListModulesIncludeVcsProviderAgentPool                     = "vcs-provider.agent-pool"
+	ListModulesIncludeVcsProviderEnvironments                  = "vcs-provider.environments"
+)
+
 // Trigger resync of the Module associated with the VCS repository.
 func (c *Client) ResyncModuleRaw(ctx context.Context, module string, req *schemas.ModuleResyncRequest) (*client.Response, error) {
 	path := "/modules/{module}/actions/resync"
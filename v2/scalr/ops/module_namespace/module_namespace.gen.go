@@ -46,17 +46,15 @@ func (c *Client) CreateModuleNamespace(ctx context.Context, req *schemas.ModuleN
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.ModuleNamespace  `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.ModuleNamespace `json:"data"`
+		Included []json.RawMessage       `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -104,17 +102,15 @@ func (c *Client) GetModuleNamespace(ctx context.Context, moduleNamespace string)
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.ModuleNamespace  `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.ModuleNamespace `json:"data"`
+		Included []json.RawMessage       `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -162,19 +158,18 @@ func (c *Client) ListModuleNamespaces(ctx context.Context, opts *ListModuleNames
 		Meta struct {
 			Pagination *client.Pagination `json:"pagination"`
 		} `json:"meta"`
-		Included []map[string]interface{} `json:"included"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
+	// Index included resources once to resolve the relationships
+	included := client.NewIncluded(result.Included)
 	resources := make([]*schemas.ModuleNamespace, len(result.Data))
 	for i := range result.Data {
 		resources[i] = &result.Data[i]
-		// Populate included resources into relationships
-		if len(result.Included) > 0 {
-			resources[i].Relationships.PopulateIncludes(result.Included)
-		}
+		resources[i].ResolveIncludes(included)
 	}
 	return resources, nil
 }
@@ -183,6 +178,7 @@ func (c *Client) ListModuleNamespaces(ctx context.Context, opts *ListModuleNames
 // This is the recommended and simple way to iterate through all the results.
 //
 // The iterator automatically fetches pages as needed and handles errors inline.
+// Pages are fetched ahead in the background when the client is created with client.WithPrefetch.
 //
 // Example:
 //
@@ -194,73 +190,23 @@ func (c *Client) ListModuleNamespaces(ctx context.Context, opts *ListModuleNames
 //	}
 func (c *Client) ListModuleNamespacesIter(ctx context.Context, opts *ListModuleNamespacesOptions) iter.Seq2[schemas.ModuleNamespace, error] {
 	return func(yield func(schemas.ModuleNamespace, error) bool) {
-		// Determine page size from opts or use default
-		pageSize := 20
-		if opts != nil && opts.PageSize > 0 {
-			pageSize = opts.PageSize
-		}
-
-		pageNum := 1
-
-		for {
-			// Check context
-			if err := ctx.Err(); err != nil {
-				yield(schemas.ModuleNamespace{}, err)
-				return
-			}
-
-			// Copy options and set page number
-			pageOpts := &ListModuleNamespacesOptions{}
-			if opts != nil {
-				*pageOpts = *opts
-			}
-			pageOpts.PageNumber = pageNum
-			pageOpts.PageSize = pageSize
-
-			// Fetch page using Raw method to get pagination metadata
-			resp, err := c.ListModuleNamespacesRaw(ctx, pageOpts)
-			if err != nil {
-				yield(schemas.ModuleNamespace{}, err)
-				return
-			}
-			defer resp.Body.Close()
-
-			// Decode response
-			var result struct {
-				Data []schemas.ModuleNamespace `json:"data"`
-				Meta struct {
-					Pagination *client.Pagination `json:"pagination"`
-				} `json:"meta"`
-				Included []map[string]interface{} `json:"included"`
-			}
-			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-				yield(schemas.ModuleNamespace{}, fmt.Errorf("failed to decode response: %w", err))
-				return
-			}
+		it := c.ListModuleNamespacesPaged(ctx, opts)
+		defer it.Close() // Stops the prefetching on early exit
 
-			// Yield each item
-			for i := range result.Data {
-				// Populate included resources into relationships
-				if len(result.Included) > 0 {
-					result.Data[i].Relationships.PopulateIncludes(result.Included)
-				}
-				if !yield(result.Data[i], nil) {
-					return // Consumer requested early exit
-				}
+		for it.Next() {
+			if !yield(*it.Value(), nil) {
+				return // Consumer requested early exit
 			}
-
-			// Check if there are more pages
-			if result.Meta.Pagination == nil || result.Meta.Pagination.NextPage == nil {
-				break
-			}
-
-			pageNum++
+		}
+		if err := it.Err(); err != nil {
+			yield(schemas.ModuleNamespace{}, err)
 		}
 	}
 }
 
 // ListModuleNamespacesPaged returns a stateful iterator with access to pagination metadata.
-// Use this when you need PageInfo(), Remaining(), or Collect() methods.
+// Use this when you need PageInfo(), Remaining(), or Collect() methods, or to resume
+// an interrupted iteration from the iterator Checkpoint().
 //
 // Example:
 //
@@ -273,6 +219,10 @@ func (c *Client) ListModuleNamespacesIter(ctx context.Context, opts *ListModuleN
 //	if err := iter.Err(); err != nil {
 //	    // Handle error
 //	}
+//
+// Resuming from a checkpoint saved by an iterator with the same options:
+//
+//	iter := client.ModuleNamespace.ListModuleNamespacesPaged(ctx, opts).Resume(checkpoint)
 func (c *Client) ListModuleNamespacesPaged(ctx context.Context, opts *ListModuleNamespacesOptions) *client.Iterator[schemas.ModuleNamespace] {
 	// Determine page size from opts or use default
 	pageSize := 20
@@ -303,26 +253,34 @@ func (c *Client) ListModuleNamespacesPaged(ctx context.Context, opts *ListModule
 			Meta struct {
 				Pagination *client.Pagination `json:"pagination"`
 			} `json:"meta"`
-			Included []map[string]interface{} `json:"included"`
+			Included []json.RawMessage `json:"included"`
 		}
 		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 			return nil, nil, fmt.Errorf("failed to decode response: %w", err)
 		}
 
-		// Convert to slice of pointers and populate includes
+		// Convert to slice of pointers and resolve includes
+		included := client.NewIncluded(result.Included)
 		items := make([]*schemas.ModuleNamespace, len(result.Data))
 		for i := range result.Data {
 			items[i] = &result.Data[i]
-			// Populate included resources into relationships
-			if len(result.Included) > 0 {
-				items[i].Relationships.PopulateIncludes(result.Included)
-			}
+			items[i].ResolveIncludes(included)
 		}
 
 		return items, result.Meta.Pagination, nil
 	}
 
-	return client.NewIterator[schemas.ModuleNamespace](ctx, pageSize, fetchPage)
+	// Checkpoints only resume the iterators of the same listing
+	listOpts := ListModuleNamespacesOptions{}
+	if opts != nil {
+		listOpts = *opts
+	}
+	listOpts.PageNumber, listOpts.PageSize = 0, 0
+	filters := client.HashOptions("ListModuleNamespaces", listOpts)
+
+	return client.NewIterator[schemas.ModuleNamespace](ctx, pageSize, fetchPage).
+		Prefetch(c.httpClient.Prefetch()).
+		FiltersHash(filters)
 }
 
 // ListModuleNamespacesOptions holds optional parameters for ListModuleNamespaces
@@ -359,16 +317,14 @@ func (c *Client) UpdateModuleNamespace(ctx context.Context, moduleNamespace stri
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.ModuleNamespace  `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.ModuleNamespace `json:"data"`
+		Included []json.RawMessage       `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
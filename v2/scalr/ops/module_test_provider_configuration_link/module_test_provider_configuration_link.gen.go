@@ -48,16 +48,14 @@ func (c *Client) CreateModuleTestProviderConfigurationLink(ctx context.Context,
 
 	var result struct {
 		Data     schemas.ModuleTestProviderConfigurationLink `json:"data"`
-		Included []map[string]interface{}                    `json:"included"`
+		Included []json.RawMessage                           `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -120,16 +118,14 @@ func (c *Client) GetModuleTestProviderConfigurationLink(ctx context.Context, mod
 
 	var result struct {
 		Data     schemas.ModuleTestProviderConfigurationLink `json:"data"`
-		Included []map[string]interface{}                    `json:"included"`
+		Included []json.RawMessage                           `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -140,6 +136,16 @@ type GetModuleTestProviderConfigurationLinkOptions struct {
 	Filter  map[string]string
 }
 
+// Relationship paths accepted by the Include option of GetModuleTestProviderConfigurationLink
+const (
+	GetModuleTestProviderConfigurationLinkIncludeProviderConfiguration             = "provider-configuration"
+	GetModuleTestProviderConfigurationLinkIncludeProviderConfigurationAccount      = "provider-configuration.account"
+	GetModuleTestProviderConfigurationLinkIncludeProviderConfigurationEnvironments = "provider-configuration.environments"
+	GetModuleTestProviderConfigurationLinkIncludeProviderConfigurationOwners       = "provider-configuration.owners"
+	GetModuleTestProviderConfigurationLinkIncludeProviderConfigurationParameters   = "provider-configuration.parameters"
+	GetModuleTestProviderConfigurationLinkIncludeProviderConfigurationTags         = "provider-configuration.tags"
+)
+
 // This endpoint returns a list of Provider Configuration links to Module Test Configurations.
 func (c *Client) ListModuleTestProviderConfigurationLinksRaw(ctx context.Context, testConfiguration string, opts *ListModuleTestProviderConfigurationLinksOptions) (*client.Response, error) {
 	path := "/test-configurations/{test_configuration}/provider-configuration-links"
@@ -185,19 +191,18 @@ func (c *Client) ListModuleTestProviderConfigurationLinks(ctx context.Context, t
 		Meta struct {
 			Pagination *client.Pagination `json:"pagination"`
 		} `json:"meta"`
-		Included []map[string]interface{} `json:"included"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
+	// Index included resources once to resolve the relationships
+	included := client.NewIncluded(result.Included)
 	resources := make([]*schemas.ModuleTestProviderConfigurationLink, len(result.Data))
 	for i := range result.Data {
 		resources[i] = &result.Data[i]
-		// Populate included resources into relationships
-		if len(result.Included) > 0 {
-			resources[i].Relationships.PopulateIncludes(result.Included)
-		}
+		resources[i].ResolveIncludes(included)
 	}
 	return resources, nil
 }
@@ -206,6 +211,7 @@ func (c *Client) ListModuleTestProviderConfigurationLinks(ctx context.Context, t
 // This is the recommended and simple way to iterate through all the results.
 //
 // The iterator automatically fetches pages as needed and handles errors inline.
+// Pages are fetched ahead in the background when the client is created with client.WithPrefetch.
 //
 // Example:
 //
@@ -217,73 +223,23 @@ func (c *Client) ListModuleTestProviderConfigurationLinks(ctx context.Context, t
 //	}
 func (c *Client) ListModuleTestProviderConfigurationLinksIter(ctx context.Context, testConfiguration string, opts *ListModuleTestProviderConfigurationLinksOptions) iter.Seq2[schemas.ModuleTestProviderConfigurationLink, error] {
 	return func(yield func(schemas.ModuleTestProviderConfigurationLink, error) bool) {
-		// Determine page size from opts or use default
-		pageSize := 20
-		if opts != nil && opts.PageSize > 0 {
-			pageSize = opts.PageSize
-		}
-
-		pageNum := 1
-
-		for {
-			// Check context
-			if err := ctx.Err(); err != nil {
-				yield(schemas.ModuleTestProviderConfigurationLink{}, err)
-				return
-			}
-
-			// Copy options and set page number
-			pageOpts := &ListModuleTestProviderConfigurationLinksOptions{}
-			if opts != nil {
-				*pageOpts = *opts
-			}
-			pageOpts.PageNumber = pageNum
-			pageOpts.PageSize = pageSize
-
-			// Fetch page using Raw method to get pagination metadata
-			resp, err := c.ListModuleTestProviderConfigurationLinksRaw(ctx, testConfiguration, pageOpts)
-			if err != nil {
-				yield(schemas.ModuleTestProviderConfigurationLink{}, err)
-				return
-			}
-			defer resp.Body.Close()
-
-			// Decode response
-			var result struct {
-				Data []schemas.ModuleTestProviderConfigurationLink `json:"data"`
-				Meta struct {
-					Pagination *client.Pagination `json:"pagination"`
-				} `json:"meta"`
-				Included []map[string]interface{} `json:"included"`
-			}
-			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-				yield(schemas.ModuleTestProviderConfigurationLink{}, fmt.Errorf("failed to decode response: %w", err))
-				return
-			}
+		it := c.ListModuleTestProviderConfigurationLinksPaged(ctx, testConfiguration, opts)
+		defer it.Close() // Stops the prefetching on early exit
 
-			// Yield each item
-			for i := range result.Data {
-				// Populate included resources into relationships
-				if len(result.Included) > 0 {
-					result.Data[i].Relationships.PopulateIncludes(result.Included)
-				}
-				if !yield(result.Data[i], nil) {
-					return // Consumer requested early exit
-				}
+		for it.Next() {
+			if !yield(*it.Value(), nil) {
+				return // Consumer requested early exit
 			}
-
-			// Check if there are more pages
-			if result.Meta.Pagination == nil || result.Meta.Pagination.NextPage == nil {
-				break
-			}
-
-			pageNum++
+		}
+		if err := it.Err(); err != nil {
+			yield(schemas.ModuleTestProviderConfigurationLink{}, err)
 		}
 	}
 }
 
 // ListModuleTestProviderConfigurationLinksPaged returns a stateful iterator with access to pagination metadata.
-// Use this when you need PageInfo(), Remaining(), or Collect() methods.
+// Use this when you need PageInfo(), Remaining(), or Collect() methods, or to resume
+// an interrupted iteration from the iterator Checkpoint().
 //
 // Example:
 //
@@ -296,6 +252,10 @@ func (c *Client) ListModuleTestProviderConfigurationLinksIter(ctx context.Contex
 //	if err := iter.Err(); err != nil {
 //	    // Handle error
 //	}
+//
+// Resuming from a checkpoint saved by an iterator with the same options:
+//
+//	iter := client.ModuleTestProviderConfigurationLink.ListModuleTestProviderConfigurationLinksPaged(ctx, testConfiguration, opts).Resume(checkpoint)
 func (c *Client) ListModuleTestProviderConfigurationLinksPaged(ctx context.Context, testConfiguration string, opts *ListModuleTestProviderConfigurationLinksOptions) *client.Iterator[schemas.ModuleTestProviderConfigurationLink] {
 	// Determine page size from opts or use default
 	pageSize := 20
@@ -326,26 +286,34 @@ func (c *Client) ListModuleTestProviderConfigurationLinksPaged(ctx context.Conte
 			Meta struct {
 				Pagination *client.Pagination `json:"pagination"`
 			} `json:"meta"`
-			Included []map[string]interface{} `json:"included"`
+			Included []json.RawMessage `json:"included"`
 		}
 		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 			return nil, nil, fmt.Errorf("failed to decode response: %w", err)
 		}
 
-		// Convert to slice of pointers and populate includes
+		// Convert to slice of pointers and resolve includes
+		included := client.NewIncluded(result.Included)
 		items := make([]*schemas.ModuleTestProviderConfigurationLink, len(result.Data))
 		for i := range result.Data {
 			items[i] = &result.Data[i]
-			// Populate included resources into relationships
-			if len(result.Included) > 0 {
-				items[i].Relationships.PopulateIncludes(result.Included)
-			}
+			items[i].ResolveIncludes(included)
 		}
 
 		return items, result.Meta.Pagination, nil
 	}
 
-	return client.NewIterator[schemas.ModuleTestProviderConfigurationLink](ctx, pageSize, fetchPage)
+	// Checkpoints only resume the iterators of the same listing
+	listOpts := ListModuleTestProviderConfigurationLinksOptions{}
+	if opts != nil {
+		listOpts = *opts
+	}
+	listOpts.PageNumber, listOpts.PageSize = 0, 0
+	filters := client.HashOptions("ListModuleTestProviderConfigurationLinks", testConfiguration, listOpts)
+
+	return client.NewIterator[schemas.ModuleTestProviderConfigurationLink](ctx, pageSize, fetchPage).
+		Prefetch(c.httpClient.Prefetch()).
+		FiltersHash(filters)
 }
 
 // ListModuleTestProviderConfigurationLinksOptions holds optional parameters for ListModuleTestProviderConfigurationLinks
@@ -359,6 +327,16 @@ type ListModuleTestProviderConfigurationLinksOptions struct {
 	Filter  map[string]string
 }
 
+// Relationship paths accepted by the Include option of ListModuleTestProviderConfigurationLinks
+const (
+	ListModuleTestProviderConfigurationLinksIncludeProviderConfiguration             = "provider-configuration"
+	ListModuleTestProviderConfigurationLinksIncludeProviderConfigurationAccount      = "provider-configuration.account"
+	ListModuleTestProviderConfigurationLinksIncludeProviderConfigurationEnvironments = "provider-configuration.environments"
+	ListModuleTestProviderConfigurationLinksIncludeProviderConfigurationOwners       = "provider-configuration.owners"
+	ListModuleTestProviderConfigurationLinksIncludeProviderConfigurationParameters   = "provider-configuration.parameters"
+	ListModuleTestProviderConfigurationLinksIncludeProviderConfigurationTags         = "provider-configuration.tags"
+)
+
 // This endpoint allows updates to attributes of an existing Module Test Provider Configuration Link.
 func (c *Client) UpdateModuleTestProviderConfigurationLinkRaw(ctx context.Context, moduleTestProviderConfigurationLink string, req *schemas.ModuleTestProviderConfigurationLinkRequest) (*client.Response, error) {
 	path := "/module-test-provider-configuration-links/{module_test_provider_configuration_link}"
@@ -383,15 +361,13 @@ func (c *Client) UpdateModuleTestProviderConfigurationLink(ctx context.Context,
 
 	var result struct {
 		Data     schemas.ModuleTestProviderConfigurationLink `json:"data"`
-		Included []map[string]interface{}                    `json:"included"`
+		Included []json.RawMessage                           `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
@@ -43,8 +43,7 @@ func (c *Client) ListModuleUsageNamespacesRaw(ctx context.Context, opts *ListMod
 		if len(opts.Sort) > 0 {
 			params.Set("sort", strings.Join(opts.Sort, ","))
 		}
-		// Handle parameter: Fields (map[string]interface{})
-		// Complex type map[string]interface{} - skip for now
+		opts.Fields.Encode(params)
 		if len(opts.Include) > 0 {
 			params.Set("include", strings.Join(opts.Include, ","))
 		}
@@ -77,19 +76,18 @@ func (c *Client) ListModuleUsageNamespaces(ctx context.Context, opts *ListModule
 		Meta struct {
 			Pagination *client.Pagination `json:"pagination"`
 		} `json:"meta"`
-		Included []map[string]interface{} `json:"included"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
+	// Index included resources once to resolve the relationships
+	included := client.NewIncluded(result.Included)
 	resources := make([]*schemas.ModuleUsageNamespace, len(result.Data))
 	for i := range result.Data {
 		resources[i] = &result.Data[i]
-		// Populate included resources into relationships
-		if len(result.Included) > 0 {
-			resources[i].Relationships.PopulateIncludes(result.Included)
-		}
+		resources[i].ResolveIncludes(included)
 	}
 	return resources, nil
 }
@@ -98,6 +96,7 @@ func (c *Client) ListModuleUsageNamespaces(ctx context.Context, opts *ListModule
 // This is the recommended and simple way to iterate through all the results.
 //
 // The iterator automatically fetches pages as needed and handles errors inline.
+// Pages are fetched ahead in the background when the client is created with client.WithPrefetch.
 //
 // Example:
 //
@@ -109,73 +108,23 @@ func (c *Client) ListModuleUsageNamespaces(ctx context.Context, opts *ListModule
 //	}
 func (c *Client) ListModuleUsageNamespacesIter(ctx context.Context, opts *ListModuleUsageNamespacesOptions) iter.Seq2[schemas.ModuleUsageNamespace, error] {
 	return func(yield func(schemas.ModuleUsageNamespace, error) bool) {
-		// Determine page size from opts or use default
-		pageSize := 20
-		if opts != nil && opts.PageSize > 0 {
-			pageSize = opts.PageSize
-		}
-
-		pageNum := 1
-
-		for {
-			// Check context
-			if err := ctx.Err(); err != nil {
-				yield(schemas.ModuleUsageNamespace{}, err)
-				return
-			}
-
-			// Copy options and set page number
-			pageOpts := &ListModuleUsageNamespacesOptions{}
-			if opts != nil {
-				*pageOpts = *opts
-			}
-			pageOpts.PageNumber = pageNum
-			pageOpts.PageSize = pageSize
-
-			// Fetch page using Raw method to get pagination metadata
-			resp, err := c.ListModuleUsageNamespacesRaw(ctx, pageOpts)
-			if err != nil {
-				yield(schemas.ModuleUsageNamespace{}, err)
-				return
-			}
-			defer resp.Body.Close()
-
-			// Decode response
-			var result struct {
-				Data []schemas.ModuleUsageNamespace `json:"data"`
-				Meta struct {
-					Pagination *client.Pagination `json:"pagination"`
-				} `json:"meta"`
-				Included []map[string]interface{} `json:"included"`
-			}
-			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-				yield(schemas.ModuleUsageNamespace{}, fmt.Errorf("failed to decode response: %w", err))
-				return
-			}
+		it := c.ListModuleUsageNamespacesPaged(ctx, opts)
+		defer it.Close() // Stops the prefetching on early exit
 
-			// Yield each item
-			for i := range result.Data {
-				// Populate included resources into relationships
-				if len(result.Included) > 0 {
-					result.Data[i].Relationships.PopulateIncludes(result.Included)
-				}
-				if !yield(result.Data[i], nil) {
-					return // Consumer requested early exit
-				}
+		for it.Next() {
+			if !yield(*it.Value(), nil) {
+				return // Consumer requested early exit
 			}
-
-			// Check if there are more pages
-			if result.Meta.Pagination == nil || result.Meta.Pagination.NextPage == nil {
-				break
-			}
-
-			pageNum++
+		}
+		if err := it.Err(); err != nil {
+			yield(schemas.ModuleUsageNamespace{}, err)
 		}
 	}
 }
 
 // ListModuleUsageNamespacesPaged returns a stateful iterator with access to pagination metadata.
-// Use this when you need PageInfo(), Remaining(), or Collect() methods.
+// Use this when you need PageInfo(), Remaining(), or Collect() methods, or to resume
+// an interrupted iteration from the iterator Checkpoint().
 //
 // Example:
 //
@@ -188,6 +137,10 @@ func (c *Client) ListModuleUsageNamespacesIter(ctx context.Context, opts *ListMo
 //	if err := iter.Err(); err != nil {
 //	    // Handle error
 //	}
+//
+// Resuming from a checkpoint saved by an iterator with the same options:
+//
+//	iter := client.ModuleUsageNamespace.ListModuleUsageNamespacesPaged(ctx, opts).Resume(checkpoint)
 func (c *Client) ListModuleUsageNamespacesPaged(ctx context.Context, opts *ListModuleUsageNamespacesOptions) *client.Iterator[schemas.ModuleUsageNamespace] {
 	// Determine page size from opts or use default
 	pageSize := 20
@@ -218,26 +171,34 @@ func (c *Client) ListModuleUsageNamespacesPaged(ctx context.Context, opts *ListM
 			Meta struct {
 				Pagination *client.Pagination `json:"pagination"`
 			} `json:"meta"`
-			Included []map[string]interface{} `json:"included"`
+			Included []json.RawMessage `json:"included"`
 		}
 		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 			return nil, nil, fmt.Errorf("failed to decode response: %w", err)
 		}
 
-		// Convert to slice of pointers and populate includes
+		// Convert to slice of pointers and resolve includes
+		included := client.NewIncluded(result.Included)
 		items := make([]*schemas.ModuleUsageNamespace, len(result.Data))
 		for i := range result.Data {
 			items[i] = &result.Data[i]
-			// Populate included resources into relationships
-			if len(result.Included) > 0 {
-				items[i].Relationships.PopulateIncludes(result.Included)
-			}
+			items[i].ResolveIncludes(included)
 		}
 
 		return items, result.Meta.Pagination, nil
 	}
 
-	return client.NewIterator[schemas.ModuleUsageNamespace](ctx, pageSize, fetchPage)
+	// Checkpoints only resume the iterators of the same listing
+	listOpts := ListModuleUsageNamespacesOptions{}
+	if opts != nil {
+		listOpts = *opts
+	}
+	listOpts.PageNumber, listOpts.PageSize = 0, 0
+	filters := client.HashOptions("ListModuleUsageNamespaces", listOpts)
+
+	return client.NewIterator[schemas.ModuleUsageNamespace](ctx, pageSize, fetchPage).
+		Prefetch(c.httpClient.Prefetch()).
+		FiltersHash(filters)
 }
 
 // ListModuleUsageNamespacesOptions holds optional parameters for ListModuleUsageNamespaces
@@ -251,8 +212,32 @@ type ListModuleUsageNamespacesOptions struct {
 	// The comma-separated list of attributes.
 	Sort []string
 	// The value of the fields[resource-type] parameter is a comma-separated list that refers to the name of the fields to be returned for the resource. An empty value indicates that no fields should be returned.
-	Fields map[string]interface{}
+	Fields client.Fields
 	// The comma-separated list of relationship paths.
 	Include []string
 	Filter  map[string]string
 }
+
+// Relationship paths accepted by the Include option of ListModuleUsageNamespaces
+const (
+	ListModuleUsageNamespacesIncludeAccount                                           = "account"
+	ListModuleUsageNamespacesIncludeAccountBillingPlan                                = "account.billing-plan"
+	ListModuleUsageNamespacesIncludeAccountIdentityProvider                           = "account.identity-provider"
+	ListModuleUsageNamespacesIncludeAccountOwner                                      = "account.owner"
+	ListModuleUsageNamespacesIncludeNamespaceAccount                                  = "namespace-account"
+	ListModuleUsageNamespacesIncludeNamespaceAccountBillingPlan                       = "namespace-account.billing-plan"
+	ListModuleUsageNamespacesIncludeNamespaceAccountIdentityProvider                  = "namespace-account.identity-provider"
+	ListModuleUsageNamespacesIncludeNamespaceAccountOwner                             = "namespace-account.owner"
+	ListModuleUsageNamespacesIncludeNamespaceEnvironment                              = "namespace-environment"
+	ListModuleUsageNamespacesIncludeNamespaceEnvironmentAccount                       = "namespace-environment.account"
+	ListModuleUsageNamespacesIncludeNamespaceEnvironmentCreatedBy                     = "namespace-environment.created-by"
+	ListModuleUsageNamespacesIncludeNamespaceEnvironmentDefaultProviderConfigurations = "namespace-environment.default-provider-configurations"
+	ListModuleUsageNamespacesIncludeNamespaceEnvironmentDefaultWorkspaceAgentPool     = "namespace-environment.default-workspace-agent-pool"
+	ListModuleUsageNamespacesIncludeNamespaceEnvironmentDriftDetectionSchedules       = "namespace-environment.drift-detection-schedules"
+	ListModuleUsageNamespacesIncludeNamespaceEnvironmentLockedBy                      = "namespace-environment.locked-by"
+	ListModuleUsageNamespacesIncludeNamespaceEnvironmentPolicyGroups                  = "namespace-environment.policy-groups"
+	ListModuleUsageNamespacesIncludeNamespaceEnvironmentProviderConfigurations        = "namespace-environment.provider-configurations"
+	ListModuleUsageNamespacesIncludeNamespaceEnvironmentStorageProfile                = "namespace-environment.storage-profile"
+	ListModuleUsageNamespacesIncludeNamespaceEnvironmentTags                          = "namespace-environment.tags"
+	ListModuleUsageNamespacesIncludeNamespaceEnvironmentUpdatedBy                     = "namespace-environment.updated-by"
+)
@@ -59,17 +59,15 @@ func (c *Client) GetModuleVersion(ctx context.Context, moduleVersion string, opt
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.ModuleVersion    `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.ModuleVersion `json:"data"`
+		Included []json.RawMessage     `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -80,6 +78,21 @@ type GetModuleVersionOptions struct {
 	Filter  map[string]string
 }
 
+// Relationship paths accepted by the Include option of GetModuleVersion
+const (
+	GetModuleVersionIncludeModule                    = "module"
+	GetModuleVersionIncludeModuleAccount             = "module.account"
+	GetModuleVersionIncludeModuleCreatedBy           = "module.created-by"
+	GetModuleVersionIncludeModuleDockerIntegration   = "module.docker-integration"
+	GetModuleVersionIncludeModuleEnvironment         = "module.environment"
+	GetModuleVersionIncludeModuleLatestModuleVersion = "module.latest-module-version"
+	GetModuleVersionIncludeModuleModuleVersion       = "module.module-version"
+	GetModuleVersionIncludeModuleModuleVersions      = "module.module-versions"
+	GetModuleVersionIncludeModuleNamespace           = "module.namespace"
+	GetModuleVersionIncludeModuleVcsProvider         = "module.vcs-provider"
+	GetModuleVersionIncludeVcsRevision               = "vcs-revision"
+)
+
 // This endpoint lists versions of a particular module. The query parameter `filter[module]` with Module ID is required.
 func (c *Client) ListModuleVersionsRaw(ctx context.Context, opts *ListModuleVersionsOptions) (*client.Response, error) {
 	path := "/module-versions"
@@ -98,8 +111,7 @@ func (c *Client) ListModuleVersionsRaw(ctx context.Context, opts *ListModuleVers
 		if len(opts.Include) > 0 {
 			params.Set("include", strings.Join(opts.Include, ","))
 		}
-		// Handle parameter: Fields (map[string]interface{})
-		// Complex type map[string]interface{} - skip for now
+		opts.Fields.Encode(params)
 		// Add filters
 		for k, v := range opts.Filter {
 			params.Set("filter["+k+"]", v)
@@ -129,19 +141,18 @@ func (c *Client) ListModuleVersions(ctx context.Context, opts *ListModuleVersion
 		Meta struct {
 			Pagination *client.Pagination `json:"pagination"`
 		} `json:"meta"`
-		Included []map[string]interface{} `json:"included"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
+	// Index included resources once to resolve the relationships
+	included := client.NewIncluded(result.Included)
 	resources := make([]*schemas.ModuleVersion, len(result.Data))
 	for i := range result.Data {
 		resources[i] = &result.Data[i]
-		// Populate included resources into relationships
-		if len(result.Included) > 0 {
-			resources[i].Relationships.PopulateIncludes(result.Included)
-		}
+		resources[i].ResolveIncludes(included)
 	}
 	return resources, nil
 }
@@ -150,6 +161,7 @@ func (c *Client) ListModuleVersions(ctx context.Context, opts *ListModuleVersion
 // This is the recommended and simple way to iterate through all the results.
 //
 // The iterator automatically fetches pages as needed and handles errors inline.
+// Pages are fetched ahead in the background when the client is created with client.WithPrefetch.
 //
 // Example:
 //
@@ -161,73 +173,23 @@ func (c *Client) ListModuleVersions(ctx context.Context, opts *ListModuleVersion
 //	}
 func (c *Client) ListModuleVersionsIter(ctx context.Context, opts *ListModuleVersionsOptions) iter.Seq2[schemas.ModuleVersion, error] {
 	return func(yield func(schemas.ModuleVersion, error) bool) {
-		// Determine page size from opts or use default
-		pageSize := 20
-		if opts != nil && opts.PageSize > 0 {
-			pageSize = opts.PageSize
-		}
-
-		pageNum := 1
-
-		for {
-			// Check context
-			if err := ctx.Err(); err != nil {
-				yield(schemas.ModuleVersion{}, err)
-				return
-			}
-
-			// Copy options and set page number
-			pageOpts := &ListModuleVersionsOptions{}
-			if opts != nil {
-				*pageOpts = *opts
-			}
-			pageOpts.PageNumber = pageNum
-			pageOpts.PageSize = pageSize
-
-			// Fetch page using Raw method to get pagination metadata
-			resp, err := c.ListModuleVersionsRaw(ctx, pageOpts)
-			if err != nil {
-				yield(schemas.ModuleVersion{}, err)
-				return
-			}
-			defer resp.Body.Close()
-
-			// Decode response
-			var result struct {
-				Data []schemas.ModuleVersion `json:"data"`
-				Meta struct {
-					Pagination *client.Pagination `json:"pagination"`
-				} `json:"meta"`
-				Included []map[string]interface{} `json:"included"`
-			}
-			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-				yield(schemas.ModuleVersion{}, fmt.Errorf("failed to decode response: %w", err))
-				return
-			}
+		it := c.ListModuleVersionsPaged(ctx, opts)
+		defer it.Close() // Stops the prefetching on early exit
 
-			// Yield each item
-			for i := range result.Data {
-				// Populate included resources into relationships
-				if len(result.Included) > 0 {
-					result.Data[i].Relationships.PopulateIncludes(result.Included)
-				}
-				if !yield(result.Data[i], nil) {
-					return // Consumer requested early exit
-				}
+		for it.Next() {
+			if !yield(*it.Value(), nil) {
+				return // Consumer requested early exit
 			}
-
-			// Check if there are more pages
-			if result.Meta.Pagination == nil || result.Meta.Pagination.NextPage == nil {
-				break
-			}
-
-			pageNum++
+		}
+		if err := it.Err(); err != nil {
+			yield(schemas.ModuleVersion{}, err)
 		}
 	}
 }
 
 // ListModuleVersionsPaged returns a stateful iterator with access to pagination metadata.
-// Use this when you need PageInfo(), Remaining(), or Collect() methods.
+// Use this when you need PageInfo(), Remaining(), or Collect() methods, or to resume
+// an interrupted iteration from the iterator Checkpoint().
 //
 // Example:
 //
@@ -240,6 +202,10 @@ func (c *Client) ListModuleVersionsIter(ctx context.Context, opts *ListModuleVer
 //	if err := iter.Err(); err != nil {
 //	    // Handle error
 //	}
+//
+// Resuming from a checkpoint saved by an iterator with the same options:
+//
+//	iter := client.ModuleVersion.ListModuleVersionsPaged(ctx, opts).Resume(checkpoint)
 func (c *Client) ListModuleVersionsPaged(ctx context.Context, opts *ListModuleVersionsOptions) *client.Iterator[schemas.ModuleVersion] {
 	// Determine page size from opts or use default
 	pageSize := 20
@@ -270,26 +236,34 @@ func (c *Client) ListModuleVersionsPaged(ctx context.Context, opts *ListModuleVe
 			Meta struct {
 				Pagination *client.Pagination `json:"pagination"`
 			} `json:"meta"`
-			Included []map[string]interface{} `json:"included"`
+			Included []json.RawMessage `json:"included"`
 		}
 		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 			return nil, nil, fmt.Errorf("failed to decode response: %w", err)
 		}
 
-		// Convert to slice of pointers and populate includes
+		// Convert to slice of pointers and resolve includes
+		included := client.NewIncluded(result.Included)
 		items := make([]*schemas.ModuleVersion, len(result.Data))
 		for i := range result.Data {
 			items[i] = &result.Data[i]
-			// Populate included resources into relationships
-			if len(result.Included) > 0 {
-				items[i].Relationships.PopulateIncludes(result.Included)
-			}
+			items[i].ResolveIncludes(included)
 		}
 
 		return items, result.Meta.Pagination, nil
 	}
 
-	return client.NewIterator[schemas.ModuleVersion](ctx, pageSize, fetchPage)
+	// Checkpoints only resume the iterators of the same listing
+	listOpts := ListModuleVersionsOptions{}
+	if opts != nil {
+		listOpts = *opts
+	}
+	listOpts.PageNumber, listOpts.PageSize = 0, 0
+	filters := client.HashOptions("ListModuleVersions", listOpts)
+
+	return client.NewIterator[schemas.ModuleVersion](ctx, pageSize, fetchPage).
+		Prefetch(c.httpClient.Prefetch()).
+		FiltersHash(filters)
 }
 
 // ListModuleVersionsOptions holds optional parameters for ListModuleVersions
@@ -303,10 +277,25 @@ type ListModuleVersionsOptions struct {
 	// The comma-separated list of relationship paths.
 	Include []string
 	// The value of the fields[resource-type] parameter is a comma-separated list that refers to the name of the fields to be returned for the resource. An empty value indicates that no fields should be returned.
-	Fields map[string]interface{}
+	Fields client.Fields
 	Filter map[string]string
 }
 
+// Relationship paths accepted by the Include option of ListModuleVersions
+const (
+	ListModuleVersionsIncludeModule                    = "module"
+	ListModuleVersionsIncludeModuleAccount             = "module.account"
+	ListModuleVersionsIncludeModuleCreatedBy           = "module.created-by"
+	ListModuleVersionsIncludeModuleDockerIntegration   = "module.docker-integration"
+	ListModuleVersionsIncludeModuleEnvironment         = "module.environment"
+	ListModuleVersionsIncludeModuleLatestModuleVersion = "module.latest-module-version"
+	ListModuleVersionsIncludeModuleModuleVersion       = "module.module-version"
+	ListModuleVersionsIncludeModuleModuleVersions      = "module.module-versions"
+	ListModuleVersionsIncludeModuleNamespace           = "module.namespace"
+	ListModuleVersionsIncludeModuleVcsProvider         = "module.vcs-provider"
+	ListModuleVersionsIncludeVcsRevision               = "vcs-revision"
+)
+
 // Trigger resync of the Module Version associated with the `relationships.vcs-revision`. Only modules associated with a VCS can be resynchronized.
 func (c *Client) ResyncModuleVersionRaw(ctx context.Context, moduleVersion string) (*client.Response, error) {
 	path := "/module-versions/{module_version}/actions/resync"
@@ -44,8 +44,8 @@ func (c *Client) GetPermission(ctx context.Context, permission string) (*schemas
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.Permission       `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.Permission `json:"data"`
+		Included []json.RawMessage  `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
@@ -78,7 +78,7 @@ func (c *Client) GetPermissions(ctx context.Context) ([]*schemas.Permission, err
 		Meta struct {
 			Pagination *client.Pagination `json:"pagination"`
 		} `json:"meta"`
-		Included []map[string]interface{} `json:"included"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
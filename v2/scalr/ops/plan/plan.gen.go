@@ -94,8 +94,8 @@ func (c *Client) GetPlan(ctx context.Context, plan string) (*schemas.Plan, error
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.Plan             `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.Plan      `json:"data"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
@@ -148,6 +148,27 @@ func (c *Client) GetPlanLog(ctx context.Context, plan string, opts *GetPlanLogOp
 	return string(bodyBytes), nil
 }
 
+// GetPlanLogStream streams the response of GetPlanLog instead of buffering it in memory.
+// The caller must close the returned body.
+func (c *Client) GetPlanLogStream(ctx context.Context, plan string, opts *GetPlanLogOptions) (io.ReadCloser, error) {
+	resp, err := c.GetPlanLogRaw(ctx, plan, opts)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+// GetPlanLogTo copies the response of GetPlanLog to w and returns the number of bytes written.
+func (c *Client) GetPlanLogTo(ctx context.Context, w io.Writer, plan string, opts *GetPlanLogOptions) (int64, error) {
+	body, err := c.GetPlanLogStream(ctx, plan, opts)
+	if err != nil {
+		return 0, err
+	}
+	defer body.Close()
+
+	return io.Copy(w, body)
+}
+
 // GetPlanLogOptions holds optional parameters for GetPlanLog
 type GetPlanLogOptions struct {
 	// Strip ANSI escape codes.
@@ -44,8 +44,8 @@ func (c *Client) GetPolicyCheck(ctx context.Context, policyCheck string) (*schem
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.PolicyCheck      `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.PolicyCheck `json:"data"`
+		Included []json.RawMessage   `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
@@ -122,7 +122,7 @@ func (c *Client) ListPolicyChecks(ctx context.Context, run string) ([]*schemas.P
 		Meta struct {
 			Pagination *client.Pagination `json:"pagination"`
 		} `json:"meta"`
-		Included []map[string]interface{} `json:"included"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
@@ -156,8 +156,8 @@ func (c *Client) OverridePolicy(ctx context.Context, policyCheck string) (*schem
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.PolicyCheck      `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.PolicyCheck `json:"data"`
+		Included []json.RawMessage   `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
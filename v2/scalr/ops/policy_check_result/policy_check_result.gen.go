@@ -42,8 +42,7 @@ func (c *Client) GetPolicyGroupCheckResultsRaw(ctx context.Context, policyGroupC
 		if len(opts.Include) > 0 {
 			params.Set("include", strings.Join(opts.Include, ","))
 		}
-		// Handle parameter: Fields (map[string]interface{})
-		// Complex type map[string]interface{} - skip for now
+		opts.Fields.Encode(params)
 		if opts.PageNumber > 0 {
 			params.Set("page[number]", fmt.Sprintf("%d", opts.PageNumber))
 		}
@@ -82,19 +81,18 @@ func (c *Client) GetPolicyGroupCheckResults(ctx context.Context, policyGroupChec
 		Meta struct {
 			Pagination *client.Pagination `json:"pagination"`
 		} `json:"meta"`
-		Included []map[string]interface{} `json:"included"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
+	// Index included resources once to resolve the relationships
+	included := client.NewIncluded(result.Included)
 	resources := make([]*schemas.PolicyCheckResult, len(result.Data))
 	for i := range result.Data {
 		resources[i] = &result.Data[i]
-		// Populate included resources into relationships
-		if len(result.Included) > 0 {
-			resources[i].Relationships.PopulateIncludes(result.Included)
-		}
+		resources[i].ResolveIncludes(included)
 	}
 	return resources, nil
 }
@@ -103,6 +101,7 @@ func (c *Client) GetPolicyGroupCheckResults(ctx context.Context, policyGroupChec
 // This is the recommended and simple way to iterate through all the results.
 //
 // The iterator automatically fetches pages as needed and handles errors inline.
+// Pages are fetched ahead in the background when the client is created with client.WithPrefetch.
 //
 // Example:
 //
@@ -114,73 +113,23 @@ func (c *Client) GetPolicyGroupCheckResults(ctx context.Context, policyGroupChec
 //	}
 func (c *Client) GetPolicyGroupCheckResultsIter(ctx context.Context, policyGroupCheck string, opts *GetPolicyGroupCheckResultsOptions) iter.Seq2[schemas.PolicyCheckResult, error] {
 	return func(yield func(schemas.PolicyCheckResult, error) bool) {
-		// Determine page size from opts or use default
-		pageSize := 20
-		if opts != nil && opts.PageSize > 0 {
-			pageSize = opts.PageSize
-		}
-
-		pageNum := 1
-
-		for {
-			// Check context
-			if err := ctx.Err(); err != nil {
-				yield(schemas.PolicyCheckResult{}, err)
-				return
-			}
-
-			// Copy options and set page number
-			pageOpts := &GetPolicyGroupCheckResultsOptions{}
-			if opts != nil {
-				*pageOpts = *opts
-			}
-			pageOpts.PageNumber = pageNum
-			pageOpts.PageSize = pageSize
-
-			// Fetch page using Raw method to get pagination metadata
-			resp, err := c.GetPolicyGroupCheckResultsRaw(ctx, policyGroupCheck, pageOpts)
-			if err != nil {
-				yield(schemas.PolicyCheckResult{}, err)
-				return
-			}
-			defer resp.Body.Close()
-
-			// Decode response
-			var result struct {
-				Data []schemas.PolicyCheckResult `json:"data"`
-				Meta struct {
-					Pagination *client.Pagination `json:"pagination"`
-				} `json:"meta"`
-				Included []map[string]interface{} `json:"included"`
-			}
-			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-				yield(schemas.PolicyCheckResult{}, fmt.Errorf("failed to decode response: %w", err))
-				return
-			}
+		it := c.GetPolicyGroupCheckResultsPaged(ctx, policyGroupCheck, opts)
+		defer it.Close() // Stops the prefetching on early exit
 
-			// Yield each item
-			for i := range result.Data {
-				// Populate included resources into relationships
-				if len(result.Included) > 0 {
-					result.Data[i].Relationships.PopulateIncludes(result.Included)
-				}
-				if !yield(result.Data[i], nil) {
-					return // Consumer requested early exit
-				}
+		for it.Next() {
+			if !yield(*it.Value(), nil) {
+				return // Consumer requested early exit
 			}
-
-			// Check if there are more pages
-			if result.Meta.Pagination == nil || result.Meta.Pagination.NextPage == nil {
-				break
-			}
-
-			pageNum++
+		}
+		if err := it.Err(); err != nil {
+			yield(schemas.PolicyCheckResult{}, err)
 		}
 	}
 }
 
 // GetPolicyGroupCheckResultsPaged returns a stateful iterator with access to pagination metadata.
-// Use this when you need PageInfo(), Remaining(), or Collect() methods.
+// Use this when you need PageInfo(), Remaining(), or Collect() methods, or to resume
+// an interrupted iteration from the iterator Checkpoint().
 //
 // Example:
 //
@@ -193,6 +142,10 @@ func (c *Client) GetPolicyGroupCheckResultsIter(ctx context.Context, policyGroup
 //	if err := iter.Err(); err != nil {
 //	    // Handle error
 //	}
+//
+// Resuming from a checkpoint saved by an iterator with the same options:
+//
+//	iter := client.PolicyCheckResult.GetPolicyGroupCheckResultsPaged(ctx, policyGroupCheck, opts).Resume(checkpoint)
 func (c *Client) GetPolicyGroupCheckResultsPaged(ctx context.Context, policyGroupCheck string, opts *GetPolicyGroupCheckResultsOptions) *client.Iterator[schemas.PolicyCheckResult] {
 	// Determine page size from opts or use default
 	pageSize := 20
@@ -223,26 +176,34 @@ func (c *Client) GetPolicyGroupCheckResultsPaged(ctx context.Context, policyGrou
 			Meta struct {
 				Pagination *client.Pagination `json:"pagination"`
 			} `json:"meta"`
-			Included []map[string]interface{} `json:"included"`
+			Included []json.RawMessage `json:"included"`
 		}
 		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 			return nil, nil, fmt.Errorf("failed to decode response: %w", err)
 		}
 
-		// Convert to slice of pointers and populate includes
+		// Convert to slice of pointers and resolve includes
+		included := client.NewIncluded(result.Included)
 		items := make([]*schemas.PolicyCheckResult, len(result.Data))
 		for i := range result.Data {
 			items[i] = &result.Data[i]
-			// Populate included resources into relationships
-			if len(result.Included) > 0 {
-				items[i].Relationships.PopulateIncludes(result.Included)
-			}
+			items[i].ResolveIncludes(included)
 		}
 
 		return items, result.Meta.Pagination, nil
 	}
 
-	return client.NewIterator[schemas.PolicyCheckResult](ctx, pageSize, fetchPage)
+	// Checkpoints only resume the iterators of the same listing
+	listOpts := GetPolicyGroupCheckResultsOptions{}
+	if opts != nil {
+		listOpts = *opts
+	}
+	listOpts.PageNumber, listOpts.PageSize = 0, 0
+	filters := client.HashOptions("GetPolicyGroupCheckResults", policyGroupCheck, listOpts)
+
+	return client.NewIterator[schemas.PolicyCheckResult](ctx, pageSize, fetchPage).
+		Prefetch(c.httpClient.Prefetch()).
+		FiltersHash(filters)
 }
 
 // GetPolicyGroupCheckResultsOptions holds optional parameters for GetPolicyGroupCheckResults
@@ -254,7 +215,7 @@ type GetPolicyGroupCheckResultsOptions struct {
 	// The comma-separated list of relationship paths.
 	Include []string
 	// The value of the fields[resource-type] parameter is a comma-separated list that refers to the name of the fields to be returned for the resource. An empty value indicates that no fields should be returned.
-	Fields map[string]interface{}
+	Fields client.Fields
 	// Page number
 	PageNumber int
 	// Page size
@@ -263,3 +224,52 @@ type GetPolicyGroupCheckResultsOptions struct {
 	Sort   []string
 	Filter map[string]string
 }
+
+// Relationship paths accepted by the Include option of GetPolicyGroupCheckResults
+const (
+	GetPolicyGroupCheckResultsIncludeEnvironment                              = "environment"
+	GetPolicyGroupCheckResultsIncludeEnvironmentAccount                       = "environment.account"
+	GetPolicyGroupCheckResultsIncludeEnvironmentCreatedBy                     = "environment.created-by"
+	GetPolicyGroupCheckResultsIncludeEnvironmentDefaultProviderConfigurations = "environment.default-provider-configurations"
+	GetPolicyGroupCheckResultsIncludeEnvironmentDefaultWorkspaceAgentPool     = "environment.default-workspace-agent-pool"
+	GetPolicyGroupCheckResultsIncludeEnvironmentDriftDetectionSchedules       = "environment.drift-detection-schedules"
+	GetPolicyGroupCheckResultsIncludeEnvironmentLockedBy                      = "environment.locked-by"
+	GetPolicyGroupCheckResultsIncludeEnvironmentPolicyGroups                  = "environment.policy-groups"
+	GetPolicyGroupCheckResultsIncludeEnvironmentProviderConfigurations        = "environment.provider-configurations"
+	GetPolicyGroupCheckResultsIncludeEnvironmentStorageProfile                = "environment.storage-profile"
+	GetPolicyGroupCheckResultsIncludeEnvironmentTags                          = "environment.tags"
+	GetPolicyGroupCheckResultsIncludeEnvironmentUpdatedBy                     = "environment.updated-by"
+	GetPolicyGroupCheckResultsIncludePolicyCheck                              = "policy-check"
+	GetPolicyGroupCheckResultsIncludeRun                                      = "run"
+	GetPolicyGroupCheckResultsIncludeRunApply                                 = "run.apply"
+	GetPolicyGroupCheckResultsIncludeRunConfigurationVersion                  = "run.configuration-version"
+	GetPolicyGroupCheckResultsIncludeRunCostEstimate                          = "run.cost-estimate"
+	GetPolicyGroupCheckResultsIncludeRunCreatedBy                             = "run.created-by"
+	GetPolicyGroupCheckResultsIncludeRunCreatedByRun                          = "run.created-by-run"
+	GetPolicyGroupCheckResultsIncludeRunEnvironment                           = "run.environment"
+	GetPolicyGroupCheckResultsIncludeRunPlan                                  = "run.plan"
+	GetPolicyGroupCheckResultsIncludeRunPolicyChecks                          = "run.policy-checks"
+	GetPolicyGroupCheckResultsIncludeRunStateVersions                         = "run.state-versions"
+	GetPolicyGroupCheckResultsIncludeRunStatusTransitions                     = "run.status-transitions"
+	GetPolicyGroupCheckResultsIncludeRunTags                                  = "run.tags"
+	GetPolicyGroupCheckResultsIncludeRunVcsRevision                           = "run.vcs-revision"
+	GetPolicyGroupCheckResultsIncludeRunWorkspace                             = "run.workspace"
+	GetPolicyGroupCheckResultsIncludeWorkspace                                = "workspace"
+	GetPolicyGroupCheckResultsIncludeWorkspaceAgentPool                       = "workspace.agent-pool"
+	GetPolicyGroupCheckResultsIncludeWorkspaceConfigurationVersion            = "workspace.configuration-version"
+	GetPolicyGroupCheckResultsIncludeWorkspaceCreatedBy                       = "workspace.created-by"
+	GetPolicyGroupCheckResultsIncludeWorkspaceCurrentRun                      = "workspace.current-run"
+	GetPolicyGroupCheckResultsIncludeWorkspaceDriftReport                     = "workspace.drift-report"
+	GetPolicyGroupCheckResultsIncludeWorkspaceEnvironment                     = "workspace.environment"
+	GetPolicyGroupCheckResultsIncludeWorkspaceLatestConfigurationVersion      = "workspace.latest-configuration-version"
+	GetPolicyGroupCheckResultsIncludeWorkspaceLatestRun                       = "workspace.latest-run"
+	GetPolicyGroupCheckResultsIncludeWorkspaceLockedBy                        = "workspace.locked-by"
+	GetPolicyGroupCheckResultsIncludeWorkspaceLockedByRun                     = "workspace.locked-by-run"
+	GetPolicyGroupCheckResultsIncludeWorkspaceModule                          = "workspace.module"
+	GetPolicyGroupCheckResultsIncludeWorkspaceModuleVersion                   = "workspace.module-version"
+	GetPolicyGroupCheckResultsIncludeWorkspaceReadmeId                        = "workspace.readme-id"
+	GetPolicyGroupCheckResultsIncludeWorkspaceSshKey                          = "workspace.ssh-key"
+	GetPolicyGroupCheckResultsIncludeWorkspaceTags                            = "workspace.tags"
+	GetPolicyGroupCheckResultsIncludeWorkspaceUpdatedBy                       = "workspace.updated-by"
+	GetPolicyGroupCheckResultsIncludeWorkspaceVcsProvider                     = "workspace.vcs-provider"
+)
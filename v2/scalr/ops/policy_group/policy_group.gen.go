@@ -60,17 +60,15 @@ func (c *Client) CreatePolicyGroup(ctx context.Context, req *schemas.PolicyGroup
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.PolicyGroup      `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.PolicyGroup `json:"data"`
+		Included []json.RawMessage   `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -81,6 +79,33 @@ type CreatePolicyGroupOptions struct {
 	Filter  map[string]string
 }
 
+// Relationship paths accepted by the Include option of CreatePolicyGroup
+const (
+	CreatePolicyGroupIncludeAccount                                   = "account"
+	CreatePolicyGroupIncludeAccountBillingPlan                        = "account.billing-plan"
+	CreatePolicyGroupIncludeAccountIdentityProvider                   = "account.identity-provider"
+	CreatePolicyGroupIncludeAccountOwner                              = "account.owner"
+	CreatePolicyGroupIncludeEnvironments                              = "environments"
+	CreatePolicyGroupIncludeEnvironmentsAccount                       = "environments.account"
+	CreatePolicyGroupIncludeEnvironmentsCreatedBy                     = "environments.created-by"
+	CreatePolicyGroupIncludeEnvironmentsDefaultProviderConfigurations = "environments.default-provider-configurations"
+	CreatePolicyGroupIncludeEnvironmentsDefaultWorkspaceAgentPool     = "environments.default-workspace-agent-pool"
+	CreatePolicyGroupIncludeEnvironmentsDriftDetectionSchedules       = "environments.drift-detection-schedules"
+	CreatePolicyGroupIncludeEnvironmentsLockedBy                      = "environments.locked-by"
+	CreatePolicyGroupIncludeEnvironmentsPolicyGroups                  = "environments.policy-groups"
+	CreatePolicyGroupIncludeEnvironmentsProviderConfigurations        = "environments.provider-configurations"
+	CreatePolicyGroupIncludeEnvironmentsStorageProfile                = "environments.storage-profile"
+	CreatePolicyGroupIncludeEnvironmentsTags                          = "environments.tags"
+	CreatePolicyGroupIncludeEnvironmentsUpdatedBy                     = "environments.updated-by"
+	CreatePolicyGroupIncludePolicies                                  = "policies"
+	CreatePolicyGroupIncludePoliciesPolicyGroup                       = "policies.policy-group"
+	CreatePolicyGroupIncludeVcsProvider                               = "vcs-provider"
+	CreatePolicyGroupIncludeVcsProviderAccount                        = "vcs-provider.account"
+	CreatePolicyGroupIncludeVcsProviderAgentPool                      = "vcs-provider.agent-pool"
+	CreatePolicyGroupIncludeVcsProviderEnvironments                   = "vcs-provider.environments"
+	CreatePolicyGroupIncludeVcsRevision                               = "vcs-revision"
+)
+
 func (c *Client) CreatePolicyGroupEnvironmentsRaw(ctx context.Context, policyGroup string, req []schemas.Environment) (*client.Response, error) {
 	path := "/policy-groups/{policy_group}/relationships/environments"
 	path = strings.ReplaceAll(path, "{policy_group}", url.PathEscape(policyGroup))
@@ -191,17 +216,15 @@ func (c *Client) GetPolicyGroup(ctx context.Context, policyGroup string, opts *G
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.PolicyGroup      `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.PolicyGroup `json:"data"`
+		Included []json.RawMessage   `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -212,6 +235,33 @@ type GetPolicyGroupOptions struct {
 	Filter  map[string]string
 }
 
+// Relationship paths accepted by the Include option of GetPolicyGroup
+const (
+	GetPolicyGroupIncludeAccount                                   = "account"
+	GetPolicyGroupIncludeAccountBillingPlan                        = "account.billing-plan"
+	GetPolicyGroupIncludeAccountIdentityProvider                   = "account.identity-provider"
+	GetPolicyGroupIncludeAccountOwner                              = "account.owner"
+	GetPolicyGroupIncludeEnvironments                              = "environments"
+	GetPolicyGroupIncludeEnvironmentsAccount                       = "environments.account"
+	GetPolicyGroupIncludeEnvironmentsCreatedBy                     = "environments.created-by"
+	GetPolicyGroupIncludeEnvironmentsDefaultProviderConfigurations = "environments.default-provider-configurations"
+	GetPolicyGroupIncludeEnvironmentsDefaultWorkspaceAgentPool     = "environments.default-workspace-agent-pool"
+	GetPolicyGroupIncludeEnvironmentsDriftDetectionSchedules       = "environments.drift-detection-schedules"
+	GetPolicyGroupIncludeEnvironmentsLockedBy                      = "environments.locked-by"
+	GetPolicyGroupIncludeEnvironmentsPolicyGroups                  = "environments.policy-groups"
+	GetPolicyGroupIncludeEnvironmentsProviderConfigurations        = "environments.provider-configurations"
+	GetPolicyGroupIncludeEnvironmentsStorageProfile                = "environments.storage-profile"
+	GetPolicyGroupIncludeEnvironmentsTags                          = "environments.tags"
+	GetPolicyGroupIncludeEnvironmentsUpdatedBy                     = "environments.updated-by"
+	GetPolicyGroupIncludePolicies                                  = "policies"
+	GetPolicyGroupIncludePoliciesPolicyGroup                       = "policies.policy-group"
+	GetPolicyGroupIncludeVcsProvider                               = "vcs-provider"
+	GetPolicyGroupIncludeVcsProviderAccount                        = "vcs-provider.account"
+	GetPolicyGroupIncludeVcsProviderAgentPool                      = "vcs-provider.agent-pool"
+	GetPolicyGroupIncludeVcsProviderEnvironments                   = "vcs-provider.environments"
+	GetPolicyGroupIncludeVcsRevision                               = "vcs-revision"
+)
+
 // This endpoint returns a list of [policy groups](/docs/policy-governance#open-policy-agent).
 func (c *Client) ListPolicyGroupsRaw(ctx context.Context, opts *ListPolicyGroupsOptions) (*client.Response, error) {
 	path := "/policy-groups"
@@ -234,8 +284,7 @@ func (c *Client) ListPolicyGroupsRaw(ctx context.Context, opts *ListPolicyGroups
 		if opts.PageSize > 0 {
 			params.Set("page[size]", fmt.Sprintf("%d", opts.PageSize))
 		}
-		// Handle parameter: Fields (map[string]interface{})
-		// Complex type map[string]interface{} - skip for now
+		opts.Fields.Encode(params)
 		// Add filters
 		for k, v := range opts.Filter {
 			params.Set("filter["+k+"]", v)
@@ -265,19 +314,18 @@ func (c *Client) ListPolicyGroups(ctx context.Context, opts *ListPolicyGroupsOpt
 		Meta struct {
 			Pagination *client.Pagination `json:"pagination"`
 		} `json:"meta"`
-		Included []map[string]interface{} `json:"included"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
+	// Index included resources once to resolve the relationships
+	included := client.NewIncluded(result.Included)
 	resources := make([]*schemas.PolicyGroup, len(result.Data))
 	for i := range result.Data {
 		resources[i] = &result.Data[i]
-		// Populate included resources into relationships
-		if len(result.Included) > 0 {
-			resources[i].Relationships.PopulateIncludes(result.Included)
-		}
+		resources[i].ResolveIncludes(included)
 	}
 	return resources, nil
 }
@@ -286,6 +334,7 @@ func (c *Client) ListPolicyGroups(ctx context.Context, opts *ListPolicyGroupsOpt
 // This is the recommended and simple way to iterate through all the results.
 //
 // The iterator automatically fetches pages as needed and handles errors inline.
+// Pages are fetched ahead in the background when the client is created with client.WithPrefetch.
 //
 // Example:
 //
@@ -297,73 +346,23 @@ func (c *Client) ListPolicyGroups(ctx context.Context, opts *ListPolicyGroupsOpt
 //	}
 func (c *Client) ListPolicyGroupsIter(ctx context.Context, opts *ListPolicyGroupsOptions) iter.Seq2[schemas.PolicyGroup, error] {
 	return func(yield func(schemas.PolicyGroup, error) bool) {
-		// Determine page size from opts or use default
-		pageSize := 20
-		if opts != nil && opts.PageSize > 0 {
-			pageSize = opts.PageSize
-		}
-
-		pageNum := 1
-
-		for {
-			// Check context
-			if err := ctx.Err(); err != nil {
-				yield(schemas.PolicyGroup{}, err)
-				return
-			}
+		it := c.ListPolicyGroupsPaged(ctx, opts)
+		defer it.Close() // Stops the prefetching on early exit
 
-			// Copy options and set page number
-			pageOpts := &ListPolicyGroupsOptions{}
-			if opts != nil {
-				*pageOpts = *opts
-			}
-			pageOpts.PageNumber = pageNum
-			pageOpts.PageSize = pageSize
-
-			// Fetch page using Raw method to get pagination metadata
-			resp, err := c.ListPolicyGroupsRaw(ctx, pageOpts)
-			if err != nil {
-				yield(schemas.PolicyGroup{}, err)
-				return
-			}
-			defer resp.Body.Close()
-
-			// Decode response
-			var result struct {
-				Data []schemas.PolicyGroup `json:"data"`
-				Meta struct {
-					Pagination *client.Pagination `json:"pagination"`
-				} `json:"meta"`
-				Included []map[string]interface{} `json:"included"`
-			}
-			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-				yield(schemas.PolicyGroup{}, fmt.Errorf("failed to decode response: %w", err))
-				return
+		for it.Next() {
+			if !yield(*it.Value(), nil) {
+				return // Consumer requested early exit
 			}
-
-			// Yield each item
-			for i := range result.Data {
-				// Populate included resources into relationships
-				if len(result.Included) > 0 {
-					result.Data[i].Relationships.PopulateIncludes(result.Included)
-				}
-				if !yield(result.Data[i], nil) {
-					return // Consumer requested early exit
-				}
-			}
-
-			// Check if there are more pages
-			if result.Meta.Pagination == nil || result.Meta.Pagination.NextPage == nil {
-				break
-			}
-
-			pageNum++
+		}
+		if err := it.Err(); err != nil {
+			yield(schemas.PolicyGroup{}, err)
 		}
 	}
 }
 
 // ListPolicyGroupsPaged returns a stateful iterator with access to pagination metadata.
-// Use this when you need PageInfo(), Remaining(), or Collect() methods.
+// Use this when you need PageInfo(), Remaining(), or Collect() methods, or to resume
+// an interrupted iteration from the iterator Checkpoint().
 //
 // Example:
 //
@@ -376,6 +375,10 @@ func (c *Client) ListPolicyGroupsIter(ctx context.Context, opts *ListPolicyGroup
 //	if err := iter.Err(); err != nil {
 //	    // Handle error
 //	}
+//
+// Resuming from a checkpoint saved by an iterator with the same options:
+//
+//	iter := client.PolicyGroup.ListPolicyGroupsPaged(ctx, opts).Resume(checkpoint)
 func (c *Client) ListPolicyGroupsPaged(ctx context.Context, opts *ListPolicyGroupsOptions) *client.Iterator[schemas.PolicyGroup] {
 	// Determine page size from opts or use default
 	pageSize := 20
@@ -406,26 +409,34 @@ func (c *Client) ListPolicyGroupsPaged(ctx context.Context, opts *ListPolicyGrou
 			Meta struct {
 				Pagination *client.Pagination `json:"pagination"`
 			} `json:"meta"`
-			Included []map[string]interface{} `json:"included"`
+			Included []json.RawMessage `json:"included"`
 		}
 		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 			return nil, nil, fmt.Errorf("failed to decode response: %w", err)
 		}
 
-		// Convert to slice of pointers and populate includes
+		// Convert to slice of pointers and resolve includes
+		included := client.NewIncluded(result.Included)
 		items := make([]*schemas.PolicyGroup, len(result.Data))
 		for i := range result.Data {
 			items[i] = &result.Data[i]
-			// Populate included resources into relationships
-			if len(result.Included) > 0 {
-				items[i].Relationships.PopulateIncludes(result.Included)
-			}
+			items[i].ResolveIncludes(included)
 		}
 
 		return items, result.Meta.Pagination, nil
 	}
 
-	return client.NewIterator[schemas.PolicyGroup](ctx, pageSize, fetchPage)
+	// Checkpoints only resume the iterators of the same listing
+	listOpts := ListPolicyGroupsOptions{}
+	if opts != nil {
+		listOpts = *opts
+	}
+	listOpts.PageNumber, listOpts.PageSize = 0, 0
+	filters := client.HashOptions("ListPolicyGroups", listOpts)
+
+	return client.NewIterator[schemas.PolicyGroup](ctx, pageSize, fetchPage).
+		Prefetch(c.httpClient.Prefetch()).
+		FiltersHash(filters)
 }
 
 // ListPolicyGroupsOptions holds optional parameters for ListPolicyGroups
@@ -441,10 +452,37 @@ type ListPolicyGroupsOptions struct {
 	// Page size
 	PageSize int
 	// The value of the fields[resource-type] parameter is a comma-separated list that refers to the name of the fields to be returned for the resource. An empty value indicates that no fields should be returned.
-	Fields map[string]interface{}
+	Fields client.Fields
 	Filter map[string]string
 }
 
+// Relationship paths accepted by the Include option of ListPolicyGroups
+const (
+	ListPolicyGroupsIncludeAccount                                   = "account"
+	ListPolicyGroupsIncludeAccountBillingPlan                        = "account.billing-plan"
+	ListPolicyGroupsIncludeAccountIdentityProvider                   = "account.identity-provider"
+	ListPolicyGroupsIncludeAccountOwner                              = "account.owner"
+	ListPolicyGroupsIncludeEnvironments                              = "environments"
+	ListPolicyGroupsIncludeEnvironmentsAccount                       = "environments.account"
+	ListPolicyGroupsIncludeEnvironmentsCreatedBy                     = "environments.created-by"
+	ListPolicyGroupsIncludeEnvironmentsDefaultProviderConfigurations = "environments.default-provider-configurations"
+	ListPolicyGroupsIncludeEnvironmentsDefaultWorkspaceAgentPool     = "environments.default-workspace-agent-pool"
+	ListPolicyGroupsIncludeEnvironmentsDriftDetectionSchedules       = "environments.drift-detection-schedules"
+	ListPolicyGroupsIncludeEnvironmentsLockedBy                      = "environments.locked-by"
+	ListPolicyGroupsIncludeEnvironmentsPolicyGroups                  = "environments.policy-groups"
+	ListPolicyGroupsIncludeEnvironmentsProviderConfigurations        = "environments.provider-configurations"
+	ListPolicyGroupsIncludeEnvironmentsStorageProfile                = "environments.storage-profile"
+	ListPolicyGroupsIncludeEnvironmentsTags                          = "environments.tags"
+	ListPolicyGroupsIncludeEnvironmentsUpdatedBy                     = "environments.updated-by"
+	ListPolicyGroupsIncludePolicies                                  = "policies"
+	ListPolicyGroupsIncludePoliciesPolicyGroup                       = "policies.policy-group"
+	ListPolicyGroupsIncludeVcsProvider                               = "vcs-provider"
+	ListPolicyGroupsIncludeVcsProviderAccount                        = "vcs-provider.account"
+	ListPolicyGroupsIncludeVcsProviderAgentPool                      = "vcs-provider.agent-pool"
+	ListPolicyGroupsIncludeVcsProviderEnvironments                   = "vcs-provider.environments"
+	ListPolicyGroupsIncludeVcsRevision                               = "vcs-revision"
+)
+
 func (c *Client) ListPullRequestPolicyCheckResultsRaw(ctx context.Context, policyGroup string, opts *ListPullRequestPolicyCheckResultsOptions) (*client.Response, error) {
 	path := "/policy-groups/{policy_group}/pull-request-policy-check-results"
 	path = strings.ReplaceAll(path, "{policy_group}", url.PathEscape(policyGroup))
@@ -475,8 +513,7 @@ func (c *Client) ListPullRequestPolicyCheckResultsRaw(ctx context.Context, polic
 		if len(opts.Include) > 0 {
 			params.Set("include", strings.Join(opts.Include, ","))
 		}
-		// Handle parameter: Fields (map[string]interface{})
-		// Complex type map[string]interface{} - skip for now
+		opts.Fields.Encode(params)
 		// Add filters
 		for k, v := range opts.Filter {
 			params.Set("filter["+k+"]", v)
@@ -505,19 +542,18 @@ func (c *Client) ListPullRequestPolicyCheckResults(ctx context.Context, policyGr
 		Meta struct {
 			Pagination *client.Pagination `json:"pagination"`
 		} `json:"meta"`
-		Included []map[string]interface{} `json:"included"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
+	// Index included resources once to resolve the relationships
+	included := client.NewIncluded(result.Included)
 	resources := make([]*schemas.PolicyCheckResult, len(result.Data))
 	for i := range result.Data {
 		resources[i] = &result.Data[i]
-		// Populate included resources into relationships
-		if len(result.Included) > 0 {
-			resources[i].Relationships.PopulateIncludes(result.Included)
-		}
+		resources[i].ResolveIncludes(included)
 	}
 	return resources, nil
 }
@@ -526,6 +562,7 @@ func (c *Client) ListPullRequestPolicyCheckResults(ctx context.Context, policyGr
 // This is the recommended and simple way to iterate through all the results.
 //
 // The iterator automatically fetches pages as needed and handles errors inline.
+// Pages are fetched ahead in the background when the client is created with client.WithPrefetch.
 //
 // Example:
 //
@@ -537,73 +574,23 @@ func (c *Client) ListPullRequestPolicyCheckResults(ctx context.Context, policyGr
 //	}
 func (c *Client) ListPullRequestPolicyCheckResultsIter(ctx context.Context, policyGroup string, opts *ListPullRequestPolicyCheckResultsOptions) iter.Seq2[schemas.PolicyCheckResult, error] {
 	return func(yield func(schemas.PolicyCheckResult, error) bool) {
-		// Determine page size from opts or use default
-		pageSize := 20
-		if opts != nil && opts.PageSize > 0 {
-			pageSize = opts.PageSize
-		}
-
-		pageNum := 1
-
-		for {
-			// Check context
-			if err := ctx.Err(); err != nil {
-				yield(schemas.PolicyCheckResult{}, err)
-				return
-			}
-
-			// Copy options and set page number
-			pageOpts := &ListPullRequestPolicyCheckResultsOptions{}
-			if opts != nil {
-				*pageOpts = *opts
-			}
-			pageOpts.PageNumber = pageNum
-			pageOpts.PageSize = pageSize
-
-			// Fetch page using Raw method to get pagination metadata
-			resp, err := c.ListPullRequestPolicyCheckResultsRaw(ctx, policyGroup, pageOpts)
-			if err != nil {
-				yield(schemas.PolicyCheckResult{}, err)
-				return
-			}
-			defer resp.Body.Close()
-
-			// Decode response
-			var result struct {
-				Data []schemas.PolicyCheckResult `json:"data"`
-				Meta struct {
-					Pagination *client.Pagination `json:"pagination"`
-				} `json:"meta"`
-				Included []map[string]interface{} `json:"included"`
-			}
-			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-				yield(schemas.PolicyCheckResult{}, fmt.Errorf("failed to decode response: %w", err))
-				return
-			}
-
-			// Yield each item
-			for i := range result.Data {
-				// Populate included resources into relationships
-				if len(result.Included) > 0 {
-					result.Data[i].Relationships.PopulateIncludes(result.Included)
-				}
-				if !yield(result.Data[i], nil) {
-					return // Consumer requested early exit
-				}
-			}
+		it := c.ListPullRequestPolicyCheckResultsPaged(ctx, policyGroup, opts)
+		defer it.Close() // Stops the prefetching on early exit
 
-			// Check if there are more pages
-			if result.Meta.Pagination == nil || result.Meta.Pagination.NextPage == nil {
-				break
+		for it.Next() {
+			if !yield(*it.Value(), nil) {
+				return // Consumer requested early exit
 			}
-
-			pageNum++
+		}
+		if err := it.Err(); err != nil {
+			yield(schemas.PolicyCheckResult{}, err)
 		}
 	}
 }
 
 // ListPullRequestPolicyCheckResultsPaged returns a stateful iterator with access to pagination metadata.
-// Use this when you need PageInfo(), Remaining(), or Collect() methods.
+// Use this when you need PageInfo(), Remaining(), or Collect() methods, or to resume
+// an interrupted iteration from the iterator Checkpoint().
 //
 // Example:
 //
@@ -616,6 +603,10 @@ func (c *Client) ListPullRequestPolicyCheckResultsIter(ctx context.Context, poli
 //	if err := iter.Err(); err != nil {
 //	    // Handle error
 //	}
+//
+// Resuming from a checkpoint saved by an iterator with the same options:
+//
+//	iter := client.PolicyGroup.ListPullRequestPolicyCheckResultsPaged(ctx, policyGroup, opts).Resume(checkpoint)
 func (c *Client) ListPullRequestPolicyCheckResultsPaged(ctx context.Context, policyGroup string, opts *ListPullRequestPolicyCheckResultsOptions) *client.Iterator[schemas.PolicyCheckResult] {
 	// Determine page size from opts or use default
 	pageSize := 20
@@ -646,26 +637,34 @@ func (c *Client) ListPullRequestPolicyCheckResultsPaged(ctx context.Context, pol
 			Meta struct {
 				Pagination *client.Pagination `json:"pagination"`
 			} `json:"meta"`
-			Included []map[string]interface{} `json:"included"`
+			Included []json.RawMessage `json:"included"`
 		}
 		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 			return nil, nil, fmt.Errorf("failed to decode response: %w", err)
 		}
 
-		// Convert to slice of pointers and populate includes
+		// Convert to slice of pointers and resolve includes
+		included := client.NewIncluded(result.Included)
 		items := make([]*schemas.PolicyCheckResult, len(result.Data))
 		for i := range result.Data {
 			items[i] = &result.Data[i]
-			// Populate included resources into relationships
-			if len(result.Included) > 0 {
-				items[i].Relationships.PopulateIncludes(result.Included)
-			}
+			items[i].ResolveIncludes(included)
 		}
 
 		return items, result.Meta.Pagination, nil
 	}
 
-	return client.NewIterator[schemas.PolicyCheckResult](ctx, pageSize, fetchPage)
+	// Checkpoints only resume the iterators of the same listing
+	listOpts := ListPullRequestPolicyCheckResultsOptions{}
+	if opts != nil {
+		listOpts = *opts
+	}
+	listOpts.PageNumber, listOpts.PageSize = 0, 0
+	filters := client.HashOptions("ListPullRequestPolicyCheckResults", policyGroup, listOpts)
+
+	return client.NewIterator[schemas.PolicyCheckResult](ctx, pageSize, fetchPage).
+		Prefetch(c.httpClient.Prefetch()).
+		FiltersHash(filters)
 }
 
 // ListPullRequestPolicyCheckResultsOptions holds optional parameters for ListPullRequestPolicyCheckResults
@@ -685,10 +684,59 @@ type ListPullRequestPolicyCheckResultsOptions struct {
 	// The comma-separated list of relationship paths.
 	Include []string
 	// The value of the fields[resource-type] parameter is a comma-separated list that refers to the name of the fields to be returned for the resource. An empty value indicates that no fields should be returned.
-	Fields map[string]interface{}
+	Fields client.Fields
 	Filter map[string]string
 }
 
+// Relationship paths accepted by the Include option of ListPullRequestPolicyCheckResults
+const (
+	ListPullRequestPolicyCheckResultsIncludeEnvironment                              = "environment"
+	ListPullRequestPolicyCheckResultsIncludeEnvironmentAccount                       = "environment.account"
+	ListPullRequestPolicyCheckResultsIncludeEnvironmentCreatedBy                     = "environment.created-by"
+	ListPullRequestPolicyCheckResultsIncludeEnvironmentDefaultProviderConfigurations = "environment.default-provider-configurations"
+	ListPullRequestPolicyCheckResultsIncludeEnvironmentDefaultWorkspaceAgentPool     = "environment.default-workspace-agent-pool"
+	ListPullRequestPolicyCheckResultsIncludeEnvironmentDriftDetectionSchedules       = "environment.drift-detection-schedules"
+	ListPullRequestPolicyCheckResultsIncludeEnvironmentLockedBy                      = "environment.locked-by"
+	ListPullRequestPolicyCheckResultsIncludeEnvironmentPolicyGroups                  = "environment.policy-groups"
+	ListPullRequestPolicyCheckResultsIncludeEnvironmentProviderConfigurations        = "environment.provider-configurations"
+	ListPullRequestPolicyCheckResultsIncludeEnvironmentStorageProfile                = "environment.storage-profile"
+	ListPullRequestPolicyCheckResultsIncludeEnvironmentTags                          = "environment.tags"
+	ListPullRequestPolicyCheckResultsIncludeEnvironmentUpdatedBy                     = "environment.updated-by"
+	ListPullRequestPolicyCheckResultsIncludePolicyCheck                              = "policy-check"
+	ListPullRequestPolicyCheckResultsIncludeRun                                      = "run"
+	ListPullRequestPolicyCheckResultsIncludeRunApply                                 = "run.apply"
+	ListPullRequestPolicyCheckResultsIncludeRunConfigurationVersion                  = "run.configuration-version"
+	ListPullRequestPolicyCheckResultsIncludeRunCostEstimate                          = "run.cost-estimate"
+	ListPullRequestPolicyCheckResultsIncludeRunCreatedBy                             = "run.created-by"
+	ListPullRequestPolicyCheckResultsIncludeRunCreatedByRun                          = "run.created-by-run"
+	ListPullRequestPolicyCheckResultsIncludeRunEnvironment                           = "run.environment"
+	ListPullRequestPolicyCheckResultsIncludeRunPlan                                  = "run.plan"
+	ListPullRequestPolicyCheckResultsIncludeRunPolicyChecks                          = "run.policy-checks"
+	ListPullRequestPolicyCheckResultsIncludeRunStateVersions                         = "run.state-versions"
+	ListPullRequestPolicyCheckResultsIncludeRunStatusTransitions                     = "run.status-transitions"
+	ListPullRequestPolicyCheckResultsIncludeRunTags                                  = "run.tags"
+	ListPullRequestPolicyCheckResultsIncludeRunVcsRevision                           = "run.vcs-revision"
+	ListPullRequestPolicyCheckResultsIncludeRunWorkspace                             = "run.workspace"
+	ListPullRequestPolicyCheckResultsIncludeWorkspace                                = "workspace"
+	ListPullRequestPolicyCheckResultsIncludeWorkspaceAgentPool                       = "workspace.agent-pool"
+	ListPullRequestPolicyCheckResultsIncludeWorkspaceConfigurationVersion            = "workspace.configuration-version"
+	ListPullRequestPolicyCheckResultsIncludeWorkspaceCreatedBy                       = "workspace.created-by"
+	ListPullRequestPolicyCheckResultsIncludeWorkspaceCurrentRun                      = "workspace.current-run"
+	ListPullRequestPolicyCheckResultsIncludeWorkspaceDriftReport                     = "workspace.drift-report"
+	ListPullRequestPolicyCheckResultsIncludeWorkspaceEnvironment                     = "workspace.environment"
+	ListPullRequestPolicyCheckResultsIncludeWorkspaceLatestConfigurationVersion      = "workspace.latest-configuration-version"
+	ListPullRequestPolicyCheckResultsIncludeWorkspaceLatestRun                       = "workspace.latest-run"
+	ListPullRequestPolicyCheckResultsIncludeWorkspaceLockedBy                        = "workspace.locked-by"
+	ListPullRequestPolicyCheckResultsIncludeWorkspaceLockedByRun                     = "workspace.locked-by-run"
+	ListPullRequestPolicyCheckResultsIncludeWorkspaceModule                          = "workspace.module"
+	ListPullRequestPolicyCheckResultsIncludeWorkspaceModuleVersion                   = "workspace.module-version"
+	ListPullRequestPolicyCheckResultsIncludeWorkspaceReadmeId                        = "workspace.readme-id"
+	ListPullRequestPolicyCheckResultsIncludeWorkspaceSshKey                          = "workspace.ssh-key"
+	ListPullRequestPolicyCheckResultsIncludeWorkspaceTags                            = "workspace.tags"
+	ListPullRequestPolicyCheckResultsIncludeWorkspaceUpdatedBy                       = "workspace.updated-by"
+	ListPullRequestPolicyCheckResultsIncludeWorkspaceVcsProvider                     = "workspace.vcs-provider"
+)
+
 // This endpoint resyncs a [policy group](/docs/policy-governance#open-policy-agent).
 func (c *Client) ResyncPolicyGroupRaw(ctx context.Context, policyGroup string) (*client.Response, error) {
 	path := "/policy-groups/{policy_group}/actions/resync"
@@ -749,17 +797,15 @@ func (c *Client) UpdatePolicyGroup(ctx context.Context, policyGroup string, req
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.PolicyGroup      `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.PolicyGroup `json:"data"`
+		Included []json.RawMessage   `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -770,6 +816,33 @@ type UpdatePolicyGroupOptions struct {
 	Filter  map[string]string
 }
 
+// Relationship paths accepted by the Include option of UpdatePolicyGroup
+const (
+	UpdatePolicyGroupIncludeAccount                                   = "account"
+	UpdatePolicyGroupIncludeAccountBillingPlan                        = "account.billing-plan"
+	UpdatePolicyGroupIncludeAccountIdentityProvider                   = "account.identity-provider"
+	UpdatePolicyGroupIncludeAccountOwner                              = "account.owner"
+	UpdatePolicyGroupIncludeEnvironments                              = "environments"
+	UpdatePolicyGroupIncludeEnvironmentsAccount                       = "environments.account"
+	UpdatePolicyGroupIncludeEnvironmentsCreatedBy                     = "environments.created-by"
+	UpdatePolicyGroupIncludeEnvironmentsDefaultProviderConfigurations = "environments.default-provider-configurations"
+	UpdatePolicyGroupIncludeEnvironmentsDefaultWorkspaceAgentPool     = "environments.default-workspace-agent-pool"
+	UpdatePolicyGroupIncludeEnvironmentsDriftDetectionSchedules       = "environments.drift-detection-schedules"
+	UpdatePolicyGroupIncludeEnvironmentsLockedBy                      = "environments.locked-by"
+	UpdatePolicyGroupIncludeEnvironmentsPolicyGroups                  = "environments.policy-groups"
+	UpdatePolicyGroupIncludeEnvironmentsProviderConfigurations        = "environments.provider-configurations"
+	UpdatePolicyGroupIncludeEnvironmentsStorageProfile                = "environments.storage-profile"
+	UpdatePolicyGroupIncludeEnvironmentsTags                          = "environments.tags"
+	UpdatePolicyGroupIncludeEnvironmentsUpdatedBy                     = "environments.updated-by"
+	UpdatePolicyGroupIncludePolicies                                  = "policies"
+	UpdatePolicyGroupIncludePoliciesPolicyGroup                       = "policies.policy-group"
+	UpdatePolicyGroupIncludeVcsProvider                               = "vcs-provider"
+	UpdatePolicyGroupIncludeVcsProviderAccount                        = "vcs-provider.account"
+	UpdatePolicyGroupIncludeVcsProviderAgentPool                      = "vcs-provider.agent-pool"
+	UpdatePolicyGroupIncludeVcsProviderEnvironments                   = "vcs-provider.environments"
+	UpdatePolicyGroupIncludeVcsRevision                               = "vcs-revision"
+)
+
 func (c *Client) UpdatePolicyGroupEnvironmentsRaw(ctx context.Context, policyGroup string, req []schemas.Environment) (*client.Response, error) {
 	path := "/policy-groups/{policy_group}/relationships/environments"
 	path = strings.ReplaceAll(path, "{policy_group}", url.PathEscape(policyGroup))
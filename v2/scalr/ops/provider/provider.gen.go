@@ -46,17 +46,15 @@ func (c *Client) CreateProvider(ctx context.Context, req *schemas.ProviderReques
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.Provider         `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.Provider  `json:"data"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -93,8 +91,7 @@ func (c *Client) GetProviderRaw(ctx context.Context, provider string, opts *GetP
 		if len(opts.Include) > 0 {
 			params.Set("include", strings.Join(opts.Include, ","))
 		}
-		// Handle parameter: Fields (map[string]interface{})
-		// Complex type map[string]interface{} - skip for now
+		opts.Fields.Encode(params)
 		// Add filters
 		for k, v := range opts.Filter {
 			params.Set("filter["+k+"]", v)
@@ -120,17 +117,15 @@ func (c *Client) GetProvider(ctx context.Context, provider string, opts *GetProv
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.Provider         `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.Provider  `json:"data"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -139,10 +134,22 @@ type GetProviderOptions struct {
 	// The comma-separated list of relationship paths.
 	Include []string
 	// The value of the fields[resource-type] parameter is a comma-separated list that refers to the name of the fields to be returned for the resource. An empty value indicates that no fields should be returned.
-	Fields map[string]interface{}
+	Fields client.Fields
 	Filter map[string]string
 }
 
+// Relationship paths accepted by the Include option of GetProvider
+const (
+	GetProviderIncludeLatestProviderVersion         = "latest-provider-version"
+	GetProviderIncludeLatestProviderVersionGpgKey   = "latest-provider-version.gpg-key"
+	GetProviderIncludeLatestProviderVersionProvider = "latest-provider-version.provider"
+	GetProviderIncludeLatestProviderVersionReadme   = "latest-provider-version.readme"
+	GetProviderIncludeProviderVersion               = "provider-version"
+	GetProviderIncludeProviderVersionGpgKey         = "provider-version.gpg-key"
+	GetProviderIncludeProviderVersionProvider       = "provider-version.provider"
+	GetProviderIncludeProviderVersionReadme         = "provider-version.readme"
+)
+
 // This endpoint returns a list of registry providers.
 func (c *Client) ListProvidersRaw(ctx context.Context, opts *ListProvidersOptions) (*client.Response, error) {
 	path := "/providers"
@@ -165,8 +172,7 @@ func (c *Client) ListProvidersRaw(ctx context.Context, opts *ListProvidersOption
 		if len(opts.Sort) > 0 {
 			params.Set("sort", strings.Join(opts.Sort, ","))
 		}
-		// Handle parameter: Fields (map[string]interface{})
-		// Complex type map[string]interface{} - skip for now
+		opts.Fields.Encode(params)
 		// Add filters
 		for k, v := range opts.Filter {
 			params.Set("filter["+k+"]", v)
@@ -196,19 +202,18 @@ func (c *Client) ListProviders(ctx context.Context, opts *ListProvidersOptions)
 		Meta struct {
 			Pagination *client.Pagination `json:"pagination"`
 		} `json:"meta"`
-		Included []map[string]interface{} `json:"included"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
+	// Index included resources once to resolve the relationships
+	included := client.NewIncluded(result.Included)
 	resources := make([]*schemas.Provider, len(result.Data))
 	for i := range result.Data {
 		resources[i] = &result.Data[i]
-		// Populate included resources into relationships
-		if len(result.Included) > 0 {
-			resources[i].Relationships.PopulateIncludes(result.Included)
-		}
+		resources[i].ResolveIncludes(included)
 	}
 	return resources, nil
 }
@@ -217,6 +222,7 @@ func (c *Client) ListProviders(ctx context.Context, opts *ListProvidersOptions)
 // This is the recommended and simple way to iterate through all the results.
 //
 // The iterator automatically fetches pages as needed and handles errors inline.
+// Pages are fetched ahead in the background when the client is created with client.WithPrefetch.
 //
 // Example:
 //
@@ -228,73 +234,23 @@ func (c *Client) ListProviders(ctx context.Context, opts *ListProvidersOptions)
 //	}
 func (c *Client) ListProvidersIter(ctx context.Context, opts *ListProvidersOptions) iter.Seq2[schemas.Provider, error] {
 	return func(yield func(schemas.Provider, error) bool) {
-		// Determine page size from opts or use default
-		pageSize := 20
-		if opts != nil && opts.PageSize > 0 {
-			pageSize = opts.PageSize
-		}
-
-		pageNum := 1
-
-		for {
-			// Check context
-			if err := ctx.Err(); err != nil {
-				yield(schemas.Provider{}, err)
-				return
-			}
-
-			// Copy options and set page number
-			pageOpts := &ListProvidersOptions{}
-			if opts != nil {
-				*pageOpts = *opts
-			}
-			pageOpts.PageNumber = pageNum
-			pageOpts.PageSize = pageSize
-
-			// Fetch page using Raw method to get pagination metadata
-			resp, err := c.ListProvidersRaw(ctx, pageOpts)
-			if err != nil {
-				yield(schemas.Provider{}, err)
-				return
-			}
-			defer resp.Body.Close()
-
-			// Decode response
-			var result struct {
-				Data []schemas.Provider `json:"data"`
-				Meta struct {
-					Pagination *client.Pagination `json:"pagination"`
-				} `json:"meta"`
-				Included []map[string]interface{} `json:"included"`
-			}
-			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-				yield(schemas.Provider{}, fmt.Errorf("failed to decode response: %w", err))
-				return
-			}
+		it := c.ListProvidersPaged(ctx, opts)
+		defer it.Close() // Stops the prefetching on early exit
 
-			// Yield each item
-			for i := range result.Data {
-				// Populate included resources into relationships
-				if len(result.Included) > 0 {
-					result.Data[i].Relationships.PopulateIncludes(result.Included)
-				}
-				if !yield(result.Data[i], nil) {
-					return // Consumer requested early exit
-				}
+		for it.Next() {
+			if !yield(*it.Value(), nil) {
+				return // Consumer requested early exit
 			}
-
-			// Check if there are more pages
-			if result.Meta.Pagination == nil || result.Meta.Pagination.NextPage == nil {
-				break
-			}
-
-			pageNum++
+		}
+		if err := it.Err(); err != nil {
+			yield(schemas.Provider{}, err)
 		}
 	}
 }
 
 // ListProvidersPaged returns a stateful iterator with access to pagination metadata.
-// Use this when you need PageInfo(), Remaining(), or Collect() methods.
+// Use this when you need PageInfo(), Remaining(), or Collect() methods, or to resume
+// an interrupted iteration from the iterator Checkpoint().
 //
 // Example:
 //
@@ -307,6 +263,10 @@ func (c *Client) ListProvidersIter(ctx context.Context, opts *ListProvidersOptio
 //	if err := iter.Err(); err != nil {
 //	    // Handle error
 //	}
+//
+// Resuming from a checkpoint saved by an iterator with the same options:
+//
+//	iter := client.Provider.ListProvidersPaged(ctx, opts).Resume(checkpoint)
 func (c *Client) ListProvidersPaged(ctx context.Context, opts *ListProvidersOptions) *client.Iterator[schemas.Provider] {
 	// Determine page size from opts or use default
 	pageSize := 20
@@ -337,26 +297,34 @@ func (c *Client) ListProvidersPaged(ctx context.Context, opts *ListProvidersOpti
 			Meta struct {
 				Pagination *client.Pagination `json:"pagination"`
 			} `json:"meta"`
-			Included []map[string]interface{} `json:"included"`
+			Included []json.RawMessage `json:"included"`
 		}
 		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 			return nil, nil, fmt.Errorf("failed to decode response: %w", err)
 		}
 
-		// Convert to slice of pointers and populate includes
+		// Convert to slice of pointers and resolve includes
+		included := client.NewIncluded(result.Included)
 		items := make([]*schemas.Provider, len(result.Data))
 		for i := range result.Data {
 			items[i] = &result.Data[i]
-			// Populate included resources into relationships
-			if len(result.Included) > 0 {
-				items[i].Relationships.PopulateIncludes(result.Included)
-			}
+			items[i].ResolveIncludes(included)
 		}
 
 		return items, result.Meta.Pagination, nil
 	}
 
-	return client.NewIterator[schemas.Provider](ctx, pageSize, fetchPage)
+	// Checkpoints only resume the iterators of the same listing
+	listOpts := ListProvidersOptions{}
+	if opts != nil {
+		listOpts = *opts
+	}
+	listOpts.PageNumber, listOpts.PageSize = 0, 0
+	filters := client.HashOptions("ListProviders", listOpts)
+
+	return client.NewIterator[schemas.Provider](ctx, pageSize, fetchPage).
+		Prefetch(c.httpClient.Prefetch()).
+		FiltersHash(filters)
 }
 
 // ListProvidersOptions holds optional parameters for ListProviders
@@ -372,10 +340,22 @@ type ListProvidersOptions struct {
 	// The comma-separated list of attributes.
 	Sort []string
 	// The value of the fields[resource-type] parameter is a comma-separated list that refers to the name of the fields to be returned for the resource. An empty value indicates that no fields should be returned.
-	Fields map[string]interface{}
+	Fields client.Fields
 	Filter map[string]string
 }
 
+// Relationship paths accepted by the Include option of ListProviders
+const (
+	ListProvidersIncludeLatestProviderVersion         = "latest-provider-version"
+	ListProvidersIncludeLatestProviderVersionGpgKey   = "latest-provider-version.gpg-key"
+	ListProvidersIncludeLatestProviderVersionProvider = "latest-provider-version.provider"
+	ListProvidersIncludeLatestProviderVersionReadme   = "latest-provider-version.readme"
+	ListProvidersIncludeProviderVersion               = "provider-version"
+	ListProvidersIncludeProviderVersionGpgKey         = "provider-version.gpg-key"
+	ListProvidersIncludeProviderVersionProvider       = "provider-version.provider"
+	ListProvidersIncludeProviderVersionReadme         = "provider-version.readme"
+)
+
 // This endpoint updates a registry provider.
 func (c *Client) UpdateProviderRaw(ctx context.Context, provider string, req *schemas.ProviderRequest) (*client.Response, error) {
 	path := "/providers/{provider}"
@@ -399,16 +379,14 @@ func (c *Client) UpdateProvider(ctx context.Context, provider string, req *schem
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.Provider         `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.Provider  `json:"data"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
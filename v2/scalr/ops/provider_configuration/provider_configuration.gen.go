@@ -80,16 +80,14 @@ func (c *Client) CreateProviderConfiguration(ctx context.Context, req *schemas.P
 
 	var result struct {
 		Data     schemas.ProviderConfiguration `json:"data"`
-		Included []map[string]interface{}      `json:"included"`
+		Included []json.RawMessage             `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -184,16 +182,14 @@ func (c *Client) GetProviderConfiguration(ctx context.Context, providerConfigura
 
 	var result struct {
 		Data     schemas.ProviderConfiguration `json:"data"`
-		Included []map[string]interface{}      `json:"included"`
+		Included []json.RawMessage             `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -204,6 +200,34 @@ type GetProviderConfigurationOptions struct {
 	Filter  map[string]string
 }
 
+// Relationship paths accepted by the Include option of GetProviderConfiguration
+const (
+	GetProviderConfigurationIncludeAccount                                   = "account"
+	GetProviderConfigurationIncludeAccountBillingPlan                        = "account.billing-plan"
+	GetProviderConfigurationIncludeAccountIdentityProvider                   = "account.identity-provider"
+	GetProviderConfigurationIncludeAccountOwner                              = "account.owner"
+	GetProviderConfigurationIncludeEnvironments                              = "environments"
+	GetProviderConfigurationIncludeEnvironmentsAccount                       = "environments.account"
+	GetProviderConfigurationIncludeEnvironmentsCreatedBy                     = "environments.created-by"
+	GetProviderConfigurationIncludeEnvironmentsDefaultProviderConfigurations = "environments.default-provider-configurations"
+	GetProviderConfigurationIncludeEnvironmentsDefaultWorkspaceAgentPool     = "environments.default-workspace-agent-pool"
+	GetProviderConfigurationIncludeEnvironmentsDriftDetectionSchedules       = "environments.drift-detection-schedules"
+	GetProviderConfigurationIncludeEnvironmentsLockedBy                      = "environments.locked-by"
+	GetProviderConfigurationIncludeEnvironmentsPolicyGroups                  = "environments.policy-groups"
+	GetProviderConfigurationIncludeEnvironmentsProviderConfigurations        = "environments.provider-configurations"
+	GetProviderConfigurationIncludeEnvironmentsStorageProfile                = "environments.storage-profile"
+	GetProviderConfigurationIncludeEnvironmentsTags                          = "environments.tags"
+	GetProviderConfigurationIncludeEnvironmentsUpdatedBy                     = "environments.updated-by"
+	GetProviderConfigurationIncludeOwners                                    = "owners"
+	GetProviderConfigurationIncludeOwnersAccount                             = "owners.account"
+	GetProviderConfigurationIncludeOwnersIdentityProvider                    = "owners.identity-provider"
+	GetProviderConfigurationIncludeOwnersUsers                               = "owners.users"
+	GetProviderConfigurationIncludeParameters                                = "parameters"
+	GetProviderConfigurationIncludeParametersProviderConfiguration           = "parameters.provider-configuration"
+	GetProviderConfigurationIncludeTags                                      = "tags"
+	GetProviderConfigurationIncludeTagsAccount                               = "tags.account"
+)
+
 // Returns a list of workspaces that use the given provider configuration.
 func (c *Client) GetProviderConfigurationWorkspaceUsageRaw(ctx context.Context, providerConfiguration string, opts *GetProviderConfigurationWorkspaceUsageOptions) (*client.Response, error) {
 	path := "/provider-configurations/{provider_configuration}/workspaces-usage"
@@ -304,7 +328,7 @@ func (c *Client) ListProviderConfigurationTags(ctx context.Context, providerConf
 		Meta struct {
 			Pagination *client.Pagination `json:"pagination"`
 		} `json:"meta"`
-		Included []map[string]interface{} `json:"included"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
@@ -321,6 +345,7 @@ func (c *Client) ListProviderConfigurationTags(ctx context.Context, providerConf
 // This is the recommended and simple way to iterate through all the results.
 //
 // The iterator automatically fetches pages as needed and handles errors inline.
+// Pages are fetched ahead in the background when the client is created with client.WithPrefetch.
 //
 // Example:
 //
@@ -332,69 +357,23 @@ func (c *Client) ListProviderConfigurationTags(ctx context.Context, providerConf
 //	}
 func (c *Client) ListProviderConfigurationTagsIter(ctx context.Context, providerConfiguration string, opts *ListProviderConfigurationTagsOptions) iter.Seq2[schemas.Tag, error] {
 	return func(yield func(schemas.Tag, error) bool) {
-		// Determine page size from opts or use default
-		pageSize := 20
-		if opts != nil && opts.PageSize > 0 {
-			pageSize = opts.PageSize
-		}
-
-		pageNum := 1
+		it := c.ListProviderConfigurationTagsPaged(ctx, providerConfiguration, opts)
+		defer it.Close() // Stops the prefetching on early exit
 
-		for {
-			// Check context
-			if err := ctx.Err(); err != nil {
-				yield(schemas.Tag{}, err)
-				return
+		for it.Next() {
+			if !yield(*it.Value(), nil) {
+				return // Consumer requested early exit
 			}
-
-			// Copy options and set page number
-			pageOpts := &ListProviderConfigurationTagsOptions{}
-			if opts != nil {
-				*pageOpts = *opts
-			}
-			pageOpts.PageNumber = pageNum
-			pageOpts.PageSize = pageSize
-
-			// Fetch page using Raw method to get pagination metadata
-			resp, err := c.ListProviderConfigurationTagsRaw(ctx, providerConfiguration, pageOpts)
-			if err != nil {
-				yield(schemas.Tag{}, err)
-				return
-			}
-			defer resp.Body.Close()
-
-			// Decode response
-			var result struct {
-				Data []schemas.Tag `json:"data"`
-				Meta struct {
-					Pagination *client.Pagination `json:"pagination"`
-				} `json:"meta"`
-				Included []map[string]interface{} `json:"included"`
-			}
-			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-				yield(schemas.Tag{}, fmt.Errorf("failed to decode response: %w", err))
-				return
-			}
-
-			// Yield each item
-			for i := range result.Data {
-				if !yield(result.Data[i], nil) {
-					return // Consumer requested early exit
-				}
-			}
-
-			// Check if there are more pages
-			if result.Meta.Pagination == nil || result.Meta.Pagination.NextPage == nil {
-				break
-			}
-
-			pageNum++
+		}
+		if err := it.Err(); err != nil {
+			yield(schemas.Tag{}, err)
 		}
 	}
 }
 
 // ListProviderConfigurationTagsPaged returns a stateful iterator with access to pagination metadata.
-// Use this when you need PageInfo(), Remaining(), or Collect() methods.
+// Use this when you need PageInfo(), Remaining(), or Collect() methods, or to resume
+// an interrupted iteration from the iterator Checkpoint().
 //
 // Example:
 //
@@ -407,6 +386,10 @@ func (c *Client) ListProviderConfigurationTagsIter(ctx context.Context, provider
 //	if err := iter.Err(); err != nil {
 //	    // Handle error
 //	}
+//
+// Resuming from a checkpoint saved by an iterator with the same options:
+//
+//	iter := client.ProviderConfiguration.ListProviderConfigurationTagsPaged(ctx, providerConfiguration, opts).Resume(checkpoint)
 func (c *Client) ListProviderConfigurationTagsPaged(ctx context.Context, providerConfiguration string, opts *ListProviderConfigurationTagsOptions) *client.Iterator[schemas.Tag] {
 	// Determine page size from opts or use default
 	pageSize := 20
@@ -437,13 +420,13 @@ func (c *Client) ListProviderConfigurationTagsPaged(ctx context.Context, provide
 			Meta struct {
 				Pagination *client.Pagination `json:"pagination"`
 			} `json:"meta"`
-			Included []map[string]interface{} `json:"included"`
+			Included []json.RawMessage `json:"included"`
 		}
 		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 			return nil, nil, fmt.Errorf("failed to decode response: %w", err)
 		}
 
-		// Convert to slice of pointers and populate includes
+		// Convert to slice of pointers and resolve includes
 		items := make([]*schemas.Tag, len(result.Data))
 		for i := range result.Data {
 			items[i] = &result.Data[i]
@@ -452,7 +435,17 @@ func (c *Client) ListProviderConfigurationTagsPaged(ctx context.Context, provide
 		return items, result.Meta.Pagination, nil
 	}
 
-	return client.NewIterator[schemas.Tag](ctx, pageSize, fetchPage)
+	// Checkpoints only resume the iterators of the same listing
+	listOpts := ListProviderConfigurationTagsOptions{}
+	if opts != nil {
+		listOpts = *opts
+	}
+	listOpts.PageNumber, listOpts.PageSize = 0, 0
+	filters := client.HashOptions("ListProviderConfigurationTags", providerConfiguration, listOpts)
+
+	return client.NewIterator[schemas.Tag](ctx, pageSize, fetchPage).
+		Prefetch(c.httpClient.Prefetch()).
+		FiltersHash(filters)
 }
 
 // ListProviderConfigurationTagsOptions holds optional parameters for ListProviderConfigurationTags
@@ -482,8 +475,7 @@ func (c *Client) ListProviderConfigurationsRaw(ctx context.Context, opts *ListPr
 		if len(opts.Include) > 0 {
 			params.Set("include", strings.Join(opts.Include, ","))
 		}
-		// Handle parameter: Fields (map[string]interface{})
-		// Complex type map[string]interface{} - skip for now
+		opts.Fields.Encode(params)
 		// Add filters
 		for k, v := range opts.Filter {
 			params.Set("filter["+k+"]", v)
@@ -513,19 +505,18 @@ func (c *Client) ListProviderConfigurations(ctx context.Context, opts *ListProvi
 		Meta struct {
 			Pagination *client.Pagination `json:"pagination"`
 		} `json:"meta"`
-		Included []map[string]interface{} `json:"included"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
+	// Index included resources once to resolve the relationships
+	included := client.NewIncluded(result.Included)
 	resources := make([]*schemas.ProviderConfiguration, len(result.Data))
 	for i := range result.Data {
 		resources[i] = &result.Data[i]
-		// Populate included resources into relationships
-		if len(result.Included) > 0 {
-			resources[i].Relationships.PopulateIncludes(result.Included)
-		}
+		resources[i].ResolveIncludes(included)
 	}
 	return resources, nil
 }
@@ -534,6 +525,7 @@ func (c *Client) ListProviderConfigurations(ctx context.Context, opts *ListProvi
 // This is the recommended and simple way to iterate through all the results.
 //
 // The iterator automatically fetches pages as needed and handles errors inline.
+// Pages are fetched ahead in the background when the client is created with client.WithPrefetch.
 //
 // Example:
 //
@@ -545,73 +537,23 @@ func (c *Client) ListProviderConfigurations(ctx context.Context, opts *ListProvi
 //	}
 func (c *Client) ListProviderConfigurationsIter(ctx context.Context, opts *ListProviderConfigurationsOptions) iter.Seq2[schemas.ProviderConfiguration, error] {
 	return func(yield func(schemas.ProviderConfiguration, error) bool) {
-		// Determine page size from opts or use default
-		pageSize := 20
-		if opts != nil && opts.PageSize > 0 {
-			pageSize = opts.PageSize
-		}
-
-		pageNum := 1
-
-		for {
-			// Check context
-			if err := ctx.Err(); err != nil {
-				yield(schemas.ProviderConfiguration{}, err)
-				return
-			}
-
-			// Copy options and set page number
-			pageOpts := &ListProviderConfigurationsOptions{}
-			if opts != nil {
-				*pageOpts = *opts
-			}
-			pageOpts.PageNumber = pageNum
-			pageOpts.PageSize = pageSize
-
-			// Fetch page using Raw method to get pagination metadata
-			resp, err := c.ListProviderConfigurationsRaw(ctx, pageOpts)
-			if err != nil {
-				yield(schemas.ProviderConfiguration{}, err)
-				return
-			}
-			defer resp.Body.Close()
-
-			// Decode response
-			var result struct {
-				Data []schemas.ProviderConfiguration `json:"data"`
-				Meta struct {
-					Pagination *client.Pagination `json:"pagination"`
-				} `json:"meta"`
-				Included []map[string]interface{} `json:"included"`
-			}
-			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-				yield(schemas.ProviderConfiguration{}, fmt.Errorf("failed to decode response: %w", err))
-				return
-			}
-
-			// Yield each item
-			for i := range result.Data {
-				// Populate included resources into relationships
-				if len(result.Included) > 0 {
-					result.Data[i].Relationships.PopulateIncludes(result.Included)
-				}
-				if !yield(result.Data[i], nil) {
-					return // Consumer requested early exit
-				}
-			}
+		it := c.ListProviderConfigurationsPaged(ctx, opts)
+		defer it.Close() // Stops the prefetching on early exit
 
-			// Check if there are more pages
-			if result.Meta.Pagination == nil || result.Meta.Pagination.NextPage == nil {
-				break
+		for it.Next() {
+			if !yield(*it.Value(), nil) {
+				return // Consumer requested early exit
 			}
-
-			pageNum++
+		}
+		if err := it.Err(); err != nil {
+			yield(schemas.ProviderConfiguration{}, err)
 		}
 	}
 }
 
 // ListProviderConfigurationsPaged returns a stateful iterator with access to pagination metadata.
-// Use this when you need PageInfo(), Remaining(), or Collect() methods.
+// Use this when you need PageInfo(), Remaining(), or Collect() methods, or to resume
+// an interrupted iteration from the iterator Checkpoint().
 //
 // Example:
 //
@@ -624,6 +566,10 @@ func (c *Client) ListProviderConfigurationsIter(ctx context.Context, opts *ListP
 //	if err := iter.Err(); err != nil {
 //	    // Handle error
 //	}
+//
+// Resuming from a checkpoint saved by an iterator with the same options:
+//
+//	iter := client.ProviderConfiguration.ListProviderConfigurationsPaged(ctx, opts).Resume(checkpoint)
 func (c *Client) ListProviderConfigurationsPaged(ctx context.Context, opts *ListProviderConfigurationsOptions) *client.Iterator[schemas.ProviderConfiguration] {
 	// Determine page size from opts or use default
 	pageSize := 20
@@ -654,26 +600,34 @@ func (c *Client) ListProviderConfigurationsPaged(ctx context.Context, opts *List
 			Meta struct {
 				Pagination *client.Pagination `json:"pagination"`
 			} `json:"meta"`
-			Included []map[string]interface{} `json:"included"`
+			Included []json.RawMessage `json:"included"`
 		}
 		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 			return nil, nil, fmt.Errorf("failed to decode response: %w", err)
 		}
 
-		// Convert to slice of pointers and populate includes
+		// Convert to slice of pointers and resolve includes
+		included := client.NewIncluded(result.Included)
 		items := make([]*schemas.ProviderConfiguration, len(result.Data))
 		for i := range result.Data {
 			items[i] = &result.Data[i]
-			// Populate included resources into relationships
-			if len(result.Included) > 0 {
-				items[i].Relationships.PopulateIncludes(result.Included)
-			}
+			items[i].ResolveIncludes(included)
 		}
 
 		return items, result.Meta.Pagination, nil
 	}
 
-	return client.NewIterator[schemas.ProviderConfiguration](ctx, pageSize, fetchPage)
+	// Checkpoints only resume the iterators of the same listing
+	listOpts := ListProviderConfigurationsOptions{}
+	if opts != nil {
+		listOpts = *opts
+	}
+	listOpts.PageNumber, listOpts.PageSize = 0, 0
+	filters := client.HashOptions("ListProviderConfigurations", listOpts)
+
+	return client.NewIterator[schemas.ProviderConfiguration](ctx, pageSize, fetchPage).
+		Prefetch(c.httpClient.Prefetch()).
+		FiltersHash(filters)
 }
 
 // ListProviderConfigurationsOptions holds optional parameters for ListProviderConfigurations
@@ -687,10 +641,38 @@ type ListProviderConfigurationsOptions struct {
 	// The comma-separated list of relationship paths.
 	Include []string
 	// The value of the fields[resource-type] parameter is a comma-separated list that refers to the name of the fields to be returned for the resource. An empty value indicates that no fields should be returned.
-	Fields map[string]interface{}
+	Fields client.Fields
 	Filter map[string]string
 }
 
+// Relationship paths accepted by the Include option of ListProviderConfigurations
+const (
+	ListProviderConfigurationsIncludeAccount                                   = "account"
+	ListProviderConfigurationsIncludeAccountBillingPlan                        = "account.billing-plan"
+	ListProviderConfigurationsIncludeAccountIdentityProvider                   = "account.identity-provider"
+	ListProviderConfigurationsIncludeAccountOwner                              = "account.owner"
+	ListProviderConfigurationsIncludeEnvironments                              = "environments"
+	ListProviderConfigurationsIncludeEnvironmentsAccount                       = "environments.account"
+	ListProviderConfigurationsIncludeEnvironmentsCreatedBy                     = "environments.created-by"
+	ListProviderConfigurationsIncludeEnvironmentsDefaultProviderConfigurations = "environments.default-provider-configurations"
+	ListProviderConfigurationsIncludeEnvironmentsDefaultWorkspaceAgentPool     = "environments.default-workspace-agent-pool"
+	ListProviderConfigurationsIncludeEnvironmentsDriftDetectionSchedules       = "environments.drift-detection-schedules"
+	ListProviderConfigurationsIncludeEnvironmentsLockedBy                      = "environments.locked-by"
+	ListProviderConfigurationsIncludeEnvironmentsPolicyGroups                  = "environments.policy-groups"
+	ListProviderConfigurationsIncludeEnvironmentsProviderConfigurations        = "environments.provider-configurations"
+	ListProviderConfigurationsIncludeEnvironmentsStorageProfile                = "environments.storage-profile"
+	ListProviderConfigurationsIncludeEnvironmentsTags                          = "environments.tags"
+	ListProviderConfigurationsIncludeEnvironmentsUpdatedBy                     = "environments.updated-by"
+	ListProviderConfigurationsIncludeOwners                                    = "owners"
+	ListProviderConfigurationsIncludeOwnersAccount                             = "owners.account"
+	ListProviderConfigurationsIncludeOwnersIdentityProvider                    = "owners.identity-provider"
+	ListProviderConfigurationsIncludeOwnersUsers                               = "owners.users"
+	ListProviderConfigurationsIncludeParameters                                = "parameters"
+	ListProviderConfigurationsIncludeParametersProviderConfiguration           = "parameters.provider-configuration"
+	ListProviderConfigurationsIncludeTags                                      = "tags"
+	ListProviderConfigurationsIncludeTagsAccount                               = "tags.account"
+)
+
 // This endpoint completely replaces provider configuration's tags with provided list.
 func (c *Client) ReplaceProviderConfigurationTagsRaw(ctx context.Context, providerConfiguration string, req []schemas.Tag) (*client.Response, error) {
 	path := "/provider-configurations/{provider_configuration}/relationships/tags"
@@ -747,15 +729,13 @@ func (c *Client) UpdateProviderConfiguration(ctx context.Context, providerConfig
 
 	var result struct {
 		Data     schemas.ProviderConfiguration `json:"data"`
-		Included []map[string]interface{}      `json:"included"`
+		Included []json.RawMessage             `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
@@ -48,16 +48,14 @@ func (c *Client) CreateProviderConfigurationLink(ctx context.Context, workspace
 
 	var result struct {
 		Data     schemas.ProviderConfigurationLink `json:"data"`
-		Included []map[string]interface{}          `json:"included"`
+		Included []json.RawMessage                 `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -106,16 +104,14 @@ func (c *Client) GetProviderConfigurationLink(ctx context.Context, providerConfi
 
 	var result struct {
 		Data     schemas.ProviderConfigurationLink `json:"data"`
-		Included []map[string]interface{}          `json:"included"`
+		Included []json.RawMessage                 `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -167,19 +163,18 @@ func (c *Client) ListProviderConfigurationLinks(ctx context.Context, workspace s
 		Meta struct {
 			Pagination *client.Pagination `json:"pagination"`
 		} `json:"meta"`
-		Included []map[string]interface{} `json:"included"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
+	// Index included resources once to resolve the relationships
+	included := client.NewIncluded(result.Included)
 	resources := make([]*schemas.ProviderConfigurationLink, len(result.Data))
 	for i := range result.Data {
 		resources[i] = &result.Data[i]
-		// Populate included resources into relationships
-		if len(result.Included) > 0 {
-			resources[i].Relationships.PopulateIncludes(result.Included)
-		}
+		resources[i].ResolveIncludes(included)
 	}
 	return resources, nil
 }
@@ -188,6 +183,7 @@ func (c *Client) ListProviderConfigurationLinks(ctx context.Context, workspace s
 // This is the recommended and simple way to iterate through all the results.
 //
 // The iterator automatically fetches pages as needed and handles errors inline.
+// Pages are fetched ahead in the background when the client is created with client.WithPrefetch.
 //
 // Example:
 //
@@ -199,73 +195,23 @@ func (c *Client) ListProviderConfigurationLinks(ctx context.Context, workspace s
 //	}
 func (c *Client) ListProviderConfigurationLinksIter(ctx context.Context, workspace string, opts *ListProviderConfigurationLinksOptions) iter.Seq2[schemas.ProviderConfigurationLink, error] {
 	return func(yield func(schemas.ProviderConfigurationLink, error) bool) {
-		// Determine page size from opts or use default
-		pageSize := 20
-		if opts != nil && opts.PageSize > 0 {
-			pageSize = opts.PageSize
-		}
-
-		pageNum := 1
-
-		for {
-			// Check context
-			if err := ctx.Err(); err != nil {
-				yield(schemas.ProviderConfigurationLink{}, err)
-				return
-			}
+		it := c.ListProviderConfigurationLinksPaged(ctx, workspace, opts)
+		defer it.Close() // Stops the prefetching on early exit
 
-			// Copy options and set page number
-			pageOpts := &ListProviderConfigurationLinksOptions{}
-			if opts != nil {
-				*pageOpts = *opts
+		for it.Next() {
+			if !yield(*it.Value(), nil) {
+				return // Consumer requested early exit
 			}
-			pageOpts.PageNumber = pageNum
-			pageOpts.PageSize = pageSize
-
-			// Fetch page using Raw method to get pagination metadata
-			resp, err := c.ListProviderConfigurationLinksRaw(ctx, workspace, pageOpts)
-			if err != nil {
-				yield(schemas.ProviderConfigurationLink{}, err)
-				return
-			}
-			defer resp.Body.Close()
-
-			// Decode response
-			var result struct {
-				Data []schemas.ProviderConfigurationLink `json:"data"`
-				Meta struct {
-					Pagination *client.Pagination `json:"pagination"`
-				} `json:"meta"`
-				Included []map[string]interface{} `json:"included"`
-			}
-			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-				yield(schemas.ProviderConfigurationLink{}, fmt.Errorf("failed to decode response: %w", err))
-				return
-			}
-
-			// Yield each item
-			for i := range result.Data {
-				// Populate included resources into relationships
-				if len(result.Included) > 0 {
-					result.Data[i].Relationships.PopulateIncludes(result.Included)
-				}
-				if !yield(result.Data[i], nil) {
-					return // Consumer requested early exit
-				}
-			}
-
-			// Check if there are more pages
-			if result.Meta.Pagination == nil || result.Meta.Pagination.NextPage == nil {
-				break
-			}
-
-			pageNum++
+		}
+		if err := it.Err(); err != nil {
+			yield(schemas.ProviderConfigurationLink{}, err)
 		}
 	}
 }
 
 // ListProviderConfigurationLinksPaged returns a stateful iterator with access to pagination metadata.
-// Use this when you need PageInfo(), Remaining(), or Collect() methods.
+// Use this when you need PageInfo(), Remaining(), or Collect() methods, or to resume
+// an interrupted iteration from the iterator Checkpoint().
 //
 // Example:
 //
@@ -278,6 +224,10 @@ func (c *Client) ListProviderConfigurationLinksIter(ctx context.Context, workspa
 //	if err := iter.Err(); err != nil {
 //	    // Handle error
 //	}
+//
+// Resuming from a checkpoint saved by an iterator with the same options:
+//
+//	iter := client.ProviderConfigurationLink.ListProviderConfigurationLinksPaged(ctx, workspace, opts).Resume(checkpoint)
 func (c *Client) ListProviderConfigurationLinksPaged(ctx context.Context, workspace string, opts *ListProviderConfigurationLinksOptions) *client.Iterator[schemas.ProviderConfigurationLink] {
 	// Determine page size from opts or use default
 	pageSize := 20
@@ -308,26 +258,34 @@ func (c *Client) ListProviderConfigurationLinksPaged(ctx context.Context, worksp
 			Meta struct {
 				Pagination *client.Pagination `json:"pagination"`
 			} `json:"meta"`
-			Included []map[string]interface{} `json:"included"`
+			Included []json.RawMessage `json:"included"`
 		}
 		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 			return nil, nil, fmt.Errorf("failed to decode response: %w", err)
 		}
 
-		// Convert to slice of pointers and populate includes
+		// Convert to slice of pointers and resolve includes
+		included := client.NewIncluded(result.Included)
 		items := make([]*schemas.ProviderConfigurationLink, len(result.Data))
 		for i := range result.Data {
 			items[i] = &result.Data[i]
-			// Populate included resources into relationships
-			if len(result.Included) > 0 {
-				items[i].Relationships.PopulateIncludes(result.Included)
-			}
+			items[i].ResolveIncludes(included)
 		}
 
 		return items, result.Meta.Pagination, nil
 	}
 
-	return client.NewIterator[schemas.ProviderConfigurationLink](ctx, pageSize, fetchPage)
+	// Checkpoints only resume the iterators of the same listing
+	listOpts := ListProviderConfigurationLinksOptions{}
+	if opts != nil {
+		listOpts = *opts
+	}
+	listOpts.PageNumber, listOpts.PageSize = 0, 0
+	filters := client.HashOptions("ListProviderConfigurationLinks", workspace, listOpts)
+
+	return client.NewIterator[schemas.ProviderConfigurationLink](ctx, pageSize, fetchPage).
+		Prefetch(c.httpClient.Prefetch()).
+		FiltersHash(filters)
 }
 
 // ListProviderConfigurationLinksOptions holds optional parameters for ListProviderConfigurationLinks
@@ -343,6 +301,46 @@ type ListProviderConfigurationLinksOptions struct {
 	Filter  map[string]string
 }
 
+// Relationship paths accepted by the Include option of ListProviderConfigurationLinks
+const (
+	ListProviderConfigurationLinksIncludeEnvironment                              = "environment"
+	ListProviderConfigurationLinksIncludeEnvironmentAccount                       = "environment.account"
+	ListProviderConfigurationLinksIncludeEnvironmentCreatedBy                     = "environment.created-by"
+	ListProviderConfigurationLinksIncludeEnvironmentDefaultProviderConfigurations = "environment.default-provider-configurations"
+	ListProviderConfigurationLinksIncludeEnvironmentDefaultWorkspaceAgentPool     = "environment.default-workspace-agent-pool"
+	ListProviderConfigurationLinksIncludeEnvironmentDriftDetectionSchedules       = "environment.drift-detection-schedules"
+	ListProviderConfigurationLinksIncludeEnvironmentLockedBy                      = "environment.locked-by"
+	ListProviderConfigurationLinksIncludeEnvironmentPolicyGroups                  = "environment.policy-groups"
+	ListProviderConfigurationLinksIncludeEnvironmentProviderConfigurations        = "environment.provider-configurations"
+	ListProviderConfigurationLinksIncludeEnvironmentStorageProfile                = "environment.storage-profile"
+	ListProviderConfigurationLinksIncludeEnvironmentTags                          = "environment.tags"
+	ListProviderConfigurationLinksIncludeEnvironmentUpdatedBy                     = "environment.updated-by"
+	ListProviderConfigurationLinksIncludeProviderConfiguration                    = "provider-configuration"
+	ListProviderConfigurationLinksIncludeProviderConfigurationAccount             = "provider-configuration.account"
+	ListProviderConfigurationLinksIncludeProviderConfigurationEnvironments        = "provider-configuration.environments"
+	ListProviderConfigurationLinksIncludeProviderConfigurationOwners              = "provider-configuration.owners"
+	ListProviderConfigurationLinksIncludeProviderConfigurationParameters          = "provider-configuration.parameters"
+	ListProviderConfigurationLinksIncludeProviderConfigurationTags                = "provider-configuration.tags"
+	ListProviderConfigurationLinksIncludeWorkspace                                = "workspace"
+	ListProviderConfigurationLinksIncludeWorkspaceAgentPool                       = "workspace.agent-pool"
+	ListProviderConfigurationLinksIncludeWorkspaceConfigurationVersion            = "workspace.configuration-version"
+	ListProviderConfigurationLinksIncludeWorkspaceCreatedBy                       = "workspace.created-by"
+	ListProviderConfigurationLinksIncludeWorkspaceCurrentRun                      = "workspace.current-run"
+	ListProviderConfigurationLinksIncludeWorkspaceDriftReport                     = "workspace.drift-report"
+	ListProviderConfigurationLinksIncludeWorkspaceEnvironment                     = "workspace.environment"
+	ListProviderConfigurationLinksIncludeWorkspaceLatestConfigurationVersion      = "workspace.latest-configuration-version"
+	ListProviderConfigurationLinksIncludeWorkspaceLatestRun                       = "workspace.latest-run"
+	ListProviderConfigurationLinksIncludeWorkspaceLockedBy                        = "workspace.locked-by"
+	ListProviderConfigurationLinksIncludeWorkspaceLockedByRun                     = "workspace.locked-by-run"
+	ListProviderConfigurationLinksIncludeWorkspaceModule                          = "workspace.module"
+	ListProviderConfigurationLinksIncludeWorkspaceModuleVersion                   = "workspace.module-version"
+	ListProviderConfigurationLinksIncludeWorkspaceReadmeId                        = "workspace.readme-id"
+	ListProviderConfigurationLinksIncludeWorkspaceSshKey                          = "workspace.ssh-key"
+	ListProviderConfigurationLinksIncludeWorkspaceTags                            = "workspace.tags"
+	ListProviderConfigurationLinksIncludeWorkspaceUpdatedBy                       = "workspace.updated-by"
+	ListProviderConfigurationLinksIncludeWorkspaceVcsProvider                     = "workspace.vcs-provider"
+)
+
 // This endpoint allows updates to attributes of an existing Provider configuration link.
 func (c *Client) UpdateProviderConfigurationLinkRaw(ctx context.Context, providerConfigurationLink string, req *schemas.ProviderConfigurationLinkRequest) (*client.Response, error) {
 	path := "/provider-configuration-links/{provider_configuration_link}"
@@ -367,15 +365,13 @@ func (c *Client) UpdateProviderConfigurationLink(ctx context.Context, providerCo
 
 	var result struct {
 		Data     schemas.ProviderConfigurationLink `json:"data"`
-		Included []map[string]interface{}          `json:"included"`
+		Included []json.RawMessage                 `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
@@ -48,16 +48,14 @@ func (c *Client) CreateProviderConfigurationParameter(ctx context.Context, provi
 
 	var result struct {
 		Data     schemas.ProviderConfigurationParameter `json:"data"`
-		Included []map[string]interface{}               `json:"included"`
+		Included []json.RawMessage                      `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -106,16 +104,14 @@ func (c *Client) GetProviderConfigurationParameter(ctx context.Context, provider
 
 	var result struct {
 		Data     schemas.ProviderConfigurationParameter `json:"data"`
-		Included []map[string]interface{}               `json:"included"`
+		Included []json.RawMessage                      `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -161,19 +157,18 @@ func (c *Client) ListProviderConfigurationParameters(ctx context.Context, provid
 		Meta struct {
 			Pagination *client.Pagination `json:"pagination"`
 		} `json:"meta"`
-		Included []map[string]interface{} `json:"included"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
+	// Index included resources once to resolve the relationships
+	included := client.NewIncluded(result.Included)
 	resources := make([]*schemas.ProviderConfigurationParameter, len(result.Data))
 	for i := range result.Data {
 		resources[i] = &result.Data[i]
-		// Populate included resources into relationships
-		if len(result.Included) > 0 {
-			resources[i].Relationships.PopulateIncludes(result.Included)
-		}
+		resources[i].ResolveIncludes(included)
 	}
 	return resources, nil
 }
@@ -182,6 +177,7 @@ func (c *Client) ListProviderConfigurationParameters(ctx context.Context, provid
 // This is the recommended and simple way to iterate through all the results.
 //
 // The iterator automatically fetches pages as needed and handles errors inline.
+// Pages are fetched ahead in the background when the client is created with client.WithPrefetch.
 //
 // Example:
 //
@@ -193,73 +189,23 @@ func (c *Client) ListProviderConfigurationParameters(ctx context.Context, provid
 //	}
 func (c *Client) ListProviderConfigurationParametersIter(ctx context.Context, providerConfiguration string, opts *ListProviderConfigurationParametersOptions) iter.Seq2[schemas.ProviderConfigurationParameter, error] {
 	return func(yield func(schemas.ProviderConfigurationParameter, error) bool) {
-		// Determine page size from opts or use default
-		pageSize := 20
-		if opts != nil && opts.PageSize > 0 {
-			pageSize = opts.PageSize
-		}
-
-		pageNum := 1
-
-		for {
-			// Check context
-			if err := ctx.Err(); err != nil {
-				yield(schemas.ProviderConfigurationParameter{}, err)
-				return
-			}
-
-			// Copy options and set page number
-			pageOpts := &ListProviderConfigurationParametersOptions{}
-			if opts != nil {
-				*pageOpts = *opts
-			}
-			pageOpts.PageNumber = pageNum
-			pageOpts.PageSize = pageSize
-
-			// Fetch page using Raw method to get pagination metadata
-			resp, err := c.ListProviderConfigurationParametersRaw(ctx, providerConfiguration, pageOpts)
-			if err != nil {
-				yield(schemas.ProviderConfigurationParameter{}, err)
-				return
-			}
-			defer resp.Body.Close()
-
-			// Decode response
-			var result struct {
-				Data []schemas.ProviderConfigurationParameter `json:"data"`
-				Meta struct {
-					Pagination *client.Pagination `json:"pagination"`
-				} `json:"meta"`
-				Included []map[string]interface{} `json:"included"`
-			}
-			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-				yield(schemas.ProviderConfigurationParameter{}, fmt.Errorf("failed to decode response: %w", err))
-				return
-			}
+		it := c.ListProviderConfigurationParametersPaged(ctx, providerConfiguration, opts)
+		defer it.Close() // Stops the prefetching on early exit
 
-			// Yield each item
-			for i := range result.Data {
-				// Populate included resources into relationships
-				if len(result.Included) > 0 {
-					result.Data[i].Relationships.PopulateIncludes(result.Included)
-				}
-				if !yield(result.Data[i], nil) {
-					return // Consumer requested early exit
-				}
+		for it.Next() {
+			if !yield(*it.Value(), nil) {
+				return // Consumer requested early exit
 			}
-
-			// Check if there are more pages
-			if result.Meta.Pagination == nil || result.Meta.Pagination.NextPage == nil {
-				break
-			}
-
-			pageNum++
+		}
+		if err := it.Err(); err != nil {
+			yield(schemas.ProviderConfigurationParameter{}, err)
 		}
 	}
 }
 
 // ListProviderConfigurationParametersPaged returns a stateful iterator with access to pagination metadata.
-// Use this when you need PageInfo(), Remaining(), or Collect() methods.
+// Use this when you need PageInfo(), Remaining(), or Collect() methods, or to resume
+// an interrupted iteration from the iterator Checkpoint().
 //
 // Example:
 //
@@ -272,6 +218,10 @@ func (c *Client) ListProviderConfigurationParametersIter(ctx context.Context, pr
 //	if err := iter.Err(); err != nil {
 //	    // Handle error
 //	}
+//
+// Resuming from a checkpoint saved by an iterator with the same options:
+//
+//	iter := client.ProviderConfigurationParameter.ListProviderConfigurationParametersPaged(ctx, providerConfiguration, opts).Resume(checkpoint)
 func (c *Client) ListProviderConfigurationParametersPaged(ctx context.Context, providerConfiguration string, opts *ListProviderConfigurationParametersOptions) *client.Iterator[schemas.ProviderConfigurationParameter] {
 	// Determine page size from opts or use default
 	pageSize := 20
@@ -302,26 +252,34 @@ func (c *Client) ListProviderConfigurationParametersPaged(ctx context.Context, p
 			Meta struct {
 				Pagination *client.Pagination `json:"pagination"`
 			} `json:"meta"`
-			Included []map[string]interface{} `json:"included"`
+			Included []json.RawMessage `json:"included"`
 		}
 		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 			return nil, nil, fmt.Errorf("failed to decode response: %w", err)
 		}
 
-		// Convert to slice of pointers and populate includes
+		// Convert to slice of pointers and resolve includes
+		included := client.NewIncluded(result.Included)
 		items := make([]*schemas.ProviderConfigurationParameter, len(result.Data))
 		for i := range result.Data {
 			items[i] = &result.Data[i]
-			// Populate included resources into relationships
-			if len(result.Included) > 0 {
-				items[i].Relationships.PopulateIncludes(result.Included)
-			}
+			items[i].ResolveIncludes(included)
 		}
 
 		return items, result.Meta.Pagination, nil
 	}
 
-	return client.NewIterator[schemas.ProviderConfigurationParameter](ctx, pageSize, fetchPage)
+	// Checkpoints only resume the iterators of the same listing
+	listOpts := ListProviderConfigurationParametersOptions{}
+	if opts != nil {
+		listOpts = *opts
+	}
+	listOpts.PageNumber, listOpts.PageSize = 0, 0
+	filters := client.HashOptions("ListProviderConfigurationParameters", providerConfiguration, listOpts)
+
+	return client.NewIterator[schemas.ProviderConfigurationParameter](ctx, pageSize, fetchPage).
+		Prefetch(c.httpClient.Prefetch()).
+		FiltersHash(filters)
 }
 
 // ListProviderConfigurationParametersOptions holds optional parameters for ListProviderConfigurationParameters
@@ -357,15 +315,13 @@ func (c *Client) UpdateProviderConfigurationParameter(ctx context.Context, provi
 
 	var result struct {
 		Data     schemas.ProviderConfigurationParameter `json:"data"`
-		Included []map[string]interface{}               `json:"included"`
+		Included []json.RawMessage                      `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
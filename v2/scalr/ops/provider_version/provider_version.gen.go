@@ -46,17 +46,15 @@ func (c *Client) CreateProviderVersion(ctx context.Context, req *schemas.Provide
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.ProviderVersion  `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.ProviderVersion `json:"data"`
+		Included []json.RawMessage       `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -93,8 +91,7 @@ func (c *Client) GetProviderVersionRaw(ctx context.Context, providerVersion stri
 		if len(opts.Include) > 0 {
 			params.Set("include", strings.Join(opts.Include, ","))
 		}
-		// Handle parameter: Fields (map[string]interface{})
-		// Complex type map[string]interface{} - skip for now
+		opts.Fields.Encode(params)
 		// Add filters
 		for k, v := range opts.Filter {
 			params.Set("filter["+k+"]", v)
@@ -120,17 +117,15 @@ func (c *Client) GetProviderVersion(ctx context.Context, providerVersion string,
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.ProviderVersion  `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.ProviderVersion `json:"data"`
+		Included []json.RawMessage       `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -139,10 +134,19 @@ type GetProviderVersionOptions struct {
 	// The comma-separated list of relationship paths.
 	Include []string
 	// The value of the fields[resource-type] parameter is a comma-separated list that refers to the name of the fields to be returned for the resource. An empty value indicates that no fields should be returned.
-	Fields map[string]interface{}
+	Fields client.Fields
 	Filter map[string]string
 }
 
+// Relationship paths accepted by the Include option of GetProviderVersion
+const (
+	GetProviderVersionIncludeGpgKey                        = "gpg-key"
+	GetProviderVersionIncludeProvider                      = "provider"
+	GetProviderVersionIncludeProviderLatestProviderVersion = "provider.latest-provider-version"
+	GetProviderVersionIncludeProviderProviderVersion       = "provider.provider-version"
+	GetProviderVersionIncludeReadme                        = "readme"
+)
+
 // This endpoint returns a list of registry provider versions.
 func (c *Client) ListProviderVersionsRaw(ctx context.Context, opts *ListProviderVersionsOptions) (*client.Response, error) {
 	path := "/provider-versions"
@@ -165,8 +169,7 @@ func (c *Client) ListProviderVersionsRaw(ctx context.Context, opts *ListProvider
 		if len(opts.Include) > 0 {
 			params.Set("include", strings.Join(opts.Include, ","))
 		}
-		// Handle parameter: Fields (map[string]interface{})
-		// Complex type map[string]interface{} - skip for now
+		opts.Fields.Encode(params)
 		// Add filters
 		for k, v := range opts.Filter {
 			params.Set("filter["+k+"]", v)
@@ -196,19 +199,18 @@ func (c *Client) ListProviderVersions(ctx context.Context, opts *ListProviderVer
 		Meta struct {
 			Pagination *client.Pagination `json:"pagination"`
 		} `json:"meta"`
-		Included []map[string]interface{} `json:"included"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
+	// Index included resources once to resolve the relationships
+	included := client.NewIncluded(result.Included)
 	resources := make([]*schemas.ProviderVersion, len(result.Data))
 	for i := range result.Data {
 		resources[i] = &result.Data[i]
-		// Populate included resources into relationships
-		if len(result.Included) > 0 {
-			resources[i].Relationships.PopulateIncludes(result.Included)
-		}
+		resources[i].ResolveIncludes(included)
 	}
 	return resources, nil
 }
@@ -217,6 +219,7 @@ func (c *Client) ListProviderVersions(ctx context.Context, opts *ListProviderVer
 // This is the recommended and simple way to iterate through all the results.
 //
 // The iterator automatically fetches pages as needed and handles errors inline.
+// Pages are fetched ahead in the background when the client is created with client.WithPrefetch.
 //
 // Example:
 //
@@ -228,73 +231,23 @@ func (c *Client) ListProviderVersions(ctx context.Context, opts *ListProviderVer
 //	}
 func (c *Client) ListProviderVersionsIter(ctx context.Context, opts *ListProviderVersionsOptions) iter.Seq2[schemas.ProviderVersion, error] {
 	return func(yield func(schemas.ProviderVersion, error) bool) {
-		// Determine page size from opts or use default
-		pageSize := 20
-		if opts != nil && opts.PageSize > 0 {
-			pageSize = opts.PageSize
-		}
-
-		pageNum := 1
-
-		for {
-			// Check context
-			if err := ctx.Err(); err != nil {
-				yield(schemas.ProviderVersion{}, err)
-				return
-			}
-
-			// Copy options and set page number
-			pageOpts := &ListProviderVersionsOptions{}
-			if opts != nil {
-				*pageOpts = *opts
-			}
-			pageOpts.PageNumber = pageNum
-			pageOpts.PageSize = pageSize
-
-			// Fetch page using Raw method to get pagination metadata
-			resp, err := c.ListProviderVersionsRaw(ctx, pageOpts)
-			if err != nil {
-				yield(schemas.ProviderVersion{}, err)
-				return
-			}
-			defer resp.Body.Close()
-
-			// Decode response
-			var result struct {
-				Data []schemas.ProviderVersion `json:"data"`
-				Meta struct {
-					Pagination *client.Pagination `json:"pagination"`
-				} `json:"meta"`
-				Included []map[string]interface{} `json:"included"`
-			}
-			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-				yield(schemas.ProviderVersion{}, fmt.Errorf("failed to decode response: %w", err))
-				return
-			}
-
-			// Yield each item
-			for i := range result.Data {
-				// Populate included resources into relationships
-				if len(result.Included) > 0 {
-					result.Data[i].Relationships.PopulateIncludes(result.Included)
-				}
-				if !yield(result.Data[i], nil) {
-					return // Consumer requested early exit
-				}
-			}
+		it := c.ListProviderVersionsPaged(ctx, opts)
+		defer it.Close() // Stops the prefetching on early exit
 
-			// Check if there are more pages
-			if result.Meta.Pagination == nil || result.Meta.Pagination.NextPage == nil {
-				break
+		for it.Next() {
+			if !yield(*it.Value(), nil) {
+				return // Consumer requested early exit
 			}
-
-			pageNum++
+		}
+		if err := it.Err(); err != nil {
+			yield(schemas.ProviderVersion{}, err)
 		}
 	}
 }
 
 // ListProviderVersionsPaged returns a stateful iterator with access to pagination metadata.
-// Use this when you need PageInfo(), Remaining(), or Collect() methods.
+// Use this when you need PageInfo(), Remaining(), or Collect() methods, or to resume
+// an interrupted iteration from the iterator Checkpoint().
 //
 // Example:
 //
@@ -307,6 +260,10 @@ func (c *Client) ListProviderVersionsIter(ctx context.Context, opts *ListProvide
 //	if err := iter.Err(); err != nil {
 //	    // Handle error
 //	}
+//
+// Resuming from a checkpoint saved by an iterator with the same options:
+//
+//	iter := client.ProviderVersion.ListProviderVersionsPaged(ctx, opts).Resume(checkpoint)
 func (c *Client) ListProviderVersionsPaged(ctx context.Context, opts *ListProviderVersionsOptions) *client.Iterator[schemas.ProviderVersion] {
 	// Determine page size from opts or use default
 	pageSize := 20
@@ -337,26 +294,34 @@ func (c *Client) ListProviderVersionsPaged(ctx context.Context, opts *ListProvid
 			Meta struct {
 				Pagination *client.Pagination `json:"pagination"`
 			} `json:"meta"`
-			Included []map[string]interface{} `json:"included"`
+			Included []json.RawMessage `json:"included"`
 		}
 		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 			return nil, nil, fmt.Errorf("failed to decode response: %w", err)
 		}
 
-		// Convert to slice of pointers and populate includes
+		// Convert to slice of pointers and resolve includes
+		included := client.NewIncluded(result.Included)
 		items := make([]*schemas.ProviderVersion, len(result.Data))
 		for i := range result.Data {
 			items[i] = &result.Data[i]
-			// Populate included resources into relationships
-			if len(result.Included) > 0 {
-				items[i].Relationships.PopulateIncludes(result.Included)
-			}
+			items[i].ResolveIncludes(included)
 		}
 
 		return items, result.Meta.Pagination, nil
 	}
 
-	return client.NewIterator[schemas.ProviderVersion](ctx, pageSize, fetchPage)
+	// Checkpoints only resume the iterators of the same listing
+	listOpts := ListProviderVersionsOptions{}
+	if opts != nil {
+		listOpts = *opts
+	}
+	listOpts.PageNumber, listOpts.PageSize = 0, 0
+	filters := client.HashOptions("ListProviderVersions", listOpts)
+
+	return client.NewIterator[schemas.ProviderVersion](ctx, pageSize, fetchPage).
+		Prefetch(c.httpClient.Prefetch()).
+		FiltersHash(filters)
 }
 
 // ListProviderVersionsOptions holds optional parameters for ListProviderVersions
@@ -372,6 +337,15 @@ type ListProviderVersionsOptions struct {
 	// The comma-separated list of relationship paths.
 	Include []string
 	// The value of the fields[resource-type] parameter is a comma-separated list that refers to the name of the fields to be returned for the resource. An empty value indicates that no fields should be returned.
-	Fields map[string]interface{}
+	Fields client.Fields
 	Filter map[string]string
 }
+
+// Relationship paths accepted by the Include option of ListProviderVersions
+const (
+	ListProviderVersionsIncludeGpgKey                        = "gpg-key"
+	ListProviderVersionsIncludeProvider                      = "provider"
+	ListProviderVersionsIncludeProviderLatestProviderVersion = "provider.latest-provider-version"
+	ListProviderVersionsIncludeProviderProviderVersion       = "provider.provider-version"
+	ListProviderVersionsIncludeReadme                        = "readme"
+)
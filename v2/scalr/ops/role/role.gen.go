@@ -60,17 +60,15 @@ func (c *Client) CreateRole(ctx context.Context, req *schemas.RoleRequest, opts
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.Role             `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.Role      `json:"data"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -81,6 +79,15 @@ type CreateRoleOptions struct {
 	Filter  map[string]string
 }
 
+// Relationship paths accepted by the Include option of CreateRole
+const (
+	CreateRoleIncludeAccount                 = "account"
+	CreateRoleIncludeAccountBillingPlan      = "account.billing-plan"
+	CreateRoleIncludeAccountIdentityProvider = "account.identity-provider"
+	CreateRoleIncludeAccountOwner            = "account.owner"
+	CreateRoleIncludePermissions             = "permissions"
+)
+
 // The endpoint deletes [IAM](https://docs.scalr.io/docs/identity-and-access-management) role by ID.
 func (c *Client) DeleteRoleRaw(ctx context.Context, role string) (*client.Response, error) {
 	path := "/roles/{role}"
@@ -139,17 +146,15 @@ func (c *Client) GetRole(ctx context.Context, role string, opts *GetRoleOptions)
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.Role             `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.Role      `json:"data"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -160,6 +165,15 @@ type GetRoleOptions struct {
 	Filter  map[string]string
 }
 
+// Relationship paths accepted by the Include option of GetRole
+const (
+	GetRoleIncludeAccount                 = "account"
+	GetRoleIncludeAccountBillingPlan      = "account.billing-plan"
+	GetRoleIncludeAccountIdentityProvider = "account.identity-provider"
+	GetRoleIncludeAccountOwner            = "account.owner"
+	GetRoleIncludePermissions             = "permissions"
+)
+
 // This endpoint returns a list of [IAM](https://docs.scalr.io/docs/identity-and-access-management) roles.
 func (c *Client) GetRolesRaw(ctx context.Context, opts *GetRolesOptions) (*client.Response, error) {
 	path := "/roles"
@@ -211,19 +225,18 @@ func (c *Client) GetRoles(ctx context.Context, opts *GetRolesOptions) ([]*schema
 		Meta struct {
 			Pagination *client.Pagination `json:"pagination"`
 		} `json:"meta"`
-		Included []map[string]interface{} `json:"included"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
+	// Index included resources once to resolve the relationships
+	included := client.NewIncluded(result.Included)
 	resources := make([]*schemas.Role, len(result.Data))
 	for i := range result.Data {
 		resources[i] = &result.Data[i]
-		// Populate included resources into relationships
-		if len(result.Included) > 0 {
-			resources[i].Relationships.PopulateIncludes(result.Included)
-		}
+		resources[i].ResolveIncludes(included)
 	}
 	return resources, nil
 }
@@ -232,6 +245,7 @@ func (c *Client) GetRoles(ctx context.Context, opts *GetRolesOptions) ([]*schema
 // This is the recommended and simple way to iterate through all the results.
 //
 // The iterator automatically fetches pages as needed and handles errors inline.
+// Pages are fetched ahead in the background when the client is created with client.WithPrefetch.
 //
 // Example:
 //
@@ -243,73 +257,23 @@ func (c *Client) GetRoles(ctx context.Context, opts *GetRolesOptions) ([]*schema
 //	}
 func (c *Client) GetRolesIter(ctx context.Context, opts *GetRolesOptions) iter.Seq2[schemas.Role, error] {
 	return func(yield func(schemas.Role, error) bool) {
-		// Determine page size from opts or use default
-		pageSize := 20
-		if opts != nil && opts.PageSize > 0 {
-			pageSize = opts.PageSize
-		}
-
-		pageNum := 1
-
-		for {
-			// Check context
-			if err := ctx.Err(); err != nil {
-				yield(schemas.Role{}, err)
-				return
-			}
-
-			// Copy options and set page number
-			pageOpts := &GetRolesOptions{}
-			if opts != nil {
-				*pageOpts = *opts
-			}
-			pageOpts.PageNumber = pageNum
-			pageOpts.PageSize = pageSize
-
-			// Fetch page using Raw method to get pagination metadata
-			resp, err := c.GetRolesRaw(ctx, pageOpts)
-			if err != nil {
-				yield(schemas.Role{}, err)
-				return
-			}
-			defer resp.Body.Close()
-
-			// Decode response
-			var result struct {
-				Data []schemas.Role `json:"data"`
-				Meta struct {
-					Pagination *client.Pagination `json:"pagination"`
-				} `json:"meta"`
-				Included []map[string]interface{} `json:"included"`
-			}
-			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-				yield(schemas.Role{}, fmt.Errorf("failed to decode response: %w", err))
-				return
-			}
+		it := c.GetRolesPaged(ctx, opts)
+		defer it.Close() // Stops the prefetching on early exit
 
-			// Yield each item
-			for i := range result.Data {
-				// Populate included resources into relationships
-				if len(result.Included) > 0 {
-					result.Data[i].Relationships.PopulateIncludes(result.Included)
-				}
-				if !yield(result.Data[i], nil) {
-					return // Consumer requested early exit
-				}
+		for it.Next() {
+			if !yield(*it.Value(), nil) {
+				return // Consumer requested early exit
 			}
-
-			// Check if there are more pages
-			if result.Meta.Pagination == nil || result.Meta.Pagination.NextPage == nil {
-				break
-			}
-
-			pageNum++
+		}
+		if err := it.Err(); err != nil {
+			yield(schemas.Role{}, err)
 		}
 	}
 }
 
 // GetRolesPaged returns a stateful iterator with access to pagination metadata.
-// Use this when you need PageInfo(), Remaining(), or Collect() methods.
+// Use this when you need PageInfo(), Remaining(), or Collect() methods, or to resume
+// an interrupted iteration from the iterator Checkpoint().
 //
 // Example:
 //
@@ -322,6 +286,10 @@ func (c *Client) GetRolesIter(ctx context.Context, opts *GetRolesOptions) iter.S
 //	if err := iter.Err(); err != nil {
 //	    // Handle error
 //	}
+//
+// Resuming from a checkpoint saved by an iterator with the same options:
+//
+//	iter := client.Role.GetRolesPaged(ctx, opts).Resume(checkpoint)
 func (c *Client) GetRolesPaged(ctx context.Context, opts *GetRolesOptions) *client.Iterator[schemas.Role] {
 	// Determine page size from opts or use default
 	pageSize := 20
@@ -352,26 +320,34 @@ func (c *Client) GetRolesPaged(ctx context.Context, opts *GetRolesOptions) *clie
 			Meta struct {
 				Pagination *client.Pagination `json:"pagination"`
 			} `json:"meta"`
-			Included []map[string]interface{} `json:"included"`
+			Included []json.RawMessage `json:"included"`
 		}
 		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 			return nil, nil, fmt.Errorf("failed to decode response: %w", err)
 		}
 
-		// Convert to slice of pointers and populate includes
+		// Convert to slice of pointers and resolve includes
+		included := client.NewIncluded(result.Included)
 		items := make([]*schemas.Role, len(result.Data))
 		for i := range result.Data {
 			items[i] = &result.Data[i]
-			// Populate included resources into relationships
-			if len(result.Included) > 0 {
-				items[i].Relationships.PopulateIncludes(result.Included)
-			}
+			items[i].ResolveIncludes(included)
 		}
 
 		return items, result.Meta.Pagination, nil
 	}
 
-	return client.NewIterator[schemas.Role](ctx, pageSize, fetchPage)
+	// Checkpoints only resume the iterators of the same listing
+	listOpts := GetRolesOptions{}
+	if opts != nil {
+		listOpts = *opts
+	}
+	listOpts.PageNumber, listOpts.PageSize = 0, 0
+	filters := client.HashOptions("GetRoles", listOpts)
+
+	return client.NewIterator[schemas.Role](ctx, pageSize, fetchPage).
+		Prefetch(c.httpClient.Prefetch()).
+		FiltersHash(filters)
 }
 
 // GetRolesOptions holds optional parameters for GetRoles
@@ -389,6 +365,15 @@ type GetRolesOptions struct {
 	Filter map[string]string
 }
 
+// Relationship paths accepted by the Include option of GetRoles
+const (
+	GetRolesIncludeAccount                 = "account"
+	GetRolesIncludeAccountBillingPlan      = "account.billing-plan"
+	GetRolesIncludeAccountIdentityProvider = "account.identity-provider"
+	GetRolesIncludeAccountOwner            = "account.owner"
+	GetRolesIncludePermissions             = "permissions"
+)
+
 // This endpoint updates [IAM](https://docs.scalr.io/docs/identity-and-access-management) role by ID.
 func (c *Client) UpdateRoleRaw(ctx context.Context, role string, req *schemas.RoleRequest, opts *UpdateRoleOptions) (*client.Response, error) {
 	path := "/roles/{role}"
@@ -426,17 +411,15 @@ func (c *Client) UpdateRole(ctx context.Context, role string, req *schemas.RoleR
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.Role             `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.Role      `json:"data"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -446,3 +429,12 @@ type UpdateRoleOptions struct {
 	Include []string
 	Filter  map[string]string
 }
+
+// Relationship paths accepted by the Include option of UpdateRole
+const (
+	UpdateRoleIncludeAccount                 = "account"
+	UpdateRoleIncludeAccountBillingPlan      = "account.billing-plan"
+	UpdateRoleIncludeAccountIdentityProvider = "account.identity-provider"
+	UpdateRoleIncludeAccountOwner            = "account.owner"
+	UpdateRoleIncludePermissions             = "permissions"
+)
@@ -114,17 +114,15 @@ func (c *Client) CreateRun(ctx context.Context, req *schemas.RunRequest, opts *C
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.Run              `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.Run       `json:"data"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -204,6 +202,27 @@ func (c *Client) DownloadPolicyInput(ctx context.Context, run string, opts *Down
 	return string(bodyBytes), nil
 }
 
+// DownloadPolicyInputStream streams the response of DownloadPolicyInput instead of buffering it in memory.
+// The caller must close the returned body.
+func (c *Client) DownloadPolicyInputStream(ctx context.Context, run string, opts *DownloadPolicyInputOptions) (io.ReadCloser, error) {
+	resp, err := c.DownloadPolicyInputRaw(ctx, run, opts)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+// DownloadPolicyInputTo copies the response of DownloadPolicyInput to w and returns the number of bytes written.
+func (c *Client) DownloadPolicyInputTo(ctx context.Context, w io.Writer, run string, opts *DownloadPolicyInputOptions) (int64, error) {
+	body, err := c.DownloadPolicyInputStream(ctx, run, opts)
+	if err != nil {
+		return 0, err
+	}
+	defer body.Close()
+
+	return io.Copy(w, body)
+}
+
 // DownloadPolicyInputOptions holds optional parameters for DownloadPolicyInput
 type DownloadPolicyInputOptions struct {
 	// The run stage
@@ -246,8 +265,7 @@ func (c *Client) GetRunRaw(ctx context.Context, run string, opts *GetRunOptions)
 		if len(opts.Include) > 0 {
 			params.Set("include", strings.Join(opts.Include, ","))
 		}
-		// Handle parameter: Fields (map[string]interface{})
-		// Complex type map[string]interface{} - skip for now
+		opts.Fields.Encode(params)
 		// Add filters
 		for k, v := range opts.Filter {
 			params.Set("filter["+k+"]", v)
@@ -273,17 +291,15 @@ func (c *Client) GetRun(ctx context.Context, run string, opts *GetRunOptions) (*
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.Run              `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.Run       `json:"data"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -292,10 +308,78 @@ type GetRunOptions struct {
 	// The comma-separated list of relationship paths.
 	Include []string
 	// The value of the fields[resource-type] parameter is a comma-separated list that refers to the name of the fields to be returned for the resource. An empty value indicates that no fields should be returned.
-	Fields map[string]interface{}
+	Fields client.Fields
 	Filter map[string]string
 }
 
+// Relationship paths accepted by the Include option of GetRun
+const (
+	GetRunIncludeApply                                    = "apply"
+	GetRunIncludeConfigurationVersion                     = "configuration-version"
+	GetRunIncludeConfigurationVersionVcsRevision          = "configuration-version.vcs-revision"
+	GetRunIncludeConfigurationVersionWorkspace            = "configuration-version.workspace"
+	GetRunIncludeCostEstimate                             = "cost-estimate"
+	GetRunIncludeCreatedBy                                = "created-by"
+	GetRunIncludeCreatedByRun                             = "created-by-run"
+	GetRunIncludeCreatedByRunApply                        = "created-by-run.apply"
+	GetRunIncludeCreatedByRunConfigurationVersion         = "created-by-run.configuration-version"
+	GetRunIncludeCreatedByRunCostEstimate                 = "created-by-run.cost-estimate"
+	GetRunIncludeCreatedByRunCreatedBy                    = "created-by-run.created-by"
+	GetRunIncludeCreatedByRunCreatedByRun                 = "created-by-run.created-by-run"
+	GetRunIncludeCreatedByRunEnvironment                  = "created-by-run.environment"
+	GetRunIncludeCreatedByRunPlan                         = "created-by-run.plan"
+	GetRunIncludeCreatedByRunPolicyChecks                 = "created-by-run.policy-checks"
+	GetRunIncludeCreatedByRunStateVersions                = "created-by-run.state-versions"
+	GetRunIncludeCreatedByRunStatusTransitions            = "created-by-run.status-transitions"
+	GetRunIncludeCreatedByRunTags                         = "created-by-run.tags"
+	GetRunIncludeCreatedByRunVcsRevision                  = "created-by-run.vcs-revision"
+	GetRunIncludeCreatedByRunWorkspace                    = "created-by-run.workspace"
+	GetRunIncludeCreatedByIdentityProviders               = "created-by.identity-providers"
+	GetRunIncludeCreatedByTeams                           = "created-by.teams"
+	GetRunIncludeEnvironment                              = "environment"
+	GetRunIncludeEnvironmentAccount                       = "environment.account"
+	GetRunIncludeEnvironmentCreatedBy                     = "environment.created-by"
+	GetRunIncludeEnvironmentDefaultProviderConfigurations = "environment.default-provider-configurations"
+	GetRunIncludeEnvironmentDefaultWorkspaceAgentPool     = "environment.default-workspace-agent-pool"
+	GetRunIncludeEnvironmentDriftDetectionSchedules       = "environment.drift-detection-schedules"
+	GetRunIncludeEnvironmentLockedBy                      = "environment.locked-by"
+	GetRunIncludeEnvironmentPolicyGroups                  = "environment.policy-groups"
+	GetRunIncludeEnvironmentProviderConfigurations        = "environment.provider-configurations"
+	GetRunIncludeEnvironmentStorageProfile                = "environment.storage-profile"
+	GetRunIncludeEnvironmentTags                          = "environment.tags"
+	GetRunIncludeEnvironmentUpdatedBy                     = "environment.updated-by"
+	GetRunIncludePlan                                     = "plan"
+	GetRunIncludePolicyChecks                             = "policy-checks"
+	GetRunIncludeStateVersions                            = "state-versions"
+	GetRunIncludeStateVersionsNextStateVersion            = "state-versions.next-state-version"
+	GetRunIncludeStateVersionsPreviousStateVersion        = "state-versions.previous-state-version"
+	GetRunIncludeStateVersionsRun                         = "state-versions.run"
+	GetRunIncludeStateVersionsWorkspace                   = "state-versions.workspace"
+	GetRunIncludeStatusTransitions                        = "status-transitions"
+	GetRunIncludeStatusTransitionsUser                    = "status-transitions.user"
+	GetRunIncludeTags                                     = "tags"
+	GetRunIncludeTagsAccount                              = "tags.account"
+	GetRunIncludeVcsRevision                              = "vcs-revision"
+	GetRunIncludeWorkspace                                = "workspace"
+	GetRunIncludeWorkspaceAgentPool                       = "workspace.agent-pool"
+	GetRunIncludeWorkspaceConfigurationVersion            = "workspace.configuration-version"
+	GetRunIncludeWorkspaceCreatedBy                       = "workspace.created-by"
+	GetRunIncludeWorkspaceCurrentRun                      = "workspace.current-run"
+	GetRunIncludeWorkspaceDriftReport                     = "workspace.drift-report"
+	GetRunIncludeWorkspaceEnvironment                     = "workspace.environment"
+	GetRunIncludeWorkspaceLatestConfigurationVersion      = "workspace.latest-configuration-version"
+	GetRunIncludeWorkspaceLatestRun                       = "workspace.latest-run"
+	GetRunIncludeWorkspaceLockedBy                        = "workspace.locked-by"
+	GetRunIncludeWorkspaceLockedByRun                     = "workspace.locked-by-run"
+	GetRunIncludeWorkspaceModule                          = "workspace.module"
+	GetRunIncludeWorkspaceModuleVersion                   = "workspace.module-version"
+	GetRunIncludeWorkspaceReadmeId                        = "workspace.readme-id"
+	GetRunIncludeWorkspaceSshKey                          = "workspace.ssh-key"
+	GetRunIncludeWorkspaceTags                            = "workspace.tags"
+	GetRunIncludeWorkspaceUpdatedBy                       = "workspace.updated-by"
+	GetRunIncludeWorkspaceVcsProvider                     = "workspace.vcs-provider"
+)
+
 // This endpoint lists runs for a specific workspace.
 func (c *Client) GetRunsRaw(ctx context.Context, opts *GetRunsOptions) (*client.Response, error) {
 	path := "/runs"
@@ -319,8 +403,7 @@ func (c *Client) GetRunsRaw(ctx context.Context, opts *GetRunsOptions) (*client.
 		if opts.Scheduled != "" {
 			params.Set("scheduled", opts.Scheduled)
 		}
-		// Handle parameter: Fields (map[string]interface{})
-		// Complex type map[string]interface{} - skip for now
+		opts.Fields.Encode(params)
 		// Add filters
 		for k, v := range opts.Filter {
 			params.Set("filter["+k+"]", v)
@@ -350,19 +433,18 @@ func (c *Client) GetRuns(ctx context.Context, opts *GetRunsOptions) ([]*schemas.
 		Meta struct {
 			Pagination *client.Pagination `json:"pagination"`
 		} `json:"meta"`
-		Included []map[string]interface{} `json:"included"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
+	// Index included resources once to resolve the relationships
+	included := client.NewIncluded(result.Included)
 	resources := make([]*schemas.Run, len(result.Data))
 	for i := range result.Data {
 		resources[i] = &result.Data[i]
-		// Populate included resources into relationships
-		if len(result.Included) > 0 {
-			resources[i].Relationships.PopulateIncludes(result.Included)
-		}
+		resources[i].ResolveIncludes(included)
 	}
 	return resources, nil
 }
@@ -371,6 +453,7 @@ func (c *Client) GetRuns(ctx context.Context, opts *GetRunsOptions) ([]*schemas.
 // This is the recommended and simple way to iterate through all the results.
 //
 // The iterator automatically fetches pages as needed and handles errors inline.
+// Pages are fetched ahead in the background when the client is created with client.WithPrefetch.
 //
 // Example:
 //
@@ -382,73 +465,23 @@ func (c *Client) GetRuns(ctx context.Context, opts *GetRunsOptions) ([]*schemas.
 //	}
 func (c *Client) GetRunsIter(ctx context.Context, opts *GetRunsOptions) iter.Seq2[schemas.Run, error] {
 	return func(yield func(schemas.Run, error) bool) {
-		// Determine page size from opts or use default
-		pageSize := 20
-		if opts != nil && opts.PageSize > 0 {
-			pageSize = opts.PageSize
-		}
-
-		pageNum := 1
-
-		for {
-			// Check context
-			if err := ctx.Err(); err != nil {
-				yield(schemas.Run{}, err)
-				return
-			}
+		it := c.GetRunsPaged(ctx, opts)
+		defer it.Close() // Stops the prefetching on early exit
 
-			// Copy options and set page number
-			pageOpts := &GetRunsOptions{}
-			if opts != nil {
-				*pageOpts = *opts
-			}
-			pageOpts.PageNumber = pageNum
-			pageOpts.PageSize = pageSize
-
-			// Fetch page using Raw method to get pagination metadata
-			resp, err := c.GetRunsRaw(ctx, pageOpts)
-			if err != nil {
-				yield(schemas.Run{}, err)
-				return
-			}
-			defer resp.Body.Close()
-
-			// Decode response
-			var result struct {
-				Data []schemas.Run `json:"data"`
-				Meta struct {
-					Pagination *client.Pagination `json:"pagination"`
-				} `json:"meta"`
-				Included []map[string]interface{} `json:"included"`
-			}
-			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-				yield(schemas.Run{}, fmt.Errorf("failed to decode response: %w", err))
-				return
+		for it.Next() {
+			if !yield(*it.Value(), nil) {
+				return // Consumer requested early exit
 			}
-
-			// Yield each item
-			for i := range result.Data {
-				// Populate included resources into relationships
-				if len(result.Included) > 0 {
-					result.Data[i].Relationships.PopulateIncludes(result.Included)
-				}
-				if !yield(result.Data[i], nil) {
-					return // Consumer requested early exit
-				}
-			}
-
-			// Check if there are more pages
-			if result.Meta.Pagination == nil || result.Meta.Pagination.NextPage == nil {
-				break
-			}
-
-			pageNum++
+		}
+		if err := it.Err(); err != nil {
+			yield(schemas.Run{}, err)
 		}
 	}
 }
 
 // GetRunsPaged returns a stateful iterator with access to pagination metadata.
-// Use this when you need PageInfo(), Remaining(), or Collect() methods.
+// Use this when you need PageInfo(), Remaining(), or Collect() methods, or to resume
+// an interrupted iteration from the iterator Checkpoint().
 //
 // Example:
 //
@@ -461,6 +494,10 @@ func (c *Client) GetRunsIter(ctx context.Context, opts *GetRunsOptions) iter.Seq
 //	if err := iter.Err(); err != nil {
 //	    // Handle error
 //	}
+//
+// Resuming from a checkpoint saved by an iterator with the same options:
+//
+//	iter := client.Run.GetRunsPaged(ctx, opts).Resume(checkpoint)
 func (c *Client) GetRunsPaged(ctx context.Context, opts *GetRunsOptions) *client.Iterator[schemas.Run] {
 	// Determine page size from opts or use default
 	pageSize := 20
@@ -491,26 +528,34 @@ func (c *Client) GetRunsPaged(ctx context.Context, opts *GetRunsOptions) *client
 			Meta struct {
 				Pagination *client.Pagination `json:"pagination"`
 			} `json:"meta"`
-			Included []map[string]interface{} `json:"included"`
+			Included []json.RawMessage `json:"included"`
 		}
 		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 			return nil, nil, fmt.Errorf("failed to decode response: %w", err)
 		}
 
-		// Convert to slice of pointers and populate includes
+		// Convert to slice of pointers and resolve includes
+		included := client.NewIncluded(result.Included)
 		items := make([]*schemas.Run, len(result.Data))
 		for i := range result.Data {
 			items[i] = &result.Data[i]
-			// Populate included resources into relationships
-			if len(result.Included) > 0 {
-				items[i].Relationships.PopulateIncludes(result.Included)
-			}
+			items[i].ResolveIncludes(included)
 		}
 
 		return items, result.Meta.Pagination, nil
 	}
 
-	return client.NewIterator[schemas.Run](ctx, pageSize, fetchPage)
+	// Checkpoints only resume the iterators of the same listing
+	listOpts := GetRunsOptions{}
+	if opts != nil {
+		listOpts = *opts
+	}
+	listOpts.PageNumber, listOpts.PageSize = 0, 0
+	filters := client.HashOptions("GetRuns", listOpts)
+
+	return client.NewIterator[schemas.Run](ctx, pageSize, fetchPage).
+		Prefetch(c.httpClient.Prefetch()).
+		FiltersHash(filters)
 }
 
 // GetRunsOptions holds optional parameters for GetRuns
@@ -526,10 +571,78 @@ type GetRunsOptions struct {
 	// List only runs that are scheduled.
 	Scheduled string
 	// The value of the fields[resource-type] parameter is a comma-separated list that refers to the name of the fields to be returned for the resource. An empty value indicates that no fields should be returned.
-	Fields map[string]interface{}
+	Fields client.Fields
 	Filter map[string]string
 }
 
+// Relationship paths accepted by the Include option of GetRuns
+const (
+	GetRunsIncludeApply                                    = "apply"
+	GetRunsIncludeConfigurationVersion                     = "configuration-version"
+	GetRunsIncludeConfigurationVersionVcsRevision          = "configuration-version.vcs-revision"
+	GetRunsIncludeConfigurationVersionWorkspace            = "configuration-version.workspace"
+	GetRunsIncludeCostEstimate                             = "cost-estimate"
+	GetRunsIncludeCreatedBy                                = "created-by"
+	GetRunsIncludeCreatedByRun                             = "created-by-run"
+	GetRunsIncludeCreatedByRunApply                        = "created-by-run.apply"
+	GetRunsIncludeCreatedByRunConfigurationVersion         = "created-by-run.configuration-version"
+	GetRunsIncludeCreatedByRunCostEstimate                 = "created-by-run.cost-estimate"
+	GetRunsIncludeCreatedByRunCreatedBy                    = "created-by-run.created-by"
+	GetRunsIncludeCreatedByRunCreatedByRun                 = "created-by-run.created-by-run"
+	GetRunsIncludeCreatedByRunEnvironment                  = "created-by-run.environment"
+	GetRunsIncludeCreatedByRunPlan                         = "created-by-run.plan"
+	GetRunsIncludeCreatedByRunPolicyChecks                 = "created-by-run.policy-checks"
+	GetRunsIncludeCreatedByRunStateVersions                = "created-by-run.state-versions"
+	GetRunsIncludeCreatedByRunStatusTransitions            = "created-by-run.status-transitions"
+	GetRunsIncludeCreatedByRunTags                         = "created-by-run.tags"
+	GetRunsIncludeCreatedByRunVcsRevision                  = "created-by-run.vcs-revision"
+	GetRunsIncludeCreatedByRunWorkspace                    = "created-by-run.workspace"
+	GetRunsIncludeCreatedByIdentityProviders               = "created-by.identity-providers"
+	GetRunsIncludeCreatedByTeams                           = "created-by.teams"
+	GetRunsIncludeEnvironment                              = "environment"
+	GetRunsIncludeEnvironmentAccount                       = "environment.account"
+	GetRunsIncludeEnvironmentCreatedBy                     = "environment.created-by"
+	GetRunsIncludeEnvironmentDefaultProviderConfigurations = "environment.default-provider-configurations"
+	GetRunsIncludeEnvironmentDefaultWorkspaceAgentPool     = "environment.default-workspace-agent-pool"
+	GetRunsIncludeEnvironmentDriftDetectionSchedules       = "environment.drift-detection-schedules"
+	GetRunsIncludeEnvironmentLockedBy                      = "environment.locked-by"
+	GetRunsIncludeEnvironmentPolicyGroups                  = "environment.policy-groups"
+	GetRunsIncludeEnvironmentProviderConfigurations        = "environment.provider-configurations"
+	GetRunsIncludeEnvironmentStorageProfile                = "environment.storage-profile"
+	GetRunsIncludeEnvironmentTags                          = "environment.tags"
+	GetRunsIncludeEnvironmentUpdatedBy                     = "environment.updated-by"
+	GetRunsIncludePlan                                     = "plan"
+	GetRunsIncludePolicyChecks                             = "policy-checks"
+	GetRunsIncludeStateVersions                            = "state-versions"
+	GetRunsIncludeStateVersionsNextStateVersion            = "state-versions.next-state-version"
+	GetRunsIncludeStateVersionsPreviousStateVersion        = "state-versions.previous-state-version"
+	GetRunsIncludeStateVersionsRun                         = "state-versions.run"
+	GetRunsIncludeStateVersionsWorkspace                   = "state-versions.workspace"
+	GetRunsIncludeStatusTransitions                        = "status-transitions"
+	GetRunsIncludeStatusTransitionsUser                    = "status-transitions.user"
+	GetRunsIncludeTags                                     = "tags"
+	GetRunsIncludeTagsAccount                              = "tags.account"
+	GetRunsIncludeVcsRevision                              = "vcs-revision"
+	GetRunsIncludeWorkspace                                = "workspace"
+	GetRunsIncludeWorkspaceAgentPool                       = "workspace.agent-pool"
+	GetRunsIncludeWorkspaceConfigurationVersion            = "workspace.configuration-version"
+	GetRunsIncludeWorkspaceCreatedBy                       = "workspace.created-by"
+	GetRunsIncludeWorkspaceCurrentRun                      = "workspace.current-run"
+	GetRunsIncludeWorkspaceDriftReport                     = "workspace.drift-report"
+	GetRunsIncludeWorkspaceEnvironment                     = "workspace.environment"
+	GetRunsIncludeWorkspaceLatestConfigurationVersion      = "workspace.latest-configuration-version"
+	GetRunsIncludeWorkspaceLatestRun                       = "workspace.latest-run"
+	GetRunsIncludeWorkspaceLockedBy                        = "workspace.locked-by"
+	GetRunsIncludeWorkspaceLockedByRun                     = "workspace.locked-by-run"
+	GetRunsIncludeWorkspaceModule                          = "workspace.module"
+	GetRunsIncludeWorkspaceModuleVersion                   = "workspace.module-version"
+	GetRunsIncludeWorkspaceReadmeId                        = "workspace.readme-id"
+	GetRunsIncludeWorkspaceSshKey                          = "workspace.ssh-key"
+	GetRunsIncludeWorkspaceTags                            = "workspace.tags"
+	GetRunsIncludeWorkspaceUpdatedBy                       = "workspace.updated-by"
+	GetRunsIncludeWorkspaceVcsProvider                     = "workspace.vcs-provider"
+)
+
 // This endpoint lists Runs Queue on allowed scopes.
 func (c *Client) GetRunsQueueRaw(ctx context.Context, opts *GetRunsQueueOptions) (*client.Response, error) {
 	path := "/runs-queue"
@@ -553,8 +666,7 @@ func (c *Client) GetRunsQueueRaw(ctx context.Context, opts *GetRunsQueueOptions)
 		if opts.Scheduled != "" {
 			params.Set("scheduled", opts.Scheduled)
 		}
-		// Handle parameter: Fields (map[string]interface{})
-		// Complex type map[string]interface{} - skip for now
+		opts.Fields.Encode(params)
 		// Add filters
 		for k, v := range opts.Filter {
 			params.Set("filter["+k+"]", v)
@@ -584,19 +696,18 @@ func (c *Client) GetRunsQueue(ctx context.Context, opts *GetRunsQueueOptions) ([
 		Meta struct {
 			Pagination *client.Pagination `json:"pagination"`
 		} `json:"meta"`
-		Included []map[string]interface{} `json:"included"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
+	// Index included resources once to resolve the relationships
+	included := client.NewIncluded(result.Included)
 	resources := make([]*schemas.Run, len(result.Data))
 	for i := range result.Data {
 		resources[i] = &result.Data[i]
-		// Populate included resources into relationships
-		if len(result.Included) > 0 {
-			resources[i].Relationships.PopulateIncludes(result.Included)
-		}
+		resources[i].ResolveIncludes(included)
 	}
 	return resources, nil
 }
@@ -605,6 +716,7 @@ func (c *Client) GetRunsQueue(ctx context.Context, opts *GetRunsQueueOptions) ([
 // This is the recommended and simple way to iterate through all the results.
 //
 // The iterator automatically fetches pages as needed and handles errors inline.
+// Pages are fetched ahead in the background when the client is created with client.WithPrefetch.
 //
 // Example:
 //
@@ -616,73 +728,23 @@ func (c *Client) GetRunsQueue(ctx context.Context, opts *GetRunsQueueOptions) ([
 //	}
 func (c *Client) GetRunsQueueIter(ctx context.Context, opts *GetRunsQueueOptions) iter.Seq2[schemas.Run, error] {
 	return func(yield func(schemas.Run, error) bool) {
-		// Determine page size from opts or use default
-		pageSize := 20
-		if opts != nil && opts.PageSize > 0 {
-			pageSize = opts.PageSize
-		}
-
-		pageNum := 1
-
-		for {
-			// Check context
-			if err := ctx.Err(); err != nil {
-				yield(schemas.Run{}, err)
-				return
-			}
+		it := c.GetRunsQueuePaged(ctx, opts)
+		defer it.Close() // Stops the prefetching on early exit
 
-			// Copy options and set page number
-			pageOpts := &GetRunsQueueOptions{}
-			if opts != nil {
-				*pageOpts = *opts
-			}
-			pageOpts.PageNumber = pageNum
-			pageOpts.PageSize = pageSize
-
-			// Fetch page using Raw method to get pagination metadata
-			resp, err := c.GetRunsQueueRaw(ctx, pageOpts)
-			if err != nil {
-				yield(schemas.Run{}, err)
-				return
-			}
-			defer resp.Body.Close()
-
-			// Decode response
-			var result struct {
-				Data []schemas.Run `json:"data"`
-				Meta struct {
-					Pagination *client.Pagination `json:"pagination"`
-				} `json:"meta"`
-				Included []map[string]interface{} `json:"included"`
-			}
-			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-				yield(schemas.Run{}, fmt.Errorf("failed to decode response: %w", err))
-				return
+		for it.Next() {
+			if !yield(*it.Value(), nil) {
+				return // Consumer requested early exit
 			}
-
-			// Yield each item
-			for i := range result.Data {
-				// Populate included resources into relationships
-				if len(result.Included) > 0 {
-					result.Data[i].Relationships.PopulateIncludes(result.Included)
-				}
-				if !yield(result.Data[i], nil) {
-					return // Consumer requested early exit
-				}
-			}
-
-			// Check if there are more pages
-			if result.Meta.Pagination == nil || result.Meta.Pagination.NextPage == nil {
-				break
-			}
-
-			pageNum++
+		}
+		if err := it.Err(); err != nil {
+			yield(schemas.Run{}, err)
 		}
 	}
 }
 
 // GetRunsQueuePaged returns a stateful iterator with access to pagination metadata.
-// Use this when you need PageInfo(), Remaining(), or Collect() methods.
+// Use this when you need PageInfo(), Remaining(), or Collect() methods, or to resume
+// an interrupted iteration from the iterator Checkpoint().
 //
 // Example:
 //
@@ -695,6 +757,10 @@ func (c *Client) GetRunsQueueIter(ctx context.Context, opts *GetRunsQueueOptions
 //	if err := iter.Err(); err != nil {
 //	    // Handle error
 //	}
+//
+// Resuming from a checkpoint saved by an iterator with the same options:
+//
+//	iter := client.Run.GetRunsQueuePaged(ctx, opts).Resume(checkpoint)
 func (c *Client) GetRunsQueuePaged(ctx context.Context, opts *GetRunsQueueOptions) *client.Iterator[schemas.Run] {
 	// Determine page size from opts or use default
 	pageSize := 20
@@ -725,26 +791,34 @@ func (c *Client) GetRunsQueuePaged(ctx context.Context, opts *GetRunsQueueOption
 			Meta struct {
 				Pagination *client.Pagination `json:"pagination"`
 			} `json:"meta"`
-			Included []map[string]interface{} `json:"included"`
+			Included []json.RawMessage `json:"included"`
 		}
 		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 			return nil, nil, fmt.Errorf("failed to decode response: %w", err)
 		}
 
-		// Convert to slice of pointers and populate includes
+		// Convert to slice of pointers and resolve includes
+		included := client.NewIncluded(result.Included)
 		items := make([]*schemas.Run, len(result.Data))
 		for i := range result.Data {
 			items[i] = &result.Data[i]
-			// Populate included resources into relationships
-			if len(result.Included) > 0 {
-				items[i].Relationships.PopulateIncludes(result.Included)
-			}
+			items[i].ResolveIncludes(included)
 		}
 
 		return items, result.Meta.Pagination, nil
 	}
 
-	return client.NewIterator[schemas.Run](ctx, pageSize, fetchPage)
+	// Checkpoints only resume the iterators of the same listing
+	listOpts := GetRunsQueueOptions{}
+	if opts != nil {
+		listOpts = *opts
+	}
+	listOpts.PageNumber, listOpts.PageSize = 0, 0
+	filters := client.HashOptions("GetRunsQueue", listOpts)
+
+	return client.NewIterator[schemas.Run](ctx, pageSize, fetchPage).
+		Prefetch(c.httpClient.Prefetch()).
+		FiltersHash(filters)
 }
 
 // GetRunsQueueOptions holds optional parameters for GetRunsQueue
@@ -760,6 +834,74 @@ type GetRunsQueueOptions struct {
 	// List only runs that are scheduled.
 	Scheduled string
 	// The value of the fields[resource-type] parameter is a comma-separated list that refers to the name of the fields to be returned for the resource. An empty value indicates that no fields should be returned.
-	Fields map[string]interface{}
+	Fields client.Fields
 	Filter map[string]string
 }
+
+// Relationship paths accepted by the Include option of GetRunsQueue
+const (
+	GetRunsQueueIncludeApply                                    = "apply"
+	GetRunsQueueIncludeConfigurationVersion                     = "configuration-version"
+	GetRunsQueueIncludeConfigurationVersionVcsRevision          = "configuration-version.vcs-revision"
+	GetRunsQueueIncludeConfigurationVersionWorkspace            = "configuration-version.workspace"
+	GetRunsQueueIncludeCostEstimate                             = "cost-estimate"
+	GetRunsQueueIncludeCreatedBy                                = "created-by"
+	GetRunsQueueIncludeCreatedByRun                             = "created-by-run"
+	GetRunsQueueIncludeCreatedByRunApply                        = "created-by-run.apply"
+	GetRunsQueueIncludeCreatedByRunConfigurationVersion         = "created-by-run.configuration-version"
+	GetRunsQueueIncludeCreatedByRunCostEstimate                 = "created-by-run.cost-estimate"
+	GetRunsQueueIncludeCreatedByRunCreatedBy                    = "created-by-run.created-by"
+	GetRunsQueueIncludeCreatedByRunCreatedByRun                 = "created-by-run.created-by-run"
+	GetRunsQueueIncludeCreatedByRunEnvironment                  = "created-by-run.environment"
+	GetRunsQueueIncludeCreatedByRunPlan                         = "created-by-run.plan"
+	GetRunsQueueIncludeCreatedByRunPolicyChecks                 = "created-by-run.policy-checks"
+	GetRunsQueueIncludeCreatedByRunStateVersions                = "created-by-run.state-versions"
+	GetRunsQueueIncludeCreatedByRunStatusTransitions            = "created-by-run.status-transitions"
+	GetRunsQueueIncludeCreatedByRunTags                         = "created-by-run.tags"
+	GetRunsQueueIncludeCreatedByRunVcsRevision                  = "created-by-run.vcs-revision"
+	GetRunsQueueIncludeCreatedByRunWorkspace                    = "created-by-run.workspace"
+	GetRunsQueueIncludeCreatedByIdentityProviders               = "created-by.identity-providers"
+	GetRunsQueueIncludeCreatedByTeams                           = "created-by.teams"
+	GetRunsQueueIncludeEnvironment                              = "environment"
+	GetRunsQueueIncludeEnvironmentAccount                       = "environment.account"
+	GetRunsQueueIncludeEnvironmentCreatedBy                     = "environment.created-by"
+	GetRunsQueueIncludeEnvironmentDefaultProviderConfigurations = "environment.default-provider-configurations"
+	GetRunsQueueIncludeEnvironmentDefaultWorkspaceAgentPool     = "environment.default-workspace-agent-pool"
+	GetRunsQueueIncludeEnvironmentDriftDetectionSchedules       = "environment.drift-detection-schedules"
+	GetRunsQueueIncludeEnvironmentLockedBy                      = "environment.locked-by"
+	GetRunsQueueIncludeEnvironmentPolicyGroups                  = "environment.policy-groups"
+	GetRunsQueueIncludeEnvironmentProviderConfigurations        = "environment.provider-configurations"
+	GetRunsQueueIncludeEnvironmentStorageProfile                = "environment.storage-profile"
+	GetRunsQueueIncludeEnvironmentTags                          = "environment.tags"
+	GetRunsQueueIncludeEnvironmentUpdatedBy                     = "environment.updated-by"
+	GetRunsQueueIncludePlan                                     = "plan"
+	GetRunsQueueIncludePolicyChecks                             = "policy-checks"
+	GetRunsQueueIncludeStateVersions                            = "state-versions"
+	GetRunsQueueIncludeStateVersionsNextStateVersion            = "state-versions.next-state-version"
+	GetRunsQueueIncludeStateVersionsPreviousStateVersion        = "state-versions.previous-state-version"
+	GetRunsQueueIncludeStateVersionsRun                         = "state-versions.run"
+	GetRunsQueueIncludeStateVersionsWorkspace                   = "state-versions.workspace"
+	GetRunsQueueIncludeStatusTransitions                        = "status-transitions"
+	GetRunsQueueIncludeStatusTransitionsUser                    = "status-transitions.user"
+	GetRunsQueueIncludeTags                                     = "tags"
+	GetRunsQueueIncludeTagsAccount                              = "tags.account"
+	GetRunsQueueIncludeVcsRevision                              = "vcs-revision"
+	GetRunsQueueIncludeWorkspace                                = "workspace"
+	GetRunsQueueIncludeWorkspaceAgentPool                       = "workspace.agent-pool"
+	GetRunsQueueIncludeWorkspaceConfigurationVersion            = "workspace.configuration-version"
+	GetRunsQueueIncludeWorkspaceCreatedBy                       = "workspace.created-by"
+	GetRunsQueueIncludeWorkspaceCurrentRun                      = "workspace.current-run"
+	GetRunsQueueIncludeWorkspaceDriftReport                     = "workspace.drift-report"
+	GetRunsQueueIncludeWorkspaceEnvironment                     = "workspace.environment"
+	GetRunsQueueIncludeWorkspaceLatestConfigurationVersion      = "workspace.latest-configuration-version"
+	GetRunsQueueIncludeWorkspaceLatestRun                       = "workspace.latest-run"
+	GetRunsQueueIncludeWorkspaceLockedBy                        = "workspace.locked-by"
+	GetRunsQueueIncludeWorkspaceLockedByRun                     = "workspace.locked-by-run"
+	GetRunsQueueIncludeWorkspaceModule                          = "workspace.module"
+	GetRunsQueueIncludeWorkspaceModuleVersion                   = "workspace.module-version"
+	GetRunsQueueIncludeWorkspaceReadmeId                        = "workspace.readme-id"
+	GetRunsQueueIncludeWorkspaceSshKey                          = "workspace.ssh-key"
+	GetRunsQueueIncludeWorkspaceTags                            = "workspace.tags"
+	GetRunsQueueIncludeWorkspaceUpdatedBy                       = "workspace.updated-by"
+	GetRunsQueueIncludeWorkspaceVcsProvider                     = "workspace.vcs-provider"
+)
@@ -46,17 +46,15 @@ func (c *Client) CreateRunScheduleRule(ctx context.Context, req *schemas.RunSche
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.RunScheduleRule  `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.RunScheduleRule `json:"data"`
+		Included []json.RawMessage       `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -116,17 +114,15 @@ func (c *Client) GetRunScheduleRule(ctx context.Context, runScheduleRule string,
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.RunScheduleRule  `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.RunScheduleRule `json:"data"`
+		Included []json.RawMessage       `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -137,6 +133,28 @@ type GetRunScheduleRuleOptions struct {
 	Filter  map[string]string
 }
 
+// Relationship paths accepted by the Include option of GetRunScheduleRule
+const (
+	GetRunScheduleRuleIncludeWorkspace                           = "workspace"
+	GetRunScheduleRuleIncludeWorkspaceAgentPool                  = "workspace.agent-pool"
+	GetRunScheduleRuleIncludeWorkspaceConfigurationVersion       = "workspace.configuration-version"
+	GetRunScheduleRuleIncludeWorkspaceCreatedBy                  = "workspace.created-by"
+	GetRunScheduleRuleIncludeWorkspaceCurrentRun                 = "workspace.current-run"
+	GetRunScheduleRuleIncludeWorkspaceDriftReport                = "workspace.drift-report"
+	GetRunScheduleRuleIncludeWorkspaceEnvironment                = "workspace.environment"
+	GetRunScheduleRuleIncludeWorkspaceLatestConfigurationVersion = "workspace.latest-configuration-version"
+	GetRunScheduleRuleIncludeWorkspaceLatestRun                  = "workspace.latest-run"
+	GetRunScheduleRuleIncludeWorkspaceLockedBy                   = "workspace.locked-by"
+	GetRunScheduleRuleIncludeWorkspaceLockedByRun                = "workspace.locked-by-run"
+	GetRunScheduleRuleIncludeWorkspaceModule                     = "workspace.module"
+	GetRunScheduleRuleIncludeWorkspaceModuleVersion              = "workspace.module-version"
+	GetRunScheduleRuleIncludeWorkspaceReadmeId                   = "workspace.readme-id"
+	GetRunScheduleRuleIncludeWorkspaceSshKey                     = "workspace.ssh-key"
+	GetRunScheduleRuleIncludeWorkspaceTags                       = "workspace.tags"
+	GetRunScheduleRuleIncludeWorkspaceUpdatedBy                  = "workspace.updated-by"
+	GetRunScheduleRuleIncludeWorkspaceVcsProvider                = "workspace.vcs-provider"
+)
+
 // This endpoint returns a list of run schedule rules.
 func (c *Client) ListScheduleRulesRaw(ctx context.Context, opts *ListScheduleRulesOptions) (*client.Response, error) {
 	path := "/run-schedule-rules"
@@ -181,19 +199,18 @@ func (c *Client) ListScheduleRules(ctx context.Context, opts *ListScheduleRulesO
 		Meta struct {
 			Pagination *client.Pagination `json:"pagination"`
 		} `json:"meta"`
-		Included []map[string]interface{} `json:"included"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
+	// Index included resources once to resolve the relationships
+	included := client.NewIncluded(result.Included)
 	resources := make([]*schemas.RunScheduleRule, len(result.Data))
 	for i := range result.Data {
 		resources[i] = &result.Data[i]
-		// Populate included resources into relationships
-		if len(result.Included) > 0 {
-			resources[i].Relationships.PopulateIncludes(result.Included)
-		}
+		resources[i].ResolveIncludes(included)
 	}
 	return resources, nil
 }
@@ -202,6 +219,7 @@ func (c *Client) ListScheduleRules(ctx context.Context, opts *ListScheduleRulesO
 // This is the recommended and simple way to iterate through all the results.
 //
 // The iterator automatically fetches pages as needed and handles errors inline.
+// Pages are fetched ahead in the background when the client is created with client.WithPrefetch.
 //
 // Example:
 //
@@ -213,73 +231,23 @@ func (c *Client) ListScheduleRules(ctx context.Context, opts *ListScheduleRulesO
 //	}
 func (c *Client) ListScheduleRulesIter(ctx context.Context, opts *ListScheduleRulesOptions) iter.Seq2[schemas.RunScheduleRule, error] {
 	return func(yield func(schemas.RunScheduleRule, error) bool) {
-		// Determine page size from opts or use default
-		pageSize := 20
-		if opts != nil && opts.PageSize > 0 {
-			pageSize = opts.PageSize
-		}
-
-		pageNum := 1
-
-		for {
-			// Check context
-			if err := ctx.Err(); err != nil {
-				yield(schemas.RunScheduleRule{}, err)
-				return
-			}
-
-			// Copy options and set page number
-			pageOpts := &ListScheduleRulesOptions{}
-			if opts != nil {
-				*pageOpts = *opts
-			}
-			pageOpts.PageNumber = pageNum
-			pageOpts.PageSize = pageSize
-
-			// Fetch page using Raw method to get pagination metadata
-			resp, err := c.ListScheduleRulesRaw(ctx, pageOpts)
-			if err != nil {
-				yield(schemas.RunScheduleRule{}, err)
-				return
-			}
-			defer resp.Body.Close()
-
-			// Decode response
-			var result struct {
-				Data []schemas.RunScheduleRule `json:"data"`
-				Meta struct {
-					Pagination *client.Pagination `json:"pagination"`
-				} `json:"meta"`
-				Included []map[string]interface{} `json:"included"`
-			}
-			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-				yield(schemas.RunScheduleRule{}, fmt.Errorf("failed to decode response: %w", err))
-				return
-			}
+		it := c.ListScheduleRulesPaged(ctx, opts)
+		defer it.Close() // Stops the prefetching on early exit
 
-			// Yield each item
-			for i := range result.Data {
-				// Populate included resources into relationships
-				if len(result.Included) > 0 {
-					result.Data[i].Relationships.PopulateIncludes(result.Included)
-				}
-				if !yield(result.Data[i], nil) {
-					return // Consumer requested early exit
-				}
+		for it.Next() {
+			if !yield(*it.Value(), nil) {
+				return // Consumer requested early exit
 			}
-
-			// Check if there are more pages
-			if result.Meta.Pagination == nil || result.Meta.Pagination.NextPage == nil {
-				break
-			}
-
-			pageNum++
+		}
+		if err := it.Err(); err != nil {
+			yield(schemas.RunScheduleRule{}, err)
 		}
 	}
 }
 
 // ListScheduleRulesPaged returns a stateful iterator with access to pagination metadata.
-// Use this when you need PageInfo(), Remaining(), or Collect() methods.
+// Use this when you need PageInfo(), Remaining(), or Collect() methods, or to resume
+// an interrupted iteration from the iterator Checkpoint().
 //
 // Example:
 //
@@ -292,6 +260,10 @@ func (c *Client) ListScheduleRulesIter(ctx context.Context, opts *ListScheduleRu
 //	if err := iter.Err(); err != nil {
 //	    // Handle error
 //	}
+//
+// Resuming from a checkpoint saved by an iterator with the same options:
+//
+//	iter := client.RunScheduleRule.ListScheduleRulesPaged(ctx, opts).Resume(checkpoint)
 func (c *Client) ListScheduleRulesPaged(ctx context.Context, opts *ListScheduleRulesOptions) *client.Iterator[schemas.RunScheduleRule] {
 	// Determine page size from opts or use default
 	pageSize := 20
@@ -322,26 +294,34 @@ func (c *Client) ListScheduleRulesPaged(ctx context.Context, opts *ListScheduleR
 			Meta struct {
 				Pagination *client.Pagination `json:"pagination"`
 			} `json:"meta"`
-			Included []map[string]interface{} `json:"included"`
+			Included []json.RawMessage `json:"included"`
 		}
 		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 			return nil, nil, fmt.Errorf("failed to decode response: %w", err)
 		}
 
-		// Convert to slice of pointers and populate includes
+		// Convert to slice of pointers and resolve includes
+		included := client.NewIncluded(result.Included)
 		items := make([]*schemas.RunScheduleRule, len(result.Data))
 		for i := range result.Data {
 			items[i] = &result.Data[i]
-			// Populate included resources into relationships
-			if len(result.Included) > 0 {
-				items[i].Relationships.PopulateIncludes(result.Included)
-			}
+			items[i].ResolveIncludes(included)
 		}
 
 		return items, result.Meta.Pagination, nil
 	}
 
-	return client.NewIterator[schemas.RunScheduleRule](ctx, pageSize, fetchPage)
+	// Checkpoints only resume the iterators of the same listing
+	listOpts := ListScheduleRulesOptions{}
+	if opts != nil {
+		listOpts = *opts
+	}
+	listOpts.PageNumber, listOpts.PageSize = 0, 0
+	filters := client.HashOptions("ListScheduleRules", listOpts)
+
+	return client.NewIterator[schemas.RunScheduleRule](ctx, pageSize, fetchPage).
+		Prefetch(c.httpClient.Prefetch()).
+		FiltersHash(filters)
 }
 
 // ListScheduleRulesOptions holds optional parameters for ListScheduleRules
@@ -355,6 +335,28 @@ type ListScheduleRulesOptions struct {
 	Filter  map[string]string
 }
 
+// Relationship paths accepted by the Include option of ListScheduleRules
+const (
+	ListScheduleRulesIncludeWorkspace                           = "workspace"
+	ListScheduleRulesIncludeWorkspaceAgentPool                  = "workspace.agent-pool"
+	ListScheduleRulesIncludeWorkspaceConfigurationVersion       = "workspace.configuration-version"
+	ListScheduleRulesIncludeWorkspaceCreatedBy                  = "workspace.created-by"
+	ListScheduleRulesIncludeWorkspaceCurrentRun                 = "workspace.current-run"
+	ListScheduleRulesIncludeWorkspaceDriftReport                = "workspace.drift-report"
+	ListScheduleRulesIncludeWorkspaceEnvironment                = "workspace.environment"
+	ListScheduleRulesIncludeWorkspaceLatestConfigurationVersion = "workspace.latest-configuration-version"
+	ListScheduleRulesIncludeWorkspaceLatestRun                  = "workspace.latest-run"
+	ListScheduleRulesIncludeWorkspaceLockedBy                   = "workspace.locked-by"
+	ListScheduleRulesIncludeWorkspaceLockedByRun                = "workspace.locked-by-run"
+	ListScheduleRulesIncludeWorkspaceModule                     = "workspace.module"
+	ListScheduleRulesIncludeWorkspaceModuleVersion              = "workspace.module-version"
+	ListScheduleRulesIncludeWorkspaceReadmeId                   = "workspace.readme-id"
+	ListScheduleRulesIncludeWorkspaceSshKey                     = "workspace.ssh-key"
+	ListScheduleRulesIncludeWorkspaceTags                       = "workspace.tags"
+	ListScheduleRulesIncludeWorkspaceUpdatedBy                  = "workspace.updated-by"
+	ListScheduleRulesIncludeWorkspaceVcsProvider                = "workspace.vcs-provider"
+)
+
 // Updates a specific run schedule rule based on the provided rule ID, schedule mode, and schedule. It validates the cron expression and raises an error if it's invalid.
 func (c *Client) UpdateRunScheduleRuleRaw(ctx context.Context, runScheduleRule string, req *schemas.RunScheduleRuleRequest) (*client.Response, error) {
 	path := "/run-schedule-rules/{run_schedule_rule}"
@@ -378,16 +380,14 @@ func (c *Client) UpdateRunScheduleRule(ctx context.Context, runScheduleRule stri
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.RunScheduleRule  `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.RunScheduleRule `json:"data"`
+		Included []json.RawMessage       `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
@@ -45,17 +45,15 @@ func (c *Client) CreateRunTrigger(ctx context.Context, req *schemas.RunTriggerRe
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.RunTrigger       `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.RunTrigger `json:"data"`
+		Included []json.RawMessage  `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -115,17 +113,15 @@ func (c *Client) GetRunTrigger(ctx context.Context, runTrigger string, opts *Get
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.RunTrigger       `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.RunTrigger `json:"data"`
+		Included []json.RawMessage  `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -135,3 +131,43 @@ type GetRunTriggerOptions struct {
 	Include []string
 	Filter  map[string]string
 }
+
+// Relationship paths accepted by the Include option of GetRunTrigger
+const (
+	GetRunTriggerIncludeDownstream                           = "downstream"
+	GetRunTriggerIncludeDownstreamAgentPool                  = "downstream.agent-pool"
+	GetRunTriggerIncludeDownstreamConfigurationVersion       = "downstream.configuration-version"
+	GetRunTriggerIncludeDownstreamCreatedBy                  = "downstream.created-by"
+	GetRunTriggerIncludeDownstreamCurrentRun                 = "downstream.current-run"
+	GetRunTriggerIncludeDownstreamDriftReport                = "downstream.drift-report"
+	GetRunTriggerIncludeDownstreamEnvironment                = "downstream.environment"
+	GetRunTriggerIncludeDownstreamLatestConfigurationVersion = "downstream.latest-configuration-version"
+	GetRunTriggerIncludeDownstreamLatestRun                  = "downstream.latest-run"
+	GetRunTriggerIncludeDownstreamLockedBy                   = "downstream.locked-by"
+	GetRunTriggerIncludeDownstreamLockedByRun                = "downstream.locked-by-run"
+	GetRunTriggerIncludeDownstreamModule                     = "downstream.module"
+	GetRunTriggerIncludeDownstreamModuleVersion              = "downstream.module-version"
+	GetRunTriggerIncludeDownstreamReadmeId                   = "downstream.readme-id"
+	GetRunTriggerIncludeDownstreamSshKey                     = "downstream.ssh-key"
+	GetRunTriggerIncludeDownstreamTags                       = "downstream.tags"
+	GetRunTriggerIncludeDownstreamUpdatedBy                  = "downstream.updated-by"
+	GetRunTriggerIncludeDownstreamVcsProvider                = "downstream.vcs-provider"
+	GetRunTriggerIncludeUpstream                             = "upstream"
+	GetRunTriggerIncludeUpstreamAgentPool                    = "upstream.agent-pool"
+	GetRunTriggerIncludeUpstreamConfigurationVersion         = "upstream.configuration-version"
+	GetRunTriggerIncludeUpstreamCreatedBy                    = "upstream.created-by"
+	GetRunTriggerIncludeUpstreamCurrentRun                   = "upstream.current-run"
+	GetRunTriggerIncludeUpstreamDriftReport                  = "upstream.drift-report"
+	GetRunTriggerIncludeUpstreamEnvironment                  = "upstream.environment"
+	GetRunTriggerIncludeUpstreamLatestConfigurationVersion   = "upstream.latest-configuration-version"
+	GetRunTriggerIncludeUpstreamLatestRun                    = "upstream.latest-run"
+	GetRunTriggerIncludeUpstreamLockedBy                     = "upstream.locked-by"
+	GetRunTriggerIncludeUpstreamLockedByRun                  = "upstream.locked-by-run"
+	GetRunTriggerIncludeUpstreamModule                       = "upstream.module"
+	GetRunTriggerIncludeUpstreamModuleVersion                = "upstream.module-version"
+	GetRunTriggerIncludeUpstreamReadmeId                     = "upstream.readme-id"
+	GetRunTriggerIncludeUpstreamSshKey                       = "upstream.ssh-key"
+	GetRunTriggerIncludeUpstreamTags                         = "upstream.tags"
+	GetRunTriggerIncludeUpstreamUpdatedBy                    = "upstream.updated-by"
+	GetRunTriggerIncludeUpstreamVcsProvider                  = "upstream.vcs-provider"
+)
@@ -41,8 +41,8 @@ func (c *Client) GetSecurityRules(ctx context.Context) (*schemas.SecurityRules,
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.SecurityRules    `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.SecurityRules `json:"data"`
+		Included []json.RawMessage     `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
@@ -73,8 +73,8 @@ func (c *Client) UpdateSecurityRules(ctx context.Context, req *schemas.SecurityR
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.SecurityRules    `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.SecurityRules `json:"data"`
+		Included []json.RawMessage     `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
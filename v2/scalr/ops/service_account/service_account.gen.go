@@ -62,16 +62,14 @@ func (c *Client) CreateAssumeServiceAccountPolicy(ctx context.Context, serviceAc
 
 	var result struct {
 		Data     schemas.AssumeServiceAccountPolicy `json:"data"`
-		Included []map[string]interface{}           `json:"included"`
+		Included []json.RawMessage                  `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -82,6 +80,16 @@ type CreateAssumeServiceAccountPolicyOptions struct {
 	Filter  map[string]string
 }
 
+// Relationship paths accepted by the Include option of CreateAssumeServiceAccountPolicy
+const (
+	CreateAssumeServiceAccountPolicyIncludeProvider                             = "provider"
+	CreateAssumeServiceAccountPolicyIncludeProviderAssumeServiceAccountPolicies = "provider.assume-service-account-policies"
+	CreateAssumeServiceAccountPolicyIncludeServiceAccount                       = "service-account"
+	CreateAssumeServiceAccountPolicyIncludeServiceAccountAccount                = "service-account.account"
+	CreateAssumeServiceAccountPolicyIncludeServiceAccountCreatedBy              = "service-account.created-by"
+	CreateAssumeServiceAccountPolicyIncludeServiceAccountOwners                 = "service-account.owners"
+)
+
 // Create a new [IAM](https://docs.scalr.io/docs/identity-and-access-management) service account.
 func (c *Client) CreateServiceAccountRaw(ctx context.Context, req *schemas.ServiceAccountRequest, opts *CreateServiceAccountOptions) (*client.Response, error) {
 	path := "/service-accounts"
@@ -118,17 +126,15 @@ func (c *Client) CreateServiceAccount(ctx context.Context, req *schemas.ServiceA
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.ServiceAccount   `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.ServiceAccount `json:"data"`
+		Included []json.RawMessage      `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -139,6 +145,21 @@ type CreateServiceAccountOptions struct {
 	Filter  map[string]string
 }
 
+// Relationship paths accepted by the Include option of CreateServiceAccount
+const (
+	CreateServiceAccountIncludeAccount                    = "account"
+	CreateServiceAccountIncludeAccountBillingPlan         = "account.billing-plan"
+	CreateServiceAccountIncludeAccountIdentityProvider    = "account.identity-provider"
+	CreateServiceAccountIncludeAccountOwner               = "account.owner"
+	CreateServiceAccountIncludeCreatedBy                  = "created-by"
+	CreateServiceAccountIncludeCreatedByIdentityProviders = "created-by.identity-providers"
+	CreateServiceAccountIncludeCreatedByTeams             = "created-by.teams"
+	CreateServiceAccountIncludeOwners                     = "owners"
+	CreateServiceAccountIncludeOwnersAccount              = "owners.account"
+	CreateServiceAccountIncludeOwnersIdentityProvider     = "owners.identity-provider"
+	CreateServiceAccountIncludeOwnersUsers                = "owners.users"
+)
+
 // The endpoint deletes an assume service account policy by ID.
 func (c *Client) DeleteAssumeServiceAccountPolicyRaw(ctx context.Context, serviceAccount string, assumeServiceAccountPolicy string) (*client.Response, error) {
 	path := "/service-accounts/{service_account}/assume-policies/{assume_service_account_policy}"
@@ -223,16 +244,14 @@ func (c *Client) GetAssumeServiceAccountPolicy(ctx context.Context, serviceAccou
 
 	var result struct {
 		Data     schemas.AssumeServiceAccountPolicy `json:"data"`
-		Included []map[string]interface{}           `json:"included"`
+		Included []json.RawMessage                  `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -243,6 +262,16 @@ type GetAssumeServiceAccountPolicyOptions struct {
 	Filter  map[string]string
 }
 
+// Relationship paths accepted by the Include option of GetAssumeServiceAccountPolicy
+const (
+	GetAssumeServiceAccountPolicyIncludeProvider                             = "provider"
+	GetAssumeServiceAccountPolicyIncludeProviderAssumeServiceAccountPolicies = "provider.assume-service-account-policies"
+	GetAssumeServiceAccountPolicyIncludeServiceAccount                       = "service-account"
+	GetAssumeServiceAccountPolicyIncludeServiceAccountAccount                = "service-account.account"
+	GetAssumeServiceAccountPolicyIncludeServiceAccountCreatedBy              = "service-account.created-by"
+	GetAssumeServiceAccountPolicyIncludeServiceAccountOwners                 = "service-account.owners"
+)
+
 // This endpoint returns an [IAM](https://docs.scalr.io/docs/identity-and-access-management) service account by ID.
 func (c *Client) GetServiceAccountRaw(ctx context.Context, serviceAccount string, opts *GetServiceAccountOptions) (*client.Response, error) {
 	path := "/service-accounts/{service_account}"
@@ -278,17 +307,15 @@ func (c *Client) GetServiceAccount(ctx context.Context, serviceAccount string, o
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.ServiceAccount   `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.ServiceAccount `json:"data"`
+		Included []json.RawMessage      `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -299,6 +326,21 @@ type GetServiceAccountOptions struct {
 	Filter  map[string]string
 }
 
+// Relationship paths accepted by the Include option of GetServiceAccount
+const (
+	GetServiceAccountIncludeAccount                    = "account"
+	GetServiceAccountIncludeAccountBillingPlan         = "account.billing-plan"
+	GetServiceAccountIncludeAccountIdentityProvider    = "account.identity-provider"
+	GetServiceAccountIncludeAccountOwner               = "account.owner"
+	GetServiceAccountIncludeCreatedBy                  = "created-by"
+	GetServiceAccountIncludeCreatedByIdentityProviders = "created-by.identity-providers"
+	GetServiceAccountIncludeCreatedByTeams             = "created-by.teams"
+	GetServiceAccountIncludeOwners                     = "owners"
+	GetServiceAccountIncludeOwnersAccount              = "owners.account"
+	GetServiceAccountIncludeOwnersIdentityProvider     = "owners.identity-provider"
+	GetServiceAccountIncludeOwnersUsers                = "owners.users"
+)
+
 // This endpoint returns a list of [IAM](https://docs.scalr.io/docs/identity-and-access-management) service accounts.
 func (c *Client) GetServiceAccountsRaw(ctx context.Context, opts *GetServiceAccountsOptions) (*client.Response, error) {
 	path := "/service-accounts"
@@ -350,19 +392,18 @@ func (c *Client) GetServiceAccounts(ctx context.Context, opts *GetServiceAccount
 		Meta struct {
 			Pagination *client.Pagination `json:"pagination"`
 		} `json:"meta"`
-		Included []map[string]interface{} `json:"included"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
+	// Index included resources once to resolve the relationships
+	included := client.NewIncluded(result.Included)
 	resources := make([]*schemas.ServiceAccount, len(result.Data))
 	for i := range result.Data {
 		resources[i] = &result.Data[i]
-		// Populate included resources into relationships
-		if len(result.Included) > 0 {
-			resources[i].Relationships.PopulateIncludes(result.Included)
-		}
+		resources[i].ResolveIncludes(included)
 	}
 	return resources, nil
 }
@@ -371,6 +412,7 @@ func (c *Client) GetServiceAccounts(ctx context.Context, opts *GetServiceAccount
 // This is the recommended and simple way to iterate through all the results.
 //
 // The iterator automatically fetches pages as needed and handles errors inline.
+// Pages are fetched ahead in the background when the client is created with client.WithPrefetch.
 //
 // Example:
 //
@@ -382,73 +424,23 @@ func (c *Client) GetServiceAccounts(ctx context.Context, opts *GetServiceAccount
 //	}
 func (c *Client) GetServiceAccountsIter(ctx context.Context, opts *GetServiceAccountsOptions) iter.Seq2[schemas.ServiceAccount, error] {
 	return func(yield func(schemas.ServiceAccount, error) bool) {
-		// Determine page size from opts or use default
-		pageSize := 20
-		if opts != nil && opts.PageSize > 0 {
-			pageSize = opts.PageSize
-		}
+		it := c.GetServiceAccountsPaged(ctx, opts)
+		defer it.Close() // Stops the prefetching on early exit
 
-		pageNum := 1
-
-		for {
-			// Check context
-			if err := ctx.Err(); err != nil {
-				yield(schemas.ServiceAccount{}, err)
-				return
+		for it.Next() {
+			if !yield(*it.Value(), nil) {
+				return // Consumer requested early exit
 			}
-
-			// Copy options and set page number
-			pageOpts := &GetServiceAccountsOptions{}
-			if opts != nil {
-				*pageOpts = *opts
-			}
-			pageOpts.PageNumber = pageNum
-			pageOpts.PageSize = pageSize
-
-			// Fetch page using Raw method to get pagination metadata
-			resp, err := c.GetServiceAccountsRaw(ctx, pageOpts)
-			if err != nil {
-				yield(schemas.ServiceAccount{}, err)
-				return
-			}
-			defer resp.Body.Close()
-
-			// Decode response
-			var result struct {
-				Data []schemas.ServiceAccount `json:"data"`
-				Meta struct {
-					Pagination *client.Pagination `json:"pagination"`
-				} `json:"meta"`
-				Included []map[string]interface{} `json:"included"`
-			}
-			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-				yield(schemas.ServiceAccount{}, fmt.Errorf("failed to decode response: %w", err))
-				return
-			}
-
-			// Yield each item
-			for i := range result.Data {
-				// Populate included resources into relationships
-				if len(result.Included) > 0 {
-					result.Data[i].Relationships.PopulateIncludes(result.Included)
-				}
-				if !yield(result.Data[i], nil) {
-					return // Consumer requested early exit
-				}
-			}
-
-			// Check if there are more pages
-			if result.Meta.Pagination == nil || result.Meta.Pagination.NextPage == nil {
-				break
-			}
-
-			pageNum++
+		}
+		if err := it.Err(); err != nil {
+			yield(schemas.ServiceAccount{}, err)
 		}
 	}
 }
 
 // GetServiceAccountsPaged returns a stateful iterator with access to pagination metadata.
-// Use this when you need PageInfo(), Remaining(), or Collect() methods.
+// Use this when you need PageInfo(), Remaining(), or Collect() methods, or to resume
+// an interrupted iteration from the iterator Checkpoint().
 //
 // Example:
 //
@@ -461,6 +453,10 @@ func (c *Client) GetServiceAccountsIter(ctx context.Context, opts *GetServiceAcc
 //	if err := iter.Err(); err != nil {
 //	    // Handle error
 //	}
+//
+// Resuming from a checkpoint saved by an iterator with the same options:
+//
+//	iter := client.ServiceAccount.GetServiceAccountsPaged(ctx, opts).Resume(checkpoint)
 func (c *Client) GetServiceAccountsPaged(ctx context.Context, opts *GetServiceAccountsOptions) *client.Iterator[schemas.ServiceAccount] {
 	// Determine page size from opts or use default
 	pageSize := 20
@@ -491,26 +487,34 @@ func (c *Client) GetServiceAccountsPaged(ctx context.Context, opts *GetServiceAc
 			Meta struct {
 				Pagination *client.Pagination `json:"pagination"`
 			} `json:"meta"`
-			Included []map[string]interface{} `json:"included"`
+			Included []json.RawMessage `json:"included"`
 		}
 		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 			return nil, nil, fmt.Errorf("failed to decode response: %w", err)
 		}
 
-		// Convert to slice of pointers and populate includes
+		// Convert to slice of pointers and resolve includes
+		included := client.NewIncluded(result.Included)
 		items := make([]*schemas.ServiceAccount, len(result.Data))
 		for i := range result.Data {
 			items[i] = &result.Data[i]
-			// Populate included resources into relationships
-			if len(result.Included) > 0 {
-				items[i].Relationships.PopulateIncludes(result.Included)
-			}
+			items[i].ResolveIncludes(included)
 		}
 
 		return items, result.Meta.Pagination, nil
 	}
 
-	return client.NewIterator[schemas.ServiceAccount](ctx, pageSize, fetchPage)
+	// Checkpoints only resume the iterators of the same listing
+	listOpts := GetServiceAccountsOptions{}
+	if opts != nil {
+		listOpts = *opts
+	}
+	listOpts.PageNumber, listOpts.PageSize = 0, 0
+	filters := client.HashOptions("GetServiceAccounts", listOpts)
+
+	return client.NewIterator[schemas.ServiceAccount](ctx, pageSize, fetchPage).
+		Prefetch(c.httpClient.Prefetch()).
+		FiltersHash(filters)
 }
 
 // GetServiceAccountsOptions holds optional parameters for GetServiceAccounts
@@ -528,6 +532,21 @@ type GetServiceAccountsOptions struct {
 	Filter map[string]string
 }
 
+// Relationship paths accepted by the Include option of GetServiceAccounts
+const (
+	GetServiceAccountsIncludeAccount                    = "account"
+	GetServiceAccountsIncludeAccountBillingPlan         = "account.billing-plan"
+	GetServiceAccountsIncludeAccountIdentityProvider    = "account.identity-provider"
+	GetServiceAccountsIncludeAccountOwner               = "account.owner"
+	GetServiceAccountsIncludeCreatedBy                  = "created-by"
+	GetServiceAccountsIncludeCreatedByIdentityProviders = "created-by.identity-providers"
+	GetServiceAccountsIncludeCreatedByTeams             = "created-by.teams"
+	GetServiceAccountsIncludeOwners                     = "owners"
+	GetServiceAccountsIncludeOwnersAccount              = "owners.account"
+	GetServiceAccountsIncludeOwnersIdentityProvider     = "owners.identity-provider"
+	GetServiceAccountsIncludeOwnersUsers                = "owners.users"
+)
+
 // List service account assume policies.
 func (c *Client) ListAssumeServiceAccountPoliciesRaw(ctx context.Context, opts *ListAssumeServiceAccountPoliciesOptions) (*client.Response, error) {
 	path := "/assume-service-account-policies"
@@ -579,19 +598,18 @@ func (c *Client) ListAssumeServiceAccountPolicies(ctx context.Context, opts *Lis
 		Meta struct {
 			Pagination *client.Pagination `json:"pagination"`
 		} `json:"meta"`
-		Included []map[string]interface{} `json:"included"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
+	// Index included resources once to resolve the relationships
+	included := client.NewIncluded(result.Included)
 	resources := make([]*schemas.AssumeServiceAccountPolicy, len(result.Data))
 	for i := range result.Data {
 		resources[i] = &result.Data[i]
-		// Populate included resources into relationships
-		if len(result.Included) > 0 {
-			resources[i].Relationships.PopulateIncludes(result.Included)
-		}
+		resources[i].ResolveIncludes(included)
 	}
 	return resources, nil
 }
@@ -600,6 +618,7 @@ func (c *Client) ListAssumeServiceAccountPolicies(ctx context.Context, opts *Lis
 // This is the recommended and simple way to iterate through all the results.
 //
 // The iterator automatically fetches pages as needed and handles errors inline.
+// Pages are fetched ahead in the background when the client is created with client.WithPrefetch.
 //
 // Example:
 //
@@ -611,73 +630,23 @@ func (c *Client) ListAssumeServiceAccountPolicies(ctx context.Context, opts *Lis
 //	}
 func (c *Client) ListAssumeServiceAccountPoliciesIter(ctx context.Context, opts *ListAssumeServiceAccountPoliciesOptions) iter.Seq2[schemas.AssumeServiceAccountPolicy, error] {
 	return func(yield func(schemas.AssumeServiceAccountPolicy, error) bool) {
-		// Determine page size from opts or use default
-		pageSize := 20
-		if opts != nil && opts.PageSize > 0 {
-			pageSize = opts.PageSize
-		}
-
-		pageNum := 1
-
-		for {
-			// Check context
-			if err := ctx.Err(); err != nil {
-				yield(schemas.AssumeServiceAccountPolicy{}, err)
-				return
-			}
+		it := c.ListAssumeServiceAccountPoliciesPaged(ctx, opts)
+		defer it.Close() // Stops the prefetching on early exit
 
-			// Copy options and set page number
-			pageOpts := &ListAssumeServiceAccountPoliciesOptions{}
-			if opts != nil {
-				*pageOpts = *opts
+		for it.Next() {
+			if !yield(*it.Value(), nil) {
+				return // Consumer requested early exit
 			}
-			pageOpts.PageNumber = pageNum
-			pageOpts.PageSize = pageSize
-
-			// Fetch page using Raw method to get pagination metadata
-			resp, err := c.ListAssumeServiceAccountPoliciesRaw(ctx, pageOpts)
-			if err != nil {
-				yield(schemas.AssumeServiceAccountPolicy{}, err)
-				return
-			}
-			defer resp.Body.Close()
-
-			// Decode response
-			var result struct {
-				Data []schemas.AssumeServiceAccountPolicy `json:"data"`
-				Meta struct {
-					Pagination *client.Pagination `json:"pagination"`
-				} `json:"meta"`
-				Included []map[string]interface{} `json:"included"`
-			}
-			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-				yield(schemas.AssumeServiceAccountPolicy{}, fmt.Errorf("failed to decode response: %w", err))
-				return
-			}
-
-			// Yield each item
-			for i := range result.Data {
-				// Populate included resources into relationships
-				if len(result.Included) > 0 {
-					result.Data[i].Relationships.PopulateIncludes(result.Included)
-				}
-				if !yield(result.Data[i], nil) {
-					return // Consumer requested early exit
-				}
-			}
-
-			// Check if there are more pages
-			if result.Meta.Pagination == nil || result.Meta.Pagination.NextPage == nil {
-				break
-			}
-
-			pageNum++
+		}
+		if err := it.Err(); err != nil {
+			yield(schemas.AssumeServiceAccountPolicy{}, err)
 		}
 	}
 }
 
 // ListAssumeServiceAccountPoliciesPaged returns a stateful iterator with access to pagination metadata.
-// Use this when you need PageInfo(), Remaining(), or Collect() methods.
+// Use this when you need PageInfo(), Remaining(), or Collect() methods, or to resume
+// an interrupted iteration from the iterator Checkpoint().
 //
 // Example:
 //
@@ -690,6 +659,10 @@ func (c *Client) ListAssumeServiceAccountPoliciesIter(ctx context.Context, opts
 //	if err := iter.Err(); err != nil {
 //	    // Handle error
 //	}
+//
+// Resuming from a checkpoint saved by an iterator with the same options:
+//
+//	iter := client.ServiceAccount.ListAssumeServiceAccountPoliciesPaged(ctx, opts).Resume(checkpoint)
 func (c *Client) ListAssumeServiceAccountPoliciesPaged(ctx context.Context, opts *ListAssumeServiceAccountPoliciesOptions) *client.Iterator[schemas.AssumeServiceAccountPolicy] {
 	// Determine page size from opts or use default
 	pageSize := 20
@@ -720,26 +693,34 @@ func (c *Client) ListAssumeServiceAccountPoliciesPaged(ctx context.Context, opts
 			Meta struct {
 				Pagination *client.Pagination `json:"pagination"`
 			} `json:"meta"`
-			Included []map[string]interface{} `json:"included"`
+			Included []json.RawMessage `json:"included"`
 		}
 		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 			return nil, nil, fmt.Errorf("failed to decode response: %w", err)
 		}
 
-		// Convert to slice of pointers and populate includes
+		// Convert to slice of pointers and resolve includes
+		included := client.NewIncluded(result.Included)
 		items := make([]*schemas.AssumeServiceAccountPolicy, len(result.Data))
 		for i := range result.Data {
 			items[i] = &result.Data[i]
-			// Populate included resources into relationships
-			if len(result.Included) > 0 {
-				items[i].Relationships.PopulateIncludes(result.Included)
-			}
+			items[i].ResolveIncludes(included)
 		}
 
 		return items, result.Meta.Pagination, nil
 	}
 
-	return client.NewIterator[schemas.AssumeServiceAccountPolicy](ctx, pageSize, fetchPage)
+	// Checkpoints only resume the iterators of the same listing
+	listOpts := ListAssumeServiceAccountPoliciesOptions{}
+	if opts != nil {
+		listOpts = *opts
+	}
+	listOpts.PageNumber, listOpts.PageSize = 0, 0
+	filters := client.HashOptions("ListAssumeServiceAccountPolicies", listOpts)
+
+	return client.NewIterator[schemas.AssumeServiceAccountPolicy](ctx, pageSize, fetchPage).
+		Prefetch(c.httpClient.Prefetch()).
+		FiltersHash(filters)
 }
 
 // ListAssumeServiceAccountPoliciesOptions holds optional parameters for ListAssumeServiceAccountPolicies
@@ -757,6 +738,16 @@ type ListAssumeServiceAccountPoliciesOptions struct {
 	Filter map[string]string
 }
 
+// Relationship paths accepted by the Include option of ListAssumeServiceAccountPolicies
+const (
+	ListAssumeServiceAccountPoliciesIncludeProvider                             = "provider"
+	ListAssumeServiceAccountPoliciesIncludeProviderAssumeServiceAccountPolicies = "provider.assume-service-account-policies"
+	ListAssumeServiceAccountPoliciesIncludeServiceAccount                       = "service-account"
+	ListAssumeServiceAccountPoliciesIncludeServiceAccountAccount                = "service-account.account"
+	ListAssumeServiceAccountPoliciesIncludeServiceAccountCreatedBy              = "service-account.created-by"
+	ListAssumeServiceAccountPoliciesIncludeServiceAccountOwners                 = "service-account.owners"
+)
+
 // Update an assume service account policy.
 func (c *Client) UpdateAssumeServiceAccountPolicyRaw(ctx context.Context, serviceAccount string, assumeServiceAccountPolicy string, req *schemas.AssumeServiceAccountPolicyRequest, opts *UpdateAssumeServiceAccountPolicyOptions) (*client.Response, error) {
 	path := "/service-accounts/{service_account}/assume-policies/{assume_service_account_policy}"
@@ -796,16 +787,14 @@ func (c *Client) UpdateAssumeServiceAccountPolicy(ctx context.Context, serviceAc
 
 	var result struct {
 		Data     schemas.AssumeServiceAccountPolicy `json:"data"`
-		Included []map[string]interface{}           `json:"included"`
+		Included []json.RawMessage                  `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -816,6 +805,16 @@ type UpdateAssumeServiceAccountPolicyOptions struct {
 	Filter  map[string]string
 }
 
+// Relationship paths accepted by the Include option of UpdateAssumeServiceAccountPolicy
+const (
+	UpdateAssumeServiceAccountPolicyIncludeProvider                             = "provider"
+	UpdateAssumeServiceAccountPolicyIncludeProviderAssumeServiceAccountPolicies = "provider.assume-service-account-policies"
+	UpdateAssumeServiceAccountPolicyIncludeServiceAccount                       = "service-account"
+	UpdateAssumeServiceAccountPolicyIncludeServiceAccountAccount                = "service-account.account"
+	UpdateAssumeServiceAccountPolicyIncludeServiceAccountCreatedBy              = "service-account.created-by"
+	UpdateAssumeServiceAccountPolicyIncludeServiceAccountOwners                 = "service-account.owners"
+)
+
 // This endpoint updates [IAM](https://docs.scalr.io/docs/identity-and-access-management) service account by ID.
 func (c *Client) UpdateServiceAccountRaw(ctx context.Context, serviceAccount string, req *schemas.ServiceAccountRequest, opts *UpdateServiceAccountOptions) (*client.Response, error) {
 	path := "/service-accounts/{service_account}"
@@ -853,17 +852,15 @@ func (c *Client) UpdateServiceAccount(ctx context.Context, serviceAccount string
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.ServiceAccount   `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.ServiceAccount `json:"data"`
+		Included []json.RawMessage      `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -873,3 +870,18 @@ type UpdateServiceAccountOptions struct {
 	Include []string
 	Filter  map[string]string
 }
+
+// Relationship paths accepted by the Include option of UpdateServiceAccount
+const (
+	UpdateServiceAccountIncludeAccount                    = "account"
+	UpdateServiceAccountIncludeAccountBillingPlan         = "account.billing-plan"
+	UpdateServiceAccountIncludeAccountIdentityProvider    = "account.identity-provider"
+	UpdateServiceAccountIncludeAccountOwner               = "account.owner"
+	UpdateServiceAccountIncludeCreatedBy                  = "created-by"
+	UpdateServiceAccountIncludeCreatedByIdentityProviders = "created-by.identity-providers"
+	UpdateServiceAccountIncludeCreatedByTeams             = "created-by.teams"
+	UpdateServiceAccountIncludeOwners                     = "owners"
+	UpdateServiceAccountIncludeOwnersAccount              = "owners.account"
+	UpdateServiceAccountIncludeOwnersIdentityProvider     = "owners.identity-provider"
+	UpdateServiceAccountIncludeOwnersUsers                = "owners.users"
+)
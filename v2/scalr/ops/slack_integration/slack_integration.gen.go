@@ -47,16 +47,14 @@ func (c *Client) CreateSlackIntegration(ctx context.Context, req *schemas.SlackI
 
 	var result struct {
 		Data     schemas.SlackIntegration `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Included []json.RawMessage        `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -119,16 +117,14 @@ func (c *Client) GetSlackIntegration(ctx context.Context, slackIntegration strin
 
 	var result struct {
 		Data     schemas.SlackIntegration `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Included []json.RawMessage        `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -139,6 +135,46 @@ type GetSlackIntegrationOptions struct {
 	Filter  map[string]string
 }
 
+// Relationship paths accepted by the Include option of GetSlackIntegration
+const (
+	GetSlackIntegrationIncludeAccount                                   = "account"
+	GetSlackIntegrationIncludeAccountBillingPlan                        = "account.billing-plan"
+	GetSlackIntegrationIncludeAccountIdentityProvider                   = "account.identity-provider"
+	GetSlackIntegrationIncludeAccountOwner                              = "account.owner"
+	GetSlackIntegrationIncludeConnection                                = "connection"
+	GetSlackIntegrationIncludeConnectionAccount                         = "connection.account"
+	GetSlackIntegrationIncludeEnvironments                              = "environments"
+	GetSlackIntegrationIncludeEnvironmentsAccount                       = "environments.account"
+	GetSlackIntegrationIncludeEnvironmentsCreatedBy                     = "environments.created-by"
+	GetSlackIntegrationIncludeEnvironmentsDefaultProviderConfigurations = "environments.default-provider-configurations"
+	GetSlackIntegrationIncludeEnvironmentsDefaultWorkspaceAgentPool     = "environments.default-workspace-agent-pool"
+	GetSlackIntegrationIncludeEnvironmentsDriftDetectionSchedules       = "environments.drift-detection-schedules"
+	GetSlackIntegrationIncludeEnvironmentsLockedBy                      = "environments.locked-by"
+	GetSlackIntegrationIncludeEnvironmentsPolicyGroups                  = "environments.policy-groups"
+	GetSlackIntegrationIncludeEnvironmentsProviderConfigurations        = "environments.provider-configurations"
+	GetSlackIntegrationIncludeEnvironmentsStorageProfile                = "environments.storage-profile"
+	GetSlackIntegrationIncludeEnvironmentsTags                          = "environments.tags"
+	GetSlackIntegrationIncludeEnvironmentsUpdatedBy                     = "environments.updated-by"
+	GetSlackIntegrationIncludeWorkspaces                                = "workspaces"
+	GetSlackIntegrationIncludeWorkspacesAgentPool                       = "workspaces.agent-pool"
+	GetSlackIntegrationIncludeWorkspacesConfigurationVersion            = "workspaces.configuration-version"
+	GetSlackIntegrationIncludeWorkspacesCreatedBy                       = "workspaces.created-by"
+	GetSlackIntegrationIncludeWorkspacesCurrentRun                      = "workspaces.current-run"
+	GetSlackIntegrationIncludeWorkspacesDriftReport                     = "workspaces.drift-report"
+	GetSlackIntegrationIncludeWorkspacesEnvironment                     = "workspaces.environment"
+	GetSlackIntegrationIncludeWorkspacesLatestConfigurationVersion      = "workspaces.latest-configuration-version"
+	GetSlackIntegrationIncludeWorkspacesLatestRun                       = "workspaces.latest-run"
+	GetSlackIntegrationIncludeWorkspacesLockedBy                        = "workspaces.locked-by"
+	GetSlackIntegrationIncludeWorkspacesLockedByRun                     = "workspaces.locked-by-run"
+	GetSlackIntegrationIncludeWorkspacesModule                          = "workspaces.module"
+	GetSlackIntegrationIncludeWorkspacesModuleVersion                   = "workspaces.module-version"
+	GetSlackIntegrationIncludeWorkspacesReadmeId                        = "workspaces.readme-id"
+	GetSlackIntegrationIncludeWorkspacesSshKey                          = "workspaces.ssh-key"
+	GetSlackIntegrationIncludeWorkspacesTags                            = "workspaces.tags"
+	GetSlackIntegrationIncludeWorkspacesUpdatedBy                       = "workspaces.updated-by"
+	GetSlackIntegrationIncludeWorkspacesVcsProvider                     = "workspaces.vcs-provider"
+)
+
 // This endpoint returns a list of Slack integrations.
 func (c *Client) ListSlackIntegrationsRaw(ctx context.Context, opts *ListSlackIntegrationsOptions) (*client.Response, error) {
 	path := "/integrations/slack"
@@ -186,19 +222,18 @@ func (c *Client) ListSlackIntegrations(ctx context.Context, opts *ListSlackInteg
 		Meta struct {
 			Pagination *client.Pagination `json:"pagination"`
 		} `json:"meta"`
-		Included []map[string]interface{} `json:"included"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
+	// Index included resources once to resolve the relationships
+	included := client.NewIncluded(result.Included)
 	resources := make([]*schemas.SlackIntegration, len(result.Data))
 	for i := range result.Data {
 		resources[i] = &result.Data[i]
-		// Populate included resources into relationships
-		if len(result.Included) > 0 {
-			resources[i].Relationships.PopulateIncludes(result.Included)
-		}
+		resources[i].ResolveIncludes(included)
 	}
 	return resources, nil
 }
@@ -207,6 +242,7 @@ func (c *Client) ListSlackIntegrations(ctx context.Context, opts *ListSlackInteg
 // This is the recommended and simple way to iterate through all the results.
 //
 // The iterator automatically fetches pages as needed and handles errors inline.
+// Pages are fetched ahead in the background when the client is created with client.WithPrefetch.
 //
 // Example:
 //
@@ -218,73 +254,23 @@ func (c *Client) ListSlackIntegrations(ctx context.Context, opts *ListSlackInteg
 //	}
 func (c *Client) ListSlackIntegrationsIter(ctx context.Context, opts *ListSlackIntegrationsOptions) iter.Seq2[schemas.SlackIntegration, error] {
 	return func(yield func(schemas.SlackIntegration, error) bool) {
-		// Determine page size from opts or use default
-		pageSize := 20
-		if opts != nil && opts.PageSize > 0 {
-			pageSize = opts.PageSize
-		}
-
-		pageNum := 1
-
-		for {
-			// Check context
-			if err := ctx.Err(); err != nil {
-				yield(schemas.SlackIntegration{}, err)
-				return
-			}
-
-			// Copy options and set page number
-			pageOpts := &ListSlackIntegrationsOptions{}
-			if opts != nil {
-				*pageOpts = *opts
-			}
-			pageOpts.PageNumber = pageNum
-			pageOpts.PageSize = pageSize
-
-			// Fetch page using Raw method to get pagination metadata
-			resp, err := c.ListSlackIntegrationsRaw(ctx, pageOpts)
-			if err != nil {
-				yield(schemas.SlackIntegration{}, err)
-				return
-			}
-			defer resp.Body.Close()
-
-			// Decode response
-			var result struct {
-				Data []schemas.SlackIntegration `json:"data"`
-				Meta struct {
-					Pagination *client.Pagination `json:"pagination"`
-				} `json:"meta"`
-				Included []map[string]interface{} `json:"included"`
-			}
-			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-				yield(schemas.SlackIntegration{}, fmt.Errorf("failed to decode response: %w", err))
-				return
-			}
+		it := c.ListSlackIntegrationsPaged(ctx, opts)
+		defer it.Close() // Stops the prefetching on early exit
 
-			// Yield each item
-			for i := range result.Data {
-				// Populate included resources into relationships
-				if len(result.Included) > 0 {
-					result.Data[i].Relationships.PopulateIncludes(result.Included)
-				}
-				if !yield(result.Data[i], nil) {
-					return // Consumer requested early exit
-				}
+		for it.Next() {
+			if !yield(*it.Value(), nil) {
+				return // Consumer requested early exit
 			}
-
-			// Check if there are more pages
-			if result.Meta.Pagination == nil || result.Meta.Pagination.NextPage == nil {
-				break
-			}
-
-			pageNum++
+		}
+		if err := it.Err(); err != nil {
+			yield(schemas.SlackIntegration{}, err)
 		}
 	}
 }
 
 // ListSlackIntegrationsPaged returns a stateful iterator with access to pagination metadata.
-// Use this when you need PageInfo(), Remaining(), or Collect() methods.
+// Use this when you need PageInfo(), Remaining(), or Collect() methods, or to resume
+// an interrupted iteration from the iterator Checkpoint().
 //
 // Example:
 //
@@ -297,6 +283,10 @@ func (c *Client) ListSlackIntegrationsIter(ctx context.Context, opts *ListSlackI
 //	if err := iter.Err(); err != nil {
 //	    // Handle error
 //	}
+//
+// Resuming from a checkpoint saved by an iterator with the same options:
+//
+//	iter := client.SlackIntegration.ListSlackIntegrationsPaged(ctx, opts).Resume(checkpoint)
 func (c *Client) ListSlackIntegrationsPaged(ctx context.Context, opts *ListSlackIntegrationsOptions) *client.Iterator[schemas.SlackIntegration] {
 	// Determine page size from opts or use default
 	pageSize := 20
@@ -327,26 +317,34 @@ func (c *Client) ListSlackIntegrationsPaged(ctx context.Context, opts *ListSlack
 			Meta struct {
 				Pagination *client.Pagination `json:"pagination"`
 			} `json:"meta"`
-			Included []map[string]interface{} `json:"included"`
+			Included []json.RawMessage `json:"included"`
 		}
 		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 			return nil, nil, fmt.Errorf("failed to decode response: %w", err)
 		}
 
-		// Convert to slice of pointers and populate includes
+		// Convert to slice of pointers and resolve includes
+		included := client.NewIncluded(result.Included)
 		items := make([]*schemas.SlackIntegration, len(result.Data))
 		for i := range result.Data {
 			items[i] = &result.Data[i]
-			// Populate included resources into relationships
-			if len(result.Included) > 0 {
-				items[i].Relationships.PopulateIncludes(result.Included)
-			}
+			items[i].ResolveIncludes(included)
 		}
 
 		return items, result.Meta.Pagination, nil
 	}
 
-	return client.NewIterator[schemas.SlackIntegration](ctx, pageSize, fetchPage)
+	// Checkpoints only resume the iterators of the same listing
+	listOpts := ListSlackIntegrationsOptions{}
+	if opts != nil {
+		listOpts = *opts
+	}
+	listOpts.PageNumber, listOpts.PageSize = 0, 0
+	filters := client.HashOptions("ListSlackIntegrations", listOpts)
+
+	return client.NewIterator[schemas.SlackIntegration](ctx, pageSize, fetchPage).
+		Prefetch(c.httpClient.Prefetch()).
+		FiltersHash(filters)
 }
 
 // ListSlackIntegrationsOptions holds optional parameters for ListSlackIntegrations
@@ -362,6 +360,46 @@ type ListSlackIntegrationsOptions struct {
 	Filter map[string]string
 }
 
+// Relationship paths accepted by the Include option of ListSlackIntegrations
+const (
+	ListSlackIntegrationsIncludeAccount                                   = "account"
+	ListSlackIntegrationsIncludeAccountBillingPlan                        = "account.billing-plan"
+	ListSlackIntegrationsIncludeAccountIdentityProvider                   = "account.identity-provider"
+	ListSlackIntegrationsIncludeAccountOwner                              = "account.owner"
+	ListSlackIntegrationsIncludeConnection                                = "connection"
+	ListSlackIntegrationsIncludeConnectionAccount                         = "connection.account"
+	ListSlackIntegrationsIncludeEnvironments                              = "environments"
+	ListSlackIntegrationsIncludeEnvironmentsAccount                       = "environments.account"
+	ListSlackIntegrationsIncludeEnvironmentsCreatedBy                     = "environments.created-by"
+	ListSlackIntegrationsIncludeEnvironmentsDefaultProviderConfigurations = "environments.default-provider-configurations"
+	ListSlackIntegrationsIncludeEnvironmentsDefaultWorkspaceAgentPool     = "environments.default-workspace-agent-pool"
+	ListSlackIntegrationsIncludeEnvironmentsDriftDetectionSchedules       = "environments.drift-detection-schedules"
+	ListSlackIntegrationsIncludeEnvironmentsLockedBy                      = "environments.locked-by"
+	ListSlackIntegrationsIncludeEnvironmentsPolicyGroups                  = "environments.policy-groups"
+	ListSlackIntegrationsIncludeEnvironmentsProviderConfigurations        = "environments.provider-configurations"
+	ListSlackIntegrationsIncludeEnvironmentsStorageProfile                = "environments.storage-profile"
+	ListSlackIntegrationsIncludeEnvironmentsTags                          = "environments.tags"
+	ListSlackIntegrationsIncludeEnvironmentsUpdatedBy                     = "environments.updated-by"
+	ListSlackIntegrationsIncludeWorkspaces                                = "workspaces"
+	ListSlackIntegrationsIncludeWorkspacesAgentPool                       = "workspaces.agent-pool"
+	ListSlackIntegrationsIncludeWorkspacesConfigurationVersion            = "workspaces.configuration-version"
+	ListSlackIntegrationsIncludeWorkspacesCreatedBy                       = "workspaces.created-by"
+	ListSlackIntegrationsIncludeWorkspacesCurrentRun                      = "workspaces.current-run"
+	ListSlackIntegrationsIncludeWorkspacesDriftReport                     = "workspaces.drift-report"
+	ListSlackIntegrationsIncludeWorkspacesEnvironment                     = "workspaces.environment"
+	ListSlackIntegrationsIncludeWorkspacesLatestConfigurationVersion      = "workspaces.latest-configuration-version"
+	ListSlackIntegrationsIncludeWorkspacesLatestRun                       = "workspaces.latest-run"
+	ListSlackIntegrationsIncludeWorkspacesLockedBy                        = "workspaces.locked-by"
+	ListSlackIntegrationsIncludeWorkspacesLockedByRun                     = "workspaces.locked-by-run"
+	ListSlackIntegrationsIncludeWorkspacesModule                          = "workspaces.module"
+	ListSlackIntegrationsIncludeWorkspacesModuleVersion                   = "workspaces.module-version"
+	ListSlackIntegrationsIncludeWorkspacesReadmeId                        = "workspaces.readme-id"
+	ListSlackIntegrationsIncludeWorkspacesSshKey                          = "workspaces.ssh-key"
+	ListSlackIntegrationsIncludeWorkspacesTags                            = "workspaces.tags"
+	ListSlackIntegrationsIncludeWorkspacesUpdatedBy                       = "workspaces.updated-by"
+	ListSlackIntegrationsIncludeWorkspacesVcsProvider                     = "workspaces.vcs-provider"
+)
+
 // This endpoint updates Slack integration.
 func (c *Client) UpdateSlackIntegrationRaw(ctx context.Context, slackIntegration string, req *schemas.SlackIntegrationRequest) (*client.Response, error) {
 	path := "/integrations/slack/{slack_integration}"
@@ -386,15 +424,13 @@ func (c *Client) UpdateSlackIntegration(ctx context.Context, slackIntegration st
 
 	var result struct {
 		Data     schemas.SlackIntegration `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Included []json.RawMessage        `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
@@ -45,8 +45,8 @@ func (c *Client) GetSoftwareVersion(ctx context.Context, softwareVersion string)
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.SoftwareVersion  `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.SoftwareVersion `json:"data"`
+		Included []json.RawMessage       `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
@@ -74,8 +74,7 @@ func (c *Client) ListSoftwareVersionsRaw(ctx context.Context, opts *ListSoftware
 		if len(opts.Sort) > 0 {
 			params.Set("sort", strings.Join(opts.Sort, ","))
 		}
-		// Handle parameter: Fields (map[string]interface{})
-		// Complex type map[string]interface{} - skip for now
+		opts.Fields.Encode(params)
 		// Add filters
 		for k, v := range opts.Filter {
 			params.Set("filter["+k+"]", v)
@@ -105,7 +104,7 @@ func (c *Client) ListSoftwareVersions(ctx context.Context, opts *ListSoftwareVer
 		Meta struct {
 			Pagination *client.Pagination `json:"pagination"`
 		} `json:"meta"`
-		Included []map[string]interface{} `json:"included"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
@@ -122,6 +121,7 @@ func (c *Client) ListSoftwareVersions(ctx context.Context, opts *ListSoftwareVer
 // This is the recommended and simple way to iterate through all the results.
 //
 // The iterator automatically fetches pages as needed and handles errors inline.
+// Pages are fetched ahead in the background when the client is created with client.WithPrefetch.
 //
 // Example:
 //
@@ -133,69 +133,23 @@ func (c *Client) ListSoftwareVersions(ctx context.Context, opts *ListSoftwareVer
 //	}
 func (c *Client) ListSoftwareVersionsIter(ctx context.Context, opts *ListSoftwareVersionsOptions) iter.Seq2[schemas.SoftwareVersion, error] {
 	return func(yield func(schemas.SoftwareVersion, error) bool) {
-		// Determine page size from opts or use default
-		pageSize := 20
-		if opts != nil && opts.PageSize > 0 {
-			pageSize = opts.PageSize
-		}
-
-		pageNum := 1
-
-		for {
-			// Check context
-			if err := ctx.Err(); err != nil {
-				yield(schemas.SoftwareVersion{}, err)
-				return
-			}
-
-			// Copy options and set page number
-			pageOpts := &ListSoftwareVersionsOptions{}
-			if opts != nil {
-				*pageOpts = *opts
-			}
-			pageOpts.PageNumber = pageNum
-			pageOpts.PageSize = pageSize
-
-			// Fetch page using Raw method to get pagination metadata
-			resp, err := c.ListSoftwareVersionsRaw(ctx, pageOpts)
-			if err != nil {
-				yield(schemas.SoftwareVersion{}, err)
-				return
-			}
-			defer resp.Body.Close()
-
-			// Decode response
-			var result struct {
-				Data []schemas.SoftwareVersion `json:"data"`
-				Meta struct {
-					Pagination *client.Pagination `json:"pagination"`
-				} `json:"meta"`
-				Included []map[string]interface{} `json:"included"`
-			}
-			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-				yield(schemas.SoftwareVersion{}, fmt.Errorf("failed to decode response: %w", err))
-				return
-			}
-
-			// Yield each item
-			for i := range result.Data {
-				if !yield(result.Data[i], nil) {
-					return // Consumer requested early exit
-				}
-			}
+		it := c.ListSoftwareVersionsPaged(ctx, opts)
+		defer it.Close() // Stops the prefetching on early exit
 
-			// Check if there are more pages
-			if result.Meta.Pagination == nil || result.Meta.Pagination.NextPage == nil {
-				break
+		for it.Next() {
+			if !yield(*it.Value(), nil) {
+				return // Consumer requested early exit
 			}
-
-			pageNum++
+		}
+		if err := it.Err(); err != nil {
+			yield(schemas.SoftwareVersion{}, err)
 		}
 	}
 }
 
 // ListSoftwareVersionsPaged returns a stateful iterator with access to pagination metadata.
-// Use this when you need PageInfo(), Remaining(), or Collect() methods.
+// Use this when you need PageInfo(), Remaining(), or Collect() methods, or to resume
+// an interrupted iteration from the iterator Checkpoint().
 //
 // Example:
 //
@@ -208,6 +162,10 @@ func (c *Client) ListSoftwareVersionsIter(ctx context.Context, opts *ListSoftwar
 //	if err := iter.Err(); err != nil {
 //	    // Handle error
 //	}
+//
+// Resuming from a checkpoint saved by an iterator with the same options:
+//
+//	iter := client.SoftwareVersion.ListSoftwareVersionsPaged(ctx, opts).Resume(checkpoint)
 func (c *Client) ListSoftwareVersionsPaged(ctx context.Context, opts *ListSoftwareVersionsOptions) *client.Iterator[schemas.SoftwareVersion] {
 	// Determine page size from opts or use default
 	pageSize := 20
@@ -238,13 +196,13 @@ func (c *Client) ListSoftwareVersionsPaged(ctx context.Context, opts *ListSoftwa
 			Meta struct {
 				Pagination *client.Pagination `json:"pagination"`
 			} `json:"meta"`
-			Included []map[string]interface{} `json:"included"`
+			Included []json.RawMessage `json:"included"`
 		}
 		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 			return nil, nil, fmt.Errorf("failed to decode response: %w", err)
 		}
 
-		// Convert to slice of pointers and populate includes
+		// Convert to slice of pointers and resolve includes
 		items := make([]*schemas.SoftwareVersion, len(result.Data))
 		for i := range result.Data {
 			items[i] = &result.Data[i]
@@ -253,7 +211,17 @@ func (c *Client) ListSoftwareVersionsPaged(ctx context.Context, opts *ListSoftwa
 		return items, result.Meta.Pagination, nil
 	}
 
-	return client.NewIterator[schemas.SoftwareVersion](ctx, pageSize, fetchPage)
+	// Checkpoints only resume the iterators of the same listing
+	listOpts := ListSoftwareVersionsOptions{}
+	if opts != nil {
+		listOpts = *opts
+	}
+	listOpts.PageNumber, listOpts.PageSize = 0, 0
+	filters := client.HashOptions("ListSoftwareVersions", listOpts)
+
+	return client.NewIterator[schemas.SoftwareVersion](ctx, pageSize, fetchPage).
+		Prefetch(c.httpClient.Prefetch()).
+		FiltersHash(filters)
 }
 
 // ListSoftwareVersionsOptions holds optional parameters for ListSoftwareVersions
@@ -267,6 +235,6 @@ type ListSoftwareVersionsOptions struct {
 	// The comma-separated list of attributes.
 	Sort []string
 	// The value of the fields[resource-type] parameter is a comma-separated list that refers to the name of the fields to be returned for the resource. An empty value indicates that no fields should be returned.
-	Fields map[string]interface{}
+	Fields client.Fields
 	Filter map[string]string
 }
@@ -47,17 +47,15 @@ func (c *Client) CreateStateVersion(ctx context.Context, req *schemas.StateVersi
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.StateVersion     `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.StateVersion `json:"data"`
+		Included []json.RawMessage    `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -82,17 +80,15 @@ func (c *Client) GetCurrentStateVersion(ctx context.Context, workspace string) (
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.StateVersion     `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.StateVersion `json:"data"`
+		Included []json.RawMessage    `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -117,17 +113,15 @@ func (c *Client) GetStateVersion(ctx context.Context, stateVersion string) (*sch
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.StateVersion     `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.StateVersion `json:"data"`
+		Included []json.RawMessage    `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -158,6 +152,27 @@ func (c *Client) GetStateVersionDownload(ctx context.Context, stateVersion strin
 	return string(bodyBytes), nil
 }
 
+// GetStateVersionDownloadStream streams the response of GetStateVersionDownload instead of buffering it in memory.
+// The caller must close the returned body.
+func (c *Client) GetStateVersionDownloadStream(ctx context.Context, stateVersion string) (io.ReadCloser, error) {
+	resp, err := c.GetStateVersionDownloadRaw(ctx, stateVersion)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+// GetStateVersionDownloadTo copies the response of GetStateVersionDownload to w and returns the number of bytes written.
+func (c *Client) GetStateVersionDownloadTo(ctx context.Context, w io.Writer, stateVersion string) (int64, error) {
+	body, err := c.GetStateVersionDownloadStream(ctx, stateVersion)
+	if err != nil {
+		return 0, err
+	}
+	defer body.Close()
+
+	return io.Copy(w, body)
+}
+
 func (c *Client) ListStateVersionsRaw(ctx context.Context, opts *ListStateVersionsOptions) (*client.Response, error) {
 	path := "/state-versions"
 
@@ -204,19 +219,18 @@ func (c *Client) ListStateVersions(ctx context.Context, opts *ListStateVersionsO
 		Meta struct {
 			Pagination *client.Pagination `json:"pagination"`
 		} `json:"meta"`
-		Included []map[string]interface{} `json:"included"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
+	// Index included resources once to resolve the relationships
+	included := client.NewIncluded(result.Included)
 	resources := make([]*schemas.StateVersion, len(result.Data))
 	for i := range result.Data {
 		resources[i] = &result.Data[i]
-		// Populate included resources into relationships
-		if len(result.Included) > 0 {
-			resources[i].Relationships.PopulateIncludes(result.Included)
-		}
+		resources[i].ResolveIncludes(included)
 	}
 	return resources, nil
 }
@@ -225,6 +239,7 @@ func (c *Client) ListStateVersions(ctx context.Context, opts *ListStateVersionsO
 // This is the recommended and simple way to iterate through all the results.
 //
 // The iterator automatically fetches pages as needed and handles errors inline.
+// Pages are fetched ahead in the background when the client is created with client.WithPrefetch.
 //
 // Example:
 //
@@ -236,73 +251,23 @@ func (c *Client) ListStateVersions(ctx context.Context, opts *ListStateVersionsO
 //	}
 func (c *Client) ListStateVersionsIter(ctx context.Context, opts *ListStateVersionsOptions) iter.Seq2[schemas.StateVersion, error] {
 	return func(yield func(schemas.StateVersion, error) bool) {
-		// Determine page size from opts or use default
-		pageSize := 20
-		if opts != nil && opts.PageSize > 0 {
-			pageSize = opts.PageSize
-		}
-
-		pageNum := 1
+		it := c.ListStateVersionsPaged(ctx, opts)
+		defer it.Close() // Stops the prefetching on early exit
 
-		for {
-			// Check context
-			if err := ctx.Err(); err != nil {
-				yield(schemas.StateVersion{}, err)
-				return
+		for it.Next() {
+			if !yield(*it.Value(), nil) {
+				return // Consumer requested early exit
 			}
-
-			// Copy options and set page number
-			pageOpts := &ListStateVersionsOptions{}
-			if opts != nil {
-				*pageOpts = *opts
-			}
-			pageOpts.PageNumber = pageNum
-			pageOpts.PageSize = pageSize
-
-			// Fetch page using Raw method to get pagination metadata
-			resp, err := c.ListStateVersionsRaw(ctx, pageOpts)
-			if err != nil {
-				yield(schemas.StateVersion{}, err)
-				return
-			}
-			defer resp.Body.Close()
-
-			// Decode response
-			var result struct {
-				Data []schemas.StateVersion `json:"data"`
-				Meta struct {
-					Pagination *client.Pagination `json:"pagination"`
-				} `json:"meta"`
-				Included []map[string]interface{} `json:"included"`
-			}
-			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-				yield(schemas.StateVersion{}, fmt.Errorf("failed to decode response: %w", err))
-				return
-			}
-
-			// Yield each item
-			for i := range result.Data {
-				// Populate included resources into relationships
-				if len(result.Included) > 0 {
-					result.Data[i].Relationships.PopulateIncludes(result.Included)
-				}
-				if !yield(result.Data[i], nil) {
-					return // Consumer requested early exit
-				}
-			}
-
-			// Check if there are more pages
-			if result.Meta.Pagination == nil || result.Meta.Pagination.NextPage == nil {
-				break
-			}
-
-			pageNum++
+		}
+		if err := it.Err(); err != nil {
+			yield(schemas.StateVersion{}, err)
 		}
 	}
 }
 
 // ListStateVersionsPaged returns a stateful iterator with access to pagination metadata.
-// Use this when you need PageInfo(), Remaining(), or Collect() methods.
+// Use this when you need PageInfo(), Remaining(), or Collect() methods, or to resume
+// an interrupted iteration from the iterator Checkpoint().
 //
 // Example:
 //
@@ -315,6 +280,10 @@ func (c *Client) ListStateVersionsIter(ctx context.Context, opts *ListStateVersi
 //	if err := iter.Err(); err != nil {
 //	    // Handle error
 //	}
+//
+// Resuming from a checkpoint saved by an iterator with the same options:
+//
+//	iter := client.StateVersion.ListStateVersionsPaged(ctx, opts).Resume(checkpoint)
 func (c *Client) ListStateVersionsPaged(ctx context.Context, opts *ListStateVersionsOptions) *client.Iterator[schemas.StateVersion] {
 	// Determine page size from opts or use default
 	pageSize := 20
@@ -345,26 +314,34 @@ func (c *Client) ListStateVersionsPaged(ctx context.Context, opts *ListStateVers
 			Meta struct {
 				Pagination *client.Pagination `json:"pagination"`
 			} `json:"meta"`
-			Included []map[string]interface{} `json:"included"`
+			Included []json.RawMessage `json:"included"`
 		}
 		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 			return nil, nil, fmt.Errorf("failed to decode response: %w", err)
 		}
 
-		// Convert to slice of pointers and populate includes
+		// Convert to slice of pointers and resolve includes
+		included := client.NewIncluded(result.Included)
 		items := make([]*schemas.StateVersion, len(result.Data))
 		for i := range result.Data {
 			items[i] = &result.Data[i]
-			// Populate included resources into relationships
-			if len(result.Included) > 0 {
-				items[i].Relationships.PopulateIncludes(result.Included)
-			}
+			items[i].ResolveIncludes(included)
 		}
 
 		return items, result.Meta.Pagination, nil
 	}
 
-	return client.NewIterator[schemas.StateVersion](ctx, pageSize, fetchPage)
+	// Checkpoints only resume the iterators of the same listing
+	listOpts := ListStateVersionsOptions{}
+	if opts != nil {
+		listOpts = *opts
+	}
+	listOpts.PageNumber, listOpts.PageSize = 0, 0
+	filters := client.HashOptions("ListStateVersions", listOpts)
+
+	return client.NewIterator[schemas.StateVersion](ctx, pageSize, fetchPage).
+		Prefetch(c.httpClient.Prefetch()).
+		FiltersHash(filters)
 }
 
 // ListStateVersionsOptions holds optional parameters for ListStateVersions
@@ -46,8 +46,8 @@ func (c *Client) CreateStorageProfile(ctx context.Context, req *schemas.StorageP
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.StorageProfile   `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.StorageProfile `json:"data"`
+		Included []json.RawMessage      `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
@@ -100,8 +100,8 @@ func (c *Client) GetStorageProfile(ctx context.Context, storageProfile string) (
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.StorageProfile   `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.StorageProfile `json:"data"`
+		Included []json.RawMessage      `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
@@ -158,7 +158,7 @@ func (c *Client) ListStorageProfiles(ctx context.Context, opts *ListStorageProfi
 		Meta struct {
 			Pagination *client.Pagination `json:"pagination"`
 		} `json:"meta"`
-		Included []map[string]interface{} `json:"included"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
@@ -175,6 +175,7 @@ func (c *Client) ListStorageProfiles(ctx context.Context, opts *ListStorageProfi
 // This is the recommended and simple way to iterate through all the results.
 //
 // The iterator automatically fetches pages as needed and handles errors inline.
+// Pages are fetched ahead in the background when the client is created with client.WithPrefetch.
 //
 // Example:
 //
@@ -186,69 +187,23 @@ func (c *Client) ListStorageProfiles(ctx context.Context, opts *ListStorageProfi
 //	}
 func (c *Client) ListStorageProfilesIter(ctx context.Context, opts *ListStorageProfilesOptions) iter.Seq2[schemas.StorageProfile, error] {
 	return func(yield func(schemas.StorageProfile, error) bool) {
-		// Determine page size from opts or use default
-		pageSize := 20
-		if opts != nil && opts.PageSize > 0 {
-			pageSize = opts.PageSize
-		}
-
-		pageNum := 1
-
-		for {
-			// Check context
-			if err := ctx.Err(); err != nil {
-				yield(schemas.StorageProfile{}, err)
-				return
-			}
-
-			// Copy options and set page number
-			pageOpts := &ListStorageProfilesOptions{}
-			if opts != nil {
-				*pageOpts = *opts
-			}
-			pageOpts.PageNumber = pageNum
-			pageOpts.PageSize = pageSize
-
-			// Fetch page using Raw method to get pagination metadata
-			resp, err := c.ListStorageProfilesRaw(ctx, pageOpts)
-			if err != nil {
-				yield(schemas.StorageProfile{}, err)
-				return
-			}
-			defer resp.Body.Close()
-
-			// Decode response
-			var result struct {
-				Data []schemas.StorageProfile `json:"data"`
-				Meta struct {
-					Pagination *client.Pagination `json:"pagination"`
-				} `json:"meta"`
-				Included []map[string]interface{} `json:"included"`
-			}
-			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-				yield(schemas.StorageProfile{}, fmt.Errorf("failed to decode response: %w", err))
-				return
-			}
+		it := c.ListStorageProfilesPaged(ctx, opts)
+		defer it.Close() // Stops the prefetching on early exit
 
-			// Yield each item
-			for i := range result.Data {
-				if !yield(result.Data[i], nil) {
-					return // Consumer requested early exit
-				}
+		for it.Next() {
+			if !yield(*it.Value(), nil) {
+				return // Consumer requested early exit
 			}
-
-			// Check if there are more pages
-			if result.Meta.Pagination == nil || result.Meta.Pagination.NextPage == nil {
-				break
-			}
-
-			pageNum++
+		}
+		if err := it.Err(); err != nil {
+			yield(schemas.StorageProfile{}, err)
 		}
 	}
 }
 
 // ListStorageProfilesPaged returns a stateful iterator with access to pagination metadata.
-// Use this when you need PageInfo(), Remaining(), or Collect() methods.
+// Use this when you need PageInfo(), Remaining(), or Collect() methods, or to resume
+// an interrupted iteration from the iterator Checkpoint().
 //
 // Example:
 //
@@ -261,6 +216,10 @@ func (c *Client) ListStorageProfilesIter(ctx context.Context, opts *ListStorageP
 //	if err := iter.Err(); err != nil {
 //	    // Handle error
 //	}
+//
+// Resuming from a checkpoint saved by an iterator with the same options:
+//
+//	iter := client.StorageProfile.ListStorageProfilesPaged(ctx, opts).Resume(checkpoint)
 func (c *Client) ListStorageProfilesPaged(ctx context.Context, opts *ListStorageProfilesOptions) *client.Iterator[schemas.StorageProfile] {
 	// Determine page size from opts or use default
 	pageSize := 20
@@ -291,13 +250,13 @@ func (c *Client) ListStorageProfilesPaged(ctx context.Context, opts *ListStorage
 			Meta struct {
 				Pagination *client.Pagination `json:"pagination"`
 			} `json:"meta"`
-			Included []map[string]interface{} `json:"included"`
+			Included []json.RawMessage `json:"included"`
 		}
 		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 			return nil, nil, fmt.Errorf("failed to decode response: %w", err)
 		}
 
-		// Convert to slice of pointers and populate includes
+		// Convert to slice of pointers and resolve includes
 		items := make([]*schemas.StorageProfile, len(result.Data))
 		for i := range result.Data {
 			items[i] = &result.Data[i]
@@ -306,7 +265,17 @@ func (c *Client) ListStorageProfilesPaged(ctx context.Context, opts *ListStorage
 		return items, result.Meta.Pagination, nil
 	}
 
-	return client.NewIterator[schemas.StorageProfile](ctx, pageSize, fetchPage)
+	// Checkpoints only resume the iterators of the same listing
+	listOpts := ListStorageProfilesOptions{}
+	if opts != nil {
+		listOpts = *opts
+	}
+	listOpts.PageNumber, listOpts.PageSize = 0, 0
+	filters := client.HashOptions("ListStorageProfiles", listOpts)
+
+	return client.NewIterator[schemas.StorageProfile](ctx, pageSize, fetchPage).
+		Prefetch(c.httpClient.Prefetch()).
+		FiltersHash(filters)
 }
 
 // ListStorageProfilesOptions holds optional parameters for ListStorageProfiles
@@ -345,8 +314,8 @@ func (c *Client) UpdateStorageProfile(ctx context.Context, storageProfile string
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.StorageProfile   `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.StorageProfile `json:"data"`
+		Included []json.RawMessage      `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
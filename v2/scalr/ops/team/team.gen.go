@@ -60,17 +60,15 @@ func (c *Client) CreateTeam(ctx context.Context, req *schemas.TeamRequest, opts
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.Team             `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.Team      `json:"data"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -81,6 +79,19 @@ type CreateTeamOptions struct {
 	Filter  map[string]string
 }
 
+// Relationship paths accepted by the Include option of CreateTeam
+const (
+	CreateTeamIncludeAccount                 = "account"
+	CreateTeamIncludeAccountBillingPlan      = "account.billing-plan"
+	CreateTeamIncludeAccountIdentityProvider = "account.identity-provider"
+	CreateTeamIncludeAccountOwner            = "account.owner"
+	CreateTeamIncludeIdentityProvider        = "identity-provider"
+	CreateTeamIncludeIdentityProviderAccount = "identity-provider.account"
+	CreateTeamIncludeUsers                   = "users"
+	CreateTeamIncludeUsersIdentityProviders  = "users.identity-providers"
+	CreateTeamIncludeUsersTeams              = "users.teams"
+)
+
 // The endpoint deletes [IAM](https://docs.scalr.io/docs/identity-and-access-management) team by ID.
 func (c *Client) DeleteTeamRaw(ctx context.Context, team string) (*client.Response, error) {
 	path := "/teams/{team}"
@@ -139,17 +150,15 @@ func (c *Client) GetTeam(ctx context.Context, team string, opts *GetTeamOptions)
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.Team             `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.Team      `json:"data"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -160,6 +169,19 @@ type GetTeamOptions struct {
 	Filter  map[string]string
 }
 
+// Relationship paths accepted by the Include option of GetTeam
+const (
+	GetTeamIncludeAccount                 = "account"
+	GetTeamIncludeAccountBillingPlan      = "account.billing-plan"
+	GetTeamIncludeAccountIdentityProvider = "account.identity-provider"
+	GetTeamIncludeAccountOwner            = "account.owner"
+	GetTeamIncludeIdentityProvider        = "identity-provider"
+	GetTeamIncludeIdentityProviderAccount = "identity-provider.account"
+	GetTeamIncludeUsers                   = "users"
+	GetTeamIncludeUsersIdentityProviders  = "users.identity-providers"
+	GetTeamIncludeUsersTeams              = "users.teams"
+)
+
 // The endpoint returns a list of [IAM](https://docs.scalr.io/docs/identity-and-access-management) teams. The endpoint supports filtering by team name (`filter[name]`), IdP (`filter[identity-provider]`) and team ID (`filter[team]=in:team-123,team-331`).
 func (c *Client) GetTeamsRaw(ctx context.Context, opts *GetTeamsOptions) (*client.Response, error) {
 	path := "/teams"
@@ -211,19 +233,18 @@ func (c *Client) GetTeams(ctx context.Context, opts *GetTeamsOptions) ([]*schema
 		Meta struct {
 			Pagination *client.Pagination `json:"pagination"`
 		} `json:"meta"`
-		Included []map[string]interface{} `json:"included"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
+	// Index included resources once to resolve the relationships
+	included := client.NewIncluded(result.Included)
 	resources := make([]*schemas.Team, len(result.Data))
 	for i := range result.Data {
 		resources[i] = &result.Data[i]
-		// Populate included resources into relationships
-		if len(result.Included) > 0 {
-			resources[i].Relationships.PopulateIncludes(result.Included)
-		}
+		resources[i].ResolveIncludes(included)
 	}
 	return resources, nil
 }
@@ -232,6 +253,7 @@ func (c *Client) GetTeams(ctx context.Context, opts *GetTeamsOptions) ([]*schema
 // This is the recommended and simple way to iterate through all the results.
 //
 // The iterator automatically fetches pages as needed and handles errors inline.
+// Pages are fetched ahead in the background when the client is created with client.WithPrefetch.
 //
 // Example:
 //
@@ -243,73 +265,23 @@ func (c *Client) GetTeams(ctx context.Context, opts *GetTeamsOptions) ([]*schema
 //	}
 func (c *Client) GetTeamsIter(ctx context.Context, opts *GetTeamsOptions) iter.Seq2[schemas.Team, error] {
 	return func(yield func(schemas.Team, error) bool) {
-		// Determine page size from opts or use default
-		pageSize := 20
-		if opts != nil && opts.PageSize > 0 {
-			pageSize = opts.PageSize
-		}
-
-		pageNum := 1
-
-		for {
-			// Check context
-			if err := ctx.Err(); err != nil {
-				yield(schemas.Team{}, err)
-				return
-			}
-
-			// Copy options and set page number
-			pageOpts := &GetTeamsOptions{}
-			if opts != nil {
-				*pageOpts = *opts
-			}
-			pageOpts.PageNumber = pageNum
-			pageOpts.PageSize = pageSize
-
-			// Fetch page using Raw method to get pagination metadata
-			resp, err := c.GetTeamsRaw(ctx, pageOpts)
-			if err != nil {
-				yield(schemas.Team{}, err)
-				return
-			}
-			defer resp.Body.Close()
-
-			// Decode response
-			var result struct {
-				Data []schemas.Team `json:"data"`
-				Meta struct {
-					Pagination *client.Pagination `json:"pagination"`
-				} `json:"meta"`
-				Included []map[string]interface{} `json:"included"`
-			}
-			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-				yield(schemas.Team{}, fmt.Errorf("failed to decode response: %w", err))
-				return
-			}
+		it := c.GetTeamsPaged(ctx, opts)
+		defer it.Close() // Stops the prefetching on early exit
 
-			// Yield each item
-			for i := range result.Data {
-				// Populate included resources into relationships
-				if len(result.Included) > 0 {
-					result.Data[i].Relationships.PopulateIncludes(result.Included)
-				}
-				if !yield(result.Data[i], nil) {
-					return // Consumer requested early exit
-				}
+		for it.Next() {
+			if !yield(*it.Value(), nil) {
+				return // Consumer requested early exit
 			}
-
-			// Check if there are more pages
-			if result.Meta.Pagination == nil || result.Meta.Pagination.NextPage == nil {
-				break
-			}
-
-			pageNum++
+		}
+		if err := it.Err(); err != nil {
+			yield(schemas.Team{}, err)
 		}
 	}
 }
 
 // GetTeamsPaged returns a stateful iterator with access to pagination metadata.
-// Use this when you need PageInfo(), Remaining(), or Collect() methods.
+// Use this when you need PageInfo(), Remaining(), or Collect() methods, or to resume
+// an interrupted iteration from the iterator Checkpoint().
 //
 // Example:
 //
@@ -322,6 +294,10 @@ func (c *Client) GetTeamsIter(ctx context.Context, opts *GetTeamsOptions) iter.S
 //	if err := iter.Err(); err != nil {
 //	    // Handle error
 //	}
+//
+// Resuming from a checkpoint saved by an iterator with the same options:
+//
+//	iter := client.Team.GetTeamsPaged(ctx, opts).Resume(checkpoint)
 func (c *Client) GetTeamsPaged(ctx context.Context, opts *GetTeamsOptions) *client.Iterator[schemas.Team] {
 	// Determine page size from opts or use default
 	pageSize := 20
@@ -352,26 +328,34 @@ func (c *Client) GetTeamsPaged(ctx context.Context, opts *GetTeamsOptions) *clie
 			Meta struct {
 				Pagination *client.Pagination `json:"pagination"`
 			} `json:"meta"`
-			Included []map[string]interface{} `json:"included"`
+			Included []json.RawMessage `json:"included"`
 		}
 		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 			return nil, nil, fmt.Errorf("failed to decode response: %w", err)
 		}
 
-		// Convert to slice of pointers and populate includes
+		// Convert to slice of pointers and resolve includes
+		included := client.NewIncluded(result.Included)
 		items := make([]*schemas.Team, len(result.Data))
 		for i := range result.Data {
 			items[i] = &result.Data[i]
-			// Populate included resources into relationships
-			if len(result.Included) > 0 {
-				items[i].Relationships.PopulateIncludes(result.Included)
-			}
+			items[i].ResolveIncludes(included)
 		}
 
 		return items, result.Meta.Pagination, nil
 	}
 
-	return client.NewIterator[schemas.Team](ctx, pageSize, fetchPage)
+	// Checkpoints only resume the iterators of the same listing
+	listOpts := GetTeamsOptions{}
+	if opts != nil {
+		listOpts = *opts
+	}
+	listOpts.PageNumber, listOpts.PageSize = 0, 0
+	filters := client.HashOptions("GetTeams", listOpts)
+
+	return client.NewIterator[schemas.Team](ctx, pageSize, fetchPage).
+		Prefetch(c.httpClient.Prefetch()).
+		FiltersHash(filters)
 }
 
 // GetTeamsOptions holds optional parameters for GetTeams
@@ -389,6 +373,19 @@ type GetTeamsOptions struct {
 	Filter map[string]string
 }
 
+// Relationship paths accepted by the Include option of GetTeams
+const (
+	GetTeamsIncludeAccount                 = "account"
+	GetTeamsIncludeAccountBillingPlan      = "account.billing-plan"
+	GetTeamsIncludeAccountIdentityProvider = "account.identity-provider"
+	GetTeamsIncludeAccountOwner            = "account.owner"
+	GetTeamsIncludeIdentityProvider        = "identity-provider"
+	GetTeamsIncludeIdentityProviderAccount = "identity-provider.account"
+	GetTeamsIncludeUsers                   = "users"
+	GetTeamsIncludeUsersIdentityProviders  = "users.identity-providers"
+	GetTeamsIncludeUsersTeams              = "users.teams"
+)
+
 // Update a team's attributes or users. The endpoint can be used to add or remove users from a team. If the account uses an external identity provider without SCIM provisioning, team membership cannot be managed via this endpoint - the “users“ relationship will be ignored. Use SCIM or manage team membership directly in the identity provider.
 func (c *Client) UpdateTeamRaw(ctx context.Context, team string, req *schemas.TeamRequest, opts *UpdateTeamOptions) (*client.Response, error) {
 	path := "/teams/{team}"
@@ -426,17 +423,15 @@ func (c *Client) UpdateTeam(ctx context.Context, team string, req *schemas.TeamR
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.Team             `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.Team      `json:"data"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -446,3 +441,16 @@ type UpdateTeamOptions struct {
 	Include []string
 	Filter  map[string]string
 }
+
+// Relationship paths accepted by the Include option of UpdateTeam
+const (
+	UpdateTeamIncludeAccount                 = "account"
+	UpdateTeamIncludeAccountBillingPlan      = "account.billing-plan"
+	UpdateTeamIncludeAccountIdentityProvider = "account.identity-provider"
+	UpdateTeamIncludeAccountOwner            = "account.owner"
+	UpdateTeamIncludeIdentityProvider        = "identity-provider"
+	UpdateTeamIncludeIdentityProviderAccount = "identity-provider.account"
+	UpdateTeamIncludeUsers                   = "users"
+	UpdateTeamIncludeUsersIdentityProviders  = "users.identity-providers"
+	UpdateTeamIncludeUsersTeams              = "users.teams"
+)
@@ -35,8 +35,7 @@ func (c *Client) GetModuleUsageRaw(ctx context.Context, moduleUsage string, opts
 		if len(opts.Include) > 0 {
 			params.Set("include", strings.Join(opts.Include, ","))
 		}
-		// Handle parameter: Fields (map[string]interface{})
-		// Complex type map[string]interface{} - skip for now
+		opts.Fields.Encode(params)
 		// Add filters
 		for k, v := range opts.Filter {
 			params.Set("filter["+k+"]", v)
@@ -63,16 +62,14 @@ func (c *Client) GetModuleUsage(ctx context.Context, moduleUsage string, opts *G
 
 	var result struct {
 		Data     schemas.TerraformModuleUsage `json:"data"`
-		Included []map[string]interface{}     `json:"included"`
+		Included []json.RawMessage            `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -81,10 +78,18 @@ type GetModuleUsageOptions struct {
 	// The comma-separated list of relationship paths.
 	Include []string
 	// The value of the fields[resource-type] parameter is a comma-separated list that refers to the name of the fields to be returned for the resource. An empty value indicates that no fields should be returned.
-	Fields map[string]interface{}
+	Fields client.Fields
 	Filter map[string]string
 }
 
+// Relationship paths accepted by the Include option of GetModuleUsage
+const (
+	GetModuleUsageIncludeNamespace                     = "namespace"
+	GetModuleUsageIncludeNamespaceAccount              = "namespace.account"
+	GetModuleUsageIncludeNamespaceNamespaceAccount     = "namespace.namespace-account"
+	GetModuleUsageIncludeNamespaceNamespaceEnvironment = "namespace.namespace-environment"
+)
+
 // This endpoint lists unique terraform module sources.
 func (c *Client) ListTerraformModuleSourcesRaw(ctx context.Context, opts *ListTerraformModuleSourcesOptions) (*client.Response, error) {
 	path := "/reports/module-sources"
@@ -166,8 +171,7 @@ func (c *Client) ListTerraformModuleUsagesRaw(ctx context.Context, opts *ListTer
 		if len(opts.Sort) > 0 {
 			params.Set("sort", strings.Join(opts.Sort, ","))
 		}
-		// Handle parameter: Fields (map[string]interface{})
-		// Complex type map[string]interface{} - skip for now
+		opts.Fields.Encode(params)
 		if len(opts.Include) > 0 {
 			params.Set("include", strings.Join(opts.Include, ","))
 		}
@@ -200,19 +204,18 @@ func (c *Client) ListTerraformModuleUsages(ctx context.Context, opts *ListTerraf
 		Meta struct {
 			Pagination *client.Pagination `json:"pagination"`
 		} `json:"meta"`
-		Included []map[string]interface{} `json:"included"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
+	// Index included resources once to resolve the relationships
+	included := client.NewIncluded(result.Included)
 	resources := make([]*schemas.TerraformModuleUsage, len(result.Data))
 	for i := range result.Data {
 		resources[i] = &result.Data[i]
-		// Populate included resources into relationships
-		if len(result.Included) > 0 {
-			resources[i].Relationships.PopulateIncludes(result.Included)
-		}
+		resources[i].ResolveIncludes(included)
 	}
 	return resources, nil
 }
@@ -221,6 +224,7 @@ func (c *Client) ListTerraformModuleUsages(ctx context.Context, opts *ListTerraf
 // This is the recommended and simple way to iterate through all the results.
 //
 // The iterator automatically fetches pages as needed and handles errors inline.
+// Pages are fetched ahead in the background when the client is created with client.WithPrefetch.
 //
 // Example:
 //
@@ -232,73 +236,23 @@ func (c *Client) ListTerraformModuleUsages(ctx context.Context, opts *ListTerraf
 //	}
 func (c *Client) ListTerraformModuleUsagesIter(ctx context.Context, opts *ListTerraformModuleUsagesOptions) iter.Seq2[schemas.TerraformModuleUsage, error] {
 	return func(yield func(schemas.TerraformModuleUsage, error) bool) {
-		// Determine page size from opts or use default
-		pageSize := 20
-		if opts != nil && opts.PageSize > 0 {
-			pageSize = opts.PageSize
-		}
-
-		pageNum := 1
-
-		for {
-			// Check context
-			if err := ctx.Err(); err != nil {
-				yield(schemas.TerraformModuleUsage{}, err)
-				return
-			}
-
-			// Copy options and set page number
-			pageOpts := &ListTerraformModuleUsagesOptions{}
-			if opts != nil {
-				*pageOpts = *opts
-			}
-			pageOpts.PageNumber = pageNum
-			pageOpts.PageSize = pageSize
-
-			// Fetch page using Raw method to get pagination metadata
-			resp, err := c.ListTerraformModuleUsagesRaw(ctx, pageOpts)
-			if err != nil {
-				yield(schemas.TerraformModuleUsage{}, err)
-				return
-			}
-			defer resp.Body.Close()
-
-			// Decode response
-			var result struct {
-				Data []schemas.TerraformModuleUsage `json:"data"`
-				Meta struct {
-					Pagination *client.Pagination `json:"pagination"`
-				} `json:"meta"`
-				Included []map[string]interface{} `json:"included"`
-			}
-			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-				yield(schemas.TerraformModuleUsage{}, fmt.Errorf("failed to decode response: %w", err))
-				return
-			}
+		it := c.ListTerraformModuleUsagesPaged(ctx, opts)
+		defer it.Close() // Stops the prefetching on early exit
 
-			// Yield each item
-			for i := range result.Data {
-				// Populate included resources into relationships
-				if len(result.Included) > 0 {
-					result.Data[i].Relationships.PopulateIncludes(result.Included)
-				}
-				if !yield(result.Data[i], nil) {
-					return // Consumer requested early exit
-				}
+		for it.Next() {
+			if !yield(*it.Value(), nil) {
+				return // Consumer requested early exit
 			}
-
-			// Check if there are more pages
-			if result.Meta.Pagination == nil || result.Meta.Pagination.NextPage == nil {
-				break
-			}
-
-			pageNum++
+		}
+		if err := it.Err(); err != nil {
+			yield(schemas.TerraformModuleUsage{}, err)
 		}
 	}
 }
 
 // ListTerraformModuleUsagesPaged returns a stateful iterator with access to pagination metadata.
-// Use this when you need PageInfo(), Remaining(), or Collect() methods.
+// Use this when you need PageInfo(), Remaining(), or Collect() methods, or to resume
+// an interrupted iteration from the iterator Checkpoint().
 //
 // Example:
 //
@@ -311,6 +265,10 @@ func (c *Client) ListTerraformModuleUsagesIter(ctx context.Context, opts *ListTe
 //	if err := iter.Err(); err != nil {
 //	    // Handle error
 //	}
+//
+// Resuming from a checkpoint saved by an iterator with the same options:
+//
+//	iter := client.TerraformModuleUsage.ListTerraformModuleUsagesPaged(ctx, opts).Resume(checkpoint)
 func (c *Client) ListTerraformModuleUsagesPaged(ctx context.Context, opts *ListTerraformModuleUsagesOptions) *client.Iterator[schemas.TerraformModuleUsage] {
 	// Determine page size from opts or use default
 	pageSize := 20
@@ -341,26 +299,34 @@ func (c *Client) ListTerraformModuleUsagesPaged(ctx context.Context, opts *ListT
 			Meta struct {
 				Pagination *client.Pagination `json:"pagination"`
 			} `json:"meta"`
-			Included []map[string]interface{} `json:"included"`
+			Included []json.RawMessage `json:"included"`
 		}
 		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 			return nil, nil, fmt.Errorf("failed to decode response: %w", err)
 		}
 
-		// Convert to slice of pointers and populate includes
+		// Convert to slice of pointers and resolve includes
+		included := client.NewIncluded(result.Included)
 		items := make([]*schemas.TerraformModuleUsage, len(result.Data))
 		for i := range result.Data {
 			items[i] = &result.Data[i]
-			// Populate included resources into relationships
-			if len(result.Included) > 0 {
-				items[i].Relationships.PopulateIncludes(result.Included)
-			}
+			items[i].ResolveIncludes(included)
 		}
 
 		return items, result.Meta.Pagination, nil
 	}
 
-	return client.NewIterator[schemas.TerraformModuleUsage](ctx, pageSize, fetchPage)
+	// Checkpoints only resume the iterators of the same listing
+	listOpts := ListTerraformModuleUsagesOptions{}
+	if opts != nil {
+		listOpts = *opts
+	}
+	listOpts.PageNumber, listOpts.PageSize = 0, 0
+	filters := client.HashOptions("ListTerraformModuleUsages", listOpts)
+
+	return client.NewIterator[schemas.TerraformModuleUsage](ctx, pageSize, fetchPage).
+		Prefetch(c.httpClient.Prefetch()).
+		FiltersHash(filters)
 }
 
 // ListTerraformModuleUsagesOptions holds optional parameters for ListTerraformModuleUsages
@@ -376,8 +342,16 @@ type ListTerraformModuleUsagesOptions struct {
 	// The comma-separated list of attributes.
 	Sort []string
 	// The value of the fields[resource-type] parameter is a comma-separated list that refers to the name of the fields to be returned for the resource. An empty value indicates that no fields should be returned.
-	Fields map[string]interface{}
+	Fields client.Fields
 	// The comma-separated list of relationship paths.
 	Include []string
 	Filter  map[string]string
 }
+
+// Relationship paths accepted by the Include option of ListTerraformModuleUsages
+const (
+	ListTerraformModuleUsagesIncludeNamespace                     = "namespace"
+	ListTerraformModuleUsagesIncludeNamespaceAccount              = "namespace.account"
+	ListTerraformModuleUsagesIncludeNamespaceNamespaceAccount     = "namespace.namespace-account"
+	ListTerraformModuleUsagesIncludeNamespaceNamespaceEnvironment = "namespace.namespace-environment"
+)
@@ -48,8 +48,7 @@ func (c *Client) ListTerraformModuleUsageRaw(ctx context.Context, opts *ListTerr
 		if len(opts.Sort) > 0 {
 			params.Set("sort", strings.Join(opts.Sort, ","))
 		}
-		// Handle parameter: Fields (map[string]interface{})
-		// Complex type map[string]interface{} - skip for now
+		opts.Fields.Encode(params)
 		if len(opts.Include) > 0 {
 			params.Set("include", strings.Join(opts.Include, ","))
 		}
@@ -82,19 +81,18 @@ func (c *Client) ListTerraformModuleUsage(ctx context.Context, opts *ListTerrafo
 		Meta struct {
 			Pagination *client.Pagination `json:"pagination"`
 		} `json:"meta"`
-		Included []map[string]interface{} `json:"included"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
+	// Index included resources once to resolve the relationships
+	included := client.NewIncluded(result.Included)
 	resources := make([]*schemas.TerraformModuleVersionUsage, len(result.Data))
 	for i := range result.Data {
 		resources[i] = &result.Data[i]
-		// Populate included resources into relationships
-		if len(result.Included) > 0 {
-			resources[i].Relationships.PopulateIncludes(result.Included)
-		}
+		resources[i].ResolveIncludes(included)
 	}
 	return resources, nil
 }
@@ -103,6 +101,7 @@ func (c *Client) ListTerraformModuleUsage(ctx context.Context, opts *ListTerrafo
 // This is the recommended and simple way to iterate through all the results.
 //
 // The iterator automatically fetches pages as needed and handles errors inline.
+// Pages are fetched ahead in the background when the client is created with client.WithPrefetch.
 //
 // Example:
 //
@@ -114,73 +113,23 @@ func (c *Client) ListTerraformModuleUsage(ctx context.Context, opts *ListTerrafo
 //	}
 func (c *Client) ListTerraformModuleUsageIter(ctx context.Context, opts *ListTerraformModuleUsageOptions) iter.Seq2[schemas.TerraformModuleVersionUsage, error] {
 	return func(yield func(schemas.TerraformModuleVersionUsage, error) bool) {
-		// Determine page size from opts or use default
-		pageSize := 20
-		if opts != nil && opts.PageSize > 0 {
-			pageSize = opts.PageSize
-		}
-
-		pageNum := 1
-
-		for {
-			// Check context
-			if err := ctx.Err(); err != nil {
-				yield(schemas.TerraformModuleVersionUsage{}, err)
-				return
-			}
-
-			// Copy options and set page number
-			pageOpts := &ListTerraformModuleUsageOptions{}
-			if opts != nil {
-				*pageOpts = *opts
-			}
-			pageOpts.PageNumber = pageNum
-			pageOpts.PageSize = pageSize
-
-			// Fetch page using Raw method to get pagination metadata
-			resp, err := c.ListTerraformModuleUsageRaw(ctx, pageOpts)
-			if err != nil {
-				yield(schemas.TerraformModuleVersionUsage{}, err)
-				return
-			}
-			defer resp.Body.Close()
-
-			// Decode response
-			var result struct {
-				Data []schemas.TerraformModuleVersionUsage `json:"data"`
-				Meta struct {
-					Pagination *client.Pagination `json:"pagination"`
-				} `json:"meta"`
-				Included []map[string]interface{} `json:"included"`
-			}
-			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-				yield(schemas.TerraformModuleVersionUsage{}, fmt.Errorf("failed to decode response: %w", err))
-				return
-			}
+		it := c.ListTerraformModuleUsagePaged(ctx, opts)
+		defer it.Close() // Stops the prefetching on early exit
 
-			// Yield each item
-			for i := range result.Data {
-				// Populate included resources into relationships
-				if len(result.Included) > 0 {
-					result.Data[i].Relationships.PopulateIncludes(result.Included)
-				}
-				if !yield(result.Data[i], nil) {
-					return // Consumer requested early exit
-				}
+		for it.Next() {
+			if !yield(*it.Value(), nil) {
+				return // Consumer requested early exit
 			}
-
-			// Check if there are more pages
-			if result.Meta.Pagination == nil || result.Meta.Pagination.NextPage == nil {
-				break
-			}
-
-			pageNum++
+		}
+		if err := it.Err(); err != nil {
+			yield(schemas.TerraformModuleVersionUsage{}, err)
 		}
 	}
 }
 
 // ListTerraformModuleUsagePaged returns a stateful iterator with access to pagination metadata.
-// Use this when you need PageInfo(), Remaining(), or Collect() methods.
+// Use this when you need PageInfo(), Remaining(), or Collect() methods, or to resume
+// an interrupted iteration from the iterator Checkpoint().
 //
 // Example:
 //
@@ -193,6 +142,10 @@ func (c *Client) ListTerraformModuleUsageIter(ctx context.Context, opts *ListTer
 //	if err := iter.Err(); err != nil {
 //	    // Handle error
 //	}
+//
+// Resuming from a checkpoint saved by an iterator with the same options:
+//
+//	iter := client.TerraformModuleVersionUsage.ListTerraformModuleUsagePaged(ctx, opts).Resume(checkpoint)
 func (c *Client) ListTerraformModuleUsagePaged(ctx context.Context, opts *ListTerraformModuleUsageOptions) *client.Iterator[schemas.TerraformModuleVersionUsage] {
 	// Determine page size from opts or use default
 	pageSize := 20
@@ -223,26 +176,34 @@ func (c *Client) ListTerraformModuleUsagePaged(ctx context.Context, opts *ListTe
 			Meta struct {
 				Pagination *client.Pagination `json:"pagination"`
 			} `json:"meta"`
-			Included []map[string]interface{} `json:"included"`
+			Included []json.RawMessage `json:"included"`
 		}
 		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 			return nil, nil, fmt.Errorf("failed to decode response: %w", err)
 		}
 
-		// Convert to slice of pointers and populate includes
+		// Convert to slice of pointers and resolve includes
+		included := client.NewIncluded(result.Included)
 		items := make([]*schemas.TerraformModuleVersionUsage, len(result.Data))
 		for i := range result.Data {
 			items[i] = &result.Data[i]
-			// Populate included resources into relationships
-			if len(result.Included) > 0 {
-				items[i].Relationships.PopulateIncludes(result.Included)
-			}
+			items[i].ResolveIncludes(included)
 		}
 
 		return items, result.Meta.Pagination, nil
 	}
 
-	return client.NewIterator[schemas.TerraformModuleVersionUsage](ctx, pageSize, fetchPage)
+	// Checkpoints only resume the iterators of the same listing
+	listOpts := ListTerraformModuleUsageOptions{}
+	if opts != nil {
+		listOpts = *opts
+	}
+	listOpts.PageNumber, listOpts.PageSize = 0, 0
+	filters := client.HashOptions("ListTerraformModuleUsage", listOpts)
+
+	return client.NewIterator[schemas.TerraformModuleVersionUsage](ctx, pageSize, fetchPage).
+		Prefetch(c.httpClient.Prefetch()).
+		FiltersHash(filters)
 }
 
 // ListTerraformModuleUsageOptions holds optional parameters for ListTerraformModuleUsage
@@ -258,12 +219,46 @@ type ListTerraformModuleUsageOptions struct {
 	// The comma-separated list of attributes.
 	Sort []string
 	// The value of the fields[resource-type] parameter is a comma-separated list that refers to the name of the fields to be returned for the resource. An empty value indicates that no fields should be returned.
-	Fields map[string]interface{}
+	Fields client.Fields
 	// The comma-separated list of relationship paths.
 	Include []string
 	Filter  map[string]string
 }
 
+// Relationship paths accepted by the Include option of ListTerraformModuleUsage
+const (
+	ListTerraformModuleUsageIncludeEnvironment                              = "environment"
+	ListTerraformModuleUsageIncludeEnvironmentAccount                       = "environment.account"
+	ListTerraformModuleUsageIncludeEnvironmentCreatedBy                     = "environment.created-by"
+	ListTerraformModuleUsageIncludeEnvironmentDefaultProviderConfigurations = "environment.default-provider-configurations"
+	ListTerraformModuleUsageIncludeEnvironmentDefaultWorkspaceAgentPool     = "environment.default-workspace-agent-pool"
+	ListTerraformModuleUsageIncludeEnvironmentDriftDetectionSchedules       = "environment.drift-detection-schedules"
+	ListTerraformModuleUsageIncludeEnvironmentLockedBy                      = "environment.locked-by"
+	ListTerraformModuleUsageIncludeEnvironmentPolicyGroups                  = "environment.policy-groups"
+	ListTerraformModuleUsageIncludeEnvironmentProviderConfigurations        = "environment.provider-configurations"
+	ListTerraformModuleUsageIncludeEnvironmentStorageProfile                = "environment.storage-profile"
+	ListTerraformModuleUsageIncludeEnvironmentTags                          = "environment.tags"
+	ListTerraformModuleUsageIncludeEnvironmentUpdatedBy                     = "environment.updated-by"
+	ListTerraformModuleUsageIncludeWorkspace                                = "workspace"
+	ListTerraformModuleUsageIncludeWorkspaceAgentPool                       = "workspace.agent-pool"
+	ListTerraformModuleUsageIncludeWorkspaceConfigurationVersion            = "workspace.configuration-version"
+	ListTerraformModuleUsageIncludeWorkspaceCreatedBy                       = "workspace.created-by"
+	ListTerraformModuleUsageIncludeWorkspaceCurrentRun                      = "workspace.current-run"
+	ListTerraformModuleUsageIncludeWorkspaceDriftReport                     = "workspace.drift-report"
+	ListTerraformModuleUsageIncludeWorkspaceEnvironment                     = "workspace.environment"
+	ListTerraformModuleUsageIncludeWorkspaceLatestConfigurationVersion      = "workspace.latest-configuration-version"
+	ListTerraformModuleUsageIncludeWorkspaceLatestRun                       = "workspace.latest-run"
+	ListTerraformModuleUsageIncludeWorkspaceLockedBy                        = "workspace.locked-by"
+	ListTerraformModuleUsageIncludeWorkspaceLockedByRun                     = "workspace.locked-by-run"
+	ListTerraformModuleUsageIncludeWorkspaceModule                          = "workspace.module"
+	ListTerraformModuleUsageIncludeWorkspaceModuleVersion                   = "workspace.module-version"
+	ListTerraformModuleUsageIncludeWorkspaceReadmeId                        = "workspace.readme-id"
+	ListTerraformModuleUsageIncludeWorkspaceSshKey                          = "workspace.ssh-key"
+	ListTerraformModuleUsageIncludeWorkspaceTags                            = "workspace.tags"
+	ListTerraformModuleUsageIncludeWorkspaceUpdatedBy                       = "workspace.updated-by"
+	ListTerraformModuleUsageIncludeWorkspaceVcsProvider                     = "workspace.vcs-provider"
+)
+
 // This endpoint lists unique terraform module versions.
 func (c *Client) ListTerraformModuleVersionsUsageRaw(ctx context.Context, opts *ListTerraformModuleVersionsUsageOptions) (*client.Response, error) {
 	path := "/reports/module-versions-usage"
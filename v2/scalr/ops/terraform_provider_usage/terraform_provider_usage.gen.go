@@ -32,8 +32,7 @@ func (c *Client) GetProviderUsageRaw(ctx context.Context, providerUsage string,
 
 	params := url.Values{}
 	if opts != nil {
-		// Handle parameter: Fields (map[string]interface{})
-		// Complex type map[string]interface{} - skip for now
+		opts.Fields.Encode(params)
 		// Add filters
 		for k, v := range opts.Filter {
 			params.Set("filter["+k+"]", v)
@@ -60,7 +59,7 @@ func (c *Client) GetProviderUsage(ctx context.Context, providerUsage string, opt
 
 	var result struct {
 		Data     schemas.TerraformProviderUsage `json:"data"`
-		Included []map[string]interface{}       `json:"included"`
+		Included []json.RawMessage              `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
@@ -72,7 +71,7 @@ func (c *Client) GetProviderUsage(ctx context.Context, providerUsage string, opt
 // GetProviderUsageOptions holds optional parameters for GetProviderUsage
 type GetProviderUsageOptions struct {
 	// The value of the fields[resource-type] parameter is a comma-separated list that refers to the name of the fields to be returned for the resource. An empty value indicates that no fields should be returned.
-	Fields map[string]interface{}
+	Fields client.Fields
 	Filter map[string]string
 }
 
@@ -147,8 +146,7 @@ func (c *Client) ListTerraformProviderUsagesRaw(ctx context.Context, opts *ListT
 		if len(opts.Sort) > 0 {
 			params.Set("sort", strings.Join(opts.Sort, ","))
 		}
-		// Handle parameter: Fields (map[string]interface{})
-		// Complex type map[string]interface{} - skip for now
+		opts.Fields.Encode(params)
 		// Add filters
 		for k, v := range opts.Filter {
 			params.Set("filter["+k+"]", v)
@@ -178,7 +176,7 @@ func (c *Client) ListTerraformProviderUsages(ctx context.Context, opts *ListTerr
 		Meta struct {
 			Pagination *client.Pagination `json:"pagination"`
 		} `json:"meta"`
-		Included []map[string]interface{} `json:"included"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
@@ -195,6 +193,7 @@ func (c *Client) ListTerraformProviderUsages(ctx context.Context, opts *ListTerr
 // This is the recommended and simple way to iterate through all the results.
 //
 // The iterator automatically fetches pages as needed and handles errors inline.
+// Pages are fetched ahead in the background when the client is created with client.WithPrefetch.
 //
 // Example:
 //
@@ -206,69 +205,23 @@ func (c *Client) ListTerraformProviderUsages(ctx context.Context, opts *ListTerr
 //	}
 func (c *Client) ListTerraformProviderUsagesIter(ctx context.Context, opts *ListTerraformProviderUsagesOptions) iter.Seq2[schemas.TerraformProviderUsage, error] {
 	return func(yield func(schemas.TerraformProviderUsage, error) bool) {
-		// Determine page size from opts or use default
-		pageSize := 20
-		if opts != nil && opts.PageSize > 0 {
-			pageSize = opts.PageSize
-		}
-
-		pageNum := 1
-
-		for {
-			// Check context
-			if err := ctx.Err(); err != nil {
-				yield(schemas.TerraformProviderUsage{}, err)
-				return
-			}
-
-			// Copy options and set page number
-			pageOpts := &ListTerraformProviderUsagesOptions{}
-			if opts != nil {
-				*pageOpts = *opts
-			}
-			pageOpts.PageNumber = pageNum
-			pageOpts.PageSize = pageSize
-
-			// Fetch page using Raw method to get pagination metadata
-			resp, err := c.ListTerraformProviderUsagesRaw(ctx, pageOpts)
-			if err != nil {
-				yield(schemas.TerraformProviderUsage{}, err)
-				return
-			}
-			defer resp.Body.Close()
-
-			// Decode response
-			var result struct {
-				Data []schemas.TerraformProviderUsage `json:"data"`
-				Meta struct {
-					Pagination *client.Pagination `json:"pagination"`
-				} `json:"meta"`
-				Included []map[string]interface{} `json:"included"`
-			}
-			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-				yield(schemas.TerraformProviderUsage{}, fmt.Errorf("failed to decode response: %w", err))
-				return
-			}
+		it := c.ListTerraformProviderUsagesPaged(ctx, opts)
+		defer it.Close() // Stops the prefetching on early exit
 
-			// Yield each item
-			for i := range result.Data {
-				if !yield(result.Data[i], nil) {
-					return // Consumer requested early exit
-				}
+		for it.Next() {
+			if !yield(*it.Value(), nil) {
+				return // Consumer requested early exit
 			}
-
-			// Check if there are more pages
-			if result.Meta.Pagination == nil || result.Meta.Pagination.NextPage == nil {
-				break
-			}
-
-			pageNum++
+		}
+		if err := it.Err(); err != nil {
+			yield(schemas.TerraformProviderUsage{}, err)
 		}
 	}
 }
 
 // ListTerraformProviderUsagesPaged returns a stateful iterator with access to pagination metadata.
-// Use this when you need PageInfo(), Remaining(), or Collect() methods.
+// Use this when you need PageInfo(), Remaining(), or Collect() methods, or to resume
+// an interrupted iteration from the iterator Checkpoint().
 //
 // Example:
 //
@@ -281,6 +234,10 @@ func (c *Client) ListTerraformProviderUsagesIter(ctx context.Context, opts *List
 //	if err := iter.Err(); err != nil {
 //	    // Handle error
 //	}
+//
+// Resuming from a checkpoint saved by an iterator with the same options:
+//
+//	iter := client.TerraformProviderUsage.ListTerraformProviderUsagesPaged(ctx, opts).Resume(checkpoint)
 func (c *Client) ListTerraformProviderUsagesPaged(ctx context.Context, opts *ListTerraformProviderUsagesOptions) *client.Iterator[schemas.TerraformProviderUsage] {
 	// Determine page size from opts or use default
 	pageSize := 20
@@ -311,13 +268,13 @@ func (c *Client) ListTerraformProviderUsagesPaged(ctx context.Context, opts *Lis
 			Meta struct {
 				Pagination *client.Pagination `json:"pagination"`
 			} `json:"meta"`
-			Included []map[string]interface{} `json:"included"`
+			Included []json.RawMessage `json:"included"`
 		}
 		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 			return nil, nil, fmt.Errorf("failed to decode response: %w", err)
 		}
 
-		// Convert to slice of pointers and populate includes
+		// Convert to slice of pointers and resolve includes
 		items := make([]*schemas.TerraformProviderUsage, len(result.Data))
 		for i := range result.Data {
 			items[i] = &result.Data[i]
@@ -326,7 +283,17 @@ func (c *Client) ListTerraformProviderUsagesPaged(ctx context.Context, opts *Lis
 		return items, result.Meta.Pagination, nil
 	}
 
-	return client.NewIterator[schemas.TerraformProviderUsage](ctx, pageSize, fetchPage)
+	// Checkpoints only resume the iterators of the same listing
+	listOpts := ListTerraformProviderUsagesOptions{}
+	if opts != nil {
+		listOpts = *opts
+	}
+	listOpts.PageNumber, listOpts.PageSize = 0, 0
+	filters := client.HashOptions("ListTerraformProviderUsages", listOpts)
+
+	return client.NewIterator[schemas.TerraformProviderUsage](ctx, pageSize, fetchPage).
+		Prefetch(c.httpClient.Prefetch()).
+		FiltersHash(filters)
 }
 
 // ListTerraformProviderUsagesOptions holds optional parameters for ListTerraformProviderUsages
@@ -342,6 +309,6 @@ type ListTerraformProviderUsagesOptions struct {
 	// The comma-separated list of attributes.
 	Sort []string
 	// The value of the fields[resource-type] parameter is a comma-separated list that refers to the name of the fields to be returned for the resource. An empty value indicates that no fields should be returned.
-	Fields map[string]interface{}
+	Fields client.Fields
 	Filter map[string]string
 }
@@ -48,8 +48,7 @@ func (c *Client) ListTerraformProviderUsageRaw(ctx context.Context, opts *ListTe
 		if len(opts.Sort) > 0 {
 			params.Set("sort", strings.Join(opts.Sort, ","))
 		}
-		// Handle parameter: Fields (map[string]interface{})
-		// Complex type map[string]interface{} - skip for now
+		opts.Fields.Encode(params)
 		if len(opts.Include) > 0 {
 			params.Set("include", strings.Join(opts.Include, ","))
 		}
@@ -82,19 +81,18 @@ func (c *Client) ListTerraformProviderUsage(ctx context.Context, opts *ListTerra
 		Meta struct {
 			Pagination *client.Pagination `json:"pagination"`
 		} `json:"meta"`
-		Included []map[string]interface{} `json:"included"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
+	// Index included resources once to resolve the relationships
+	included := client.NewIncluded(result.Included)
 	resources := make([]*schemas.TerraformProviderVersionUsage, len(result.Data))
 	for i := range result.Data {
 		resources[i] = &result.Data[i]
-		// Populate included resources into relationships
-		if len(result.Included) > 0 {
-			resources[i].Relationships.PopulateIncludes(result.Included)
-		}
+		resources[i].ResolveIncludes(included)
 	}
 	return resources, nil
 }
@@ -103,6 +101,7 @@ func (c *Client) ListTerraformProviderUsage(ctx context.Context, opts *ListTerra
 // This is the recommended and simple way to iterate through all the results.
 //
 // The iterator automatically fetches pages as needed and handles errors inline.
+// Pages are fetched ahead in the background when the client is created with client.WithPrefetch.
 //
 // Example:
 //
@@ -114,73 +113,23 @@ func (c *Client) ListTerraformProviderUsage(ctx context.Context, opts *ListTerra
 //	}
 func (c *Client) ListTerraformProviderUsageIter(ctx context.Context, opts *ListTerraformProviderUsageOptions) iter.Seq2[schemas.TerraformProviderVersionUsage, error] {
 	return func(yield func(schemas.TerraformProviderVersionUsage, error) bool) {
-		// Determine page size from opts or use default
-		pageSize := 20
-		if opts != nil && opts.PageSize > 0 {
-			pageSize = opts.PageSize
-		}
-
-		pageNum := 1
-
-		for {
-			// Check context
-			if err := ctx.Err(); err != nil {
-				yield(schemas.TerraformProviderVersionUsage{}, err)
-				return
-			}
-
-			// Copy options and set page number
-			pageOpts := &ListTerraformProviderUsageOptions{}
-			if opts != nil {
-				*pageOpts = *opts
-			}
-			pageOpts.PageNumber = pageNum
-			pageOpts.PageSize = pageSize
-
-			// Fetch page using Raw method to get pagination metadata
-			resp, err := c.ListTerraformProviderUsageRaw(ctx, pageOpts)
-			if err != nil {
-				yield(schemas.TerraformProviderVersionUsage{}, err)
-				return
-			}
-			defer resp.Body.Close()
-
-			// Decode response
-			var result struct {
-				Data []schemas.TerraformProviderVersionUsage `json:"data"`
-				Meta struct {
-					Pagination *client.Pagination `json:"pagination"`
-				} `json:"meta"`
-				Included []map[string]interface{} `json:"included"`
-			}
-			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-				yield(schemas.TerraformProviderVersionUsage{}, fmt.Errorf("failed to decode response: %w", err))
-				return
-			}
+		it := c.ListTerraformProviderUsagePaged(ctx, opts)
+		defer it.Close() // Stops the prefetching on early exit
 
-			// Yield each item
-			for i := range result.Data {
-				// Populate included resources into relationships
-				if len(result.Included) > 0 {
-					result.Data[i].Relationships.PopulateIncludes(result.Included)
-				}
-				if !yield(result.Data[i], nil) {
-					return // Consumer requested early exit
-				}
+		for it.Next() {
+			if !yield(*it.Value(), nil) {
+				return // Consumer requested early exit
 			}
-
-			// Check if there are more pages
-			if result.Meta.Pagination == nil || result.Meta.Pagination.NextPage == nil {
-				break
-			}
-
-			pageNum++
+		}
+		if err := it.Err(); err != nil {
+			yield(schemas.TerraformProviderVersionUsage{}, err)
 		}
 	}
 }
 
 // ListTerraformProviderUsagePaged returns a stateful iterator with access to pagination metadata.
-// Use this when you need PageInfo(), Remaining(), or Collect() methods.
+// Use this when you need PageInfo(), Remaining(), or Collect() methods, or to resume
+// an interrupted iteration from the iterator Checkpoint().
 //
 // Example:
 //
@@ -193,6 +142,10 @@ func (c *Client) ListTerraformProviderUsageIter(ctx context.Context, opts *ListT
 //	if err := iter.Err(); err != nil {
 //	    // Handle error
 //	}
+//
+// Resuming from a checkpoint saved by an iterator with the same options:
+//
+//	iter := client.TerraformProviderVersionUsage.ListTerraformProviderUsagePaged(ctx, opts).Resume(checkpoint)
 func (c *Client) ListTerraformProviderUsagePaged(ctx context.Context, opts *ListTerraformProviderUsageOptions) *client.Iterator[schemas.TerraformProviderVersionUsage] {
 	// Determine page size from opts or use default
 	pageSize := 20
@@ -223,26 +176,34 @@ func (c *Client) ListTerraformProviderUsagePaged(ctx context.Context, opts *List
 			Meta struct {
 				Pagination *client.Pagination `json:"pagination"`
 			} `json:"meta"`
-			Included []map[string]interface{} `json:"included"`
+			Included []json.RawMessage `json:"included"`
 		}
 		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 			return nil, nil, fmt.Errorf("failed to decode response: %w", err)
 		}
 
-		// Convert to slice of pointers and populate includes
+		// Convert to slice of pointers and resolve includes
+		included := client.NewIncluded(result.Included)
 		items := make([]*schemas.TerraformProviderVersionUsage, len(result.Data))
 		for i := range result.Data {
 			items[i] = &result.Data[i]
-			// Populate included resources into relationships
-			if len(result.Included) > 0 {
-				items[i].Relationships.PopulateIncludes(result.Included)
-			}
+			items[i].ResolveIncludes(included)
 		}
 
 		return items, result.Meta.Pagination, nil
 	}
 
-	return client.NewIterator[schemas.TerraformProviderVersionUsage](ctx, pageSize, fetchPage)
+	// Checkpoints only resume the iterators of the same listing
+	listOpts := ListTerraformProviderUsageOptions{}
+	if opts != nil {
+		listOpts = *opts
+	}
+	listOpts.PageNumber, listOpts.PageSize = 0, 0
+	filters := client.HashOptions("ListTerraformProviderUsage", listOpts)
+
+	return client.NewIterator[schemas.TerraformProviderVersionUsage](ctx, pageSize, fetchPage).
+		Prefetch(c.httpClient.Prefetch()).
+		FiltersHash(filters)
 }
 
 // ListTerraformProviderUsageOptions holds optional parameters for ListTerraformProviderUsage
@@ -258,12 +219,46 @@ type ListTerraformProviderUsageOptions struct {
 	// The comma-separated list of attributes.
 	Sort []string
 	// The value of the fields[resource-type] parameter is a comma-separated list that refers to the name of the fields to be returned for the resource. An empty value indicates that no fields should be returned.
-	Fields map[string]interface{}
+	Fields client.Fields
 	// The comma-separated list of relationship paths.
 	Include []string
 	Filter  map[string]string
 }
 
+// Relationship paths accepted by the Include option of ListTerraformProviderUsage
+const (
+	ListTerraformProviderUsageIncludeEnvironment                              = "environment"
+	ListTerraformProviderUsageIncludeEnvironmentAccount                       = "environment.account"
+	ListTerraformProviderUsageIncludeEnvironmentCreatedBy                     = "environment.created-by"
+	ListTerraformProviderUsageIncludeEnvironmentDefaultProviderConfigurations = "environment.default-provider-configurations"
+	ListTerraformProviderUsageIncludeEnvironmentDefaultWorkspaceAgentPool     = "environment.default-workspace-agent-pool"
+	ListTerraformProviderUsageIncludeEnvironmentDriftDetectionSchedules       = "environment.drift-detection-schedules"
+	ListTerraformProviderUsageIncludeEnvironmentLockedBy                      = "environment.locked-by"
+	ListTerraformProviderUsageIncludeEnvironmentPolicyGroups                  = "environment.policy-groups"
+	ListTerraformProviderUsageIncludeEnvironmentProviderConfigurations        = "environment.provider-configurations"
+	ListTerraformProviderUsageIncludeEnvironmentStorageProfile                = "environment.storage-profile"
+	ListTerraformProviderUsageIncludeEnvironmentTags                          = "environment.tags"
+	ListTerraformProviderUsageIncludeEnvironmentUpdatedBy                     = "environment.updated-by"
+	ListTerraformProviderUsageIncludeWorkspace                                = "workspace"
+	ListTerraformProviderUsageIncludeWorkspaceAgentPool                       = "workspace.agent-pool"
+	ListTerraformProviderUsageIncludeWorkspaceConfigurationVersion            = "workspace.configuration-version"
+	ListTerraformProviderUsageIncludeWorkspaceCreatedBy                       = "workspace.created-by"
+	ListTerraformProviderUsageIncludeWorkspaceCurrentRun                      = "workspace.current-run"
+	ListTerraformProviderUsageIncludeWorkspaceDriftReport                     = "workspace.drift-report"
+	ListTerraformProviderUsageIncludeWorkspaceEnvironment                     = "workspace.environment"
+	ListTerraformProviderUsageIncludeWorkspaceLatestConfigurationVersion      = "workspace.latest-configuration-version"
+	ListTerraformProviderUsageIncludeWorkspaceLatestRun                       = "workspace.latest-run"
+	ListTerraformProviderUsageIncludeWorkspaceLockedBy                        = "workspace.locked-by"
+	ListTerraformProviderUsageIncludeWorkspaceLockedByRun                     = "workspace.locked-by-run"
+	ListTerraformProviderUsageIncludeWorkspaceModule                          = "workspace.module"
+	ListTerraformProviderUsageIncludeWorkspaceModuleVersion                   = "workspace.module-version"
+	ListTerraformProviderUsageIncludeWorkspaceReadmeId                        = "workspace.readme-id"
+	ListTerraformProviderUsageIncludeWorkspaceSshKey                          = "workspace.ssh-key"
+	ListTerraformProviderUsageIncludeWorkspaceTags                            = "workspace.tags"
+	ListTerraformProviderUsageIncludeWorkspaceUpdatedBy                       = "workspace.updated-by"
+	ListTerraformProviderUsageIncludeWorkspaceVcsProvider                     = "workspace.vcs-provider"
+)
+
 // This endpoint lists unique terraform provider versions.
 func (c *Client) ListTerraformProviderVersionsUsageRaw(ctx context.Context, opts *ListTerraformProviderVersionsUsageOptions) (*client.Response, error) {
 	path := "/reports/provider-versions-usage"
@@ -47,8 +47,7 @@ func (c *Client) ListTerraformResourceInstancesUsageRaw(ctx context.Context, opt
 		if len(opts.Sort) > 0 {
 			params.Set("sort", strings.Join(opts.Sort, ","))
 		}
-		// Handle parameter: Fields (map[string]interface{})
-		// Complex type map[string]interface{} - skip for now
+		opts.Fields.Encode(params)
 		if len(opts.Include) > 0 {
 			params.Set("include", strings.Join(opts.Include, ","))
 		}
@@ -81,19 +80,18 @@ func (c *Client) ListTerraformResourceInstancesUsage(ctx context.Context, opts *
 		Meta struct {
 			Pagination *client.Pagination `json:"pagination"`
 		} `json:"meta"`
-		Included []map[string]interface{} `json:"included"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
+	// Index included resources once to resolve the relationships
+	included := client.NewIncluded(result.Included)
 	resources := make([]*schemas.TerraformResourceInstanceUsage, len(result.Data))
 	for i := range result.Data {
 		resources[i] = &result.Data[i]
-		// Populate included resources into relationships
-		if len(result.Included) > 0 {
-			resources[i].Relationships.PopulateIncludes(result.Included)
-		}
+		resources[i].ResolveIncludes(included)
 	}
 	return resources, nil
 }
@@ -102,6 +100,7 @@ func (c *Client) ListTerraformResourceInstancesUsage(ctx context.Context, opts *
 // This is the recommended and simple way to iterate through all the results.
 //
 // The iterator automatically fetches pages as needed and handles errors inline.
+// Pages are fetched ahead in the background when the client is created with client.WithPrefetch.
 //
 // Example:
 //
@@ -113,73 +112,23 @@ func (c *Client) ListTerraformResourceInstancesUsage(ctx context.Context, opts *
 //	}
 func (c *Client) ListTerraformResourceInstancesUsageIter(ctx context.Context, opts *ListTerraformResourceInstancesUsageOptions) iter.Seq2[schemas.TerraformResourceInstanceUsage, error] {
 	return func(yield func(schemas.TerraformResourceInstanceUsage, error) bool) {
-		// Determine page size from opts or use default
-		pageSize := 20
-		if opts != nil && opts.PageSize > 0 {
-			pageSize = opts.PageSize
-		}
-
-		pageNum := 1
-
-		for {
-			// Check context
-			if err := ctx.Err(); err != nil {
-				yield(schemas.TerraformResourceInstanceUsage{}, err)
-				return
-			}
-
-			// Copy options and set page number
-			pageOpts := &ListTerraformResourceInstancesUsageOptions{}
-			if opts != nil {
-				*pageOpts = *opts
-			}
-			pageOpts.PageNumber = pageNum
-			pageOpts.PageSize = pageSize
-
-			// Fetch page using Raw method to get pagination metadata
-			resp, err := c.ListTerraformResourceInstancesUsageRaw(ctx, pageOpts)
-			if err != nil {
-				yield(schemas.TerraformResourceInstanceUsage{}, err)
-				return
-			}
-			defer resp.Body.Close()
-
-			// Decode response
-			var result struct {
-				Data []schemas.TerraformResourceInstanceUsage `json:"data"`
-				Meta struct {
-					Pagination *client.Pagination `json:"pagination"`
-				} `json:"meta"`
-				Included []map[string]interface{} `json:"included"`
-			}
-			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-				yield(schemas.TerraformResourceInstanceUsage{}, fmt.Errorf("failed to decode response: %w", err))
-				return
-			}
+		it := c.ListTerraformResourceInstancesUsagePaged(ctx, opts)
+		defer it.Close() // Stops the prefetching on early exit
 
-			// Yield each item
-			for i := range result.Data {
-				// Populate included resources into relationships
-				if len(result.Included) > 0 {
-					result.Data[i].Relationships.PopulateIncludes(result.Included)
-				}
-				if !yield(result.Data[i], nil) {
-					return // Consumer requested early exit
-				}
+		for it.Next() {
+			if !yield(*it.Value(), nil) {
+				return // Consumer requested early exit
 			}
-
-			// Check if there are more pages
-			if result.Meta.Pagination == nil || result.Meta.Pagination.NextPage == nil {
-				break
-			}
-
-			pageNum++
+		}
+		if err := it.Err(); err != nil {
+			yield(schemas.TerraformResourceInstanceUsage{}, err)
 		}
 	}
 }
 
 // ListTerraformResourceInstancesUsagePaged returns a stateful iterator with access to pagination metadata.
-// Use this when you need PageInfo(), Remaining(), or Collect() methods.
+// Use this when you need PageInfo(), Remaining(), or Collect() methods, or to resume
+// an interrupted iteration from the iterator Checkpoint().
 //
 // Example:
 //
@@ -192,6 +141,10 @@ func (c *Client) ListTerraformResourceInstancesUsageIter(ctx context.Context, op
 //	if err := iter.Err(); err != nil {
 //	    // Handle error
 //	}
+//
+// Resuming from a checkpoint saved by an iterator with the same options:
+//
+//	iter := client.TerraformResourceInstanceUsage.ListTerraformResourceInstancesUsagePaged(ctx, opts).Resume(checkpoint)
 func (c *Client) ListTerraformResourceInstancesUsagePaged(ctx context.Context, opts *ListTerraformResourceInstancesUsageOptions) *client.Iterator[schemas.TerraformResourceInstanceUsage] {
 	// Determine page size from opts or use default
 	pageSize := 20
@@ -222,26 +175,34 @@ func (c *Client) ListTerraformResourceInstancesUsagePaged(ctx context.Context, o
 			Meta struct {
 				Pagination *client.Pagination `json:"pagination"`
 			} `json:"meta"`
-			Included []map[string]interface{} `json:"included"`
+			Included []json.RawMessage `json:"included"`
 		}
 		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 			return nil, nil, fmt.Errorf("failed to decode response: %w", err)
 		}
 
-		// Convert to slice of pointers and populate includes
+		// Convert to slice of pointers and resolve includes
+		included := client.NewIncluded(result.Included)
 		items := make([]*schemas.TerraformResourceInstanceUsage, len(result.Data))
 		for i := range result.Data {
 			items[i] = &result.Data[i]
-			// Populate included resources into relationships
-			if len(result.Included) > 0 {
-				items[i].Relationships.PopulateIncludes(result.Included)
-			}
+			items[i].ResolveIncludes(included)
 		}
 
 		return items, result.Meta.Pagination, nil
 	}
 
-	return client.NewIterator[schemas.TerraformResourceInstanceUsage](ctx, pageSize, fetchPage)
+	// Checkpoints only resume the iterators of the same listing
+	listOpts := ListTerraformResourceInstancesUsageOptions{}
+	if opts != nil {
+		listOpts = *opts
+	}
+	listOpts.PageNumber, listOpts.PageSize = 0, 0
+	filters := client.HashOptions("ListTerraformResourceInstancesUsage", listOpts)
+
+	return client.NewIterator[schemas.TerraformResourceInstanceUsage](ctx, pageSize, fetchPage).
+		Prefetch(c.httpClient.Prefetch()).
+		FiltersHash(filters)
 }
 
 // ListTerraformResourceInstancesUsageOptions holds optional parameters for ListTerraformResourceInstancesUsage
@@ -257,8 +218,63 @@ type ListTerraformResourceInstancesUsageOptions struct {
 	// The comma-separated list of attributes.
 	Sort []string
 	// The value of the fields[resource-type] parameter is a comma-separated list that refers to the name of the fields to be returned for the resource. An empty value indicates that no fields should be returned.
-	Fields map[string]interface{}
+	Fields client.Fields
 	// The comma-separated list of relationship paths.
 	Include []string
 	Filter  map[string]string
 }
+
+// Relationship paths accepted by the Include option of ListTerraformResourceInstancesUsage
+const (
+	ListTerraformResourceInstancesUsageIncludeEnvironment                              = "environment"
+	ListTerraformResourceInstancesUsageIncludeEnvironmentAccount                       = "environment.account"
+	ListTerraformResourceInstancesUsageIncludeEnvironmentCreatedBy                     = "environment.created-by"
+	ListTerraformResourceInstancesUsageIncludeEnvironmentDefaultProviderConfigurations = "environment.default-provider-configurations"
+	ListTerraformResourceInstancesUsageIncludeEnvironmentDefaultWorkspaceAgentPool     = "environment.default-workspace-agent-pool"
+	ListTerraformResourceInstancesUsageIncludeEnvironmentDriftDetectionSchedules       = "environment.drift-detection-schedules"
+	ListTerraformResourceInstancesUsageIncludeEnvironmentLockedBy                      = "environment.locked-by"
+	ListTerraformResourceInstancesUsageIncludeEnvironmentPolicyGroups                  = "environment.policy-groups"
+	ListTerraformResourceInstancesUsageIncludeEnvironmentProviderConfigurations        = "environment.provider-configurations"
+	ListTerraformResourceInstancesUsageIncludeEnvironmentStorageProfile                = "environment.storage-profile"
+	ListTerraformResourceInstancesUsageIncludeEnvironmentTags                          = "environment.tags"
+	ListTerraformResourceInstancesUsageIncludeEnvironmentUpdatedBy                     = "environment.updated-by"
+	ListTerraformResourceInstancesUsageIncludeResource                                 = "resource"
+	ListTerraformResourceInstancesUsageIncludeResourceAccount                          = "resource.account"
+	ListTerraformResourceInstancesUsageIncludeRun                                      = "run"
+	ListTerraformResourceInstancesUsageIncludeRunApply                                 = "run.apply"
+	ListTerraformResourceInstancesUsageIncludeRunConfigurationVersion                  = "run.configuration-version"
+	ListTerraformResourceInstancesUsageIncludeRunCostEstimate                          = "run.cost-estimate"
+	ListTerraformResourceInstancesUsageIncludeRunCreatedBy                             = "run.created-by"
+	ListTerraformResourceInstancesUsageIncludeRunCreatedByRun                          = "run.created-by-run"
+	ListTerraformResourceInstancesUsageIncludeRunEnvironment                           = "run.environment"
+	ListTerraformResourceInstancesUsageIncludeRunPlan                                  = "run.plan"
+	ListTerraformResourceInstancesUsageIncludeRunPolicyChecks                          = "run.policy-checks"
+	ListTerraformResourceInstancesUsageIncludeRunStateVersions                         = "run.state-versions"
+	ListTerraformResourceInstancesUsageIncludeRunStatusTransitions                     = "run.status-transitions"
+	ListTerraformResourceInstancesUsageIncludeRunTags                                  = "run.tags"
+	ListTerraformResourceInstancesUsageIncludeRunVcsRevision                           = "run.vcs-revision"
+	ListTerraformResourceInstancesUsageIncludeRunWorkspace                             = "run.workspace"
+	ListTerraformResourceInstancesUsageIncludeStateVersion                             = "state-version"
+	ListTerraformResourceInstancesUsageIncludeStateVersionNextStateVersion             = "state-version.next-state-version"
+	ListTerraformResourceInstancesUsageIncludeStateVersionPreviousStateVersion         = "state-version.previous-state-version"
+	ListTerraformResourceInstancesUsageIncludeStateVersionRun                          = "state-version.run"
+	ListTerraformResourceInstancesUsageIncludeStateVersionWorkspace                    = "state-version.workspace"
+	ListTerraformResourceInstancesUsageIncludeWorkspace                                = "workspace"
+	ListTerraformResourceInstancesUsageIncludeWorkspaceAgentPool                       = "workspace.agent-pool"
+	ListTerraformResourceInstancesUsageIncludeWorkspaceConfigurationVersion            = "workspace.configuration-version"
+	ListTerraformResourceInstancesUsageIncludeWorkspaceCreatedBy                       = "workspace.created-by"
+	ListTerraformResourceInstancesUsageIncludeWorkspaceCurrentRun                      = "workspace.current-run"
+	ListTerraformResourceInstancesUsageIncludeWorkspaceDriftReport                     = "workspace.drift-report"
+	ListTerraformResourceInstancesUsageIncludeWorkspaceEnvironment                     = "workspace.environment"
+	ListTerraformResourceInstancesUsageIncludeWorkspaceLatestConfigurationVersion      = "workspace.latest-configuration-version"
+	ListTerraformResourceInstancesUsageIncludeWorkspaceLatestRun                       = "workspace.latest-run"
+	ListTerraformResourceInstancesUsageIncludeWorkspaceLockedBy                        = "workspace.locked-by"
+	ListTerraformResourceInstancesUsageIncludeWorkspaceLockedByRun                     = "workspace.locked-by-run"
+	ListTerraformResourceInstancesUsageIncludeWorkspaceModule                          = "workspace.module"
+	ListTerraformResourceInstancesUsageIncludeWorkspaceModuleVersion                   = "workspace.module-version"
+	ListTerraformResourceInstancesUsageIncludeWorkspaceReadmeId                        = "workspace.readme-id"
+	ListTerraformResourceInstancesUsageIncludeWorkspaceSshKey                          = "workspace.ssh-key"
+	ListTerraformResourceInstancesUsageIncludeWorkspaceTags                            = "workspace.tags"
+	ListTerraformResourceInstancesUsageIncludeWorkspaceUpdatedBy                       = "workspace.updated-by"
+	ListTerraformResourceInstancesUsageIncludeWorkspaceVcsProvider                     = "workspace.vcs-provider"
+)
@@ -48,8 +48,7 @@ func (c *Client) ListTerraformVersionsUsageRaw(ctx context.Context, opts *ListTe
 		if len(opts.Sort) > 0 {
 			params.Set("sort", strings.Join(opts.Sort, ","))
 		}
-		// Handle parameter: Fields (map[string]interface{})
-		// Complex type map[string]interface{} - skip for now
+		opts.Fields.Encode(params)
 		if len(opts.Include) > 0 {
 			params.Set("include", strings.Join(opts.Include, ","))
 		}
@@ -82,19 +81,18 @@ func (c *Client) ListTerraformVersionsUsage(ctx context.Context, opts *ListTerra
 		Meta struct {
 			Pagination *client.Pagination `json:"pagination"`
 		} `json:"meta"`
-		Included []map[string]interface{} `json:"included"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
+	// Index included resources once to resolve the relationships
+	included := client.NewIncluded(result.Included)
 	resources := make([]*schemas.TerraformVersionUsage, len(result.Data))
 	for i := range result.Data {
 		resources[i] = &result.Data[i]
-		// Populate included resources into relationships
-		if len(result.Included) > 0 {
-			resources[i].Relationships.PopulateIncludes(result.Included)
-		}
+		resources[i].ResolveIncludes(included)
 	}
 	return resources, nil
 }
@@ -103,6 +101,7 @@ func (c *Client) ListTerraformVersionsUsage(ctx context.Context, opts *ListTerra
 // This is the recommended and simple way to iterate through all the results.
 //
 // The iterator automatically fetches pages as needed and handles errors inline.
+// Pages are fetched ahead in the background when the client is created with client.WithPrefetch.
 //
 // Example:
 //
@@ -114,73 +113,23 @@ func (c *Client) ListTerraformVersionsUsage(ctx context.Context, opts *ListTerra
 //	}
 func (c *Client) ListTerraformVersionsUsageIter(ctx context.Context, opts *ListTerraformVersionsUsageOptions) iter.Seq2[schemas.TerraformVersionUsage, error] {
 	return func(yield func(schemas.TerraformVersionUsage, error) bool) {
-		// Determine page size from opts or use default
-		pageSize := 20
-		if opts != nil && opts.PageSize > 0 {
-			pageSize = opts.PageSize
-		}
-
-		pageNum := 1
-
-		for {
-			// Check context
-			if err := ctx.Err(); err != nil {
-				yield(schemas.TerraformVersionUsage{}, err)
-				return
-			}
-
-			// Copy options and set page number
-			pageOpts := &ListTerraformVersionsUsageOptions{}
-			if opts != nil {
-				*pageOpts = *opts
-			}
-			pageOpts.PageNumber = pageNum
-			pageOpts.PageSize = pageSize
-
-			// Fetch page using Raw method to get pagination metadata
-			resp, err := c.ListTerraformVersionsUsageRaw(ctx, pageOpts)
-			if err != nil {
-				yield(schemas.TerraformVersionUsage{}, err)
-				return
-			}
-			defer resp.Body.Close()
-
-			// Decode response
-			var result struct {
-				Data []schemas.TerraformVersionUsage `json:"data"`
-				Meta struct {
-					Pagination *client.Pagination `json:"pagination"`
-				} `json:"meta"`
-				Included []map[string]interface{} `json:"included"`
-			}
-			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-				yield(schemas.TerraformVersionUsage{}, fmt.Errorf("failed to decode response: %w", err))
-				return
-			}
+		it := c.ListTerraformVersionsUsagePaged(ctx, opts)
+		defer it.Close() // Stops the prefetching on early exit
 
-			// Yield each item
-			for i := range result.Data {
-				// Populate included resources into relationships
-				if len(result.Included) > 0 {
-					result.Data[i].Relationships.PopulateIncludes(result.Included)
-				}
-				if !yield(result.Data[i], nil) {
-					return // Consumer requested early exit
-				}
+		for it.Next() {
+			if !yield(*it.Value(), nil) {
+				return // Consumer requested early exit
 			}
-
-			// Check if there are more pages
-			if result.Meta.Pagination == nil || result.Meta.Pagination.NextPage == nil {
-				break
-			}
-
-			pageNum++
+		}
+		if err := it.Err(); err != nil {
+			yield(schemas.TerraformVersionUsage{}, err)
 		}
 	}
 }
 
 // ListTerraformVersionsUsagePaged returns a stateful iterator with access to pagination metadata.
-// Use this when you need PageInfo(), Remaining(), or Collect() methods.
+// Use this when you need PageInfo(), Remaining(), or Collect() methods, or to resume
+// an interrupted iteration from the iterator Checkpoint().
 //
 // Example:
 //
@@ -193,6 +142,10 @@ func (c *Client) ListTerraformVersionsUsageIter(ctx context.Context, opts *ListT
 //	if err := iter.Err(); err != nil {
 //	    // Handle error
 //	}
+//
+// Resuming from a checkpoint saved by an iterator with the same options:
+//
+//	iter := client.TerraformVersionUsage.ListTerraformVersionsUsagePaged(ctx, opts).Resume(checkpoint)
 func (c *Client) ListTerraformVersionsUsagePaged(ctx context.Context, opts *ListTerraformVersionsUsageOptions) *client.Iterator[schemas.TerraformVersionUsage] {
 	// Determine page size from opts or use default
 	pageSize := 20
@@ -223,26 +176,34 @@ func (c *Client) ListTerraformVersionsUsagePaged(ctx context.Context, opts *List
 			Meta struct {
 				Pagination *client.Pagination `json:"pagination"`
 			} `json:"meta"`
-			Included []map[string]interface{} `json:"included"`
+			Included []json.RawMessage `json:"included"`
 		}
 		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 			return nil, nil, fmt.Errorf("failed to decode response: %w", err)
 		}
 
-		// Convert to slice of pointers and populate includes
+		// Convert to slice of pointers and resolve includes
+		included := client.NewIncluded(result.Included)
 		items := make([]*schemas.TerraformVersionUsage, len(result.Data))
 		for i := range result.Data {
 			items[i] = &result.Data[i]
-			// Populate included resources into relationships
-			if len(result.Included) > 0 {
-				items[i].Relationships.PopulateIncludes(result.Included)
-			}
+			items[i].ResolveIncludes(included)
 		}
 
 		return items, result.Meta.Pagination, nil
 	}
 
-	return client.NewIterator[schemas.TerraformVersionUsage](ctx, pageSize, fetchPage)
+	// Checkpoints only resume the iterators of the same listing
+	listOpts := ListTerraformVersionsUsageOptions{}
+	if opts != nil {
+		listOpts = *opts
+	}
+	listOpts.PageNumber, listOpts.PageSize = 0, 0
+	filters := client.HashOptions("ListTerraformVersionsUsage", listOpts)
+
+	return client.NewIterator[schemas.TerraformVersionUsage](ctx, pageSize, fetchPage).
+		Prefetch(c.httpClient.Prefetch()).
+		FiltersHash(filters)
 }
 
 // ListTerraformVersionsUsageOptions holds optional parameters for ListTerraformVersionsUsage
@@ -258,12 +219,50 @@ type ListTerraformVersionsUsageOptions struct {
 	// The comma-separated list of attributes.
 	Sort []string
 	// The value of the fields[resource-type] parameter is a comma-separated list that refers to the name of the fields to be returned for the resource. An empty value indicates that no fields should be returned.
-	Fields map[string]interface{}
+	Fields client.Fields
 	// The comma-separated list of relationship paths.
 	Include []string
 	Filter  map[string]string
 }
 
+// Relationship paths accepted by the Include option of ListTerraformVersionsUsage
+const (
+	ListTerraformVersionsUsageIncludeAccount                                  = "account"
+	ListTerraformVersionsUsageIncludeAccountBillingPlan                       = "account.billing-plan"
+	ListTerraformVersionsUsageIncludeAccountIdentityProvider                  = "account.identity-provider"
+	ListTerraformVersionsUsageIncludeAccountOwner                             = "account.owner"
+	ListTerraformVersionsUsageIncludeEnvironment                              = "environment"
+	ListTerraformVersionsUsageIncludeEnvironmentAccount                       = "environment.account"
+	ListTerraformVersionsUsageIncludeEnvironmentCreatedBy                     = "environment.created-by"
+	ListTerraformVersionsUsageIncludeEnvironmentDefaultProviderConfigurations = "environment.default-provider-configurations"
+	ListTerraformVersionsUsageIncludeEnvironmentDefaultWorkspaceAgentPool     = "environment.default-workspace-agent-pool"
+	ListTerraformVersionsUsageIncludeEnvironmentDriftDetectionSchedules       = "environment.drift-detection-schedules"
+	ListTerraformVersionsUsageIncludeEnvironmentLockedBy                      = "environment.locked-by"
+	ListTerraformVersionsUsageIncludeEnvironmentPolicyGroups                  = "environment.policy-groups"
+	ListTerraformVersionsUsageIncludeEnvironmentProviderConfigurations        = "environment.provider-configurations"
+	ListTerraformVersionsUsageIncludeEnvironmentStorageProfile                = "environment.storage-profile"
+	ListTerraformVersionsUsageIncludeEnvironmentTags                          = "environment.tags"
+	ListTerraformVersionsUsageIncludeEnvironmentUpdatedBy                     = "environment.updated-by"
+	ListTerraformVersionsUsageIncludeWorkspace                                = "workspace"
+	ListTerraformVersionsUsageIncludeWorkspaceAgentPool                       = "workspace.agent-pool"
+	ListTerraformVersionsUsageIncludeWorkspaceConfigurationVersion            = "workspace.configuration-version"
+	ListTerraformVersionsUsageIncludeWorkspaceCreatedBy                       = "workspace.created-by"
+	ListTerraformVersionsUsageIncludeWorkspaceCurrentRun                      = "workspace.current-run"
+	ListTerraformVersionsUsageIncludeWorkspaceDriftReport                     = "workspace.drift-report"
+	ListTerraformVersionsUsageIncludeWorkspaceEnvironment                     = "workspace.environment"
+	ListTerraformVersionsUsageIncludeWorkspaceLatestConfigurationVersion      = "workspace.latest-configuration-version"
+	ListTerraformVersionsUsageIncludeWorkspaceLatestRun                       = "workspace.latest-run"
+	ListTerraformVersionsUsageIncludeWorkspaceLockedBy                        = "workspace.locked-by"
+	ListTerraformVersionsUsageIncludeWorkspaceLockedByRun                     = "workspace.locked-by-run"
+	ListTerraformVersionsUsageIncludeWorkspaceModule                          = "workspace.module"
+	ListTerraformVersionsUsageIncludeWorkspaceModuleVersion                   = "workspace.module-version"
+	ListTerraformVersionsUsageIncludeWorkspaceReadmeId                        = "workspace.readme-id"
+	ListTerraformVersionsUsageIncludeWorkspaceSshKey                          = "workspace.ssh-key"
+	ListTerraformVersionsUsageIncludeWorkspaceTags                            = "workspace.tags"
+	ListTerraformVersionsUsageIncludeWorkspaceUpdatedBy                       = "workspace.updated-by"
+	ListTerraformVersionsUsageIncludeWorkspaceVcsProvider                     = "workspace.vcs-provider"
+)
+
 // This endpoint lists unique terraform versions in use.
 func (c *Client) ListTerraformVersionsUsageVersionsRaw(ctx context.Context, opts *ListTerraformVersionsUsageVersionsOptions) (*client.Response, error) {
 	path := "/reports/tf-versions-usage-versions"
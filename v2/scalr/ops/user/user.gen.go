@@ -60,17 +60,15 @@ func (c *Client) CreateUser(ctx context.Context, req *schemas.CreateUserRequest,
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.CreateUser       `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.CreateUser `json:"data"`
+		Included []json.RawMessage  `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -81,6 +79,12 @@ type CreateUserOptions struct {
 	Filter  map[string]string
 }
 
+// Relationship paths accepted by the Include option of CreateUser
+const (
+	CreateUserIncludeIdentityProviders        = "identity-providers"
+	CreateUserIncludeIdentityProvidersAccount = "identity-providers.account"
+)
+
 // The endpoint deletes [IAM](https://docs.scalr.io/docs/identity-and-access-management) user by ID.
 func (c *Client) DeleteUserRaw(ctx context.Context, user string) (*client.Response, error) {
 	path := "/users/{user}"
@@ -155,19 +159,18 @@ func (c *Client) GetAccountUsers(ctx context.Context, opts *GetAccountUsersOptio
 		Meta struct {
 			Pagination *client.Pagination `json:"pagination"`
 		} `json:"meta"`
-		Included []map[string]interface{} `json:"included"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
+	// Index included resources once to resolve the relationships
+	included := client.NewIncluded(result.Included)
 	resources := make([]*schemas.AccountUser, len(result.Data))
 	for i := range result.Data {
 		resources[i] = &result.Data[i]
-		// Populate included resources into relationships
-		if len(result.Included) > 0 {
-			resources[i].Relationships.PopulateIncludes(result.Included)
-		}
+		resources[i].ResolveIncludes(included)
 	}
 	return resources, nil
 }
@@ -176,6 +179,7 @@ func (c *Client) GetAccountUsers(ctx context.Context, opts *GetAccountUsersOptio
 // This is the recommended and simple way to iterate through all the results.
 //
 // The iterator automatically fetches pages as needed and handles errors inline.
+// Pages are fetched ahead in the background when the client is created with client.WithPrefetch.
 //
 // Example:
 //
@@ -187,73 +191,23 @@ func (c *Client) GetAccountUsers(ctx context.Context, opts *GetAccountUsersOptio
 //	}
 func (c *Client) GetAccountUsersIter(ctx context.Context, opts *GetAccountUsersOptions) iter.Seq2[schemas.AccountUser, error] {
 	return func(yield func(schemas.AccountUser, error) bool) {
-		// Determine page size from opts or use default
-		pageSize := 20
-		if opts != nil && opts.PageSize > 0 {
-			pageSize = opts.PageSize
-		}
-
-		pageNum := 1
-
-		for {
-			// Check context
-			if err := ctx.Err(); err != nil {
-				yield(schemas.AccountUser{}, err)
-				return
-			}
-
-			// Copy options and set page number
-			pageOpts := &GetAccountUsersOptions{}
-			if opts != nil {
-				*pageOpts = *opts
-			}
-			pageOpts.PageNumber = pageNum
-			pageOpts.PageSize = pageSize
-
-			// Fetch page using Raw method to get pagination metadata
-			resp, err := c.GetAccountUsersRaw(ctx, pageOpts)
-			if err != nil {
-				yield(schemas.AccountUser{}, err)
-				return
-			}
-			defer resp.Body.Close()
-
-			// Decode response
-			var result struct {
-				Data []schemas.AccountUser `json:"data"`
-				Meta struct {
-					Pagination *client.Pagination `json:"pagination"`
-				} `json:"meta"`
-				Included []map[string]interface{} `json:"included"`
-			}
-			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-				yield(schemas.AccountUser{}, fmt.Errorf("failed to decode response: %w", err))
-				return
-			}
-
-			// Yield each item
-			for i := range result.Data {
-				// Populate included resources into relationships
-				if len(result.Included) > 0 {
-					result.Data[i].Relationships.PopulateIncludes(result.Included)
-				}
-				if !yield(result.Data[i], nil) {
-					return // Consumer requested early exit
-				}
-			}
+		it := c.GetAccountUsersPaged(ctx, opts)
+		defer it.Close() // Stops the prefetching on early exit
 
-			// Check if there are more pages
-			if result.Meta.Pagination == nil || result.Meta.Pagination.NextPage == nil {
-				break
+		for it.Next() {
+			if !yield(*it.Value(), nil) {
+				return // Consumer requested early exit
 			}
-
-			pageNum++
+		}
+		if err := it.Err(); err != nil {
+			yield(schemas.AccountUser{}, err)
 		}
 	}
 }
 
 // GetAccountUsersPaged returns a stateful iterator with access to pagination metadata.
-// Use this when you need PageInfo(), Remaining(), or Collect() methods.
+// Use this when you need PageInfo(), Remaining(), or Collect() methods, or to resume
+// an interrupted iteration from the iterator Checkpoint().
 //
 // Example:
 //
@@ -266,6 +220,10 @@ func (c *Client) GetAccountUsersIter(ctx context.Context, opts *GetAccountUsersO
 //	if err := iter.Err(); err != nil {
 //	    // Handle error
 //	}
+//
+// Resuming from a checkpoint saved by an iterator with the same options:
+//
+//	iter := client.User.GetAccountUsersPaged(ctx, opts).Resume(checkpoint)
 func (c *Client) GetAccountUsersPaged(ctx context.Context, opts *GetAccountUsersOptions) *client.Iterator[schemas.AccountUser] {
 	// Determine page size from opts or use default
 	pageSize := 20
@@ -296,26 +254,34 @@ func (c *Client) GetAccountUsersPaged(ctx context.Context, opts *GetAccountUsers
 			Meta struct {
 				Pagination *client.Pagination `json:"pagination"`
 			} `json:"meta"`
-			Included []map[string]interface{} `json:"included"`
+			Included []json.RawMessage `json:"included"`
 		}
 		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 			return nil, nil, fmt.Errorf("failed to decode response: %w", err)
 		}
 
-		// Convert to slice of pointers and populate includes
+		// Convert to slice of pointers and resolve includes
+		included := client.NewIncluded(result.Included)
 		items := make([]*schemas.AccountUser, len(result.Data))
 		for i := range result.Data {
 			items[i] = &result.Data[i]
-			// Populate included resources into relationships
-			if len(result.Included) > 0 {
-				items[i].Relationships.PopulateIncludes(result.Included)
-			}
+			items[i].ResolveIncludes(included)
 		}
 
 		return items, result.Meta.Pagination, nil
 	}
 
-	return client.NewIterator[schemas.AccountUser](ctx, pageSize, fetchPage)
+	// Checkpoints only resume the iterators of the same listing
+	listOpts := GetAccountUsersOptions{}
+	if opts != nil {
+		listOpts = *opts
+	}
+	listOpts.PageNumber, listOpts.PageSize = 0, 0
+	filters := client.HashOptions("GetAccountUsers", listOpts)
+
+	return client.NewIterator[schemas.AccountUser](ctx, pageSize, fetchPage).
+		Prefetch(c.httpClient.Prefetch()).
+		FiltersHash(filters)
 }
 
 // GetAccountUsersOptions holds optional parameters for GetAccountUsers
@@ -333,6 +299,21 @@ type GetAccountUsersOptions struct {
 	Filter  map[string]string
 }
 
+// Relationship paths accepted by the Include option of GetAccountUsers
+const (
+	GetAccountUsersIncludeAccount                 = "account"
+	GetAccountUsersIncludeAccountBillingPlan      = "account.billing-plan"
+	GetAccountUsersIncludeAccountIdentityProvider = "account.identity-provider"
+	GetAccountUsersIncludeAccountOwner            = "account.owner"
+	GetAccountUsersIncludeTeams                   = "teams"
+	GetAccountUsersIncludeTeamsAccount            = "teams.account"
+	GetAccountUsersIncludeTeamsIdentityProvider   = "teams.identity-provider"
+	GetAccountUsersIncludeTeamsUsers              = "teams.users"
+	GetAccountUsersIncludeUser                    = "user"
+	GetAccountUsersIncludeUserIdentityProviders   = "user.identity-providers"
+	GetAccountUsersIncludeUserTeams               = "user.teams"
+)
+
 // This endpoint returns an [IAM](https://docs.scalr.io/docs/identity-and-access-management) user by ID.
 func (c *Client) GetUserRaw(ctx context.Context, user string, opts *GetUserOptions) (*client.Response, error) {
 	path := "/users/{user}"
@@ -368,17 +349,15 @@ func (c *Client) GetUser(ctx context.Context, user string, opts *GetUserOptions)
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.User             `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.User      `json:"data"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -389,6 +368,16 @@ type GetUserOptions struct {
 	Filter  map[string]string
 }
 
+// Relationship paths accepted by the Include option of GetUser
+const (
+	GetUserIncludeIdentityProviders        = "identity-providers"
+	GetUserIncludeIdentityProvidersAccount = "identity-providers.account"
+	GetUserIncludeTeams                    = "teams"
+	GetUserIncludeTeamsAccount             = "teams.account"
+	GetUserIncludeTeamsIdentityProvider    = "teams.identity-provider"
+	GetUserIncludeTeamsUsers               = "teams.users"
+)
+
 // This endpoint returns a list of [IAM](https://docs.scalr.io/docs/identity-and-access-management) users. The response can be filtered by IdP: `filter[identity-provider]` or user ID: `filter[user]`.
 func (c *Client) GetUsersRaw(ctx context.Context, opts *GetUsersOptions) (*client.Response, error) {
 	path := "/users"
@@ -444,19 +433,18 @@ func (c *Client) GetUsers(ctx context.Context, opts *GetUsersOptions) ([]*schema
 		Meta struct {
 			Pagination *client.Pagination `json:"pagination"`
 		} `json:"meta"`
-		Included []map[string]interface{} `json:"included"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
+	// Index included resources once to resolve the relationships
+	included := client.NewIncluded(result.Included)
 	resources := make([]*schemas.User, len(result.Data))
 	for i := range result.Data {
 		resources[i] = &result.Data[i]
-		// Populate included resources into relationships
-		if len(result.Included) > 0 {
-			resources[i].Relationships.PopulateIncludes(result.Included)
-		}
+		resources[i].ResolveIncludes(included)
 	}
 	return resources, nil
 }
@@ -465,6 +453,7 @@ func (c *Client) GetUsers(ctx context.Context, opts *GetUsersOptions) ([]*schema
 // This is the recommended and simple way to iterate through all the results.
 //
 // The iterator automatically fetches pages as needed and handles errors inline.
+// Pages are fetched ahead in the background when the client is created with client.WithPrefetch.
 //
 // Example:
 //
@@ -476,73 +465,23 @@ func (c *Client) GetUsers(ctx context.Context, opts *GetUsersOptions) ([]*schema
 //	}
 func (c *Client) GetUsersIter(ctx context.Context, opts *GetUsersOptions) iter.Seq2[schemas.User, error] {
 	return func(yield func(schemas.User, error) bool) {
-		// Determine page size from opts or use default
-		pageSize := 20
-		if opts != nil && opts.PageSize > 0 {
-			pageSize = opts.PageSize
-		}
-
-		pageNum := 1
-
-		for {
-			// Check context
-			if err := ctx.Err(); err != nil {
-				yield(schemas.User{}, err)
-				return
-			}
-
-			// Copy options and set page number
-			pageOpts := &GetUsersOptions{}
-			if opts != nil {
-				*pageOpts = *opts
-			}
-			pageOpts.PageNumber = pageNum
-			pageOpts.PageSize = pageSize
-
-			// Fetch page using Raw method to get pagination metadata
-			resp, err := c.GetUsersRaw(ctx, pageOpts)
-			if err != nil {
-				yield(schemas.User{}, err)
-				return
-			}
-			defer resp.Body.Close()
-
-			// Decode response
-			var result struct {
-				Data []schemas.User `json:"data"`
-				Meta struct {
-					Pagination *client.Pagination `json:"pagination"`
-				} `json:"meta"`
-				Included []map[string]interface{} `json:"included"`
-			}
-			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-				yield(schemas.User{}, fmt.Errorf("failed to decode response: %w", err))
-				return
-			}
+		it := c.GetUsersPaged(ctx, opts)
+		defer it.Close() // Stops the prefetching on early exit
 
-			// Yield each item
-			for i := range result.Data {
-				// Populate included resources into relationships
-				if len(result.Included) > 0 {
-					result.Data[i].Relationships.PopulateIncludes(result.Included)
-				}
-				if !yield(result.Data[i], nil) {
-					return // Consumer requested early exit
-				}
+		for it.Next() {
+			if !yield(*it.Value(), nil) {
+				return // Consumer requested early exit
 			}
-
-			// Check if there are more pages
-			if result.Meta.Pagination == nil || result.Meta.Pagination.NextPage == nil {
-				break
-			}
-
-			pageNum++
+		}
+		if err := it.Err(); err != nil {
+			yield(schemas.User{}, err)
 		}
 	}
 }
 
 // GetUsersPaged returns a stateful iterator with access to pagination metadata.
-// Use this when you need PageInfo(), Remaining(), or Collect() methods.
+// Use this when you need PageInfo(), Remaining(), or Collect() methods, or to resume
+// an interrupted iteration from the iterator Checkpoint().
 //
 // Example:
 //
@@ -555,6 +494,10 @@ func (c *Client) GetUsersIter(ctx context.Context, opts *GetUsersOptions) iter.S
 //	if err := iter.Err(); err != nil {
 //	    // Handle error
 //	}
+//
+// Resuming from a checkpoint saved by an iterator with the same options:
+//
+//	iter := client.User.GetUsersPaged(ctx, opts).Resume(checkpoint)
 func (c *Client) GetUsersPaged(ctx context.Context, opts *GetUsersOptions) *client.Iterator[schemas.User] {
 	// Determine page size from opts or use default
 	pageSize := 20
@@ -585,26 +528,34 @@ func (c *Client) GetUsersPaged(ctx context.Context, opts *GetUsersOptions) *clie
 			Meta struct {
 				Pagination *client.Pagination `json:"pagination"`
 			} `json:"meta"`
-			Included []map[string]interface{} `json:"included"`
+			Included []json.RawMessage `json:"included"`
 		}
 		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 			return nil, nil, fmt.Errorf("failed to decode response: %w", err)
 		}
 
-		// Convert to slice of pointers and populate includes
+		// Convert to slice of pointers and resolve includes
+		included := client.NewIncluded(result.Included)
 		items := make([]*schemas.User, len(result.Data))
 		for i := range result.Data {
 			items[i] = &result.Data[i]
-			// Populate included resources into relationships
-			if len(result.Included) > 0 {
-				items[i].Relationships.PopulateIncludes(result.Included)
-			}
+			items[i].ResolveIncludes(included)
 		}
 
 		return items, result.Meta.Pagination, nil
 	}
 
-	return client.NewIterator[schemas.User](ctx, pageSize, fetchPage)
+	// Checkpoints only resume the iterators of the same listing
+	listOpts := GetUsersOptions{}
+	if opts != nil {
+		listOpts = *opts
+	}
+	listOpts.PageNumber, listOpts.PageSize = 0, 0
+	filters := client.HashOptions("GetUsers", listOpts)
+
+	return client.NewIterator[schemas.User](ctx, pageSize, fetchPage).
+		Prefetch(c.httpClient.Prefetch()).
+		FiltersHash(filters)
 }
 
 // GetUsersOptions holds optional parameters for GetUsers
@@ -624,6 +575,16 @@ type GetUsersOptions struct {
 	Filter      map[string]string
 }
 
+// Relationship paths accepted by the Include option of GetUsers
+const (
+	GetUsersIncludeIdentityProviders        = "identity-providers"
+	GetUsersIncludeIdentityProvidersAccount = "identity-providers.account"
+	GetUsersIncludeTeams                    = "teams"
+	GetUsersIncludeTeamsAccount             = "teams.account"
+	GetUsersIncludeTeamsIdentityProvider    = "teams.identity-provider"
+	GetUsersIncludeTeamsUsers               = "teams.users"
+)
+
 // Invite the user to the account by adding it to the account teams and/or creating access policies within the account. If the user with a specified email does not exist - a new one will be created. The new user will be in the 'pending' status until the first login to the account. This is the preferred way to create users.
 func (c *Client) InviteUserToAccountRaw(ctx context.Context, account string, req *schemas.UserInviteRequest, opts *InviteUserToAccountOptions) (*client.Response, error) {
 	path := "/accounts/{account}/actions/invite"
@@ -661,17 +622,15 @@ func (c *Client) InviteUserToAccount(ctx context.Context, account string, req *s
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.AccountUser      `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.AccountUser `json:"data"`
+		Included []json.RawMessage   `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -682,6 +641,21 @@ type InviteUserToAccountOptions struct {
 	Filter  map[string]string
 }
 
+// Relationship paths accepted by the Include option of InviteUserToAccount
+const (
+	InviteUserToAccountIncludeAccount                 = "account"
+	InviteUserToAccountIncludeAccountBillingPlan      = "account.billing-plan"
+	InviteUserToAccountIncludeAccountIdentityProvider = "account.identity-provider"
+	InviteUserToAccountIncludeAccountOwner            = "account.owner"
+	InviteUserToAccountIncludeTeams                   = "teams"
+	InviteUserToAccountIncludeTeamsAccount            = "teams.account"
+	InviteUserToAccountIncludeTeamsIdentityProvider   = "teams.identity-provider"
+	InviteUserToAccountIncludeTeamsUsers              = "teams.users"
+	InviteUserToAccountIncludeUser                    = "user"
+	InviteUserToAccountIncludeUserIdentityProviders   = "user.identity-providers"
+	InviteUserToAccountIncludeUserTeams               = "user.teams"
+)
+
 // Removes a user from the account. This revokes all access policies and team memberships associated with the account for that user.
 func (c *Client) RemoveUserFromAccountRaw(ctx context.Context, account string, user string) (*client.Response, error) {
 	path := "/accounts/{account}/actions/remove/{user}"
@@ -743,17 +717,15 @@ func (c *Client) UpdateUser(ctx context.Context, user string, req *schemas.UserR
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.User             `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.User      `json:"data"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -763,3 +735,13 @@ type UpdateUserOptions struct {
 	Include []string
 	Filter  map[string]string
 }
+
+// Relationship paths accepted by the Include option of UpdateUser
+const (
+	UpdateUserIncludeIdentityProviders        = "identity-providers"
+	UpdateUserIncludeIdentityProvidersAccount = "identity-providers.account"
+	UpdateUserIncludeTeams                    = "teams"
+	UpdateUserIncludeTeamsAccount             = "teams.account"
+	UpdateUserIncludeTeamsIdentityProvider    = "teams.identity-provider"
+	UpdateUserIncludeTeamsUsers               = "teams.users"
+)
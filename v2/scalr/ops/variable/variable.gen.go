@@ -62,17 +62,15 @@ func (c *Client) CreateVariable(ctx context.Context, req *schemas.VariableReques
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.Variable         `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.Variable  `json:"data"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -85,6 +83,47 @@ type CreateVariableOptions struct {
 	Filter  map[string]string
 }
 
+// Relationship paths accepted by the Include option of CreateVariable
+const (
+	CreateVariableIncludeAccount                                  = "account"
+	CreateVariableIncludeAccountBillingPlan                       = "account.billing-plan"
+	CreateVariableIncludeAccountIdentityProvider                  = "account.identity-provider"
+	CreateVariableIncludeAccountOwner                             = "account.owner"
+	CreateVariableIncludeEnvironment                              = "environment"
+	CreateVariableIncludeEnvironmentAccount                       = "environment.account"
+	CreateVariableIncludeEnvironmentCreatedBy                     = "environment.created-by"
+	CreateVariableIncludeEnvironmentDefaultProviderConfigurations = "environment.default-provider-configurations"
+	CreateVariableIncludeEnvironmentDefaultWorkspaceAgentPool     = "environment.default-workspace-agent-pool"
+	CreateVariableIncludeEnvironmentDriftDetectionSchedules       = "environment.drift-detection-schedules"
+	CreateVariableIncludeEnvironmentLockedBy                      = "environment.locked-by"
+	CreateVariableIncludeEnvironmentPolicyGroups                  = "environment.policy-groups"
+	CreateVariableIncludeEnvironmentProviderConfigurations        = "environment.provider-configurations"
+	CreateVariableIncludeEnvironmentStorageProfile                = "environment.storage-profile"
+	CreateVariableIncludeEnvironmentTags                          = "environment.tags"
+	CreateVariableIncludeEnvironmentUpdatedBy                     = "environment.updated-by"
+	CreateVariableIncludeUpdatedBy                                = "updated-by"
+	CreateVariableIncludeUpdatedByIdentityProviders               = "updated-by.identity-providers"
+	CreateVariableIncludeUpdatedByTeams                           = "updated-by.teams"
+	CreateVariableIncludeWorkspace                                = "workspace"
+	CreateVariableIncludeWorkspaceAgentPool                       = "workspace.agent-pool"
+	CreateVariableIncludeWorkspaceConfigurationVersion            = "workspace.configuration-version"
+	CreateVariableIncludeWorkspaceCreatedBy                       = "workspace.created-by"
+	CreateVariableIncludeWorkspaceCurrentRun                      = "workspace.current-run"
+	CreateVariableIncludeWorkspaceDriftReport                     = "workspace.drift-report"
+	CreateVariableIncludeWorkspaceEnvironment                     = "workspace.environment"
+	CreateVariableIncludeWorkspaceLatestConfigurationVersion      = "workspace.latest-configuration-version"
+	CreateVariableIncludeWorkspaceLatestRun                       = "workspace.latest-run"
+	CreateVariableIncludeWorkspaceLockedBy                        = "workspace.locked-by"
+	CreateVariableIncludeWorkspaceLockedByRun                     = "workspace.locked-by-run"
+	CreateVariableIncludeWorkspaceModule                          = "workspace.module"
+	CreateVariableIncludeWorkspaceModuleVersion                   = "workspace.module-version"
+	CreateVariableIncludeWorkspaceReadmeId                        = "workspace.readme-id"
+	CreateVariableIncludeWorkspaceSshKey                          = "workspace.ssh-key"
+	CreateVariableIncludeWorkspaceTags                            = "workspace.tags"
+	CreateVariableIncludeWorkspaceUpdatedBy                       = "workspace.updated-by"
+	CreateVariableIncludeWorkspaceVcsProvider                     = "workspace.vcs-provider"
+)
+
 func (c *Client) DeleteVariableRaw(ctx context.Context, var_ string) (*client.Response, error) {
 	path := "/vars/{var}"
 	path = strings.ReplaceAll(path, "{var}", url.PathEscape(var_))
@@ -116,8 +155,7 @@ func (c *Client) GetVariableRaw(ctx context.Context, var_ string, opts *GetVaria
 		if len(opts.Include) > 0 {
 			params.Set("include", strings.Join(opts.Include, ","))
 		}
-		// Handle parameter: Fields (map[string]interface{})
-		// Complex type map[string]interface{} - skip for now
+		opts.Fields.Encode(params)
 		// Add filters
 		for k, v := range opts.Filter {
 			params.Set("filter["+k+"]", v)
@@ -143,17 +181,15 @@ func (c *Client) GetVariable(ctx context.Context, var_ string, opts *GetVariable
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.Variable         `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.Variable  `json:"data"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -162,10 +198,51 @@ type GetVariableOptions struct {
 	// The comma-separated list of relationship paths.
 	Include []string
 	// The value of the fields[resource-type] parameter is a comma-separated list that refers to the name of the fields to be returned for the resource. An empty value indicates that no fields should be returned.
-	Fields map[string]interface{}
+	Fields client.Fields
 	Filter map[string]string
 }
 
+// Relationship paths accepted by the Include option of GetVariable
+const (
+	GetVariableIncludeAccount                                  = "account"
+	GetVariableIncludeAccountBillingPlan                       = "account.billing-plan"
+	GetVariableIncludeAccountIdentityProvider                  = "account.identity-provider"
+	GetVariableIncludeAccountOwner                             = "account.owner"
+	GetVariableIncludeEnvironment                              = "environment"
+	GetVariableIncludeEnvironmentAccount                       = "environment.account"
+	GetVariableIncludeEnvironmentCreatedBy                     = "environment.created-by"
+	GetVariableIncludeEnvironmentDefaultProviderConfigurations = "environment.default-provider-configurations"
+	GetVariableIncludeEnvironmentDefaultWorkspaceAgentPool     = "environment.default-workspace-agent-pool"
+	GetVariableIncludeEnvironmentDriftDetectionSchedules       = "environment.drift-detection-schedules"
+	GetVariableIncludeEnvironmentLockedBy                      = "environment.locked-by"
+	GetVariableIncludeEnvironmentPolicyGroups                  = "environment.policy-groups"
+	GetVariableIncludeEnvironmentProviderConfigurations        = "environment.provider-configurations"
+	GetVariableIncludeEnvironmentStorageProfile                = "environment.storage-profile"
+	GetVariableIncludeEnvironmentTags                          = "environment.tags"
+	GetVariableIncludeEnvironmentUpdatedBy                     = "environment.updated-by"
+	GetVariableIncludeUpdatedBy                                = "updated-by"
+	GetVariableIncludeUpdatedByIdentityProviders               = "updated-by.identity-providers"
+	GetVariableIncludeUpdatedByTeams                           = "updated-by.teams"
+	GetVariableIncludeWorkspace                                = "workspace"
+	GetVariableIncludeWorkspaceAgentPool                       = "workspace.agent-pool"
+	GetVariableIncludeWorkspaceConfigurationVersion            = "workspace.configuration-version"
+	GetVariableIncludeWorkspaceCreatedBy                       = "workspace.created-by"
+	GetVariableIncludeWorkspaceCurrentRun                      = "workspace.current-run"
+	GetVariableIncludeWorkspaceDriftReport                     = "workspace.drift-report"
+	GetVariableIncludeWorkspaceEnvironment                     = "workspace.environment"
+	GetVariableIncludeWorkspaceLatestConfigurationVersion      = "workspace.latest-configuration-version"
+	GetVariableIncludeWorkspaceLatestRun                       = "workspace.latest-run"
+	GetVariableIncludeWorkspaceLockedBy                        = "workspace.locked-by"
+	GetVariableIncludeWorkspaceLockedByRun                     = "workspace.locked-by-run"
+	GetVariableIncludeWorkspaceModule                          = "workspace.module"
+	GetVariableIncludeWorkspaceModuleVersion                   = "workspace.module-version"
+	GetVariableIncludeWorkspaceReadmeId                        = "workspace.readme-id"
+	GetVariableIncludeWorkspaceSshKey                          = "workspace.ssh-key"
+	GetVariableIncludeWorkspaceTags                            = "workspace.tags"
+	GetVariableIncludeWorkspaceUpdatedBy                       = "workspace.updated-by"
+	GetVariableIncludeWorkspaceVcsProvider                     = "workspace.vcs-provider"
+)
+
 // This endpoint returns a list of variables. Cloud Credentials are exposed as Terraform ENV Variables if filters are specified.
 func (c *Client) GetVariablesRaw(ctx context.Context, opts *GetVariablesOptions) (*client.Response, error) {
 	path := "/vars"
@@ -213,19 +290,18 @@ func (c *Client) GetVariables(ctx context.Context, opts *GetVariablesOptions) ([
 		Meta struct {
 			Pagination *client.Pagination `json:"pagination"`
 		} `json:"meta"`
-		Included []map[string]interface{} `json:"included"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
+	// Index included resources once to resolve the relationships
+	included := client.NewIncluded(result.Included)
 	resources := make([]*schemas.Variable, len(result.Data))
 	for i := range result.Data {
 		resources[i] = &result.Data[i]
-		// Populate included resources into relationships
-		if len(result.Included) > 0 {
-			resources[i].Relationships.PopulateIncludes(result.Included)
-		}
+		resources[i].ResolveIncludes(included)
 	}
 	return resources, nil
 }
@@ -234,6 +310,7 @@ func (c *Client) GetVariables(ctx context.Context, opts *GetVariablesOptions) ([
 // This is the recommended and simple way to iterate through all the results.
 //
 // The iterator automatically fetches pages as needed and handles errors inline.
+// Pages are fetched ahead in the background when the client is created with client.WithPrefetch.
 //
 // Example:
 //
@@ -245,73 +322,23 @@ func (c *Client) GetVariables(ctx context.Context, opts *GetVariablesOptions) ([
 //	}
 func (c *Client) GetVariablesIter(ctx context.Context, opts *GetVariablesOptions) iter.Seq2[schemas.Variable, error] {
 	return func(yield func(schemas.Variable, error) bool) {
-		// Determine page size from opts or use default
-		pageSize := 20
-		if opts != nil && opts.PageSize > 0 {
-			pageSize = opts.PageSize
-		}
-
-		pageNum := 1
-
-		for {
-			// Check context
-			if err := ctx.Err(); err != nil {
-				yield(schemas.Variable{}, err)
-				return
-			}
-
-			// Copy options and set page number
-			pageOpts := &GetVariablesOptions{}
-			if opts != nil {
-				*pageOpts = *opts
-			}
-			pageOpts.PageNumber = pageNum
-			pageOpts.PageSize = pageSize
-
-			// Fetch page using Raw method to get pagination metadata
-			resp, err := c.GetVariablesRaw(ctx, pageOpts)
-			if err != nil {
-				yield(schemas.Variable{}, err)
-				return
-			}
-			defer resp.Body.Close()
-
-			// Decode response
-			var result struct {
-				Data []schemas.Variable `json:"data"`
-				Meta struct {
-					Pagination *client.Pagination `json:"pagination"`
-				} `json:"meta"`
-				Included []map[string]interface{} `json:"included"`
-			}
-			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-				yield(schemas.Variable{}, fmt.Errorf("failed to decode response: %w", err))
-				return
-			}
+		it := c.GetVariablesPaged(ctx, opts)
+		defer it.Close() // Stops the prefetching on early exit
 
-			// Yield each item
-			for i := range result.Data {
-				// Populate included resources into relationships
-				if len(result.Included) > 0 {
-					result.Data[i].Relationships.PopulateIncludes(result.Included)
-				}
-				if !yield(result.Data[i], nil) {
-					return // Consumer requested early exit
-				}
+		for it.Next() {
+			if !yield(*it.Value(), nil) {
+				return // Consumer requested early exit
 			}
-
-			// Check if there are more pages
-			if result.Meta.Pagination == nil || result.Meta.Pagination.NextPage == nil {
-				break
-			}
-
-			pageNum++
+		}
+		if err := it.Err(); err != nil {
+			yield(schemas.Variable{}, err)
 		}
 	}
 }
 
 // GetVariablesPaged returns a stateful iterator with access to pagination metadata.
-// Use this when you need PageInfo(), Remaining(), or Collect() methods.
+// Use this when you need PageInfo(), Remaining(), or Collect() methods, or to resume
+// an interrupted iteration from the iterator Checkpoint().
 //
 // Example:
 //
@@ -324,6 +351,10 @@ func (c *Client) GetVariablesIter(ctx context.Context, opts *GetVariablesOptions
 //	if err := iter.Err(); err != nil {
 //	    // Handle error
 //	}
+//
+// Resuming from a checkpoint saved by an iterator with the same options:
+//
+//	iter := client.Variable.GetVariablesPaged(ctx, opts).Resume(checkpoint)
 func (c *Client) GetVariablesPaged(ctx context.Context, opts *GetVariablesOptions) *client.Iterator[schemas.Variable] {
 	// Determine page size from opts or use default
 	pageSize := 20
@@ -354,26 +385,34 @@ func (c *Client) GetVariablesPaged(ctx context.Context, opts *GetVariablesOption
 			Meta struct {
 				Pagination *client.Pagination `json:"pagination"`
 			} `json:"meta"`
-			Included []map[string]interface{} `json:"included"`
+			Included []json.RawMessage `json:"included"`
 		}
 		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 			return nil, nil, fmt.Errorf("failed to decode response: %w", err)
 		}
 
-		// Convert to slice of pointers and populate includes
+		// Convert to slice of pointers and resolve includes
+		included := client.NewIncluded(result.Included)
 		items := make([]*schemas.Variable, len(result.Data))
 		for i := range result.Data {
 			items[i] = &result.Data[i]
-			// Populate included resources into relationships
-			if len(result.Included) > 0 {
-				items[i].Relationships.PopulateIncludes(result.Included)
-			}
+			items[i].ResolveIncludes(included)
 		}
 
 		return items, result.Meta.Pagination, nil
 	}
 
-	return client.NewIterator[schemas.Variable](ctx, pageSize, fetchPage)
+	// Checkpoints only resume the iterators of the same listing
+	listOpts := GetVariablesOptions{}
+	if opts != nil {
+		listOpts = *opts
+	}
+	listOpts.PageNumber, listOpts.PageSize = 0, 0
+	filters := client.HashOptions("GetVariables", listOpts)
+
+	return client.NewIterator[schemas.Variable](ctx, pageSize, fetchPage).
+		Prefetch(c.httpClient.Prefetch()).
+		FiltersHash(filters)
 }
 
 // GetVariablesOptions holds optional parameters for GetVariables
@@ -389,6 +428,47 @@ type GetVariablesOptions struct {
 	Filter map[string]string
 }
 
+// Relationship paths accepted by the Include option of GetVariables
+const (
+	GetVariablesIncludeAccount                                  = "account"
+	GetVariablesIncludeAccountBillingPlan                       = "account.billing-plan"
+	GetVariablesIncludeAccountIdentityProvider                  = "account.identity-provider"
+	GetVariablesIncludeAccountOwner                             = "account.owner"
+	GetVariablesIncludeEnvironment                              = "environment"
+	GetVariablesIncludeEnvironmentAccount                       = "environment.account"
+	GetVariablesIncludeEnvironmentCreatedBy                     = "environment.created-by"
+	GetVariablesIncludeEnvironmentDefaultProviderConfigurations = "environment.default-provider-configurations"
+	GetVariablesIncludeEnvironmentDefaultWorkspaceAgentPool     = "environment.default-workspace-agent-pool"
+	GetVariablesIncludeEnvironmentDriftDetectionSchedules       = "environment.drift-detection-schedules"
+	GetVariablesIncludeEnvironmentLockedBy                      = "environment.locked-by"
+	GetVariablesIncludeEnvironmentPolicyGroups                  = "environment.policy-groups"
+	GetVariablesIncludeEnvironmentProviderConfigurations        = "environment.provider-configurations"
+	GetVariablesIncludeEnvironmentStorageProfile                = "environment.storage-profile"
+	GetVariablesIncludeEnvironmentTags                          = "environment.tags"
+	GetVariablesIncludeEnvironmentUpdatedBy                     = "environment.updated-by"
+	GetVariablesIncludeUpdatedBy                                = "updated-by"
+	GetVariablesIncludeUpdatedByIdentityProviders               = "updated-by.identity-providers"
+	GetVariablesIncludeUpdatedByTeams                           = "updated-by.teams"
+	GetVariablesIncludeWorkspace                                = "workspace"
+	GetVariablesIncludeWorkspaceAgentPool                       = "workspace.agent-pool"
+	GetVariablesIncludeWorkspaceConfigurationVersion            = "workspace.configuration-version"
+	GetVariablesIncludeWorkspaceCreatedBy                       = "workspace.created-by"
+	GetVariablesIncludeWorkspaceCurrentRun                      = "workspace.current-run"
+	GetVariablesIncludeWorkspaceDriftReport                     = "workspace.drift-report"
+	GetVariablesIncludeWorkspaceEnvironment                     = "workspace.environment"
+	GetVariablesIncludeWorkspaceLatestConfigurationVersion      = "workspace.latest-configuration-version"
+	GetVariablesIncludeWorkspaceLatestRun                       = "workspace.latest-run"
+	GetVariablesIncludeWorkspaceLockedBy                        = "workspace.locked-by"
+	GetVariablesIncludeWorkspaceLockedByRun                     = "workspace.locked-by-run"
+	GetVariablesIncludeWorkspaceModule                          = "workspace.module"
+	GetVariablesIncludeWorkspaceModuleVersion                   = "workspace.module-version"
+	GetVariablesIncludeWorkspaceReadmeId                        = "workspace.readme-id"
+	GetVariablesIncludeWorkspaceSshKey                          = "workspace.ssh-key"
+	GetVariablesIncludeWorkspaceTags                            = "workspace.tags"
+	GetVariablesIncludeWorkspaceUpdatedBy                       = "workspace.updated-by"
+	GetVariablesIncludeWorkspaceVcsProvider                     = "workspace.vcs-provider"
+)
+
 func (c *Client) UpdateVariableRaw(ctx context.Context, var_ string, req *schemas.VariableRequest, opts *UpdateVariableOptions) (*client.Response, error) {
 	path := "/vars/{var}"
 	path = strings.ReplaceAll(path, "{var}", url.PathEscape(var_))
@@ -426,17 +506,15 @@ func (c *Client) UpdateVariable(ctx context.Context, var_ string, req *schemas.V
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.Variable         `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.Variable  `json:"data"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -448,3 +526,44 @@ type UpdateVariableOptions struct {
 	Include []string
 	Filter  map[string]string
 }
+
+// Relationship paths accepted by the Include option of UpdateVariable
+const (
+	UpdateVariableIncludeAccount                                  = "account"
+	UpdateVariableIncludeAccountBillingPlan                       = "account.billing-plan"
+	UpdateVariableIncludeAccountIdentityProvider                  = "account.identity-provider"
+	UpdateVariableIncludeAccountOwner                             = "account.owner"
+	UpdateVariableIncludeEnvironment                              = "environment"
+	UpdateVariableIncludeEnvironmentAccount                       = "environment.account"
+	UpdateVariableIncludeEnvironmentCreatedBy                     = "environment.created-by"
+	UpdateVariableIncludeEnvironmentDefaultProviderConfigurations = "environment.default-provider-configurations"
+	UpdateVariableIncludeEnvironmentDefaultWorkspaceAgentPool     = "environment.default-workspace-agent-pool"
+	UpdateVariableIncludeEnvironmentDriftDetectionSchedules       = "environment.drift-detection-schedules"
+	UpdateVariableIncludeEnvironmentLockedBy                      = "environment.locked-by"
+	UpdateVariableIncludeEnvironmentPolicyGroups                  = "environment.policy-groups"
+	UpdateVariableIncludeEnvironmentProviderConfigurations        = "environment.provider-configurations"
+	UpdateVariableIncludeEnvironmentStorageProfile                = "environment.storage-profile"
+	UpdateVariableIncludeEnvironmentTags                          = "environment.tags"
+	UpdateVariableIncludeEnvironmentUpdatedBy                     = "environment.updated-by"
+	UpdateVariableIncludeUpdatedBy                                = "updated-by"
+	UpdateVariableIncludeUpdatedByIdentityProviders               = "updated-by.identity-providers"
+	UpdateVariableIncludeUpdatedByTeams                           = "updated-by.teams"
+	UpdateVariableIncludeWorkspace                                = "workspace"
+	UpdateVariableIncludeWorkspaceAgentPool                       = "workspace.agent-pool"
+	UpdateVariableIncludeWorkspaceConfigurationVersion            = "workspace.configuration-version"
+	UpdateVariableIncludeWorkspaceCreatedBy                       = "workspace.created-by"
+	UpdateVariableIncludeWorkspaceCurrentRun                      = "workspace.current-run"
+	UpdateVariableIncludeWorkspaceDriftReport                     = "workspace.drift-report"
+	UpdateVariableIncludeWorkspaceEnvironment                     = "workspace.environment"
+	UpdateVariableIncludeWorkspaceLatestConfigurationVersion      = "workspace.latest-configuration-version"
+	UpdateVariableIncludeWorkspaceLatestRun                       = "workspace.latest-run"
+	UpdateVariableIncludeWorkspaceLockedBy                        = "workspace.locked-by"
+	UpdateVariableIncludeWorkspaceLockedByRun                     = "workspace.locked-by-run"
+	UpdateVariableIncludeWorkspaceModule                          = "workspace.module"
+	UpdateVariableIncludeWorkspaceModuleVersion                   = "workspace.module-version"
+	UpdateVariableIncludeWorkspaceReadmeId                        = "workspace.readme-id"
+	UpdateVariableIncludeWorkspaceSshKey                          = "workspace.ssh-key"
+	UpdateVariableIncludeWorkspaceTags                            = "workspace.tags"
+	UpdateVariableIncludeWorkspaceUpdatedBy                       = "workspace.updated-by"
+	UpdateVariableIncludeWorkspaceVcsProvider                     = "workspace.vcs-provider"
+)
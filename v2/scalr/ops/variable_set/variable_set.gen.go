@@ -33,8 +33,7 @@ func (c *Client) CreateVarSetRaw(ctx context.Context, req *schemas.VariableSetRe
 		if len(opts.Include) > 0 {
 			params.Set("include", strings.Join(opts.Include, ","))
 		}
-		// Handle parameter: Fields (map[string]interface{})
-		// Complex type map[string]interface{} - skip for now
+		opts.Fields.Encode(params)
 		// Add filters
 		for k, v := range opts.Filter {
 			params.Set("filter["+k+"]", v)
@@ -62,17 +61,15 @@ func (c *Client) CreateVarSet(ctx context.Context, req *schemas.VariableSetReque
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.VariableSet      `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.VariableSet `json:"data"`
+		Included []json.RawMessage   `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -81,10 +78,34 @@ type CreateVarSetOptions struct {
 	// The comma-separated list of relationship paths.
 	Include []string
 	// The value of the fields[resource-type] parameter is a comma-separated list that refers to the name of the fields to be returned for the resource. An empty value indicates that no fields should be returned.
-	Fields map[string]interface{}
+	Fields client.Fields
 	Filter map[string]string
 }
 
+// Relationship paths accepted by the Include option of CreateVarSet
+const (
+	CreateVarSetIncludeAccount                                   = "account"
+	CreateVarSetIncludeAccountBillingPlan                        = "account.billing-plan"
+	CreateVarSetIncludeAccountIdentityProvider                   = "account.identity-provider"
+	CreateVarSetIncludeAccountOwner                              = "account.owner"
+	CreateVarSetIncludeEnvironments                              = "environments"
+	CreateVarSetIncludeEnvironmentsAccount                       = "environments.account"
+	CreateVarSetIncludeEnvironmentsCreatedBy                     = "environments.created-by"
+	CreateVarSetIncludeEnvironmentsDefaultProviderConfigurations = "environments.default-provider-configurations"
+	CreateVarSetIncludeEnvironmentsDefaultWorkspaceAgentPool     = "environments.default-workspace-agent-pool"
+	CreateVarSetIncludeEnvironmentsDriftDetectionSchedules       = "environments.drift-detection-schedules"
+	CreateVarSetIncludeEnvironmentsLockedBy                      = "environments.locked-by"
+	CreateVarSetIncludeEnvironmentsPolicyGroups                  = "environments.policy-groups"
+	CreateVarSetIncludeEnvironmentsProviderConfigurations        = "environments.provider-configurations"
+	CreateVarSetIncludeEnvironmentsStorageProfile                = "environments.storage-profile"
+	CreateVarSetIncludeEnvironmentsTags                          = "environments.tags"
+	CreateVarSetIncludeEnvironmentsUpdatedBy                     = "environments.updated-by"
+	CreateVarSetIncludeOwners                                    = "owners"
+	CreateVarSetIncludeOwnersAccount                             = "owners.account"
+	CreateVarSetIncludeOwnersIdentityProvider                    = "owners.identity-provider"
+	CreateVarSetIncludeOwnersUsers                               = "owners.users"
+)
+
 // Deletes a variable set and all its contained variables, environment links, and workspace applications.
 func (c *Client) DeleteVarSetRaw(ctx context.Context, varSet string) (*client.Response, error) {
 	path := "/var-sets/{var_set}"
@@ -118,8 +139,7 @@ func (c *Client) GetVarSetRaw(ctx context.Context, varSet string, opts *GetVarSe
 		if len(opts.Include) > 0 {
 			params.Set("include", strings.Join(opts.Include, ","))
 		}
-		// Handle parameter: Fields (map[string]interface{})
-		// Complex type map[string]interface{} - skip for now
+		opts.Fields.Encode(params)
 		// Add filters
 		for k, v := range opts.Filter {
 			params.Set("filter["+k+"]", v)
@@ -145,17 +165,15 @@ func (c *Client) GetVarSet(ctx context.Context, varSet string, opts *GetVarSetOp
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.VariableSet      `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.VariableSet `json:"data"`
+		Included []json.RawMessage   `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -164,10 +182,34 @@ type GetVarSetOptions struct {
 	// The comma-separated list of relationship paths.
 	Include []string
 	// The value of the fields[resource-type] parameter is a comma-separated list that refers to the name of the fields to be returned for the resource. An empty value indicates that no fields should be returned.
-	Fields map[string]interface{}
+	Fields client.Fields
 	Filter map[string]string
 }
 
+// Relationship paths accepted by the Include option of GetVarSet
+const (
+	GetVarSetIncludeAccount                                   = "account"
+	GetVarSetIncludeAccountBillingPlan                        = "account.billing-plan"
+	GetVarSetIncludeAccountIdentityProvider                   = "account.identity-provider"
+	GetVarSetIncludeAccountOwner                              = "account.owner"
+	GetVarSetIncludeEnvironments                              = "environments"
+	GetVarSetIncludeEnvironmentsAccount                       = "environments.account"
+	GetVarSetIncludeEnvironmentsCreatedBy                     = "environments.created-by"
+	GetVarSetIncludeEnvironmentsDefaultProviderConfigurations = "environments.default-provider-configurations"
+	GetVarSetIncludeEnvironmentsDefaultWorkspaceAgentPool     = "environments.default-workspace-agent-pool"
+	GetVarSetIncludeEnvironmentsDriftDetectionSchedules       = "environments.drift-detection-schedules"
+	GetVarSetIncludeEnvironmentsLockedBy                      = "environments.locked-by"
+	GetVarSetIncludeEnvironmentsPolicyGroups                  = "environments.policy-groups"
+	GetVarSetIncludeEnvironmentsProviderConfigurations        = "environments.provider-configurations"
+	GetVarSetIncludeEnvironmentsStorageProfile                = "environments.storage-profile"
+	GetVarSetIncludeEnvironmentsTags                          = "environments.tags"
+	GetVarSetIncludeEnvironmentsUpdatedBy                     = "environments.updated-by"
+	GetVarSetIncludeOwners                                    = "owners"
+	GetVarSetIncludeOwnersAccount                             = "owners.account"
+	GetVarSetIncludeOwnersIdentityProvider                    = "owners.identity-provider"
+	GetVarSetIncludeOwnersUsers                               = "owners.users"
+)
+
 // Returns a list of variable sets.
 func (c *Client) ListVarSetsRaw(ctx context.Context, opts *ListVarSetsOptions) (*client.Response, error) {
 	path := "/var-sets"
@@ -190,8 +232,7 @@ func (c *Client) ListVarSetsRaw(ctx context.Context, opts *ListVarSetsOptions) (
 		if len(opts.Include) > 0 {
 			params.Set("include", strings.Join(opts.Include, ","))
 		}
-		// Handle parameter: Fields (map[string]interface{})
-		// Complex type map[string]interface{} - skip for now
+		opts.Fields.Encode(params)
 		// Add filters
 		for k, v := range opts.Filter {
 			params.Set("filter["+k+"]", v)
@@ -221,19 +262,18 @@ func (c *Client) ListVarSets(ctx context.Context, opts *ListVarSetsOptions) ([]*
 		Meta struct {
 			Pagination *client.Pagination `json:"pagination"`
 		} `json:"meta"`
-		Included []map[string]interface{} `json:"included"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
+	// Index included resources once to resolve the relationships
+	included := client.NewIncluded(result.Included)
 	resources := make([]*schemas.VariableSet, len(result.Data))
 	for i := range result.Data {
 		resources[i] = &result.Data[i]
-		// Populate included resources into relationships
-		if len(result.Included) > 0 {
-			resources[i].Relationships.PopulateIncludes(result.Included)
-		}
+		resources[i].ResolveIncludes(included)
 	}
 	return resources, nil
 }
@@ -242,6 +282,7 @@ func (c *Client) ListVarSets(ctx context.Context, opts *ListVarSetsOptions) ([]*
 // This is the recommended and simple way to iterate through all the results.
 //
 // The iterator automatically fetches pages as needed and handles errors inline.
+// Pages are fetched ahead in the background when the client is created with client.WithPrefetch.
 //
 // Example:
 //
@@ -253,73 +294,23 @@ func (c *Client) ListVarSets(ctx context.Context, opts *ListVarSetsOptions) ([]*
 //	}
 func (c *Client) ListVarSetsIter(ctx context.Context, opts *ListVarSetsOptions) iter.Seq2[schemas.VariableSet, error] {
 	return func(yield func(schemas.VariableSet, error) bool) {
-		// Determine page size from opts or use default
-		pageSize := 20
-		if opts != nil && opts.PageSize > 0 {
-			pageSize = opts.PageSize
-		}
-
-		pageNum := 1
-
-		for {
-			// Check context
-			if err := ctx.Err(); err != nil {
-				yield(schemas.VariableSet{}, err)
-				return
-			}
-
-			// Copy options and set page number
-			pageOpts := &ListVarSetsOptions{}
-			if opts != nil {
-				*pageOpts = *opts
-			}
-			pageOpts.PageNumber = pageNum
-			pageOpts.PageSize = pageSize
-
-			// Fetch page using Raw method to get pagination metadata
-			resp, err := c.ListVarSetsRaw(ctx, pageOpts)
-			if err != nil {
-				yield(schemas.VariableSet{}, err)
-				return
-			}
-			defer resp.Body.Close()
-
-			// Decode response
-			var result struct {
-				Data []schemas.VariableSet `json:"data"`
-				Meta struct {
-					Pagination *client.Pagination `json:"pagination"`
-				} `json:"meta"`
-				Included []map[string]interface{} `json:"included"`
-			}
-			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-				yield(schemas.VariableSet{}, fmt.Errorf("failed to decode response: %w", err))
-				return
-			}
+		it := c.ListVarSetsPaged(ctx, opts)
+		defer it.Close() // Stops the prefetching on early exit
 
-			// Yield each item
-			for i := range result.Data {
-				// Populate included resources into relationships
-				if len(result.Included) > 0 {
-					result.Data[i].Relationships.PopulateIncludes(result.Included)
-				}
-				if !yield(result.Data[i], nil) {
-					return // Consumer requested early exit
-				}
+		for it.Next() {
+			if !yield(*it.Value(), nil) {
+				return // Consumer requested early exit
 			}
-
-			// Check if there are more pages
-			if result.Meta.Pagination == nil || result.Meta.Pagination.NextPage == nil {
-				break
-			}
-
-			pageNum++
+		}
+		if err := it.Err(); err != nil {
+			yield(schemas.VariableSet{}, err)
 		}
 	}
 }
 
 // ListVarSetsPaged returns a stateful iterator with access to pagination metadata.
-// Use this when you need PageInfo(), Remaining(), or Collect() methods.
+// Use this when you need PageInfo(), Remaining(), or Collect() methods, or to resume
+// an interrupted iteration from the iterator Checkpoint().
 //
 // Example:
 //
@@ -332,6 +323,10 @@ func (c *Client) ListVarSetsIter(ctx context.Context, opts *ListVarSetsOptions)
 //	if err := iter.Err(); err != nil {
 //	    // Handle error
 //	}
+//
+// Resuming from a checkpoint saved by an iterator with the same options:
+//
+//	iter := client.VariableSet.ListVarSetsPaged(ctx, opts).Resume(checkpoint)
 func (c *Client) ListVarSetsPaged(ctx context.Context, opts *ListVarSetsOptions) *client.Iterator[schemas.VariableSet] {
 	// Determine page size from opts or use default
 	pageSize := 20
@@ -362,26 +357,34 @@ func (c *Client) ListVarSetsPaged(ctx context.Context, opts *ListVarSetsOptions)
 			Meta struct {
 				Pagination *client.Pagination `json:"pagination"`
 			} `json:"meta"`
-			Included []map[string]interface{} `json:"included"`
+			Included []json.RawMessage `json:"included"`
 		}
 		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 			return nil, nil, fmt.Errorf("failed to decode response: %w", err)
 		}
 
-		// Convert to slice of pointers and populate includes
+		// Convert to slice of pointers and resolve includes
+		included := client.NewIncluded(result.Included)
 		items := make([]*schemas.VariableSet, len(result.Data))
 		for i := range result.Data {
 			items[i] = &result.Data[i]
-			// Populate included resources into relationships
-			if len(result.Included) > 0 {
-				items[i].Relationships.PopulateIncludes(result.Included)
-			}
+			items[i].ResolveIncludes(included)
 		}
 
 		return items, result.Meta.Pagination, nil
 	}
 
-	return client.NewIterator[schemas.VariableSet](ctx, pageSize, fetchPage)
+	// Checkpoints only resume the iterators of the same listing
+	listOpts := ListVarSetsOptions{}
+	if opts != nil {
+		listOpts = *opts
+	}
+	listOpts.PageNumber, listOpts.PageSize = 0, 0
+	filters := client.HashOptions("ListVarSets", listOpts)
+
+	return client.NewIterator[schemas.VariableSet](ctx, pageSize, fetchPage).
+		Prefetch(c.httpClient.Prefetch()).
+		FiltersHash(filters)
 }
 
 // ListVarSetsOptions holds optional parameters for ListVarSets
@@ -397,10 +400,34 @@ type ListVarSetsOptions struct {
 	// The comma-separated list of relationship paths.
 	Include []string
 	// The value of the fields[resource-type] parameter is a comma-separated list that refers to the name of the fields to be returned for the resource. An empty value indicates that no fields should be returned.
-	Fields map[string]interface{}
+	Fields client.Fields
 	Filter map[string]string
 }
 
+// Relationship paths accepted by the Include option of ListVarSets
+const (
+	ListVarSetsIncludeAccount                                   = "account"
+	ListVarSetsIncludeAccountBillingPlan                        = "account.billing-plan"
+	ListVarSetsIncludeAccountIdentityProvider                   = "account.identity-provider"
+	ListVarSetsIncludeAccountOwner                              = "account.owner"
+	ListVarSetsIncludeEnvironments                              = "environments"
+	ListVarSetsIncludeEnvironmentsAccount                       = "environments.account"
+	ListVarSetsIncludeEnvironmentsCreatedBy                     = "environments.created-by"
+	ListVarSetsIncludeEnvironmentsDefaultProviderConfigurations = "environments.default-provider-configurations"
+	ListVarSetsIncludeEnvironmentsDefaultWorkspaceAgentPool     = "environments.default-workspace-agent-pool"
+	ListVarSetsIncludeEnvironmentsDriftDetectionSchedules       = "environments.drift-detection-schedules"
+	ListVarSetsIncludeEnvironmentsLockedBy                      = "environments.locked-by"
+	ListVarSetsIncludeEnvironmentsPolicyGroups                  = "environments.policy-groups"
+	ListVarSetsIncludeEnvironmentsProviderConfigurations        = "environments.provider-configurations"
+	ListVarSetsIncludeEnvironmentsStorageProfile                = "environments.storage-profile"
+	ListVarSetsIncludeEnvironmentsTags                          = "environments.tags"
+	ListVarSetsIncludeEnvironmentsUpdatedBy                     = "environments.updated-by"
+	ListVarSetsIncludeOwners                                    = "owners"
+	ListVarSetsIncludeOwnersAccount                             = "owners.account"
+	ListVarSetsIncludeOwnersIdentityProvider                    = "owners.identity-provider"
+	ListVarSetsIncludeOwnersUsers                               = "owners.users"
+)
+
 // Updates attributes of an existing variable set.
 func (c *Client) UpdateVarSetRaw(ctx context.Context, varSet string, req *schemas.VariableSetRequest, opts *UpdateVarSetOptions) (*client.Response, error) {
 	path := "/var-sets/{var_set}"
@@ -411,8 +438,7 @@ func (c *Client) UpdateVarSetRaw(ctx context.Context, varSet string, req *schema
 		if len(opts.Include) > 0 {
 			params.Set("include", strings.Join(opts.Include, ","))
 		}
-		// Handle parameter: Fields (map[string]interface{})
-		// Complex type map[string]interface{} - skip for now
+		opts.Fields.Encode(params)
 		// Add filters
 		for k, v := range opts.Filter {
 			params.Set("filter["+k+"]", v)
@@ -440,17 +466,15 @@ func (c *Client) UpdateVarSet(ctx context.Context, varSet string, req *schemas.V
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.VariableSet      `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.VariableSet `json:"data"`
+		Included []json.RawMessage   `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -459,6 +483,30 @@ type UpdateVarSetOptions struct {
 	// The comma-separated list of relationship paths.
 	Include []string
 	// The value of the fields[resource-type] parameter is a comma-separated list that refers to the name of the fields to be returned for the resource. An empty value indicates that no fields should be returned.
-	Fields map[string]interface{}
+	Fields client.Fields
 	Filter map[string]string
 }
+
+// Relationship paths accepted by the Include option of UpdateVarSet
+const (
+	UpdateVarSetIncludeAccount                                   = "account"
+	UpdateVarSetIncludeAccountBillingPlan                        = "account.billing-plan"
+	UpdateVarSetIncludeAccountIdentityProvider                   = "account.identity-provider"
+	UpdateVarSetIncludeAccountOwner                              = "account.owner"
+	UpdateVarSetIncludeEnvironments                              = "environments"
+	UpdateVarSetIncludeEnvironmentsAccount                       = "environments.account"
+	UpdateVarSetIncludeEnvironmentsCreatedBy                     = "environments.created-by"
+	UpdateVarSetIncludeEnvironmentsDefaultProviderConfigurations = "environments.default-provider-configurations"
+	UpdateVarSetIncludeEnvironmentsDefaultWorkspaceAgentPool     = "environments.default-workspace-agent-pool"
+	UpdateVarSetIncludeEnvironmentsDriftDetectionSchedules       = "environments.drift-detection-schedules"
+	UpdateVarSetIncludeEnvironmentsLockedBy                      = "environments.locked-by"
+	UpdateVarSetIncludeEnvironmentsPolicyGroups                  = "environments.policy-groups"
+	UpdateVarSetIncludeEnvironmentsProviderConfigurations        = "environments.provider-configurations"
+	UpdateVarSetIncludeEnvironmentsStorageProfile                = "environments.storage-profile"
+	UpdateVarSetIncludeEnvironmentsTags                          = "environments.tags"
+	UpdateVarSetIncludeEnvironmentsUpdatedBy                     = "environments.updated-by"
+	UpdateVarSetIncludeOwners                                    = "owners"
+	UpdateVarSetIncludeOwnersAccount                             = "owners.account"
+	UpdateVarSetIncludeOwnersIdentityProvider                    = "owners.identity-provider"
+	UpdateVarSetIncludeOwnersUsers                               = "owners.users"
+)
@@ -61,16 +61,14 @@ func (c *Client) CreateVarSetVariable(ctx context.Context, req *schemas.Variable
 
 	var result struct {
 		Data     schemas.VariableSetVariable `json:"data"`
-		Included []map[string]interface{}    `json:"included"`
+		Included []json.RawMessage           `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -81,6 +79,18 @@ type CreateVarSetVariableOptions struct {
 	Filter  map[string]string
 }
 
+// Relationship paths accepted by the Include option of CreateVarSetVariable
+const (
+	CreateVarSetVariableIncludeAccount                 = "account"
+	CreateVarSetVariableIncludeAccountBillingPlan      = "account.billing-plan"
+	CreateVarSetVariableIncludeAccountIdentityProvider = "account.identity-provider"
+	CreateVarSetVariableIncludeAccountOwner            = "account.owner"
+	CreateVarSetVariableIncludeVarSet                  = "var-set"
+	CreateVarSetVariableIncludeVarSetAccount           = "var-set.account"
+	CreateVarSetVariableIncludeVarSetEnvironments      = "var-set.environments"
+	CreateVarSetVariableIncludeVarSetOwners            = "var-set.owners"
+)
+
 // Deletes a variable set variable.
 func (c *Client) DeleteVarSetVariableRaw(ctx context.Context, vsVar string) (*client.Response, error) {
 	path := "/var-set-variables/{vs_var}"
@@ -138,16 +148,14 @@ func (c *Client) GetVarSetVariable(ctx context.Context, vsVar string, opts *GetV
 
 	var result struct {
 		Data     schemas.VariableSetVariable `json:"data"`
-		Included []map[string]interface{}    `json:"included"`
+		Included []json.RawMessage           `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -158,6 +166,18 @@ type GetVarSetVariableOptions struct {
 	Filter  map[string]string
 }
 
+// Relationship paths accepted by the Include option of GetVarSetVariable
+const (
+	GetVarSetVariableIncludeAccount                 = "account"
+	GetVarSetVariableIncludeAccountBillingPlan      = "account.billing-plan"
+	GetVarSetVariableIncludeAccountIdentityProvider = "account.identity-provider"
+	GetVarSetVariableIncludeAccountOwner            = "account.owner"
+	GetVarSetVariableIncludeVarSet                  = "var-set"
+	GetVarSetVariableIncludeVarSetAccount           = "var-set.account"
+	GetVarSetVariableIncludeVarSetEnvironments      = "var-set.environments"
+	GetVarSetVariableIncludeVarSetOwners            = "var-set.owners"
+)
+
 // Returns a list of variable set variables.
 func (c *Client) ListVarSetVariablesRaw(ctx context.Context, opts *ListVarSetVariablesOptions) (*client.Response, error) {
 	path := "/var-set-variables"
@@ -205,19 +225,18 @@ func (c *Client) ListVarSetVariables(ctx context.Context, opts *ListVarSetVariab
 		Meta struct {
 			Pagination *client.Pagination `json:"pagination"`
 		} `json:"meta"`
-		Included []map[string]interface{} `json:"included"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
+	// Index included resources once to resolve the relationships
+	included := client.NewIncluded(result.Included)
 	resources := make([]*schemas.VariableSetVariable, len(result.Data))
 	for i := range result.Data {
 		resources[i] = &result.Data[i]
-		// Populate included resources into relationships
-		if len(result.Included) > 0 {
-			resources[i].Relationships.PopulateIncludes(result.Included)
-		}
+		resources[i].ResolveIncludes(included)
 	}
 	return resources, nil
 }
@@ -226,6 +245,7 @@ func (c *Client) ListVarSetVariables(ctx context.Context, opts *ListVarSetVariab
 // This is the recommended and simple way to iterate through all the results.
 //
 // The iterator automatically fetches pages as needed and handles errors inline.
+// Pages are fetched ahead in the background when the client is created with client.WithPrefetch.
 //
 // Example:
 //
@@ -237,73 +257,23 @@ func (c *Client) ListVarSetVariables(ctx context.Context, opts *ListVarSetVariab
 //	}
 func (c *Client) ListVarSetVariablesIter(ctx context.Context, opts *ListVarSetVariablesOptions) iter.Seq2[schemas.VariableSetVariable, error] {
 	return func(yield func(schemas.VariableSetVariable, error) bool) {
-		// Determine page size from opts or use default
-		pageSize := 20
-		if opts != nil && opts.PageSize > 0 {
-			pageSize = opts.PageSize
-		}
-
-		pageNum := 1
-
-		for {
-			// Check context
-			if err := ctx.Err(); err != nil {
-				yield(schemas.VariableSetVariable{}, err)
-				return
-			}
-
-			// Copy options and set page number
-			pageOpts := &ListVarSetVariablesOptions{}
-			if opts != nil {
-				*pageOpts = *opts
-			}
-			pageOpts.PageNumber = pageNum
-			pageOpts.PageSize = pageSize
-
-			// Fetch page using Raw method to get pagination metadata
-			resp, err := c.ListVarSetVariablesRaw(ctx, pageOpts)
-			if err != nil {
-				yield(schemas.VariableSetVariable{}, err)
-				return
-			}
-			defer resp.Body.Close()
-
-			// Decode response
-			var result struct {
-				Data []schemas.VariableSetVariable `json:"data"`
-				Meta struct {
-					Pagination *client.Pagination `json:"pagination"`
-				} `json:"meta"`
-				Included []map[string]interface{} `json:"included"`
-			}
-			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-				yield(schemas.VariableSetVariable{}, fmt.Errorf("failed to decode response: %w", err))
-				return
-			}
+		it := c.ListVarSetVariablesPaged(ctx, opts)
+		defer it.Close() // Stops the prefetching on early exit
 
-			// Yield each item
-			for i := range result.Data {
-				// Populate included resources into relationships
-				if len(result.Included) > 0 {
-					result.Data[i].Relationships.PopulateIncludes(result.Included)
-				}
-				if !yield(result.Data[i], nil) {
-					return // Consumer requested early exit
-				}
+		for it.Next() {
+			if !yield(*it.Value(), nil) {
+				return // Consumer requested early exit
 			}
-
-			// Check if there are more pages
-			if result.Meta.Pagination == nil || result.Meta.Pagination.NextPage == nil {
-				break
-			}
-
-			pageNum++
+		}
+		if err := it.Err(); err != nil {
+			yield(schemas.VariableSetVariable{}, err)
 		}
 	}
 }
 
 // ListVarSetVariablesPaged returns a stateful iterator with access to pagination metadata.
-// Use this when you need PageInfo(), Remaining(), or Collect() methods.
+// Use this when you need PageInfo(), Remaining(), or Collect() methods, or to resume
+// an interrupted iteration from the iterator Checkpoint().
 //
 // Example:
 //
@@ -316,6 +286,10 @@ func (c *Client) ListVarSetVariablesIter(ctx context.Context, opts *ListVarSetVa
 //	if err := iter.Err(); err != nil {
 //	    // Handle error
 //	}
+//
+// Resuming from a checkpoint saved by an iterator with the same options:
+//
+//	iter := client.VariableSetVariable.ListVarSetVariablesPaged(ctx, opts).Resume(checkpoint)
 func (c *Client) ListVarSetVariablesPaged(ctx context.Context, opts *ListVarSetVariablesOptions) *client.Iterator[schemas.VariableSetVariable] {
 	// Determine page size from opts or use default
 	pageSize := 20
@@ -346,26 +320,34 @@ func (c *Client) ListVarSetVariablesPaged(ctx context.Context, opts *ListVarSetV
 			Meta struct {
 				Pagination *client.Pagination `json:"pagination"`
 			} `json:"meta"`
-			Included []map[string]interface{} `json:"included"`
+			Included []json.RawMessage `json:"included"`
 		}
 		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 			return nil, nil, fmt.Errorf("failed to decode response: %w", err)
 		}
 
-		// Convert to slice of pointers and populate includes
+		// Convert to slice of pointers and resolve includes
+		included := client.NewIncluded(result.Included)
 		items := make([]*schemas.VariableSetVariable, len(result.Data))
 		for i := range result.Data {
 			items[i] = &result.Data[i]
-			// Populate included resources into relationships
-			if len(result.Included) > 0 {
-				items[i].Relationships.PopulateIncludes(result.Included)
-			}
+			items[i].ResolveIncludes(included)
 		}
 
 		return items, result.Meta.Pagination, nil
 	}
 
-	return client.NewIterator[schemas.VariableSetVariable](ctx, pageSize, fetchPage)
+	// Checkpoints only resume the iterators of the same listing
+	listOpts := ListVarSetVariablesOptions{}
+	if opts != nil {
+		listOpts = *opts
+	}
+	listOpts.PageNumber, listOpts.PageSize = 0, 0
+	filters := client.HashOptions("ListVarSetVariables", listOpts)
+
+	return client.NewIterator[schemas.VariableSetVariable](ctx, pageSize, fetchPage).
+		Prefetch(c.httpClient.Prefetch()).
+		FiltersHash(filters)
 }
 
 // ListVarSetVariablesOptions holds optional parameters for ListVarSetVariables
@@ -381,6 +363,18 @@ type ListVarSetVariablesOptions struct {
 	Filter  map[string]string
 }
 
+// Relationship paths accepted by the Include option of ListVarSetVariables
+const (
+	ListVarSetVariablesIncludeAccount                 = "account"
+	ListVarSetVariablesIncludeAccountBillingPlan      = "account.billing-plan"
+	ListVarSetVariablesIncludeAccountIdentityProvider = "account.identity-provider"
+	ListVarSetVariablesIncludeAccountOwner            = "account.owner"
+	ListVarSetVariablesIncludeVarSet                  = "var-set"
+	ListVarSetVariablesIncludeVarSetAccount           = "var-set.account"
+	ListVarSetVariablesIncludeVarSetEnvironments      = "var-set.environments"
+	ListVarSetVariablesIncludeVarSetOwners            = "var-set.owners"
+)
+
 // Updates attributes of an existing variable set variable.
 func (c *Client) UpdateVarSetVariableRaw(ctx context.Context, vsVar string, req *schemas.VariableSetVariableRequest, opts *UpdateVarSetVariableOptions) (*client.Response, error) {
 	path := "/var-set-variables/{vs_var}"
@@ -419,16 +413,14 @@ func (c *Client) UpdateVarSetVariable(ctx context.Context, vsVar string, req *sc
 
 	var result struct {
 		Data     schemas.VariableSetVariable `json:"data"`
-		Included []map[string]interface{}    `json:"included"`
+		Included []json.RawMessage           `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -438,3 +430,15 @@ type UpdateVarSetVariableOptions struct {
 	Include []string
 	Filter  map[string]string
 }
+
+// Relationship paths accepted by the Include option of UpdateVarSetVariable
+const (
+	UpdateVarSetVariableIncludeAccount                 = "account"
+	UpdateVarSetVariableIncludeAccountBillingPlan      = "account.billing-plan"
+	UpdateVarSetVariableIncludeAccountIdentityProvider = "account.identity-provider"
+	UpdateVarSetVariableIncludeAccountOwner            = "account.owner"
+	UpdateVarSetVariableIncludeVarSet                  = "var-set"
+	UpdateVarSetVariableIncludeVarSetAccount           = "var-set.account"
+	UpdateVarSetVariableIncludeVarSetEnvironments      = "var-set.environments"
+	UpdateVarSetVariableIncludeVarSetOwners            = "var-set.owners"
+)
@@ -46,17 +46,15 @@ func (c *Client) CreateVcsProvider(ctx context.Context, req *schemas.VcsProvider
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.VcsProvider      `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.VcsProvider `json:"data"`
+		Included []json.RawMessage   `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -93,8 +91,7 @@ func (c *Client) GetVcsProviderRaw(ctx context.Context, vcsProvider string, opts
 		if len(opts.Include) > 0 {
 			params.Set("include", strings.Join(opts.Include, ","))
 		}
-		// Handle parameter: Fields (map[string]interface{})
-		// Complex type map[string]interface{} - skip for now
+		opts.Fields.Encode(params)
 		// Add filters
 		for k, v := range opts.Filter {
 			params.Set("filter["+k+"]", v)
@@ -120,17 +117,15 @@ func (c *Client) GetVcsProvider(ctx context.Context, vcsProvider string, opts *G
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.VcsProvider      `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.VcsProvider `json:"data"`
+		Included []json.RawMessage   `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -139,10 +134,37 @@ type GetVcsProviderOptions struct {
 	// The comma-separated list of relationship paths.
 	Include []string
 	// The value of the fields[resource-type] parameter is a comma-separated list that refers to the name of the fields to be returned for the resource. An empty value indicates that no fields should be returned.
-	Fields map[string]interface{}
+	Fields client.Fields
 	Filter map[string]string
 }
 
+// Relationship paths accepted by the Include option of GetVcsProvider
+const (
+	GetVcsProviderIncludeAccount                                   = "account"
+	GetVcsProviderIncludeAccountBillingPlan                        = "account.billing-plan"
+	GetVcsProviderIncludeAccountIdentityProvider                   = "account.identity-provider"
+	GetVcsProviderIncludeAccountOwner                              = "account.owner"
+	GetVcsProviderIncludeAgentPool                                 = "agent-pool"
+	GetVcsProviderIncludeAgentPoolAccount                          = "agent-pool.account"
+	GetVcsProviderIncludeAgentPoolAgents                           = "agent-pool.agents"
+	GetVcsProviderIncludeAgentPoolDefaultEnvironments              = "agent-pool.default-environments"
+	GetVcsProviderIncludeAgentPoolEnvironment                      = "agent-pool.environment"
+	GetVcsProviderIncludeAgentPoolEnvironments                     = "agent-pool.environments"
+	GetVcsProviderIncludeAgentPoolWorkspaces                       = "agent-pool.workspaces"
+	GetVcsProviderIncludeEnvironments                              = "environments"
+	GetVcsProviderIncludeEnvironmentsAccount                       = "environments.account"
+	GetVcsProviderIncludeEnvironmentsCreatedBy                     = "environments.created-by"
+	GetVcsProviderIncludeEnvironmentsDefaultProviderConfigurations = "environments.default-provider-configurations"
+	GetVcsProviderIncludeEnvironmentsDefaultWorkspaceAgentPool     = "environments.default-workspace-agent-pool"
+	GetVcsProviderIncludeEnvironmentsDriftDetectionSchedules       = "environments.drift-detection-schedules"
+	GetVcsProviderIncludeEnvironmentsLockedBy                      = "environments.locked-by"
+	GetVcsProviderIncludeEnvironmentsPolicyGroups                  = "environments.policy-groups"
+	GetVcsProviderIncludeEnvironmentsProviderConfigurations        = "environments.provider-configurations"
+	GetVcsProviderIncludeEnvironmentsStorageProfile                = "environments.storage-profile"
+	GetVcsProviderIncludeEnvironmentsTags                          = "environments.tags"
+	GetVcsProviderIncludeEnvironmentsUpdatedBy                     = "environments.updated-by"
+)
+
 // This endpoint returns a list of VCS providers by various filters. To list VCS providers accessible from a specific environment - `filter[environment]`, or when from a specific account - `filter[account]` has to be specified. For self-hosted Scalr there's also an option to list all VCS providers created globally - both `filter[account]=null` and `filter[environment]=null` has to be specified. If no `environment` or `account` filters were specified, all VCS providers to which a current user has read access will be returned.
 func (c *Client) ListVcsProvidersRaw(ctx context.Context, opts *ListVcsProvidersOptions) (*client.Response, error) {
 	path := "/vcs-providers"
@@ -165,8 +187,7 @@ func (c *Client) ListVcsProvidersRaw(ctx context.Context, opts *ListVcsProviders
 		if len(opts.Include) > 0 {
 			params.Set("include", strings.Join(opts.Include, ","))
 		}
-		// Handle parameter: Fields (map[string]interface{})
-		// Complex type map[string]interface{} - skip for now
+		opts.Fields.Encode(params)
 		// Add filters
 		for k, v := range opts.Filter {
 			params.Set("filter["+k+"]", v)
@@ -196,19 +217,18 @@ func (c *Client) ListVcsProviders(ctx context.Context, opts *ListVcsProvidersOpt
 		Meta struct {
 			Pagination *client.Pagination `json:"pagination"`
 		} `json:"meta"`
-		Included []map[string]interface{} `json:"included"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
+	// Index included resources once to resolve the relationships
+	included := client.NewIncluded(result.Included)
 	resources := make([]*schemas.VcsProvider, len(result.Data))
 	for i := range result.Data {
 		resources[i] = &result.Data[i]
-		// Populate included resources into relationships
-		if len(result.Included) > 0 {
-			resources[i].Relationships.PopulateIncludes(result.Included)
-		}
+		resources[i].ResolveIncludes(included)
 	}
 	return resources, nil
 }
@@ -217,6 +237,7 @@ func (c *Client) ListVcsProviders(ctx context.Context, opts *ListVcsProvidersOpt
 // This is the recommended and simple way to iterate through all the results.
 //
 // The iterator automatically fetches pages as needed and handles errors inline.
+// Pages are fetched ahead in the background when the client is created with client.WithPrefetch.
 //
 // Example:
 //
@@ -228,73 +249,23 @@ func (c *Client) ListVcsProviders(ctx context.Context, opts *ListVcsProvidersOpt
 //	}
 func (c *Client) ListVcsProvidersIter(ctx context.Context, opts *ListVcsProvidersOptions) iter.Seq2[schemas.VcsProvider, error] {
 	return func(yield func(schemas.VcsProvider, error) bool) {
-		// Determine page size from opts or use default
-		pageSize := 20
-		if opts != nil && opts.PageSize > 0 {
-			pageSize = opts.PageSize
-		}
-
-		pageNum := 1
-
-		for {
-			// Check context
-			if err := ctx.Err(); err != nil {
-				yield(schemas.VcsProvider{}, err)
-				return
-			}
-
-			// Copy options and set page number
-			pageOpts := &ListVcsProvidersOptions{}
-			if opts != nil {
-				*pageOpts = *opts
-			}
-			pageOpts.PageNumber = pageNum
-			pageOpts.PageSize = pageSize
-
-			// Fetch page using Raw method to get pagination metadata
-			resp, err := c.ListVcsProvidersRaw(ctx, pageOpts)
-			if err != nil {
-				yield(schemas.VcsProvider{}, err)
-				return
-			}
-			defer resp.Body.Close()
-
-			// Decode response
-			var result struct {
-				Data []schemas.VcsProvider `json:"data"`
-				Meta struct {
-					Pagination *client.Pagination `json:"pagination"`
-				} `json:"meta"`
-				Included []map[string]interface{} `json:"included"`
-			}
-			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-				yield(schemas.VcsProvider{}, fmt.Errorf("failed to decode response: %w", err))
-				return
-			}
+		it := c.ListVcsProvidersPaged(ctx, opts)
+		defer it.Close() // Stops the prefetching on early exit
 
-			// Yield each item
-			for i := range result.Data {
-				// Populate included resources into relationships
-				if len(result.Included) > 0 {
-					result.Data[i].Relationships.PopulateIncludes(result.Included)
-				}
-				if !yield(result.Data[i], nil) {
-					return // Consumer requested early exit
-				}
+		for it.Next() {
+			if !yield(*it.Value(), nil) {
+				return // Consumer requested early exit
 			}
-
-			// Check if there are more pages
-			if result.Meta.Pagination == nil || result.Meta.Pagination.NextPage == nil {
-				break
-			}
-
-			pageNum++
+		}
+		if err := it.Err(); err != nil {
+			yield(schemas.VcsProvider{}, err)
 		}
 	}
 }
 
 // ListVcsProvidersPaged returns a stateful iterator with access to pagination metadata.
-// Use this when you need PageInfo(), Remaining(), or Collect() methods.
+// Use this when you need PageInfo(), Remaining(), or Collect() methods, or to resume
+// an interrupted iteration from the iterator Checkpoint().
 //
 // Example:
 //
@@ -307,6 +278,10 @@ func (c *Client) ListVcsProvidersIter(ctx context.Context, opts *ListVcsProvider
 //	if err := iter.Err(); err != nil {
 //	    // Handle error
 //	}
+//
+// Resuming from a checkpoint saved by an iterator with the same options:
+//
+//	iter := client.VcsProvider.ListVcsProvidersPaged(ctx, opts).Resume(checkpoint)
 func (c *Client) ListVcsProvidersPaged(ctx context.Context, opts *ListVcsProvidersOptions) *client.Iterator[schemas.VcsProvider] {
 	// Determine page size from opts or use default
 	pageSize := 20
@@ -337,26 +312,34 @@ func (c *Client) ListVcsProvidersPaged(ctx context.Context, opts *ListVcsProvide
 			Meta struct {
 				Pagination *client.Pagination `json:"pagination"`
 			} `json:"meta"`
-			Included []map[string]interface{} `json:"included"`
+			Included []json.RawMessage `json:"included"`
 		}
 		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 			return nil, nil, fmt.Errorf("failed to decode response: %w", err)
 		}
 
-		// Convert to slice of pointers and populate includes
+		// Convert to slice of pointers and resolve includes
+		included := client.NewIncluded(result.Included)
 		items := make([]*schemas.VcsProvider, len(result.Data))
 		for i := range result.Data {
 			items[i] = &result.Data[i]
-			// Populate included resources into relationships
-			if len(result.Included) > 0 {
-				items[i].Relationships.PopulateIncludes(result.Included)
-			}
+			items[i].ResolveIncludes(included)
 		}
 
 		return items, result.Meta.Pagination, nil
 	}
 
-	return client.NewIterator[schemas.VcsProvider](ctx, pageSize, fetchPage)
+	// Checkpoints only resume the iterators of the same listing
+	listOpts := ListVcsProvidersOptions{}
+	if opts != nil {
+		listOpts = *opts
+	}
+	listOpts.PageNumber, listOpts.PageSize = 0, 0
+	filters := client.HashOptions("ListVcsProviders", listOpts)
+
+	return client.NewIterator[schemas.VcsProvider](ctx, pageSize, fetchPage).
+		Prefetch(c.httpClient.Prefetch()).
+		FiltersHash(filters)
 }
 
 // ListVcsProvidersOptions holds optional parameters for ListVcsProviders
@@ -372,10 +355,37 @@ type ListVcsProvidersOptions struct {
 	// The comma-separated list of relationship paths.
 	Include []string
 	// The value of the fields[resource-type] parameter is a comma-separated list that refers to the name of the fields to be returned for the resource. An empty value indicates that no fields should be returned.
-	Fields map[string]interface{}
+	Fields client.Fields
 	Filter map[string]string
 }
 
+// Relationship paths accepted by the Include option of ListVcsProviders
+const (
+	ListVcsProvidersIncludeAccount                                   = "account"
+	ListVcsProvidersIncludeAccountBillingPlan                        = "account.billing-plan"
+	ListVcsProvidersIncludeAccountIdentityProvider                   = "account.identity-provider"
+	ListVcsProvidersIncludeAccountOwner                              = "account.owner"
+	ListVcsProvidersIncludeAgentPool                                 = "agent-pool"
+	ListVcsProvidersIncludeAgentPoolAccount                          = "agent-pool.account"
+	ListVcsProvidersIncludeAgentPoolAgents                           = "agent-pool.agents"
+	ListVcsProvidersIncludeAgentPoolDefaultEnvironments              = "agent-pool.default-environments"
+	ListVcsProvidersIncludeAgentPoolEnvironment                      = "agent-pool.environment"
+	ListVcsProvidersIncludeAgentPoolEnvironments                     = "agent-pool.environments"
+	ListVcsProvidersIncludeAgentPoolWorkspaces                       = "agent-pool.workspaces"
+	ListVcsProvidersIncludeEnvironments                              = "environments"
+	ListVcsProvidersIncludeEnvironmentsAccount                       = "environments.account"
+	ListVcsProvidersIncludeEnvironmentsCreatedBy                     = "environments.created-by"
+	ListVcsProvidersIncludeEnvironmentsDefaultProviderConfigurations = "environments.default-provider-configurations"
+	ListVcsProvidersIncludeEnvironmentsDefaultWorkspaceAgentPool     = "environments.default-workspace-agent-pool"
+	ListVcsProvidersIncludeEnvironmentsDriftDetectionSchedules       = "environments.drift-detection-schedules"
+	ListVcsProvidersIncludeEnvironmentsLockedBy                      = "environments.locked-by"
+	ListVcsProvidersIncludeEnvironmentsPolicyGroups                  = "environments.policy-groups"
+	ListVcsProvidersIncludeEnvironmentsProviderConfigurations        = "environments.provider-configurations"
+	ListVcsProvidersIncludeEnvironmentsStorageProfile                = "environments.storage-profile"
+	ListVcsProvidersIncludeEnvironmentsTags                          = "environments.tags"
+	ListVcsProvidersIncludeEnvironmentsUpdatedBy                     = "environments.updated-by"
+)
+
 // This endpoint allows updates to attributes of an existing VCS provider.
 func (c *Client) UpdateVcsProviderRaw(ctx context.Context, vcsProvider string, req *schemas.VcsProviderRequest) (*client.Response, error) {
 	path := "/vcs-providers/{vcs_provider}"
@@ -399,16 +409,14 @@ func (c *Client) UpdateVcsProvider(ctx context.Context, vcsProvider string, req
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.VcsProvider      `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.VcsProvider `json:"data"`
+		Included []json.RawMessage   `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
@@ -34,8 +34,7 @@ func (c *Client) GetWebhookIntegrationDeliveryRaw(ctx context.Context, webhookDe
 		if len(opts.Include) > 0 {
 			params.Set("include", strings.Join(opts.Include, ","))
 		}
-		// Handle parameter: Fields (map[string]interface{})
-		// Complex type map[string]interface{} - skip for now
+		opts.Fields.Encode(params)
 		// Add filters
 		for k, v := range opts.Filter {
 			params.Set("filter["+k+"]", v)
@@ -62,16 +61,14 @@ func (c *Client) GetWebhookIntegrationDelivery(ctx context.Context, webhookDeliv
 
 	var result struct {
 		Data     schemas.WebhookIntegrationDelivery `json:"data"`
-		Included []map[string]interface{}           `json:"included"`
+		Included []json.RawMessage                  `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -80,10 +77,66 @@ type GetWebhookIntegrationDeliveryOptions struct {
 	// The comma-separated list of relationship paths.
 	Include []string
 	// The value of the fields[resource-type] parameter is a comma-separated list that refers to the name of the fields to be returned for the resource. An empty value indicates that no fields should be returned.
-	Fields map[string]interface{}
+	Fields client.Fields
 	Filter map[string]string
 }
 
+// Relationship paths accepted by the Include option of GetWebhookIntegrationDelivery
+const (
+	GetWebhookIntegrationDeliveryIncludeEnvironment                              = "environment"
+	GetWebhookIntegrationDeliveryIncludeEnvironmentAccount                       = "environment.account"
+	GetWebhookIntegrationDeliveryIncludeEnvironmentCreatedBy                     = "environment.created-by"
+	GetWebhookIntegrationDeliveryIncludeEnvironmentDefaultProviderConfigurations = "environment.default-provider-configurations"
+	GetWebhookIntegrationDeliveryIncludeEnvironmentDefaultWorkspaceAgentPool     = "environment.default-workspace-agent-pool"
+	GetWebhookIntegrationDeliveryIncludeEnvironmentDriftDetectionSchedules       = "environment.drift-detection-schedules"
+	GetWebhookIntegrationDeliveryIncludeEnvironmentLockedBy                      = "environment.locked-by"
+	GetWebhookIntegrationDeliveryIncludeEnvironmentPolicyGroups                  = "environment.policy-groups"
+	GetWebhookIntegrationDeliveryIncludeEnvironmentProviderConfigurations        = "environment.provider-configurations"
+	GetWebhookIntegrationDeliveryIncludeEnvironmentStorageProfile                = "environment.storage-profile"
+	GetWebhookIntegrationDeliveryIncludeEnvironmentTags                          = "environment.tags"
+	GetWebhookIntegrationDeliveryIncludeEnvironmentUpdatedBy                     = "environment.updated-by"
+	GetWebhookIntegrationDeliveryIncludeEvent                                    = "event"
+	GetWebhookIntegrationDeliveryIncludeRun                                      = "run"
+	GetWebhookIntegrationDeliveryIncludeRunApply                                 = "run.apply"
+	GetWebhookIntegrationDeliveryIncludeRunConfigurationVersion                  = "run.configuration-version"
+	GetWebhookIntegrationDeliveryIncludeRunCostEstimate                          = "run.cost-estimate"
+	GetWebhookIntegrationDeliveryIncludeRunCreatedBy                             = "run.created-by"
+	GetWebhookIntegrationDeliveryIncludeRunCreatedByRun                          = "run.created-by-run"
+	GetWebhookIntegrationDeliveryIncludeRunEnvironment                           = "run.environment"
+	GetWebhookIntegrationDeliveryIncludeRunPlan                                  = "run.plan"
+	GetWebhookIntegrationDeliveryIncludeRunPolicyChecks                          = "run.policy-checks"
+	GetWebhookIntegrationDeliveryIncludeRunStateVersions                         = "run.state-versions"
+	GetWebhookIntegrationDeliveryIncludeRunStatusTransitions                     = "run.status-transitions"
+	GetWebhookIntegrationDeliveryIncludeRunTags                                  = "run.tags"
+	GetWebhookIntegrationDeliveryIncludeRunVcsRevision                           = "run.vcs-revision"
+	GetWebhookIntegrationDeliveryIncludeRunWorkspace                             = "run.workspace"
+	GetWebhookIntegrationDeliveryIncludeTriggeredBy                              = "triggered-by"
+	GetWebhookIntegrationDeliveryIncludeTriggeredByIdentityProviders             = "triggered-by.identity-providers"
+	GetWebhookIntegrationDeliveryIncludeTriggeredByTeams                         = "triggered-by.teams"
+	GetWebhookIntegrationDeliveryIncludeWebhook                                  = "webhook"
+	GetWebhookIntegrationDeliveryIncludeWebhookAccount                           = "webhook.account"
+	GetWebhookIntegrationDeliveryIncludeWebhookEnvironments                      = "webhook.environments"
+	GetWebhookIntegrationDeliveryIncludeWebhookEvents                            = "webhook.events"
+	GetWebhookIntegrationDeliveryIncludeWorkspace                                = "workspace"
+	GetWebhookIntegrationDeliveryIncludeWorkspaceAgentPool                       = "workspace.agent-pool"
+	GetWebhookIntegrationDeliveryIncludeWorkspaceConfigurationVersion            = "workspace.configuration-version"
+	GetWebhookIntegrationDeliveryIncludeWorkspaceCreatedBy                       = "workspace.created-by"
+	GetWebhookIntegrationDeliveryIncludeWorkspaceCurrentRun                      = "workspace.current-run"
+	GetWebhookIntegrationDeliveryIncludeWorkspaceDriftReport                     = "workspace.drift-report"
+	GetWebhookIntegrationDeliveryIncludeWorkspaceEnvironment                     = "workspace.environment"
+	GetWebhookIntegrationDeliveryIncludeWorkspaceLatestConfigurationVersion      = "workspace.latest-configuration-version"
+	GetWebhookIntegrationDeliveryIncludeWorkspaceLatestRun                       = "workspace.latest-run"
+	GetWebhookIntegrationDeliveryIncludeWorkspaceLockedBy                        = "workspace.locked-by"
+	GetWebhookIntegrationDeliveryIncludeWorkspaceLockedByRun                     = "workspace.locked-by-run"
+	GetWebhookIntegrationDeliveryIncludeWorkspaceModule                          = "workspace.module"
+	GetWebhookIntegrationDeliveryIncludeWorkspaceModuleVersion                   = "workspace.module-version"
+	GetWebhookIntegrationDeliveryIncludeWorkspaceReadmeId                        = "workspace.readme-id"
+	GetWebhookIntegrationDeliveryIncludeWorkspaceSshKey                          = "workspace.ssh-key"
+	GetWebhookIntegrationDeliveryIncludeWorkspaceTags                            = "workspace.tags"
+	GetWebhookIntegrationDeliveryIncludeWorkspaceUpdatedBy                       = "workspace.updated-by"
+	GetWebhookIntegrationDeliveryIncludeWorkspaceVcsProvider                     = "workspace.vcs-provider"
+)
+
 // This endpoint returns a list of webhook integration deliveries filtered according to various criteria.
 func (c *Client) ListWebhookIntegrationDeliveriesRaw(ctx context.Context, opts *ListWebhookIntegrationDeliveriesOptions) (*client.Response, error) {
 	path := "/integrations/webhook-deliveries"
@@ -106,8 +159,7 @@ func (c *Client) ListWebhookIntegrationDeliveriesRaw(ctx context.Context, opts *
 		if opts.Query != "" {
 			params.Set("query", opts.Query)
 		}
-		// Handle parameter: Fields (map[string]interface{})
-		// Complex type map[string]interface{} - skip for now
+		opts.Fields.Encode(params)
 		// Add filters
 		for k, v := range opts.Filter {
 			params.Set("filter["+k+"]", v)
@@ -137,19 +189,18 @@ func (c *Client) ListWebhookIntegrationDeliveries(ctx context.Context, opts *Lis
 		Meta struct {
 			Pagination *client.Pagination `json:"pagination"`
 		} `json:"meta"`
-		Included []map[string]interface{} `json:"included"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
+	// Index included resources once to resolve the relationships
+	included := client.NewIncluded(result.Included)
 	resources := make([]*schemas.WebhookIntegrationDelivery, len(result.Data))
 	for i := range result.Data {
 		resources[i] = &result.Data[i]
-		// Populate included resources into relationships
-		if len(result.Included) > 0 {
-			resources[i].Relationships.PopulateIncludes(result.Included)
-		}
+		resources[i].ResolveIncludes(included)
 	}
 	return resources, nil
 }
@@ -158,6 +209,7 @@ func (c *Client) ListWebhookIntegrationDeliveries(ctx context.Context, opts *Lis
 // This is the recommended and simple way to iterate through all the results.
 //
 // The iterator automatically fetches pages as needed and handles errors inline.
+// Pages are fetched ahead in the background when the client is created with client.WithPrefetch.
 //
 // Example:
 //
@@ -169,73 +221,23 @@ func (c *Client) ListWebhookIntegrationDeliveries(ctx context.Context, opts *Lis
 //	}
 func (c *Client) ListWebhookIntegrationDeliveriesIter(ctx context.Context, opts *ListWebhookIntegrationDeliveriesOptions) iter.Seq2[schemas.WebhookIntegrationDelivery, error] {
 	return func(yield func(schemas.WebhookIntegrationDelivery, error) bool) {
-		// Determine page size from opts or use default
-		pageSize := 20
-		if opts != nil && opts.PageSize > 0 {
-			pageSize = opts.PageSize
-		}
-
-		pageNum := 1
-
-		for {
-			// Check context
-			if err := ctx.Err(); err != nil {
-				yield(schemas.WebhookIntegrationDelivery{}, err)
-				return
-			}
-
-			// Copy options and set page number
-			pageOpts := &ListWebhookIntegrationDeliveriesOptions{}
-			if opts != nil {
-				*pageOpts = *opts
-			}
-			pageOpts.PageNumber = pageNum
-			pageOpts.PageSize = pageSize
+		it := c.ListWebhookIntegrationDeliveriesPaged(ctx, opts)
+		defer it.Close() // Stops the prefetching on early exit
 
-			// Fetch page using Raw method to get pagination metadata
-			resp, err := c.ListWebhookIntegrationDeliveriesRaw(ctx, pageOpts)
-			if err != nil {
-				yield(schemas.WebhookIntegrationDelivery{}, err)
-				return
+		for it.Next() {
+			if !yield(*it.Value(), nil) {
+				return // Consumer requested early exit
 			}
-			defer resp.Body.Close()
-
-			// Decode response
-			var result struct {
-				Data []schemas.WebhookIntegrationDelivery `json:"data"`
-				Meta struct {
-					Pagination *client.Pagination `json:"pagination"`
-				} `json:"meta"`
-				Included []map[string]interface{} `json:"included"`
-			}
-			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-				yield(schemas.WebhookIntegrationDelivery{}, fmt.Errorf("failed to decode response: %w", err))
-				return
-			}
-
-			// Yield each item
-			for i := range result.Data {
-				// Populate included resources into relationships
-				if len(result.Included) > 0 {
-					result.Data[i].Relationships.PopulateIncludes(result.Included)
-				}
-				if !yield(result.Data[i], nil) {
-					return // Consumer requested early exit
-				}
-			}
-
-			// Check if there are more pages
-			if result.Meta.Pagination == nil || result.Meta.Pagination.NextPage == nil {
-				break
-			}
-
-			pageNum++
+		}
+		if err := it.Err(); err != nil {
+			yield(schemas.WebhookIntegrationDelivery{}, err)
 		}
 	}
 }
 
 // ListWebhookIntegrationDeliveriesPaged returns a stateful iterator with access to pagination metadata.
-// Use this when you need PageInfo(), Remaining(), or Collect() methods.
+// Use this when you need PageInfo(), Remaining(), or Collect() methods, or to resume
+// an interrupted iteration from the iterator Checkpoint().
 //
 // Example:
 //
@@ -248,6 +250,10 @@ func (c *Client) ListWebhookIntegrationDeliveriesIter(ctx context.Context, opts
 //	if err := iter.Err(); err != nil {
 //	    // Handle error
 //	}
+//
+// Resuming from a checkpoint saved by an iterator with the same options:
+//
+//	iter := client.WebhookIntegrationDelivery.ListWebhookIntegrationDeliveriesPaged(ctx, opts).Resume(checkpoint)
 func (c *Client) ListWebhookIntegrationDeliveriesPaged(ctx context.Context, opts *ListWebhookIntegrationDeliveriesOptions) *client.Iterator[schemas.WebhookIntegrationDelivery] {
 	// Determine page size from opts or use default
 	pageSize := 20
@@ -278,26 +284,34 @@ func (c *Client) ListWebhookIntegrationDeliveriesPaged(ctx context.Context, opts
 			Meta struct {
 				Pagination *client.Pagination `json:"pagination"`
 			} `json:"meta"`
-			Included []map[string]interface{} `json:"included"`
+			Included []json.RawMessage `json:"included"`
 		}
 		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 			return nil, nil, fmt.Errorf("failed to decode response: %w", err)
 		}
 
-		// Convert to slice of pointers and populate includes
+		// Convert to slice of pointers and resolve includes
+		included := client.NewIncluded(result.Included)
 		items := make([]*schemas.WebhookIntegrationDelivery, len(result.Data))
 		for i := range result.Data {
 			items[i] = &result.Data[i]
-			// Populate included resources into relationships
-			if len(result.Included) > 0 {
-				items[i].Relationships.PopulateIncludes(result.Included)
-			}
+			items[i].ResolveIncludes(included)
 		}
 
 		return items, result.Meta.Pagination, nil
 	}
 
-	return client.NewIterator[schemas.WebhookIntegrationDelivery](ctx, pageSize, fetchPage)
+	// Checkpoints only resume the iterators of the same listing
+	listOpts := ListWebhookIntegrationDeliveriesOptions{}
+	if opts != nil {
+		listOpts = *opts
+	}
+	listOpts.PageNumber, listOpts.PageSize = 0, 0
+	filters := client.HashOptions("ListWebhookIntegrationDeliveries", listOpts)
+
+	return client.NewIterator[schemas.WebhookIntegrationDelivery](ctx, pageSize, fetchPage).
+		Prefetch(c.httpClient.Prefetch()).
+		FiltersHash(filters)
 }
 
 // ListWebhookIntegrationDeliveriesOptions holds optional parameters for ListWebhookIntegrationDeliveries
@@ -313,6 +327,62 @@ type ListWebhookIntegrationDeliveriesOptions struct {
 	// Query string
 	Query string
 	// The value of the fields[resource-type] parameter is a comma-separated list that refers to the name of the fields to be returned for the resource. An empty value indicates that no fields should be returned.
-	Fields map[string]interface{}
+	Fields client.Fields
 	Filter map[string]string
 }
+
+// Relationship paths accepted by the Include option of ListWebhookIntegrationDeliveries
+const (
+	ListWebhookIntegrationDeliveriesIncludeEnvironment                              = "environment"
+	ListWebhookIntegrationDeliveriesIncludeEnvironmentAccount                       = "environment.account"
+	ListWebhookIntegrationDeliveriesIncludeEnvironmentCreatedBy                     = "environment.created-by"
+	ListWebhookIntegrationDeliveriesIncludeEnvironmentDefaultProviderConfigurations = "environment.default-provider-configurations"
+	ListWebhookIntegrationDeliveriesIncludeEnvironmentDefaultWorkspaceAgentPool     = "environment.default-workspace-agent-pool"
+	ListWebhookIntegrationDeliveriesIncludeEnvironmentDriftDetectionSchedules       = "environment.drift-detection-schedules"
+	ListWebhookIntegrationDeliveriesIncludeEnvironmentLockedBy                      = "environment.locked-by"
+	ListWebhookIntegrationDeliveriesIncludeEnvironmentPolicyGroups                  = "environment.policy-groups"
+	ListWebhookIntegrationDeliveriesIncludeEnvironmentProviderConfigurations        = "environment.provider-configurations"
+	ListWebhookIntegrationDeliveriesIncludeEnvironmentStorageProfile                = "environment.storage-profile"
+	ListWebhookIntegrationDeliveriesIncludeEnvironmentTags                          = "environment.tags"
+	ListWebhookIntegrationDeliveriesIncludeEnvironmentUpdatedBy                     = "environment.updated-by"
+	ListWebhookIntegrationDeliveriesIncludeEvent                                    = "event"
+	ListWebhookIntegrationDeliveriesIncludeRun                                      = "run"
+	ListWebhookIntegrationDeliveriesIncludeRunApply                                 = "run.apply"
+	ListWebhookIntegrationDeliveriesIncludeRunConfigurationVersion                  = "run.configuration-version"
+	ListWebhookIntegrationDeliveriesIncludeRunCostEstimate                          = "run.cost-estimate"
+	ListWebhookIntegrationDeliveriesIncludeRunCreatedBy                             = "run.created-by"
+	ListWebhookIntegrationDeliveriesIncludeRunCreatedByRun                          = "run.created-by-run"
+	ListWebhookIntegrationDeliveriesIncludeRunEnvironment                           = "run.environment"
+	ListWebhookIntegrationDeliveriesIncludeRunPlan                                  = "run.plan"
+	ListWebhookIntegrationDeliveriesIncludeRunPolicyChecks                          = "run.policy-checks"
+	ListWebhookIntegrationDeliveriesIncludeRunStateVersions                         = "run.state-versions"
+	ListWebhookIntegrationDeliveriesIncludeRunStatusTransitions                     = "run.status-transitions"
+	ListWebhookIntegrationDeliveriesIncludeRunTags                                  = "run.tags"
+	ListWebhookIntegrationDeliveriesIncludeRunVcsRevision                           = "run.vcs-revision"
+	ListWebhookIntegrationDeliveriesIncludeRunWorkspace                             = "run.workspace"
+	ListWebhookIntegrationDeliveriesIncludeTriggeredBy                              = "triggered-by"
+	ListWebhookIntegrationDeliveriesIncludeTriggeredByIdentityProviders             = "triggered-by.identity-providers"
+	ListWebhookIntegrationDeliveriesIncludeTriggeredByTeams                         = "triggered-by.teams"
+	ListWebhookIntegrationDeliveriesIncludeWebhook                                  = "webhook"
+	ListWebhookIntegrationDeliveriesIncludeWebhookAccount                           = "webhook.account"
+	ListWebhookIntegrationDeliveriesIncludeWebhookEnvironments                      = "webhook.environments"
+	ListWebhookIntegrationDeliveriesIncludeWebhookEvents                            = "webhook.events"
+	ListWebhookIntegrationDeliveriesIncludeWorkspace                                = "workspace"
+	ListWebhookIntegrationDeliveriesIncludeWorkspaceAgentPool                       = "workspace.agent-pool"
+	ListWebhookIntegrationDeliveriesIncludeWorkspaceConfigurationVersion            = "workspace.configuration-version"
+	ListWebhookIntegrationDeliveriesIncludeWorkspaceCreatedBy                       = "workspace.created-by"
+	ListWebhookIntegrationDeliveriesIncludeWorkspaceCurrentRun                      = "workspace.current-run"
+	ListWebhookIntegrationDeliveriesIncludeWorkspaceDriftReport                     = "workspace.drift-report"
+	ListWebhookIntegrationDeliveriesIncludeWorkspaceEnvironment                     = "workspace.environment"
+	ListWebhookIntegrationDeliveriesIncludeWorkspaceLatestConfigurationVersion      = "workspace.latest-configuration-version"
+	ListWebhookIntegrationDeliveriesIncludeWorkspaceLatestRun                       = "workspace.latest-run"
+	ListWebhookIntegrationDeliveriesIncludeWorkspaceLockedBy                        = "workspace.locked-by"
+	ListWebhookIntegrationDeliveriesIncludeWorkspaceLockedByRun                     = "workspace.locked-by-run"
+	ListWebhookIntegrationDeliveriesIncludeWorkspaceModule                          = "workspace.module"
+	ListWebhookIntegrationDeliveriesIncludeWorkspaceModuleVersion                   = "workspace.module-version"
+	ListWebhookIntegrationDeliveriesIncludeWorkspaceReadmeId                        = "workspace.readme-id"
+	ListWebhookIntegrationDeliveriesIncludeWorkspaceSshKey                          = "workspace.ssh-key"
+	ListWebhookIntegrationDeliveriesIncludeWorkspaceTags                            = "workspace.tags"
+	ListWebhookIntegrationDeliveriesIncludeWorkspaceUpdatedBy                       = "workspace.updated-by"
+	ListWebhookIntegrationDeliveriesIncludeWorkspaceVcsProvider                     = "workspace.vcs-provider"
+)
@@ -47,16 +47,14 @@ func (c *Client) CreateWorkloadIdentityProvider(ctx context.Context, req *schema
 
 	var result struct {
 		Data     schemas.WorkloadIdentityProvider `json:"data"`
-		Included []map[string]interface{}         `json:"included"`
+		Included []json.RawMessage                `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -105,16 +103,14 @@ func (c *Client) GetWorkloadIdentityProvider(ctx context.Context, workloadIdenti
 
 	var result struct {
 		Data     schemas.WorkloadIdentityProvider `json:"data"`
-		Included []map[string]interface{}         `json:"included"`
+		Included []json.RawMessage                `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -166,19 +162,18 @@ func (c *Client) ListWorkloadIdentityProviders(ctx context.Context, opts *ListWo
 		Meta struct {
 			Pagination *client.Pagination `json:"pagination"`
 		} `json:"meta"`
-		Included []map[string]interface{} `json:"included"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
+	// Index included resources once to resolve the relationships
+	included := client.NewIncluded(result.Included)
 	resources := make([]*schemas.WorkloadIdentityProvider, len(result.Data))
 	for i := range result.Data {
 		resources[i] = &result.Data[i]
-		// Populate included resources into relationships
-		if len(result.Included) > 0 {
-			resources[i].Relationships.PopulateIncludes(result.Included)
-		}
+		resources[i].ResolveIncludes(included)
 	}
 	return resources, nil
 }
@@ -187,6 +182,7 @@ func (c *Client) ListWorkloadIdentityProviders(ctx context.Context, opts *ListWo
 // This is the recommended and simple way to iterate through all the results.
 //
 // The iterator automatically fetches pages as needed and handles errors inline.
+// Pages are fetched ahead in the background when the client is created with client.WithPrefetch.
 //
 // Example:
 //
@@ -198,73 +194,23 @@ func (c *Client) ListWorkloadIdentityProviders(ctx context.Context, opts *ListWo
 //	}
 func (c *Client) ListWorkloadIdentityProvidersIter(ctx context.Context, opts *ListWorkloadIdentityProvidersOptions) iter.Seq2[schemas.WorkloadIdentityProvider, error] {
 	return func(yield func(schemas.WorkloadIdentityProvider, error) bool) {
-		// Determine page size from opts or use default
-		pageSize := 20
-		if opts != nil && opts.PageSize > 0 {
-			pageSize = opts.PageSize
-		}
-
-		pageNum := 1
-
-		for {
-			// Check context
-			if err := ctx.Err(); err != nil {
-				yield(schemas.WorkloadIdentityProvider{}, err)
-				return
-			}
-
-			// Copy options and set page number
-			pageOpts := &ListWorkloadIdentityProvidersOptions{}
-			if opts != nil {
-				*pageOpts = *opts
-			}
-			pageOpts.PageNumber = pageNum
-			pageOpts.PageSize = pageSize
-
-			// Fetch page using Raw method to get pagination metadata
-			resp, err := c.ListWorkloadIdentityProvidersRaw(ctx, pageOpts)
-			if err != nil {
-				yield(schemas.WorkloadIdentityProvider{}, err)
-				return
-			}
-			defer resp.Body.Close()
-
-			// Decode response
-			var result struct {
-				Data []schemas.WorkloadIdentityProvider `json:"data"`
-				Meta struct {
-					Pagination *client.Pagination `json:"pagination"`
-				} `json:"meta"`
-				Included []map[string]interface{} `json:"included"`
-			}
-			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-				yield(schemas.WorkloadIdentityProvider{}, fmt.Errorf("failed to decode response: %w", err))
-				return
-			}
+		it := c.ListWorkloadIdentityProvidersPaged(ctx, opts)
+		defer it.Close() // Stops the prefetching on early exit
 
-			// Yield each item
-			for i := range result.Data {
-				// Populate included resources into relationships
-				if len(result.Included) > 0 {
-					result.Data[i].Relationships.PopulateIncludes(result.Included)
-				}
-				if !yield(result.Data[i], nil) {
-					return // Consumer requested early exit
-				}
+		for it.Next() {
+			if !yield(*it.Value(), nil) {
+				return // Consumer requested early exit
 			}
-
-			// Check if there are more pages
-			if result.Meta.Pagination == nil || result.Meta.Pagination.NextPage == nil {
-				break
-			}
-
-			pageNum++
+		}
+		if err := it.Err(); err != nil {
+			yield(schemas.WorkloadIdentityProvider{}, err)
 		}
 	}
 }
 
 // ListWorkloadIdentityProvidersPaged returns a stateful iterator with access to pagination metadata.
-// Use this when you need PageInfo(), Remaining(), or Collect() methods.
+// Use this when you need PageInfo(), Remaining(), or Collect() methods, or to resume
+// an interrupted iteration from the iterator Checkpoint().
 //
 // Example:
 //
@@ -277,6 +223,10 @@ func (c *Client) ListWorkloadIdentityProvidersIter(ctx context.Context, opts *Li
 //	if err := iter.Err(); err != nil {
 //	    // Handle error
 //	}
+//
+// Resuming from a checkpoint saved by an iterator with the same options:
+//
+//	iter := client.WorkloadIdentityProvider.ListWorkloadIdentityProvidersPaged(ctx, opts).Resume(checkpoint)
 func (c *Client) ListWorkloadIdentityProvidersPaged(ctx context.Context, opts *ListWorkloadIdentityProvidersOptions) *client.Iterator[schemas.WorkloadIdentityProvider] {
 	// Determine page size from opts or use default
 	pageSize := 20
@@ -307,26 +257,34 @@ func (c *Client) ListWorkloadIdentityProvidersPaged(ctx context.Context, opts *L
 			Meta struct {
 				Pagination *client.Pagination `json:"pagination"`
 			} `json:"meta"`
-			Included []map[string]interface{} `json:"included"`
+			Included []json.RawMessage `json:"included"`
 		}
 		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 			return nil, nil, fmt.Errorf("failed to decode response: %w", err)
 		}
 
-		// Convert to slice of pointers and populate includes
+		// Convert to slice of pointers and resolve includes
+		included := client.NewIncluded(result.Included)
 		items := make([]*schemas.WorkloadIdentityProvider, len(result.Data))
 		for i := range result.Data {
 			items[i] = &result.Data[i]
-			// Populate included resources into relationships
-			if len(result.Included) > 0 {
-				items[i].Relationships.PopulateIncludes(result.Included)
-			}
+			items[i].ResolveIncludes(included)
 		}
 
 		return items, result.Meta.Pagination, nil
 	}
 
-	return client.NewIterator[schemas.WorkloadIdentityProvider](ctx, pageSize, fetchPage)
+	// Checkpoints only resume the iterators of the same listing
+	listOpts := ListWorkloadIdentityProvidersOptions{}
+	if opts != nil {
+		listOpts = *opts
+	}
+	listOpts.PageNumber, listOpts.PageSize = 0, 0
+	filters := client.HashOptions("ListWorkloadIdentityProviders", listOpts)
+
+	return client.NewIterator[schemas.WorkloadIdentityProvider](ctx, pageSize, fetchPage).
+		Prefetch(c.httpClient.Prefetch()).
+		FiltersHash(filters)
 }
 
 // ListWorkloadIdentityProvidersOptions holds optional parameters for ListWorkloadIdentityProviders
@@ -366,15 +324,13 @@ func (c *Client) UpdateWorkloadIdentityProvider(ctx context.Context, workloadIde
 
 	var result struct {
 		Data     schemas.WorkloadIdentityProvider `json:"data"`
-		Included []map[string]interface{}         `json:"included"`
+		Included []json.RawMessage                `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
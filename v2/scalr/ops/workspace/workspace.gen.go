@@ -110,17 +110,15 @@ func (c *Client) AddWorkspaceToFavorites(ctx context.Context, workspace string)
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.Workspace        `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.Workspace `json:"data"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -178,17 +176,15 @@ func (c *Client) CreateWorkspace(ctx context.Context, req *schemas.WorkspaceRequ
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.Workspace        `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.Workspace `json:"data"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -319,8 +315,7 @@ func (c *Client) GetWorkspaceRaw(ctx context.Context, workspace string, opts *Ge
 		if len(opts.Include) > 0 {
 			params.Set("include", strings.Join(opts.Include, ","))
 		}
-		// Handle parameter: Fields (map[string]interface{})
-		// Complex type map[string]interface{} - skip for now
+		opts.Fields.Encode(params)
 		// Add filters
 		for k, v := range opts.Filter {
 			params.Set("filter["+k+"]", v)
@@ -346,17 +341,15 @@ func (c *Client) GetWorkspace(ctx context.Context, workspace string, opts *GetWo
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.Workspace        `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.Workspace `json:"data"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -365,10 +358,115 @@ type GetWorkspaceOptions struct {
 	// The comma-separated list of relationship paths.
 	Include []string
 	// The value of the fields[resource-type] parameter is a comma-separated list that refers to the name of the fields to be returned for the resource. An empty value indicates that no fields should be returned.
-	Fields map[string]interface{}
+	Fields client.Fields
 	Filter map[string]string
 }
 
+// Relationship paths accepted by the Include option of GetWorkspace
+const (
+	GetWorkspaceIncludeAgentPool                                = "agent-pool"
+	GetWorkspaceIncludeAgentPoolAccount                         = "agent-pool.account"
+	GetWorkspaceIncludeAgentPoolAgents                          = "agent-pool.agents"
+	GetWorkspaceIncludeAgentPoolDefaultEnvironments             = "agent-pool.default-environments"
+	GetWorkspaceIncludeAgentPoolEnvironment                     = "agent-pool.environment"
+	GetWorkspaceIncludeAgentPoolEnvironments                    = "agent-pool.environments"
+	GetWorkspaceIncludeAgentPoolWorkspaces                      = "agent-pool.workspaces"
+	GetWorkspaceIncludeConfigurationVersion                     = "configuration-version"
+	GetWorkspaceIncludeConfigurationVersionVcsRevision          = "configuration-version.vcs-revision"
+	GetWorkspaceIncludeConfigurationVersionWorkspace            = "configuration-version.workspace"
+	GetWorkspaceIncludeCreatedBy                                = "created-by"
+	GetWorkspaceIncludeCreatedByIdentityProviders               = "created-by.identity-providers"
+	GetWorkspaceIncludeCreatedByTeams                           = "created-by.teams"
+	GetWorkspaceIncludeCurrentRun                               = "current-run"
+	GetWorkspaceIncludeCurrentRunApply                          = "current-run.apply"
+	GetWorkspaceIncludeCurrentRunConfigurationVersion           = "current-run.configuration-version"
+	GetWorkspaceIncludeCurrentRunCostEstimate                   = "current-run.cost-estimate"
+	GetWorkspaceIncludeCurrentRunCreatedBy                      = "current-run.created-by"
+	GetWorkspaceIncludeCurrentRunCreatedByRun                   = "current-run.created-by-run"
+	GetWorkspaceIncludeCurrentRunEnvironment                    = "current-run.environment"
+	GetWorkspaceIncludeCurrentRunPlan                           = "current-run.plan"
+	GetWorkspaceIncludeCurrentRunPolicyChecks                   = "current-run.policy-checks"
+	GetWorkspaceIncludeCurrentRunStateVersions                  = "current-run.state-versions"
+	GetWorkspaceIncludeCurrentRunStatusTransitions              = "current-run.status-transitions"
+	GetWorkspaceIncludeCurrentRunTags                           = "current-run.tags"
+	GetWorkspaceIncludeCurrentRunVcsRevision                    = "current-run.vcs-revision"
+	GetWorkspaceIncludeCurrentRunWorkspace                      = "current-run.workspace"
+	GetWorkspaceIncludeDriftReport                              = "drift-report"
+	GetWorkspaceIncludeDriftReportRun                           = "drift-report.run"
+	GetWorkspaceIncludeEnvironment                              = "environment"
+	GetWorkspaceIncludeEnvironmentAccount                       = "environment.account"
+	GetWorkspaceIncludeEnvironmentCreatedBy                     = "environment.created-by"
+	GetWorkspaceIncludeEnvironmentDefaultProviderConfigurations = "environment.default-provider-configurations"
+	GetWorkspaceIncludeEnvironmentDefaultWorkspaceAgentPool     = "environment.default-workspace-agent-pool"
+	GetWorkspaceIncludeEnvironmentDriftDetectionSchedules       = "environment.drift-detection-schedules"
+	GetWorkspaceIncludeEnvironmentLockedBy                      = "environment.locked-by"
+	GetWorkspaceIncludeEnvironmentPolicyGroups                  = "environment.policy-groups"
+	GetWorkspaceIncludeEnvironmentProviderConfigurations        = "environment.provider-configurations"
+	GetWorkspaceIncludeEnvironmentStorageProfile                = "environment.storage-profile"
+	GetWorkspaceIncludeEnvironmentTags                          = "environment.tags"
+	GetWorkspaceIncludeEnvironmentUpdatedBy                     = "environment.updated-by"
+	GetWorkspaceIncludeLatestConfigurationVersion               = "latest-configuration-version"
+	GetWorkspaceIncludeLatestConfigurationVersionVcsRevision    = "latest-configuration-version.vcs-revision"
+	GetWorkspaceIncludeLatestConfigurationVersionWorkspace      = "latest-configuration-version.workspace"
+	GetWorkspaceIncludeLatestRun                                = "latest-run"
+	GetWorkspaceIncludeLatestRunApply                           = "latest-run.apply"
+	GetWorkspaceIncludeLatestRunConfigurationVersion            = "latest-run.configuration-version"
+	GetWorkspaceIncludeLatestRunCostEstimate                    = "latest-run.cost-estimate"
+	GetWorkspaceIncludeLatestRunCreatedBy                       = "latest-run.created-by"
+	GetWorkspaceIncludeLatestRunCreatedByRun                    = "latest-run.created-by-run"
+	GetWorkspaceIncludeLatestRunEnvironment                     = "latest-run.environment"
+	GetWorkspaceIncludeLatestRunPlan                            = "latest-run.plan"
+	GetWorkspaceIncludeLatestRunPolicyChecks                    = "latest-run.policy-checks"
+	GetWorkspaceIncludeLatestRunStateVersions                   = "latest-run.state-versions"
+	GetWorkspaceIncludeLatestRunStatusTransitions               = "latest-run.status-transitions"
+	GetWorkspaceIncludeLatestRunTags                            = "latest-run.tags"
+	GetWorkspaceIncludeLatestRunVcsRevision                     = "latest-run.vcs-revision"
+	GetWorkspaceIncludeLatestRunWorkspace                       = "latest-run.workspace"
+	GetWorkspaceIncludeLockedBy                                 = "locked-by"
+	GetWorkspaceIncludeLockedByRun                              = "locked-by-run"
+	GetWorkspaceIncludeLockedByRunApply                         = "locked-by-run.apply"
+	GetWorkspaceIncludeLockedByRunConfigurationVersion          = "locked-by-run.configuration-version"
+	GetWorkspaceIncludeLockedByRunCostEstimate                  = "locked-by-run.cost-estimate"
+	GetWorkspaceIncludeLockedByRunCreatedBy                     = "locked-by-run.created-by"
+	GetWorkspaceIncludeLockedByRunCreatedByRun                  = "locked-by-run.created-by-run"
+	GetWorkspaceIncludeLockedByRunEnvironment                   = "locked-by-run.environment"
+	GetWorkspaceIncludeLockedByRunPlan                          = "locked-by-run.plan"
+	GetWorkspaceIncludeLockedByRunPolicyChecks                  = "locked-by-run.policy-checks"
+	GetWorkspaceIncludeLockedByRunStateVersions                 = "locked-by-run.state-versions"
+	GetWorkspaceIncludeLockedByRunStatusTransitions             = "locked-by-run.status-transitions"
+	GetWorkspaceIncludeLockedByRunTags                          = "locked-by-run.tags"
+	GetWorkspaceIncludeLockedByRunVcsRevision                   = "locked-by-run.vcs-revision"
+	GetWorkspaceIncludeLockedByRunWorkspace                     = "locked-by-run.workspace"
+	GetWorkspaceIncludeLockedByIdentityProviders                = "locked-by.identity-providers"
+	GetWorkspaceIncludeLockedByTeams                            = "locked-by.teams"
+	GetWorkspaceIncludeModule                                   = "module"
+	GetWorkspaceIncludeModuleVersion                            = "module-version"
+	GetWorkspaceIncludeModuleVersionModule                      = "module-version.module"
+	GetWorkspaceIncludeModuleVersionVcsRevision                 = "module-version.vcs-revision"
+	GetWorkspaceIncludeModuleAccount                            = "module.account"
+	GetWorkspaceIncludeModuleCreatedBy                          = "module.created-by"
+	GetWorkspaceIncludeModuleDockerIntegration                  = "module.docker-integration"
+	GetWorkspaceIncludeModuleEnvironment                        = "module.environment"
+	GetWorkspaceIncludeModuleLatestModuleVersion                = "module.latest-module-version"
+	GetWorkspaceIncludeModuleModuleVersion                      = "module.module-version"
+	GetWorkspaceIncludeModuleModuleVersions                     = "module.module-versions"
+	GetWorkspaceIncludeModuleNamespace                          = "module.namespace"
+	GetWorkspaceIncludeModuleVcsProvider                        = "module.vcs-provider"
+	GetWorkspaceIncludeReadmeId                                 = "readme-id"
+	GetWorkspaceIncludeSshKey                                   = "ssh-key"
+	GetWorkspaceIncludeSshKeyAccount                            = "ssh-key.account"
+	GetWorkspaceIncludeSshKeyEnvironments                       = "ssh-key.environments"
+	GetWorkspaceIncludeTags                                     = "tags"
+	GetWorkspaceIncludeTagsAccount                              = "tags.account"
+	GetWorkspaceIncludeUpdatedBy                                = "updated-by"
+	GetWorkspaceIncludeUpdatedByIdentityProviders               = "updated-by.identity-providers"
+	GetWorkspaceIncludeUpdatedByTeams                           = "updated-by.teams"
+	GetWorkspaceIncludeVcsProvider                              = "vcs-provider"
+	GetWorkspaceIncludeVcsProviderAccount                       = "vcs-provider.account"
+	GetWorkspaceIncludeVcsProviderAgentPool                     = "vcs-provider.agent-pool"
+	GetWorkspaceIncludeVcsProviderEnvironments                  = "vcs-provider.environments"
+)
+
 // This endpoint returns a list of outputs from current state version run output.
 func (c *Client) GetWorkspaceOutputsRaw(ctx context.Context, workspace string) (*client.Response, error) {
 	path := "/workspaces/{workspace}/outputs"
@@ -421,8 +519,7 @@ func (c *Client) GetWorkspacesRaw(ctx context.Context, opts *GetWorkspacesOption
 		if len(opts.Sort) > 0 {
 			params.Set("sort", strings.Join(opts.Sort, ","))
 		}
-		// Handle parameter: Fields (map[string]interface{})
-		// Complex type map[string]interface{} - skip for now
+		opts.Fields.Encode(params)
 		// Add filters
 		for k, v := range opts.Filter {
 			params.Set("filter["+k+"]", v)
@@ -451,19 +548,18 @@ func (c *Client) GetWorkspaces(ctx context.Context, opts *GetWorkspacesOptions)
 		Meta struct {
 			Pagination *client.Pagination `json:"pagination"`
 		} `json:"meta"`
-		Included []map[string]interface{} `json:"included"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
+	// Index included resources once to resolve the relationships
+	included := client.NewIncluded(result.Included)
 	resources := make([]*schemas.Workspace, len(result.Data))
 	for i := range result.Data {
 		resources[i] = &result.Data[i]
-		// Populate included resources into relationships
-		if len(result.Included) > 0 {
-			resources[i].Relationships.PopulateIncludes(result.Included)
-		}
+		resources[i].ResolveIncludes(included)
 	}
 	return resources, nil
 }
@@ -472,6 +568,7 @@ func (c *Client) GetWorkspaces(ctx context.Context, opts *GetWorkspacesOptions)
 // This is the recommended and simple way to iterate through all the results.
 //
 // The iterator automatically fetches pages as needed and handles errors inline.
+// Pages are fetched ahead in the background when the client is created with client.WithPrefetch.
 //
 // Example:
 //
@@ -483,73 +580,23 @@ func (c *Client) GetWorkspaces(ctx context.Context, opts *GetWorkspacesOptions)
 //	}
 func (c *Client) GetWorkspacesIter(ctx context.Context, opts *GetWorkspacesOptions) iter.Seq2[schemas.Workspace, error] {
 	return func(yield func(schemas.Workspace, error) bool) {
-		// Determine page size from opts or use default
-		pageSize := 20
-		if opts != nil && opts.PageSize > 0 {
-			pageSize = opts.PageSize
-		}
-
-		pageNum := 1
-
-		for {
-			// Check context
-			if err := ctx.Err(); err != nil {
-				yield(schemas.Workspace{}, err)
-				return
-			}
-
-			// Copy options and set page number
-			pageOpts := &GetWorkspacesOptions{}
-			if opts != nil {
-				*pageOpts = *opts
-			}
-			pageOpts.PageNumber = pageNum
-			pageOpts.PageSize = pageSize
-
-			// Fetch page using Raw method to get pagination metadata
-			resp, err := c.GetWorkspacesRaw(ctx, pageOpts)
-			if err != nil {
-				yield(schemas.Workspace{}, err)
-				return
-			}
-			defer resp.Body.Close()
-
-			// Decode response
-			var result struct {
-				Data []schemas.Workspace `json:"data"`
-				Meta struct {
-					Pagination *client.Pagination `json:"pagination"`
-				} `json:"meta"`
-				Included []map[string]interface{} `json:"included"`
-			}
-			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-				yield(schemas.Workspace{}, fmt.Errorf("failed to decode response: %w", err))
-				return
-			}
-
-			// Yield each item
-			for i := range result.Data {
-				// Populate included resources into relationships
-				if len(result.Included) > 0 {
-					result.Data[i].Relationships.PopulateIncludes(result.Included)
-				}
-				if !yield(result.Data[i], nil) {
-					return // Consumer requested early exit
-				}
-			}
+		it := c.GetWorkspacesPaged(ctx, opts)
+		defer it.Close() // Stops the prefetching on early exit
 
-			// Check if there are more pages
-			if result.Meta.Pagination == nil || result.Meta.Pagination.NextPage == nil {
-				break
+		for it.Next() {
+			if !yield(*it.Value(), nil) {
+				return // Consumer requested early exit
 			}
-
-			pageNum++
+		}
+		if err := it.Err(); err != nil {
+			yield(schemas.Workspace{}, err)
 		}
 	}
 }
 
 // GetWorkspacesPaged returns a stateful iterator with access to pagination metadata.
-// Use this when you need PageInfo(), Remaining(), or Collect() methods.
+// Use this when you need PageInfo(), Remaining(), or Collect() methods, or to resume
+// an interrupted iteration from the iterator Checkpoint().
 //
 // Example:
 //
@@ -562,6 +609,10 @@ func (c *Client) GetWorkspacesIter(ctx context.Context, opts *GetWorkspacesOptio
 //	if err := iter.Err(); err != nil {
 //	    // Handle error
 //	}
+//
+// Resuming from a checkpoint saved by an iterator with the same options:
+//
+//	iter := client.Workspace.GetWorkspacesPaged(ctx, opts).Resume(checkpoint)
 func (c *Client) GetWorkspacesPaged(ctx context.Context, opts *GetWorkspacesOptions) *client.Iterator[schemas.Workspace] {
 	// Determine page size from opts or use default
 	pageSize := 20
@@ -592,26 +643,34 @@ func (c *Client) GetWorkspacesPaged(ctx context.Context, opts *GetWorkspacesOpti
 			Meta struct {
 				Pagination *client.Pagination `json:"pagination"`
 			} `json:"meta"`
-			Included []map[string]interface{} `json:"included"`
+			Included []json.RawMessage `json:"included"`
 		}
 		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 			return nil, nil, fmt.Errorf("failed to decode response: %w", err)
 		}
 
-		// Convert to slice of pointers and populate includes
+		// Convert to slice of pointers and resolve includes
+		included := client.NewIncluded(result.Included)
 		items := make([]*schemas.Workspace, len(result.Data))
 		for i := range result.Data {
 			items[i] = &result.Data[i]
-			// Populate included resources into relationships
-			if len(result.Included) > 0 {
-				items[i].Relationships.PopulateIncludes(result.Included)
-			}
+			items[i].ResolveIncludes(included)
 		}
 
 		return items, result.Meta.Pagination, nil
 	}
 
-	return client.NewIterator[schemas.Workspace](ctx, pageSize, fetchPage)
+	// Checkpoints only resume the iterators of the same listing
+	listOpts := GetWorkspacesOptions{}
+	if opts != nil {
+		listOpts = *opts
+	}
+	listOpts.PageNumber, listOpts.PageSize = 0, 0
+	filters := client.HashOptions("GetWorkspaces", listOpts)
+
+	return client.NewIterator[schemas.Workspace](ctx, pageSize, fetchPage).
+		Prefetch(c.httpClient.Prefetch()).
+		FiltersHash(filters)
 }
 
 // GetWorkspacesOptions holds optional parameters for GetWorkspaces
@@ -629,10 +688,115 @@ type GetWorkspacesOptions struct {
 	// The comma-separated list of attributes.
 	Sort []string
 	// The value of the fields[resource-type] parameter is a comma-separated list that refers to the name of the fields to be returned for the resource. An empty value indicates that no fields should be returned.
-	Fields map[string]interface{}
+	Fields client.Fields
 	Filter map[string]string
 }
 
+// Relationship paths accepted by the Include option of GetWorkspaces
+const (
+	GetWorkspacesIncludeAgentPool                                = "agent-pool"
+	GetWorkspacesIncludeAgentPoolAccount                         = "agent-pool.account"
+	GetWorkspacesIncludeAgentPoolAgents                          = "agent-pool.agents"
+	GetWorkspacesIncludeAgentPoolDefaultEnvironments             = "agent-pool.default-environments"
+	GetWorkspacesIncludeAgentPoolEnvironment                     = "agent-pool.environment"
+	GetWorkspacesIncludeAgentPoolEnvironments                    = "agent-pool.environments"
+	GetWorkspacesIncludeAgentPoolWorkspaces                      = "agent-pool.workspaces"
+	GetWorkspacesIncludeConfigurationVersion                     = "configuration-version"
+	GetWorkspacesIncludeConfigurationVersionVcsRevision          = "configuration-version.vcs-revision"
+	GetWorkspacesIncludeConfigurationVersionWorkspace            = "configuration-version.workspace"
+	GetWorkspacesIncludeCreatedBy                                = "created-by"
+	GetWorkspacesIncludeCreatedByIdentityProviders               = "created-by.identity-providers"
+	GetWorkspacesIncludeCreatedByTeams                           = "created-by.teams"
+	GetWorkspacesIncludeCurrentRun                               = "current-run"
+	GetWorkspacesIncludeCurrentRunApply                          = "current-run.apply"
+	GetWorkspacesIncludeCurrentRunConfigurationVersion           = "current-run.configuration-version"
+	GetWorkspacesIncludeCurrentRunCostEstimate                   = "current-run.cost-estimate"
+	GetWorkspacesIncludeCurrentRunCreatedBy                      = "current-run.created-by"
+	GetWorkspacesIncludeCurrentRunCreatedByRun                   = "current-run.created-by-run"
+	GetWorkspacesIncludeCurrentRunEnvironment                    = "current-run.environment"
+	GetWorkspacesIncludeCurrentRunPlan                           = "current-run.plan"
+	GetWorkspacesIncludeCurrentRunPolicyChecks                   = "current-run.policy-checks"
+	GetWorkspacesIncludeCurrentRunStateVersions                  = "current-run.state-versions"
+	GetWorkspacesIncludeCurrentRunStatusTransitions              = "current-run.status-transitions"
+	GetWorkspacesIncludeCurrentRunTags                           = "current-run.tags"
+	GetWorkspacesIncludeCurrentRunVcsRevision                    = "current-run.vcs-revision"
+	GetWorkspacesIncludeCurrentRunWorkspace                      = "current-run.workspace"
+	GetWorkspacesIncludeDriftReport                              = "drift-report"
+	GetWorkspacesIncludeDriftReportRun                           = "drift-report.run"
+	GetWorkspacesIncludeEnvironment                              = "environment"
+	GetWorkspacesIncludeEnvironmentAccount                       = "environment.account"
+	GetWorkspacesIncludeEnvironmentCreatedBy                     = "environment.created-by"
+	GetWorkspacesIncludeEnvironmentDefaultProviderConfigurations = "environment.default-provider-configurations"
+	GetWorkspacesIncludeEnvironmentDefaultWorkspaceAgentPool     = "environment.default-workspace-agent-pool"
+	GetWorkspacesIncludeEnvironmentDriftDetectionSchedules       = "environment.drift-detection-schedules"
+	GetWorkspacesIncludeEnvironmentLockedBy                      = "environment.locked-by"
+	GetWorkspacesIncludeEnvironmentPolicyGroups                  = "environment.policy-groups"
+	GetWorkspacesIncludeEnvironmentProviderConfigurations        = "environment.provider-configurations"
+	GetWorkspacesIncludeEnvironmentStorageProfile                = "environment.storage-profile"
+	GetWorkspacesIncludeEnvironmentTags                          = "environment.tags"
+	GetWorkspacesIncludeEnvironmentUpdatedBy                     = "environment.updated-by"
+	GetWorkspacesIncludeLatestConfigurationVersion               = "latest-configuration-version"
+	GetWorkspacesIncludeLatestConfigurationVersionVcsRevision    = "latest-configuration-version.vcs-revision"
+	GetWorkspacesIncludeLatestConfigurationVersionWorkspace      = "latest-configuration-version.workspace"
+	GetWorkspacesIncludeLatestRun                                = "latest-run"
+	GetWorkspacesIncludeLatestRunApply                           = "latest-run.apply"
+	GetWorkspacesIncludeLatestRunConfigurationVersion            = "latest-run.configuration-version"
+	GetWorkspacesIncludeLatestRunCostEstimate                    = "latest-run.cost-estimate"
+	GetWorkspacesIncludeLatestRunCreatedBy                       = "latest-run.created-by"
+	GetWorkspacesIncludeLatestRunCreatedByRun                    = "latest-run.created-by-run"
+	GetWorkspacesIncludeLatestRunEnvironment                     = "latest-run.environment"
+	GetWorkspacesIncludeLatestRunPlan                            = "latest-run.plan"
+	GetWorkspacesIncludeLatestRunPolicyChecks                    = "latest-run.policy-checks"
+	GetWorkspacesIncludeLatestRunStateVersions                   = "latest-run.state-versions"
+	GetWorkspacesIncludeLatestRunStatusTransitions               = "latest-run.status-transitions"
+	GetWorkspacesIncludeLatestRunTags                            = "latest-run.tags"
+	GetWorkspacesIncludeLatestRunVcsRevision                     = "latest-run.vcs-revision"
+	GetWorkspacesIncludeLatestRunWorkspace                       = "latest-run.workspace"
+	GetWorkspacesIncludeLockedBy                                 = "locked-by"
+	GetWorkspacesIncludeLockedByRun                              = "locked-by-run"
+	GetWorkspacesIncludeLockedByRunApply                         = "locked-by-run.apply"
+	GetWorkspacesIncludeLockedByRunConfigurationVersion          = "locked-by-run.configuration-version"
+	GetWorkspacesIncludeLockedByRunCostEstimate                  = "locked-by-run.cost-estimate"
+	GetWorkspacesIncludeLockedByRunCreatedBy                     = "locked-by-run.created-by"
+	GetWorkspacesIncludeLockedByRunCreatedByRun                  = "locked-by-run.created-by-run"
+	GetWorkspacesIncludeLockedByRunEnvironment                   = "locked-by-run.environment"
+	GetWorkspacesIncludeLockedByRunPlan                          = "locked-by-run.plan"
+	GetWorkspacesIncludeLockedByRunPolicyChecks                  = "locked-by-run.policy-checks"
+	GetWorkspacesIncludeLockedByRunStateVersions                 = "locked-by-run.state-versions"
+	GetWorkspacesIncludeLockedByRunStatusTransitions             = "locked-by-run.status-transitions"
+	GetWorkspacesIncludeLockedByRunTags                          = "locked-by-run.tags"
+	GetWorkspacesIncludeLockedByRunVcsRevision                   = "locked-by-run.vcs-revision"
+	GetWorkspacesIncludeLockedByRunWorkspace                     = "locked-by-run.workspace"
+	GetWorkspacesIncludeLockedByIdentityProviders                = "locked-by.identity-providers"
+	GetWorkspacesIncludeLockedByTeams                            = "locked-by.teams"
+	GetWorkspacesIncludeModule                                   = "module"
+	GetWorkspacesIncludeModuleVersion                            = "module-version"
+	GetWorkspacesIncludeModuleVersionModule                      = "module-version.module"
+	GetWorkspacesIncludeModuleVersionVcsRevision                 = "module-version.vcs-revision"
+	GetWorkspacesIncludeModuleAccount                            = "module.account"
+	GetWorkspacesIncludeModuleCreatedBy                          = "module.created-by"
+	GetWorkspacesIncludeModuleDockerIntegration                  = "module.docker-integration"
+	GetWorkspacesIncludeModuleEnvironment                        = "module.environment"
+	GetWorkspacesIncludeModuleLatestModuleVersion                = "module.latest-module-version"
+	GetWorkspacesIncludeModuleModuleVersion                      = "module.module-version"
+	GetWorkspacesIncludeModuleModuleVersions                     = "module.module-versions"
+	GetWorkspacesIncludeModuleNamespace                          = "module.namespace"
+	GetWorkspacesIncludeModuleVcsProvider                        = "module.vcs-provider"
+	GetWorkspacesIncludeReadmeId                                 = "readme-id"
+	GetWorkspacesIncludeSshKey                                   = "ssh-key"
+	GetWorkspacesIncludeSshKeyAccount                            = "ssh-key.account"
+	GetWorkspacesIncludeSshKeyEnvironments                       = "ssh-key.environments"
+	GetWorkspacesIncludeTags                                     = "tags"
+	GetWorkspacesIncludeTagsAccount                              = "tags.account"
+	GetWorkspacesIncludeUpdatedBy                                = "updated-by"
+	GetWorkspacesIncludeUpdatedByIdentityProviders               = "updated-by.identity-providers"
+	GetWorkspacesIncludeUpdatedByTeams                           = "updated-by.teams"
+	GetWorkspacesIncludeVcsProvider                              = "vcs-provider"
+	GetWorkspacesIncludeVcsProviderAccount                       = "vcs-provider.account"
+	GetWorkspacesIncludeVcsProviderAgentPool                     = "vcs-provider.agent-pool"
+	GetWorkspacesIncludeVcsProviderEnvironments                  = "vcs-provider.environments"
+)
+
 // This endpoint returns a list of other workspaces that that were explicitly added as state consumers for given workspace.
 func (c *Client) ListRemoteStateConsumersRaw(ctx context.Context, workspace string, opts *ListRemoteStateConsumersOptions) (*client.Response, error) {
 	path := "/workspaces/{workspace}/relationships/remote-state-consumers"
@@ -675,7 +839,7 @@ func (c *Client) ListRemoteStateConsumers(ctx context.Context, workspace string,
 		Meta struct {
 			Pagination *client.Pagination `json:"pagination"`
 		} `json:"meta"`
-		Included []map[string]interface{} `json:"included"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
@@ -692,6 +856,7 @@ func (c *Client) ListRemoteStateConsumers(ctx context.Context, workspace string,
 // This is the recommended and simple way to iterate through all the results.
 //
 // The iterator automatically fetches pages as needed and handles errors inline.
+// Pages are fetched ahead in the background when the client is created with client.WithPrefetch.
 //
 // Example:
 //
@@ -703,69 +868,23 @@ func (c *Client) ListRemoteStateConsumers(ctx context.Context, workspace string,
 //	}
 func (c *Client) ListRemoteStateConsumersIter(ctx context.Context, workspace string, opts *ListRemoteStateConsumersOptions) iter.Seq2[schemas.Workspace, error] {
 	return func(yield func(schemas.Workspace, error) bool) {
-		// Determine page size from opts or use default
-		pageSize := 20
-		if opts != nil && opts.PageSize > 0 {
-			pageSize = opts.PageSize
-		}
-
-		pageNum := 1
-
-		for {
-			// Check context
-			if err := ctx.Err(); err != nil {
-				yield(schemas.Workspace{}, err)
-				return
-			}
-
-			// Copy options and set page number
-			pageOpts := &ListRemoteStateConsumersOptions{}
-			if opts != nil {
-				*pageOpts = *opts
-			}
-			pageOpts.PageNumber = pageNum
-			pageOpts.PageSize = pageSize
-
-			// Fetch page using Raw method to get pagination metadata
-			resp, err := c.ListRemoteStateConsumersRaw(ctx, workspace, pageOpts)
-			if err != nil {
-				yield(schemas.Workspace{}, err)
-				return
-			}
-			defer resp.Body.Close()
-
-			// Decode response
-			var result struct {
-				Data []schemas.Workspace `json:"data"`
-				Meta struct {
-					Pagination *client.Pagination `json:"pagination"`
-				} `json:"meta"`
-				Included []map[string]interface{} `json:"included"`
-			}
-			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-				yield(schemas.Workspace{}, fmt.Errorf("failed to decode response: %w", err))
-				return
-			}
+		it := c.ListRemoteStateConsumersPaged(ctx, workspace, opts)
+		defer it.Close() // Stops the prefetching on early exit
 
-			// Yield each item
-			for i := range result.Data {
-				if !yield(result.Data[i], nil) {
-					return // Consumer requested early exit
-				}
+		for it.Next() {
+			if !yield(*it.Value(), nil) {
+				return // Consumer requested early exit
 			}
-
-			// Check if there are more pages
-			if result.Meta.Pagination == nil || result.Meta.Pagination.NextPage == nil {
-				break
-			}
-
-			pageNum++
+		}
+		if err := it.Err(); err != nil {
+			yield(schemas.Workspace{}, err)
 		}
 	}
 }
 
 // ListRemoteStateConsumersPaged returns a stateful iterator with access to pagination metadata.
-// Use this when you need PageInfo(), Remaining(), or Collect() methods.
+// Use this when you need PageInfo(), Remaining(), or Collect() methods, or to resume
+// an interrupted iteration from the iterator Checkpoint().
 //
 // Example:
 //
@@ -778,6 +897,10 @@ func (c *Client) ListRemoteStateConsumersIter(ctx context.Context, workspace str
 //	if err := iter.Err(); err != nil {
 //	    // Handle error
 //	}
+//
+// Resuming from a checkpoint saved by an iterator with the same options:
+//
+//	iter := client.Workspace.ListRemoteStateConsumersPaged(ctx, workspace, opts).Resume(checkpoint)
 func (c *Client) ListRemoteStateConsumersPaged(ctx context.Context, workspace string, opts *ListRemoteStateConsumersOptions) *client.Iterator[schemas.Workspace] {
 	// Determine page size from opts or use default
 	pageSize := 20
@@ -808,13 +931,13 @@ func (c *Client) ListRemoteStateConsumersPaged(ctx context.Context, workspace st
 			Meta struct {
 				Pagination *client.Pagination `json:"pagination"`
 			} `json:"meta"`
-			Included []map[string]interface{} `json:"included"`
+			Included []json.RawMessage `json:"included"`
 		}
 		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 			return nil, nil, fmt.Errorf("failed to decode response: %w", err)
 		}
 
-		// Convert to slice of pointers and populate includes
+		// Convert to slice of pointers and resolve includes
 		items := make([]*schemas.Workspace, len(result.Data))
 		for i := range result.Data {
 			items[i] = &result.Data[i]
@@ -823,7 +946,17 @@ func (c *Client) ListRemoteStateConsumersPaged(ctx context.Context, workspace st
 		return items, result.Meta.Pagination, nil
 	}
 
-	return client.NewIterator[schemas.Workspace](ctx, pageSize, fetchPage)
+	// Checkpoints only resume the iterators of the same listing
+	listOpts := ListRemoteStateConsumersOptions{}
+	if opts != nil {
+		listOpts = *opts
+	}
+	listOpts.PageNumber, listOpts.PageSize = 0, 0
+	filters := client.HashOptions("ListRemoteStateConsumers", workspace, listOpts)
+
+	return client.NewIterator[schemas.Workspace](ctx, pageSize, fetchPage).
+		Prefetch(c.httpClient.Prefetch()).
+		FiltersHash(filters)
 }
 
 // ListRemoteStateConsumersOptions holds optional parameters for ListRemoteStateConsumers
@@ -877,7 +1010,7 @@ func (c *Client) ListWorkspaceTags(ctx context.Context, workspace string, opts *
 		Meta struct {
 			Pagination *client.Pagination `json:"pagination"`
 		} `json:"meta"`
-		Included []map[string]interface{} `json:"included"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
@@ -894,6 +1027,7 @@ func (c *Client) ListWorkspaceTags(ctx context.Context, workspace string, opts *
 // This is the recommended and simple way to iterate through all the results.
 //
 // The iterator automatically fetches pages as needed and handles errors inline.
+// Pages are fetched ahead in the background when the client is created with client.WithPrefetch.
 //
 // Example:
 //
@@ -905,69 +1039,23 @@ func (c *Client) ListWorkspaceTags(ctx context.Context, workspace string, opts *
 //	}
 func (c *Client) ListWorkspaceTagsIter(ctx context.Context, workspace string, opts *ListWorkspaceTagsOptions) iter.Seq2[schemas.Tag, error] {
 	return func(yield func(schemas.Tag, error) bool) {
-		// Determine page size from opts or use default
-		pageSize := 20
-		if opts != nil && opts.PageSize > 0 {
-			pageSize = opts.PageSize
-		}
-
-		pageNum := 1
-
-		for {
-			// Check context
-			if err := ctx.Err(); err != nil {
-				yield(schemas.Tag{}, err)
-				return
-			}
-
-			// Copy options and set page number
-			pageOpts := &ListWorkspaceTagsOptions{}
-			if opts != nil {
-				*pageOpts = *opts
-			}
-			pageOpts.PageNumber = pageNum
-			pageOpts.PageSize = pageSize
-
-			// Fetch page using Raw method to get pagination metadata
-			resp, err := c.ListWorkspaceTagsRaw(ctx, workspace, pageOpts)
-			if err != nil {
-				yield(schemas.Tag{}, err)
-				return
-			}
-			defer resp.Body.Close()
-
-			// Decode response
-			var result struct {
-				Data []schemas.Tag `json:"data"`
-				Meta struct {
-					Pagination *client.Pagination `json:"pagination"`
-				} `json:"meta"`
-				Included []map[string]interface{} `json:"included"`
-			}
-			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-				yield(schemas.Tag{}, fmt.Errorf("failed to decode response: %w", err))
-				return
-			}
-
-			// Yield each item
-			for i := range result.Data {
-				if !yield(result.Data[i], nil) {
-					return // Consumer requested early exit
-				}
-			}
+		it := c.ListWorkspaceTagsPaged(ctx, workspace, opts)
+		defer it.Close() // Stops the prefetching on early exit
 
-			// Check if there are more pages
-			if result.Meta.Pagination == nil || result.Meta.Pagination.NextPage == nil {
-				break
+		for it.Next() {
+			if !yield(*it.Value(), nil) {
+				return // Consumer requested early exit
 			}
-
-			pageNum++
+		}
+		if err := it.Err(); err != nil {
+			yield(schemas.Tag{}, err)
 		}
 	}
 }
 
 // ListWorkspaceTagsPaged returns a stateful iterator with access to pagination metadata.
-// Use this when you need PageInfo(), Remaining(), or Collect() methods.
+// Use this when you need PageInfo(), Remaining(), or Collect() methods, or to resume
+// an interrupted iteration from the iterator Checkpoint().
 //
 // Example:
 //
@@ -980,6 +1068,10 @@ func (c *Client) ListWorkspaceTagsIter(ctx context.Context, workspace string, op
 //	if err := iter.Err(); err != nil {
 //	    // Handle error
 //	}
+//
+// Resuming from a checkpoint saved by an iterator with the same options:
+//
+//	iter := client.Workspace.ListWorkspaceTagsPaged(ctx, workspace, opts).Resume(checkpoint)
 func (c *Client) ListWorkspaceTagsPaged(ctx context.Context, workspace string, opts *ListWorkspaceTagsOptions) *client.Iterator[schemas.Tag] {
 	// Determine page size from opts or use default
 	pageSize := 20
@@ -1010,13 +1102,13 @@ func (c *Client) ListWorkspaceTagsPaged(ctx context.Context, workspace string, o
 			Meta struct {
 				Pagination *client.Pagination `json:"pagination"`
 			} `json:"meta"`
-			Included []map[string]interface{} `json:"included"`
+			Included []json.RawMessage `json:"included"`
 		}
 		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 			return nil, nil, fmt.Errorf("failed to decode response: %w", err)
 		}
 
-		// Convert to slice of pointers and populate includes
+		// Convert to slice of pointers and resolve includes
 		items := make([]*schemas.Tag, len(result.Data))
 		for i := range result.Data {
 			items[i] = &result.Data[i]
@@ -1025,7 +1117,17 @@ func (c *Client) ListWorkspaceTagsPaged(ctx context.Context, workspace string, o
 		return items, result.Meta.Pagination, nil
 	}
 
-	return client.NewIterator[schemas.Tag](ctx, pageSize, fetchPage)
+	// Checkpoints only resume the iterators of the same listing
+	listOpts := ListWorkspaceTagsOptions{}
+	if opts != nil {
+		listOpts = *opts
+	}
+	listOpts.PageNumber, listOpts.PageSize = 0, 0
+	filters := client.HashOptions("ListWorkspaceTags", workspace, listOpts)
+
+	return client.NewIterator[schemas.Tag](ctx, pageSize, fetchPage).
+		Prefetch(c.httpClient.Prefetch()).
+		FiltersHash(filters)
 }
 
 // ListWorkspaceTagsOptions holds optional parameters for ListWorkspaceTags
@@ -1079,7 +1181,7 @@ func (c *Client) ListWorkspaceVariableSets(ctx context.Context, workspace string
 		Meta struct {
 			Pagination *client.Pagination `json:"pagination"`
 		} `json:"meta"`
-		Included []map[string]interface{} `json:"included"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
@@ -1096,6 +1198,7 @@ func (c *Client) ListWorkspaceVariableSets(ctx context.Context, workspace string
 // This is the recommended and simple way to iterate through all the results.
 //
 // The iterator automatically fetches pages as needed and handles errors inline.
+// Pages are fetched ahead in the background when the client is created with client.WithPrefetch.
 //
 // Example:
 //
@@ -1107,69 +1210,23 @@ func (c *Client) ListWorkspaceVariableSets(ctx context.Context, workspace string
 //	}
 func (c *Client) ListWorkspaceVariableSetsIter(ctx context.Context, workspace string, opts *ListWorkspaceVariableSetsOptions) iter.Seq2[schemas.VariableSet, error] {
 	return func(yield func(schemas.VariableSet, error) bool) {
-		// Determine page size from opts or use default
-		pageSize := 20
-		if opts != nil && opts.PageSize > 0 {
-			pageSize = opts.PageSize
-		}
+		it := c.ListWorkspaceVariableSetsPaged(ctx, workspace, opts)
+		defer it.Close() // Stops the prefetching on early exit
 
-		pageNum := 1
-
-		for {
-			// Check context
-			if err := ctx.Err(); err != nil {
-				yield(schemas.VariableSet{}, err)
-				return
+		for it.Next() {
+			if !yield(*it.Value(), nil) {
+				return // Consumer requested early exit
 			}
-
-			// Copy options and set page number
-			pageOpts := &ListWorkspaceVariableSetsOptions{}
-			if opts != nil {
-				*pageOpts = *opts
-			}
-			pageOpts.PageNumber = pageNum
-			pageOpts.PageSize = pageSize
-
-			// Fetch page using Raw method to get pagination metadata
-			resp, err := c.ListWorkspaceVariableSetsRaw(ctx, workspace, pageOpts)
-			if err != nil {
-				yield(schemas.VariableSet{}, err)
-				return
-			}
-			defer resp.Body.Close()
-
-			// Decode response
-			var result struct {
-				Data []schemas.VariableSet `json:"data"`
-				Meta struct {
-					Pagination *client.Pagination `json:"pagination"`
-				} `json:"meta"`
-				Included []map[string]interface{} `json:"included"`
-			}
-			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-				yield(schemas.VariableSet{}, fmt.Errorf("failed to decode response: %w", err))
-				return
-			}
-
-			// Yield each item
-			for i := range result.Data {
-				if !yield(result.Data[i], nil) {
-					return // Consumer requested early exit
-				}
-			}
-
-			// Check if there are more pages
-			if result.Meta.Pagination == nil || result.Meta.Pagination.NextPage == nil {
-				break
-			}
-
-			pageNum++
+		}
+		if err := it.Err(); err != nil {
+			yield(schemas.VariableSet{}, err)
 		}
 	}
 }
 
 // ListWorkspaceVariableSetsPaged returns a stateful iterator with access to pagination metadata.
-// Use this when you need PageInfo(), Remaining(), or Collect() methods.
+// Use this when you need PageInfo(), Remaining(), or Collect() methods, or to resume
+// an interrupted iteration from the iterator Checkpoint().
 //
 // Example:
 //
@@ -1182,6 +1239,10 @@ func (c *Client) ListWorkspaceVariableSetsIter(ctx context.Context, workspace st
 //	if err := iter.Err(); err != nil {
 //	    // Handle error
 //	}
+//
+// Resuming from a checkpoint saved by an iterator with the same options:
+//
+//	iter := client.Workspace.ListWorkspaceVariableSetsPaged(ctx, workspace, opts).Resume(checkpoint)
 func (c *Client) ListWorkspaceVariableSetsPaged(ctx context.Context, workspace string, opts *ListWorkspaceVariableSetsOptions) *client.Iterator[schemas.VariableSet] {
 	// Determine page size from opts or use default
 	pageSize := 20
@@ -1212,13 +1273,13 @@ func (c *Client) ListWorkspaceVariableSetsPaged(ctx context.Context, workspace s
 			Meta struct {
 				Pagination *client.Pagination `json:"pagination"`
 			} `json:"meta"`
-			Included []map[string]interface{} `json:"included"`
+			Included []json.RawMessage `json:"included"`
 		}
 		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 			return nil, nil, fmt.Errorf("failed to decode response: %w", err)
 		}
 
-		// Convert to slice of pointers and populate includes
+		// Convert to slice of pointers and resolve includes
 		items := make([]*schemas.VariableSet, len(result.Data))
 		for i := range result.Data {
 			items[i] = &result.Data[i]
@@ -1227,7 +1288,17 @@ func (c *Client) ListWorkspaceVariableSetsPaged(ctx context.Context, workspace s
 		return items, result.Meta.Pagination, nil
 	}
 
-	return client.NewIterator[schemas.VariableSet](ctx, pageSize, fetchPage)
+	// Checkpoints only resume the iterators of the same listing
+	listOpts := ListWorkspaceVariableSetsOptions{}
+	if opts != nil {
+		listOpts = *opts
+	}
+	listOpts.PageNumber, listOpts.PageSize = 0, 0
+	filters := client.HashOptions("ListWorkspaceVariableSets", workspace, listOpts)
+
+	return client.NewIterator[schemas.VariableSet](ctx, pageSize, fetchPage).
+		Prefetch(c.httpClient.Prefetch()).
+		FiltersHash(filters)
 }
 
 // ListWorkspaceVariableSetsOptions holds optional parameters for ListWorkspaceVariableSets
@@ -1262,17 +1333,15 @@ func (c *Client) LockWorkspace(ctx context.Context, workspace string, req *schem
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.Workspace        `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.Workspace `json:"data"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -1297,17 +1366,15 @@ func (c *Client) RemoveWorkspaceFromFavorites(ctx context.Context, workspace str
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.Workspace        `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.Workspace `json:"data"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -1429,16 +1496,14 @@ func (c *Client) ResyncWorkspace(ctx context.Context, workspace string) (*schema
 
 	var result struct {
 		Data     schemas.ConfigurationVersion `json:"data"`
-		Included []map[string]interface{}     `json:"included"`
+		Included []json.RawMessage            `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -1463,17 +1528,15 @@ func (c *Client) SetSchedule(ctx context.Context, workspace string, req *schemas
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.Workspace        `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.Workspace `json:"data"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -1498,17 +1561,15 @@ func (c *Client) UnlockWorkspace(ctx context.Context, workspace string) (*schema
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.Workspace        `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.Workspace `json:"data"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
 
@@ -1533,16 +1594,14 @@ func (c *Client) UpdateWorkspace(ctx context.Context, workspace string, req *sch
 	defer resp.Body.Close()
 
 	var result struct {
-		Data     schemas.Workspace        `json:"data"`
-		Included []map[string]interface{} `json:"included"`
+		Data     schemas.Workspace `json:"data"`
+		Included []json.RawMessage `json:"included"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Populate included resources into relationships
-	if len(result.Included) > 0 {
-		result.Data.Relationships.PopulateIncludes(result.Included)
-	}
+	// Resolve included resources into relationships
+	result.Data.ResolveIncludes(client.NewIncluded(result.Included))
 	return &result.Data, nil
 }
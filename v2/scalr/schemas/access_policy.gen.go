@@ -5,6 +5,7 @@ package schemas
 import (
 	"encoding/json"
 
+	"github.com/scalr/go-scalr/v2/scalr/client"
 	"github.com/scalr/go-scalr/v2/scalr/value"
 )
 
@@ -15,6 +16,9 @@ type AccessPolicy struct {
 	Type          string                    `json:"type"`
 	Attributes    AccessPolicyAttributes    `json:"attributes"`
 	Relationships AccessPolicyRelationships `json:"relationships"`
+
+	// stub is set for a related resource which was not included, only its ID and type are known
+	stub bool
 }
 
 // GetID returns the resource ID (implements client.ResourceLike)
@@ -30,6 +34,33 @@ func (r AccessPolicy) GetResourceType() string {
 	return "access-policies"
 }
 
+// Loaded reports whether the resource holds its attributes and relationships.
+// It is false for a related resource which was not included in the response,
+// only its ID and type are known then.
+func (r AccessPolicy) Loaded() bool {
+	return !r.stub
+}
+
+// ResolveIncludes replaces the relationships with the included resources (implements client.IncludeResolver)
+func (r *AccessPolicy) ResolveIncludes(included *client.Included) {
+	r.Relationships.ResolveIncludes(included)
+}
+
+// AccessPolicyResourceType is the JSON:API resource type of AccessPolicy, the key of its sparse fieldset
+const AccessPolicyResourceType = "access-policies"
+
+// AccessPolicy field names, to select with sparse fieldsets
+const (
+	AccessPolicyFieldAccount        = "account"
+	AccessPolicyFieldEnvironment    = "environment"
+	AccessPolicyFieldIsSystem       = "is-system"
+	AccessPolicyFieldRoles          = "roles"
+	AccessPolicyFieldServiceAccount = "service-account"
+	AccessPolicyFieldTeam           = "team"
+	AccessPolicyFieldUser           = "user"
+	AccessPolicyFieldWorkspace      = "workspace"
+)
+
 // AccessPolicyAttributes holds the attributes for AccessPolicy (response)
 type AccessPolicyAttributes struct {
 	// The access policy is a built-in read-only policy that cannot be updated or deleted.
@@ -77,6 +108,7 @@ func (r *AccessPolicyRelationships) UnmarshalJSON(data []byte) error {
 			r.Account = &Account{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -95,6 +127,7 @@ func (r *AccessPolicyRelationships) UnmarshalJSON(data []byte) error {
 			r.Environment = &Environment{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -115,6 +148,7 @@ func (r *AccessPolicyRelationships) UnmarshalJSON(data []byte) error {
 				r.Roles[i] = &Role{
 					ID:   d.ID,
 					Type: d.Type,
+					stub: true,
 				}
 			}
 		}
@@ -134,6 +168,7 @@ func (r *AccessPolicyRelationships) UnmarshalJSON(data []byte) error {
 			r.ServiceAccount = &ServiceAccount{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -152,6 +187,7 @@ func (r *AccessPolicyRelationships) UnmarshalJSON(data []byte) error {
 			r.Team = &Team{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -170,6 +206,7 @@ func (r *AccessPolicyRelationships) UnmarshalJSON(data []byte) error {
 			r.User = &User{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -188,119 +225,66 @@ func (r *AccessPolicyRelationships) UnmarshalJSON(data []byte) error {
 			r.Workspace = &Workspace{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
 	return nil
 }
 
-// PopulateIncludes merges included resources into the relationships
-func (r *AccessPolicyRelationships) PopulateIncludes(included []map[string]interface{}) {
-	if r == nil || len(included) == 0 {
+// ResolveIncludes replaces the relationships with the included resources, along
+// with their own relationships. Relationships which were not included are kept as is.
+func (r *AccessPolicyRelationships) ResolveIncludes(included *client.Included) {
+	if r == nil || included.Len() == 0 {
 		return
 	}
 
-	// Build a map of included resources by type+id for fast lookup
-	includedMap := make(map[string]map[string]interface{})
-	for _, inc := range included {
-		if id, ok := inc["id"].(string); ok {
-			if typ, ok := inc["type"].(string); ok {
-				key := typ + ":" + id
-				includedMap[key] = inc
-			}
+	if r.Account != nil {
+		if full, ok := client.Resolve[Account](included, r.Account.Type, r.Account.ID); ok {
+			r.Account = full
 		}
 	}
-
-	// Populate to-one relationship: Account
-	if r.Account != nil && r.Account.ID != "" {
-		key := r.Account.Type + ":" + r.Account.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full Account
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.Account = &full
-			}
+	if r.Environment != nil {
+		if full, ok := client.Resolve[Environment](included, r.Environment.Type, r.Environment.ID); ok {
+			r.Environment = full
 		}
 	}
-	// Populate to-one relationship: Environment
-	if r.Environment != nil && r.Environment.ID != "" {
-		key := r.Environment.Type + ":" + r.Environment.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full Environment
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.Environment = &full
-			}
+	for i, rel := range r.Roles {
+		if rel == nil {
+			continue
 		}
-	}
-	// Populate to-many relationship: Roles
-	if r.Roles != nil {
-		for i, resource := range r.Roles {
-			if resource != nil && resource.ID != "" {
-				key := resource.Type + ":" + resource.ID
-				if fullResource, ok := includedMap[key]; ok {
-					// Unmarshal the full resource
-					data, _ := json.Marshal(fullResource)
-					var full Role
-					if err := json.Unmarshal(data, &full); err == nil {
-						r.Roles[i] = &full
-					}
-				}
-			}
+		if full, ok := client.Resolve[Role](included, rel.Type, rel.ID); ok {
+			r.Roles[i] = full
 		}
 	}
-	// Populate to-one relationship: ServiceAccount
-	if r.ServiceAccount != nil && r.ServiceAccount.ID != "" {
-		key := r.ServiceAccount.Type + ":" + r.ServiceAccount.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full ServiceAccount
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.ServiceAccount = &full
-			}
+	if r.ServiceAccount != nil {
+		if full, ok := client.Resolve[ServiceAccount](included, r.ServiceAccount.Type, r.ServiceAccount.ID); ok {
+			r.ServiceAccount = full
 		}
 	}
-	// Populate to-one relationship: Team
-	if r.Team != nil && r.Team.ID != "" {
-		key := r.Team.Type + ":" + r.Team.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full Team
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.Team = &full
-			}
+	if r.Team != nil {
+		if full, ok := client.Resolve[Team](included, r.Team.Type, r.Team.ID); ok {
+			r.Team = full
 		}
 	}
-	// Populate to-one relationship: User
-	if r.User != nil && r.User.ID != "" {
-		key := r.User.Type + ":" + r.User.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full User
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.User = &full
-			}
+	if r.User != nil {
+		if full, ok := client.Resolve[User](included, r.User.Type, r.User.ID); ok {
+			r.User = full
 		}
 	}
-	// Populate to-one relationship: Workspace
-	if r.Workspace != nil && r.Workspace.ID != "" {
-		key := r.Workspace.Type + ":" + r.Workspace.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full Workspace
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.Workspace = &full
-			}
+	if r.Workspace != nil {
+		if full, ok := client.Resolve[Workspace](included, r.Workspace.Type, r.Workspace.ID); ok {
+			r.Workspace = full
 		}
 	}
 }
 
+// PopulateIncludes merges included resources into the relationships.
+// Prefer ResolveIncludes with an index built once per response.
+func (r *AccessPolicyRelationships) PopulateIncludes(included []map[string]interface{}) {
+	r.ResolveIncludes(client.NewIncludedFromMaps(included))
+}
+
 // Request version - used when marshalling for API requests
 // Grants the permissions declared in a role to a user, team, or service account. An access policy is applied to an environment or a specific workspace. (for requests)
 type AccessPolicyRequest struct {
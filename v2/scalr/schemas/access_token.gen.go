@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"time"
 
+	"github.com/scalr/go-scalr/v2/scalr/client"
 	"github.com/scalr/go-scalr/v2/scalr/value"
 )
 
@@ -16,6 +17,9 @@ type AccessToken struct {
 	Type          string                   `json:"type"`
 	Attributes    AccessTokenAttributes    `json:"attributes"`
 	Relationships AccessTokenRelationships `json:"relationships"`
+
+	// stub is set for a related resource which was not included, only its ID and type are known
+	stub bool
 }
 
 // GetID returns the resource ID (implements client.ResourceLike)
@@ -31,6 +35,34 @@ func (r AccessToken) GetResourceType() string {
 	return "access-tokens"
 }
 
+// Loaded reports whether the resource holds its attributes and relationships.
+// It is false for a related resource which was not included in the response,
+// only its ID and type are known then.
+func (r AccessToken) Loaded() bool {
+	return !r.stub
+}
+
+// ResolveIncludes replaces the relationships with the included resources (implements client.IncludeResolver)
+func (r *AccessToken) ResolveIncludes(included *client.Included) {
+	r.Relationships.ResolveIncludes(included)
+}
+
+// AccessTokenResourceType is the JSON:API resource type of AccessToken, the key of its sparse fieldset
+const AccessTokenResourceType = "access-tokens"
+
+// AccessToken field names, to select with sparse fieldsets
+const (
+	AccessTokenFieldCreatedAt   = "created-at"
+	AccessTokenFieldCreatedBy   = "created-by"
+	AccessTokenFieldDescription = "description"
+	AccessTokenFieldExpiresAt   = "expires-at"
+	AccessTokenFieldExpiresIn   = "expires-in"
+	AccessTokenFieldIsExpired   = "is-expired"
+	AccessTokenFieldLastUsedAt  = "last-used-at"
+	AccessTokenFieldName        = "name"
+	AccessTokenFieldToken       = "token"
+)
+
 // AccessTokenAttributes holds the attributes for AccessToken (response)
 type AccessTokenAttributes struct {
 	// Date/Time the token was created.
@@ -80,41 +112,31 @@ func (r *AccessTokenRelationships) UnmarshalJSON(data []byte) error {
 			r.CreatedBy = &User{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
 	return nil
 }
 
-// PopulateIncludes merges included resources into the relationships
-func (r *AccessTokenRelationships) PopulateIncludes(included []map[string]interface{}) {
-	if r == nil || len(included) == 0 {
+// ResolveIncludes replaces the relationships with the included resources, along
+// with their own relationships. Relationships which were not included are kept as is.
+func (r *AccessTokenRelationships) ResolveIncludes(included *client.Included) {
+	if r == nil || included.Len() == 0 {
 		return
 	}
 
-	// Build a map of included resources by type+id for fast lookup
-	includedMap := make(map[string]map[string]interface{})
-	for _, inc := range included {
-		if id, ok := inc["id"].(string); ok {
-			if typ, ok := inc["type"].(string); ok {
-				key := typ + ":" + id
-				includedMap[key] = inc
-			}
+	if r.CreatedBy != nil {
+		if full, ok := client.Resolve[User](included, r.CreatedBy.Type, r.CreatedBy.ID); ok {
+			r.CreatedBy = full
 		}
 	}
+}
 
-	// Populate to-one relationship: CreatedBy
-	if r.CreatedBy != nil && r.CreatedBy.ID != "" {
-		key := r.CreatedBy.Type + ":" + r.CreatedBy.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full User
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.CreatedBy = &full
-			}
-		}
-	}
+// PopulateIncludes merges included resources into the relationships.
+// Prefer ResolveIncludes with an index built once per response.
+func (r *AccessTokenRelationships) PopulateIncludes(included []map[string]interface{}) {
+	r.ResolveIncludes(client.NewIncludedFromMaps(included))
 }
 
 // Request version - used when marshalling for API requests
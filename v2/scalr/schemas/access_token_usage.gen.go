@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"time"
 
+	"github.com/scalr/go-scalr/v2/scalr/client"
 	"github.com/scalr/go-scalr/v2/scalr/value"
 )
 
@@ -36,6 +37,9 @@ type AccessTokenUsage struct {
 	ID         string                     `json:"id"`
 	Type       string                     `json:"type"`
 	Attributes AccessTokenUsageAttributes `json:"attributes"`
+
+	// stub is set for a related resource which was not included, only its ID and type are known
+	stub bool
 }
 
 // GetID returns the resource ID (implements client.ResourceLike)
@@ -51,6 +55,33 @@ func (r AccessTokenUsage) GetResourceType() string {
 	return "access-token-usages"
 }
 
+// Loaded reports whether the resource holds its attributes and relationships.
+// It is false for a related resource which was not included in the response,
+// only its ID and type are known then.
+func (r AccessTokenUsage) Loaded() bool {
+	return !r.stub
+}
+
+// ResolveIncludes replaces the relationships with the included resources (implements client.IncludeResolver)
+func (r *AccessTokenUsage) ResolveIncludes(included *client.Included) {
+}
+
+// AccessTokenUsageResourceType is the JSON:API resource type of AccessTokenUsage, the key of its sparse fieldset
+const AccessTokenUsageResourceType = "access-token-usages"
+
+// AccessTokenUsage field names, to select with sparse fieldsets
+const (
+	AccessTokenUsageFieldCreatedAt      = "created-at"
+	AccessTokenUsageFieldDescription    = "description"
+	AccessTokenUsageFieldExpiresAt      = "expires-at"
+	AccessTokenUsageFieldLastCharacters = "last-characters"
+	AccessTokenUsageFieldLastUsedAt     = "last-used-at"
+	AccessTokenUsageFieldName           = "name"
+	AccessTokenUsageFieldOwnerEmail     = "owner-email"
+	AccessTokenUsageFieldOwnerStatus    = "owner-status"
+	AccessTokenUsageFieldOwnerType      = "owner-type"
+)
+
 // AccessTokenUsageAttributes holds the attributes for AccessTokenUsage (response)
 type AccessTokenUsageAttributes struct {
 	// The time when the token was created.
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"time"
 
+	"github.com/scalr/go-scalr/v2/scalr/client"
 	"github.com/scalr/go-scalr/v2/scalr/value"
 )
 
@@ -16,6 +17,9 @@ type Account struct {
 	Type          string               `json:"type"`
 	Attributes    AccountAttributes    `json:"attributes"`
 	Relationships AccountRelationships `json:"relationships"`
+
+	// stub is set for a related resource which was not included, only its ID and type are known
+	stub bool
 }
 
 // GetID returns the resource ID (implements client.ResourceLike)
@@ -31,6 +35,35 @@ func (r Account) GetResourceType() string {
 	return "accounts"
 }
 
+// Loaded reports whether the resource holds its attributes and relationships.
+// It is false for a related resource which was not included in the response,
+// only its ID and type are known then.
+func (r Account) Loaded() bool {
+	return !r.stub
+}
+
+// ResolveIncludes replaces the relationships with the included resources (implements client.IncludeResolver)
+func (r *Account) ResolveIncludes(included *client.Included) {
+	r.Relationships.ResolveIncludes(included)
+}
+
+// AccountResourceType is the JSON:API resource type of Account, the key of its sparse fieldset
+const AccountResourceType = "accounts"
+
+// Account field names, to select with sparse fieldsets
+const (
+	AccountFieldAllowedIps           = "allowed-ips"
+	AccountFieldBillingPlan          = "billing-plan"
+	AccountFieldCreatedAt            = "created-at"
+	AccountFieldIdentityProvider     = "identity-provider"
+	AccountFieldLastLoginAttemptAt   = "last-login-attempt-at"
+	AccountFieldLoginAttempts        = "login-attempts"
+	AccountFieldName                 = "name"
+	AccountFieldOwner                = "owner"
+	AccountFieldQuotas               = "quotas"
+	AccountFieldSupportAccessEnabled = "support-access-enabled"
+)
+
 // AccountAttributes holds the attributes for Account (response)
 type AccountAttributes struct {
 	// The list of allowed IP networks for IP fencing
@@ -79,6 +112,7 @@ func (r *AccountRelationships) UnmarshalJSON(data []byte) error {
 			r.BillingPlan = &BillingPlan{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -97,6 +131,7 @@ func (r *AccountRelationships) UnmarshalJSON(data []byte) error {
 			r.IdentityProvider = &IdentityProvider{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -115,67 +150,43 @@ func (r *AccountRelationships) UnmarshalJSON(data []byte) error {
 			r.Owner = &User{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
 	return nil
 }
 
-// PopulateIncludes merges included resources into the relationships
-func (r *AccountRelationships) PopulateIncludes(included []map[string]interface{}) {
-	if r == nil || len(included) == 0 {
+// ResolveIncludes replaces the relationships with the included resources, along
+// with their own relationships. Relationships which were not included are kept as is.
+func (r *AccountRelationships) ResolveIncludes(included *client.Included) {
+	if r == nil || included.Len() == 0 {
 		return
 	}
 
-	// Build a map of included resources by type+id for fast lookup
-	includedMap := make(map[string]map[string]interface{})
-	for _, inc := range included {
-		if id, ok := inc["id"].(string); ok {
-			if typ, ok := inc["type"].(string); ok {
-				key := typ + ":" + id
-				includedMap[key] = inc
-			}
+	if r.BillingPlan != nil {
+		if full, ok := client.Resolve[BillingPlan](included, r.BillingPlan.Type, r.BillingPlan.ID); ok {
+			r.BillingPlan = full
 		}
 	}
-
-	// Populate to-one relationship: BillingPlan
-	if r.BillingPlan != nil && r.BillingPlan.ID != "" {
-		key := r.BillingPlan.Type + ":" + r.BillingPlan.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full BillingPlan
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.BillingPlan = &full
-			}
+	if r.IdentityProvider != nil {
+		if full, ok := client.Resolve[IdentityProvider](included, r.IdentityProvider.Type, r.IdentityProvider.ID); ok {
+			r.IdentityProvider = full
 		}
 	}
-	// Populate to-one relationship: IdentityProvider
-	if r.IdentityProvider != nil && r.IdentityProvider.ID != "" {
-		key := r.IdentityProvider.Type + ":" + r.IdentityProvider.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full IdentityProvider
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.IdentityProvider = &full
-			}
-		}
-	}
-	// Populate to-one relationship: Owner
-	if r.Owner != nil && r.Owner.ID != "" {
-		key := r.Owner.Type + ":" + r.Owner.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full User
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.Owner = &full
-			}
+	if r.Owner != nil {
+		if full, ok := client.Resolve[User](included, r.Owner.Type, r.Owner.ID); ok {
+			r.Owner = full
 		}
 	}
 }
 
+// PopulateIncludes merges included resources into the relationships.
+// Prefer ResolveIncludes with an index built once per response.
+func (r *AccountRelationships) PopulateIncludes(included []map[string]interface{}) {
+	r.ResolveIncludes(client.NewIncludedFromMaps(included))
+}
+
 // Request version - used when marshalling for API requests
 // API Resource for the account. (for requests)
 type AccountRequest struct {
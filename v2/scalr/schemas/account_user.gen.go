@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"time"
 
+	"github.com/scalr/go-scalr/v2/scalr/client"
 	"github.com/scalr/go-scalr/v2/scalr/value"
 )
 
@@ -27,6 +28,9 @@ type AccountUser struct {
 	Type          string                   `json:"type"`
 	Attributes    AccountUserAttributes    `json:"attributes"`
 	Relationships AccountUserRelationships `json:"relationships"`
+
+	// stub is set for a related resource which was not included, only its ID and type are known
+	stub bool
 }
 
 // GetID returns the resource ID (implements client.ResourceLike)
@@ -42,6 +46,30 @@ func (r AccountUser) GetResourceType() string {
 	return "account-users"
 }
 
+// Loaded reports whether the resource holds its attributes and relationships.
+// It is false for a related resource which was not included in the response,
+// only its ID and type are known then.
+func (r AccountUser) Loaded() bool {
+	return !r.stub
+}
+
+// ResolveIncludes replaces the relationships with the included resources (implements client.IncludeResolver)
+func (r *AccountUser) ResolveIncludes(included *client.Included) {
+	r.Relationships.ResolveIncludes(included)
+}
+
+// AccountUserResourceType is the JSON:API resource type of AccountUser, the key of its sparse fieldset
+const AccountUserResourceType = "account-users"
+
+// AccountUser field names, to select with sparse fieldsets
+const (
+	AccountUserFieldAccount     = "account"
+	AccountUserFieldLastLoginAt = "last-login-at"
+	AccountUserFieldStatus      = "status"
+	AccountUserFieldTeams       = "teams"
+	AccountUserFieldUser        = "user"
+)
+
 // AccountUserAttributes holds the attributes for AccountUser (response)
 type AccountUserAttributes struct {
 	// The UTC timestamp when the user logged in to the account.
@@ -80,6 +108,7 @@ func (r *AccountUserRelationships) UnmarshalJSON(data []byte) error {
 			r.Account = &Account{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -100,6 +129,7 @@ func (r *AccountUserRelationships) UnmarshalJSON(data []byte) error {
 				r.Teams[i] = &Team{
 					ID:   d.ID,
 					Type: d.Type,
+					stub: true,
 				}
 			}
 		}
@@ -119,71 +149,46 @@ func (r *AccountUserRelationships) UnmarshalJSON(data []byte) error {
 			r.User = &User{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
 	return nil
 }
 
-// PopulateIncludes merges included resources into the relationships
-func (r *AccountUserRelationships) PopulateIncludes(included []map[string]interface{}) {
-	if r == nil || len(included) == 0 {
+// ResolveIncludes replaces the relationships with the included resources, along
+// with their own relationships. Relationships which were not included are kept as is.
+func (r *AccountUserRelationships) ResolveIncludes(included *client.Included) {
+	if r == nil || included.Len() == 0 {
 		return
 	}
 
-	// Build a map of included resources by type+id for fast lookup
-	includedMap := make(map[string]map[string]interface{})
-	for _, inc := range included {
-		if id, ok := inc["id"].(string); ok {
-			if typ, ok := inc["type"].(string); ok {
-				key := typ + ":" + id
-				includedMap[key] = inc
-			}
+	if r.Account != nil {
+		if full, ok := client.Resolve[Account](included, r.Account.Type, r.Account.ID); ok {
+			r.Account = full
 		}
 	}
-
-	// Populate to-one relationship: Account
-	if r.Account != nil && r.Account.ID != "" {
-		key := r.Account.Type + ":" + r.Account.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full Account
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.Account = &full
-			}
+	for i, rel := range r.Teams {
+		if rel == nil {
+			continue
 		}
-	}
-	// Populate to-many relationship: Teams
-	if r.Teams != nil {
-		for i, resource := range r.Teams {
-			if resource != nil && resource.ID != "" {
-				key := resource.Type + ":" + resource.ID
-				if fullResource, ok := includedMap[key]; ok {
-					// Unmarshal the full resource
-					data, _ := json.Marshal(fullResource)
-					var full Team
-					if err := json.Unmarshal(data, &full); err == nil {
-						r.Teams[i] = &full
-					}
-				}
-			}
+		if full, ok := client.Resolve[Team](included, rel.Type, rel.ID); ok {
+			r.Teams[i] = full
 		}
 	}
-	// Populate to-one relationship: User
-	if r.User != nil && r.User.ID != "" {
-		key := r.User.Type + ":" + r.User.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full User
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.User = &full
-			}
+	if r.User != nil {
+		if full, ok := client.Resolve[User](included, r.User.Type, r.User.ID); ok {
+			r.User = full
 		}
 	}
 }
 
+// PopulateIncludes merges included resources into the relationships.
+// Prefer ResolveIncludes with an index built once per response.
+func (r *AccountUserRelationships) PopulateIncludes(included []map[string]interface{}) {
+	r.ResolveIncludes(client.NewIncludedFromMaps(included))
+}
+
 // Request version - used when marshalling for API requests
 // Represents an account-user relation. (for requests)
 type AccountUserRequest struct {
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"time"
 
+	"github.com/scalr/go-scalr/v2/scalr/client"
 	"github.com/scalr/go-scalr/v2/scalr/value"
 )
 
@@ -87,6 +88,9 @@ type Agent struct {
 	Type          string             `json:"type"`
 	Attributes    AgentAttributes    `json:"attributes"`
 	Relationships AgentRelationships `json:"relationships"`
+
+	// stub is set for a related resource which was not included, only its ID and type are known
+	stub bool
 }
 
 // GetID returns the resource ID (implements client.ResourceLike)
@@ -102,6 +106,39 @@ func (r Agent) GetResourceType() string {
 	return "agents"
 }
 
+// Loaded reports whether the resource holds its attributes and relationships.
+// It is false for a related resource which was not included in the response,
+// only its ID and type are known then.
+func (r Agent) Loaded() bool {
+	return !r.stub
+}
+
+// ResolveIncludes replaces the relationships with the included resources (implements client.IncludeResolver)
+func (r *Agent) ResolveIncludes(included *client.Included) {
+	r.Relationships.ResolveIncludes(included)
+}
+
+// AgentResourceType is the JSON:API resource type of Agent, the key of its sparse fieldset
+const AgentResourceType = "agents"
+
+// Agent field names, to select with sparse fieldsets
+const (
+	AgentFieldCpuPlatform          = "cpu-platform"
+	AgentFieldCreatedAt            = "created-at"
+	AgentFieldDriver               = "driver"
+	AgentFieldErrorMessage         = "error-message"
+	AgentFieldKubernetesDriverMode = "kubernetes-driver-mode"
+	AgentFieldLastSeenAt           = "last-seen-at"
+	AgentFieldName                 = "name"
+	AgentFieldOs                   = "os"
+	AgentFieldPool                 = "pool"
+	AgentFieldRelayLastSeenAt      = "relay-last-seen-at"
+	AgentFieldRuntime              = "runtime"
+	AgentFieldStatus               = "status"
+	AgentFieldUpgradeStatus        = "upgrade-status"
+	AgentFieldVersion              = "version"
+)
+
 // AgentAttributes holds the attributes for Agent (response)
 type AgentAttributes struct {
 	// The agent's CPU platform. The default value is `linux_amd64`.
@@ -161,41 +198,31 @@ func (r *AgentRelationships) UnmarshalJSON(data []byte) error {
 			r.Pool = &AgentPool{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
 	return nil
 }
 
-// PopulateIncludes merges included resources into the relationships
-func (r *AgentRelationships) PopulateIncludes(included []map[string]interface{}) {
-	if r == nil || len(included) == 0 {
+// ResolveIncludes replaces the relationships with the included resources, along
+// with their own relationships. Relationships which were not included are kept as is.
+func (r *AgentRelationships) ResolveIncludes(included *client.Included) {
+	if r == nil || included.Len() == 0 {
 		return
 	}
 
-	// Build a map of included resources by type+id for fast lookup
-	includedMap := make(map[string]map[string]interface{})
-	for _, inc := range included {
-		if id, ok := inc["id"].(string); ok {
-			if typ, ok := inc["type"].(string); ok {
-				key := typ + ":" + id
-				includedMap[key] = inc
-			}
+	if r.Pool != nil {
+		if full, ok := client.Resolve[AgentPool](included, r.Pool.Type, r.Pool.ID); ok {
+			r.Pool = full
 		}
 	}
+}
 
-	// Populate to-one relationship: Pool
-	if r.Pool != nil && r.Pool.ID != "" {
-		key := r.Pool.Type + ":" + r.Pool.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full AgentPool
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.Pool = &full
-			}
-		}
-	}
+// PopulateIncludes merges included resources into the relationships.
+// Prefer ResolveIncludes with an index built once per response.
+func (r *AgentRelationships) PopulateIncludes(included []map[string]interface{}) {
+	r.ResolveIncludes(client.NewIncludedFromMaps(included))
 }
 
 // Request version - used when marshalling for API requests
@@ -5,6 +5,7 @@ package schemas
 import (
 	"encoding/json"
 
+	"github.com/scalr/go-scalr/v2/scalr/client"
 	"github.com/scalr/go-scalr/v2/scalr/value"
 )
 
@@ -24,6 +25,9 @@ type AgentPool struct {
 	Type          string                 `json:"type"`
 	Attributes    AgentPoolAttributes    `json:"attributes"`
 	Relationships AgentPoolRelationships `json:"relationships"`
+
+	// stub is set for a related resource which was not included, only its ID and type are known
+	stub bool
 }
 
 // GetID returns the resource ID (implements client.ResourceLike)
@@ -39,6 +43,41 @@ func (r AgentPool) GetResourceType() string {
 	return "agent-pools"
 }
 
+// Loaded reports whether the resource holds its attributes and relationships.
+// It is false for a related resource which was not included in the response,
+// only its ID and type are known then.
+func (r AgentPool) Loaded() bool {
+	return !r.stub
+}
+
+// ResolveIncludes replaces the relationships with the included resources (implements client.IncludeResolver)
+func (r *AgentPool) ResolveIncludes(included *client.Included) {
+	r.Relationships.ResolveIncludes(included)
+}
+
+// AgentPoolResourceType is the JSON:API resource type of AgentPool, the key of its sparse fieldset
+const AgentPoolResourceType = "agent-pools"
+
+// AgentPool field names, to select with sparse fieldsets
+const (
+	AgentPoolFieldAccount             = "account"
+	AgentPoolFieldAgents              = "agents"
+	AgentPoolFieldDefault             = "default"
+	AgentPoolFieldDefaultEnvironments = "default-environments"
+	AgentPoolFieldEnvironment         = "environment"
+	AgentPoolFieldEnvironments        = "environments"
+	AgentPoolFieldFeatures            = "features"
+	AgentPoolFieldInUse               = "in-use"
+	AgentPoolFieldIsScalrManaged      = "is-scalr-managed"
+	AgentPoolFieldIsShared            = "is-shared"
+	AgentPoolFieldName                = "name"
+	AgentPoolFieldVcsEnabled          = "vcs-enabled"
+	AgentPoolFieldWebhookEnabled      = "webhook-enabled"
+	AgentPoolFieldWebhookHeaders      = "webhook-headers"
+	AgentPoolFieldWebhookUrl          = "webhook-url"
+	AgentPoolFieldWorkspaces          = "workspaces"
+)
+
 // AgentPoolAttributes holds the attributes for AgentPool (response)
 type AgentPoolAttributes struct {
 	// Default agent pool for the entire account. Used by a workspace if no default pool is set at the environment level and no other pool is explicitly linked at the workspace level.
@@ -102,6 +141,7 @@ func (r *AgentPoolRelationships) UnmarshalJSON(data []byte) error {
 			r.Account = &Account{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -122,6 +162,7 @@ func (r *AgentPoolRelationships) UnmarshalJSON(data []byte) error {
 				r.Agents[i] = &Agent{
 					ID:   d.ID,
 					Type: d.Type,
+					stub: true,
 				}
 			}
 		}
@@ -143,6 +184,7 @@ func (r *AgentPoolRelationships) UnmarshalJSON(data []byte) error {
 				r.DefaultEnvironments[i] = &Environment{
 					ID:   d.ID,
 					Type: d.Type,
+					stub: true,
 				}
 			}
 		}
@@ -162,6 +204,7 @@ func (r *AgentPoolRelationships) UnmarshalJSON(data []byte) error {
 			r.Environment = &Environment{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -182,6 +225,7 @@ func (r *AgentPoolRelationships) UnmarshalJSON(data []byte) error {
 				r.Environments[i] = &Environment{
 					ID:   d.ID,
 					Type: d.Type,
+					stub: true,
 				}
 			}
 		}
@@ -203,6 +247,7 @@ func (r *AgentPoolRelationships) UnmarshalJSON(data []byte) error {
 				r.Workspaces[i] = &Workspace{
 					ID:   d.ID,
 					Type: d.Type,
+					stub: true,
 				}
 			}
 		}
@@ -210,113 +255,63 @@ func (r *AgentPoolRelationships) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-// PopulateIncludes merges included resources into the relationships
-func (r *AgentPoolRelationships) PopulateIncludes(included []map[string]interface{}) {
-	if r == nil || len(included) == 0 {
+// ResolveIncludes replaces the relationships with the included resources, along
+// with their own relationships. Relationships which were not included are kept as is.
+func (r *AgentPoolRelationships) ResolveIncludes(included *client.Included) {
+	if r == nil || included.Len() == 0 {
 		return
 	}
 
-	// Build a map of included resources by type+id for fast lookup
-	includedMap := make(map[string]map[string]interface{})
-	for _, inc := range included {
-		if id, ok := inc["id"].(string); ok {
-			if typ, ok := inc["type"].(string); ok {
-				key := typ + ":" + id
-				includedMap[key] = inc
-			}
+	if r.Account != nil {
+		if full, ok := client.Resolve[Account](included, r.Account.Type, r.Account.ID); ok {
+			r.Account = full
 		}
 	}
-
-	// Populate to-one relationship: Account
-	if r.Account != nil && r.Account.ID != "" {
-		key := r.Account.Type + ":" + r.Account.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full Account
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.Account = &full
-			}
+	for i, rel := range r.Agents {
+		if rel == nil {
+			continue
 		}
-	}
-	// Populate to-many relationship: Agents
-	if r.Agents != nil {
-		for i, resource := range r.Agents {
-			if resource != nil && resource.ID != "" {
-				key := resource.Type + ":" + resource.ID
-				if fullResource, ok := includedMap[key]; ok {
-					// Unmarshal the full resource
-					data, _ := json.Marshal(fullResource)
-					var full Agent
-					if err := json.Unmarshal(data, &full); err == nil {
-						r.Agents[i] = &full
-					}
-				}
-			}
+		if full, ok := client.Resolve[Agent](included, rel.Type, rel.ID); ok {
+			r.Agents[i] = full
 		}
 	}
-	// Populate to-many relationship: DefaultEnvironments
-	if r.DefaultEnvironments != nil {
-		for i, resource := range r.DefaultEnvironments {
-			if resource != nil && resource.ID != "" {
-				key := resource.Type + ":" + resource.ID
-				if fullResource, ok := includedMap[key]; ok {
-					// Unmarshal the full resource
-					data, _ := json.Marshal(fullResource)
-					var full Environment
-					if err := json.Unmarshal(data, &full); err == nil {
-						r.DefaultEnvironments[i] = &full
-					}
-				}
-			}
+	for i, rel := range r.DefaultEnvironments {
+		if rel == nil {
+			continue
+		}
+		if full, ok := client.Resolve[Environment](included, rel.Type, rel.ID); ok {
+			r.DefaultEnvironments[i] = full
 		}
 	}
-	// Populate to-one relationship: Environment
-	if r.Environment != nil && r.Environment.ID != "" {
-		key := r.Environment.Type + ":" + r.Environment.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full Environment
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.Environment = &full
-			}
+	if r.Environment != nil {
+		if full, ok := client.Resolve[Environment](included, r.Environment.Type, r.Environment.ID); ok {
+			r.Environment = full
 		}
 	}
-	// Populate to-many relationship: Environments
-	if r.Environments != nil {
-		for i, resource := range r.Environments {
-			if resource != nil && resource.ID != "" {
-				key := resource.Type + ":" + resource.ID
-				if fullResource, ok := includedMap[key]; ok {
-					// Unmarshal the full resource
-					data, _ := json.Marshal(fullResource)
-					var full Environment
-					if err := json.Unmarshal(data, &full); err == nil {
-						r.Environments[i] = &full
-					}
-				}
-			}
+	for i, rel := range r.Environments {
+		if rel == nil {
+			continue
+		}
+		if full, ok := client.Resolve[Environment](included, rel.Type, rel.ID); ok {
+			r.Environments[i] = full
 		}
 	}
-	// Populate to-many relationship: Workspaces
-	if r.Workspaces != nil {
-		for i, resource := range r.Workspaces {
-			if resource != nil && resource.ID != "" {
-				key := resource.Type + ":" + resource.ID
-				if fullResource, ok := includedMap[key]; ok {
-					// Unmarshal the full resource
-					data, _ := json.Marshal(fullResource)
-					var full Workspace
-					if err := json.Unmarshal(data, &full); err == nil {
-						r.Workspaces[i] = &full
-					}
-				}
-			}
+	for i, rel := range r.Workspaces {
+		if rel == nil {
+			continue
+		}
+		if full, ok := client.Resolve[Workspace](included, rel.Type, rel.ID); ok {
+			r.Workspaces[i] = full
 		}
 	}
 }
 
+// PopulateIncludes merges included resources into the relationships.
+// Prefer ResolveIncludes with an index built once per response.
+func (r *AgentPoolRelationships) PopulateIncludes(included []map[string]interface{}) {
+	r.ResolveIncludes(client.NewIncludedFromMaps(included))
+}
+
 // Request version - used when marshalling for API requests
 // With the agent pool resource you can manage a pool of [self-hosted agents](/docs/agent-pools) A workspace may be configured to use an agent pool to execute terraform [runs](/docs/workspaces-runs). Agents could be hosted on a physical or virtual machines within the customer's network. (for requests)
 type AgentPoolRequest struct {
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"time"
 
+	"github.com/scalr/go-scalr/v2/scalr/client"
 	"github.com/scalr/go-scalr/v2/scalr/value"
 )
 
@@ -35,6 +36,9 @@ type AiUsage struct {
 	Type          string               `json:"type"`
 	Attributes    AiUsageAttributes    `json:"attributes"`
 	Relationships AiUsageRelationships `json:"relationships"`
+
+	// stub is set for a related resource which was not included, only its ID and type are known
+	stub bool
 }
 
 // GetID returns the resource ID (implements client.ResourceLike)
@@ -50,6 +54,31 @@ func (r AiUsage) GetResourceType() string {
 	return "ai-usages"
 }
 
+// Loaded reports whether the resource holds its attributes and relationships.
+// It is false for a related resource which was not included in the response,
+// only its ID and type are known then.
+func (r AiUsage) Loaded() bool {
+	return !r.stub
+}
+
+// ResolveIncludes replaces the relationships with the included resources (implements client.IncludeResolver)
+func (r *AiUsage) ResolveIncludes(included *client.Included) {
+	r.Relationships.ResolveIncludes(included)
+}
+
+// AiUsageResourceType is the JSON:API resource type of AiUsage, the key of its sparse fieldset
+const AiUsageResourceType = "ai-usages"
+
+// AiUsage field names, to select with sparse fieldsets
+const (
+	AiUsageFieldAccount          = "account"
+	AiUsageFieldModel            = "model"
+	AiUsageFieldRequestType      = "request-type"
+	AiUsageFieldRequestedAt      = "requested-at"
+	AiUsageFieldRequestedByEmail = "requested-by-email"
+	AiUsageFieldRun              = "run"
+)
+
 // AiUsageAttributes holds the attributes for AiUsage (response)
 type AiUsageAttributes struct {
 	// The AI model name used to process the request.
@@ -93,6 +122,7 @@ func (r *AiUsageRelationships) UnmarshalJSON(data []byte) error {
 			r.Account = &Account{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -111,55 +141,38 @@ func (r *AiUsageRelationships) UnmarshalJSON(data []byte) error {
 			r.Run = &Run{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
 	return nil
 }
 
-// PopulateIncludes merges included resources into the relationships
-func (r *AiUsageRelationships) PopulateIncludes(included []map[string]interface{}) {
-	if r == nil || len(included) == 0 {
+// ResolveIncludes replaces the relationships with the included resources, along
+// with their own relationships. Relationships which were not included are kept as is.
+func (r *AiUsageRelationships) ResolveIncludes(included *client.Included) {
+	if r == nil || included.Len() == 0 {
 		return
 	}
 
-	// Build a map of included resources by type+id for fast lookup
-	includedMap := make(map[string]map[string]interface{})
-	for _, inc := range included {
-		if id, ok := inc["id"].(string); ok {
-			if typ, ok := inc["type"].(string); ok {
-				key := typ + ":" + id
-				includedMap[key] = inc
-			}
+	if r.Account != nil {
+		if full, ok := client.Resolve[Account](included, r.Account.Type, r.Account.ID); ok {
+			r.Account = full
 		}
 	}
-
-	// Populate to-one relationship: Account
-	if r.Account != nil && r.Account.ID != "" {
-		key := r.Account.Type + ":" + r.Account.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full Account
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.Account = &full
-			}
-		}
-	}
-	// Populate to-one relationship: Run
-	if r.Run != nil && r.Run.ID != "" {
-		key := r.Run.Type + ":" + r.Run.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full Run
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.Run = &full
-			}
+	if r.Run != nil {
+		if full, ok := client.Resolve[Run](included, r.Run.Type, r.Run.ID); ok {
+			r.Run = full
 		}
 	}
 }
 
+// PopulateIncludes merges included resources into the relationships.
+// Prefer ResolveIncludes with an index built once per response.
+func (r *AiUsageRelationships) PopulateIncludes(included []map[string]interface{}) {
+	r.ResolveIncludes(client.NewIncludedFromMaps(included))
+}
+
 // Request version - used when marshalling for API requests
 // Represents the AI usage item. (for requests)
 type AiUsageRequest struct {
@@ -5,6 +5,7 @@ package schemas
 import (
 	"encoding/json"
 
+	"github.com/scalr/go-scalr/v2/scalr/client"
 	"github.com/scalr/go-scalr/v2/scalr/value"
 )
 
@@ -29,6 +30,9 @@ type Apply struct {
 	ID         string          `json:"id"`
 	Type       string          `json:"type"`
 	Attributes ApplyAttributes `json:"attributes"`
+
+	// stub is set for a related resource which was not included, only its ID and type are known
+	stub bool
 }
 
 // GetID returns the resource ID (implements client.ResourceLike)
@@ -44,6 +48,32 @@ func (r Apply) GetResourceType() string {
 	return "applies"
 }
 
+// Loaded reports whether the resource holds its attributes and relationships.
+// It is false for a related resource which was not included in the response,
+// only its ID and type are known then.
+func (r Apply) Loaded() bool {
+	return !r.stub
+}
+
+// ResolveIncludes replaces the relationships with the included resources (implements client.IncludeResolver)
+func (r *Apply) ResolveIncludes(included *client.Included) {
+}
+
+// ApplyResourceType is the JSON:API resource type of Apply, the key of its sparse fieldset
+const ApplyResourceType = "applies"
+
+// Apply field names, to select with sparse fieldsets
+const (
+	ApplyFieldExecutionDetails     = "execution-details"
+	ApplyFieldResourceAdditions    = "resource-additions"
+	ApplyFieldResourceChanges      = "resource-changes"
+	ApplyFieldResourceDestructions = "resource-destructions"
+	ApplyFieldResourceForgets      = "resource-forgets"
+	ApplyFieldResourceImports      = "resource-imports"
+	ApplyFieldStatus               = "status"
+	ApplyFieldStatusTimestamps     = "status-timestamps"
+)
+
 // ApplyAttributes holds the attributes for Apply (response)
 type ApplyAttributes struct {
 	// Apply execution details.
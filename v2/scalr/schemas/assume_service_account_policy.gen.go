@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"time"
 
+	"github.com/scalr/go-scalr/v2/scalr/client"
 	"github.com/scalr/go-scalr/v2/scalr/value"
 )
 
@@ -16,6 +17,9 @@ type AssumeServiceAccountPolicy struct {
 	Type          string                                  `json:"type"`
 	Attributes    AssumeServiceAccountPolicyAttributes    `json:"attributes"`
 	Relationships AssumeServiceAccountPolicyRelationships `json:"relationships"`
+
+	// stub is set for a related resource which was not included, only its ID and type are known
+	stub bool
 }
 
 // GetID returns the resource ID (implements client.ResourceLike)
@@ -31,6 +35,32 @@ func (r AssumeServiceAccountPolicy) GetResourceType() string {
 	return "assume-service-account-policies"
 }
 
+// Loaded reports whether the resource holds its attributes and relationships.
+// It is false for a related resource which was not included in the response,
+// only its ID and type are known then.
+func (r AssumeServiceAccountPolicy) Loaded() bool {
+	return !r.stub
+}
+
+// ResolveIncludes replaces the relationships with the included resources (implements client.IncludeResolver)
+func (r *AssumeServiceAccountPolicy) ResolveIncludes(included *client.Included) {
+	r.Relationships.ResolveIncludes(included)
+}
+
+// AssumeServiceAccountPolicyResourceType is the JSON:API resource type of AssumeServiceAccountPolicy, the key of its sparse fieldset
+const AssumeServiceAccountPolicyResourceType = "assume-service-account-policies"
+
+// AssumeServiceAccountPolicy field names, to select with sparse fieldsets
+const (
+	AssumeServiceAccountPolicyFieldClaimConditions        = "claim-conditions"
+	AssumeServiceAccountPolicyFieldCreatedAt              = "created-at"
+	AssumeServiceAccountPolicyFieldCreatedByEmail         = "created-by-email"
+	AssumeServiceAccountPolicyFieldMaximumSessionDuration = "maximum-session-duration"
+	AssumeServiceAccountPolicyFieldName                   = "name"
+	AssumeServiceAccountPolicyFieldProvider               = "provider"
+	AssumeServiceAccountPolicyFieldServiceAccount         = "service-account"
+)
+
 // AssumeServiceAccountPolicyAttributes holds the attributes for AssumeServiceAccountPolicy (response)
 type AssumeServiceAccountPolicyAttributes struct {
 	// A claim mapping, defining the conditions under which the workload identity can impersonate the service account..
@@ -74,6 +104,7 @@ func (r *AssumeServiceAccountPolicyRelationships) UnmarshalJSON(data []byte) err
 			r.Provider = &WorkloadIdentityProvider{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -92,55 +123,38 @@ func (r *AssumeServiceAccountPolicyRelationships) UnmarshalJSON(data []byte) err
 			r.ServiceAccount = &ServiceAccount{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
 	return nil
 }
 
-// PopulateIncludes merges included resources into the relationships
-func (r *AssumeServiceAccountPolicyRelationships) PopulateIncludes(included []map[string]interface{}) {
-	if r == nil || len(included) == 0 {
+// ResolveIncludes replaces the relationships with the included resources, along
+// with their own relationships. Relationships which were not included are kept as is.
+func (r *AssumeServiceAccountPolicyRelationships) ResolveIncludes(included *client.Included) {
+	if r == nil || included.Len() == 0 {
 		return
 	}
 
-	// Build a map of included resources by type+id for fast lookup
-	includedMap := make(map[string]map[string]interface{})
-	for _, inc := range included {
-		if id, ok := inc["id"].(string); ok {
-			if typ, ok := inc["type"].(string); ok {
-				key := typ + ":" + id
-				includedMap[key] = inc
-			}
+	if r.Provider != nil {
+		if full, ok := client.Resolve[WorkloadIdentityProvider](included, r.Provider.Type, r.Provider.ID); ok {
+			r.Provider = full
 		}
 	}
-
-	// Populate to-one relationship: Provider
-	if r.Provider != nil && r.Provider.ID != "" {
-		key := r.Provider.Type + ":" + r.Provider.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full WorkloadIdentityProvider
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.Provider = &full
-			}
-		}
-	}
-	// Populate to-one relationship: ServiceAccount
-	if r.ServiceAccount != nil && r.ServiceAccount.ID != "" {
-		key := r.ServiceAccount.Type + ":" + r.ServiceAccount.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full ServiceAccount
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.ServiceAccount = &full
-			}
+	if r.ServiceAccount != nil {
+		if full, ok := client.Resolve[ServiceAccount](included, r.ServiceAccount.Type, r.ServiceAccount.ID); ok {
+			r.ServiceAccount = full
 		}
 	}
 }
 
+// PopulateIncludes merges included resources into the relationships.
+// Prefer ResolveIncludes with an index built once per response.
+func (r *AssumeServiceAccountPolicyRelationships) PopulateIncludes(included []map[string]interface{}) {
+	r.ResolveIncludes(client.NewIncludedFromMaps(included))
+}
+
 // Request version - used when marshalling for API requests
 // A trust configuration that maps authenticated workload identities to specific service account. (for requests)
 type AssumeServiceAccountPolicyRequest struct {
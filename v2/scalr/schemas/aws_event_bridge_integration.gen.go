@@ -5,6 +5,7 @@ package schemas
 import (
 	"encoding/json"
 
+	"github.com/scalr/go-scalr/v2/scalr/client"
 	"github.com/scalr/go-scalr/v2/scalr/value"
 )
 
@@ -27,6 +28,9 @@ type AWSEventBridgeIntegration struct {
 	Type          string                                 `json:"type"`
 	Attributes    AWSEventBridgeIntegrationAttributes    `json:"attributes"`
 	Relationships AWSEventBridgeIntegrationRelationships `json:"relationships"`
+
+	// stub is set for a related resource which was not included, only its ID and type are known
+	stub bool
 }
 
 // GetID returns the resource ID (implements client.ResourceLike)
@@ -42,6 +46,33 @@ func (r AWSEventBridgeIntegration) GetResourceType() string {
 	return "aws-event-bridge-integrations"
 }
 
+// Loaded reports whether the resource holds its attributes and relationships.
+// It is false for a related resource which was not included in the response,
+// only its ID and type are known then.
+func (r AWSEventBridgeIntegration) Loaded() bool {
+	return !r.stub
+}
+
+// ResolveIncludes replaces the relationships with the included resources (implements client.IncludeResolver)
+func (r *AWSEventBridgeIntegration) ResolveIncludes(included *client.Included) {
+	r.Relationships.ResolveIncludes(included)
+}
+
+// AWSEventBridgeIntegrationResourceType is the JSON:API resource type of AWSEventBridgeIntegration, the key of its sparse fieldset
+const AWSEventBridgeIntegrationResourceType = "aws-event-bridge-integrations"
+
+// AWSEventBridgeIntegration field names, to select with sparse fieldsets
+const (
+	AWSEventBridgeIntegrationFieldAccount        = "account"
+	AWSEventBridgeIntegrationFieldAwsAccountId   = "aws-account-id"
+	AWSEventBridgeIntegrationFieldErrMessage     = "err-message"
+	AWSEventBridgeIntegrationFieldEventSource    = "event-source"
+	AWSEventBridgeIntegrationFieldEventSourceArn = "event-source-arn"
+	AWSEventBridgeIntegrationFieldName           = "name"
+	AWSEventBridgeIntegrationFieldRegion         = "region"
+	AWSEventBridgeIntegrationFieldStatus         = "status"
+)
+
 // AWSEventBridgeIntegrationAttributes holds the attributes for AWSEventBridgeIntegration (response)
 type AWSEventBridgeIntegrationAttributes struct {
 	// The AWS account ID, 12 digits.
@@ -89,41 +120,31 @@ func (r *AWSEventBridgeIntegrationRelationships) UnmarshalJSON(data []byte) erro
 			r.Account = &Account{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
 	return nil
 }
 
-// PopulateIncludes merges included resources into the relationships
-func (r *AWSEventBridgeIntegrationRelationships) PopulateIncludes(included []map[string]interface{}) {
-	if r == nil || len(included) == 0 {
+// ResolveIncludes replaces the relationships with the included resources, along
+// with their own relationships. Relationships which were not included are kept as is.
+func (r *AWSEventBridgeIntegrationRelationships) ResolveIncludes(included *client.Included) {
+	if r == nil || included.Len() == 0 {
 		return
 	}
 
-	// Build a map of included resources by type+id for fast lookup
-	includedMap := make(map[string]map[string]interface{})
-	for _, inc := range included {
-		if id, ok := inc["id"].(string); ok {
-			if typ, ok := inc["type"].(string); ok {
-				key := typ + ":" + id
-				includedMap[key] = inc
-			}
+	if r.Account != nil {
+		if full, ok := client.Resolve[Account](included, r.Account.Type, r.Account.ID); ok {
+			r.Account = full
 		}
 	}
+}
 
-	// Populate to-one relationship: Account
-	if r.Account != nil && r.Account.ID != "" {
-		key := r.Account.Type + ":" + r.Account.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full Account
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.Account = &full
-			}
-		}
-	}
+// PopulateIncludes merges included resources into the relationships.
+// Prefer ResolveIncludes with an index built once per response.
+func (r *AWSEventBridgeIntegrationRelationships) PopulateIncludes(included []map[string]interface{}) {
+	r.ResolveIncludes(client.NewIncludedFromMaps(included))
 }
 
 // Request version - used when marshalling for API requests
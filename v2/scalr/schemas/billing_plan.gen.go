@@ -5,6 +5,7 @@ package schemas
 import (
 	"encoding/json"
 
+	"github.com/scalr/go-scalr/v2/scalr/client"
 	"github.com/scalr/go-scalr/v2/scalr/value"
 )
 
@@ -25,6 +26,9 @@ type BillingPlan struct {
 	ID         string                `json:"id"`
 	Type       string                `json:"type"`
 	Attributes BillingPlanAttributes `json:"attributes"`
+
+	// stub is set for a related resource which was not included, only its ID and type are known
+	stub bool
 }
 
 // GetID returns the resource ID (implements client.ResourceLike)
@@ -40,6 +44,28 @@ func (r BillingPlan) GetResourceType() string {
 	return "billing-plans"
 }
 
+// Loaded reports whether the resource holds its attributes and relationships.
+// It is false for a related resource which was not included in the response,
+// only its ID and type are known then.
+func (r BillingPlan) Loaded() bool {
+	return !r.stub
+}
+
+// ResolveIncludes replaces the relationships with the included resources (implements client.IncludeResolver)
+func (r *BillingPlan) ResolveIncludes(included *client.Included) {
+}
+
+// BillingPlanResourceType is the JSON:API resource type of BillingPlan, the key of its sparse fieldset
+const BillingPlanResourceType = "billing-plans"
+
+// BillingPlan field names, to select with sparse fieldsets
+const (
+	BillingPlanFieldAmount       = "amount"
+	BillingPlanFieldIncludedRuns = "included-runs"
+	BillingPlanFieldPlanType     = "plan-type"
+	BillingPlanFieldPrices       = "prices"
+)
+
 // BillingPlanAttributes holds the attributes for BillingPlan (response)
 type BillingPlanAttributes struct {
 	Amount       int                      `json:"amount"`
@@ -5,6 +5,7 @@ package schemas
 import (
 	"encoding/json"
 
+	"github.com/scalr/go-scalr/v2/scalr/client"
 	"github.com/scalr/go-scalr/v2/scalr/value"
 )
 
@@ -14,6 +15,9 @@ type BillingUsage struct {
 	ID         string                 `json:"id"`
 	Type       string                 `json:"type"`
 	Attributes BillingUsageAttributes `json:"attributes"`
+
+	// stub is set for a related resource which was not included, only its ID and type are known
+	stub bool
 }
 
 // GetID returns the resource ID (implements client.ResourceLike)
@@ -29,6 +33,33 @@ func (r BillingUsage) GetResourceType() string {
 	return "billing-usages"
 }
 
+// Loaded reports whether the resource holds its attributes and relationships.
+// It is false for a related resource which was not included in the response,
+// only its ID and type are known then.
+func (r BillingUsage) Loaded() bool {
+	return !r.stub
+}
+
+// ResolveIncludes replaces the relationships with the included resources (implements client.IncludeResolver)
+func (r *BillingUsage) ResolveIncludes(included *client.Included) {
+}
+
+// BillingUsageResourceType is the JSON:API resource type of BillingUsage, the key of its sparse fieldset
+const BillingUsageResourceType = "billing-usages"
+
+// BillingUsage field names, to select with sparse fieldsets
+const (
+	BillingUsageFieldAccountId       = "account-id"
+	BillingUsageFieldAccountName     = "account-name"
+	BillingUsageFieldEnvironmentId   = "environment-id"
+	BillingUsageFieldEnvironmentName = "environment-name"
+	BillingUsageFieldPlanApplyRuns   = "plan-apply-runs"
+	BillingUsageFieldPlanOnlyRuns    = "plan-only-runs"
+	BillingUsageFieldTotalRuns       = "total-runs"
+	BillingUsageFieldWorkspaceId     = "workspace-id"
+	BillingUsageFieldWorkspaceName   = "workspace-name"
+)
+
 // BillingUsageAttributes holds the attributes for BillingUsage (response)
 type BillingUsageAttributes struct {
 	// The ID of the account.
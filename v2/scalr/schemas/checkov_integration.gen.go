@@ -5,6 +5,7 @@ package schemas
 import (
 	"encoding/json"
 
+	"github.com/scalr/go-scalr/v2/scalr/client"
 	"github.com/scalr/go-scalr/v2/scalr/value"
 )
 
@@ -27,6 +28,9 @@ type CheckovIntegration struct {
 	Type          string                          `json:"type"`
 	Attributes    CheckovIntegrationAttributes    `json:"attributes"`
 	Relationships CheckovIntegrationRelationships `json:"relationships"`
+
+	// stub is set for a related resource which was not included, only its ID and type are known
+	stub bool
 }
 
 // GetID returns the resource ID (implements client.ResourceLike)
@@ -42,6 +46,35 @@ func (r CheckovIntegration) GetResourceType() string {
 	return "checkov-integrations"
 }
 
+// Loaded reports whether the resource holds its attributes and relationships.
+// It is false for a related resource which was not included in the response,
+// only its ID and type are known then.
+func (r CheckovIntegration) Loaded() bool {
+	return !r.stub
+}
+
+// ResolveIncludes replaces the relationships with the included resources (implements client.IncludeResolver)
+func (r *CheckovIntegration) ResolveIncludes(included *client.Included) {
+	r.Relationships.ResolveIncludes(included)
+}
+
+// CheckovIntegrationResourceType is the JSON:API resource type of CheckovIntegration, the key of its sparse fieldset
+const CheckovIntegrationResourceType = "checkov-integrations"
+
+// CheckovIntegration field names, to select with sparse fieldsets
+const (
+	CheckovIntegrationFieldCliArgs               = "cli-args"
+	CheckovIntegrationFieldEnvironments          = "environments"
+	CheckovIntegrationFieldErrMessage            = "err-message"
+	CheckovIntegrationFieldExternalChecksEnabled = "external-checks-enabled"
+	CheckovIntegrationFieldIsShared              = "is-shared"
+	CheckovIntegrationFieldName                  = "name"
+	CheckovIntegrationFieldStatus                = "status"
+	CheckovIntegrationFieldVcsProvider           = "vcs-provider"
+	CheckovIntegrationFieldVcsRepo               = "vcs-repo"
+	CheckovIntegrationFieldVersion               = "version"
+)
+
 // CheckovIntegrationAttributes holds the attributes for CheckovIntegration (response)
 type CheckovIntegrationAttributes struct {
 	// CLI parameters to be passed to checkov command.
@@ -94,6 +127,7 @@ func (r *CheckovIntegrationRelationships) UnmarshalJSON(data []byte) error {
 				r.Environments[i] = &Environment{
 					ID:   d.ID,
 					Type: d.Type,
+					stub: true,
 				}
 			}
 		}
@@ -113,59 +147,41 @@ func (r *CheckovIntegrationRelationships) UnmarshalJSON(data []byte) error {
 			r.VcsProvider = &VcsProvider{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
 	return nil
 }
 
-// PopulateIncludes merges included resources into the relationships
-func (r *CheckovIntegrationRelationships) PopulateIncludes(included []map[string]interface{}) {
-	if r == nil || len(included) == 0 {
+// ResolveIncludes replaces the relationships with the included resources, along
+// with their own relationships. Relationships which were not included are kept as is.
+func (r *CheckovIntegrationRelationships) ResolveIncludes(included *client.Included) {
+	if r == nil || included.Len() == 0 {
 		return
 	}
 
-	// Build a map of included resources by type+id for fast lookup
-	includedMap := make(map[string]map[string]interface{})
-	for _, inc := range included {
-		if id, ok := inc["id"].(string); ok {
-			if typ, ok := inc["type"].(string); ok {
-				key := typ + ":" + id
-				includedMap[key] = inc
-			}
+	for i, rel := range r.Environments {
+		if rel == nil {
+			continue
 		}
-	}
-
-	// Populate to-many relationship: Environments
-	if r.Environments != nil {
-		for i, resource := range r.Environments {
-			if resource != nil && resource.ID != "" {
-				key := resource.Type + ":" + resource.ID
-				if fullResource, ok := includedMap[key]; ok {
-					// Unmarshal the full resource
-					data, _ := json.Marshal(fullResource)
-					var full Environment
-					if err := json.Unmarshal(data, &full); err == nil {
-						r.Environments[i] = &full
-					}
-				}
-			}
+		if full, ok := client.Resolve[Environment](included, rel.Type, rel.ID); ok {
+			r.Environments[i] = full
 		}
 	}
-	// Populate to-one relationship: VcsProvider
-	if r.VcsProvider != nil && r.VcsProvider.ID != "" {
-		key := r.VcsProvider.Type + ":" + r.VcsProvider.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full VcsProvider
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.VcsProvider = &full
-			}
+	if r.VcsProvider != nil {
+		if full, ok := client.Resolve[VcsProvider](included, r.VcsProvider.Type, r.VcsProvider.ID); ok {
+			r.VcsProvider = full
 		}
 	}
 }
 
+// PopulateIncludes merges included resources into the relationships.
+// Prefer ResolveIncludes with an index built once per response.
+func (r *CheckovIntegrationRelationships) PopulateIncludes(included []map[string]interface{}) {
+	r.ResolveIncludes(client.NewIncludedFromMaps(included))
+}
+
 // Request version - used when marshalling for API requests
 
 type CheckovIntegrationRequest struct {
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"time"
 
+	"github.com/scalr/go-scalr/v2/scalr/client"
 	"github.com/scalr/go-scalr/v2/scalr/value"
 )
 
@@ -27,6 +28,9 @@ type ConfigurationVersion struct {
 	Type          string                            `json:"type"`
 	Attributes    ConfigurationVersionAttributes    `json:"attributes"`
 	Relationships ConfigurationVersionRelationships `json:"relationships"`
+
+	// stub is set for a related resource which was not included, only its ID and type are known
+	stub bool
 }
 
 // GetID returns the resource ID (implements client.ResourceLike)
@@ -42,6 +46,36 @@ func (r ConfigurationVersion) GetResourceType() string {
 	return "configuration-versions"
 }
 
+// Loaded reports whether the resource holds its attributes and relationships.
+// It is false for a related resource which was not included in the response,
+// only its ID and type are known then.
+func (r ConfigurationVersion) Loaded() bool {
+	return !r.stub
+}
+
+// ResolveIncludes replaces the relationships with the included resources (implements client.IncludeResolver)
+func (r *ConfigurationVersion) ResolveIncludes(included *client.Included) {
+	r.Relationships.ResolveIncludes(included)
+}
+
+// ConfigurationVersionResourceType is the JSON:API resource type of ConfigurationVersion, the key of its sparse fieldset
+const ConfigurationVersionResourceType = "configuration-versions"
+
+// ConfigurationVersion field names, to select with sparse fieldsets
+const (
+	ConfigurationVersionFieldAutoQueueRuns    = "auto-queue-runs"
+	ConfigurationVersionFieldCreatedAt        = "created-at"
+	ConfigurationVersionFieldErrorMessage     = "error-message"
+	ConfigurationVersionFieldInputs           = "inputs"
+	ConfigurationVersionFieldIsDry            = "is-dry"
+	ConfigurationVersionFieldSize             = "size"
+	ConfigurationVersionFieldSource           = "source"
+	ConfigurationVersionFieldStatus           = "status"
+	ConfigurationVersionFieldStatusTimestamps = "status-timestamps"
+	ConfigurationVersionFieldVcsRevision      = "vcs-revision"
+	ConfigurationVersionFieldWorkspace        = "workspace"
+)
+
 // ConfigurationVersionAttributes holds the attributes for ConfigurationVersion (response)
 type ConfigurationVersionAttributes struct {
 	// Indicates if a run should automatically be queued when the configuration has been uploaded.
@@ -95,6 +129,7 @@ func (r *ConfigurationVersionRelationships) UnmarshalJSON(data []byte) error {
 			r.VcsRevision = &VcsRevision{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -113,55 +148,38 @@ func (r *ConfigurationVersionRelationships) UnmarshalJSON(data []byte) error {
 			r.Workspace = &Workspace{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
 	return nil
 }
 
-// PopulateIncludes merges included resources into the relationships
-func (r *ConfigurationVersionRelationships) PopulateIncludes(included []map[string]interface{}) {
-	if r == nil || len(included) == 0 {
+// ResolveIncludes replaces the relationships with the included resources, along
+// with their own relationships. Relationships which were not included are kept as is.
+func (r *ConfigurationVersionRelationships) ResolveIncludes(included *client.Included) {
+	if r == nil || included.Len() == 0 {
 		return
 	}
 
-	// Build a map of included resources by type+id for fast lookup
-	includedMap := make(map[string]map[string]interface{})
-	for _, inc := range included {
-		if id, ok := inc["id"].(string); ok {
-			if typ, ok := inc["type"].(string); ok {
-				key := typ + ":" + id
-				includedMap[key] = inc
-			}
+	if r.VcsRevision != nil {
+		if full, ok := client.Resolve[VcsRevision](included, r.VcsRevision.Type, r.VcsRevision.ID); ok {
+			r.VcsRevision = full
 		}
 	}
-
-	// Populate to-one relationship: VcsRevision
-	if r.VcsRevision != nil && r.VcsRevision.ID != "" {
-		key := r.VcsRevision.Type + ":" + r.VcsRevision.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full VcsRevision
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.VcsRevision = &full
-			}
-		}
-	}
-	// Populate to-one relationship: Workspace
-	if r.Workspace != nil && r.Workspace.ID != "" {
-		key := r.Workspace.Type + ":" + r.Workspace.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full Workspace
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.Workspace = &full
-			}
+	if r.Workspace != nil {
+		if full, ok := client.Resolve[Workspace](included, r.Workspace.Type, r.Workspace.ID); ok {
+			r.Workspace = full
 		}
 	}
 }
 
+// PopulateIncludes merges included resources into the relationships.
+// Prefer ResolveIncludes with an index built once per response.
+func (r *ConfigurationVersionRelationships) PopulateIncludes(included []map[string]interface{}) {
+	r.ResolveIncludes(client.NewIncludedFromMaps(included))
+}
+
 // Request version - used when marshalling for API requests
 // A Configuration Version describes the version of a Terraform configuration files. Each run is associated with a configuration version. It provides details of the source of the configuration files, the upload status, and the relationships to VCS and the workspace. (for requests)
 type ConfigurationVersionRequest struct {
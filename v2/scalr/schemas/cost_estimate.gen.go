@@ -5,6 +5,7 @@ package schemas
 import (
 	"encoding/json"
 
+	"github.com/scalr/go-scalr/v2/scalr/client"
 	"github.com/scalr/go-scalr/v2/scalr/value"
 )
 
@@ -28,6 +29,9 @@ type CostEstimate struct {
 	ID         string                 `json:"id"`
 	Type       string                 `json:"type"`
 	Attributes CostEstimateAttributes `json:"attributes"`
+
+	// stub is set for a related resource which was not included, only its ID and type are known
+	stub bool
 }
 
 // GetID returns the resource ID (implements client.ResourceLike)
@@ -43,6 +47,33 @@ func (r CostEstimate) GetResourceType() string {
 	return "cost-estimates"
 }
 
+// Loaded reports whether the resource holds its attributes and relationships.
+// It is false for a related resource which was not included in the response,
+// only its ID and type are known then.
+func (r CostEstimate) Loaded() bool {
+	return !r.stub
+}
+
+// ResolveIncludes replaces the relationships with the included resources (implements client.IncludeResolver)
+func (r *CostEstimate) ResolveIncludes(included *client.Included) {
+}
+
+// CostEstimateResourceType is the JSON:API resource type of CostEstimate, the key of its sparse fieldset
+const CostEstimateResourceType = "cost-estimates"
+
+// CostEstimate field names, to select with sparse fieldsets
+const (
+	CostEstimateFieldDeltaMonthlyCost        = "delta-monthly-cost"
+	CostEstimateFieldErrorMessage            = "error-message"
+	CostEstimateFieldMatchedResourcesCount   = "matched-resources-count"
+	CostEstimateFieldPriorMonthlyCost        = "prior-monthly-cost"
+	CostEstimateFieldProposedMonthlyCost     = "proposed-monthly-cost"
+	CostEstimateFieldResourcesCount          = "resources-count"
+	CostEstimateFieldStatus                  = "status"
+	CostEstimateFieldStatusTimestamps        = "status-timestamps"
+	CostEstimateFieldUnmatchedResourcesCount = "unmatched-resources-count"
+)
+
 // CostEstimateAttributes holds the attributes for CostEstimate (response)
 type CostEstimateAttributes struct {
 	// The change in the estimated cost ($) from the previous run (if any).
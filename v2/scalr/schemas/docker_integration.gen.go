@@ -5,6 +5,7 @@ package schemas
 import (
 	"encoding/json"
 
+	"github.com/scalr/go-scalr/v2/scalr/client"
 	"github.com/scalr/go-scalr/v2/scalr/value"
 )
 
@@ -27,6 +28,9 @@ type DockerIntegration struct {
 	Type          string                         `json:"type"`
 	Attributes    DockerIntegrationAttributes    `json:"attributes"`
 	Relationships DockerIntegrationRelationships `json:"relationships"`
+
+	// stub is set for a related resource which was not included, only its ID and type are known
+	stub bool
 }
 
 // GetID returns the resource ID (implements client.ResourceLike)
@@ -42,6 +46,33 @@ func (r DockerIntegration) GetResourceType() string {
 	return "docker-integrations"
 }
 
+// Loaded reports whether the resource holds its attributes and relationships.
+// It is false for a related resource which was not included in the response,
+// only its ID and type are known then.
+func (r DockerIntegration) Loaded() bool {
+	return !r.stub
+}
+
+// ResolveIncludes replaces the relationships with the included resources (implements client.IncludeResolver)
+func (r *DockerIntegration) ResolveIncludes(included *client.Included) {
+	r.Relationships.ResolveIncludes(included)
+}
+
+// DockerIntegrationResourceType is the JSON:API resource type of DockerIntegration, the key of its sparse fieldset
+const DockerIntegrationResourceType = "docker-integrations"
+
+// DockerIntegration field names, to select with sparse fieldsets
+const (
+	DockerIntegrationFieldAccount           = "account"
+	DockerIntegrationFieldErrorMessage      = "error-message"
+	DockerIntegrationFieldExportCredentials = "export-credentials"
+	DockerIntegrationFieldName              = "name"
+	DockerIntegrationFieldPassword          = "password"
+	DockerIntegrationFieldRegistryUrl       = "registry-url"
+	DockerIntegrationFieldStatus            = "status"
+	DockerIntegrationFieldUsername          = "username"
+)
+
 // DockerIntegrationAttributes holds the attributes for DockerIntegration (response)
 type DockerIntegrationAttributes struct {
 	// Last Docker registry connection test error message, if any.
@@ -89,41 +120,31 @@ func (r *DockerIntegrationRelationships) UnmarshalJSON(data []byte) error {
 			r.Account = &Account{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
 	return nil
 }
 
-// PopulateIncludes merges included resources into the relationships
-func (r *DockerIntegrationRelationships) PopulateIncludes(included []map[string]interface{}) {
-	if r == nil || len(included) == 0 {
+// ResolveIncludes replaces the relationships with the included resources, along
+// with their own relationships. Relationships which were not included are kept as is.
+func (r *DockerIntegrationRelationships) ResolveIncludes(included *client.Included) {
+	if r == nil || included.Len() == 0 {
 		return
 	}
 
-	// Build a map of included resources by type+id for fast lookup
-	includedMap := make(map[string]map[string]interface{})
-	for _, inc := range included {
-		if id, ok := inc["id"].(string); ok {
-			if typ, ok := inc["type"].(string); ok {
-				key := typ + ":" + id
-				includedMap[key] = inc
-			}
+	if r.Account != nil {
+		if full, ok := client.Resolve[Account](included, r.Account.Type, r.Account.ID); ok {
+			r.Account = full
 		}
 	}
+}
 
-	// Populate to-one relationship: Account
-	if r.Account != nil && r.Account.ID != "" {
-		key := r.Account.Type + ":" + r.Account.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full Account
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.Account = &full
-			}
-		}
-	}
+// PopulateIncludes merges included resources into the relationships.
+// Prefer ResolveIncludes with an index built once per response.
+func (r *DockerIntegrationRelationships) PopulateIncludes(included []map[string]interface{}) {
+	r.ResolveIncludes(client.NewIncludedFromMaps(included))
 }
 
 // Request version - used when marshalling for API requests
@@ -5,6 +5,7 @@ package schemas
 import (
 	"encoding/json"
 
+	"github.com/scalr/go-scalr/v2/scalr/client"
 	"github.com/scalr/go-scalr/v2/scalr/value"
 )
 
@@ -35,6 +36,9 @@ type DriftDetectionSchedule struct {
 	Type          string                              `json:"type"`
 	Attributes    DriftDetectionScheduleAttributes    `json:"attributes"`
 	Relationships DriftDetectionScheduleRelationships `json:"relationships"`
+
+	// stub is set for a related resource which was not included, only its ID and type are known
+	stub bool
 }
 
 // GetID returns the resource ID (implements client.ResourceLike)
@@ -50,6 +54,29 @@ func (r DriftDetectionSchedule) GetResourceType() string {
 	return "drift-detection-schedule"
 }
 
+// Loaded reports whether the resource holds its attributes and relationships.
+// It is false for a related resource which was not included in the response,
+// only its ID and type are known then.
+func (r DriftDetectionSchedule) Loaded() bool {
+	return !r.stub
+}
+
+// ResolveIncludes replaces the relationships with the included resources (implements client.IncludeResolver)
+func (r *DriftDetectionSchedule) ResolveIncludes(included *client.Included) {
+	r.Relationships.ResolveIncludes(included)
+}
+
+// DriftDetectionScheduleResourceType is the JSON:API resource type of DriftDetectionSchedule, the key of its sparse fieldset
+const DriftDetectionScheduleResourceType = "drift-detection-schedule"
+
+// DriftDetectionSchedule field names, to select with sparse fieldsets
+const (
+	DriftDetectionScheduleFieldEnvironment      = "environment"
+	DriftDetectionScheduleFieldRunMode          = "run-mode"
+	DriftDetectionScheduleFieldSchedule         = "schedule"
+	DriftDetectionScheduleFieldWorkspaceFilters = "workspace-filters"
+)
+
 // DriftDetectionScheduleAttributes holds the attributes for DriftDetectionSchedule (response)
 type DriftDetectionScheduleAttributes struct {
 	// The run mode of the drift detection.
@@ -89,41 +116,31 @@ func (r *DriftDetectionScheduleRelationships) UnmarshalJSON(data []byte) error {
 			r.Environment = &Environment{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
 	return nil
 }
 
-// PopulateIncludes merges included resources into the relationships
-func (r *DriftDetectionScheduleRelationships) PopulateIncludes(included []map[string]interface{}) {
-	if r == nil || len(included) == 0 {
+// ResolveIncludes replaces the relationships with the included resources, along
+// with their own relationships. Relationships which were not included are kept as is.
+func (r *DriftDetectionScheduleRelationships) ResolveIncludes(included *client.Included) {
+	if r == nil || included.Len() == 0 {
 		return
 	}
 
-	// Build a map of included resources by type+id for fast lookup
-	includedMap := make(map[string]map[string]interface{})
-	for _, inc := range included {
-		if id, ok := inc["id"].(string); ok {
-			if typ, ok := inc["type"].(string); ok {
-				key := typ + ":" + id
-				includedMap[key] = inc
-			}
+	if r.Environment != nil {
+		if full, ok := client.Resolve[Environment](included, r.Environment.Type, r.Environment.ID); ok {
+			r.Environment = full
 		}
 	}
+}
 
-	// Populate to-one relationship: Environment
-	if r.Environment != nil && r.Environment.ID != "" {
-		key := r.Environment.Type + ":" + r.Environment.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full Environment
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.Environment = &full
-			}
-		}
-	}
+// PopulateIncludes merges included resources into the relationships.
+// Prefer ResolveIncludes with an index built once per response.
+func (r *DriftDetectionScheduleRelationships) PopulateIncludes(included []map[string]interface{}) {
+	r.ResolveIncludes(client.NewIncludedFromMaps(included))
 }
 
 // Request version - used when marshalling for API requests
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"time"
 
+	"github.com/scalr/go-scalr/v2/scalr/client"
 	"github.com/scalr/go-scalr/v2/scalr/value"
 )
 
@@ -32,6 +33,9 @@ type DriftReport struct {
 	Type          string                   `json:"type"`
 	Attributes    DriftReportAttributes    `json:"attributes"`
 	Relationships DriftReportRelationships `json:"relationships"`
+
+	// stub is set for a related resource which was not included, only its ID and type are known
+	stub bool
 }
 
 // GetID returns the resource ID (implements client.ResourceLike)
@@ -47,6 +51,32 @@ func (r DriftReport) GetResourceType() string {
 	return "drift-reports"
 }
 
+// Loaded reports whether the resource holds its attributes and relationships.
+// It is false for a related resource which was not included in the response,
+// only its ID and type are known then.
+func (r DriftReport) Loaded() bool {
+	return !r.stub
+}
+
+// ResolveIncludes replaces the relationships with the included resources (implements client.IncludeResolver)
+func (r *DriftReport) ResolveIncludes(included *client.Included) {
+	r.Relationships.ResolveIncludes(included)
+}
+
+// DriftReportResourceType is the JSON:API resource type of DriftReport, the key of its sparse fieldset
+const DriftReportResourceType = "drift-reports"
+
+// DriftReport field names, to select with sparse fieldsets
+const (
+	DriftReportFieldIsPaused       = "is-paused"
+	DriftReportFieldPauseReason    = "pause-reason"
+	DriftReportFieldReason         = "reason"
+	DriftReportFieldRun            = "run"
+	DriftReportFieldStatus         = "status"
+	DriftReportFieldUpdatedAt      = "updated-at"
+	DriftReportFieldUpdatedByEmail = "updated-by-email"
+)
+
 // DriftReportAttributes holds the attributes for DriftReport (response)
 type DriftReportAttributes struct {
 	// Whether the drift-detection for the workspace is paused.
@@ -92,41 +122,31 @@ func (r *DriftReportRelationships) UnmarshalJSON(data []byte) error {
 			r.Run = &Run{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
 	return nil
 }
 
-// PopulateIncludes merges included resources into the relationships
-func (r *DriftReportRelationships) PopulateIncludes(included []map[string]interface{}) {
-	if r == nil || len(included) == 0 {
+// ResolveIncludes replaces the relationships with the included resources, along
+// with their own relationships. Relationships which were not included are kept as is.
+func (r *DriftReportRelationships) ResolveIncludes(included *client.Included) {
+	if r == nil || included.Len() == 0 {
 		return
 	}
 
-	// Build a map of included resources by type+id for fast lookup
-	includedMap := make(map[string]map[string]interface{})
-	for _, inc := range included {
-		if id, ok := inc["id"].(string); ok {
-			if typ, ok := inc["type"].(string); ok {
-				key := typ + ":" + id
-				includedMap[key] = inc
-			}
+	if r.Run != nil {
+		if full, ok := client.Resolve[Run](included, r.Run.Type, r.Run.ID); ok {
+			r.Run = full
 		}
 	}
+}
 
-	// Populate to-one relationship: Run
-	if r.Run != nil && r.Run.ID != "" {
-		key := r.Run.Type + ":" + r.Run.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full Run
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.Run = &full
-			}
-		}
-	}
+// PopulateIncludes merges included resources into the relationships.
+// Prefer ResolveIncludes with an index built once per response.
+func (r *DriftReportRelationships) PopulateIncludes(included []map[string]interface{}) {
+	r.ResolveIncludes(client.NewIncludedFromMaps(included))
 }
 
 // Request version - used when marshalling for API requests
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"time"
 
+	"github.com/scalr/go-scalr/v2/scalr/client"
 	"github.com/scalr/go-scalr/v2/scalr/value"
 )
 
@@ -16,6 +17,9 @@ type Environment struct {
 	Type          string                   `json:"type"`
 	Attributes    EnvironmentAttributes    `json:"attributes"`
 	Relationships EnvironmentRelationships `json:"relationships"`
+
+	// stub is set for a related resource which was not included, only its ID and type are known
+	stub bool
 }
 
 // GetID returns the resource ID (implements client.ResourceLike)
@@ -31,6 +35,49 @@ func (r Environment) GetResourceType() string {
 	return "environments"
 }
 
+// Loaded reports whether the resource holds its attributes and relationships.
+// It is false for a related resource which was not included in the response,
+// only its ID and type are known then.
+func (r Environment) Loaded() bool {
+	return !r.stub
+}
+
+// ResolveIncludes replaces the relationships with the included resources (implements client.IncludeResolver)
+func (r *Environment) ResolveIncludes(included *client.Included) {
+	r.Relationships.ResolveIncludes(included)
+}
+
+// EnvironmentResourceType is the JSON:API resource type of Environment, the key of its sparse fieldset
+const EnvironmentResourceType = "environments"
+
+// Environment field names, to select with sparse fieldsets
+const (
+	EnvironmentFieldAccount                       = "account"
+	EnvironmentFieldCreatedAt                     = "created-at"
+	EnvironmentFieldCreatedBy                     = "created-by"
+	EnvironmentFieldCreatedByEmail                = "created-by-email"
+	EnvironmentFieldDefaultProviderConfigurations = "default-provider-configurations"
+	EnvironmentFieldDefaultWorkspaceAgentPool     = "default-workspace-agent-pool"
+	EnvironmentFieldDriftDetectionSchedules       = "drift-detection-schedules"
+	EnvironmentFieldFavorite                      = "favorite"
+	EnvironmentFieldIsFederatedToAccount          = "is-federated-to-account"
+	EnvironmentFieldLockReason                    = "lock-reason"
+	EnvironmentFieldLocked                        = "locked"
+	EnvironmentFieldLockedBy                      = "locked-by"
+	EnvironmentFieldMaskSensitiveOutput           = "mask-sensitive-output"
+	EnvironmentFieldName                          = "name"
+	EnvironmentFieldPermissions                   = "permissions"
+	EnvironmentFieldPolicyGroups                  = "policy-groups"
+	EnvironmentFieldProviderConfigurations        = "provider-configurations"
+	EnvironmentFieldRemoteBackend                 = "remote-backend"
+	EnvironmentFieldRemoteBackendOverridable      = "remote-backend-overridable"
+	EnvironmentFieldStatus                        = "status"
+	EnvironmentFieldStorageProfile                = "storage-profile"
+	EnvironmentFieldTags                          = "tags"
+	EnvironmentFieldUpdatedAt                     = "updated-at"
+	EnvironmentFieldUpdatedBy                     = "updated-by"
+)
+
 // EnvironmentAttributes holds the attributes for Environment (response)
 type EnvironmentAttributes struct {
 	// Date/Time the environment was created.
@@ -105,6 +152,7 @@ func (r *EnvironmentRelationships) UnmarshalJSON(data []byte) error {
 			r.Account = &Account{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -123,6 +171,7 @@ func (r *EnvironmentRelationships) UnmarshalJSON(data []byte) error {
 			r.CreatedBy = &User{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -143,6 +192,7 @@ func (r *EnvironmentRelationships) UnmarshalJSON(data []byte) error {
 				r.DefaultProviderConfigurations[i] = &ProviderConfiguration{
 					ID:   d.ID,
 					Type: d.Type,
+					stub: true,
 				}
 			}
 		}
@@ -162,6 +212,7 @@ func (r *EnvironmentRelationships) UnmarshalJSON(data []byte) error {
 			r.DefaultWorkspaceAgentPool = &AgentPool{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -182,6 +233,7 @@ func (r *EnvironmentRelationships) UnmarshalJSON(data []byte) error {
 				r.DriftDetectionSchedules[i] = &DriftDetectionSchedule{
 					ID:   d.ID,
 					Type: d.Type,
+					stub: true,
 				}
 			}
 		}
@@ -201,6 +253,7 @@ func (r *EnvironmentRelationships) UnmarshalJSON(data []byte) error {
 			r.LockedBy = &User{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -221,6 +274,7 @@ func (r *EnvironmentRelationships) UnmarshalJSON(data []byte) error {
 				r.PolicyGroups[i] = &PolicyGroup{
 					ID:   d.ID,
 					Type: d.Type,
+					stub: true,
 				}
 			}
 		}
@@ -242,6 +296,7 @@ func (r *EnvironmentRelationships) UnmarshalJSON(data []byte) error {
 				r.ProviderConfigurations[i] = &ProviderConfiguration{
 					ID:   d.ID,
 					Type: d.Type,
+					stub: true,
 				}
 			}
 		}
@@ -261,6 +316,7 @@ func (r *EnvironmentRelationships) UnmarshalJSON(data []byte) error {
 			r.StorageProfile = &StorageProfile{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -281,6 +337,7 @@ func (r *EnvironmentRelationships) UnmarshalJSON(data []byte) error {
 				r.Tags[i] = &Tag{
 					ID:   d.ID,
 					Type: d.Type,
+					stub: true,
 				}
 			}
 		}
@@ -300,183 +357,98 @@ func (r *EnvironmentRelationships) UnmarshalJSON(data []byte) error {
 			r.UpdatedBy = &User{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
 	return nil
 }
 
-// PopulateIncludes merges included resources into the relationships
-func (r *EnvironmentRelationships) PopulateIncludes(included []map[string]interface{}) {
-	if r == nil || len(included) == 0 {
+// ResolveIncludes replaces the relationships with the included resources, along
+// with their own relationships. Relationships which were not included are kept as is.
+func (r *EnvironmentRelationships) ResolveIncludes(included *client.Included) {
+	if r == nil || included.Len() == 0 {
 		return
 	}
 
-	// Build a map of included resources by type+id for fast lookup
-	includedMap := make(map[string]map[string]interface{})
-	for _, inc := range included {
-		if id, ok := inc["id"].(string); ok {
-			if typ, ok := inc["type"].(string); ok {
-				key := typ + ":" + id
-				includedMap[key] = inc
-			}
+	if r.Account != nil {
+		if full, ok := client.Resolve[Account](included, r.Account.Type, r.Account.ID); ok {
+			r.Account = full
 		}
 	}
-
-	// Populate to-one relationship: Account
-	if r.Account != nil && r.Account.ID != "" {
-		key := r.Account.Type + ":" + r.Account.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full Account
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.Account = &full
-			}
+	if r.CreatedBy != nil {
+		if full, ok := client.Resolve[User](included, r.CreatedBy.Type, r.CreatedBy.ID); ok {
+			r.CreatedBy = full
 		}
 	}
-	// Populate to-one relationship: CreatedBy
-	if r.CreatedBy != nil && r.CreatedBy.ID != "" {
-		key := r.CreatedBy.Type + ":" + r.CreatedBy.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full User
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.CreatedBy = &full
-			}
+	for i, rel := range r.DefaultProviderConfigurations {
+		if rel == nil {
+			continue
 		}
-	}
-	// Populate to-many relationship: DefaultProviderConfigurations
-	if r.DefaultProviderConfigurations != nil {
-		for i, resource := range r.DefaultProviderConfigurations {
-			if resource != nil && resource.ID != "" {
-				key := resource.Type + ":" + resource.ID
-				if fullResource, ok := includedMap[key]; ok {
-					// Unmarshal the full resource
-					data, _ := json.Marshal(fullResource)
-					var full ProviderConfiguration
-					if err := json.Unmarshal(data, &full); err == nil {
-						r.DefaultProviderConfigurations[i] = &full
-					}
-				}
-			}
+		if full, ok := client.Resolve[ProviderConfiguration](included, rel.Type, rel.ID); ok {
+			r.DefaultProviderConfigurations[i] = full
 		}
 	}
-	// Populate to-one relationship: DefaultWorkspaceAgentPool
-	if r.DefaultWorkspaceAgentPool != nil && r.DefaultWorkspaceAgentPool.ID != "" {
-		key := r.DefaultWorkspaceAgentPool.Type + ":" + r.DefaultWorkspaceAgentPool.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full AgentPool
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.DefaultWorkspaceAgentPool = &full
-			}
+	if r.DefaultWorkspaceAgentPool != nil {
+		if full, ok := client.Resolve[AgentPool](included, r.DefaultWorkspaceAgentPool.Type, r.DefaultWorkspaceAgentPool.ID); ok {
+			r.DefaultWorkspaceAgentPool = full
 		}
 	}
-	// Populate to-many relationship: DriftDetectionSchedules
-	if r.DriftDetectionSchedules != nil {
-		for i, resource := range r.DriftDetectionSchedules {
-			if resource != nil && resource.ID != "" {
-				key := resource.Type + ":" + resource.ID
-				if fullResource, ok := includedMap[key]; ok {
-					// Unmarshal the full resource
-					data, _ := json.Marshal(fullResource)
-					var full DriftDetectionSchedule
-					if err := json.Unmarshal(data, &full); err == nil {
-						r.DriftDetectionSchedules[i] = &full
-					}
-				}
-			}
+	for i, rel := range r.DriftDetectionSchedules {
+		if rel == nil {
+			continue
+		}
+		if full, ok := client.Resolve[DriftDetectionSchedule](included, rel.Type, rel.ID); ok {
+			r.DriftDetectionSchedules[i] = full
 		}
 	}
-	// Populate to-one relationship: LockedBy
-	if r.LockedBy != nil && r.LockedBy.ID != "" {
-		key := r.LockedBy.Type + ":" + r.LockedBy.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full User
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.LockedBy = &full
-			}
+	if r.LockedBy != nil {
+		if full, ok := client.Resolve[User](included, r.LockedBy.Type, r.LockedBy.ID); ok {
+			r.LockedBy = full
 		}
 	}
-	// Populate to-many relationship: PolicyGroups
-	if r.PolicyGroups != nil {
-		for i, resource := range r.PolicyGroups {
-			if resource != nil && resource.ID != "" {
-				key := resource.Type + ":" + resource.ID
-				if fullResource, ok := includedMap[key]; ok {
-					// Unmarshal the full resource
-					data, _ := json.Marshal(fullResource)
-					var full PolicyGroup
-					if err := json.Unmarshal(data, &full); err == nil {
-						r.PolicyGroups[i] = &full
-					}
-				}
-			}
+	for i, rel := range r.PolicyGroups {
+		if rel == nil {
+			continue
+		}
+		if full, ok := client.Resolve[PolicyGroup](included, rel.Type, rel.ID); ok {
+			r.PolicyGroups[i] = full
 		}
 	}
-	// Populate to-many relationship: ProviderConfigurations
-	if r.ProviderConfigurations != nil {
-		for i, resource := range r.ProviderConfigurations {
-			if resource != nil && resource.ID != "" {
-				key := resource.Type + ":" + resource.ID
-				if fullResource, ok := includedMap[key]; ok {
-					// Unmarshal the full resource
-					data, _ := json.Marshal(fullResource)
-					var full ProviderConfiguration
-					if err := json.Unmarshal(data, &full); err == nil {
-						r.ProviderConfigurations[i] = &full
-					}
-				}
-			}
+	for i, rel := range r.ProviderConfigurations {
+		if rel == nil {
+			continue
+		}
+		if full, ok := client.Resolve[ProviderConfiguration](included, rel.Type, rel.ID); ok {
+			r.ProviderConfigurations[i] = full
 		}
 	}
-	// Populate to-one relationship: StorageProfile
-	if r.StorageProfile != nil && r.StorageProfile.ID != "" {
-		key := r.StorageProfile.Type + ":" + r.StorageProfile.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full StorageProfile
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.StorageProfile = &full
-			}
+	if r.StorageProfile != nil {
+		if full, ok := client.Resolve[StorageProfile](included, r.StorageProfile.Type, r.StorageProfile.ID); ok {
+			r.StorageProfile = full
 		}
 	}
-	// Populate to-many relationship: Tags
-	if r.Tags != nil {
-		for i, resource := range r.Tags {
-			if resource != nil && resource.ID != "" {
-				key := resource.Type + ":" + resource.ID
-				if fullResource, ok := includedMap[key]; ok {
-					// Unmarshal the full resource
-					data, _ := json.Marshal(fullResource)
-					var full Tag
-					if err := json.Unmarshal(data, &full); err == nil {
-						r.Tags[i] = &full
-					}
-				}
-			}
+	for i, rel := range r.Tags {
+		if rel == nil {
+			continue
+		}
+		if full, ok := client.Resolve[Tag](included, rel.Type, rel.ID); ok {
+			r.Tags[i] = full
 		}
 	}
-	// Populate to-one relationship: UpdatedBy
-	if r.UpdatedBy != nil && r.UpdatedBy.ID != "" {
-		key := r.UpdatedBy.Type + ":" + r.UpdatedBy.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full User
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.UpdatedBy = &full
-			}
+	if r.UpdatedBy != nil {
+		if full, ok := client.Resolve[User](included, r.UpdatedBy.Type, r.UpdatedBy.ID); ok {
+			r.UpdatedBy = full
 		}
 	}
 }
 
+// PopulateIncludes merges included resources into the relationships.
+// Prefer ResolveIncludes with an index built once per response.
+func (r *EnvironmentRelationships) PopulateIncludes(included []map[string]interface{}) {
+	r.ResolveIncludes(client.NewIncludedFromMaps(included))
+}
+
 // Request version - used when marshalling for API requests
 // Environments are collections of related workspaces that correspond to functional areas, SDLC stages, projects or any grouping that is required. An account can have multiple environments. Workspaces within an environment are where Terraform configurations are run to deploy infrastructure, and where state files are stored. An Environment can have set of policy groups assigned that are applied to all workspaces in the environment. The Environment can also have variables, credentials, registry modules, and VCS providers that are available to every workspace. (for requests)
 type EnvironmentRequest struct {
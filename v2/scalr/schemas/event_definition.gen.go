@@ -5,6 +5,7 @@ package schemas
 import (
 	"encoding/json"
 
+	"github.com/scalr/go-scalr/v2/scalr/client"
 	"github.com/scalr/go-scalr/v2/scalr/value"
 )
 
@@ -14,6 +15,9 @@ type EventDefinition struct {
 	ID         string                    `json:"id"`
 	Type       string                    `json:"type"`
 	Attributes EventDefinitionAttributes `json:"attributes"`
+
+	// stub is set for a related resource which was not included, only its ID and type are known
+	stub bool
 }
 
 // GetID returns the resource ID (implements client.ResourceLike)
@@ -29,6 +33,26 @@ func (r EventDefinition) GetResourceType() string {
 	return "event-definitions"
 }
 
+// Loaded reports whether the resource holds its attributes and relationships.
+// It is false for a related resource which was not included in the response,
+// only its ID and type are known then.
+func (r EventDefinition) Loaded() bool {
+	return !r.stub
+}
+
+// ResolveIncludes replaces the relationships with the included resources (implements client.IncludeResolver)
+func (r *EventDefinition) ResolveIncludes(included *client.Included) {
+}
+
+// EventDefinitionResourceType is the JSON:API resource type of EventDefinition, the key of its sparse fieldset
+const EventDefinitionResourceType = "event-definitions"
+
+// EventDefinition field names, to select with sparse fieldsets
+const (
+	EventDefinitionFieldDescription = "description"
+	EventDefinitionFieldName        = "name"
+)
+
 // EventDefinitionAttributes holds the attributes for EventDefinition (response)
 type EventDefinitionAttributes struct {
 	// The event details.
@@ -20,3 +20,6 @@ func (r FederatedEnvironmentRelationship) GetResourceType() string {
 	}
 	return "environments"
 }
+
+// FederatedEnvironmentRelationshipResourceType is the JSON:API resource type of FederatedEnvironmentRelationship, the key of its sparse fieldset
+const FederatedEnvironmentRelationshipResourceType = "environments"
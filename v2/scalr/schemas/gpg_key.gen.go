@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"time"
 
+	"github.com/scalr/go-scalr/v2/scalr/client"
 	"github.com/scalr/go-scalr/v2/scalr/value"
 )
 
@@ -15,6 +16,9 @@ type GPGKey struct {
 	ID         string           `json:"id"`
 	Type       string           `json:"type"`
 	Attributes GPGKeyAttributes `json:"attributes"`
+
+	// stub is set for a related resource which was not included, only its ID and type are known
+	stub bool
 }
 
 // GetID returns the resource ID (implements client.ResourceLike)
@@ -30,6 +34,30 @@ func (r GPGKey) GetResourceType() string {
 	return "gpg-keys"
 }
 
+// Loaded reports whether the resource holds its attributes and relationships.
+// It is false for a related resource which was not included in the response,
+// only its ID and type are known then.
+func (r GPGKey) Loaded() bool {
+	return !r.stub
+}
+
+// ResolveIncludes replaces the relationships with the included resources (implements client.IncludeResolver)
+func (r *GPGKey) ResolveIncludes(included *client.Included) {
+}
+
+// GPGKeyResourceType is the JSON:API resource type of GPGKey, the key of its sparse fieldset
+const GPGKeyResourceType = "gpg-keys"
+
+// GPGKey field names, to select with sparse fieldsets
+const (
+	GPGKeyFieldAsciiArmor     = "ascii-armor"
+	GPGKeyFieldCreatedAt      = "created-at"
+	GPGKeyFieldCreatedByEmail = "created-by-email"
+	GPGKeyFieldDescription    = "description"
+	GPGKeyFieldName           = "name"
+	GPGKeyFieldUsageCount     = "usage-count"
+)
+
 // GPGKeyAttributes holds the attributes for GPGKey (response)
 type GPGKeyAttributes struct {
 	// The full ASCII-armored public GPG key.
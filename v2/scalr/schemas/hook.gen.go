@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"time"
 
+	"github.com/scalr/go-scalr/v2/scalr/client"
 	"github.com/scalr/go-scalr/v2/scalr/value"
 )
 
@@ -27,6 +28,9 @@ type Hook struct {
 	Type          string            `json:"type"`
 	Attributes    HookAttributes    `json:"attributes"`
 	Relationships HookRelationships `json:"relationships"`
+
+	// stub is set for a related resource which was not included, only its ID and type are known
+	stub bool
 }
 
 // GetID returns the resource ID (implements client.ResourceLike)
@@ -42,6 +46,40 @@ func (r Hook) GetResourceType() string {
 	return "hooks"
 }
 
+// Loaded reports whether the resource holds its attributes and relationships.
+// It is false for a related resource which was not included in the response,
+// only its ID and type are known then.
+func (r Hook) Loaded() bool {
+	return !r.stub
+}
+
+// ResolveIncludes replaces the relationships with the included resources (implements client.IncludeResolver)
+func (r *Hook) ResolveIncludes(included *client.Included) {
+	r.Relationships.ResolveIncludes(included)
+}
+
+// HookResourceType is the JSON:API resource type of Hook, the key of its sparse fieldset
+const HookResourceType = "hooks"
+
+// Hook field names, to select with sparse fieldsets
+const (
+	HookFieldAccount        = "account"
+	HookFieldDescription    = "description"
+	HookFieldEnvironments   = "environments"
+	HookFieldErrorMessage   = "error-message"
+	HookFieldHookBlobId     = "hook-blob-id"
+	HookFieldInterpreter    = "interpreter"
+	HookFieldName           = "name"
+	HookFieldReadme         = "readme"
+	HookFieldScriptfilePath = "scriptfile-path"
+	HookFieldStatus         = "status"
+	HookFieldUpdatedAt      = "updated-at"
+	HookFieldUpdatedBy      = "updated-by"
+	HookFieldVcsProvider    = "vcs-provider"
+	HookFieldVcsRepo        = "vcs-repo"
+	HookFieldVcsRevision    = "vcs-revision"
+)
+
 // HookAttributes holds the attributes for Hook (response)
 type HookAttributes struct {
 	// Brief description of the hook's purpose.
@@ -103,6 +141,7 @@ func (r *HookRelationships) UnmarshalJSON(data []byte) error {
 			r.Account = &Account{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -123,6 +162,7 @@ func (r *HookRelationships) UnmarshalJSON(data []byte) error {
 				r.Environments[i] = &Environment{
 					ID:   d.ID,
 					Type: d.Type,
+					stub: true,
 				}
 			}
 		}
@@ -142,6 +182,7 @@ func (r *HookRelationships) UnmarshalJSON(data []byte) error {
 			r.Readme = &HookReadme{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -160,6 +201,7 @@ func (r *HookRelationships) UnmarshalJSON(data []byte) error {
 			r.UpdatedBy = &User{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -178,6 +220,7 @@ func (r *HookRelationships) UnmarshalJSON(data []byte) error {
 			r.VcsProvider = &VcsProvider{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -196,107 +239,61 @@ func (r *HookRelationships) UnmarshalJSON(data []byte) error {
 			r.VcsRevision = &VcsRevision{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
 	return nil
 }
 
-// PopulateIncludes merges included resources into the relationships
-func (r *HookRelationships) PopulateIncludes(included []map[string]interface{}) {
-	if r == nil || len(included) == 0 {
+// ResolveIncludes replaces the relationships with the included resources, along
+// with their own relationships. Relationships which were not included are kept as is.
+func (r *HookRelationships) ResolveIncludes(included *client.Included) {
+	if r == nil || included.Len() == 0 {
 		return
 	}
 
-	// Build a map of included resources by type+id for fast lookup
-	includedMap := make(map[string]map[string]interface{})
-	for _, inc := range included {
-		if id, ok := inc["id"].(string); ok {
-			if typ, ok := inc["type"].(string); ok {
-				key := typ + ":" + id
-				includedMap[key] = inc
-			}
+	if r.Account != nil {
+		if full, ok := client.Resolve[Account](included, r.Account.Type, r.Account.ID); ok {
+			r.Account = full
 		}
 	}
-
-	// Populate to-one relationship: Account
-	if r.Account != nil && r.Account.ID != "" {
-		key := r.Account.Type + ":" + r.Account.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full Account
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.Account = &full
-			}
+	for i, rel := range r.Environments {
+		if rel == nil {
+			continue
 		}
-	}
-	// Populate to-many relationship: Environments
-	if r.Environments != nil {
-		for i, resource := range r.Environments {
-			if resource != nil && resource.ID != "" {
-				key := resource.Type + ":" + resource.ID
-				if fullResource, ok := includedMap[key]; ok {
-					// Unmarshal the full resource
-					data, _ := json.Marshal(fullResource)
-					var full Environment
-					if err := json.Unmarshal(data, &full); err == nil {
-						r.Environments[i] = &full
-					}
-				}
-			}
+		if full, ok := client.Resolve[Environment](included, rel.Type, rel.ID); ok {
+			r.Environments[i] = full
 		}
 	}
-	// Populate to-one relationship: Readme
-	if r.Readme != nil && r.Readme.ID != "" {
-		key := r.Readme.Type + ":" + r.Readme.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full HookReadme
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.Readme = &full
-			}
+	if r.Readme != nil {
+		if full, ok := client.Resolve[HookReadme](included, r.Readme.Type, r.Readme.ID); ok {
+			r.Readme = full
 		}
 	}
-	// Populate to-one relationship: UpdatedBy
-	if r.UpdatedBy != nil && r.UpdatedBy.ID != "" {
-		key := r.UpdatedBy.Type + ":" + r.UpdatedBy.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full User
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.UpdatedBy = &full
-			}
+	if r.UpdatedBy != nil {
+		if full, ok := client.Resolve[User](included, r.UpdatedBy.Type, r.UpdatedBy.ID); ok {
+			r.UpdatedBy = full
 		}
 	}
-	// Populate to-one relationship: VcsProvider
-	if r.VcsProvider != nil && r.VcsProvider.ID != "" {
-		key := r.VcsProvider.Type + ":" + r.VcsProvider.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full VcsProvider
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.VcsProvider = &full
-			}
+	if r.VcsProvider != nil {
+		if full, ok := client.Resolve[VcsProvider](included, r.VcsProvider.Type, r.VcsProvider.ID); ok {
+			r.VcsProvider = full
 		}
 	}
-	// Populate to-one relationship: VcsRevision
-	if r.VcsRevision != nil && r.VcsRevision.ID != "" {
-		key := r.VcsRevision.Type + ":" + r.VcsRevision.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full VcsRevision
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.VcsRevision = &full
-			}
+	if r.VcsRevision != nil {
+		if full, ok := client.Resolve[VcsRevision](included, r.VcsRevision.Type, r.VcsRevision.ID); ok {
+			r.VcsRevision = full
 		}
 	}
 }
 
+// PopulateIncludes merges included resources into the relationships.
+// Prefer ResolveIncludes with an index built once per response.
+func (r *HookRelationships) PopulateIncludes(included []map[string]interface{}) {
+	r.ResolveIncludes(client.NewIncludedFromMaps(included))
+}
+
 // Request version - used when marshalling for API requests
 // Represents a reusable hook in the Scalr Hooks Registry. (for requests)
 type HookRequest struct {
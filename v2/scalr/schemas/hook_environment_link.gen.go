@@ -5,6 +5,7 @@ package schemas
 import (
 	"encoding/json"
 
+	"github.com/scalr/go-scalr/v2/scalr/client"
 	"github.com/scalr/go-scalr/v2/scalr/value"
 )
 
@@ -28,6 +29,9 @@ type HookEnvironmentLink struct {
 	Type          string                           `json:"type"`
 	Attributes    HookEnvironmentLinkAttributes    `json:"attributes"`
 	Relationships HookEnvironmentLinkRelationships `json:"relationships"`
+
+	// stub is set for a related resource which was not included, only its ID and type are known
+	stub bool
 }
 
 // GetID returns the resource ID (implements client.ResourceLike)
@@ -43,6 +47,30 @@ func (r HookEnvironmentLink) GetResourceType() string {
 	return "hook-environment-links"
 }
 
+// Loaded reports whether the resource holds its attributes and relationships.
+// It is false for a related resource which was not included in the response,
+// only its ID and type are known then.
+func (r HookEnvironmentLink) Loaded() bool {
+	return !r.stub
+}
+
+// ResolveIncludes replaces the relationships with the included resources (implements client.IncludeResolver)
+func (r *HookEnvironmentLink) ResolveIncludes(included *client.Included) {
+	r.Relationships.ResolveIncludes(included)
+}
+
+// HookEnvironmentLinkResourceType is the JSON:API resource type of HookEnvironmentLink, the key of its sparse fieldset
+const HookEnvironmentLinkResourceType = "hook-environment-links"
+
+// HookEnvironmentLink field names, to select with sparse fieldsets
+const (
+	HookEnvironmentLinkFieldEnvironment = "environment"
+	HookEnvironmentLinkFieldEvents      = "events"
+	HookEnvironmentLinkFieldHook        = "hook"
+	HookEnvironmentLinkFieldVcsProvider = "vcs-provider"
+	HookEnvironmentLinkFieldVcsRevision = "vcs-revision"
+)
+
 // HookEnvironmentLinkAttributes holds the attributes for HookEnvironmentLink (response)
 type HookEnvironmentLinkAttributes struct {
 	// The list of events the hook is enabled for.
@@ -84,6 +112,7 @@ func (r *HookEnvironmentLinkRelationships) UnmarshalJSON(data []byte) error {
 			r.Environment = &Environment{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -102,6 +131,7 @@ func (r *HookEnvironmentLinkRelationships) UnmarshalJSON(data []byte) error {
 			r.Hook = &Hook{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -120,6 +150,7 @@ func (r *HookEnvironmentLinkRelationships) UnmarshalJSON(data []byte) error {
 			r.VcsProvider = &VcsProvider{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -138,79 +169,48 @@ func (r *HookEnvironmentLinkRelationships) UnmarshalJSON(data []byte) error {
 			r.VcsRevision = &VcsRevision{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
 	return nil
 }
 
-// PopulateIncludes merges included resources into the relationships
-func (r *HookEnvironmentLinkRelationships) PopulateIncludes(included []map[string]interface{}) {
-	if r == nil || len(included) == 0 {
+// ResolveIncludes replaces the relationships with the included resources, along
+// with their own relationships. Relationships which were not included are kept as is.
+func (r *HookEnvironmentLinkRelationships) ResolveIncludes(included *client.Included) {
+	if r == nil || included.Len() == 0 {
 		return
 	}
 
-	// Build a map of included resources by type+id for fast lookup
-	includedMap := make(map[string]map[string]interface{})
-	for _, inc := range included {
-		if id, ok := inc["id"].(string); ok {
-			if typ, ok := inc["type"].(string); ok {
-				key := typ + ":" + id
-				includedMap[key] = inc
-			}
+	if r.Environment != nil {
+		if full, ok := client.Resolve[Environment](included, r.Environment.Type, r.Environment.ID); ok {
+			r.Environment = full
 		}
 	}
-
-	// Populate to-one relationship: Environment
-	if r.Environment != nil && r.Environment.ID != "" {
-		key := r.Environment.Type + ":" + r.Environment.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full Environment
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.Environment = &full
-			}
-		}
-	}
-	// Populate to-one relationship: Hook
-	if r.Hook != nil && r.Hook.ID != "" {
-		key := r.Hook.Type + ":" + r.Hook.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full Hook
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.Hook = &full
-			}
+	if r.Hook != nil {
+		if full, ok := client.Resolve[Hook](included, r.Hook.Type, r.Hook.ID); ok {
+			r.Hook = full
 		}
 	}
-	// Populate to-one relationship: VcsProvider
-	if r.VcsProvider != nil && r.VcsProvider.ID != "" {
-		key := r.VcsProvider.Type + ":" + r.VcsProvider.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full VcsProvider
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.VcsProvider = &full
-			}
+	if r.VcsProvider != nil {
+		if full, ok := client.Resolve[VcsProvider](included, r.VcsProvider.Type, r.VcsProvider.ID); ok {
+			r.VcsProvider = full
 		}
 	}
-	// Populate to-one relationship: VcsRevision
-	if r.VcsRevision != nil && r.VcsRevision.ID != "" {
-		key := r.VcsRevision.Type + ":" + r.VcsRevision.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full VcsRevision
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.VcsRevision = &full
-			}
+	if r.VcsRevision != nil {
+		if full, ok := client.Resolve[VcsRevision](included, r.VcsRevision.Type, r.VcsRevision.ID); ok {
+			r.VcsRevision = full
 		}
 	}
 }
 
+// PopulateIncludes merges included resources into the relationships.
+// Prefer ResolveIncludes with an index built once per response.
+func (r *HookEnvironmentLinkRelationships) PopulateIncludes(included []map[string]interface{}) {
+	r.ResolveIncludes(client.NewIncludedFromMaps(included))
+}
+
 // Request version - used when marshalling for API requests
 // Represents the link between a hook and an environment. (for requests)
 type HookEnvironmentLinkRequest struct {
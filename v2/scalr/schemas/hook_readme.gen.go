@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"time"
 
+	"github.com/scalr/go-scalr/v2/scalr/client"
 	"github.com/scalr/go-scalr/v2/scalr/value"
 )
 
@@ -15,6 +16,9 @@ type HookReadme struct {
 	ID         string               `json:"id"`
 	Type       string               `json:"type"`
 	Attributes HookReadmeAttributes `json:"attributes"`
+
+	// stub is set for a related resource which was not included, only its ID and type are known
+	stub bool
 }
 
 // GetID returns the resource ID (implements client.ResourceLike)
@@ -30,6 +34,26 @@ func (r HookReadme) GetResourceType() string {
 	return "hook-readme"
 }
 
+// Loaded reports whether the resource holds its attributes and relationships.
+// It is false for a related resource which was not included in the response,
+// only its ID and type are known then.
+func (r HookReadme) Loaded() bool {
+	return !r.stub
+}
+
+// ResolveIncludes replaces the relationships with the included resources (implements client.IncludeResolver)
+func (r *HookReadme) ResolveIncludes(included *client.Included) {
+}
+
+// HookReadmeResourceType is the JSON:API resource type of HookReadme, the key of its sparse fieldset
+const HookReadmeResourceType = "hook-readme"
+
+// HookReadme field names, to select with sparse fieldsets
+const (
+	HookReadmeFieldContent   = "content"
+	HookReadmeFieldCreatedAt = "created-at"
+)
+
 // HookReadmeAttributes holds the attributes for HookReadme (response)
 type HookReadmeAttributes struct {
 	// The content of the readme file.
@@ -5,6 +5,7 @@ package schemas
 import (
 	"encoding/json"
 
+	"github.com/scalr/go-scalr/v2/scalr/client"
 	"github.com/scalr/go-scalr/v2/scalr/value"
 )
 
@@ -26,6 +27,9 @@ type InfracostIntegration struct {
 	Type          string                            `json:"type"`
 	Attributes    InfracostIntegrationAttributes    `json:"attributes"`
 	Relationships InfracostIntegrationRelationships `json:"relationships"`
+
+	// stub is set for a related resource which was not included, only its ID and type are known
+	stub bool
 }
 
 // GetID returns the resource ID (implements client.ResourceLike)
@@ -41,6 +45,31 @@ func (r InfracostIntegration) GetResourceType() string {
 	return "infracost-integration"
 }
 
+// Loaded reports whether the resource holds its attributes and relationships.
+// It is false for a related resource which was not included in the response,
+// only its ID and type are known then.
+func (r InfracostIntegration) Loaded() bool {
+	return !r.stub
+}
+
+// ResolveIncludes replaces the relationships with the included resources (implements client.IncludeResolver)
+func (r *InfracostIntegration) ResolveIncludes(included *client.Included) {
+	r.Relationships.ResolveIncludes(included)
+}
+
+// InfracostIntegrationResourceType is the JSON:API resource type of InfracostIntegration, the key of its sparse fieldset
+const InfracostIntegrationResourceType = "infracost-integration"
+
+// InfracostIntegration field names, to select with sparse fieldsets
+const (
+	InfracostIntegrationFieldApiKey       = "api-key"
+	InfracostIntegrationFieldEnvironments = "environments"
+	InfracostIntegrationFieldErrMessage   = "err-message"
+	InfracostIntegrationFieldIsShared     = "is-shared"
+	InfracostIntegrationFieldName         = "name"
+	InfracostIntegrationFieldStatus       = "status"
+)
+
 // InfracostIntegrationAttributes holds the attributes for InfracostIntegration (response)
 type InfracostIntegrationAttributes struct {
 	// The API key.
@@ -86,6 +115,7 @@ func (r *InfracostIntegrationRelationships) UnmarshalJSON(data []byte) error {
 				r.Environments[i] = &Environment{
 					ID:   d.ID,
 					Type: d.Type,
+					stub: true,
 				}
 			}
 		}
@@ -93,41 +123,29 @@ func (r *InfracostIntegrationRelationships) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-// PopulateIncludes merges included resources into the relationships
-func (r *InfracostIntegrationRelationships) PopulateIncludes(included []map[string]interface{}) {
-	if r == nil || len(included) == 0 {
+// ResolveIncludes replaces the relationships with the included resources, along
+// with their own relationships. Relationships which were not included are kept as is.
+func (r *InfracostIntegrationRelationships) ResolveIncludes(included *client.Included) {
+	if r == nil || included.Len() == 0 {
 		return
 	}
 
-	// Build a map of included resources by type+id for fast lookup
-	includedMap := make(map[string]map[string]interface{})
-	for _, inc := range included {
-		if id, ok := inc["id"].(string); ok {
-			if typ, ok := inc["type"].(string); ok {
-				key := typ + ":" + id
-				includedMap[key] = inc
-			}
+	for i, rel := range r.Environments {
+		if rel == nil {
+			continue
 		}
-	}
-
-	// Populate to-many relationship: Environments
-	if r.Environments != nil {
-		for i, resource := range r.Environments {
-			if resource != nil && resource.ID != "" {
-				key := resource.Type + ":" + resource.ID
-				if fullResource, ok := includedMap[key]; ok {
-					// Unmarshal the full resource
-					data, _ := json.Marshal(fullResource)
-					var full Environment
-					if err := json.Unmarshal(data, &full); err == nil {
-						r.Environments[i] = &full
-					}
-				}
-			}
+		if full, ok := client.Resolve[Environment](included, rel.Type, rel.ID); ok {
+			r.Environments[i] = full
 		}
 	}
 }
 
+// PopulateIncludes merges included resources into the relationships.
+// Prefer ResolveIncludes with an index built once per response.
+func (r *InfracostIntegrationRelationships) PopulateIncludes(included []map[string]interface{}) {
+	r.ResolveIncludes(client.NewIncludedFromMaps(included))
+}
+
 // Request version - used when marshalling for API requests
 
 type InfracostIntegrationRequest struct {
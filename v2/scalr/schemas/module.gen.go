@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"time"
 
+	"github.com/scalr/go-scalr/v2/scalr/client"
 	"github.com/scalr/go-scalr/v2/scalr/value"
 )
 
@@ -38,6 +39,9 @@ type Module struct {
 	Type          string              `json:"type"`
 	Attributes    ModuleAttributes    `json:"attributes"`
 	Relationships ModuleRelationships `json:"relationships"`
+
+	// stub is set for a related resource which was not included, only its ID and type are known
+	stub bool
 }
 
 // GetID returns the resource ID (implements client.ResourceLike)
@@ -53,6 +57,44 @@ func (r Module) GetResourceType() string {
 	return "modules"
 }
 
+// Loaded reports whether the resource holds its attributes and relationships.
+// It is false for a related resource which was not included in the response,
+// only its ID and type are known then.
+func (r Module) Loaded() bool {
+	return !r.stub
+}
+
+// ResolveIncludes replaces the relationships with the included resources (implements client.IncludeResolver)
+func (r *Module) ResolveIncludes(included *client.Included) {
+	r.Relationships.ResolveIncludes(included)
+}
+
+// ModuleResourceType is the JSON:API resource type of Module, the key of its sparse fieldset
+const ModuleResourceType = "modules"
+
+// Module field names, to select with sparse fieldsets
+const (
+	ModuleFieldAccount             = "account"
+	ModuleFieldCreatedAt           = "created-at"
+	ModuleFieldCreatedBy           = "created-by"
+	ModuleFieldDescription         = "description"
+	ModuleFieldDockerImage         = "docker-image"
+	ModuleFieldDockerIntegration   = "docker-integration"
+	ModuleFieldEnvironment         = "environment"
+	ModuleFieldErrorMessage        = "error-message"
+	ModuleFieldLatestModuleVersion = "latest-module-version"
+	ModuleFieldModuleVersion       = "module-version"
+	ModuleFieldModuleVersions      = "module-versions"
+	ModuleFieldName                = "name"
+	ModuleFieldNamespace           = "namespace"
+	ModuleFieldProvider            = "provider"
+	ModuleFieldSource              = "source"
+	ModuleFieldSourceType          = "source-type"
+	ModuleFieldStatus              = "status"
+	ModuleFieldVcsProvider         = "vcs-provider"
+	ModuleFieldVcsRepo             = "vcs-repo"
+)
+
 // ModuleAttributes holds the attributes for Module (response)
 type ModuleAttributes struct {
 	// The resource creation timestamp.
@@ -121,6 +163,7 @@ func (r *ModuleRelationships) UnmarshalJSON(data []byte) error {
 			r.Account = &Account{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -139,6 +182,7 @@ func (r *ModuleRelationships) UnmarshalJSON(data []byte) error {
 			r.CreatedBy = &User{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -157,6 +201,7 @@ func (r *ModuleRelationships) UnmarshalJSON(data []byte) error {
 			r.DockerIntegration = &DockerIntegration{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -175,6 +220,7 @@ func (r *ModuleRelationships) UnmarshalJSON(data []byte) error {
 			r.Environment = &Environment{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -193,6 +239,7 @@ func (r *ModuleRelationships) UnmarshalJSON(data []byte) error {
 			r.LatestModuleVersion = &ModuleVersion{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -211,6 +258,7 @@ func (r *ModuleRelationships) UnmarshalJSON(data []byte) error {
 			r.ModuleVersion = &ModuleVersion{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -231,6 +279,7 @@ func (r *ModuleRelationships) UnmarshalJSON(data []byte) error {
 				r.ModuleVersions[i] = &ModuleVersion{
 					ID:   d.ID,
 					Type: d.Type,
+					stub: true,
 				}
 			}
 		}
@@ -250,6 +299,7 @@ func (r *ModuleRelationships) UnmarshalJSON(data []byte) error {
 			r.Namespace = &ModuleNamespace{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -268,143 +318,76 @@ func (r *ModuleRelationships) UnmarshalJSON(data []byte) error {
 			r.VcsProvider = &VcsProvider{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
 	return nil
 }
 
-// PopulateIncludes merges included resources into the relationships
-func (r *ModuleRelationships) PopulateIncludes(included []map[string]interface{}) {
-	if r == nil || len(included) == 0 {
+// ResolveIncludes replaces the relationships with the included resources, along
+// with their own relationships. Relationships which were not included are kept as is.
+func (r *ModuleRelationships) ResolveIncludes(included *client.Included) {
+	if r == nil || included.Len() == 0 {
 		return
 	}
 
-	// Build a map of included resources by type+id for fast lookup
-	includedMap := make(map[string]map[string]interface{})
-	for _, inc := range included {
-		if id, ok := inc["id"].(string); ok {
-			if typ, ok := inc["type"].(string); ok {
-				key := typ + ":" + id
-				includedMap[key] = inc
-			}
+	if r.Account != nil {
+		if full, ok := client.Resolve[Account](included, r.Account.Type, r.Account.ID); ok {
+			r.Account = full
 		}
 	}
-
-	// Populate to-one relationship: Account
-	if r.Account != nil && r.Account.ID != "" {
-		key := r.Account.Type + ":" + r.Account.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full Account
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.Account = &full
-			}
+	if r.CreatedBy != nil {
+		if full, ok := client.Resolve[User](included, r.CreatedBy.Type, r.CreatedBy.ID); ok {
+			r.CreatedBy = full
 		}
 	}
-	// Populate to-one relationship: CreatedBy
-	if r.CreatedBy != nil && r.CreatedBy.ID != "" {
-		key := r.CreatedBy.Type + ":" + r.CreatedBy.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full User
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.CreatedBy = &full
-			}
+	if r.DockerIntegration != nil {
+		if full, ok := client.Resolve[DockerIntegration](included, r.DockerIntegration.Type, r.DockerIntegration.ID); ok {
+			r.DockerIntegration = full
 		}
 	}
-	// Populate to-one relationship: DockerIntegration
-	if r.DockerIntegration != nil && r.DockerIntegration.ID != "" {
-		key := r.DockerIntegration.Type + ":" + r.DockerIntegration.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full DockerIntegration
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.DockerIntegration = &full
-			}
+	if r.Environment != nil {
+		if full, ok := client.Resolve[Environment](included, r.Environment.Type, r.Environment.ID); ok {
+			r.Environment = full
 		}
 	}
-	// Populate to-one relationship: Environment
-	if r.Environment != nil && r.Environment.ID != "" {
-		key := r.Environment.Type + ":" + r.Environment.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full Environment
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.Environment = &full
-			}
+	if r.LatestModuleVersion != nil {
+		if full, ok := client.Resolve[ModuleVersion](included, r.LatestModuleVersion.Type, r.LatestModuleVersion.ID); ok {
+			r.LatestModuleVersion = full
 		}
 	}
-	// Populate to-one relationship: LatestModuleVersion
-	if r.LatestModuleVersion != nil && r.LatestModuleVersion.ID != "" {
-		key := r.LatestModuleVersion.Type + ":" + r.LatestModuleVersion.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full ModuleVersion
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.LatestModuleVersion = &full
-			}
+	if r.ModuleVersion != nil {
+		if full, ok := client.Resolve[ModuleVersion](included, r.ModuleVersion.Type, r.ModuleVersion.ID); ok {
+			r.ModuleVersion = full
 		}
 	}
-	// Populate to-one relationship: ModuleVersion
-	if r.ModuleVersion != nil && r.ModuleVersion.ID != "" {
-		key := r.ModuleVersion.Type + ":" + r.ModuleVersion.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full ModuleVersion
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.ModuleVersion = &full
-			}
+	for i, rel := range r.ModuleVersions {
+		if rel == nil {
+			continue
 		}
-	}
-	// Populate to-many relationship: ModuleVersions
-	if r.ModuleVersions != nil {
-		for i, resource := range r.ModuleVersions {
-			if resource != nil && resource.ID != "" {
-				key := resource.Type + ":" + resource.ID
-				if fullResource, ok := includedMap[key]; ok {
-					// Unmarshal the full resource
-					data, _ := json.Marshal(fullResource)
-					var full ModuleVersion
-					if err := json.Unmarshal(data, &full); err == nil {
-						r.ModuleVersions[i] = &full
-					}
-				}
-			}
+		if full, ok := client.Resolve[ModuleVersion](included, rel.Type, rel.ID); ok {
+			r.ModuleVersions[i] = full
 		}
 	}
-	// Populate to-one relationship: Namespace
-	if r.Namespace != nil && r.Namespace.ID != "" {
-		key := r.Namespace.Type + ":" + r.Namespace.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full ModuleNamespace
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.Namespace = &full
-			}
+	if r.Namespace != nil {
+		if full, ok := client.Resolve[ModuleNamespace](included, r.Namespace.Type, r.Namespace.ID); ok {
+			r.Namespace = full
 		}
 	}
-	// Populate to-one relationship: VcsProvider
-	if r.VcsProvider != nil && r.VcsProvider.ID != "" {
-		key := r.VcsProvider.Type + ":" + r.VcsProvider.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full VcsProvider
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.VcsProvider = &full
-			}
+	if r.VcsProvider != nil {
+		if full, ok := client.Resolve[VcsProvider](included, r.VcsProvider.Type, r.VcsProvider.ID); ok {
+			r.VcsProvider = full
 		}
 	}
 }
 
+// PopulateIncludes merges included resources into the relationships.
+// Prefer ResolveIncludes with an index built once per response.
+func (r *ModuleRelationships) PopulateIncludes(included []map[string]interface{}) {
+	r.ResolveIncludes(client.NewIncludedFromMaps(included))
+}
+
 // Request version - used when marshalling for API requests
 // A terraform module in the [Private Module Registry](/docs/private-module-registry). (for requests)
 type ModuleRequest struct {
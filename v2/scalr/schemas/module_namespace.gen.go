@@ -5,6 +5,7 @@ package schemas
 import (
 	"encoding/json"
 
+	"github.com/scalr/go-scalr/v2/scalr/client"
 	"github.com/scalr/go-scalr/v2/scalr/value"
 )
 
@@ -15,6 +16,9 @@ type ModuleNamespace struct {
 	Type          string                       `json:"type"`
 	Attributes    ModuleNamespaceAttributes    `json:"attributes"`
 	Relationships ModuleNamespaceRelationships `json:"relationships"`
+
+	// stub is set for a related resource which was not included, only its ID and type are known
+	stub bool
 }
 
 // GetID returns the resource ID (implements client.ResourceLike)
@@ -30,6 +34,30 @@ func (r ModuleNamespace) GetResourceType() string {
 	return "module-namespaces"
 }
 
+// Loaded reports whether the resource holds its attributes and relationships.
+// It is false for a related resource which was not included in the response,
+// only its ID and type are known then.
+func (r ModuleNamespace) Loaded() bool {
+	return !r.stub
+}
+
+// ResolveIncludes replaces the relationships with the included resources (implements client.IncludeResolver)
+func (r *ModuleNamespace) ResolveIncludes(included *client.Included) {
+	r.Relationships.ResolveIncludes(included)
+}
+
+// ModuleNamespaceResourceType is the JSON:API resource type of ModuleNamespace, the key of its sparse fieldset
+const ModuleNamespaceResourceType = "module-namespaces"
+
+// ModuleNamespace field names, to select with sparse fieldsets
+const (
+	ModuleNamespaceFieldEnvironments = "environments"
+	ModuleNamespaceFieldIsShared     = "is-shared"
+	ModuleNamespaceFieldModules      = "modules"
+	ModuleNamespaceFieldName         = "name"
+	ModuleNamespaceFieldOwners       = "owners"
+)
+
 // ModuleNamespaceAttributes holds the attributes for ModuleNamespace (response)
 type ModuleNamespaceAttributes struct {
 	// Whether the module namespace is shared across all current and future environments.
@@ -73,6 +101,7 @@ func (r *ModuleNamespaceRelationships) UnmarshalJSON(data []byte) error {
 				r.Environments[i] = &Environment{
 					ID:   d.ID,
 					Type: d.Type,
+					stub: true,
 				}
 			}
 		}
@@ -94,6 +123,7 @@ func (r *ModuleNamespaceRelationships) UnmarshalJSON(data []byte) error {
 				r.Modules[i] = &Module{
 					ID:   d.ID,
 					Type: d.Type,
+					stub: true,
 				}
 			}
 		}
@@ -115,6 +145,7 @@ func (r *ModuleNamespaceRelationships) UnmarshalJSON(data []byte) error {
 				r.Owners[i] = &Team{
 					ID:   d.ID,
 					Type: d.Type,
+					stub: true,
 				}
 			}
 		}
@@ -122,73 +153,45 @@ func (r *ModuleNamespaceRelationships) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-// PopulateIncludes merges included resources into the relationships
-func (r *ModuleNamespaceRelationships) PopulateIncludes(included []map[string]interface{}) {
-	if r == nil || len(included) == 0 {
+// ResolveIncludes replaces the relationships with the included resources, along
+// with their own relationships. Relationships which were not included are kept as is.
+func (r *ModuleNamespaceRelationships) ResolveIncludes(included *client.Included) {
+	if r == nil || included.Len() == 0 {
 		return
 	}
 
-	// Build a map of included resources by type+id for fast lookup
-	includedMap := make(map[string]map[string]interface{})
-	for _, inc := range included {
-		if id, ok := inc["id"].(string); ok {
-			if typ, ok := inc["type"].(string); ok {
-				key := typ + ":" + id
-				includedMap[key] = inc
-			}
+	for i, rel := range r.Environments {
+		if rel == nil {
+			continue
 		}
-	}
-
-	// Populate to-many relationship: Environments
-	if r.Environments != nil {
-		for i, resource := range r.Environments {
-			if resource != nil && resource.ID != "" {
-				key := resource.Type + ":" + resource.ID
-				if fullResource, ok := includedMap[key]; ok {
-					// Unmarshal the full resource
-					data, _ := json.Marshal(fullResource)
-					var full Environment
-					if err := json.Unmarshal(data, &full); err == nil {
-						r.Environments[i] = &full
-					}
-				}
-			}
+		if full, ok := client.Resolve[Environment](included, rel.Type, rel.ID); ok {
+			r.Environments[i] = full
 		}
 	}
-	// Populate to-many relationship: Modules
-	if r.Modules != nil {
-		for i, resource := range r.Modules {
-			if resource != nil && resource.ID != "" {
-				key := resource.Type + ":" + resource.ID
-				if fullResource, ok := includedMap[key]; ok {
-					// Unmarshal the full resource
-					data, _ := json.Marshal(fullResource)
-					var full Module
-					if err := json.Unmarshal(data, &full); err == nil {
-						r.Modules[i] = &full
-					}
-				}
-			}
+	for i, rel := range r.Modules {
+		if rel == nil {
+			continue
+		}
+		if full, ok := client.Resolve[Module](included, rel.Type, rel.ID); ok {
+			r.Modules[i] = full
 		}
 	}
-	// Populate to-many relationship: Owners
-	if r.Owners != nil {
-		for i, resource := range r.Owners {
-			if resource != nil && resource.ID != "" {
-				key := resource.Type + ":" + resource.ID
-				if fullResource, ok := includedMap[key]; ok {
-					// Unmarshal the full resource
-					data, _ := json.Marshal(fullResource)
-					var full Team
-					if err := json.Unmarshal(data, &full); err == nil {
-						r.Owners[i] = &full
-					}
-				}
-			}
+	for i, rel := range r.Owners {
+		if rel == nil {
+			continue
+		}
+		if full, ok := client.Resolve[Team](included, rel.Type, rel.ID); ok {
+			r.Owners[i] = full
 		}
 	}
 }
 
+// PopulateIncludes merges included resources into the relationships.
+// Prefer ResolveIncludes with an index built once per response.
+func (r *ModuleNamespaceRelationships) PopulateIncludes(included []map[string]interface{}) {
+	r.ResolveIncludes(client.NewIncludedFromMaps(included))
+}
+
 // Request version - used when marshalling for API requests
 // A terraform module namespace in the [Private Module Registry](../../module.html). (for requests)
 type ModuleNamespaceRequest struct {
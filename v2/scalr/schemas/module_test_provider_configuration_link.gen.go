@@ -5,6 +5,7 @@ package schemas
 import (
 	"encoding/json"
 
+	"github.com/scalr/go-scalr/v2/scalr/client"
 	"github.com/scalr/go-scalr/v2/scalr/value"
 )
 
@@ -15,6 +16,9 @@ type ModuleTestProviderConfigurationLink struct {
 	Type string `json:"type"`
 
 	Relationships ModuleTestProviderConfigurationLinkRelationships `json:"relationships"`
+
+	// stub is set for a related resource which was not included, only its ID and type are known
+	stub bool
 }
 
 // GetID returns the resource ID (implements client.ResourceLike)
@@ -30,6 +34,26 @@ func (r ModuleTestProviderConfigurationLink) GetResourceType() string {
 	return "module-test-provider-configuration-links"
 }
 
+// Loaded reports whether the resource holds its attributes and relationships.
+// It is false for a related resource which was not included in the response,
+// only its ID and type are known then.
+func (r ModuleTestProviderConfigurationLink) Loaded() bool {
+	return !r.stub
+}
+
+// ResolveIncludes replaces the relationships with the included resources (implements client.IncludeResolver)
+func (r *ModuleTestProviderConfigurationLink) ResolveIncludes(included *client.Included) {
+	r.Relationships.ResolveIncludes(included)
+}
+
+// ModuleTestProviderConfigurationLinkResourceType is the JSON:API resource type of ModuleTestProviderConfigurationLink, the key of its sparse fieldset
+const ModuleTestProviderConfigurationLinkResourceType = "module-test-provider-configuration-links"
+
+// ModuleTestProviderConfigurationLink field names, to select with sparse fieldsets
+const (
+	ModuleTestProviderConfigurationLinkFieldProviderConfiguration = "provider-configuration"
+)
+
 // ModuleTestProviderConfigurationLinkRelationships holds the relationships for ModuleTestProviderConfigurationLink (response)
 type ModuleTestProviderConfigurationLinkRelationships struct {
 	ProviderConfiguration *ProviderConfiguration `json:"provider-configuration"`
@@ -58,41 +82,31 @@ func (r *ModuleTestProviderConfigurationLinkRelationships) UnmarshalJSON(data []
 			r.ProviderConfiguration = &ProviderConfiguration{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
 	return nil
 }
 
-// PopulateIncludes merges included resources into the relationships
-func (r *ModuleTestProviderConfigurationLinkRelationships) PopulateIncludes(included []map[string]interface{}) {
-	if r == nil || len(included) == 0 {
+// ResolveIncludes replaces the relationships with the included resources, along
+// with their own relationships. Relationships which were not included are kept as is.
+func (r *ModuleTestProviderConfigurationLinkRelationships) ResolveIncludes(included *client.Included) {
+	if r == nil || included.Len() == 0 {
 		return
 	}
 
-	// Build a map of included resources by type+id for fast lookup
-	includedMap := make(map[string]map[string]interface{})
-	for _, inc := range included {
-		if id, ok := inc["id"].(string); ok {
-			if typ, ok := inc["type"].(string); ok {
-				key := typ + ":" + id
-				includedMap[key] = inc
-			}
+	if r.ProviderConfiguration != nil {
+		if full, ok := client.Resolve[ProviderConfiguration](included, r.ProviderConfiguration.Type, r.ProviderConfiguration.ID); ok {
+			r.ProviderConfiguration = full
 		}
 	}
+}
 
-	// Populate to-one relationship: ProviderConfiguration
-	if r.ProviderConfiguration != nil && r.ProviderConfiguration.ID != "" {
-		key := r.ProviderConfiguration.Type + ":" + r.ProviderConfiguration.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full ProviderConfiguration
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.ProviderConfiguration = &full
-			}
-		}
-	}
+// PopulateIncludes merges included resources into the relationships.
+// Prefer ResolveIncludes with an index built once per response.
+func (r *ModuleTestProviderConfigurationLinkRelationships) PopulateIncludes(included []map[string]interface{}) {
+	r.ResolveIncludes(client.NewIncludedFromMaps(included))
 }
 
 // Request version - used when marshalling for API requests
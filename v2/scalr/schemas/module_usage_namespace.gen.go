@@ -5,6 +5,7 @@ package schemas
 import (
 	"encoding/json"
 
+	"github.com/scalr/go-scalr/v2/scalr/client"
 	"github.com/scalr/go-scalr/v2/scalr/value"
 )
 
@@ -15,6 +16,9 @@ type ModuleUsageNamespace struct {
 	Type          string                            `json:"type"`
 	Attributes    ModuleUsageNamespaceAttributes    `json:"attributes"`
 	Relationships ModuleUsageNamespaceRelationships `json:"relationships"`
+
+	// stub is set for a related resource which was not included, only its ID and type are known
+	stub bool
 }
 
 // GetID returns the resource ID (implements client.ResourceLike)
@@ -30,6 +34,30 @@ func (r ModuleUsageNamespace) GetResourceType() string {
 	return "tf-module-namespaces"
 }
 
+// Loaded reports whether the resource holds its attributes and relationships.
+// It is false for a related resource which was not included in the response,
+// only its ID and type are known then.
+func (r ModuleUsageNamespace) Loaded() bool {
+	return !r.stub
+}
+
+// ResolveIncludes replaces the relationships with the included resources (implements client.IncludeResolver)
+func (r *ModuleUsageNamespace) ResolveIncludes(included *client.Included) {
+	r.Relationships.ResolveIncludes(included)
+}
+
+// ModuleUsageNamespaceResourceType is the JSON:API resource type of ModuleUsageNamespace, the key of its sparse fieldset
+const ModuleUsageNamespaceResourceType = "tf-module-namespaces"
+
+// ModuleUsageNamespace field names, to select with sparse fieldsets
+const (
+	ModuleUsageNamespaceFieldAccount              = "account"
+	ModuleUsageNamespaceFieldNamespaceAccount     = "namespace-account"
+	ModuleUsageNamespaceFieldNamespaceEnvironment = "namespace-environment"
+	ModuleUsageNamespaceFieldNamespaceName        = "namespace-name"
+	ModuleUsageNamespaceFieldSource               = "source"
+)
+
 // ModuleUsageNamespaceAttributes holds the attributes for ModuleUsageNamespace (response)
 type ModuleUsageNamespaceAttributes struct {
 	// Name of the namespace
@@ -70,6 +98,7 @@ func (r *ModuleUsageNamespaceRelationships) UnmarshalJSON(data []byte) error {
 			r.Account = &Account{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -88,6 +117,7 @@ func (r *ModuleUsageNamespaceRelationships) UnmarshalJSON(data []byte) error {
 			r.NamespaceAccount = &Account{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -106,67 +136,43 @@ func (r *ModuleUsageNamespaceRelationships) UnmarshalJSON(data []byte) error {
 			r.NamespaceEnvironment = &Environment{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
 	return nil
 }
 
-// PopulateIncludes merges included resources into the relationships
-func (r *ModuleUsageNamespaceRelationships) PopulateIncludes(included []map[string]interface{}) {
-	if r == nil || len(included) == 0 {
+// ResolveIncludes replaces the relationships with the included resources, along
+// with their own relationships. Relationships which were not included are kept as is.
+func (r *ModuleUsageNamespaceRelationships) ResolveIncludes(included *client.Included) {
+	if r == nil || included.Len() == 0 {
 		return
 	}
 
-	// Build a map of included resources by type+id for fast lookup
-	includedMap := make(map[string]map[string]interface{})
-	for _, inc := range included {
-		if id, ok := inc["id"].(string); ok {
-			if typ, ok := inc["type"].(string); ok {
-				key := typ + ":" + id
-				includedMap[key] = inc
-			}
+	if r.Account != nil {
+		if full, ok := client.Resolve[Account](included, r.Account.Type, r.Account.ID); ok {
+			r.Account = full
 		}
 	}
-
-	// Populate to-one relationship: Account
-	if r.Account != nil && r.Account.ID != "" {
-		key := r.Account.Type + ":" + r.Account.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full Account
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.Account = &full
-			}
+	if r.NamespaceAccount != nil {
+		if full, ok := client.Resolve[Account](included, r.NamespaceAccount.Type, r.NamespaceAccount.ID); ok {
+			r.NamespaceAccount = full
 		}
 	}
-	// Populate to-one relationship: NamespaceAccount
-	if r.NamespaceAccount != nil && r.NamespaceAccount.ID != "" {
-		key := r.NamespaceAccount.Type + ":" + r.NamespaceAccount.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full Account
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.NamespaceAccount = &full
-			}
-		}
-	}
-	// Populate to-one relationship: NamespaceEnvironment
-	if r.NamespaceEnvironment != nil && r.NamespaceEnvironment.ID != "" {
-		key := r.NamespaceEnvironment.Type + ":" + r.NamespaceEnvironment.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full Environment
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.NamespaceEnvironment = &full
-			}
+	if r.NamespaceEnvironment != nil {
+		if full, ok := client.Resolve[Environment](included, r.NamespaceEnvironment.Type, r.NamespaceEnvironment.ID); ok {
+			r.NamespaceEnvironment = full
 		}
 	}
 }
 
+// PopulateIncludes merges included resources into the relationships.
+// Prefer ResolveIncludes with an index built once per response.
+func (r *ModuleUsageNamespaceRelationships) PopulateIncludes(included []map[string]interface{}) {
+	r.ResolveIncludes(client.NewIncludedFromMaps(included))
+}
+
 // Request version - used when marshalling for API requests
 // Represents terraform module usage namespace instance. (for requests)
 type ModuleUsageNamespaceRequest struct {
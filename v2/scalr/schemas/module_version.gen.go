@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"time"
 
+	"github.com/scalr/go-scalr/v2/scalr/client"
 	"github.com/scalr/go-scalr/v2/scalr/value"
 )
 
@@ -38,6 +39,9 @@ type ModuleVersion struct {
 	Type          string                     `json:"type"`
 	Attributes    ModuleVersionAttributes    `json:"attributes"`
 	Relationships ModuleVersionRelationships `json:"relationships"`
+
+	// stub is set for a related resource which was not included, only its ID and type are known
+	stub bool
 }
 
 // GetID returns the resource ID (implements client.ResourceLike)
@@ -53,6 +57,39 @@ func (r ModuleVersion) GetResourceType() string {
 	return "module-versions"
 }
 
+// Loaded reports whether the resource holds its attributes and relationships.
+// It is false for a related resource which was not included in the response,
+// only its ID and type are known then.
+func (r ModuleVersion) Loaded() bool {
+	return !r.stub
+}
+
+// ResolveIncludes replaces the relationships with the included resources (implements client.IncludeResolver)
+func (r *ModuleVersion) ResolveIncludes(included *client.Included) {
+	r.Relationships.ResolveIncludes(included)
+}
+
+// ModuleVersionResourceType is the JSON:API resource type of ModuleVersion, the key of its sparse fieldset
+const ModuleVersionResourceType = "module-versions"
+
+// ModuleVersion field names, to select with sparse fieldsets
+const (
+	ModuleVersionFieldCreatedAt     = "created-at"
+	ModuleVersionFieldDependencies  = "dependencies"
+	ModuleVersionFieldDetails       = "details"
+	ModuleVersionFieldErrorMessage  = "error-message"
+	ModuleVersionFieldInputs        = "inputs"
+	ModuleVersionFieldIsForbiddenBy = "is-forbidden-by"
+	ModuleVersionFieldIsRootModule  = "is-root-module"
+	ModuleVersionFieldModule        = "module"
+	ModuleVersionFieldOutputs       = "outputs"
+	ModuleVersionFieldResources     = "resources"
+	ModuleVersionFieldSize          = "size"
+	ModuleVersionFieldStatus        = "status"
+	ModuleVersionFieldVcsRevision   = "vcs-revision"
+	ModuleVersionFieldVersion       = "version"
+)
+
 // ModuleVersionAttributes holds the attributes for ModuleVersion (response)
 type ModuleVersionAttributes struct {
 	// The resource creation timestamp.
@@ -112,6 +149,7 @@ func (r *ModuleVersionRelationships) UnmarshalJSON(data []byte) error {
 			r.Module = &Module{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -130,55 +168,38 @@ func (r *ModuleVersionRelationships) UnmarshalJSON(data []byte) error {
 			r.VcsRevision = &VcsRevision{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
 	return nil
 }
 
-// PopulateIncludes merges included resources into the relationships
-func (r *ModuleVersionRelationships) PopulateIncludes(included []map[string]interface{}) {
-	if r == nil || len(included) == 0 {
+// ResolveIncludes replaces the relationships with the included resources, along
+// with their own relationships. Relationships which were not included are kept as is.
+func (r *ModuleVersionRelationships) ResolveIncludes(included *client.Included) {
+	if r == nil || included.Len() == 0 {
 		return
 	}
 
-	// Build a map of included resources by type+id for fast lookup
-	includedMap := make(map[string]map[string]interface{})
-	for _, inc := range included {
-		if id, ok := inc["id"].(string); ok {
-			if typ, ok := inc["type"].(string); ok {
-				key := typ + ":" + id
-				includedMap[key] = inc
-			}
+	if r.Module != nil {
+		if full, ok := client.Resolve[Module](included, r.Module.Type, r.Module.ID); ok {
+			r.Module = full
 		}
 	}
-
-	// Populate to-one relationship: Module
-	if r.Module != nil && r.Module.ID != "" {
-		key := r.Module.Type + ":" + r.Module.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full Module
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.Module = &full
-			}
-		}
-	}
-	// Populate to-one relationship: VcsRevision
-	if r.VcsRevision != nil && r.VcsRevision.ID != "" {
-		key := r.VcsRevision.Type + ":" + r.VcsRevision.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full VcsRevision
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.VcsRevision = &full
-			}
+	if r.VcsRevision != nil {
+		if full, ok := client.Resolve[VcsRevision](included, r.VcsRevision.Type, r.VcsRevision.ID); ok {
+			r.VcsRevision = full
 		}
 	}
 }
 
+// PopulateIncludes merges included resources into the relationships.
+// Prefer ResolveIncludes with an index built once per response.
+func (r *ModuleVersionRelationships) PopulateIncludes(included []map[string]interface{}) {
+	r.ResolveIncludes(client.NewIncludedFromMaps(included))
+}
+
 // Request version - used when marshalling for API requests
 // A terraform module's version in the [Private Module Registry](../../module.html). (for requests)
 type ModuleVersionRequest struct {
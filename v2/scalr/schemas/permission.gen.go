@@ -5,6 +5,7 @@ package schemas
 import (
 	"encoding/json"
 
+	"github.com/scalr/go-scalr/v2/scalr/client"
 	"github.com/scalr/go-scalr/v2/scalr/value"
 )
 
@@ -25,6 +26,9 @@ type Permission struct {
 	ID         string               `json:"id"`
 	Type       string               `json:"type"`
 	Attributes PermissionAttributes `json:"attributes"`
+
+	// stub is set for a related resource which was not included, only its ID and type are known
+	stub bool
 }
 
 // GetID returns the resource ID (implements client.ResourceLike)
@@ -40,6 +44,26 @@ func (r Permission) GetResourceType() string {
 	return "permissions"
 }
 
+// Loaded reports whether the resource holds its attributes and relationships.
+// It is false for a related resource which was not included in the response,
+// only its ID and type are known then.
+func (r Permission) Loaded() bool {
+	return !r.stub
+}
+
+// ResolveIncludes replaces the relationships with the included resources (implements client.IncludeResolver)
+func (r *Permission) ResolveIncludes(included *client.Included) {
+}
+
+// PermissionResourceType is the JSON:API resource type of Permission, the key of its sparse fieldset
+const PermissionResourceType = "permissions"
+
+// Permission field names, to select with sparse fieldsets
+const (
+	PermissionFieldApplicableScopes = "applicable-scopes"
+	PermissionFieldDescription      = "description"
+)
+
 // PermissionAttributes holds the attributes for Permission (response)
 type PermissionAttributes struct {
 	// Scope identities, this permission could be applied to in an [access policy](access-policies.html).
@@ -5,6 +5,7 @@ package schemas
 import (
 	"encoding/json"
 
+	"github.com/scalr/go-scalr/v2/scalr/client"
 	"github.com/scalr/go-scalr/v2/scalr/value"
 )
 
@@ -29,6 +30,9 @@ type Plan struct {
 	ID         string         `json:"id"`
 	Type       string         `json:"type"`
 	Attributes PlanAttributes `json:"attributes"`
+
+	// stub is set for a related resource which was not included, only its ID and type are known
+	stub bool
 }
 
 // GetID returns the resource ID (implements client.ResourceLike)
@@ -44,6 +48,31 @@ func (r Plan) GetResourceType() string {
 	return "plans"
 }
 
+// Loaded reports whether the resource holds its attributes and relationships.
+// It is false for a related resource which was not included in the response,
+// only its ID and type are known then.
+func (r Plan) Loaded() bool {
+	return !r.stub
+}
+
+// ResolveIncludes replaces the relationships with the included resources (implements client.IncludeResolver)
+func (r *Plan) ResolveIncludes(included *client.Included) {
+}
+
+// PlanResourceType is the JSON:API resource type of Plan, the key of its sparse fieldset
+const PlanResourceType = "plans"
+
+// Plan field names, to select with sparse fieldsets
+const (
+	PlanFieldExecutionDetails     = "execution-details"
+	PlanFieldHasChanges           = "has-changes"
+	PlanFieldResourceAdditions    = "resource-additions"
+	PlanFieldResourceChanges      = "resource-changes"
+	PlanFieldResourceDestructions = "resource-destructions"
+	PlanFieldStatus               = "status"
+	PlanFieldStatusTimestamps     = "status-timestamps"
+)
+
 // PlanAttributes holds the attributes for Plan (response)
 type PlanAttributes struct {
 	// Plan execution details.
@@ -5,6 +5,7 @@ package schemas
 import (
 	"encoding/json"
 
+	"github.com/scalr/go-scalr/v2/scalr/client"
 	"github.com/scalr/go-scalr/v2/scalr/value"
 )
 
@@ -26,6 +27,9 @@ type Policy struct {
 	Type          string              `json:"type"`
 	Attributes    PolicyAttributes    `json:"attributes"`
 	Relationships PolicyRelationships `json:"relationships"`
+
+	// stub is set for a related resource which was not included, only its ID and type are known
+	stub bool
 }
 
 // GetID returns the resource ID (implements client.ResourceLike)
@@ -41,6 +45,29 @@ func (r Policy) GetResourceType() string {
 	return "policies"
 }
 
+// Loaded reports whether the resource holds its attributes and relationships.
+// It is false for a related resource which was not included in the response,
+// only its ID and type are known then.
+func (r Policy) Loaded() bool {
+	return !r.stub
+}
+
+// ResolveIncludes replaces the relationships with the included resources (implements client.IncludeResolver)
+func (r *Policy) ResolveIncludes(included *client.Included) {
+	r.Relationships.ResolveIncludes(included)
+}
+
+// PolicyResourceType is the JSON:API resource type of Policy, the key of its sparse fieldset
+const PolicyResourceType = "policies"
+
+// Policy field names, to select with sparse fieldsets
+const (
+	PolicyFieldEnabled       = "enabled"
+	PolicyFieldEnforcedLevel = "enforced-level"
+	PolicyFieldName          = "name"
+	PolicyFieldPolicyGroup   = "policy-group"
+)
+
 // PolicyAttributes holds the attributes for Policy (response)
 type PolicyAttributes struct {
 	// If set to `false`, the policy will not be evaluated during a run.
@@ -80,41 +107,31 @@ func (r *PolicyRelationships) UnmarshalJSON(data []byte) error {
 			r.PolicyGroup = &PolicyGroup{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
 	return nil
 }
 
-// PopulateIncludes merges included resources into the relationships
-func (r *PolicyRelationships) PopulateIncludes(included []map[string]interface{}) {
-	if r == nil || len(included) == 0 {
+// ResolveIncludes replaces the relationships with the included resources, along
+// with their own relationships. Relationships which were not included are kept as is.
+func (r *PolicyRelationships) ResolveIncludes(included *client.Included) {
+	if r == nil || included.Len() == 0 {
 		return
 	}
 
-	// Build a map of included resources by type+id for fast lookup
-	includedMap := make(map[string]map[string]interface{})
-	for _, inc := range included {
-		if id, ok := inc["id"].(string); ok {
-			if typ, ok := inc["type"].(string); ok {
-				key := typ + ":" + id
-				includedMap[key] = inc
-			}
+	if r.PolicyGroup != nil {
+		if full, ok := client.Resolve[PolicyGroup](included, r.PolicyGroup.Type, r.PolicyGroup.ID); ok {
+			r.PolicyGroup = full
 		}
 	}
+}
 
-	// Populate to-one relationship: PolicyGroup
-	if r.PolicyGroup != nil && r.PolicyGroup.ID != "" {
-		key := r.PolicyGroup.Type + ":" + r.PolicyGroup.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full PolicyGroup
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.PolicyGroup = &full
-			}
-		}
-	}
+// PopulateIncludes merges included resources into the relationships.
+// Prefer ResolveIncludes with an index built once per response.
+func (r *PolicyRelationships) PopulateIncludes(included []map[string]interface{}) {
+	r.ResolveIncludes(client.NewIncludedFromMaps(included))
 }
 
 // Request version - used when marshalling for API requests
@@ -5,6 +5,7 @@ package schemas
 import (
 	"encoding/json"
 
+	"github.com/scalr/go-scalr/v2/scalr/client"
 	"github.com/scalr/go-scalr/v2/scalr/value"
 )
 
@@ -31,6 +32,9 @@ type PolicyCheck struct {
 	ID         string                `json:"id"`
 	Type       string                `json:"type"`
 	Attributes PolicyCheckAttributes `json:"attributes"`
+
+	// stub is set for a related resource which was not included, only its ID and type are known
+	stub bool
 }
 
 // GetID returns the resource ID (implements client.ResourceLike)
@@ -46,6 +50,28 @@ func (r PolicyCheck) GetResourceType() string {
 	return "policy-checks"
 }
 
+// Loaded reports whether the resource holds its attributes and relationships.
+// It is false for a related resource which was not included in the response,
+// only its ID and type are known then.
+func (r PolicyCheck) Loaded() bool {
+	return !r.stub
+}
+
+// ResolveIncludes replaces the relationships with the included resources (implements client.IncludeResolver)
+func (r *PolicyCheck) ResolveIncludes(included *client.Included) {
+}
+
+// PolicyCheckResourceType is the JSON:API resource type of PolicyCheck, the key of its sparse fieldset
+const PolicyCheckResourceType = "policy-checks"
+
+// PolicyCheck field names, to select with sparse fieldsets
+const (
+	PolicyCheckFieldPermissions      = "permissions"
+	PolicyCheckFieldResult           = "result"
+	PolicyCheckFieldStatus           = "status"
+	PolicyCheckFieldStatusTimestamps = "status-timestamps"
+)
+
 // PolicyCheckAttributes holds the attributes for PolicyCheck (response)
 type PolicyCheckAttributes struct {
 	Permissions map[string]interface{} `json:"permissions"`
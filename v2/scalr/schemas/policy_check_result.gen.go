@@ -5,6 +5,7 @@ package schemas
 import (
 	"encoding/json"
 
+	"github.com/scalr/go-scalr/v2/scalr/client"
 	"github.com/scalr/go-scalr/v2/scalr/value"
 )
 
@@ -27,6 +28,9 @@ type PolicyCheckResult struct {
 	Type          string                         `json:"type"`
 	Attributes    PolicyCheckResultAttributes    `json:"attributes"`
 	Relationships PolicyCheckResultRelationships `json:"relationships"`
+
+	// stub is set for a related resource which was not included, only its ID and type are known
+	stub bool
 }
 
 // GetID returns the resource ID (implements client.ResourceLike)
@@ -42,6 +46,35 @@ func (r PolicyCheckResult) GetResourceType() string {
 	return "policy-check-results"
 }
 
+// Loaded reports whether the resource holds its attributes and relationships.
+// It is false for a related resource which was not included in the response,
+// only its ID and type are known then.
+func (r PolicyCheckResult) Loaded() bool {
+	return !r.stub
+}
+
+// ResolveIncludes replaces the relationships with the included resources (implements client.IncludeResolver)
+func (r *PolicyCheckResult) ResolveIncludes(included *client.Included) {
+	r.Relationships.ResolveIncludes(included)
+}
+
+// PolicyCheckResultResourceType is the JSON:API resource type of PolicyCheckResult, the key of its sparse fieldset
+const PolicyCheckResultResourceType = "policy-check-results"
+
+// PolicyCheckResult field names, to select with sparse fieldsets
+const (
+	PolicyCheckResultFieldEnvironment       = "environment"
+	PolicyCheckResultFieldMessages          = "messages"
+	PolicyCheckResultFieldName              = "name"
+	PolicyCheckResultFieldPolicyCheck       = "policy-check"
+	PolicyCheckResultFieldPullRequestNumber = "pull-request-number"
+	PolicyCheckResultFieldPullRequestTitle  = "pull-request-title"
+	PolicyCheckResultFieldResult            = "result"
+	PolicyCheckResultFieldRun               = "run"
+	PolicyCheckResultFieldUnitPath          = "unit-path"
+	PolicyCheckResultFieldWorkspace         = "workspace"
+)
+
 // PolicyCheckResultAttributes holds the attributes for PolicyCheckResult (response)
 type PolicyCheckResultAttributes struct {
 	// The messages returned by the policy check.
@@ -93,6 +126,7 @@ func (r *PolicyCheckResultRelationships) UnmarshalJSON(data []byte) error {
 			r.Environment = &Environment{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -111,6 +145,7 @@ func (r *PolicyCheckResultRelationships) UnmarshalJSON(data []byte) error {
 			r.PolicyCheck = &PolicyCheck{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -129,6 +164,7 @@ func (r *PolicyCheckResultRelationships) UnmarshalJSON(data []byte) error {
 			r.Run = &Run{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -147,79 +183,48 @@ func (r *PolicyCheckResultRelationships) UnmarshalJSON(data []byte) error {
 			r.Workspace = &Workspace{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
 	return nil
 }
 
-// PopulateIncludes merges included resources into the relationships
-func (r *PolicyCheckResultRelationships) PopulateIncludes(included []map[string]interface{}) {
-	if r == nil || len(included) == 0 {
+// ResolveIncludes replaces the relationships with the included resources, along
+// with their own relationships. Relationships which were not included are kept as is.
+func (r *PolicyCheckResultRelationships) ResolveIncludes(included *client.Included) {
+	if r == nil || included.Len() == 0 {
 		return
 	}
 
-	// Build a map of included resources by type+id for fast lookup
-	includedMap := make(map[string]map[string]interface{})
-	for _, inc := range included {
-		if id, ok := inc["id"].(string); ok {
-			if typ, ok := inc["type"].(string); ok {
-				key := typ + ":" + id
-				includedMap[key] = inc
-			}
+	if r.Environment != nil {
+		if full, ok := client.Resolve[Environment](included, r.Environment.Type, r.Environment.ID); ok {
+			r.Environment = full
 		}
 	}
-
-	// Populate to-one relationship: Environment
-	if r.Environment != nil && r.Environment.ID != "" {
-		key := r.Environment.Type + ":" + r.Environment.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full Environment
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.Environment = &full
-			}
-		}
-	}
-	// Populate to-one relationship: PolicyCheck
-	if r.PolicyCheck != nil && r.PolicyCheck.ID != "" {
-		key := r.PolicyCheck.Type + ":" + r.PolicyCheck.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full PolicyCheck
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.PolicyCheck = &full
-			}
+	if r.PolicyCheck != nil {
+		if full, ok := client.Resolve[PolicyCheck](included, r.PolicyCheck.Type, r.PolicyCheck.ID); ok {
+			r.PolicyCheck = full
 		}
 	}
-	// Populate to-one relationship: Run
-	if r.Run != nil && r.Run.ID != "" {
-		key := r.Run.Type + ":" + r.Run.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full Run
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.Run = &full
-			}
+	if r.Run != nil {
+		if full, ok := client.Resolve[Run](included, r.Run.Type, r.Run.ID); ok {
+			r.Run = full
 		}
 	}
-	// Populate to-one relationship: Workspace
-	if r.Workspace != nil && r.Workspace.ID != "" {
-		key := r.Workspace.Type + ":" + r.Workspace.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full Workspace
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.Workspace = &full
-			}
+	if r.Workspace != nil {
+		if full, ok := client.Resolve[Workspace](included, r.Workspace.Type, r.Workspace.ID); ok {
+			r.Workspace = full
 		}
 	}
 }
 
+// PopulateIncludes merges included resources into the relationships.
+// Prefer ResolveIncludes with an index built once per response.
+func (r *PolicyCheckResultRelationships) PopulateIncludes(included []map[string]interface{}) {
+	r.ResolveIncludes(client.NewIncludedFromMaps(included))
+}
+
 // Request version - used when marshalling for API requests
 // Represents a terraform policy check result. (for requests)
 type PolicyCheckResultRequest struct {
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"time"
 
+	"github.com/scalr/go-scalr/v2/scalr/client"
 	"github.com/scalr/go-scalr/v2/scalr/value"
 )
 
@@ -37,6 +38,9 @@ type PolicyGroup struct {
 	Type          string                   `json:"type"`
 	Attributes    PolicyGroupAttributes    `json:"attributes"`
 	Relationships PolicyGroupRelationships `json:"relationships"`
+
+	// stub is set for a related resource which was not included, only its ID and type are known
+	stub bool
 }
 
 // GetID returns the resource ID (implements client.ResourceLike)
@@ -52,6 +56,39 @@ func (r PolicyGroup) GetResourceType() string {
 	return "policy-groups"
 }
 
+// Loaded reports whether the resource holds its attributes and relationships.
+// It is false for a related resource which was not included in the response,
+// only its ID and type are known then.
+func (r PolicyGroup) Loaded() bool {
+	return !r.stub
+}
+
+// ResolveIncludes replaces the relationships with the included resources (implements client.IncludeResolver)
+func (r *PolicyGroup) ResolveIncludes(included *client.Included) {
+	r.Relationships.ResolveIncludes(included)
+}
+
+// PolicyGroupResourceType is the JSON:API resource type of PolicyGroup, the key of its sparse fieldset
+const PolicyGroupResourceType = "policy-groups"
+
+// PolicyGroup field names, to select with sparse fieldsets
+const (
+	PolicyGroupFieldAccount               = "account"
+	PolicyGroupFieldCommonFunctionsFolder = "common-functions-folder"
+	PolicyGroupFieldCreatedAt             = "created-at"
+	PolicyGroupFieldEnvironments          = "environments"
+	PolicyGroupFieldErrorMessage          = "error-message"
+	PolicyGroupFieldExecuteAs             = "execute-as"
+	PolicyGroupFieldIsEnforced            = "is-enforced"
+	PolicyGroupFieldName                  = "name"
+	PolicyGroupFieldOpaVersion            = "opa-version"
+	PolicyGroupFieldPolicies              = "policies"
+	PolicyGroupFieldStatus                = "status"
+	PolicyGroupFieldVcsProvider           = "vcs-provider"
+	PolicyGroupFieldVcsRepo               = "vcs-repo"
+	PolicyGroupFieldVcsRevision           = "vcs-revision"
+)
+
 // PolicyGroupAttributes holds the attributes for PolicyGroup (response)
 type PolicyGroupAttributes struct {
 	// An absolute path from the repository root to the folder that contains common rego functions.
@@ -110,6 +147,7 @@ func (r *PolicyGroupRelationships) UnmarshalJSON(data []byte) error {
 			r.Account = &Account{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -130,6 +168,7 @@ func (r *PolicyGroupRelationships) UnmarshalJSON(data []byte) error {
 				r.Environments[i] = &Environment{
 					ID:   d.ID,
 					Type: d.Type,
+					stub: true,
 				}
 			}
 		}
@@ -151,6 +190,7 @@ func (r *PolicyGroupRelationships) UnmarshalJSON(data []byte) error {
 				r.Policies[i] = &Policy{
 					ID:   d.ID,
 					Type: d.Type,
+					stub: true,
 				}
 			}
 		}
@@ -170,6 +210,7 @@ func (r *PolicyGroupRelationships) UnmarshalJSON(data []byte) error {
 			r.VcsProvider = &VcsProvider{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -188,99 +229,59 @@ func (r *PolicyGroupRelationships) UnmarshalJSON(data []byte) error {
 			r.VcsRevision = &VcsRevision{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
 	return nil
 }
 
-// PopulateIncludes merges included resources into the relationships
-func (r *PolicyGroupRelationships) PopulateIncludes(included []map[string]interface{}) {
-	if r == nil || len(included) == 0 {
+// ResolveIncludes replaces the relationships with the included resources, along
+// with their own relationships. Relationships which were not included are kept as is.
+func (r *PolicyGroupRelationships) ResolveIncludes(included *client.Included) {
+	if r == nil || included.Len() == 0 {
 		return
 	}
 
-	// Build a map of included resources by type+id for fast lookup
-	includedMap := make(map[string]map[string]interface{})
-	for _, inc := range included {
-		if id, ok := inc["id"].(string); ok {
-			if typ, ok := inc["type"].(string); ok {
-				key := typ + ":" + id
-				includedMap[key] = inc
-			}
+	if r.Account != nil {
+		if full, ok := client.Resolve[Account](included, r.Account.Type, r.Account.ID); ok {
+			r.Account = full
 		}
 	}
-
-	// Populate to-one relationship: Account
-	if r.Account != nil && r.Account.ID != "" {
-		key := r.Account.Type + ":" + r.Account.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full Account
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.Account = &full
-			}
+	for i, rel := range r.Environments {
+		if rel == nil {
+			continue
 		}
-	}
-	// Populate to-many relationship: Environments
-	if r.Environments != nil {
-		for i, resource := range r.Environments {
-			if resource != nil && resource.ID != "" {
-				key := resource.Type + ":" + resource.ID
-				if fullResource, ok := includedMap[key]; ok {
-					// Unmarshal the full resource
-					data, _ := json.Marshal(fullResource)
-					var full Environment
-					if err := json.Unmarshal(data, &full); err == nil {
-						r.Environments[i] = &full
-					}
-				}
-			}
+		if full, ok := client.Resolve[Environment](included, rel.Type, rel.ID); ok {
+			r.Environments[i] = full
 		}
 	}
-	// Populate to-many relationship: Policies
-	if r.Policies != nil {
-		for i, resource := range r.Policies {
-			if resource != nil && resource.ID != "" {
-				key := resource.Type + ":" + resource.ID
-				if fullResource, ok := includedMap[key]; ok {
-					// Unmarshal the full resource
-					data, _ := json.Marshal(fullResource)
-					var full Policy
-					if err := json.Unmarshal(data, &full); err == nil {
-						r.Policies[i] = &full
-					}
-				}
-			}
+	for i, rel := range r.Policies {
+		if rel == nil {
+			continue
+		}
+		if full, ok := client.Resolve[Policy](included, rel.Type, rel.ID); ok {
+			r.Policies[i] = full
 		}
 	}
-	// Populate to-one relationship: VcsProvider
-	if r.VcsProvider != nil && r.VcsProvider.ID != "" {
-		key := r.VcsProvider.Type + ":" + r.VcsProvider.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full VcsProvider
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.VcsProvider = &full
-			}
+	if r.VcsProvider != nil {
+		if full, ok := client.Resolve[VcsProvider](included, r.VcsProvider.Type, r.VcsProvider.ID); ok {
+			r.VcsProvider = full
 		}
 	}
-	// Populate to-one relationship: VcsRevision
-	if r.VcsRevision != nil && r.VcsRevision.ID != "" {
-		key := r.VcsRevision.Type + ":" + r.VcsRevision.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full VcsRevision
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.VcsRevision = &full
-			}
+	if r.VcsRevision != nil {
+		if full, ok := client.Resolve[VcsRevision](included, r.VcsRevision.Type, r.VcsRevision.ID); ok {
+			r.VcsRevision = full
 		}
 	}
 }
 
+// PopulateIncludes merges included resources into the relationships.
+// Prefer ResolveIncludes with an index built once per response.
+func (r *PolicyGroupRelationships) PopulateIncludes(included []map[string]interface{}) {
+	r.ResolveIncludes(client.NewIncludedFromMaps(included))
+}
+
 // Request version - used when marshalling for API requests
 // A policy group represents the collection of [OPA](/docs/policy-governance#open-policy-agent) policies stored in a VCS repository. When [linked to an environment](/docs/assign-policies), the policy group will participate in the policy check phase of every run in that environment. (for requests)
 type PolicyGroupRequest struct {
@@ -19,3 +19,6 @@ func (r PolicyGroupEnvironmentRelationship) GetResourceType() string {
 	}
 	return "environments"
 }
+
+// PolicyGroupEnvironmentRelationshipResourceType is the JSON:API resource type of PolicyGroupEnvironmentRelationship, the key of its sparse fieldset
+const PolicyGroupEnvironmentRelationshipResourceType = "environments"
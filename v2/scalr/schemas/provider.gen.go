@@ -5,6 +5,7 @@ package schemas
 import (
 	"encoding/json"
 
+	"github.com/scalr/go-scalr/v2/scalr/client"
 	"github.com/scalr/go-scalr/v2/scalr/value"
 )
 
@@ -15,6 +16,9 @@ type Provider struct {
 	Type          string                `json:"type"`
 	Attributes    ProviderAttributes    `json:"attributes"`
 	Relationships ProviderRelationships `json:"relationships"`
+
+	// stub is set for a related resource which was not included, only its ID and type are known
+	stub bool
 }
 
 // GetID returns the resource ID (implements client.ResourceLike)
@@ -30,6 +34,30 @@ func (r Provider) GetResourceType() string {
 	return "providers"
 }
 
+// Loaded reports whether the resource holds its attributes and relationships.
+// It is false for a related resource which was not included in the response,
+// only its ID and type are known then.
+func (r Provider) Loaded() bool {
+	return !r.stub
+}
+
+// ResolveIncludes replaces the relationships with the included resources (implements client.IncludeResolver)
+func (r *Provider) ResolveIncludes(included *client.Included) {
+	r.Relationships.ResolveIncludes(included)
+}
+
+// ProviderResourceType is the JSON:API resource type of Provider, the key of its sparse fieldset
+const ProviderResourceType = "providers"
+
+// Provider field names, to select with sparse fieldsets
+const (
+	ProviderFieldDescription           = "description"
+	ProviderFieldLatestProviderVersion = "latest-provider-version"
+	ProviderFieldName                  = "name"
+	ProviderFieldNamespace             = "namespace"
+	ProviderFieldProviderVersion       = "provider-version"
+)
+
 // ProviderAttributes holds the attributes for Provider (response)
 type ProviderAttributes struct {
 	// The description of the provider.
@@ -71,6 +99,7 @@ func (r *ProviderRelationships) UnmarshalJSON(data []byte) error {
 			r.LatestProviderVersion = &ProviderVersion{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -89,55 +118,38 @@ func (r *ProviderRelationships) UnmarshalJSON(data []byte) error {
 			r.ProviderVersion = &ProviderVersion{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
 	return nil
 }
 
-// PopulateIncludes merges included resources into the relationships
-func (r *ProviderRelationships) PopulateIncludes(included []map[string]interface{}) {
-	if r == nil || len(included) == 0 {
+// ResolveIncludes replaces the relationships with the included resources, along
+// with their own relationships. Relationships which were not included are kept as is.
+func (r *ProviderRelationships) ResolveIncludes(included *client.Included) {
+	if r == nil || included.Len() == 0 {
 		return
 	}
 
-	// Build a map of included resources by type+id for fast lookup
-	includedMap := make(map[string]map[string]interface{})
-	for _, inc := range included {
-		if id, ok := inc["id"].(string); ok {
-			if typ, ok := inc["type"].(string); ok {
-				key := typ + ":" + id
-				includedMap[key] = inc
-			}
+	if r.LatestProviderVersion != nil {
+		if full, ok := client.Resolve[ProviderVersion](included, r.LatestProviderVersion.Type, r.LatestProviderVersion.ID); ok {
+			r.LatestProviderVersion = full
 		}
 	}
-
-	// Populate to-one relationship: LatestProviderVersion
-	if r.LatestProviderVersion != nil && r.LatestProviderVersion.ID != "" {
-		key := r.LatestProviderVersion.Type + ":" + r.LatestProviderVersion.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full ProviderVersion
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.LatestProviderVersion = &full
-			}
-		}
-	}
-	// Populate to-one relationship: ProviderVersion
-	if r.ProviderVersion != nil && r.ProviderVersion.ID != "" {
-		key := r.ProviderVersion.Type + ":" + r.ProviderVersion.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full ProviderVersion
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.ProviderVersion = &full
-			}
+	if r.ProviderVersion != nil {
+		if full, ok := client.Resolve[ProviderVersion](included, r.ProviderVersion.Type, r.ProviderVersion.ID); ok {
+			r.ProviderVersion = full
 		}
 	}
 }
 
+// PopulateIncludes merges included resources into the relationships.
+// Prefer ResolveIncludes with an index built once per response.
+func (r *ProviderRelationships) PopulateIncludes(included []map[string]interface{}) {
+	r.ResolveIncludes(client.NewIncludedFromMaps(included))
+}
+
 // Request version - used when marshalling for API requests
 // The Terraform Registry Provider resource. (for requests)
 type ProviderRequest struct {
@@ -5,6 +5,7 @@ package schemas
 import (
 	"encoding/json"
 
+	"github.com/scalr/go-scalr/v2/scalr/client"
 	"github.com/scalr/go-scalr/v2/scalr/value"
 )
 
@@ -107,6 +108,9 @@ type ProviderConfiguration struct {
 	Type          string                             `json:"type"`
 	Attributes    ProviderConfigurationAttributes    `json:"attributes"`
 	Relationships ProviderConfigurationRelationships `json:"relationships"`
+
+	// stub is set for a related resource which was not included, only its ID and type are known
+	stub bool
 }
 
 // GetID returns the resource ID (implements client.ResourceLike)
@@ -122,6 +126,68 @@ func (r ProviderConfiguration) GetResourceType() string {
 	return "provider-configurations"
 }
 
+// Loaded reports whether the resource holds its attributes and relationships.
+// It is false for a related resource which was not included in the response,
+// only its ID and type are known then.
+func (r ProviderConfiguration) Loaded() bool {
+	return !r.stub
+}
+
+// ResolveIncludes replaces the relationships with the included resources (implements client.IncludeResolver)
+func (r *ProviderConfiguration) ResolveIncludes(included *client.Included) {
+	r.Relationships.ResolveIncludes(included)
+}
+
+// ProviderConfigurationResourceType is the JSON:API resource type of ProviderConfiguration, the key of its sparse fieldset
+const ProviderConfigurationResourceType = "provider-configurations"
+
+// ProviderConfiguration field names, to select with sparse fieldsets
+const (
+	ProviderConfigurationFieldAccount                     = "account"
+	ProviderConfigurationFieldApplyOnly                   = "apply-only"
+	ProviderConfigurationFieldAwsAccessKey                = "aws-access-key"
+	ProviderConfigurationFieldAwsAccountType              = "aws-account-type"
+	ProviderConfigurationFieldAwsAudience                 = "aws-audience"
+	ProviderConfigurationFieldAwsCredentialsSource        = "aws-credentials-source"
+	ProviderConfigurationFieldAwsCredentialsType          = "aws-credentials-type"
+	ProviderConfigurationFieldAwsDefaultTags              = "aws-default-tags"
+	ProviderConfigurationFieldAwsDefaultTagsStrategy      = "aws-default-tags-strategy"
+	ProviderConfigurationFieldAwsExternalId               = "aws-external-id"
+	ProviderConfigurationFieldAwsRoleArn                  = "aws-role-arn"
+	ProviderConfigurationFieldAwsSecretKey                = "aws-secret-key"
+	ProviderConfigurationFieldAwsTrustedEntityType        = "aws-trusted-entity-type"
+	ProviderConfigurationFieldAzurermAudience             = "azurerm-audience"
+	ProviderConfigurationFieldAzurermAuthType             = "azurerm-auth-type"
+	ProviderConfigurationFieldAzurermClientId             = "azurerm-client-id"
+	ProviderConfigurationFieldAzurermClientSecret         = "azurerm-client-secret"
+	ProviderConfigurationFieldAzurermSubscriptionId       = "azurerm-subscription-id"
+	ProviderConfigurationFieldAzurermTenantId             = "azurerm-tenant-id"
+	ProviderConfigurationFieldEnvironments                = "environments"
+	ProviderConfigurationFieldErrorMessage                = "error-message"
+	ProviderConfigurationFieldExportShellVariables        = "export-shell-variables"
+	ProviderConfigurationFieldGoogleAuthType              = "google-auth-type"
+	ProviderConfigurationFieldGoogleCredentials           = "google-credentials"
+	ProviderConfigurationFieldGoogleDefaultLabels         = "google-default-labels"
+	ProviderConfigurationFieldGoogleDefaultLabelsStrategy = "google-default-labels-strategy"
+	ProviderConfigurationFieldGoogleProject               = "google-project"
+	ProviderConfigurationFieldGoogleServiceAccountEmail   = "google-service-account-email"
+	ProviderConfigurationFieldGoogleServiceAccountName    = "google-service-account-name"
+	ProviderConfigurationFieldGoogleUseDefaultProject     = "google-use-default-project"
+	ProviderConfigurationFieldGoogleWorkloadProviderName  = "google-workload-provider-name"
+	ProviderConfigurationFieldIsAllowedInModuleTest       = "is-allowed-in-module-test"
+	ProviderConfigurationFieldIsCustom                    = "is-custom"
+	ProviderConfigurationFieldIsShared                    = "is-shared"
+	ProviderConfigurationFieldIsUsedInModuleTest          = "is-used-in-module-test"
+	ProviderConfigurationFieldName                        = "name"
+	ProviderConfigurationFieldOwners                      = "owners"
+	ProviderConfigurationFieldParameters                  = "parameters"
+	ProviderConfigurationFieldProviderName                = "provider-name"
+	ProviderConfigurationFieldScalrHostname               = "scalr-hostname"
+	ProviderConfigurationFieldScalrToken                  = "scalr-token"
+	ProviderConfigurationFieldStatus                      = "status"
+	ProviderConfigurationFieldTags                        = "tags"
+)
+
 // ProviderConfigurationAttributes holds the attributes for ProviderConfiguration (response)
 type ProviderConfigurationAttributes struct {
 	// Use this provider configuration only for the apply phase. This option is available only for built in AWS providers.
@@ -238,6 +304,7 @@ func (r *ProviderConfigurationRelationships) UnmarshalJSON(data []byte) error {
 			r.Account = &Account{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -258,6 +325,7 @@ func (r *ProviderConfigurationRelationships) UnmarshalJSON(data []byte) error {
 				r.Environments[i] = &Environment{
 					ID:   d.ID,
 					Type: d.Type,
+					stub: true,
 				}
 			}
 		}
@@ -279,6 +347,7 @@ func (r *ProviderConfigurationRelationships) UnmarshalJSON(data []byte) error {
 				r.Owners[i] = &Team{
 					ID:   d.ID,
 					Type: d.Type,
+					stub: true,
 				}
 			}
 		}
@@ -300,6 +369,7 @@ func (r *ProviderConfigurationRelationships) UnmarshalJSON(data []byte) error {
 				r.Parameters[i] = &ProviderConfigurationParameter{
 					ID:   d.ID,
 					Type: d.Type,
+					stub: true,
 				}
 			}
 		}
@@ -321,6 +391,7 @@ func (r *ProviderConfigurationRelationships) UnmarshalJSON(data []byte) error {
 				r.Tags[i] = &Tag{
 					ID:   d.ID,
 					Type: d.Type,
+					stub: true,
 				}
 			}
 		}
@@ -328,101 +399,58 @@ func (r *ProviderConfigurationRelationships) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-// PopulateIncludes merges included resources into the relationships
-func (r *ProviderConfigurationRelationships) PopulateIncludes(included []map[string]interface{}) {
-	if r == nil || len(included) == 0 {
+// ResolveIncludes replaces the relationships with the included resources, along
+// with their own relationships. Relationships which were not included are kept as is.
+func (r *ProviderConfigurationRelationships) ResolveIncludes(included *client.Included) {
+	if r == nil || included.Len() == 0 {
 		return
 	}
 
-	// Build a map of included resources by type+id for fast lookup
-	includedMap := make(map[string]map[string]interface{})
-	for _, inc := range included {
-		if id, ok := inc["id"].(string); ok {
-			if typ, ok := inc["type"].(string); ok {
-				key := typ + ":" + id
-				includedMap[key] = inc
-			}
+	if r.Account != nil {
+		if full, ok := client.Resolve[Account](included, r.Account.Type, r.Account.ID); ok {
+			r.Account = full
 		}
 	}
-
-	// Populate to-one relationship: Account
-	if r.Account != nil && r.Account.ID != "" {
-		key := r.Account.Type + ":" + r.Account.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full Account
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.Account = &full
-			}
+	for i, rel := range r.Environments {
+		if rel == nil {
+			continue
 		}
-	}
-	// Populate to-many relationship: Environments
-	if r.Environments != nil {
-		for i, resource := range r.Environments {
-			if resource != nil && resource.ID != "" {
-				key := resource.Type + ":" + resource.ID
-				if fullResource, ok := includedMap[key]; ok {
-					// Unmarshal the full resource
-					data, _ := json.Marshal(fullResource)
-					var full Environment
-					if err := json.Unmarshal(data, &full); err == nil {
-						r.Environments[i] = &full
-					}
-				}
-			}
+		if full, ok := client.Resolve[Environment](included, rel.Type, rel.ID); ok {
+			r.Environments[i] = full
 		}
 	}
-	// Populate to-many relationship: Owners
-	if r.Owners != nil {
-		for i, resource := range r.Owners {
-			if resource != nil && resource.ID != "" {
-				key := resource.Type + ":" + resource.ID
-				if fullResource, ok := includedMap[key]; ok {
-					// Unmarshal the full resource
-					data, _ := json.Marshal(fullResource)
-					var full Team
-					if err := json.Unmarshal(data, &full); err == nil {
-						r.Owners[i] = &full
-					}
-				}
-			}
+	for i, rel := range r.Owners {
+		if rel == nil {
+			continue
+		}
+		if full, ok := client.Resolve[Team](included, rel.Type, rel.ID); ok {
+			r.Owners[i] = full
 		}
 	}
-	// Populate to-many relationship: Parameters
-	if r.Parameters != nil {
-		for i, resource := range r.Parameters {
-			if resource != nil && resource.ID != "" {
-				key := resource.Type + ":" + resource.ID
-				if fullResource, ok := includedMap[key]; ok {
-					// Unmarshal the full resource
-					data, _ := json.Marshal(fullResource)
-					var full ProviderConfigurationParameter
-					if err := json.Unmarshal(data, &full); err == nil {
-						r.Parameters[i] = &full
-					}
-				}
-			}
+	for i, rel := range r.Parameters {
+		if rel == nil {
+			continue
+		}
+		if full, ok := client.Resolve[ProviderConfigurationParameter](included, rel.Type, rel.ID); ok {
+			r.Parameters[i] = full
 		}
 	}
-	// Populate to-many relationship: Tags
-	if r.Tags != nil {
-		for i, resource := range r.Tags {
-			if resource != nil && resource.ID != "" {
-				key := resource.Type + ":" + resource.ID
-				if fullResource, ok := includedMap[key]; ok {
-					// Unmarshal the full resource
-					data, _ := json.Marshal(fullResource)
-					var full Tag
-					if err := json.Unmarshal(data, &full); err == nil {
-						r.Tags[i] = &full
-					}
-				}
-			}
+	for i, rel := range r.Tags {
+		if rel == nil {
+			continue
+		}
+		if full, ok := client.Resolve[Tag](included, rel.Type, rel.ID); ok {
+			r.Tags[i] = full
 		}
 	}
 }
 
+// PopulateIncludes merges included resources into the relationships.
+// Prefer ResolveIncludes with an index built once per response.
+func (r *ProviderConfigurationRelationships) PopulateIncludes(included []map[string]interface{}) {
+	r.ResolveIncludes(client.NewIncludedFromMaps(included))
+}
+
 // Request version - used when marshalling for API requests
 // The configuration of provider. Provider configuration is managed on the account scope and can be linked to environments or workspaces. (for requests)
 type ProviderConfigurationRequest struct {
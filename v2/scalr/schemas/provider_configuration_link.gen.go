@@ -5,6 +5,7 @@ package schemas
 import (
 	"encoding/json"
 
+	"github.com/scalr/go-scalr/v2/scalr/client"
 	"github.com/scalr/go-scalr/v2/scalr/value"
 )
 
@@ -15,6 +16,9 @@ type ProviderConfigurationLink struct {
 	Type          string                                 `json:"type"`
 	Attributes    ProviderConfigurationLinkAttributes    `json:"attributes"`
 	Relationships ProviderConfigurationLinkRelationships `json:"relationships"`
+
+	// stub is set for a related resource which was not included, only its ID and type are known
+	stub bool
 }
 
 // GetID returns the resource ID (implements client.ResourceLike)
@@ -30,6 +34,30 @@ func (r ProviderConfigurationLink) GetResourceType() string {
 	return "provider-configuration-links"
 }
 
+// Loaded reports whether the resource holds its attributes and relationships.
+// It is false for a related resource which was not included in the response,
+// only its ID and type are known then.
+func (r ProviderConfigurationLink) Loaded() bool {
+	return !r.stub
+}
+
+// ResolveIncludes replaces the relationships with the included resources (implements client.IncludeResolver)
+func (r *ProviderConfigurationLink) ResolveIncludes(included *client.Included) {
+	r.Relationships.ResolveIncludes(included)
+}
+
+// ProviderConfigurationLinkResourceType is the JSON:API resource type of ProviderConfigurationLink, the key of its sparse fieldset
+const ProviderConfigurationLinkResourceType = "provider-configuration-links"
+
+// ProviderConfigurationLink field names, to select with sparse fieldsets
+const (
+	ProviderConfigurationLinkFieldAlias                 = "alias"
+	ProviderConfigurationLinkFieldDefault               = "default"
+	ProviderConfigurationLinkFieldEnvironment           = "environment"
+	ProviderConfigurationLinkFieldProviderConfiguration = "provider-configuration"
+	ProviderConfigurationLinkFieldWorkspace             = "workspace"
+)
+
 // ProviderConfigurationLinkAttributes holds the attributes for ProviderConfigurationLink (response)
 type ProviderConfigurationLinkAttributes struct {
 	// Is used only for the workspace links. Meta-argument for using the same provider with different configurations for different resources.
@@ -68,6 +96,7 @@ func (r *ProviderConfigurationLinkRelationships) UnmarshalJSON(data []byte) erro
 			r.Environment = &Environment{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -86,6 +115,7 @@ func (r *ProviderConfigurationLinkRelationships) UnmarshalJSON(data []byte) erro
 			r.ProviderConfiguration = &ProviderConfiguration{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -104,67 +134,43 @@ func (r *ProviderConfigurationLinkRelationships) UnmarshalJSON(data []byte) erro
 			r.Workspace = &Workspace{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
 	return nil
 }
 
-// PopulateIncludes merges included resources into the relationships
-func (r *ProviderConfigurationLinkRelationships) PopulateIncludes(included []map[string]interface{}) {
-	if r == nil || len(included) == 0 {
+// ResolveIncludes replaces the relationships with the included resources, along
+// with their own relationships. Relationships which were not included are kept as is.
+func (r *ProviderConfigurationLinkRelationships) ResolveIncludes(included *client.Included) {
+	if r == nil || included.Len() == 0 {
 		return
 	}
 
-	// Build a map of included resources by type+id for fast lookup
-	includedMap := make(map[string]map[string]interface{})
-	for _, inc := range included {
-		if id, ok := inc["id"].(string); ok {
-			if typ, ok := inc["type"].(string); ok {
-				key := typ + ":" + id
-				includedMap[key] = inc
-			}
+	if r.Environment != nil {
+		if full, ok := client.Resolve[Environment](included, r.Environment.Type, r.Environment.ID); ok {
+			r.Environment = full
 		}
 	}
-
-	// Populate to-one relationship: Environment
-	if r.Environment != nil && r.Environment.ID != "" {
-		key := r.Environment.Type + ":" + r.Environment.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full Environment
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.Environment = &full
-			}
+	if r.ProviderConfiguration != nil {
+		if full, ok := client.Resolve[ProviderConfiguration](included, r.ProviderConfiguration.Type, r.ProviderConfiguration.ID); ok {
+			r.ProviderConfiguration = full
 		}
 	}
-	// Populate to-one relationship: ProviderConfiguration
-	if r.ProviderConfiguration != nil && r.ProviderConfiguration.ID != "" {
-		key := r.ProviderConfiguration.Type + ":" + r.ProviderConfiguration.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full ProviderConfiguration
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.ProviderConfiguration = &full
-			}
-		}
-	}
-	// Populate to-one relationship: Workspace
-	if r.Workspace != nil && r.Workspace.ID != "" {
-		key := r.Workspace.Type + ":" + r.Workspace.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full Workspace
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.Workspace = &full
-			}
+	if r.Workspace != nil {
+		if full, ok := client.Resolve[Workspace](included, r.Workspace.Type, r.Workspace.ID); ok {
+			r.Workspace = full
 		}
 	}
 }
 
+// PopulateIncludes merges included resources into the relationships.
+// Prefer ResolveIncludes with an index built once per response.
+func (r *ProviderConfigurationLinkRelationships) PopulateIncludes(included []map[string]interface{}) {
+	r.ResolveIncludes(client.NewIncludedFromMaps(included))
+}
+
 // Request version - used when marshalling for API requests
 // The provider configuration link attaches the provider configuration to an environment or workspace. (for requests)
 type ProviderConfigurationLinkRequest struct {
@@ -5,6 +5,7 @@ package schemas
 import (
 	"encoding/json"
 
+	"github.com/scalr/go-scalr/v2/scalr/client"
 	"github.com/scalr/go-scalr/v2/scalr/value"
 )
 
@@ -15,6 +16,9 @@ type ProviderConfigurationParameter struct {
 	Type          string                                      `json:"type"`
 	Attributes    ProviderConfigurationParameterAttributes    `json:"attributes"`
 	Relationships ProviderConfigurationParameterRelationships `json:"relationships"`
+
+	// stub is set for a related resource which was not included, only its ID and type are known
+	stub bool
 }
 
 // GetID returns the resource ID (implements client.ResourceLike)
@@ -30,6 +34,31 @@ func (r ProviderConfigurationParameter) GetResourceType() string {
 	return "provider-configuration-parameters"
 }
 
+// Loaded reports whether the resource holds its attributes and relationships.
+// It is false for a related resource which was not included in the response,
+// only its ID and type are known then.
+func (r ProviderConfigurationParameter) Loaded() bool {
+	return !r.stub
+}
+
+// ResolveIncludes replaces the relationships with the included resources (implements client.IncludeResolver)
+func (r *ProviderConfigurationParameter) ResolveIncludes(included *client.Included) {
+	r.Relationships.ResolveIncludes(included)
+}
+
+// ProviderConfigurationParameterResourceType is the JSON:API resource type of ProviderConfigurationParameter, the key of its sparse fieldset
+const ProviderConfigurationParameterResourceType = "provider-configuration-parameters"
+
+// ProviderConfigurationParameter field names, to select with sparse fieldsets
+const (
+	ProviderConfigurationParameterFieldDescription           = "description"
+	ProviderConfigurationParameterFieldHcl                   = "hcl"
+	ProviderConfigurationParameterFieldKey                   = "key"
+	ProviderConfigurationParameterFieldProviderConfiguration = "provider-configuration"
+	ProviderConfigurationParameterFieldSensitive             = "sensitive"
+	ProviderConfigurationParameterFieldValue                 = "value"
+)
+
 // ProviderConfigurationParameterAttributes holds the attributes for ProviderConfigurationParameter (response)
 type ProviderConfigurationParameterAttributes struct {
 	// Variable description.
@@ -72,41 +101,31 @@ func (r *ProviderConfigurationParameterRelationships) UnmarshalJSON(data []byte)
 			r.ProviderConfiguration = &ProviderConfiguration{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
 	return nil
 }
 
-// PopulateIncludes merges included resources into the relationships
-func (r *ProviderConfigurationParameterRelationships) PopulateIncludes(included []map[string]interface{}) {
-	if r == nil || len(included) == 0 {
+// ResolveIncludes replaces the relationships with the included resources, along
+// with their own relationships. Relationships which were not included are kept as is.
+func (r *ProviderConfigurationParameterRelationships) ResolveIncludes(included *client.Included) {
+	if r == nil || included.Len() == 0 {
 		return
 	}
 
-	// Build a map of included resources by type+id for fast lookup
-	includedMap := make(map[string]map[string]interface{})
-	for _, inc := range included {
-		if id, ok := inc["id"].(string); ok {
-			if typ, ok := inc["type"].(string); ok {
-				key := typ + ":" + id
-				includedMap[key] = inc
-			}
+	if r.ProviderConfiguration != nil {
+		if full, ok := client.Resolve[ProviderConfiguration](included, r.ProviderConfiguration.Type, r.ProviderConfiguration.ID); ok {
+			r.ProviderConfiguration = full
 		}
 	}
+}
 
-	// Populate to-one relationship: ProviderConfiguration
-	if r.ProviderConfiguration != nil && r.ProviderConfiguration.ID != "" {
-		key := r.ProviderConfiguration.Type + ":" + r.ProviderConfiguration.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full ProviderConfiguration
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.ProviderConfiguration = &full
-			}
-		}
-	}
+// PopulateIncludes merges included resources into the relationships.
+// Prefer ResolveIncludes with an index built once per response.
+func (r *ProviderConfigurationParameterRelationships) PopulateIncludes(included []map[string]interface{}) {
+	r.ResolveIncludes(client.NewIncludedFromMaps(included))
 }
 
 // Request version - used when marshalling for API requests
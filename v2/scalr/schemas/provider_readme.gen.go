@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"time"
 
+	"github.com/scalr/go-scalr/v2/scalr/client"
 	"github.com/scalr/go-scalr/v2/scalr/value"
 )
 
@@ -15,6 +16,9 @@ type ProviderReadme struct {
 	ID         string                   `json:"id"`
 	Type       string                   `json:"type"`
 	Attributes ProviderReadmeAttributes `json:"attributes"`
+
+	// stub is set for a related resource which was not included, only its ID and type are known
+	stub bool
 }
 
 // GetID returns the resource ID (implements client.ResourceLike)
@@ -30,6 +34,26 @@ func (r ProviderReadme) GetResourceType() string {
 	return "provider-readme"
 }
 
+// Loaded reports whether the resource holds its attributes and relationships.
+// It is false for a related resource which was not included in the response,
+// only its ID and type are known then.
+func (r ProviderReadme) Loaded() bool {
+	return !r.stub
+}
+
+// ResolveIncludes replaces the relationships with the included resources (implements client.IncludeResolver)
+func (r *ProviderReadme) ResolveIncludes(included *client.Included) {
+}
+
+// ProviderReadmeResourceType is the JSON:API resource type of ProviderReadme, the key of its sparse fieldset
+const ProviderReadmeResourceType = "provider-readme"
+
+// ProviderReadme field names, to select with sparse fieldsets
+const (
+	ProviderReadmeFieldContent   = "content"
+	ProviderReadmeFieldCreatedAt = "created-at"
+)
+
 // ProviderReadmeAttributes holds the attributes for ProviderReadme (response)
 type ProviderReadmeAttributes struct {
 	// The content of the README file.
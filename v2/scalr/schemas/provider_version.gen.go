@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"time"
 
+	"github.com/scalr/go-scalr/v2/scalr/client"
 	"github.com/scalr/go-scalr/v2/scalr/value"
 )
 
@@ -27,6 +28,9 @@ type ProviderVersion struct {
 	Type          string                       `json:"type"`
 	Attributes    ProviderVersionAttributes    `json:"attributes"`
 	Relationships ProviderVersionRelationships `json:"relationships"`
+
+	// stub is set for a related resource which was not included, only its ID and type are known
+	stub bool
 }
 
 // GetID returns the resource ID (implements client.ResourceLike)
@@ -42,6 +46,34 @@ func (r ProviderVersion) GetResourceType() string {
 	return "provider-versions"
 }
 
+// Loaded reports whether the resource holds its attributes and relationships.
+// It is false for a related resource which was not included in the response,
+// only its ID and type are known then.
+func (r ProviderVersion) Loaded() bool {
+	return !r.stub
+}
+
+// ResolveIncludes replaces the relationships with the included resources (implements client.IncludeResolver)
+func (r *ProviderVersion) ResolveIncludes(included *client.Included) {
+	r.Relationships.ResolveIncludes(included)
+}
+
+// ProviderVersionResourceType is the JSON:API resource type of ProviderVersion, the key of its sparse fieldset
+const ProviderVersionResourceType = "provider-versions"
+
+// ProviderVersion field names, to select with sparse fieldsets
+const (
+	ProviderVersionFieldCreatedByEmail = "created-by-email"
+	ProviderVersionFieldErrorMessage   = "error-message"
+	ProviderVersionFieldGpgKey         = "gpg-key"
+	ProviderVersionFieldProvider       = "provider"
+	ProviderVersionFieldReadme         = "readme"
+	ProviderVersionFieldReleasedAt     = "released-at"
+	ProviderVersionFieldSize           = "size"
+	ProviderVersionFieldStatus         = "status"
+	ProviderVersionFieldVersion        = "version"
+)
+
 // ProviderVersionAttributes holds the attributes for ProviderVersion (response)
 type ProviderVersionAttributes struct {
 	// The email of the user who added the provider version.
@@ -91,6 +123,7 @@ func (r *ProviderVersionRelationships) UnmarshalJSON(data []byte) error {
 			r.GpgKey = &GPGKey{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -109,6 +142,7 @@ func (r *ProviderVersionRelationships) UnmarshalJSON(data []byte) error {
 			r.Provider = &Provider{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -127,67 +161,43 @@ func (r *ProviderVersionRelationships) UnmarshalJSON(data []byte) error {
 			r.Readme = &ProviderReadme{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
 	return nil
 }
 
-// PopulateIncludes merges included resources into the relationships
-func (r *ProviderVersionRelationships) PopulateIncludes(included []map[string]interface{}) {
-	if r == nil || len(included) == 0 {
+// ResolveIncludes replaces the relationships with the included resources, along
+// with their own relationships. Relationships which were not included are kept as is.
+func (r *ProviderVersionRelationships) ResolveIncludes(included *client.Included) {
+	if r == nil || included.Len() == 0 {
 		return
 	}
 
-	// Build a map of included resources by type+id for fast lookup
-	includedMap := make(map[string]map[string]interface{})
-	for _, inc := range included {
-		if id, ok := inc["id"].(string); ok {
-			if typ, ok := inc["type"].(string); ok {
-				key := typ + ":" + id
-				includedMap[key] = inc
-			}
-		}
-	}
-
-	// Populate to-one relationship: GpgKey
-	if r.GpgKey != nil && r.GpgKey.ID != "" {
-		key := r.GpgKey.Type + ":" + r.GpgKey.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full GPGKey
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.GpgKey = &full
-			}
+	if r.GpgKey != nil {
+		if full, ok := client.Resolve[GPGKey](included, r.GpgKey.Type, r.GpgKey.ID); ok {
+			r.GpgKey = full
 		}
 	}
-	// Populate to-one relationship: Provider
-	if r.Provider != nil && r.Provider.ID != "" {
-		key := r.Provider.Type + ":" + r.Provider.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full Provider
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.Provider = &full
-			}
+	if r.Provider != nil {
+		if full, ok := client.Resolve[Provider](included, r.Provider.Type, r.Provider.ID); ok {
+			r.Provider = full
 		}
 	}
-	// Populate to-one relationship: Readme
-	if r.Readme != nil && r.Readme.ID != "" {
-		key := r.Readme.Type + ":" + r.Readme.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full ProviderReadme
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.Readme = &full
-			}
+	if r.Readme != nil {
+		if full, ok := client.Resolve[ProviderReadme](included, r.Readme.Type, r.Readme.ID); ok {
+			r.Readme = full
 		}
 	}
 }
 
+// PopulateIncludes merges included resources into the relationships.
+// Prefer ResolveIncludes with an index built once per response.
+func (r *ProviderVersionRelationships) PopulateIncludes(included []map[string]interface{}) {
+	r.ResolveIncludes(client.NewIncludedFromMaps(included))
+}
+
 // Request version - used when marshalling for API requests
 // The Terraform Registry Provider Version resource. (for requests)
 type ProviderVersionRequest struct {
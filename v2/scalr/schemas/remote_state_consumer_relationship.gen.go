@@ -20,3 +20,6 @@ func (r RemoteStateConsumerRelationship) GetResourceType() string {
 	}
 	return "workspaces"
 }
+
+// RemoteStateConsumerRelationshipResourceType is the JSON:API resource type of RemoteStateConsumerRelationship, the key of its sparse fieldset
+const RemoteStateConsumerRelationshipResourceType = "workspaces"
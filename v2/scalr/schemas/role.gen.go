@@ -5,6 +5,7 @@ package schemas
 import (
 	"encoding/json"
 
+	"github.com/scalr/go-scalr/v2/scalr/client"
 	"github.com/scalr/go-scalr/v2/scalr/value"
 )
 
@@ -15,6 +16,9 @@ type Role struct {
 	Type          string            `json:"type"`
 	Attributes    RoleAttributes    `json:"attributes"`
 	Relationships RoleRelationships `json:"relationships"`
+
+	// stub is set for a related resource which was not included, only its ID and type are known
+	stub bool
 }
 
 // GetID returns the resource ID (implements client.ResourceLike)
@@ -30,6 +34,30 @@ func (r Role) GetResourceType() string {
 	return "roles"
 }
 
+// Loaded reports whether the resource holds its attributes and relationships.
+// It is false for a related resource which was not included in the response,
+// only its ID and type are known then.
+func (r Role) Loaded() bool {
+	return !r.stub
+}
+
+// ResolveIncludes replaces the relationships with the included resources (implements client.IncludeResolver)
+func (r *Role) ResolveIncludes(included *client.Included) {
+	r.Relationships.ResolveIncludes(included)
+}
+
+// RoleResourceType is the JSON:API resource type of Role, the key of its sparse fieldset
+const RoleResourceType = "roles"
+
+// Role field names, to select with sparse fieldsets
+const (
+	RoleFieldAccount     = "account"
+	RoleFieldDescription = "description"
+	RoleFieldIsSystem    = "is-system"
+	RoleFieldName        = "name"
+	RoleFieldPermissions = "permissions"
+)
+
 // RoleAttributes holds the attributes for Role (response)
 type RoleAttributes struct {
 	// The description of the role.
@@ -71,6 +99,7 @@ func (r *RoleRelationships) UnmarshalJSON(data []byte) error {
 			r.Account = &Account{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -91,6 +120,7 @@ func (r *RoleRelationships) UnmarshalJSON(data []byte) error {
 				r.Permissions[i] = &Permission{
 					ID:   d.ID,
 					Type: d.Type,
+					stub: true,
 				}
 			}
 		}
@@ -98,53 +128,34 @@ func (r *RoleRelationships) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-// PopulateIncludes merges included resources into the relationships
-func (r *RoleRelationships) PopulateIncludes(included []map[string]interface{}) {
-	if r == nil || len(included) == 0 {
+// ResolveIncludes replaces the relationships with the included resources, along
+// with their own relationships. Relationships which were not included are kept as is.
+func (r *RoleRelationships) ResolveIncludes(included *client.Included) {
+	if r == nil || included.Len() == 0 {
 		return
 	}
 
-	// Build a map of included resources by type+id for fast lookup
-	includedMap := make(map[string]map[string]interface{})
-	for _, inc := range included {
-		if id, ok := inc["id"].(string); ok {
-			if typ, ok := inc["type"].(string); ok {
-				key := typ + ":" + id
-				includedMap[key] = inc
-			}
+	if r.Account != nil {
+		if full, ok := client.Resolve[Account](included, r.Account.Type, r.Account.ID); ok {
+			r.Account = full
 		}
 	}
-
-	// Populate to-one relationship: Account
-	if r.Account != nil && r.Account.ID != "" {
-		key := r.Account.Type + ":" + r.Account.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full Account
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.Account = &full
-			}
+	for i, rel := range r.Permissions {
+		if rel == nil {
+			continue
 		}
-	}
-	// Populate to-many relationship: Permissions
-	if r.Permissions != nil {
-		for i, resource := range r.Permissions {
-			if resource != nil && resource.ID != "" {
-				key := resource.Type + ":" + resource.ID
-				if fullResource, ok := includedMap[key]; ok {
-					// Unmarshal the full resource
-					data, _ := json.Marshal(fullResource)
-					var full Permission
-					if err := json.Unmarshal(data, &full); err == nil {
-						r.Permissions[i] = &full
-					}
-				}
-			}
+		if full, ok := client.Resolve[Permission](included, rel.Type, rel.ID); ok {
+			r.Permissions[i] = full
 		}
 	}
 }
 
+// PopulateIncludes merges included resources into the relationships.
+// Prefer ResolveIncludes with an index built once per response.
+func (r *RoleRelationships) PopulateIncludes(included []map[string]interface{}) {
+	r.ResolveIncludes(client.NewIncludedFromMaps(included))
+}
+
 // Request version - used when marshalling for API requests
 // A collection of permissions that can be assigned to a user, team, or service account via an [access policy](/docs/identity-and-access-management#access-policies). (for requests)
 type RoleRequest struct {
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"time"
 
+	"github.com/scalr/go-scalr/v2/scalr/client"
 	"github.com/scalr/go-scalr/v2/scalr/value"
 )
 
@@ -62,6 +63,9 @@ type Run struct {
 	Type          string           `json:"type"`
 	Attributes    RunAttributes    `json:"attributes"`
 	Relationships RunRelationships `json:"relationships"`
+
+	// stub is set for a related resource which was not included, only its ID and type are known
+	stub bool
 }
 
 // GetID returns the resource ID (implements client.ResourceLike)
@@ -77,6 +81,60 @@ func (r Run) GetResourceType() string {
 	return "runs"
 }
 
+// Loaded reports whether the resource holds its attributes and relationships.
+// It is false for a related resource which was not included in the response,
+// only its ID and type are known then.
+func (r Run) Loaded() bool {
+	return !r.stub
+}
+
+// ResolveIncludes replaces the relationships with the included resources (implements client.IncludeResolver)
+func (r *Run) ResolveIncludes(included *client.Included) {
+	r.Relationships.ResolveIncludes(included)
+}
+
+// RunResourceType is the JSON:API resource type of Run, the key of its sparse fieldset
+const RunResourceType = "runs"
+
+// Run field names, to select with sparse fieldsets
+const (
+	RunFieldApply                = "apply"
+	RunFieldApplyAt              = "apply-at"
+	RunFieldAutoApply            = "auto-apply"
+	RunFieldConfigurationVersion = "configuration-version"
+	RunFieldCostEstimate         = "cost-estimate"
+	RunFieldCreatedAt            = "created-at"
+	RunFieldCreatedBy            = "created-by"
+	RunFieldCreatedByRun         = "created-by-run"
+	RunFieldEnvironment          = "environment"
+	RunFieldErrorMessage         = "error-message"
+	RunFieldHasChanges           = "has-changes"
+	RunFieldIacPlatform          = "iac-platform"
+	RunFieldInputs               = "inputs"
+	RunFieldIsDestroy            = "is-destroy"
+	RunFieldIsDry                = "is-dry"
+	RunFieldMessage              = "message"
+	RunFieldPermissions          = "permissions"
+	RunFieldPlan                 = "plan"
+	RunFieldPlanAt               = "plan-at"
+	RunFieldPolicyChecks         = "policy-checks"
+	RunFieldPositionInQueue      = "position-in-queue"
+	RunFieldRefresh              = "refresh"
+	RunFieldRefreshOnly          = "refresh-only"
+	RunFieldReplaceAddrs         = "replace-addrs"
+	RunFieldSavePlan             = "save-plan"
+	RunFieldSource               = "source"
+	RunFieldStateVersions        = "state-versions"
+	RunFieldStatus               = "status"
+	RunFieldStatusTimestamps     = "status-timestamps"
+	RunFieldStatusTransitions    = "status-transitions"
+	RunFieldTags                 = "tags"
+	RunFieldTargetAddrs          = "target-addrs"
+	RunFieldVariables            = "variables"
+	RunFieldVcsRevision          = "vcs-revision"
+	RunFieldWorkspace            = "workspace"
+)
+
 // RunAttributes holds the attributes for Run (response)
 type RunAttributes struct {
 	// The UTC datetime at which the Apply should be queued.
@@ -174,6 +232,7 @@ func (r *RunRelationships) UnmarshalJSON(data []byte) error {
 			r.Apply = &Apply{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -192,6 +251,7 @@ func (r *RunRelationships) UnmarshalJSON(data []byte) error {
 			r.ConfigurationVersion = &ConfigurationVersion{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -210,6 +270,7 @@ func (r *RunRelationships) UnmarshalJSON(data []byte) error {
 			r.CostEstimate = &CostEstimate{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -228,6 +289,7 @@ func (r *RunRelationships) UnmarshalJSON(data []byte) error {
 			r.CreatedBy = &User{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -246,6 +308,7 @@ func (r *RunRelationships) UnmarshalJSON(data []byte) error {
 			r.CreatedByRun = &Run{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -264,6 +327,7 @@ func (r *RunRelationships) UnmarshalJSON(data []byte) error {
 			r.Environment = &Environment{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -282,6 +346,7 @@ func (r *RunRelationships) UnmarshalJSON(data []byte) error {
 			r.Plan = &Plan{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -302,6 +367,7 @@ func (r *RunRelationships) UnmarshalJSON(data []byte) error {
 				r.PolicyChecks[i] = &PolicyCheck{
 					ID:   d.ID,
 					Type: d.Type,
+					stub: true,
 				}
 			}
 		}
@@ -323,6 +389,7 @@ func (r *RunRelationships) UnmarshalJSON(data []byte) error {
 				r.StateVersions[i] = &StateVersion{
 					ID:   d.ID,
 					Type: d.Type,
+					stub: true,
 				}
 			}
 		}
@@ -344,6 +411,7 @@ func (r *RunRelationships) UnmarshalJSON(data []byte) error {
 				r.StatusTransitions[i] = &StatusTransition{
 					ID:   d.ID,
 					Type: d.Type,
+					stub: true,
 				}
 			}
 		}
@@ -365,6 +433,7 @@ func (r *RunRelationships) UnmarshalJSON(data []byte) error {
 				r.Tags[i] = &Tag{
 					ID:   d.ID,
 					Type: d.Type,
+					stub: true,
 				}
 			}
 		}
@@ -384,6 +453,7 @@ func (r *RunRelationships) UnmarshalJSON(data []byte) error {
 			r.VcsRevision = &VcsRevision{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -402,203 +472,105 @@ func (r *RunRelationships) UnmarshalJSON(data []byte) error {
 			r.Workspace = &Workspace{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
 	return nil
 }
 
-// PopulateIncludes merges included resources into the relationships
-func (r *RunRelationships) PopulateIncludes(included []map[string]interface{}) {
-	if r == nil || len(included) == 0 {
+// ResolveIncludes replaces the relationships with the included resources, along
+// with their own relationships. Relationships which were not included are kept as is.
+func (r *RunRelationships) ResolveIncludes(included *client.Included) {
+	if r == nil || included.Len() == 0 {
 		return
 	}
 
-	// Build a map of included resources by type+id for fast lookup
-	includedMap := make(map[string]map[string]interface{})
-	for _, inc := range included {
-		if id, ok := inc["id"].(string); ok {
-			if typ, ok := inc["type"].(string); ok {
-				key := typ + ":" + id
-				includedMap[key] = inc
-			}
+	if r.Apply != nil {
+		if full, ok := client.Resolve[Apply](included, r.Apply.Type, r.Apply.ID); ok {
+			r.Apply = full
 		}
 	}
-
-	// Populate to-one relationship: Apply
-	if r.Apply != nil && r.Apply.ID != "" {
-		key := r.Apply.Type + ":" + r.Apply.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full Apply
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.Apply = &full
-			}
+	if r.ConfigurationVersion != nil {
+		if full, ok := client.Resolve[ConfigurationVersion](included, r.ConfigurationVersion.Type, r.ConfigurationVersion.ID); ok {
+			r.ConfigurationVersion = full
 		}
 	}
-	// Populate to-one relationship: ConfigurationVersion
-	if r.ConfigurationVersion != nil && r.ConfigurationVersion.ID != "" {
-		key := r.ConfigurationVersion.Type + ":" + r.ConfigurationVersion.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full ConfigurationVersion
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.ConfigurationVersion = &full
-			}
+	if r.CostEstimate != nil {
+		if full, ok := client.Resolve[CostEstimate](included, r.CostEstimate.Type, r.CostEstimate.ID); ok {
+			r.CostEstimate = full
 		}
 	}
-	// Populate to-one relationship: CostEstimate
-	if r.CostEstimate != nil && r.CostEstimate.ID != "" {
-		key := r.CostEstimate.Type + ":" + r.CostEstimate.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full CostEstimate
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.CostEstimate = &full
-			}
+	if r.CreatedBy != nil {
+		if full, ok := client.Resolve[User](included, r.CreatedBy.Type, r.CreatedBy.ID); ok {
+			r.CreatedBy = full
 		}
 	}
-	// Populate to-one relationship: CreatedBy
-	if r.CreatedBy != nil && r.CreatedBy.ID != "" {
-		key := r.CreatedBy.Type + ":" + r.CreatedBy.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full User
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.CreatedBy = &full
-			}
+	if r.CreatedByRun != nil {
+		if full, ok := client.Resolve[Run](included, r.CreatedByRun.Type, r.CreatedByRun.ID); ok {
+			r.CreatedByRun = full
 		}
 	}
-	// Populate to-one relationship: CreatedByRun
-	if r.CreatedByRun != nil && r.CreatedByRun.ID != "" {
-		key := r.CreatedByRun.Type + ":" + r.CreatedByRun.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full Run
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.CreatedByRun = &full
-			}
+	if r.Environment != nil {
+		if full, ok := client.Resolve[Environment](included, r.Environment.Type, r.Environment.ID); ok {
+			r.Environment = full
 		}
 	}
-	// Populate to-one relationship: Environment
-	if r.Environment != nil && r.Environment.ID != "" {
-		key := r.Environment.Type + ":" + r.Environment.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full Environment
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.Environment = &full
-			}
+	if r.Plan != nil {
+		if full, ok := client.Resolve[Plan](included, r.Plan.Type, r.Plan.ID); ok {
+			r.Plan = full
 		}
 	}
-	// Populate to-one relationship: Plan
-	if r.Plan != nil && r.Plan.ID != "" {
-		key := r.Plan.Type + ":" + r.Plan.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full Plan
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.Plan = &full
-			}
+	for i, rel := range r.PolicyChecks {
+		if rel == nil {
+			continue
 		}
-	}
-	// Populate to-many relationship: PolicyChecks
-	if r.PolicyChecks != nil {
-		for i, resource := range r.PolicyChecks {
-			if resource != nil && resource.ID != "" {
-				key := resource.Type + ":" + resource.ID
-				if fullResource, ok := includedMap[key]; ok {
-					// Unmarshal the full resource
-					data, _ := json.Marshal(fullResource)
-					var full PolicyCheck
-					if err := json.Unmarshal(data, &full); err == nil {
-						r.PolicyChecks[i] = &full
-					}
-				}
-			}
+		if full, ok := client.Resolve[PolicyCheck](included, rel.Type, rel.ID); ok {
+			r.PolicyChecks[i] = full
 		}
 	}
-	// Populate to-many relationship: StateVersions
-	if r.StateVersions != nil {
-		for i, resource := range r.StateVersions {
-			if resource != nil && resource.ID != "" {
-				key := resource.Type + ":" + resource.ID
-				if fullResource, ok := includedMap[key]; ok {
-					// Unmarshal the full resource
-					data, _ := json.Marshal(fullResource)
-					var full StateVersion
-					if err := json.Unmarshal(data, &full); err == nil {
-						r.StateVersions[i] = &full
-					}
-				}
-			}
+	for i, rel := range r.StateVersions {
+		if rel == nil {
+			continue
+		}
+		if full, ok := client.Resolve[StateVersion](included, rel.Type, rel.ID); ok {
+			r.StateVersions[i] = full
 		}
 	}
-	// Populate to-many relationship: StatusTransitions
-	if r.StatusTransitions != nil {
-		for i, resource := range r.StatusTransitions {
-			if resource != nil && resource.ID != "" {
-				key := resource.Type + ":" + resource.ID
-				if fullResource, ok := includedMap[key]; ok {
-					// Unmarshal the full resource
-					data, _ := json.Marshal(fullResource)
-					var full StatusTransition
-					if err := json.Unmarshal(data, &full); err == nil {
-						r.StatusTransitions[i] = &full
-					}
-				}
-			}
+	for i, rel := range r.StatusTransitions {
+		if rel == nil {
+			continue
+		}
+		if full, ok := client.Resolve[StatusTransition](included, rel.Type, rel.ID); ok {
+			r.StatusTransitions[i] = full
 		}
 	}
-	// Populate to-many relationship: Tags
-	if r.Tags != nil {
-		for i, resource := range r.Tags {
-			if resource != nil && resource.ID != "" {
-				key := resource.Type + ":" + resource.ID
-				if fullResource, ok := includedMap[key]; ok {
-					// Unmarshal the full resource
-					data, _ := json.Marshal(fullResource)
-					var full Tag
-					if err := json.Unmarshal(data, &full); err == nil {
-						r.Tags[i] = &full
-					}
-				}
-			}
+	for i, rel := range r.Tags {
+		if rel == nil {
+			continue
+		}
+		if full, ok := client.Resolve[Tag](included, rel.Type, rel.ID); ok {
+			r.Tags[i] = full
 		}
 	}
-	// Populate to-one relationship: VcsRevision
-	if r.VcsRevision != nil && r.VcsRevision.ID != "" {
-		key := r.VcsRevision.Type + ":" + r.VcsRevision.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full VcsRevision
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.VcsRevision = &full
-			}
+	if r.VcsRevision != nil {
+		if full, ok := client.Resolve[VcsRevision](included, r.VcsRevision.Type, r.VcsRevision.ID); ok {
+			r.VcsRevision = full
 		}
 	}
-	// Populate to-one relationship: Workspace
-	if r.Workspace != nil && r.Workspace.ID != "" {
-		key := r.Workspace.Type + ":" + r.Workspace.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full Workspace
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.Workspace = &full
-			}
+	if r.Workspace != nil {
+		if full, ok := client.Resolve[Workspace](included, r.Workspace.Type, r.Workspace.ID); ok {
+			r.Workspace = full
 		}
 	}
 }
 
+// PopulateIncludes merges included resources into the relationships.
+// Prefer ResolveIncludes with an index built once per response.
+func (r *RunRelationships) PopulateIncludes(included []map[string]interface{}) {
+	r.ResolveIncludes(client.NewIncludedFromMaps(included))
+}
+
 // Request version - used when marshalling for API requests
 // A Run provides details of an entire run operation potentially comprising `plan`, `cost-estimation`, `policy-check` and `apply`. (for requests)
 type RunRequest struct {
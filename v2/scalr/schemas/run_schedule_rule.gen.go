@@ -5,6 +5,7 @@ package schemas
 import (
 	"encoding/json"
 
+	"github.com/scalr/go-scalr/v2/scalr/client"
 	"github.com/scalr/go-scalr/v2/scalr/value"
 )
 
@@ -26,6 +27,9 @@ type RunScheduleRule struct {
 	Type          string                       `json:"type"`
 	Attributes    RunScheduleRuleAttributes    `json:"attributes"`
 	Relationships RunScheduleRuleRelationships `json:"relationships"`
+
+	// stub is set for a related resource which was not included, only its ID and type are known
+	stub bool
 }
 
 // GetID returns the resource ID (implements client.ResourceLike)
@@ -41,6 +45,28 @@ func (r RunScheduleRule) GetResourceType() string {
 	return "run-schedule-rules"
 }
 
+// Loaded reports whether the resource holds its attributes and relationships.
+// It is false for a related resource which was not included in the response,
+// only its ID and type are known then.
+func (r RunScheduleRule) Loaded() bool {
+	return !r.stub
+}
+
+// ResolveIncludes replaces the relationships with the included resources (implements client.IncludeResolver)
+func (r *RunScheduleRule) ResolveIncludes(included *client.Included) {
+	r.Relationships.ResolveIncludes(included)
+}
+
+// RunScheduleRuleResourceType is the JSON:API resource type of RunScheduleRule, the key of its sparse fieldset
+const RunScheduleRuleResourceType = "run-schedule-rules"
+
+// RunScheduleRule field names, to select with sparse fieldsets
+const (
+	RunScheduleRuleFieldSchedule     = "schedule"
+	RunScheduleRuleFieldScheduleMode = "schedule-mode"
+	RunScheduleRuleFieldWorkspace    = "workspace"
+)
+
 // RunScheduleRuleAttributes holds the attributes for RunScheduleRule (response)
 type RunScheduleRuleAttributes struct {
 	// Cron expression for scheduled runs. Time should be in UTC.
@@ -78,41 +104,31 @@ func (r *RunScheduleRuleRelationships) UnmarshalJSON(data []byte) error {
 			r.Workspace = &Workspace{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
 	return nil
 }
 
-// PopulateIncludes merges included resources into the relationships
-func (r *RunScheduleRuleRelationships) PopulateIncludes(included []map[string]interface{}) {
-	if r == nil || len(included) == 0 {
+// ResolveIncludes replaces the relationships with the included resources, along
+// with their own relationships. Relationships which were not included are kept as is.
+func (r *RunScheduleRuleRelationships) ResolveIncludes(included *client.Included) {
+	if r == nil || included.Len() == 0 {
 		return
 	}
 
-	// Build a map of included resources by type+id for fast lookup
-	includedMap := make(map[string]map[string]interface{})
-	for _, inc := range included {
-		if id, ok := inc["id"].(string); ok {
-			if typ, ok := inc["type"].(string); ok {
-				key := typ + ":" + id
-				includedMap[key] = inc
-			}
+	if r.Workspace != nil {
+		if full, ok := client.Resolve[Workspace](included, r.Workspace.Type, r.Workspace.ID); ok {
+			r.Workspace = full
 		}
 	}
+}
 
-	// Populate to-one relationship: Workspace
-	if r.Workspace != nil && r.Workspace.ID != "" {
-		key := r.Workspace.Type + ":" + r.Workspace.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full Workspace
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.Workspace = &full
-			}
-		}
-	}
+// PopulateIncludes merges included resources into the relationships.
+// Prefer ResolveIncludes with an index built once per response.
+func (r *RunScheduleRuleRelationships) PopulateIncludes(included []map[string]interface{}) {
+	r.ResolveIncludes(client.NewIncludedFromMaps(included))
 }
 
 // Request version - used when marshalling for API requests
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"time"
 
+	"github.com/scalr/go-scalr/v2/scalr/client"
 	"github.com/scalr/go-scalr/v2/scalr/value"
 )
 
@@ -16,6 +17,9 @@ type RunTrigger struct {
 	Type          string                  `json:"type"`
 	Attributes    RunTriggerAttributes    `json:"attributes"`
 	Relationships RunTriggerRelationships `json:"relationships"`
+
+	// stub is set for a related resource which was not included, only its ID and type are known
+	stub bool
 }
 
 // GetID returns the resource ID (implements client.ResourceLike)
@@ -31,6 +35,28 @@ func (r RunTrigger) GetResourceType() string {
 	return "run-triggers"
 }
 
+// Loaded reports whether the resource holds its attributes and relationships.
+// It is false for a related resource which was not included in the response,
+// only its ID and type are known then.
+func (r RunTrigger) Loaded() bool {
+	return !r.stub
+}
+
+// ResolveIncludes replaces the relationships with the included resources (implements client.IncludeResolver)
+func (r *RunTrigger) ResolveIncludes(included *client.Included) {
+	r.Relationships.ResolveIncludes(included)
+}
+
+// RunTriggerResourceType is the JSON:API resource type of RunTrigger, the key of its sparse fieldset
+const RunTriggerResourceType = "run-triggers"
+
+// RunTrigger field names, to select with sparse fieldsets
+const (
+	RunTriggerFieldCreatedAt  = "created-at"
+	RunTriggerFieldDownstream = "downstream"
+	RunTriggerFieldUpstream   = "upstream"
+)
+
 // RunTriggerAttributes holds the attributes for RunTrigger (response)
 type RunTriggerAttributes struct {
 	// The resource creation timestamp.
@@ -68,6 +94,7 @@ func (r *RunTriggerRelationships) UnmarshalJSON(data []byte) error {
 			r.Downstream = &Workspace{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -86,55 +113,38 @@ func (r *RunTriggerRelationships) UnmarshalJSON(data []byte) error {
 			r.Upstream = &Workspace{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
 	return nil
 }
 
-// PopulateIncludes merges included resources into the relationships
-func (r *RunTriggerRelationships) PopulateIncludes(included []map[string]interface{}) {
-	if r == nil || len(included) == 0 {
+// ResolveIncludes replaces the relationships with the included resources, along
+// with their own relationships. Relationships which were not included are kept as is.
+func (r *RunTriggerRelationships) ResolveIncludes(included *client.Included) {
+	if r == nil || included.Len() == 0 {
 		return
 	}
 
-	// Build a map of included resources by type+id for fast lookup
-	includedMap := make(map[string]map[string]interface{})
-	for _, inc := range included {
-		if id, ok := inc["id"].(string); ok {
-			if typ, ok := inc["type"].(string); ok {
-				key := typ + ":" + id
-				includedMap[key] = inc
-			}
+	if r.Downstream != nil {
+		if full, ok := client.Resolve[Workspace](included, r.Downstream.Type, r.Downstream.ID); ok {
+			r.Downstream = full
 		}
 	}
-
-	// Populate to-one relationship: Downstream
-	if r.Downstream != nil && r.Downstream.ID != "" {
-		key := r.Downstream.Type + ":" + r.Downstream.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full Workspace
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.Downstream = &full
-			}
-		}
-	}
-	// Populate to-one relationship: Upstream
-	if r.Upstream != nil && r.Upstream.ID != "" {
-		key := r.Upstream.Type + ":" + r.Upstream.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full Workspace
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.Upstream = &full
-			}
+	if r.Upstream != nil {
+		if full, ok := client.Resolve[Workspace](included, r.Upstream.Type, r.Upstream.ID); ok {
+			r.Upstream = full
 		}
 	}
 }
 
+// PopulateIncludes merges included resources into the relationships.
+// Prefer ResolveIncludes with an index built once per response.
+func (r *RunTriggerRelationships) PopulateIncludes(included []map[string]interface{}) {
+	r.ResolveIncludes(client.NewIncludedFromMaps(included))
+}
+
 // Request version - used when marshalling for API requests
 // Run triggers allow runs to queue automatically in downstream workspace on successful apply of runs in any of the upstream workspaces. (for requests)
 type RunTriggerRequest struct {
@@ -5,6 +5,7 @@ package schemas
 import (
 	"encoding/json"
 
+	"github.com/scalr/go-scalr/v2/scalr/client"
 	"github.com/scalr/go-scalr/v2/scalr/value"
 )
 
@@ -71,6 +72,9 @@ type SamlIntegration struct {
 	Type          string                       `json:"type"`
 	Attributes    SamlIntegrationAttributes    `json:"attributes"`
 	Relationships SamlIntegrationRelationships `json:"relationships"`
+
+	// stub is set for a related resource which was not included, only its ID and type are known
+	stub bool
 }
 
 // GetID returns the resource ID (implements client.ResourceLike)
@@ -86,6 +90,79 @@ func (r SamlIntegration) GetResourceType() string {
 	return "saml-integration"
 }
 
+// Loaded reports whether the resource holds its attributes and relationships.
+// It is false for a related resource which was not included in the response,
+// only its ID and type are known then.
+func (r SamlIntegration) Loaded() bool {
+	return !r.stub
+}
+
+// ResolveIncludes replaces the relationships with the included resources (implements client.IncludeResolver)
+func (r *SamlIntegration) ResolveIncludes(included *client.Included) {
+	r.Relationships.ResolveIncludes(included)
+}
+
+// SamlIntegrationResourceType is the JSON:API resource type of SamlIntegration, the key of its sparse fieldset
+const SamlIntegrationResourceType = "saml-integration"
+
+// SamlIntegration field names, to select with sparse fieldsets
+const (
+	SamlIntegrationFieldAccount                                 = "account"
+	SamlIntegrationFieldAutoRedirect                            = "auto-redirect"
+	SamlIntegrationFieldBaseUrl                                 = "base-url"
+	SamlIntegrationFieldDebug                                   = "debug"
+	SamlIntegrationFieldErrorMessage                            = "error-message"
+	SamlIntegrationFieldIdpCertFingerprint                      = "idp-cert-fingerprint"
+	SamlIntegrationFieldIdpCertFingerprintAlgorithm             = "idp-cert-fingerprint-algorithm"
+	SamlIntegrationFieldIdpEntityId                             = "idp-entity-id"
+	SamlIntegrationFieldIdpSingleLogoutServiceBinding           = "idp-single-logout-service-binding"
+	SamlIntegrationFieldIdpSingleLogoutServiceResponseUrl       = "idp-single-logout-service-response-url"
+	SamlIntegrationFieldIdpSingleLogoutServiceUrl               = "idp-single-logout-service-url"
+	SamlIntegrationFieldIdpSingleSignOnServiceBinding           = "idp-single-sign-on-service-binding"
+	SamlIntegrationFieldIdpSingleSignOnServiceUrl               = "idp-single-sign-on-service-url"
+	SamlIntegrationFieldIdpX509Cert                             = "idp-x509cert"
+	SamlIntegrationFieldIdpX509CertMultiEncryption              = "idp-x509cert-multi-encryption"
+	SamlIntegrationFieldIdpX509CertMultiSigning                 = "idp-x509cert-multi-signing"
+	SamlIntegrationFieldIsUsed                                  = "is-used"
+	SamlIntegrationFieldMappingAzureAadAccountType              = "mapping-azure-aad-account-type"
+	SamlIntegrationFieldMappingAzureAadClientId                 = "mapping-azure-aad-client-id"
+	SamlIntegrationFieldMappingAzureAadEnabled                  = "mapping-azure-aad-enabled"
+	SamlIntegrationFieldMappingAzureAadSecretKey                = "mapping-azure-aad-secret-key"
+	SamlIntegrationFieldMappingAzureAadTenantId                 = "mapping-azure-aad-tenant-id"
+	SamlIntegrationFieldMappingEmail                            = "mapping-email"
+	SamlIntegrationFieldMappingFullname                         = "mapping-fullname"
+	SamlIntegrationFieldMappingGroups                           = "mapping-groups"
+	SamlIntegrationFieldMappingSeparator                        = "mapping-separator"
+	SamlIntegrationFieldName                                    = "name"
+	SamlIntegrationFieldSecurityAllowRepeatAttributeName        = "security-allow-repeat-attribute-name"
+	SamlIntegrationFieldSecurityAuthnRequestsSigned             = "security-authn-requests-signed"
+	SamlIntegrationFieldSecurityDigestAlgorithm                 = "security-digest-algorithm"
+	SamlIntegrationFieldSecurityLogoutRequestSigned             = "security-logout-request-signed"
+	SamlIntegrationFieldSecurityLogoutResponseSigned            = "security-logout-response-signed"
+	SamlIntegrationFieldSecurityNameIdEncrypted                 = "security-name-id-encrypted"
+	SamlIntegrationFieldSecurityRequestedAuthnContext           = "security-requested-authn-context"
+	SamlIntegrationFieldSecurityRequestedAuthnContextComparison = "security-requested-authn-context-comparison"
+	SamlIntegrationFieldSecuritySignMetadata                    = "security-sign-metadata"
+	SamlIntegrationFieldSecuritySignatureAlgorithm              = "security-signature-algorithm"
+	SamlIntegrationFieldSecurityWantAssertionsEncrypted         = "security-want-assertions-encrypted"
+	SamlIntegrationFieldSecurityWantAssertionsSigned            = "security-want-assertions-signed"
+	SamlIntegrationFieldSecurityWantMessagesSigned              = "security-want-messages-signed"
+	SamlIntegrationFieldSecurityWantNameId                      = "security-want-name-id"
+	SamlIntegrationFieldSecurityWantNameIdEncrypted             = "security-want-name-id-encrypted"
+	SamlIntegrationFieldSpAssertionConsumerServiceBinding       = "sp-assertion-consumer-service-binding"
+	SamlIntegrationFieldSpDefaultEntityId                       = "sp-default-entity-id"
+	SamlIntegrationFieldSpEntityId                              = "sp-entity-id"
+	SamlIntegrationFieldSpNameIdFormat                          = "sp-name-id-format"
+	SamlIntegrationFieldSpPrivateKey                            = "sp-private-key"
+	SamlIntegrationFieldSpSingleLogoutServiceBinding            = "sp-single-logout-service-binding"
+	SamlIntegrationFieldSpX509Cert                              = "sp-x509cert"
+	SamlIntegrationFieldSpX509CertNew                           = "sp-x509cert-new"
+	SamlIntegrationFieldStatus                                  = "status"
+	SamlIntegrationFieldStrict                                  = "strict"
+	SamlIntegrationFieldUseIdentifierInUrls                     = "use-identifier-in-urls"
+	SamlIntegrationFieldVerificationStatus                      = "verification-status"
+)
+
 // SamlIntegrationAttributes holds the attributes for SamlIntegration (response)
 type SamlIntegrationAttributes struct {
 	// If enabled, user will be redirected to IdP's login page.
@@ -223,41 +300,31 @@ func (r *SamlIntegrationRelationships) UnmarshalJSON(data []byte) error {
 			r.Account = &Account{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
 	return nil
 }
 
-// PopulateIncludes merges included resources into the relationships
-func (r *SamlIntegrationRelationships) PopulateIncludes(included []map[string]interface{}) {
-	if r == nil || len(included) == 0 {
+// ResolveIncludes replaces the relationships with the included resources, along
+// with their own relationships. Relationships which were not included are kept as is.
+func (r *SamlIntegrationRelationships) ResolveIncludes(included *client.Included) {
+	if r == nil || included.Len() == 0 {
 		return
 	}
 
-	// Build a map of included resources by type+id for fast lookup
-	includedMap := make(map[string]map[string]interface{})
-	for _, inc := range included {
-		if id, ok := inc["id"].(string); ok {
-			if typ, ok := inc["type"].(string); ok {
-				key := typ + ":" + id
-				includedMap[key] = inc
-			}
+	if r.Account != nil {
+		if full, ok := client.Resolve[Account](included, r.Account.Type, r.Account.ID); ok {
+			r.Account = full
 		}
 	}
+}
 
-	// Populate to-one relationship: Account
-	if r.Account != nil && r.Account.ID != "" {
-		key := r.Account.Type + ":" + r.Account.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full Account
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.Account = &full
-			}
-		}
-	}
+// PopulateIncludes merges included resources into the relationships.
+// Prefer ResolveIncludes with an index built once per response.
+func (r *SamlIntegrationRelationships) PopulateIncludes(included []map[string]interface{}) {
+	r.ResolveIncludes(client.NewIncludedFromMaps(included))
 }
 
 // Request version - used when marshalling for API requests
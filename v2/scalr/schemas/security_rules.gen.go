@@ -5,6 +5,7 @@ package schemas
 import (
 	"encoding/json"
 
+	"github.com/scalr/go-scalr/v2/scalr/client"
 	"github.com/scalr/go-scalr/v2/scalr/value"
 )
 
@@ -14,6 +15,9 @@ type SecurityRules struct {
 	ID         string                  `json:"id"`
 	Type       string                  `json:"type"`
 	Attributes SecurityRulesAttributes `json:"attributes"`
+
+	// stub is set for a related resource which was not included, only its ID and type are known
+	stub bool
 }
 
 // GetID returns the resource ID (implements client.ResourceLike)
@@ -29,6 +33,28 @@ func (r SecurityRules) GetResourceType() string {
 	return "security-rules"
 }
 
+// Loaded reports whether the resource holds its attributes and relationships.
+// It is false for a related resource which was not included in the response,
+// only its ID and type are known then.
+func (r SecurityRules) Loaded() bool {
+	return !r.stub
+}
+
+// ResolveIncludes replaces the relationships with the included resources (implements client.IncludeResolver)
+func (r *SecurityRules) ResolveIncludes(included *client.Included) {
+}
+
+// SecurityRulesResourceType is the JSON:API resource type of SecurityRules, the key of its sparse fieldset
+const SecurityRulesResourceType = "security-rules"
+
+// SecurityRules field names, to select with sparse fieldsets
+const (
+	SecurityRulesFieldEnforceAgentPool                = "enforce-agent-pool"
+	SecurityRulesFieldMaxPersonalTokenLifetime        = "max-personal-token-lifetime"
+	SecurityRulesFieldMaxServiceAccountTokenLifetime  = "max-service-account-token-lifetime"
+	SecurityRulesFieldRequireOwnersForServiceAccounts = "require-owners-for-service-accounts"
+)
+
 // SecurityRulesAttributes holds the attributes for SecurityRules (response)
 type SecurityRulesAttributes struct {
 	// Whether to require a self-hosted agent pool when creating workspaces.
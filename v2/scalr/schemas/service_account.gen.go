@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"time"
 
+	"github.com/scalr/go-scalr/v2/scalr/client"
 	"github.com/scalr/go-scalr/v2/scalr/value"
 )
 
@@ -26,6 +27,9 @@ type ServiceAccount struct {
 	Type          string                      `json:"type"`
 	Attributes    ServiceAccountAttributes    `json:"attributes"`
 	Relationships ServiceAccountRelationships `json:"relationships"`
+
+	// stub is set for a related resource which was not included, only its ID and type are known
+	stub bool
 }
 
 // GetID returns the resource ID (implements client.ResourceLike)
@@ -41,6 +45,33 @@ func (r ServiceAccount) GetResourceType() string {
 	return "service-accounts"
 }
 
+// Loaded reports whether the resource holds its attributes and relationships.
+// It is false for a related resource which was not included in the response,
+// only its ID and type are known then.
+func (r ServiceAccount) Loaded() bool {
+	return !r.stub
+}
+
+// ResolveIncludes replaces the relationships with the included resources (implements client.IncludeResolver)
+func (r *ServiceAccount) ResolveIncludes(included *client.Included) {
+	r.Relationships.ResolveIncludes(included)
+}
+
+// ServiceAccountResourceType is the JSON:API resource type of ServiceAccount, the key of its sparse fieldset
+const ServiceAccountResourceType = "service-accounts"
+
+// ServiceAccount field names, to select with sparse fieldsets
+const (
+	ServiceAccountFieldAccount     = "account"
+	ServiceAccountFieldCreatedAt   = "created-at"
+	ServiceAccountFieldCreatedBy   = "created-by"
+	ServiceAccountFieldDescription = "description"
+	ServiceAccountFieldEmail       = "email"
+	ServiceAccountFieldName        = "name"
+	ServiceAccountFieldOwners      = "owners"
+	ServiceAccountFieldStatus      = "status"
+)
+
 // ServiceAccountAttributes holds the attributes for ServiceAccount (response)
 type ServiceAccountAttributes struct {
 	CreatedAt time.Time `json:"created-at"`
@@ -87,6 +118,7 @@ func (r *ServiceAccountRelationships) UnmarshalJSON(data []byte) error {
 			r.Account = &Account{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -105,6 +137,7 @@ func (r *ServiceAccountRelationships) UnmarshalJSON(data []byte) error {
 			r.CreatedBy = &User{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -125,6 +158,7 @@ func (r *ServiceAccountRelationships) UnmarshalJSON(data []byte) error {
 				r.Owners[i] = &Team{
 					ID:   d.ID,
 					Type: d.Type,
+					stub: true,
 				}
 			}
 		}
@@ -132,65 +166,39 @@ func (r *ServiceAccountRelationships) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-// PopulateIncludes merges included resources into the relationships
-func (r *ServiceAccountRelationships) PopulateIncludes(included []map[string]interface{}) {
-	if r == nil || len(included) == 0 {
+// ResolveIncludes replaces the relationships with the included resources, along
+// with their own relationships. Relationships which were not included are kept as is.
+func (r *ServiceAccountRelationships) ResolveIncludes(included *client.Included) {
+	if r == nil || included.Len() == 0 {
 		return
 	}
 
-	// Build a map of included resources by type+id for fast lookup
-	includedMap := make(map[string]map[string]interface{})
-	for _, inc := range included {
-		if id, ok := inc["id"].(string); ok {
-			if typ, ok := inc["type"].(string); ok {
-				key := typ + ":" + id
-				includedMap[key] = inc
-			}
+	if r.Account != nil {
+		if full, ok := client.Resolve[Account](included, r.Account.Type, r.Account.ID); ok {
+			r.Account = full
 		}
 	}
-
-	// Populate to-one relationship: Account
-	if r.Account != nil && r.Account.ID != "" {
-		key := r.Account.Type + ":" + r.Account.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full Account
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.Account = &full
-			}
+	if r.CreatedBy != nil {
+		if full, ok := client.Resolve[User](included, r.CreatedBy.Type, r.CreatedBy.ID); ok {
+			r.CreatedBy = full
 		}
 	}
-	// Populate to-one relationship: CreatedBy
-	if r.CreatedBy != nil && r.CreatedBy.ID != "" {
-		key := r.CreatedBy.Type + ":" + r.CreatedBy.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full User
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.CreatedBy = &full
-			}
+	for i, rel := range r.Owners {
+		if rel == nil {
+			continue
 		}
-	}
-	// Populate to-many relationship: Owners
-	if r.Owners != nil {
-		for i, resource := range r.Owners {
-			if resource != nil && resource.ID != "" {
-				key := resource.Type + ":" + resource.ID
-				if fullResource, ok := includedMap[key]; ok {
-					// Unmarshal the full resource
-					data, _ := json.Marshal(fullResource)
-					var full Team
-					if err := json.Unmarshal(data, &full); err == nil {
-						r.Owners[i] = &full
-					}
-				}
-			}
+		if full, ok := client.Resolve[Team](included, rel.Type, rel.ID); ok {
+			r.Owners[i] = full
 		}
 	}
 }
 
+// PopulateIncludes merges included resources into the relationships.
+// Prefer ResolveIncludes with an index built once per response.
+func (r *ServiceAccountRelationships) PopulateIncludes(included []map[string]interface{}) {
+	r.ResolveIncludes(client.NewIncludedFromMaps(included))
+}
+
 // Request version - used when marshalling for API requests
 // Represents a service account definition. A service account is a special type of account intended to represent a non-human user that needs to authenticate and be authorized to access data in Scalr APIs. (for requests)
 type ServiceAccountRequest struct {
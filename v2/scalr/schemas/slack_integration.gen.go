@@ -5,6 +5,7 @@ package schemas
 import (
 	"encoding/json"
 
+	"github.com/scalr/go-scalr/v2/scalr/client"
 	"github.com/scalr/go-scalr/v2/scalr/value"
 )
 
@@ -49,6 +50,9 @@ type SlackIntegration struct {
 	Type          string                        `json:"type"`
 	Attributes    SlackIntegrationAttributes    `json:"attributes"`
 	Relationships SlackIntegrationRelationships `json:"relationships"`
+
+	// stub is set for a related resource which was not included, only its ID and type are known
+	stub bool
 }
 
 // GetID returns the resource ID (implements client.ResourceLike)
@@ -64,6 +68,36 @@ func (r SlackIntegration) GetResourceType() string {
 	return "slack-integrations"
 }
 
+// Loaded reports whether the resource holds its attributes and relationships.
+// It is false for a related resource which was not included in the response,
+// only its ID and type are known then.
+func (r SlackIntegration) Loaded() bool {
+	return !r.stub
+}
+
+// ResolveIncludes replaces the relationships with the included resources (implements client.IncludeResolver)
+func (r *SlackIntegration) ResolveIncludes(included *client.Included) {
+	r.Relationships.ResolveIncludes(included)
+}
+
+// SlackIntegrationResourceType is the JSON:API resource type of SlackIntegration, the key of its sparse fieldset
+const SlackIntegrationResourceType = "slack-integrations"
+
+// SlackIntegration field names, to select with sparse fieldsets
+const (
+	SlackIntegrationFieldAccount      = "account"
+	SlackIntegrationFieldChannelId    = "channel-id"
+	SlackIntegrationFieldConnection   = "connection"
+	SlackIntegrationFieldEnvironments = "environments"
+	SlackIntegrationFieldErrMessage   = "err-message"
+	SlackIntegrationFieldEvents       = "events"
+	SlackIntegrationFieldIsApplyOnly  = "is-apply-only"
+	SlackIntegrationFieldName         = "name"
+	SlackIntegrationFieldRunMode      = "run-mode"
+	SlackIntegrationFieldStatus       = "status"
+	SlackIntegrationFieldWorkspaces   = "workspaces"
+)
+
 // SlackIntegrationAttributes holds the attributes for SlackIntegration (response)
 type SlackIntegrationAttributes struct {
 	// A Slack channel ID to which to send messages.
@@ -117,6 +151,7 @@ func (r *SlackIntegrationRelationships) UnmarshalJSON(data []byte) error {
 			r.Account = &Account{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -135,6 +170,7 @@ func (r *SlackIntegrationRelationships) UnmarshalJSON(data []byte) error {
 			r.Connection = &SlackConnection{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -155,6 +191,7 @@ func (r *SlackIntegrationRelationships) UnmarshalJSON(data []byte) error {
 				r.Environments[i] = &Environment{
 					ID:   d.ID,
 					Type: d.Type,
+					stub: true,
 				}
 			}
 		}
@@ -176,6 +213,7 @@ func (r *SlackIntegrationRelationships) UnmarshalJSON(data []byte) error {
 				r.Workspaces[i] = &Workspace{
 					ID:   d.ID,
 					Type: d.Type,
+					stub: true,
 				}
 			}
 		}
@@ -183,81 +221,47 @@ func (r *SlackIntegrationRelationships) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-// PopulateIncludes merges included resources into the relationships
-func (r *SlackIntegrationRelationships) PopulateIncludes(included []map[string]interface{}) {
-	if r == nil || len(included) == 0 {
+// ResolveIncludes replaces the relationships with the included resources, along
+// with their own relationships. Relationships which were not included are kept as is.
+func (r *SlackIntegrationRelationships) ResolveIncludes(included *client.Included) {
+	if r == nil || included.Len() == 0 {
 		return
 	}
 
-	// Build a map of included resources by type+id for fast lookup
-	includedMap := make(map[string]map[string]interface{})
-	for _, inc := range included {
-		if id, ok := inc["id"].(string); ok {
-			if typ, ok := inc["type"].(string); ok {
-				key := typ + ":" + id
-				includedMap[key] = inc
-			}
+	if r.Account != nil {
+		if full, ok := client.Resolve[Account](included, r.Account.Type, r.Account.ID); ok {
+			r.Account = full
 		}
 	}
-
-	// Populate to-one relationship: Account
-	if r.Account != nil && r.Account.ID != "" {
-		key := r.Account.Type + ":" + r.Account.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full Account
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.Account = &full
-			}
+	if r.Connection != nil {
+		if full, ok := client.Resolve[SlackConnection](included, r.Connection.Type, r.Connection.ID); ok {
+			r.Connection = full
 		}
 	}
-	// Populate to-one relationship: Connection
-	if r.Connection != nil && r.Connection.ID != "" {
-		key := r.Connection.Type + ":" + r.Connection.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full SlackConnection
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.Connection = &full
-			}
+	for i, rel := range r.Environments {
+		if rel == nil {
+			continue
 		}
-	}
-	// Populate to-many relationship: Environments
-	if r.Environments != nil {
-		for i, resource := range r.Environments {
-			if resource != nil && resource.ID != "" {
-				key := resource.Type + ":" + resource.ID
-				if fullResource, ok := includedMap[key]; ok {
-					// Unmarshal the full resource
-					data, _ := json.Marshal(fullResource)
-					var full Environment
-					if err := json.Unmarshal(data, &full); err == nil {
-						r.Environments[i] = &full
-					}
-				}
-			}
+		if full, ok := client.Resolve[Environment](included, rel.Type, rel.ID); ok {
+			r.Environments[i] = full
 		}
 	}
-	// Populate to-many relationship: Workspaces
-	if r.Workspaces != nil {
-		for i, resource := range r.Workspaces {
-			if resource != nil && resource.ID != "" {
-				key := resource.Type + ":" + resource.ID
-				if fullResource, ok := includedMap[key]; ok {
-					// Unmarshal the full resource
-					data, _ := json.Marshal(fullResource)
-					var full Workspace
-					if err := json.Unmarshal(data, &full); err == nil {
-						r.Workspaces[i] = &full
-					}
-				}
-			}
+	for i, rel := range r.Workspaces {
+		if rel == nil {
+			continue
+		}
+		if full, ok := client.Resolve[Workspace](included, rel.Type, rel.ID); ok {
+			r.Workspaces[i] = full
 		}
 	}
 }
 
+// PopulateIncludes merges included resources into the relationships.
+// Prefer ResolveIncludes with an index built once per response.
+func (r *SlackIntegrationRelationships) PopulateIncludes(included []map[string]interface{}) {
+	r.ResolveIncludes(client.NewIncludedFromMaps(included))
+}
+
 // Request version - used when marshalling for API requests
 
 type SlackIntegrationRequest struct {
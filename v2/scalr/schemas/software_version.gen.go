@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"time"
 
+	"github.com/scalr/go-scalr/v2/scalr/client"
 	"github.com/scalr/go-scalr/v2/scalr/value"
 )
 
@@ -41,6 +42,9 @@ type SoftwareVersion struct {
 	ID         string                    `json:"id"`
 	Type       string                    `json:"type"`
 	Attributes SoftwareVersionAttributes `json:"attributes"`
+
+	// stub is set for a related resource which was not included, only its ID and type are known
+	stub bool
 }
 
 // GetID returns the resource ID (implements client.ResourceLike)
@@ -56,6 +60,34 @@ func (r SoftwareVersion) GetResourceType() string {
 	return "software-versions"
 }
 
+// Loaded reports whether the resource holds its attributes and relationships.
+// It is false for a related resource which was not included in the response,
+// only its ID and type are known then.
+func (r SoftwareVersion) Loaded() bool {
+	return !r.stub
+}
+
+// ResolveIncludes replaces the relationships with the included resources (implements client.IncludeResolver)
+func (r *SoftwareVersion) ResolveIncludes(included *client.Included) {
+}
+
+// SoftwareVersionResourceType is the JSON:API resource type of SoftwareVersion, the key of its sparse fieldset
+const SoftwareVersionResourceType = "software-versions"
+
+// SoftwareVersion field names, to select with sparse fieldsets
+const (
+	SoftwareVersionFieldCreatedAt    = "created-at"
+	SoftwareVersionFieldDefault      = "default"
+	SoftwareVersionFieldDeprecated   = "deprecated"
+	SoftwareVersionFieldError        = "error"
+	SoftwareVersionFieldHash         = "hash"
+	SoftwareVersionFieldImage        = "image"
+	SoftwareVersionFieldLatest       = "latest"
+	SoftwareVersionFieldSoftwareType = "software-type"
+	SoftwareVersionFieldStatus       = "status"
+	SoftwareVersionFieldVersion      = "version"
+)
+
 // SoftwareVersionAttributes holds the attributes for SoftwareVersion (response)
 type SoftwareVersionAttributes struct {
 	// The resource creation timestamp.
@@ -20,3 +20,6 @@ func (r SsoBypassUsersRelationship) GetResourceType() string {
 	}
 	return "users"
 }
+
+// SsoBypassUsersRelationshipResourceType is the JSON:API resource type of SsoBypassUsersRelationship, the key of its sparse fieldset
+const SsoBypassUsersRelationshipResourceType = "users"
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"time"
 
+	"github.com/scalr/go-scalr/v2/scalr/client"
 	"github.com/scalr/go-scalr/v2/scalr/value"
 )
 
@@ -16,6 +17,9 @@ type StateVersion struct {
 	Type          string                    `json:"type"`
 	Attributes    StateVersionAttributes    `json:"attributes"`
 	Relationships StateVersionRelationships `json:"relationships"`
+
+	// stub is set for a related resource which was not included, only its ID and type are known
+	stub bool
 }
 
 // GetID returns the resource ID (implements client.ResourceLike)
@@ -31,6 +35,40 @@ func (r StateVersion) GetResourceType() string {
 	return "state-versions"
 }
 
+// Loaded reports whether the resource holds its attributes and relationships.
+// It is false for a related resource which was not included in the response,
+// only its ID and type are known then.
+func (r StateVersion) Loaded() bool {
+	return !r.stub
+}
+
+// ResolveIncludes replaces the relationships with the included resources (implements client.IncludeResolver)
+func (r *StateVersion) ResolveIncludes(included *client.Included) {
+	r.Relationships.ResolveIncludes(included)
+}
+
+// StateVersionResourceType is the JSON:API resource type of StateVersion, the key of its sparse fieldset
+const StateVersionResourceType = "state-versions"
+
+// StateVersion field names, to select with sparse fieldsets
+const (
+	StateVersionFieldCreatedAt            = "created-at"
+	StateVersionFieldForce                = "force"
+	StateVersionFieldLineage              = "lineage"
+	StateVersionFieldMd5                  = "md5"
+	StateVersionFieldModules              = "modules"
+	StateVersionFieldNextStateVersion     = "next-state-version"
+	StateVersionFieldOutputs              = "outputs"
+	StateVersionFieldPreviousStateVersion = "previous-state-version"
+	StateVersionFieldProviders            = "providers"
+	StateVersionFieldResources            = "resources"
+	StateVersionFieldRun                  = "run"
+	StateVersionFieldSerial               = "serial"
+	StateVersionFieldSize                 = "size"
+	StateVersionFieldState                = "state"
+	StateVersionFieldWorkspace            = "workspace"
+)
+
 // StateVersionAttributes holds the attributes for StateVersion (response)
 type StateVersionAttributes struct {
 	// The resource creation timestamp.
@@ -91,6 +129,7 @@ func (r *StateVersionRelationships) UnmarshalJSON(data []byte) error {
 			r.NextStateVersion = &StateVersion{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -109,6 +148,7 @@ func (r *StateVersionRelationships) UnmarshalJSON(data []byte) error {
 			r.PreviousStateVersion = &StateVersion{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -127,6 +167,7 @@ func (r *StateVersionRelationships) UnmarshalJSON(data []byte) error {
 			r.Run = &Run{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -145,79 +186,48 @@ func (r *StateVersionRelationships) UnmarshalJSON(data []byte) error {
 			r.Workspace = &Workspace{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
 	return nil
 }
 
-// PopulateIncludes merges included resources into the relationships
-func (r *StateVersionRelationships) PopulateIncludes(included []map[string]interface{}) {
-	if r == nil || len(included) == 0 {
+// ResolveIncludes replaces the relationships with the included resources, along
+// with their own relationships. Relationships which were not included are kept as is.
+func (r *StateVersionRelationships) ResolveIncludes(included *client.Included) {
+	if r == nil || included.Len() == 0 {
 		return
 	}
 
-	// Build a map of included resources by type+id for fast lookup
-	includedMap := make(map[string]map[string]interface{})
-	for _, inc := range included {
-		if id, ok := inc["id"].(string); ok {
-			if typ, ok := inc["type"].(string); ok {
-				key := typ + ":" + id
-				includedMap[key] = inc
-			}
-		}
-	}
-
-	// Populate to-one relationship: NextStateVersion
-	if r.NextStateVersion != nil && r.NextStateVersion.ID != "" {
-		key := r.NextStateVersion.Type + ":" + r.NextStateVersion.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full StateVersion
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.NextStateVersion = &full
-			}
+	if r.NextStateVersion != nil {
+		if full, ok := client.Resolve[StateVersion](included, r.NextStateVersion.Type, r.NextStateVersion.ID); ok {
+			r.NextStateVersion = full
 		}
 	}
-	// Populate to-one relationship: PreviousStateVersion
-	if r.PreviousStateVersion != nil && r.PreviousStateVersion.ID != "" {
-		key := r.PreviousStateVersion.Type + ":" + r.PreviousStateVersion.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full StateVersion
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.PreviousStateVersion = &full
-			}
+	if r.PreviousStateVersion != nil {
+		if full, ok := client.Resolve[StateVersion](included, r.PreviousStateVersion.Type, r.PreviousStateVersion.ID); ok {
+			r.PreviousStateVersion = full
 		}
 	}
-	// Populate to-one relationship: Run
-	if r.Run != nil && r.Run.ID != "" {
-		key := r.Run.Type + ":" + r.Run.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full Run
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.Run = &full
-			}
+	if r.Run != nil {
+		if full, ok := client.Resolve[Run](included, r.Run.Type, r.Run.ID); ok {
+			r.Run = full
 		}
 	}
-	// Populate to-one relationship: Workspace
-	if r.Workspace != nil && r.Workspace.ID != "" {
-		key := r.Workspace.Type + ":" + r.Workspace.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full Workspace
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.Workspace = &full
-			}
+	if r.Workspace != nil {
+		if full, ok := client.Resolve[Workspace](included, r.Workspace.Type, r.Workspace.ID); ok {
+			r.Workspace = full
 		}
 	}
 }
 
+// PopulateIncludes merges included resources into the relationships.
+// Prefer ResolveIncludes with an index built once per response.
+func (r *StateVersionRelationships) PopulateIncludes(included []map[string]interface{}) {
+	r.ResolveIncludes(client.NewIncludedFromMaps(included))
+}
+
 // Request version - used when marshalling for API requests
 // A State version is the version of a state file associated with a workspace. (for requests)
 type StateVersionRequest struct {
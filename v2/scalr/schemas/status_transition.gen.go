@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"time"
 
+	"github.com/scalr/go-scalr/v2/scalr/client"
 	"github.com/scalr/go-scalr/v2/scalr/value"
 )
 
@@ -16,6 +17,9 @@ type StatusTransition struct {
 	Type          string                        `json:"type"`
 	Attributes    StatusTransitionAttributes    `json:"attributes"`
 	Relationships StatusTransitionRelationships `json:"relationships"`
+
+	// stub is set for a related resource which was not included, only its ID and type are known
+	stub bool
 }
 
 // GetID returns the resource ID (implements client.ResourceLike)
@@ -31,6 +35,29 @@ func (r StatusTransition) GetResourceType() string {
 	return "status-transitions"
 }
 
+// Loaded reports whether the resource holds its attributes and relationships.
+// It is false for a related resource which was not included in the response,
+// only its ID and type are known then.
+func (r StatusTransition) Loaded() bool {
+	return !r.stub
+}
+
+// ResolveIncludes replaces the relationships with the included resources (implements client.IncludeResolver)
+func (r *StatusTransition) ResolveIncludes(included *client.Included) {
+	r.Relationships.ResolveIncludes(included)
+}
+
+// StatusTransitionResourceType is the JSON:API resource type of StatusTransition, the key of its sparse fieldset
+const StatusTransitionResourceType = "status-transitions"
+
+// StatusTransition field names, to select with sparse fieldsets
+const (
+	StatusTransitionFieldOccurredAt = "occurred-at"
+	StatusTransitionFieldReason     = "reason"
+	StatusTransitionFieldStatus     = "status"
+	StatusTransitionFieldUser       = "user"
+)
+
 // StatusTransitionAttributes holds the attributes for StatusTransition (response)
 type StatusTransitionAttributes struct {
 	OccurredAt time.Time `json:"occurred-at"`
@@ -66,41 +93,31 @@ func (r *StatusTransitionRelationships) UnmarshalJSON(data []byte) error {
 			r.User = &User{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
 	return nil
 }
 
-// PopulateIncludes merges included resources into the relationships
-func (r *StatusTransitionRelationships) PopulateIncludes(included []map[string]interface{}) {
-	if r == nil || len(included) == 0 {
+// ResolveIncludes replaces the relationships with the included resources, along
+// with their own relationships. Relationships which were not included are kept as is.
+func (r *StatusTransitionRelationships) ResolveIncludes(included *client.Included) {
+	if r == nil || included.Len() == 0 {
 		return
 	}
 
-	// Build a map of included resources by type+id for fast lookup
-	includedMap := make(map[string]map[string]interface{})
-	for _, inc := range included {
-		if id, ok := inc["id"].(string); ok {
-			if typ, ok := inc["type"].(string); ok {
-				key := typ + ":" + id
-				includedMap[key] = inc
-			}
+	if r.User != nil {
+		if full, ok := client.Resolve[User](included, r.User.Type, r.User.ID); ok {
+			r.User = full
 		}
 	}
+}
 
-	// Populate to-one relationship: User
-	if r.User != nil && r.User.ID != "" {
-		key := r.User.Type + ":" + r.User.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full User
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.User = &full
-			}
-		}
-	}
+// PopulateIncludes merges included resources into the relationships.
+// Prefer ResolveIncludes with an index built once per response.
+func (r *StatusTransitionRelationships) PopulateIncludes(included []map[string]interface{}) {
+	r.ResolveIncludes(client.NewIncludedFromMaps(included))
 }
 
 // Request version - used when marshalling for API requests
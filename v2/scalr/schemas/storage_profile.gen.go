@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"time"
 
+	"github.com/scalr/go-scalr/v2/scalr/client"
 	"github.com/scalr/go-scalr/v2/scalr/value"
 )
 
@@ -26,6 +27,9 @@ type StorageProfile struct {
 	ID         string                   `json:"id"`
 	Type       string                   `json:"type"`
 	Attributes StorageProfileAttributes `json:"attributes"`
+
+	// stub is set for a related resource which was not included, only its ID and type are known
+	stub bool
 }
 
 // GetID returns the resource ID (implements client.ResourceLike)
@@ -41,6 +45,44 @@ func (r StorageProfile) GetResourceType() string {
 	return "storage-profiles"
 }
 
+// Loaded reports whether the resource holds its attributes and relationships.
+// It is false for a related resource which was not included in the response,
+// only its ID and type are known then.
+func (r StorageProfile) Loaded() bool {
+	return !r.stub
+}
+
+// ResolveIncludes replaces the relationships with the included resources (implements client.IncludeResolver)
+func (r *StorageProfile) ResolveIncludes(included *client.Included) {
+}
+
+// StorageProfileResourceType is the JSON:API resource type of StorageProfile, the key of its sparse fieldset
+const StorageProfileResourceType = "storage-profiles"
+
+// StorageProfile field names, to select with sparse fieldsets
+const (
+	StorageProfileFieldAwsS3Audience         = "aws-s3-audience"
+	StorageProfileFieldAwsS3BucketName       = "aws-s3-bucket-name"
+	StorageProfileFieldAwsS3Region           = "aws-s3-region"
+	StorageProfileFieldAwsS3RoleArn          = "aws-s3-role-arn"
+	StorageProfileFieldAzurermAudience       = "azurerm-audience"
+	StorageProfileFieldAzurermClientId       = "azurerm-client-id"
+	StorageProfileFieldAzurermContainerName  = "azurerm-container-name"
+	StorageProfileFieldAzurermStorageAccount = "azurerm-storage-account"
+	StorageProfileFieldAzurermTenantId       = "azurerm-tenant-id"
+	StorageProfileFieldBackendType           = "backend-type"
+	StorageProfileFieldCreatedAt             = "created-at"
+	StorageProfileFieldDefault               = "default"
+	StorageProfileFieldErrorMessage          = "error-message"
+	StorageProfileFieldGoogleCredentials     = "google-credentials"
+	StorageProfileFieldGoogleEncryptionKey   = "google-encryption-key"
+	StorageProfileFieldGoogleProject         = "google-project"
+	StorageProfileFieldGoogleStorageBucket   = "google-storage-bucket"
+	StorageProfileFieldIsSystem              = "is-system"
+	StorageProfileFieldName                  = "name"
+	StorageProfileFieldUpdatedAt             = "updated-at"
+)
+
 // StorageProfileAttributes holds the attributes for StorageProfile (response)
 type StorageProfileAttributes struct {
 	// The value of the aud claim for the identity token.
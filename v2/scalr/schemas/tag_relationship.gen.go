@@ -20,3 +20,6 @@ func (r TagRelationship) GetResourceType() string {
 	}
 	return "tags"
 }
+
+// TagRelationshipResourceType is the JSON:API resource type of TagRelationship, the key of its sparse fieldset
+const TagRelationshipResourceType = "tags"
@@ -5,6 +5,7 @@ package schemas
 import (
 	"encoding/json"
 
+	"github.com/scalr/go-scalr/v2/scalr/client"
 	"github.com/scalr/go-scalr/v2/scalr/value"
 )
 
@@ -15,6 +16,9 @@ type Team struct {
 	Type          string            `json:"type"`
 	Attributes    TeamAttributes    `json:"attributes"`
 	Relationships TeamRelationships `json:"relationships"`
+
+	// stub is set for a related resource which was not included, only its ID and type are known
+	stub bool
 }
 
 // GetID returns the resource ID (implements client.ResourceLike)
@@ -30,6 +34,30 @@ func (r Team) GetResourceType() string {
 	return "teams"
 }
 
+// Loaded reports whether the resource holds its attributes and relationships.
+// It is false for a related resource which was not included in the response,
+// only its ID and type are known then.
+func (r Team) Loaded() bool {
+	return !r.stub
+}
+
+// ResolveIncludes replaces the relationships with the included resources (implements client.IncludeResolver)
+func (r *Team) ResolveIncludes(included *client.Included) {
+	r.Relationships.ResolveIncludes(included)
+}
+
+// TeamResourceType is the JSON:API resource type of Team, the key of its sparse fieldset
+const TeamResourceType = "teams"
+
+// Team field names, to select with sparse fieldsets
+const (
+	TeamFieldAccount          = "account"
+	TeamFieldDescription      = "description"
+	TeamFieldIdentityProvider = "identity-provider"
+	TeamFieldName             = "name"
+	TeamFieldUsers            = "users"
+)
+
 // TeamAttributes holds the attributes for Team (response)
 type TeamAttributes struct {
 	// The verbose description of the team.
@@ -71,6 +99,7 @@ func (r *TeamRelationships) UnmarshalJSON(data []byte) error {
 			r.Account = &Account{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -89,6 +118,7 @@ func (r *TeamRelationships) UnmarshalJSON(data []byte) error {
 			r.IdentityProvider = &IdentityProvider{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -109,6 +139,7 @@ func (r *TeamRelationships) UnmarshalJSON(data []byte) error {
 				r.Users[i] = &User{
 					ID:   d.ID,
 					Type: d.Type,
+					stub: true,
 				}
 			}
 		}
@@ -116,65 +147,39 @@ func (r *TeamRelationships) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-// PopulateIncludes merges included resources into the relationships
-func (r *TeamRelationships) PopulateIncludes(included []map[string]interface{}) {
-	if r == nil || len(included) == 0 {
+// ResolveIncludes replaces the relationships with the included resources, along
+// with their own relationships. Relationships which were not included are kept as is.
+func (r *TeamRelationships) ResolveIncludes(included *client.Included) {
+	if r == nil || included.Len() == 0 {
 		return
 	}
 
-	// Build a map of included resources by type+id for fast lookup
-	includedMap := make(map[string]map[string]interface{})
-	for _, inc := range included {
-		if id, ok := inc["id"].(string); ok {
-			if typ, ok := inc["type"].(string); ok {
-				key := typ + ":" + id
-				includedMap[key] = inc
-			}
+	if r.Account != nil {
+		if full, ok := client.Resolve[Account](included, r.Account.Type, r.Account.ID); ok {
+			r.Account = full
 		}
 	}
-
-	// Populate to-one relationship: Account
-	if r.Account != nil && r.Account.ID != "" {
-		key := r.Account.Type + ":" + r.Account.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full Account
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.Account = &full
-			}
+	if r.IdentityProvider != nil {
+		if full, ok := client.Resolve[IdentityProvider](included, r.IdentityProvider.Type, r.IdentityProvider.ID); ok {
+			r.IdentityProvider = full
 		}
 	}
-	// Populate to-one relationship: IdentityProvider
-	if r.IdentityProvider != nil && r.IdentityProvider.ID != "" {
-		key := r.IdentityProvider.Type + ":" + r.IdentityProvider.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full IdentityProvider
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.IdentityProvider = &full
-			}
+	for i, rel := range r.Users {
+		if rel == nil {
+			continue
 		}
-	}
-	// Populate to-many relationship: Users
-	if r.Users != nil {
-		for i, resource := range r.Users {
-			if resource != nil && resource.ID != "" {
-				key := resource.Type + ":" + resource.ID
-				if fullResource, ok := includedMap[key]; ok {
-					// Unmarshal the full resource
-					data, _ := json.Marshal(fullResource)
-					var full User
-					if err := json.Unmarshal(data, &full); err == nil {
-						r.Users[i] = &full
-					}
-				}
-			}
+		if full, ok := client.Resolve[User](included, rel.Type, rel.ID); ok {
+			r.Users[i] = full
 		}
 	}
 }
 
+// PopulateIncludes merges included resources into the relationships.
+// Prefer ResolveIncludes with an index built once per response.
+func (r *TeamRelationships) PopulateIncludes(included []map[string]interface{}) {
+	r.ResolveIncludes(client.NewIncludedFromMaps(included))
+}
+
 // Request version - used when marshalling for API requests
 // A [IAM](https://docs.scalr.io/docs/identity-and-access-management) team is used to organize users into logical units. A team can not be used to group service accounts, only standard users. (for requests)
 type TeamRequest struct {
@@ -5,6 +5,7 @@ package schemas
 import (
 	"encoding/json"
 
+	"github.com/scalr/go-scalr/v2/scalr/client"
 	"github.com/scalr/go-scalr/v2/scalr/value"
 )
 
@@ -15,6 +16,9 @@ type TerraformModuleUsage struct {
 	Type          string                            `json:"type"`
 	Attributes    TerraformModuleUsageAttributes    `json:"attributes"`
 	Relationships TerraformModuleUsageRelationships `json:"relationships"`
+
+	// stub is set for a related resource which was not included, only its ID and type are known
+	stub bool
 }
 
 // GetID returns the resource ID (implements client.ResourceLike)
@@ -30,6 +34,31 @@ func (r TerraformModuleUsage) GetResourceType() string {
 	return "tf-module-usages"
 }
 
+// Loaded reports whether the resource holds its attributes and relationships.
+// It is false for a related resource which was not included in the response,
+// only its ID and type are known then.
+func (r TerraformModuleUsage) Loaded() bool {
+	return !r.stub
+}
+
+// ResolveIncludes replaces the relationships with the included resources (implements client.IncludeResolver)
+func (r *TerraformModuleUsage) ResolveIncludes(included *client.Included) {
+	r.Relationships.ResolveIncludes(included)
+}
+
+// TerraformModuleUsageResourceType is the JSON:API resource type of TerraformModuleUsage, the key of its sparse fieldset
+const TerraformModuleUsageResourceType = "tf-module-usages"
+
+// TerraformModuleUsage field names, to select with sparse fieldsets
+const (
+	TerraformModuleUsageFieldModule            = "module"
+	TerraformModuleUsageFieldNamespace         = "namespace"
+	TerraformModuleUsageFieldParentModule      = "parent-module"
+	TerraformModuleUsageFieldSource            = "source"
+	TerraformModuleUsageFieldVersionsUsedCount = "versions-used-count"
+	TerraformModuleUsageFieldWorkspacesCount   = "workspaces-count"
+)
+
 // TerraformModuleUsageAttributes holds the attributes for TerraformModuleUsage (response)
 type TerraformModuleUsageAttributes struct {
 	Module       string  `json:"module"`
@@ -70,41 +99,31 @@ func (r *TerraformModuleUsageRelationships) UnmarshalJSON(data []byte) error {
 			r.Namespace = &ModuleUsageNamespace{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
 	return nil
 }
 
-// PopulateIncludes merges included resources into the relationships
-func (r *TerraformModuleUsageRelationships) PopulateIncludes(included []map[string]interface{}) {
-	if r == nil || len(included) == 0 {
+// ResolveIncludes replaces the relationships with the included resources, along
+// with their own relationships. Relationships which were not included are kept as is.
+func (r *TerraformModuleUsageRelationships) ResolveIncludes(included *client.Included) {
+	if r == nil || included.Len() == 0 {
 		return
 	}
 
-	// Build a map of included resources by type+id for fast lookup
-	includedMap := make(map[string]map[string]interface{})
-	for _, inc := range included {
-		if id, ok := inc["id"].(string); ok {
-			if typ, ok := inc["type"].(string); ok {
-				key := typ + ":" + id
-				includedMap[key] = inc
-			}
+	if r.Namespace != nil {
+		if full, ok := client.Resolve[ModuleUsageNamespace](included, r.Namespace.Type, r.Namespace.ID); ok {
+			r.Namespace = full
 		}
 	}
+}
 
-	// Populate to-one relationship: Namespace
-	if r.Namespace != nil && r.Namespace.ID != "" {
-		key := r.Namespace.Type + ":" + r.Namespace.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full ModuleUsageNamespace
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.Namespace = &full
-			}
-		}
-	}
+// PopulateIncludes merges included resources into the relationships.
+// Prefer ResolveIncludes with an index built once per response.
+func (r *TerraformModuleUsageRelationships) PopulateIncludes(included []map[string]interface{}) {
+	r.ResolveIncludes(client.NewIncludedFromMaps(included))
 }
 
 // Request version - used when marshalling for API requests
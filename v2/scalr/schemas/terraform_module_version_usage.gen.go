@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"time"
 
+	"github.com/scalr/go-scalr/v2/scalr/client"
 	"github.com/scalr/go-scalr/v2/scalr/value"
 )
 
@@ -16,6 +17,9 @@ type TerraformModuleVersionUsage struct {
 	Type          string                                   `json:"type"`
 	Attributes    TerraformModuleVersionUsageAttributes    `json:"attributes"`
 	Relationships TerraformModuleVersionUsageRelationships `json:"relationships"`
+
+	// stub is set for a related resource which was not included, only its ID and type are known
+	stub bool
 }
 
 // GetID returns the resource ID (implements client.ResourceLike)
@@ -31,6 +35,29 @@ func (r TerraformModuleVersionUsage) GetResourceType() string {
 	return "tf-module-version-usages"
 }
 
+// Loaded reports whether the resource holds its attributes and relationships.
+// It is false for a related resource which was not included in the response,
+// only its ID and type are known then.
+func (r TerraformModuleVersionUsage) Loaded() bool {
+	return !r.stub
+}
+
+// ResolveIncludes replaces the relationships with the included resources (implements client.IncludeResolver)
+func (r *TerraformModuleVersionUsage) ResolveIncludes(included *client.Included) {
+	r.Relationships.ResolveIncludes(included)
+}
+
+// TerraformModuleVersionUsageResourceType is the JSON:API resource type of TerraformModuleVersionUsage, the key of its sparse fieldset
+const TerraformModuleVersionUsageResourceType = "tf-module-version-usages"
+
+// TerraformModuleVersionUsage field names, to select with sparse fieldsets
+const (
+	TerraformModuleVersionUsageFieldCreatedAt   = "created-at"
+	TerraformModuleVersionUsageFieldEnvironment = "environment"
+	TerraformModuleVersionUsageFieldVersion     = "version"
+	TerraformModuleVersionUsageFieldWorkspace   = "workspace"
+)
+
 // TerraformModuleVersionUsageAttributes holds the attributes for TerraformModuleVersionUsage (response)
 type TerraformModuleVersionUsageAttributes struct {
 	CreatedAt time.Time `json:"created-at"`
@@ -68,6 +95,7 @@ func (r *TerraformModuleVersionUsageRelationships) UnmarshalJSON(data []byte) er
 			r.Environment = &Environment{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -86,55 +114,38 @@ func (r *TerraformModuleVersionUsageRelationships) UnmarshalJSON(data []byte) er
 			r.Workspace = &Workspace{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
 	return nil
 }
 
-// PopulateIncludes merges included resources into the relationships
-func (r *TerraformModuleVersionUsageRelationships) PopulateIncludes(included []map[string]interface{}) {
-	if r == nil || len(included) == 0 {
+// ResolveIncludes replaces the relationships with the included resources, along
+// with their own relationships. Relationships which were not included are kept as is.
+func (r *TerraformModuleVersionUsageRelationships) ResolveIncludes(included *client.Included) {
+	if r == nil || included.Len() == 0 {
 		return
 	}
 
-	// Build a map of included resources by type+id for fast lookup
-	includedMap := make(map[string]map[string]interface{})
-	for _, inc := range included {
-		if id, ok := inc["id"].(string); ok {
-			if typ, ok := inc["type"].(string); ok {
-				key := typ + ":" + id
-				includedMap[key] = inc
-			}
+	if r.Environment != nil {
+		if full, ok := client.Resolve[Environment](included, r.Environment.Type, r.Environment.ID); ok {
+			r.Environment = full
 		}
 	}
-
-	// Populate to-one relationship: Environment
-	if r.Environment != nil && r.Environment.ID != "" {
-		key := r.Environment.Type + ":" + r.Environment.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full Environment
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.Environment = &full
-			}
-		}
-	}
-	// Populate to-one relationship: Workspace
-	if r.Workspace != nil && r.Workspace.ID != "" {
-		key := r.Workspace.Type + ":" + r.Workspace.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full Workspace
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.Workspace = &full
-			}
+	if r.Workspace != nil {
+		if full, ok := client.Resolve[Workspace](included, r.Workspace.Type, r.Workspace.ID); ok {
+			r.Workspace = full
 		}
 	}
 }
 
+// PopulateIncludes merges included resources into the relationships.
+// Prefer ResolveIncludes with an index built once per response.
+func (r *TerraformModuleVersionUsageRelationships) PopulateIncludes(included []map[string]interface{}) {
+	r.ResolveIncludes(client.NewIncludedFromMaps(included))
+}
+
 // Request version - used when marshalling for API requests
 // Represents terraform module version usage instance. (for requests)
 type TerraformModuleVersionUsageRequest struct {
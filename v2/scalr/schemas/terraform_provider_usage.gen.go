@@ -5,6 +5,7 @@ package schemas
 import (
 	"encoding/json"
 
+	"github.com/scalr/go-scalr/v2/scalr/client"
 	"github.com/scalr/go-scalr/v2/scalr/value"
 )
 
@@ -14,6 +15,9 @@ type TerraformProviderUsage struct {
 	ID         string                           `json:"id"`
 	Type       string                           `json:"type"`
 	Attributes TerraformProviderUsageAttributes `json:"attributes"`
+
+	// stub is set for a related resource which was not included, only its ID and type are known
+	stub bool
 }
 
 // GetID returns the resource ID (implements client.ResourceLike)
@@ -29,6 +33,28 @@ func (r TerraformProviderUsage) GetResourceType() string {
 	return "tf-provider-usages"
 }
 
+// Loaded reports whether the resource holds its attributes and relationships.
+// It is false for a related resource which was not included in the response,
+// only its ID and type are known then.
+func (r TerraformProviderUsage) Loaded() bool {
+	return !r.stub
+}
+
+// ResolveIncludes replaces the relationships with the included resources (implements client.IncludeResolver)
+func (r *TerraformProviderUsage) ResolveIncludes(included *client.Included) {
+}
+
+// TerraformProviderUsageResourceType is the JSON:API resource type of TerraformProviderUsage, the key of its sparse fieldset
+const TerraformProviderUsageResourceType = "tf-provider-usages"
+
+// TerraformProviderUsage field names, to select with sparse fieldsets
+const (
+	TerraformProviderUsageFieldProvider          = "provider"
+	TerraformProviderUsageFieldSource            = "source"
+	TerraformProviderUsageFieldVersionsUsedCount = "versions-used-count"
+	TerraformProviderUsageFieldWorkspacesCount   = "workspaces-count"
+)
+
 // TerraformProviderUsageAttributes holds the attributes for TerraformProviderUsage (response)
 type TerraformProviderUsageAttributes struct {
 	Provider          string `json:"provider"`
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"time"
 
+	"github.com/scalr/go-scalr/v2/scalr/client"
 	"github.com/scalr/go-scalr/v2/scalr/value"
 )
 
@@ -16,6 +17,9 @@ type TerraformResourceInstanceUsage struct {
 	Type          string                                      `json:"type"`
 	Attributes    TerraformResourceInstanceUsageAttributes    `json:"attributes"`
 	Relationships TerraformResourceInstanceUsageRelationships `json:"relationships"`
+
+	// stub is set for a related resource which was not included, only its ID and type are known
+	stub bool
 }
 
 // GetID returns the resource ID (implements client.ResourceLike)
@@ -31,6 +35,38 @@ func (r TerraformResourceInstanceUsage) GetResourceType() string {
 	return "tf-resource-instance-usages"
 }
 
+// Loaded reports whether the resource holds its attributes and relationships.
+// It is false for a related resource which was not included in the response,
+// only its ID and type are known then.
+func (r TerraformResourceInstanceUsage) Loaded() bool {
+	return !r.stub
+}
+
+// ResolveIncludes replaces the relationships with the included resources (implements client.IncludeResolver)
+func (r *TerraformResourceInstanceUsage) ResolveIncludes(included *client.Included) {
+	r.Relationships.ResolveIncludes(included)
+}
+
+// TerraformResourceInstanceUsageResourceType is the JSON:API resource type of TerraformResourceInstanceUsage, the key of its sparse fieldset
+const TerraformResourceInstanceUsageResourceType = "tf-resource-instance-usages"
+
+// TerraformResourceInstanceUsage field names, to select with sparse fieldsets
+const (
+	TerraformResourceInstanceUsageFieldAddress        = "address"
+	TerraformResourceInstanceUsageFieldEnvironment    = "environment"
+	TerraformResourceInstanceUsageFieldExternalId     = "external-id"
+	TerraformResourceInstanceUsageFieldIsActive       = "is-active"
+	TerraformResourceInstanceUsageFieldIsDuplicate    = "is-duplicate"
+	TerraformResourceInstanceUsageFieldName           = "name"
+	TerraformResourceInstanceUsageFieldResource       = "resource"
+	TerraformResourceInstanceUsageFieldRun            = "run"
+	TerraformResourceInstanceUsageFieldStateVersion   = "state-version"
+	TerraformResourceInstanceUsageFieldUpdatedAt      = "updated-at"
+	TerraformResourceInstanceUsageFieldUpdatedByEmail = "updated-by-email"
+	TerraformResourceInstanceUsageFieldWorkspace      = "workspace"
+	TerraformResourceInstanceUsageFieldWorkspaceName  = "workspace-name"
+)
+
 // TerraformResourceInstanceUsageAttributes holds the attributes for TerraformResourceInstanceUsage (response)
 type TerraformResourceInstanceUsageAttributes struct {
 	// Resource instance address. Combines module, name and index of the resource.
@@ -88,6 +124,7 @@ func (r *TerraformResourceInstanceUsageRelationships) UnmarshalJSON(data []byte)
 			r.Environment = &Environment{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -106,6 +143,7 @@ func (r *TerraformResourceInstanceUsageRelationships) UnmarshalJSON(data []byte)
 			r.Resource = &TerraformResourceUsage{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -124,6 +162,7 @@ func (r *TerraformResourceInstanceUsageRelationships) UnmarshalJSON(data []byte)
 			r.Run = &Run{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -142,6 +181,7 @@ func (r *TerraformResourceInstanceUsageRelationships) UnmarshalJSON(data []byte)
 			r.StateVersion = &StateVersion{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -160,91 +200,53 @@ func (r *TerraformResourceInstanceUsageRelationships) UnmarshalJSON(data []byte)
 			r.Workspace = &Workspace{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
 	return nil
 }
 
-// PopulateIncludes merges included resources into the relationships
-func (r *TerraformResourceInstanceUsageRelationships) PopulateIncludes(included []map[string]interface{}) {
-	if r == nil || len(included) == 0 {
+// ResolveIncludes replaces the relationships with the included resources, along
+// with their own relationships. Relationships which were not included are kept as is.
+func (r *TerraformResourceInstanceUsageRelationships) ResolveIncludes(included *client.Included) {
+	if r == nil || included.Len() == 0 {
 		return
 	}
 
-	// Build a map of included resources by type+id for fast lookup
-	includedMap := make(map[string]map[string]interface{})
-	for _, inc := range included {
-		if id, ok := inc["id"].(string); ok {
-			if typ, ok := inc["type"].(string); ok {
-				key := typ + ":" + id
-				includedMap[key] = inc
-			}
+	if r.Environment != nil {
+		if full, ok := client.Resolve[Environment](included, r.Environment.Type, r.Environment.ID); ok {
+			r.Environment = full
 		}
 	}
-
-	// Populate to-one relationship: Environment
-	if r.Environment != nil && r.Environment.ID != "" {
-		key := r.Environment.Type + ":" + r.Environment.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full Environment
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.Environment = &full
-			}
+	if r.Resource != nil {
+		if full, ok := client.Resolve[TerraformResourceUsage](included, r.Resource.Type, r.Resource.ID); ok {
+			r.Resource = full
 		}
 	}
-	// Populate to-one relationship: Resource
-	if r.Resource != nil && r.Resource.ID != "" {
-		key := r.Resource.Type + ":" + r.Resource.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full TerraformResourceUsage
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.Resource = &full
-			}
-		}
-	}
-	// Populate to-one relationship: Run
-	if r.Run != nil && r.Run.ID != "" {
-		key := r.Run.Type + ":" + r.Run.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full Run
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.Run = &full
-			}
+	if r.Run != nil {
+		if full, ok := client.Resolve[Run](included, r.Run.Type, r.Run.ID); ok {
+			r.Run = full
 		}
 	}
-	// Populate to-one relationship: StateVersion
-	if r.StateVersion != nil && r.StateVersion.ID != "" {
-		key := r.StateVersion.Type + ":" + r.StateVersion.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full StateVersion
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.StateVersion = &full
-			}
+	if r.StateVersion != nil {
+		if full, ok := client.Resolve[StateVersion](included, r.StateVersion.Type, r.StateVersion.ID); ok {
+			r.StateVersion = full
 		}
 	}
-	// Populate to-one relationship: Workspace
-	if r.Workspace != nil && r.Workspace.ID != "" {
-		key := r.Workspace.Type + ":" + r.Workspace.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full Workspace
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.Workspace = &full
-			}
+	if r.Workspace != nil {
+		if full, ok := client.Resolve[Workspace](included, r.Workspace.Type, r.Workspace.ID); ok {
+			r.Workspace = full
 		}
 	}
 }
 
+// PopulateIncludes merges included resources into the relationships.
+// Prefer ResolveIncludes with an index built once per response.
+func (r *TerraformResourceInstanceUsageRelationships) PopulateIncludes(included []map[string]interface{}) {
+	r.ResolveIncludes(client.NewIncludedFromMaps(included))
+}
+
 // Request version - used when marshalling for API requests
 // Represents terraform resource instance usage (for requests)
 type TerraformResourceInstanceUsageRequest struct {
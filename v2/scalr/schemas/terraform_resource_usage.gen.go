@@ -5,6 +5,7 @@ package schemas
 import (
 	"encoding/json"
 
+	"github.com/scalr/go-scalr/v2/scalr/client"
 	"github.com/scalr/go-scalr/v2/scalr/value"
 )
 
@@ -15,6 +16,9 @@ type TerraformResourceUsage struct {
 	Type          string                              `json:"type"`
 	Attributes    TerraformResourceUsageAttributes    `json:"attributes"`
 	Relationships TerraformResourceUsageRelationships `json:"relationships"`
+
+	// stub is set for a related resource which was not included, only its ID and type are known
+	stub bool
 }
 
 // GetID returns the resource ID (implements client.ResourceLike)
@@ -30,6 +34,31 @@ func (r TerraformResourceUsage) GetResourceType() string {
 	return "tf-resource-usages"
 }
 
+// Loaded reports whether the resource holds its attributes and relationships.
+// It is false for a related resource which was not included in the response,
+// only its ID and type are known then.
+func (r TerraformResourceUsage) Loaded() bool {
+	return !r.stub
+}
+
+// ResolveIncludes replaces the relationships with the included resources (implements client.IncludeResolver)
+func (r *TerraformResourceUsage) ResolveIncludes(included *client.Included) {
+	r.Relationships.ResolveIncludes(included)
+}
+
+// TerraformResourceUsageResourceType is the JSON:API resource type of TerraformResourceUsage, the key of its sparse fieldset
+const TerraformResourceUsageResourceType = "tf-resource-usages"
+
+// TerraformResourceUsage field names, to select with sparse fieldsets
+const (
+	TerraformResourceUsageFieldAccount               = "account"
+	TerraformResourceUsageFieldActiveInstancesCount  = "active-instances-count"
+	TerraformResourceUsageFieldDeletedInstancesCount = "deleted-instances-count"
+	TerraformResourceUsageFieldName                  = "name"
+	TerraformResourceUsageFieldProviderType          = "provider-type"
+	TerraformResourceUsageFieldWorkspacesCount       = "workspaces-count"
+)
+
 // TerraformResourceUsageAttributes holds the attributes for TerraformResourceUsage (response)
 type TerraformResourceUsageAttributes struct {
 	// The total number of resource instances which are present in the infrastructure.
@@ -73,41 +102,31 @@ func (r *TerraformResourceUsageRelationships) UnmarshalJSON(data []byte) error {
 			r.Account = &Account{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
 	return nil
 }
 
-// PopulateIncludes merges included resources into the relationships
-func (r *TerraformResourceUsageRelationships) PopulateIncludes(included []map[string]interface{}) {
-	if r == nil || len(included) == 0 {
+// ResolveIncludes replaces the relationships with the included resources, along
+// with their own relationships. Relationships which were not included are kept as is.
+func (r *TerraformResourceUsageRelationships) ResolveIncludes(included *client.Included) {
+	if r == nil || included.Len() == 0 {
 		return
 	}
 
-	// Build a map of included resources by type+id for fast lookup
-	includedMap := make(map[string]map[string]interface{})
-	for _, inc := range included {
-		if id, ok := inc["id"].(string); ok {
-			if typ, ok := inc["type"].(string); ok {
-				key := typ + ":" + id
-				includedMap[key] = inc
-			}
+	if r.Account != nil {
+		if full, ok := client.Resolve[Account](included, r.Account.Type, r.Account.ID); ok {
+			r.Account = full
 		}
 	}
+}
 
-	// Populate to-one relationship: Account
-	if r.Account != nil && r.Account.ID != "" {
-		key := r.Account.Type + ":" + r.Account.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full Account
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.Account = &full
-			}
-		}
-	}
+// PopulateIncludes merges included resources into the relationships.
+// Prefer ResolveIncludes with an index built once per response.
+func (r *TerraformResourceUsageRelationships) PopulateIncludes(included []map[string]interface{}) {
+	r.ResolveIncludes(client.NewIncludedFromMaps(included))
 }
 
 // Request version - used when marshalling for API requests
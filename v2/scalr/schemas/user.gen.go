@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"time"
 
+	"github.com/scalr/go-scalr/v2/scalr/client"
 	"github.com/scalr/go-scalr/v2/scalr/value"
 )
 
@@ -27,6 +28,9 @@ type User struct {
 	Type          string            `json:"type"`
 	Attributes    UserAttributes    `json:"attributes"`
 	Relationships UserRelationships `json:"relationships"`
+
+	// stub is set for a related resource which was not included, only its ID and type are known
+	stub bool
 }
 
 // GetID returns the resource ID (implements client.ResourceLike)
@@ -42,6 +46,33 @@ func (r User) GetResourceType() string {
 	return "users"
 }
 
+// Loaded reports whether the resource holds its attributes and relationships.
+// It is false for a related resource which was not included in the response,
+// only its ID and type are known then.
+func (r User) Loaded() bool {
+	return !r.stub
+}
+
+// ResolveIncludes replaces the relationships with the included resources (implements client.IncludeResolver)
+func (r *User) ResolveIncludes(included *client.Included) {
+	r.Relationships.ResolveIncludes(included)
+}
+
+// UserResourceType is the JSON:API resource type of User, the key of its sparse fieldset
+const UserResourceType = "users"
+
+// User field names, to select with sparse fieldsets
+const (
+	UserFieldCreatedAt         = "created-at"
+	UserFieldEmail             = "email"
+	UserFieldFullName          = "full-name"
+	UserFieldIdentityProviders = "identity-providers"
+	UserFieldLastLoginAt       = "last-login-at"
+	UserFieldStatus            = "status"
+	UserFieldTeams             = "teams"
+	UserFieldUsername          = "username"
+)
+
 // UserAttributes holds the attributes for User (response)
 type UserAttributes struct {
 	CreatedAt   *time.Time `json:"created-at"`
@@ -83,6 +114,7 @@ func (r *UserRelationships) UnmarshalJSON(data []byte) error {
 				r.IdentityProviders[i] = &IdentityProvider{
 					ID:   d.ID,
 					Type: d.Type,
+					stub: true,
 				}
 			}
 		}
@@ -104,6 +136,7 @@ func (r *UserRelationships) UnmarshalJSON(data []byte) error {
 				r.Teams[i] = &Team{
 					ID:   d.ID,
 					Type: d.Type,
+					stub: true,
 				}
 			}
 		}
@@ -111,57 +144,37 @@ func (r *UserRelationships) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-// PopulateIncludes merges included resources into the relationships
-func (r *UserRelationships) PopulateIncludes(included []map[string]interface{}) {
-	if r == nil || len(included) == 0 {
+// ResolveIncludes replaces the relationships with the included resources, along
+// with their own relationships. Relationships which were not included are kept as is.
+func (r *UserRelationships) ResolveIncludes(included *client.Included) {
+	if r == nil || included.Len() == 0 {
 		return
 	}
 
-	// Build a map of included resources by type+id for fast lookup
-	includedMap := make(map[string]map[string]interface{})
-	for _, inc := range included {
-		if id, ok := inc["id"].(string); ok {
-			if typ, ok := inc["type"].(string); ok {
-				key := typ + ":" + id
-				includedMap[key] = inc
-			}
+	for i, rel := range r.IdentityProviders {
+		if rel == nil {
+			continue
 		}
-	}
-
-	// Populate to-many relationship: IdentityProviders
-	if r.IdentityProviders != nil {
-		for i, resource := range r.IdentityProviders {
-			if resource != nil && resource.ID != "" {
-				key := resource.Type + ":" + resource.ID
-				if fullResource, ok := includedMap[key]; ok {
-					// Unmarshal the full resource
-					data, _ := json.Marshal(fullResource)
-					var full IdentityProvider
-					if err := json.Unmarshal(data, &full); err == nil {
-						r.IdentityProviders[i] = &full
-					}
-				}
-			}
+		if full, ok := client.Resolve[IdentityProvider](included, rel.Type, rel.ID); ok {
+			r.IdentityProviders[i] = full
 		}
 	}
-	// Populate to-many relationship: Teams
-	if r.Teams != nil {
-		for i, resource := range r.Teams {
-			if resource != nil && resource.ID != "" {
-				key := resource.Type + ":" + resource.ID
-				if fullResource, ok := includedMap[key]; ok {
-					// Unmarshal the full resource
-					data, _ := json.Marshal(fullResource)
-					var full Team
-					if err := json.Unmarshal(data, &full); err == nil {
-						r.Teams[i] = &full
-					}
-				}
-			}
+	for i, rel := range r.Teams {
+		if rel == nil {
+			continue
+		}
+		if full, ok := client.Resolve[Team](included, rel.Type, rel.ID); ok {
+			r.Teams[i] = full
 		}
 	}
 }
 
+// PopulateIncludes merges included resources into the relationships.
+// Prefer ResolveIncludes with an index built once per response.
+func (r *UserRelationships) PopulateIncludes(included []map[string]interface{}) {
+	r.ResolveIncludes(client.NewIncludedFromMaps(included))
+}
+
 // Request version - used when marshalling for API requests
 // Represents a Scalr [IAM](https://docs.scalr.io/docs/identity-and-access-management) user. (for requests)
 type UserRequest struct {
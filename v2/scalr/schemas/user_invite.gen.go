@@ -5,6 +5,7 @@ package schemas
 import (
 	"encoding/json"
 
+	"github.com/scalr/go-scalr/v2/scalr/client"
 	"github.com/scalr/go-scalr/v2/scalr/value"
 )
 
@@ -15,6 +16,9 @@ type UserInvite struct {
 	Type          string                  `json:"type"`
 	Attributes    UserInviteAttributes    `json:"attributes"`
 	Relationships UserInviteRelationships `json:"relationships"`
+
+	// stub is set for a related resource which was not included, only its ID and type are known
+	stub bool
 }
 
 // GetID returns the resource ID (implements client.ResourceLike)
@@ -30,6 +34,29 @@ func (r UserInvite) GetResourceType() string {
 	return "users"
 }
 
+// Loaded reports whether the resource holds its attributes and relationships.
+// It is false for a related resource which was not included in the response,
+// only its ID and type are known then.
+func (r UserInvite) Loaded() bool {
+	return !r.stub
+}
+
+// ResolveIncludes replaces the relationships with the included resources (implements client.IncludeResolver)
+func (r *UserInvite) ResolveIncludes(included *client.Included) {
+	r.Relationships.ResolveIncludes(included)
+}
+
+// UserInviteResourceType is the JSON:API resource type of UserInvite, the key of its sparse fieldset
+const UserInviteResourceType = "users"
+
+// UserInvite field names, to select with sparse fieldsets
+const (
+	UserInviteFieldEmail      = "email"
+	UserInviteFieldRoles      = "roles"
+	UserInviteFieldSendInvite = "send-invite"
+	UserInviteFieldTeams      = "teams"
+)
+
 // UserInviteAttributes holds the attributes for UserInvite (response)
 type UserInviteAttributes struct {
 	Email string `json:"email"`
@@ -70,6 +97,7 @@ func (r *UserInviteRelationships) UnmarshalJSON(data []byte) error {
 				r.Roles[i] = &Role{
 					ID:   d.ID,
 					Type: d.Type,
+					stub: true,
 				}
 			}
 		}
@@ -91,6 +119,7 @@ func (r *UserInviteRelationships) UnmarshalJSON(data []byte) error {
 				r.Teams[i] = &Team{
 					ID:   d.ID,
 					Type: d.Type,
+					stub: true,
 				}
 			}
 		}
@@ -98,57 +127,37 @@ func (r *UserInviteRelationships) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-// PopulateIncludes merges included resources into the relationships
-func (r *UserInviteRelationships) PopulateIncludes(included []map[string]interface{}) {
-	if r == nil || len(included) == 0 {
+// ResolveIncludes replaces the relationships with the included resources, along
+// with their own relationships. Relationships which were not included are kept as is.
+func (r *UserInviteRelationships) ResolveIncludes(included *client.Included) {
+	if r == nil || included.Len() == 0 {
 		return
 	}
 
-	// Build a map of included resources by type+id for fast lookup
-	includedMap := make(map[string]map[string]interface{})
-	for _, inc := range included {
-		if id, ok := inc["id"].(string); ok {
-			if typ, ok := inc["type"].(string); ok {
-				key := typ + ":" + id
-				includedMap[key] = inc
-			}
+	for i, rel := range r.Roles {
+		if rel == nil {
+			continue
 		}
-	}
-
-	// Populate to-many relationship: Roles
-	if r.Roles != nil {
-		for i, resource := range r.Roles {
-			if resource != nil && resource.ID != "" {
-				key := resource.Type + ":" + resource.ID
-				if fullResource, ok := includedMap[key]; ok {
-					// Unmarshal the full resource
-					data, _ := json.Marshal(fullResource)
-					var full Role
-					if err := json.Unmarshal(data, &full); err == nil {
-						r.Roles[i] = &full
-					}
-				}
-			}
+		if full, ok := client.Resolve[Role](included, rel.Type, rel.ID); ok {
+			r.Roles[i] = full
 		}
 	}
-	// Populate to-many relationship: Teams
-	if r.Teams != nil {
-		for i, resource := range r.Teams {
-			if resource != nil && resource.ID != "" {
-				key := resource.Type + ":" + resource.ID
-				if fullResource, ok := includedMap[key]; ok {
-					// Unmarshal the full resource
-					data, _ := json.Marshal(fullResource)
-					var full Team
-					if err := json.Unmarshal(data, &full); err == nil {
-						r.Teams[i] = &full
-					}
-				}
-			}
+	for i, rel := range r.Teams {
+		if rel == nil {
+			continue
+		}
+		if full, ok := client.Resolve[Team](included, rel.Type, rel.ID); ok {
+			r.Teams[i] = full
 		}
 	}
 }
 
+// PopulateIncludes merges included resources into the relationships.
+// Prefer ResolveIncludes with an index built once per response.
+func (r *UserInviteRelationships) PopulateIncludes(included []map[string]interface{}) {
+	r.ResolveIncludes(client.NewIncludedFromMaps(included))
+}
+
 // Request version - used when marshalling for API requests
 // Represents an create [IAM](https://docs.scalr.io/docs/identity-and-access-management) account user request. (for requests)
 type UserInviteRequest struct {
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"time"
 
+	"github.com/scalr/go-scalr/v2/scalr/client"
 	"github.com/scalr/go-scalr/v2/scalr/value"
 )
 
@@ -27,6 +28,9 @@ type Variable struct {
 	Type          string                `json:"type"`
 	Attributes    VariableAttributes    `json:"attributes"`
 	Relationships VariableRelationships `json:"relationships"`
+
+	// stub is set for a related resource which was not included, only its ID and type are known
+	stub bool
 }
 
 // GetID returns the resource ID (implements client.ResourceLike)
@@ -42,6 +46,38 @@ func (r Variable) GetResourceType() string {
 	return "vars"
 }
 
+// Loaded reports whether the resource holds its attributes and relationships.
+// It is false for a related resource which was not included in the response,
+// only its ID and type are known then.
+func (r Variable) Loaded() bool {
+	return !r.stub
+}
+
+// ResolveIncludes replaces the relationships with the included resources (implements client.IncludeResolver)
+func (r *Variable) ResolveIncludes(included *client.Included) {
+	r.Relationships.ResolveIncludes(included)
+}
+
+// VariableResourceType is the JSON:API resource type of Variable, the key of its sparse fieldset
+const VariableResourceType = "vars"
+
+// Variable field names, to select with sparse fieldsets
+const (
+	VariableFieldAccount        = "account"
+	VariableFieldCategory       = "category"
+	VariableFieldDescription    = "description"
+	VariableFieldEnvironment    = "environment"
+	VariableFieldFinal          = "final"
+	VariableFieldHcl            = "hcl"
+	VariableFieldKey            = "key"
+	VariableFieldSensitive      = "sensitive"
+	VariableFieldUpdatedAt      = "updated-at"
+	VariableFieldUpdatedBy      = "updated-by"
+	VariableFieldUpdatedByEmail = "updated-by-email"
+	VariableFieldValue          = "value"
+	VariableFieldWorkspace      = "workspace"
+)
+
 // VariableAttributes holds the attributes for Variable (response)
 type VariableAttributes struct {
 	// * `terraform` - Values to be passed to terraform input variables of the same name. * `env` - shell environment variables. They will be injected via `export` during a terraform run.
@@ -99,6 +135,7 @@ func (r *VariableRelationships) UnmarshalJSON(data []byte) error {
 			r.Account = &Account{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -117,6 +154,7 @@ func (r *VariableRelationships) UnmarshalJSON(data []byte) error {
 			r.Environment = &Environment{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -135,6 +173,7 @@ func (r *VariableRelationships) UnmarshalJSON(data []byte) error {
 			r.UpdatedBy = &User{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -153,79 +192,48 @@ func (r *VariableRelationships) UnmarshalJSON(data []byte) error {
 			r.Workspace = &Workspace{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
 	return nil
 }
 
-// PopulateIncludes merges included resources into the relationships
-func (r *VariableRelationships) PopulateIncludes(included []map[string]interface{}) {
-	if r == nil || len(included) == 0 {
+// ResolveIncludes replaces the relationships with the included resources, along
+// with their own relationships. Relationships which were not included are kept as is.
+func (r *VariableRelationships) ResolveIncludes(included *client.Included) {
+	if r == nil || included.Len() == 0 {
 		return
 	}
 
-	// Build a map of included resources by type+id for fast lookup
-	includedMap := make(map[string]map[string]interface{})
-	for _, inc := range included {
-		if id, ok := inc["id"].(string); ok {
-			if typ, ok := inc["type"].(string); ok {
-				key := typ + ":" + id
-				includedMap[key] = inc
-			}
+	if r.Account != nil {
+		if full, ok := client.Resolve[Account](included, r.Account.Type, r.Account.ID); ok {
+			r.Account = full
 		}
 	}
-
-	// Populate to-one relationship: Account
-	if r.Account != nil && r.Account.ID != "" {
-		key := r.Account.Type + ":" + r.Account.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full Account
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.Account = &full
-			}
-		}
-	}
-	// Populate to-one relationship: Environment
-	if r.Environment != nil && r.Environment.ID != "" {
-		key := r.Environment.Type + ":" + r.Environment.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full Environment
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.Environment = &full
-			}
+	if r.Environment != nil {
+		if full, ok := client.Resolve[Environment](included, r.Environment.Type, r.Environment.ID); ok {
+			r.Environment = full
 		}
 	}
-	// Populate to-one relationship: UpdatedBy
-	if r.UpdatedBy != nil && r.UpdatedBy.ID != "" {
-		key := r.UpdatedBy.Type + ":" + r.UpdatedBy.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full User
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.UpdatedBy = &full
-			}
+	if r.UpdatedBy != nil {
+		if full, ok := client.Resolve[User](included, r.UpdatedBy.Type, r.UpdatedBy.ID); ok {
+			r.UpdatedBy = full
 		}
 	}
-	// Populate to-one relationship: Workspace
-	if r.Workspace != nil && r.Workspace.ID != "" {
-		key := r.Workspace.Type + ":" + r.Workspace.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full Workspace
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.Workspace = &full
-			}
+	if r.Workspace != nil {
+		if full, ok := client.Resolve[Workspace](included, r.Workspace.Type, r.Workspace.ID); ok {
+			r.Workspace = full
 		}
 	}
 }
 
+// PopulateIncludes merges included resources into the relationships.
+// Prefer ResolveIncludes with an index built once per response.
+func (r *VariableRelationships) PopulateIncludes(included []map[string]interface{}) {
+	r.ResolveIncludes(client.NewIncludedFromMaps(included))
+}
+
 // Request version - used when marshalling for API requests
 // A Variable describes the configuration and value of a variable in a workspace. In Scalr there are "terraform" and "environment" variables. * Terraform variables define values to be passed into the corresponding Terraform input variable that is defined in the Configuration Version to be used in a run. Scalr Terraform variables are added to the `terraform.tfvars.json` file in the working directory of the workspace prior to any run. The values passed in can be HCL structures if the `hcl` attribute is `true`. * Environment variables define shell variables that are added to the run time environment of a workspace using `export VAR=value`. These variables can pass authentication parameters to providers or any data required for local processing, such as via `local-exec` provisioners. (for requests)
 type VariableRequest struct {
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"time"
 
+	"github.com/scalr/go-scalr/v2/scalr/client"
 	"github.com/scalr/go-scalr/v2/scalr/value"
 )
 
@@ -26,6 +27,9 @@ type VariableSetVariable struct {
 	Type          string                           `json:"type"`
 	Attributes    VariableSetVariableAttributes    `json:"attributes"`
 	Relationships VariableSetVariableRelationships `json:"relationships"`
+
+	// stub is set for a related resource which was not included, only its ID and type are known
+	stub bool
 }
 
 // GetID returns the resource ID (implements client.ResourceLike)
@@ -41,6 +45,36 @@ func (r VariableSetVariable) GetResourceType() string {
 	return "var-set-variables"
 }
 
+// Loaded reports whether the resource holds its attributes and relationships.
+// It is false for a related resource which was not included in the response,
+// only its ID and type are known then.
+func (r VariableSetVariable) Loaded() bool {
+	return !r.stub
+}
+
+// ResolveIncludes replaces the relationships with the included resources (implements client.IncludeResolver)
+func (r *VariableSetVariable) ResolveIncludes(included *client.Included) {
+	r.Relationships.ResolveIncludes(included)
+}
+
+// VariableSetVariableResourceType is the JSON:API resource type of VariableSetVariable, the key of its sparse fieldset
+const VariableSetVariableResourceType = "var-set-variables"
+
+// VariableSetVariable field names, to select with sparse fieldsets
+const (
+	VariableSetVariableFieldAccount        = "account"
+	VariableSetVariableFieldCategory       = "category"
+	VariableSetVariableFieldDescription    = "description"
+	VariableSetVariableFieldFinal          = "final"
+	VariableSetVariableFieldHcl            = "hcl"
+	VariableSetVariableFieldKey            = "key"
+	VariableSetVariableFieldSensitive      = "sensitive"
+	VariableSetVariableFieldUpdatedAt      = "updated-at"
+	VariableSetVariableFieldUpdatedByEmail = "updated-by-email"
+	VariableSetVariableFieldValue          = "value"
+	VariableSetVariableFieldVarSet         = "var-set"
+)
+
 // VariableSetVariableAttributes holds the attributes for VariableSetVariable (response)
 type VariableSetVariableAttributes struct {
 	// * `terraform` - values to be passed to terraform input variables of the same name. * `shell` - environment variables. They will be injected via `export` during a terraform run.
@@ -94,6 +128,7 @@ func (r *VariableSetVariableRelationships) UnmarshalJSON(data []byte) error {
 			r.Account = &Account{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -112,55 +147,38 @@ func (r *VariableSetVariableRelationships) UnmarshalJSON(data []byte) error {
 			r.VarSet = &VariableSet{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
 	return nil
 }
 
-// PopulateIncludes merges included resources into the relationships
-func (r *VariableSetVariableRelationships) PopulateIncludes(included []map[string]interface{}) {
-	if r == nil || len(included) == 0 {
+// ResolveIncludes replaces the relationships with the included resources, along
+// with their own relationships. Relationships which were not included are kept as is.
+func (r *VariableSetVariableRelationships) ResolveIncludes(included *client.Included) {
+	if r == nil || included.Len() == 0 {
 		return
 	}
 
-	// Build a map of included resources by type+id for fast lookup
-	includedMap := make(map[string]map[string]interface{})
-	for _, inc := range included {
-		if id, ok := inc["id"].(string); ok {
-			if typ, ok := inc["type"].(string); ok {
-				key := typ + ":" + id
-				includedMap[key] = inc
-			}
+	if r.Account != nil {
+		if full, ok := client.Resolve[Account](included, r.Account.Type, r.Account.ID); ok {
+			r.Account = full
 		}
 	}
-
-	// Populate to-one relationship: Account
-	if r.Account != nil && r.Account.ID != "" {
-		key := r.Account.Type + ":" + r.Account.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full Account
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.Account = &full
-			}
-		}
-	}
-	// Populate to-one relationship: VarSet
-	if r.VarSet != nil && r.VarSet.ID != "" {
-		key := r.VarSet.Type + ":" + r.VarSet.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full VariableSet
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.VarSet = &full
-			}
+	if r.VarSet != nil {
+		if full, ok := client.Resolve[VariableSet](included, r.VarSet.Type, r.VarSet.ID); ok {
+			r.VarSet = full
 		}
 	}
 }
 
+// PopulateIncludes merges included resources into the relationships.
+// Prefer ResolveIncludes with an index built once per response.
+func (r *VariableSetVariableRelationships) PopulateIncludes(included []map[string]interface{}) {
+	r.ResolveIncludes(client.NewIncludedFromMaps(included))
+}
+
 // Request version - used when marshalling for API requests
 // A Variable describes the configuration and value of a variable in a variable set. There are "terraform" and "shell" variables. * Terraform variables define values to be passed into the corresponding Terraform input variable that is defined in the Configuration Version to be used in a run. Scalr Terraform variables are added to the `terraform.tfvars.json` file in the working directory of the workspace prior to any run. The values passed in can be HCL structures if the `hcl` attribute is `true`. * Shell variables define environment variables that are added to the runtime environment of a workspace using `export VAR=value`. These variables can pass authentication parameters to providers or any data required for local processing, such as via `local-exec` provisioners. (for requests)
 type VariableSetVariableRequest struct {
@@ -5,6 +5,7 @@ package schemas
 import (
 	"encoding/json"
 
+	"github.com/scalr/go-scalr/v2/scalr/client"
 	"github.com/scalr/go-scalr/v2/scalr/value"
 )
 
@@ -50,6 +51,9 @@ type VcsProvider struct {
 	Type          string                   `json:"type"`
 	Attributes    VcsProviderAttributes    `json:"attributes"`
 	Relationships VcsProviderRelationships `json:"relationships"`
+
+	// stub is set for a related resource which was not included, only its ID and type are known
+	stub bool
 }
 
 // GetID returns the resource ID (implements client.ResourceLike)
@@ -65,6 +69,44 @@ func (r VcsProvider) GetResourceType() string {
 	return "vcs-providers"
 }
 
+// Loaded reports whether the resource holds its attributes and relationships.
+// It is false for a related resource which was not included in the response,
+// only its ID and type are known then.
+func (r VcsProvider) Loaded() bool {
+	return !r.stub
+}
+
+// ResolveIncludes replaces the relationships with the included resources (implements client.IncludeResolver)
+func (r *VcsProvider) ResolveIncludes(included *client.Included) {
+	r.Relationships.ResolveIncludes(included)
+}
+
+// VcsProviderResourceType is the JSON:API resource type of VcsProvider, the key of its sparse fieldset
+const VcsProviderResourceType = "vcs-providers"
+
+// VcsProvider field names, to select with sparse fieldsets
+const (
+	VcsProviderFieldAccount                = "account"
+	VcsProviderFieldAgentPool              = "agent-pool"
+	VcsProviderFieldAppliesEnabled         = "applies-enabled"
+	VcsProviderFieldAuthType               = "auth-type"
+	VcsProviderFieldAutoMerge              = "auto-merge"
+	VcsProviderFieldChecksEnabled          = "checks-enabled"
+	VcsProviderFieldCommentsEnabled        = "comments-enabled"
+	VcsProviderFieldCompareStrategy        = "compare-strategy"
+	VcsProviderFieldDraftPrRunsEnabled     = "draft-pr-runs-enabled"
+	VcsProviderFieldEnvironments           = "environments"
+	VcsProviderFieldErrorMessage           = "error-message"
+	VcsProviderFieldIsShared               = "is-shared"
+	VcsProviderFieldName                   = "name"
+	VcsProviderFieldPlansEnabled           = "plans-enabled"
+	VcsProviderFieldPrMergeCommentsEnabled = "pr-merge-comments-enabled"
+	VcsProviderFieldToken                  = "token"
+	VcsProviderFieldUrl                    = "url"
+	VcsProviderFieldUsername               = "username"
+	VcsProviderFieldVcsType                = "vcs-type"
+)
+
 // VcsProviderAttributes holds the attributes for VcsProvider (response)
 type VcsProviderAttributes struct {
 	// Indicates whether triggering apply runs from a PR comment is enabled for this VCS provider.
@@ -134,6 +176,7 @@ func (r *VcsProviderRelationships) UnmarshalJSON(data []byte) error {
 			r.Account = &Account{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -152,6 +195,7 @@ func (r *VcsProviderRelationships) UnmarshalJSON(data []byte) error {
 			r.AgentPool = &AgentPool{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -172,6 +216,7 @@ func (r *VcsProviderRelationships) UnmarshalJSON(data []byte) error {
 				r.Environments[i] = &Environment{
 					ID:   d.ID,
 					Type: d.Type,
+					stub: true,
 				}
 			}
 		}
@@ -179,65 +224,39 @@ func (r *VcsProviderRelationships) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-// PopulateIncludes merges included resources into the relationships
-func (r *VcsProviderRelationships) PopulateIncludes(included []map[string]interface{}) {
-	if r == nil || len(included) == 0 {
+// ResolveIncludes replaces the relationships with the included resources, along
+// with their own relationships. Relationships which were not included are kept as is.
+func (r *VcsProviderRelationships) ResolveIncludes(included *client.Included) {
+	if r == nil || included.Len() == 0 {
 		return
 	}
 
-	// Build a map of included resources by type+id for fast lookup
-	includedMap := make(map[string]map[string]interface{})
-	for _, inc := range included {
-		if id, ok := inc["id"].(string); ok {
-			if typ, ok := inc["type"].(string); ok {
-				key := typ + ":" + id
-				includedMap[key] = inc
-			}
+	if r.Account != nil {
+		if full, ok := client.Resolve[Account](included, r.Account.Type, r.Account.ID); ok {
+			r.Account = full
 		}
 	}
-
-	// Populate to-one relationship: Account
-	if r.Account != nil && r.Account.ID != "" {
-		key := r.Account.Type + ":" + r.Account.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full Account
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.Account = &full
-			}
+	if r.AgentPool != nil {
+		if full, ok := client.Resolve[AgentPool](included, r.AgentPool.Type, r.AgentPool.ID); ok {
+			r.AgentPool = full
 		}
 	}
-	// Populate to-one relationship: AgentPool
-	if r.AgentPool != nil && r.AgentPool.ID != "" {
-		key := r.AgentPool.Type + ":" + r.AgentPool.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full AgentPool
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.AgentPool = &full
-			}
+	for i, rel := range r.Environments {
+		if rel == nil {
+			continue
 		}
-	}
-	// Populate to-many relationship: Environments
-	if r.Environments != nil {
-		for i, resource := range r.Environments {
-			if resource != nil && resource.ID != "" {
-				key := resource.Type + ":" + resource.ID
-				if fullResource, ok := includedMap[key]; ok {
-					// Unmarshal the full resource
-					data, _ := json.Marshal(fullResource)
-					var full Environment
-					if err := json.Unmarshal(data, &full); err == nil {
-						r.Environments[i] = &full
-					}
-				}
-			}
+		if full, ok := client.Resolve[Environment](included, rel.Type, rel.ID); ok {
+			r.Environments[i] = full
 		}
 	}
 }
 
+// PopulateIncludes merges included resources into the relationships.
+// Prefer ResolveIncludes with an index built once per response.
+func (r *VcsProviderRelationships) PopulateIncludes(included []map[string]interface{}) {
+	r.ResolveIncludes(client.NewIncludedFromMaps(included))
+}
+
 // Request version - used when marshalling for API requests
 // The VCS Provider resource represents a connection between a Scalr account and a VCS, such as GitHub, Gitlab, Bitbucket, and Azure DevOps. (for requests)
 type VcsProviderRequest struct {
@@ -5,6 +5,7 @@ package schemas
 import (
 	"encoding/json"
 
+	"github.com/scalr/go-scalr/v2/scalr/client"
 	"github.com/scalr/go-scalr/v2/scalr/value"
 )
 
@@ -14,6 +15,9 @@ type VcsRevision struct {
 	ID         string                `json:"id"`
 	Type       string                `json:"type"`
 	Attributes VcsRevisionAttributes `json:"attributes"`
+
+	// stub is set for a related resource which was not included, only its ID and type are known
+	stub bool
 }
 
 // GetID returns the resource ID (implements client.ResourceLike)
@@ -29,6 +33,31 @@ func (r VcsRevision) GetResourceType() string {
 	return "vcs-revisions"
 }
 
+// Loaded reports whether the resource holds its attributes and relationships.
+// It is false for a related resource which was not included in the response,
+// only its ID and type are known then.
+func (r VcsRevision) Loaded() bool {
+	return !r.stub
+}
+
+// ResolveIncludes replaces the relationships with the included resources (implements client.IncludeResolver)
+func (r *VcsRevision) ResolveIncludes(included *client.Included) {
+}
+
+// VcsRevisionResourceType is the JSON:API resource type of VcsRevision, the key of its sparse fieldset
+const VcsRevisionResourceType = "vcs-revisions"
+
+// VcsRevision field names, to select with sparse fieldsets
+const (
+	VcsRevisionFieldBranch         = "branch"
+	VcsRevisionFieldCloneUrl       = "clone-url"
+	VcsRevisionFieldCommitMessage  = "commit-message"
+	VcsRevisionFieldCommitSha      = "commit-sha"
+	VcsRevisionFieldCommitUrl      = "commit-url"
+	VcsRevisionFieldRepositoryId   = "repository-id"
+	VcsRevisionFieldSenderUsername = "sender-username"
+)
+
 // VcsRevisionAttributes holds the attributes for VcsRevision (response)
 type VcsRevisionAttributes struct {
 	Branch         *string `json:"branch"`
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"time"
 
+	"github.com/scalr/go-scalr/v2/scalr/client"
 	"github.com/scalr/go-scalr/v2/scalr/value"
 )
 
@@ -16,6 +17,9 @@ type WebhookIntegration struct {
 	Type          string                          `json:"type"`
 	Attributes    WebhookIntegrationAttributes    `json:"attributes"`
 	Relationships WebhookIntegrationRelationships `json:"relationships"`
+
+	// stub is set for a related resource which was not included, only its ID and type are known
+	stub bool
 }
 
 // GetID returns the resource ID (implements client.ResourceLike)
@@ -31,6 +35,39 @@ func (r WebhookIntegration) GetResourceType() string {
 	return "webhook-integrations"
 }
 
+// Loaded reports whether the resource holds its attributes and relationships.
+// It is false for a related resource which was not included in the response,
+// only its ID and type are known then.
+func (r WebhookIntegration) Loaded() bool {
+	return !r.stub
+}
+
+// ResolveIncludes replaces the relationships with the included resources (implements client.IncludeResolver)
+func (r *WebhookIntegration) ResolveIncludes(included *client.Included) {
+	r.Relationships.ResolveIncludes(included)
+}
+
+// WebhookIntegrationResourceType is the JSON:API resource type of WebhookIntegration, the key of its sparse fieldset
+const WebhookIntegrationResourceType = "webhook-integrations"
+
+// WebhookIntegration field names, to select with sparse fieldsets
+const (
+	WebhookIntegrationFieldAccount         = "account"
+	WebhookIntegrationFieldEnabled         = "enabled"
+	WebhookIntegrationFieldEnvironments    = "environments"
+	WebhookIntegrationFieldEvents          = "events"
+	WebhookIntegrationFieldHeaders         = "headers"
+	WebhookIntegrationFieldHttpMethod      = "http-method"
+	WebhookIntegrationFieldIsShared        = "is-shared"
+	WebhookIntegrationFieldLastTriggeredAt = "last-triggered-at"
+	WebhookIntegrationFieldMaxAttempts     = "max-attempts"
+	WebhookIntegrationFieldName            = "name"
+	WebhookIntegrationFieldSecretKey       = "secret-key"
+	WebhookIntegrationFieldStatistics      = "statistics"
+	WebhookIntegrationFieldTimeout         = "timeout"
+	WebhookIntegrationFieldUrl             = "url"
+)
+
 // WebhookIntegrationAttributes holds the attributes for WebhookIntegration (response)
 type WebhookIntegrationAttributes struct {
 	// Webhook can be turned off by setting to `false`.
@@ -88,6 +125,7 @@ func (r *WebhookIntegrationRelationships) UnmarshalJSON(data []byte) error {
 			r.Account = &Account{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -108,6 +146,7 @@ func (r *WebhookIntegrationRelationships) UnmarshalJSON(data []byte) error {
 				r.Environments[i] = &Environment{
 					ID:   d.ID,
 					Type: d.Type,
+					stub: true,
 				}
 			}
 		}
@@ -129,6 +168,7 @@ func (r *WebhookIntegrationRelationships) UnmarshalJSON(data []byte) error {
 				r.Events[i] = &EventDefinition{
 					ID:   d.ID,
 					Type: d.Type,
+					stub: true,
 				}
 			}
 		}
@@ -136,69 +176,42 @@ func (r *WebhookIntegrationRelationships) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-// PopulateIncludes merges included resources into the relationships
-func (r *WebhookIntegrationRelationships) PopulateIncludes(included []map[string]interface{}) {
-	if r == nil || len(included) == 0 {
+// ResolveIncludes replaces the relationships with the included resources, along
+// with their own relationships. Relationships which were not included are kept as is.
+func (r *WebhookIntegrationRelationships) ResolveIncludes(included *client.Included) {
+	if r == nil || included.Len() == 0 {
 		return
 	}
 
-	// Build a map of included resources by type+id for fast lookup
-	includedMap := make(map[string]map[string]interface{})
-	for _, inc := range included {
-		if id, ok := inc["id"].(string); ok {
-			if typ, ok := inc["type"].(string); ok {
-				key := typ + ":" + id
-				includedMap[key] = inc
-			}
+	if r.Account != nil {
+		if full, ok := client.Resolve[Account](included, r.Account.Type, r.Account.ID); ok {
+			r.Account = full
 		}
 	}
-
-	// Populate to-one relationship: Account
-	if r.Account != nil && r.Account.ID != "" {
-		key := r.Account.Type + ":" + r.Account.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full Account
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.Account = &full
-			}
+	for i, rel := range r.Environments {
+		if rel == nil {
+			continue
 		}
-	}
-	// Populate to-many relationship: Environments
-	if r.Environments != nil {
-		for i, resource := range r.Environments {
-			if resource != nil && resource.ID != "" {
-				key := resource.Type + ":" + resource.ID
-				if fullResource, ok := includedMap[key]; ok {
-					// Unmarshal the full resource
-					data, _ := json.Marshal(fullResource)
-					var full Environment
-					if err := json.Unmarshal(data, &full); err == nil {
-						r.Environments[i] = &full
-					}
-				}
-			}
+		if full, ok := client.Resolve[Environment](included, rel.Type, rel.ID); ok {
+			r.Environments[i] = full
 		}
 	}
-	// Populate to-many relationship: Events
-	if r.Events != nil {
-		for i, resource := range r.Events {
-			if resource != nil && resource.ID != "" {
-				key := resource.Type + ":" + resource.ID
-				if fullResource, ok := includedMap[key]; ok {
-					// Unmarshal the full resource
-					data, _ := json.Marshal(fullResource)
-					var full EventDefinition
-					if err := json.Unmarshal(data, &full); err == nil {
-						r.Events[i] = &full
-					}
-				}
-			}
+	for i, rel := range r.Events {
+		if rel == nil {
+			continue
+		}
+		if full, ok := client.Resolve[EventDefinition](included, rel.Type, rel.ID); ok {
+			r.Events[i] = full
 		}
 	}
 }
 
+// PopulateIncludes merges included resources into the relationships.
+// Prefer ResolveIncludes with an index built once per response.
+func (r *WebhookIntegrationRelationships) PopulateIncludes(included []map[string]interface{}) {
+	r.ResolveIncludes(client.NewIncludedFromMaps(included))
+}
+
 // Request version - used when marshalling for API requests
 // Represents the webhook integration The extra fields below are not available in response by default. Ask for them explicitly in the query parameter `fields[webhook-integrations]`: * statistics (for requests)
 type WebhookIntegrationRequest struct {
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"time"
 
+	"github.com/scalr/go-scalr/v2/scalr/client"
 	"github.com/scalr/go-scalr/v2/scalr/value"
 )
 
@@ -16,6 +17,9 @@ type WebhookIntegrationDelivery struct {
 	Type          string                                  `json:"type"`
 	Attributes    WebhookIntegrationDeliveryAttributes    `json:"attributes"`
 	Relationships WebhookIntegrationDeliveryRelationships `json:"relationships"`
+
+	// stub is set for a related resource which was not included, only its ID and type are known
+	stub bool
 }
 
 // GetID returns the resource ID (implements client.ResourceLike)
@@ -31,6 +35,41 @@ func (r WebhookIntegrationDelivery) GetResourceType() string {
 	return "webhook-integration-deliveries"
 }
 
+// Loaded reports whether the resource holds its attributes and relationships.
+// It is false for a related resource which was not included in the response,
+// only its ID and type are known then.
+func (r WebhookIntegrationDelivery) Loaded() bool {
+	return !r.stub
+}
+
+// ResolveIncludes replaces the relationships with the included resources (implements client.IncludeResolver)
+func (r *WebhookIntegrationDelivery) ResolveIncludes(included *client.Included) {
+	r.Relationships.ResolveIncludes(included)
+}
+
+// WebhookIntegrationDeliveryResourceType is the JSON:API resource type of WebhookIntegrationDelivery, the key of its sparse fieldset
+const WebhookIntegrationDeliveryResourceType = "webhook-integration-deliveries"
+
+// WebhookIntegrationDelivery field names, to select with sparse fieldsets
+const (
+	WebhookIntegrationDeliveryFieldAttempts            = "attempts"
+	WebhookIntegrationDeliveryFieldEnvironment         = "environment"
+	WebhookIntegrationDeliveryFieldErrorMessage        = "error-message"
+	WebhookIntegrationDeliveryFieldEvent               = "event"
+	WebhookIntegrationDeliveryFieldLastHandleAttemptAt = "last-handle-attempt-at"
+	WebhookIntegrationDeliveryFieldRequestBody         = "request-body"
+	WebhookIntegrationDeliveryFieldRequestHeaders      = "request-headers"
+	WebhookIntegrationDeliveryFieldResponseBody        = "response-body"
+	WebhookIntegrationDeliveryFieldResponseCode        = "response-code"
+	WebhookIntegrationDeliveryFieldResponseHeaders     = "response-headers"
+	WebhookIntegrationDeliveryFieldRun                 = "run"
+	WebhookIntegrationDeliveryFieldStatus              = "status"
+	WebhookIntegrationDeliveryFieldTriggeredAt         = "triggered-at"
+	WebhookIntegrationDeliveryFieldTriggeredBy         = "triggered-by"
+	WebhookIntegrationDeliveryFieldWebhook             = "webhook"
+	WebhookIntegrationDeliveryFieldWorkspace           = "workspace"
+)
+
 // WebhookIntegrationDeliveryAttributes holds the attributes for WebhookIntegrationDelivery (response)
 type WebhookIntegrationDeliveryAttributes struct {
 	// The number of attempts made to deliver the webhook.
@@ -94,6 +133,7 @@ func (r *WebhookIntegrationDeliveryRelationships) UnmarshalJSON(data []byte) err
 			r.Environment = &Environment{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -112,6 +152,7 @@ func (r *WebhookIntegrationDeliveryRelationships) UnmarshalJSON(data []byte) err
 			r.Event = &EventDefinition{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -130,6 +171,7 @@ func (r *WebhookIntegrationDeliveryRelationships) UnmarshalJSON(data []byte) err
 			r.Run = &Run{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -148,6 +190,7 @@ func (r *WebhookIntegrationDeliveryRelationships) UnmarshalJSON(data []byte) err
 			r.TriggeredBy = &User{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -166,6 +209,7 @@ func (r *WebhookIntegrationDeliveryRelationships) UnmarshalJSON(data []byte) err
 			r.Webhook = &WebhookIntegration{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -184,103 +228,58 @@ func (r *WebhookIntegrationDeliveryRelationships) UnmarshalJSON(data []byte) err
 			r.Workspace = &Workspace{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
 	return nil
 }
 
-// PopulateIncludes merges included resources into the relationships
-func (r *WebhookIntegrationDeliveryRelationships) PopulateIncludes(included []map[string]interface{}) {
-	if r == nil || len(included) == 0 {
+// ResolveIncludes replaces the relationships with the included resources, along
+// with their own relationships. Relationships which were not included are kept as is.
+func (r *WebhookIntegrationDeliveryRelationships) ResolveIncludes(included *client.Included) {
+	if r == nil || included.Len() == 0 {
 		return
 	}
 
-	// Build a map of included resources by type+id for fast lookup
-	includedMap := make(map[string]map[string]interface{})
-	for _, inc := range included {
-		if id, ok := inc["id"].(string); ok {
-			if typ, ok := inc["type"].(string); ok {
-				key := typ + ":" + id
-				includedMap[key] = inc
-			}
-		}
-	}
-
-	// Populate to-one relationship: Environment
-	if r.Environment != nil && r.Environment.ID != "" {
-		key := r.Environment.Type + ":" + r.Environment.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full Environment
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.Environment = &full
-			}
+	if r.Environment != nil {
+		if full, ok := client.Resolve[Environment](included, r.Environment.Type, r.Environment.ID); ok {
+			r.Environment = full
 		}
 	}
-	// Populate to-one relationship: Event
-	if r.Event != nil && r.Event.ID != "" {
-		key := r.Event.Type + ":" + r.Event.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full EventDefinition
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.Event = &full
-			}
+	if r.Event != nil {
+		if full, ok := client.Resolve[EventDefinition](included, r.Event.Type, r.Event.ID); ok {
+			r.Event = full
 		}
 	}
-	// Populate to-one relationship: Run
-	if r.Run != nil && r.Run.ID != "" {
-		key := r.Run.Type + ":" + r.Run.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full Run
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.Run = &full
-			}
+	if r.Run != nil {
+		if full, ok := client.Resolve[Run](included, r.Run.Type, r.Run.ID); ok {
+			r.Run = full
 		}
 	}
-	// Populate to-one relationship: TriggeredBy
-	if r.TriggeredBy != nil && r.TriggeredBy.ID != "" {
-		key := r.TriggeredBy.Type + ":" + r.TriggeredBy.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full User
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.TriggeredBy = &full
-			}
+	if r.TriggeredBy != nil {
+		if full, ok := client.Resolve[User](included, r.TriggeredBy.Type, r.TriggeredBy.ID); ok {
+			r.TriggeredBy = full
 		}
 	}
-	// Populate to-one relationship: Webhook
-	if r.Webhook != nil && r.Webhook.ID != "" {
-		key := r.Webhook.Type + ":" + r.Webhook.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full WebhookIntegration
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.Webhook = &full
-			}
+	if r.Webhook != nil {
+		if full, ok := client.Resolve[WebhookIntegration](included, r.Webhook.Type, r.Webhook.ID); ok {
+			r.Webhook = full
 		}
 	}
-	// Populate to-one relationship: Workspace
-	if r.Workspace != nil && r.Workspace.ID != "" {
-		key := r.Workspace.Type + ":" + r.Workspace.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full Workspace
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.Workspace = &full
-			}
+	if r.Workspace != nil {
+		if full, ok := client.Resolve[Workspace](included, r.Workspace.Type, r.Workspace.ID); ok {
+			r.Workspace = full
 		}
 	}
 }
 
+// PopulateIncludes merges included resources into the relationships.
+// Prefer ResolveIncludes with an index built once per response.
+func (r *WebhookIntegrationDeliveryRelationships) PopulateIncludes(included []map[string]interface{}) {
+	r.ResolveIncludes(client.NewIncludedFromMaps(included))
+}
+
 // Request version - used when marshalling for API requests
 // Represents an IaCP Webhook integration delivery. (for requests)
 type WebhookIntegrationDeliveryRequest struct {
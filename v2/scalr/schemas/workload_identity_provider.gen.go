@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"time"
 
+	"github.com/scalr/go-scalr/v2/scalr/client"
 	"github.com/scalr/go-scalr/v2/scalr/value"
 )
 
@@ -30,6 +31,9 @@ type WorkloadIdentityProvider struct {
 	Type          string                                `json:"type"`
 	Attributes    WorkloadIdentityProviderAttributes    `json:"attributes"`
 	Relationships WorkloadIdentityProviderRelationships `json:"relationships"`
+
+	// stub is set for a related resource which was not included, only its ID and type are known
+	stub bool
 }
 
 // GetID returns the resource ID (implements client.ResourceLike)
@@ -45,6 +49,32 @@ func (r WorkloadIdentityProvider) GetResourceType() string {
 	return "workload-identity-providers"
 }
 
+// Loaded reports whether the resource holds its attributes and relationships.
+// It is false for a related resource which was not included in the response,
+// only its ID and type are known then.
+func (r WorkloadIdentityProvider) Loaded() bool {
+	return !r.stub
+}
+
+// ResolveIncludes replaces the relationships with the included resources (implements client.IncludeResolver)
+func (r *WorkloadIdentityProvider) ResolveIncludes(included *client.Included) {
+	r.Relationships.ResolveIncludes(included)
+}
+
+// WorkloadIdentityProviderResourceType is the JSON:API resource type of WorkloadIdentityProvider, the key of its sparse fieldset
+const WorkloadIdentityProviderResourceType = "workload-identity-providers"
+
+// WorkloadIdentityProvider field names, to select with sparse fieldsets
+const (
+	WorkloadIdentityProviderFieldAllowedAudiences             = "allowed-audiences"
+	WorkloadIdentityProviderFieldAssumeServiceAccountPolicies = "assume-service-account-policies"
+	WorkloadIdentityProviderFieldCreatedAt                    = "created-at"
+	WorkloadIdentityProviderFieldCreatedByEmail               = "created-by-email"
+	WorkloadIdentityProviderFieldName                         = "name"
+	WorkloadIdentityProviderFieldStatus                       = "status"
+	WorkloadIdentityProviderFieldUrl                          = "url"
+)
+
 // WorkloadIdentityProviderAttributes holds the attributes for WorkloadIdentityProvider (response)
 type WorkloadIdentityProviderAttributes struct {
 	// A list of audiences allowed for the IdP tokens.
@@ -91,6 +121,7 @@ func (r *WorkloadIdentityProviderRelationships) UnmarshalJSON(data []byte) error
 				r.AssumeServiceAccountPolicies[i] = &AssumeServiceAccountPolicy{
 					ID:   d.ID,
 					Type: d.Type,
+					stub: true,
 				}
 			}
 		}
@@ -98,41 +129,29 @@ func (r *WorkloadIdentityProviderRelationships) UnmarshalJSON(data []byte) error
 	return nil
 }
 
-// PopulateIncludes merges included resources into the relationships
-func (r *WorkloadIdentityProviderRelationships) PopulateIncludes(included []map[string]interface{}) {
-	if r == nil || len(included) == 0 {
+// ResolveIncludes replaces the relationships with the included resources, along
+// with their own relationships. Relationships which were not included are kept as is.
+func (r *WorkloadIdentityProviderRelationships) ResolveIncludes(included *client.Included) {
+	if r == nil || included.Len() == 0 {
 		return
 	}
 
-	// Build a map of included resources by type+id for fast lookup
-	includedMap := make(map[string]map[string]interface{})
-	for _, inc := range included {
-		if id, ok := inc["id"].(string); ok {
-			if typ, ok := inc["type"].(string); ok {
-				key := typ + ":" + id
-				includedMap[key] = inc
-			}
+	for i, rel := range r.AssumeServiceAccountPolicies {
+		if rel == nil {
+			continue
 		}
-	}
-
-	// Populate to-many relationship: AssumeServiceAccountPolicies
-	if r.AssumeServiceAccountPolicies != nil {
-		for i, resource := range r.AssumeServiceAccountPolicies {
-			if resource != nil && resource.ID != "" {
-				key := resource.Type + ":" + resource.ID
-				if fullResource, ok := includedMap[key]; ok {
-					// Unmarshal the full resource
-					data, _ := json.Marshal(fullResource)
-					var full AssumeServiceAccountPolicy
-					if err := json.Unmarshal(data, &full); err == nil {
-						r.AssumeServiceAccountPolicies[i] = &full
-					}
-				}
-			}
+		if full, ok := client.Resolve[AssumeServiceAccountPolicy](included, rel.Type, rel.ID); ok {
+			r.AssumeServiceAccountPolicies[i] = full
 		}
 	}
 }
 
+// PopulateIncludes merges included resources into the relationships.
+// Prefer ResolveIncludes with an index built once per response.
+func (r *WorkloadIdentityProviderRelationships) PopulateIncludes(included []map[string]interface{}) {
+	r.ResolveIncludes(client.NewIncludedFromMaps(included))
+}
+
 // Request version - used when marshalling for API requests
 // External OpenID Connect 1.0 compliant identity provider. (for requests)
 type WorkloadIdentityProviderRequest struct {
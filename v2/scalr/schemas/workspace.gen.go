@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"time"
 
+	"github.com/scalr/go-scalr/v2/scalr/client"
 	"github.com/scalr/go-scalr/v2/scalr/value"
 )
 
@@ -73,6 +74,9 @@ type Workspace struct {
 	Type          string                 `json:"type"`
 	Attributes    WorkspaceAttributes    `json:"attributes"`
 	Relationships WorkspaceRelationships `json:"relationships"`
+
+	// stub is set for a related resource which was not included, only its ID and type are known
+	stub bool
 }
 
 // GetID returns the resource ID (implements client.ResourceLike)
@@ -88,6 +92,74 @@ func (r Workspace) GetResourceType() string {
 	return "workspaces"
 }
 
+// Loaded reports whether the resource holds its attributes and relationships.
+// It is false for a related resource which was not included in the response,
+// only its ID and type are known then.
+func (r Workspace) Loaded() bool {
+	return !r.stub
+}
+
+// ResolveIncludes replaces the relationships with the included resources (implements client.IncludeResolver)
+func (r *Workspace) ResolveIncludes(included *client.Included) {
+	r.Relationships.ResolveIncludes(included)
+}
+
+// WorkspaceResourceType is the JSON:API resource type of Workspace, the key of its sparse fieldset
+const WorkspaceResourceType = "workspaces"
+
+// Workspace field names, to select with sparse fieldsets
+const (
+	WorkspaceFieldAgentPool                        = "agent-pool"
+	WorkspaceFieldApplySchedule                    = "apply-schedule"
+	WorkspaceFieldAutoApply                        = "auto-apply"
+	WorkspaceFieldAutoDestroyDays                  = "auto-destroy-days"
+	WorkspaceFieldAutoDestroyStatus                = "auto-destroy-status"
+	WorkspaceFieldAutoDestroyTime                  = "auto-destroy-time"
+	WorkspaceFieldAutoQueueRuns                    = "auto-queue-runs"
+	WorkspaceFieldConfigurationVersion             = "configuration-version"
+	WorkspaceFieldCreatedAt                        = "created-at"
+	WorkspaceFieldCreatedBy                        = "created-by"
+	WorkspaceFieldCurrentRun                       = "current-run"
+	WorkspaceFieldDeletionProtectionChangedByEmail = "deletion-protection-changed-by-email"
+	WorkspaceFieldDeletionProtectionEnabled        = "deletion-protection-enabled"
+	WorkspaceFieldDestroySchedule                  = "destroy-schedule"
+	WorkspaceFieldDriftReport                      = "drift-report"
+	WorkspaceFieldEnvironment                      = "environment"
+	WorkspaceFieldEnvironmentType                  = "environment-type"
+	WorkspaceFieldExecutionMode                    = "execution-mode"
+	WorkspaceFieldFavorite                         = "favorite"
+	WorkspaceFieldForceLatestRun                   = "force-latest-run"
+	WorkspaceFieldHasResources                     = "has-resources"
+	WorkspaceFieldHooks                            = "hooks"
+	WorkspaceFieldIacPlatform                      = "iac-platform"
+	WorkspaceFieldLatestConfigurationVersion       = "latest-configuration-version"
+	WorkspaceFieldLatestRun                        = "latest-run"
+	WorkspaceFieldLockReason                       = "lock-reason"
+	WorkspaceFieldLocked                           = "locked"
+	WorkspaceFieldLockedBy                         = "locked-by"
+	WorkspaceFieldLockedByRun                      = "locked-by-run"
+	WorkspaceFieldModule                           = "module"
+	WorkspaceFieldModuleVersion                    = "module-version"
+	WorkspaceFieldName                             = "name"
+	WorkspaceFieldOperations                       = "operations"
+	WorkspaceFieldPermissions                      = "permissions"
+	WorkspaceFieldReadmeId                         = "readme-id"
+	WorkspaceFieldRemoteBackend                    = "remote-backend"
+	WorkspaceFieldRemoteStateSharing               = "remote-state-sharing"
+	WorkspaceFieldRunOperationTimeout              = "run-operation-timeout"
+	WorkspaceFieldSshKey                           = "ssh-key"
+	WorkspaceFieldTags                             = "tags"
+	WorkspaceFieldTerraformVersion                 = "terraform-version"
+	WorkspaceFieldTerragrunt                       = "terragrunt"
+	WorkspaceFieldUpdatedAt                        = "updated-at"
+	WorkspaceFieldUpdatedBy                        = "updated-by"
+	WorkspaceFieldUpdatedByEmail                   = "updated-by-email"
+	WorkspaceFieldVarFiles                         = "var-files"
+	WorkspaceFieldVcsProvider                      = "vcs-provider"
+	WorkspaceFieldVcsRepo                          = "vcs-repo"
+	WorkspaceFieldWorkingDirectory                 = "working-directory"
+)
+
 // WorkspaceAttributes holds the attributes for Workspace (response)
 type WorkspaceAttributes struct {
 	// Cron expression for scheduled runs. Time should be in UTC.
@@ -210,6 +282,7 @@ func (r *WorkspaceRelationships) UnmarshalJSON(data []byte) error {
 			r.AgentPool = &AgentPool{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -228,6 +301,7 @@ func (r *WorkspaceRelationships) UnmarshalJSON(data []byte) error {
 			r.ConfigurationVersion = &ConfigurationVersion{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -246,6 +320,7 @@ func (r *WorkspaceRelationships) UnmarshalJSON(data []byte) error {
 			r.CreatedBy = &User{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -264,6 +339,7 @@ func (r *WorkspaceRelationships) UnmarshalJSON(data []byte) error {
 			r.CurrentRun = &Run{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -282,6 +358,7 @@ func (r *WorkspaceRelationships) UnmarshalJSON(data []byte) error {
 			r.DriftReport = &DriftReport{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -300,6 +377,7 @@ func (r *WorkspaceRelationships) UnmarshalJSON(data []byte) error {
 			r.Environment = &Environment{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -318,6 +396,7 @@ func (r *WorkspaceRelationships) UnmarshalJSON(data []byte) error {
 			r.LatestConfigurationVersion = &ConfigurationVersion{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -336,6 +415,7 @@ func (r *WorkspaceRelationships) UnmarshalJSON(data []byte) error {
 			r.LatestRun = &Run{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -354,6 +434,7 @@ func (r *WorkspaceRelationships) UnmarshalJSON(data []byte) error {
 			r.LockedBy = &User{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -372,6 +453,7 @@ func (r *WorkspaceRelationships) UnmarshalJSON(data []byte) error {
 			r.LockedByRun = &Run{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -390,6 +472,7 @@ func (r *WorkspaceRelationships) UnmarshalJSON(data []byte) error {
 			r.Module = &Module{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -408,6 +491,7 @@ func (r *WorkspaceRelationships) UnmarshalJSON(data []byte) error {
 			r.ModuleVersion = &ModuleVersion{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -426,6 +510,7 @@ func (r *WorkspaceRelationships) UnmarshalJSON(data []byte) error {
 			r.ReadmeId = &WorkspaceReadme{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -444,6 +529,7 @@ func (r *WorkspaceRelationships) UnmarshalJSON(data []byte) error {
 			r.SshKey = &SSHKey{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -464,6 +550,7 @@ func (r *WorkspaceRelationships) UnmarshalJSON(data []byte) error {
 				r.Tags[i] = &Tag{
 					ID:   d.ID,
 					Type: d.Type,
+					stub: true,
 				}
 			}
 		}
@@ -483,6 +570,7 @@ func (r *WorkspaceRelationships) UnmarshalJSON(data []byte) error {
 			r.UpdatedBy = &User{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
@@ -501,239 +589,116 @@ func (r *WorkspaceRelationships) UnmarshalJSON(data []byte) error {
 			r.VcsProvider = &VcsProvider{
 				ID:   rel.Data.ID,
 				Type: rel.Data.Type,
+				stub: true,
 			}
 		}
 	}
 	return nil
 }
 
-// PopulateIncludes merges included resources into the relationships
-func (r *WorkspaceRelationships) PopulateIncludes(included []map[string]interface{}) {
-	if r == nil || len(included) == 0 {
+// ResolveIncludes replaces the relationships with the included resources, along
+// with their own relationships. Relationships which were not included are kept as is.
+func (r *WorkspaceRelationships) ResolveIncludes(included *client.Included) {
+	if r == nil || included.Len() == 0 {
 		return
 	}
 
-	// Build a map of included resources by type+id for fast lookup
-	includedMap := make(map[string]map[string]interface{})
-	for _, inc := range included {
-		if id, ok := inc["id"].(string); ok {
-			if typ, ok := inc["type"].(string); ok {
-				key := typ + ":" + id
-				includedMap[key] = inc
-			}
+	if r.AgentPool != nil {
+		if full, ok := client.Resolve[AgentPool](included, r.AgentPool.Type, r.AgentPool.ID); ok {
+			r.AgentPool = full
 		}
 	}
-
-	// Populate to-one relationship: AgentPool
-	if r.AgentPool != nil && r.AgentPool.ID != "" {
-		key := r.AgentPool.Type + ":" + r.AgentPool.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full AgentPool
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.AgentPool = &full
-			}
+	if r.ConfigurationVersion != nil {
+		if full, ok := client.Resolve[ConfigurationVersion](included, r.ConfigurationVersion.Type, r.ConfigurationVersion.ID); ok {
+			r.ConfigurationVersion = full
 		}
 	}
-	// Populate to-one relationship: ConfigurationVersion
-	if r.ConfigurationVersion != nil && r.ConfigurationVersion.ID != "" {
-		key := r.ConfigurationVersion.Type + ":" + r.ConfigurationVersion.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full ConfigurationVersion
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.ConfigurationVersion = &full
-			}
+	if r.CreatedBy != nil {
+		if full, ok := client.Resolve[User](included, r.CreatedBy.Type, r.CreatedBy.ID); ok {
+			r.CreatedBy = full
 		}
 	}
-	// Populate to-one relationship: CreatedBy
-	if r.CreatedBy != nil && r.CreatedBy.ID != "" {
-		key := r.CreatedBy.Type + ":" + r.CreatedBy.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full User
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.CreatedBy = &full
-			}
+	if r.CurrentRun != nil {
+		if full, ok := client.Resolve[Run](included, r.CurrentRun.Type, r.CurrentRun.ID); ok {
+			r.CurrentRun = full
 		}
 	}
-	// Populate to-one relationship: CurrentRun
-	if r.CurrentRun != nil && r.CurrentRun.ID != "" {
-		key := r.CurrentRun.Type + ":" + r.CurrentRun.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full Run
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.CurrentRun = &full
-			}
+	if r.DriftReport != nil {
+		if full, ok := client.Resolve[DriftReport](included, r.DriftReport.Type, r.DriftReport.ID); ok {
+			r.DriftReport = full
 		}
 	}
-	// Populate to-one relationship: DriftReport
-	if r.DriftReport != nil && r.DriftReport.ID != "" {
-		key := r.DriftReport.Type + ":" + r.DriftReport.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full DriftReport
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.DriftReport = &full
-			}
+	if r.Environment != nil {
+		if full, ok := client.Resolve[Environment](included, r.Environment.Type, r.Environment.ID); ok {
+			r.Environment = full
 		}
 	}
-	// Populate to-one relationship: Environment
-	if r.Environment != nil && r.Environment.ID != "" {
-		key := r.Environment.Type + ":" + r.Environment.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full Environment
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.Environment = &full
-			}
+	if r.LatestConfigurationVersion != nil {
+		if full, ok := client.Resolve[ConfigurationVersion](included, r.LatestConfigurationVersion.Type, r.LatestConfigurationVersion.ID); ok {
+			r.LatestConfigurationVersion = full
 		}
 	}
-	// Populate to-one relationship: LatestConfigurationVersion
-	if r.LatestConfigurationVersion != nil && r.LatestConfigurationVersion.ID != "" {
-		key := r.LatestConfigurationVersion.Type + ":" + r.LatestConfigurationVersion.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full ConfigurationVersion
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.LatestConfigurationVersion = &full
-			}
+	if r.LatestRun != nil {
+		if full, ok := client.Resolve[Run](included, r.LatestRun.Type, r.LatestRun.ID); ok {
+			r.LatestRun = full
 		}
 	}
-	// Populate to-one relationship: LatestRun
-	if r.LatestRun != nil && r.LatestRun.ID != "" {
-		key := r.LatestRun.Type + ":" + r.LatestRun.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full Run
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.LatestRun = &full
-			}
+	if r.LockedBy != nil {
+		if full, ok := client.Resolve[User](included, r.LockedBy.Type, r.LockedBy.ID); ok {
+			r.LockedBy = full
 		}
 	}
-	// Populate to-one relationship: LockedBy
-	if r.LockedBy != nil && r.LockedBy.ID != "" {
-		key := r.LockedBy.Type + ":" + r.LockedBy.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full User
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.LockedBy = &full
-			}
+	if r.LockedByRun != nil {
+		if full, ok := client.Resolve[Run](included, r.LockedByRun.Type, r.LockedByRun.ID); ok {
+			r.LockedByRun = full
 		}
 	}
-	// Populate to-one relationship: LockedByRun
-	if r.LockedByRun != nil && r.LockedByRun.ID != "" {
-		key := r.LockedByRun.Type + ":" + r.LockedByRun.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full Run
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.LockedByRun = &full
-			}
+	if r.Module != nil {
+		if full, ok := client.Resolve[Module](included, r.Module.Type, r.Module.ID); ok {
+			r.Module = full
 		}
 	}
-	// Populate to-one relationship: Module
-	if r.Module != nil && r.Module.ID != "" {
-		key := r.Module.Type + ":" + r.Module.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full Module
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.Module = &full
-			}
+	if r.ModuleVersion != nil {
+		if full, ok := client.Resolve[ModuleVersion](included, r.ModuleVersion.Type, r.ModuleVersion.ID); ok {
+			r.ModuleVersion = full
 		}
 	}
-	// Populate to-one relationship: ModuleVersion
-	if r.ModuleVersion != nil && r.ModuleVersion.ID != "" {
-		key := r.ModuleVersion.Type + ":" + r.ModuleVersion.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full ModuleVersion
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.ModuleVersion = &full
-			}
+	if r.ReadmeId != nil {
+		if full, ok := client.Resolve[WorkspaceReadme](included, r.ReadmeId.Type, r.ReadmeId.ID); ok {
+			r.ReadmeId = full
 		}
 	}
-	// Populate to-one relationship: ReadmeId
-	if r.ReadmeId != nil && r.ReadmeId.ID != "" {
-		key := r.ReadmeId.Type + ":" + r.ReadmeId.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full WorkspaceReadme
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.ReadmeId = &full
-			}
+	if r.SshKey != nil {
+		if full, ok := client.Resolve[SSHKey](included, r.SshKey.Type, r.SshKey.ID); ok {
+			r.SshKey = full
 		}
 	}
-	// Populate to-one relationship: SshKey
-	if r.SshKey != nil && r.SshKey.ID != "" {
-		key := r.SshKey.Type + ":" + r.SshKey.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full SSHKey
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.SshKey = &full
-			}
+	for i, rel := range r.Tags {
+		if rel == nil {
+			continue
 		}
-	}
-	// Populate to-many relationship: Tags
-	if r.Tags != nil {
-		for i, resource := range r.Tags {
-			if resource != nil && resource.ID != "" {
-				key := resource.Type + ":" + resource.ID
-				if fullResource, ok := includedMap[key]; ok {
-					// Unmarshal the full resource
-					data, _ := json.Marshal(fullResource)
-					var full Tag
-					if err := json.Unmarshal(data, &full); err == nil {
-						r.Tags[i] = &full
-					}
-				}
-			}
+		if full, ok := client.Resolve[Tag](included, rel.Type, rel.ID); ok {
+			r.Tags[i] = full
 		}
 	}
-	// Populate to-one relationship: UpdatedBy
-	if r.UpdatedBy != nil && r.UpdatedBy.ID != "" {
-		key := r.UpdatedBy.Type + ":" + r.UpdatedBy.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full User
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.UpdatedBy = &full
-			}
+	if r.UpdatedBy != nil {
+		if full, ok := client.Resolve[User](included, r.UpdatedBy.Type, r.UpdatedBy.ID); ok {
+			r.UpdatedBy = full
 		}
 	}
-	// Populate to-one relationship: VcsProvider
-	if r.VcsProvider != nil && r.VcsProvider.ID != "" {
-		key := r.VcsProvider.Type + ":" + r.VcsProvider.ID
-		if fullResource, ok := includedMap[key]; ok {
-			// Unmarshal the full resource
-			data, _ := json.Marshal(fullResource)
-			var full VcsProvider
-			if err := json.Unmarshal(data, &full); err == nil {
-				r.VcsProvider = &full
-			}
+	if r.VcsProvider != nil {
+		if full, ok := client.Resolve[VcsProvider](included, r.VcsProvider.Type, r.VcsProvider.ID); ok {
+			r.VcsProvider = full
 		}
 	}
 }
 
+// PopulateIncludes merges included resources into the relationships.
+// Prefer ResolveIncludes with an index built once per response.
+func (r *WorkspaceRelationships) PopulateIncludes(included []map[string]interface{}) {
+	r.ResolveIncludes(client.NewIncludedFromMaps(included))
+}
+
 // Request version - used when marshalling for API requests
 // A Workspace is where Terraform runs are performed for a specific configuration, and where the resulting state file(s) are stored. Workspaces belong to environments and can have `variables` configured to provide inputs to the configuration, authenticate providers etc. The extra fields below are not available in response by default. Ask for them explicitly in the query parameter `fields[workspaces]`: * module (for requests)
 type WorkspaceRequest struct {
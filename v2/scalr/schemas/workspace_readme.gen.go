@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"time"
 
+	"github.com/scalr/go-scalr/v2/scalr/client"
 	"github.com/scalr/go-scalr/v2/scalr/value"
 )
 
@@ -15,6 +16,9 @@ type WorkspaceReadme struct {
 	ID         string                    `json:"id"`
 	Type       string                    `json:"type"`
 	Attributes WorkspaceReadmeAttributes `json:"attributes"`
+
+	// stub is set for a related resource which was not included, only its ID and type are known
+	stub bool
 }
 
 // GetID returns the resource ID (implements client.ResourceLike)
@@ -30,6 +34,26 @@ func (r WorkspaceReadme) GetResourceType() string {
 	return "workspace-readme"
 }
 
+// Loaded reports whether the resource holds its attributes and relationships.
+// It is false for a related resource which was not included in the response,
+// only its ID and type are known then.
+func (r WorkspaceReadme) Loaded() bool {
+	return !r.stub
+}
+
+// ResolveIncludes replaces the relationships with the included resources (implements client.IncludeResolver)
+func (r *WorkspaceReadme) ResolveIncludes(included *client.Included) {
+}
+
+// WorkspaceReadmeResourceType is the JSON:API resource type of WorkspaceReadme, the key of its sparse fieldset
+const WorkspaceReadmeResourceType = "workspace-readme"
+
+// WorkspaceReadme field names, to select with sparse fieldsets
+const (
+	WorkspaceReadmeFieldContent   = "content"
+	WorkspaceReadmeFieldCreatedAt = "created-at"
+)
+
 // WorkspaceReadmeAttributes holds the attributes for WorkspaceReadme (response)
 type WorkspaceReadmeAttributes struct {
 	// The content of the readme file.
@@ -20,3 +20,6 @@ func (r WorkspaceVariableSetRelationship) GetResourceType() string {
 	}
 	return "var-sets"
 }
+
+// WorkspaceVariableSetRelationshipResourceType is the JSON:API resource type of WorkspaceVariableSetRelationship, the key of its sparse fieldset
+const WorkspaceVariableSetRelationshipResourceType = "var-sets"